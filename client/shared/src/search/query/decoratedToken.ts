@@ -1030,7 +1030,8 @@ const decoratePredicateBody = (path: string[], body: string, offset: number): De
         case 'has.tag':
         case 'has.owner':
         case 'has.key':
-        case 'has.topic': {
+        case 'has.topic':
+        case 'depends.on': {
             return [
                 {
                     type: 'literal',
@@ -212,6 +212,9 @@ const toPredicateHover = (token: MetaPredicate): string => {
         case 'has.topic': {
             return `**Built-in predicate**. Search only inside repositories that have the github topic \`${parameters}\`.`
         }
+        case 'depends.on': {
+            return `**Built-in predicate**. Search only inside repositories that depend on the package \`${parameters}\`, directly or transitively, according to their precise code intelligence data.`
+        }
         case 'contains.commit.after':
         case 'has.commit.after': {
             return `**Built-in predicate**. Search only inside repositories that have been committed to since \`${parameters}\`.`
@@ -44,6 +44,10 @@ export const PREDICATES: Access[] = [
                     { name: 'topic' },
                 ],
             },
+            {
+                name: 'depends',
+                fields: [{ name: 'on' }],
+            },
         ],
     },
     {
@@ -200,6 +204,12 @@ export const predicateCompletion = (field: string): Completion[] => {
                 description: 'Search only inside repositories that have a matching GitHub/GitLab topic',
                 asSnippet: true,
             },
+            {
+                label: 'depends.on(...)',
+                insertText: 'depends.on(${1})',
+                description: 'Search only inside repositories that depend on a package, directly or transitively',
+                asSnippet: true,
+            },
             {
                 label: 'has.commit.after(...)',
                 insertText: 'has.commit.after(${1:1 month ago})',
@@ -241,6 +251,12 @@ export const predicateCompletion = (field: string): Completion[] => {
                 asSnippet: true,
                 description: 'Search only inside files that have a contributor that matches a pattern',
             },
+            {
+                label: 'has.drift(...)',
+                insertText: 'has.drift(${1})',
+                asSnippet: true,
+                description: 'Search only inside files whose declared owners are unlikely to still own them',
+            },
         ]
     }
     return []
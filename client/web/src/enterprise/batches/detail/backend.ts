@@ -847,6 +847,14 @@ export const GET_CHANGESETS_BY_IDS_QUERY = gql`
                     repository {
                         name
                     }
+                    impact {
+                        codeHost
+                        team
+                        timeToMerge
+                        reviewLatency
+                        checkRuns
+                        failedCheckRuns
+                    }
                 }
             }
         }
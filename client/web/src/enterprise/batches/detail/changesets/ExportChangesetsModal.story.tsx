@@ -41,6 +41,15 @@ const mocks = new WildcardMockLink([
                             repository: {
                                 name: 'github.com/sourcegraph/sourcegraph',
                             },
+                            impact: {
+                                __typename: 'ChangesetImpactMetrics',
+                                codeHost: 'github',
+                                team: 'batch-changes',
+                                timeToMerge: null,
+                                reviewLatency: 3600,
+                                checkRuns: 4,
+                                failedCheckRuns: 1,
+                            },
                         },
                     ],
                 },
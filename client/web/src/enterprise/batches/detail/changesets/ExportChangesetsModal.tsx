@@ -20,7 +20,19 @@ const exportOptions = {
 
 type ExportFormat = typeof exportOptions[keyof typeof exportOptions]
 
-const headers = ['title', 'externalURL', 'repository', 'reviewState', 'state'] as const
+const headers = [
+    'title',
+    'externalURL',
+    'repository',
+    'reviewState',
+    'state',
+    'codeHost',
+    'team',
+    'timeToMergeSeconds',
+    'reviewLatencySeconds',
+    'checkRuns',
+    'failedCheckRuns',
+] as const
 
 export const ExportChangesetsModal: React.FunctionComponent<React.PropsWithChildren<ExportChangesetsModalProps>> = ({
     onCancel,
@@ -51,6 +63,12 @@ export const ExportChangesetsModal: React.FunctionComponent<React.PropsWithChild
                             node.repository.name,
                             node.reviewState,
                             node.state,
+                            node.impact?.codeHost ?? '',
+                            node.impact?.team ?? '',
+                            node.impact?.timeToMerge ?? '',
+                            node.impact?.reviewLatency ?? '',
+                            node.impact?.checkRuns ?? '',
+                            node.impact?.failedCheckRuns ?? '',
                         ].join(', ')
                     )
                 }
@@ -62,7 +80,7 @@ export const ExportChangesetsModal: React.FunctionComponent<React.PropsWithChild
 
     const constructJSONDataExport = useCallback(
         (nodes: GetChangesetsByIDsResult['getChangesetsByIDs']['nodes']): string => {
-            const jsonRows: Record<typeof headers[number], string | null>[] = []
+            const jsonRows: Record<typeof headers[number], string | number | null>[] = []
             for (const node of nodes) {
                 if (node.__typename === 'ExternalChangeset') {
                     jsonRows.push({
@@ -71,6 +89,12 @@ export const ExportChangesetsModal: React.FunctionComponent<React.PropsWithChild
                         repository: node.repository.name,
                         reviewState: node.reviewState,
                         state: node.state,
+                        codeHost: node.impact?.codeHost ?? null,
+                        team: node.impact?.team ?? null,
+                        timeToMergeSeconds: node.impact?.timeToMerge ?? null,
+                        reviewLatencySeconds: node.impact?.reviewLatency ?? null,
+                        checkRuns: node.impact?.checkRuns ?? null,
+                        failedCheckRuns: node.impact?.failedCheckRuns ?? null,
                     })
                 }
             }
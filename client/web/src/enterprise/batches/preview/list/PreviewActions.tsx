@@ -103,6 +103,9 @@ const PreviewAction: React.FunctionComponent<React.PropsWithChildren<PreviewActi
         case ChangesetSpecOperation.REATTACH: {
             return <PreviewActionReattach className={className} />
         }
+        case ChangesetSpecOperation.REBASE: {
+            return <PreviewActionRebase className={className} />
+        }
         case ChangesetSpecOperation.SYNC:
         case ChangesetSpecOperation.SLEEP: {
             // We don't want to expose these states.
@@ -337,6 +340,21 @@ export const PreviewActionPush: React.FunctionComponent<React.PropsWithChildren<
     </div>
 )
 
+export const PreviewActionRebase: React.FunctionComponent<React.PropsWithChildren<{ className?: string }>> = ({
+    className,
+}) => (
+    <div className={classNames(className, iconClassNames)}>
+        <Tooltip content="This changeset has merge conflicts and will be rebased onto its base branch">
+            <Icon
+                aria-label="This changeset has merge conflicts and will be rebased onto its base branch"
+                className="mr-1"
+                svgPath={mdiSourceBranchSync}
+            />
+        </Tooltip>
+        <span aria-hidden={true}>Rebase</span>
+    </div>
+)
+
 export const PreviewActionUnknown: React.FunctionComponent<
     React.PropsWithChildren<{ className?: string; operations: string }>
 > = ({ operations, className }) => (
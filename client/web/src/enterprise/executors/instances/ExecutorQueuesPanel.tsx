@@ -0,0 +1,112 @@
+import React from 'react'
+
+import { Badge, Container, ErrorAlert, H3, LoadingSpinner, Text } from '@sourcegraph/wildcard'
+
+import {
+    ExecutorQueuedJobWaitReason,
+    type ExecutorQueueFields,
+    type ExecutorQueuedJobFields,
+} from '../../../graphql-operations'
+
+import { useExecutorQueues as defaultUseExecutorQueues } from './useExecutorQueues'
+
+const waitReasonDescriptions: Record<ExecutorQueuedJobWaitReason, string> = {
+    [ExecutorQueuedJobWaitReason.LOWER_PRIORITY]: 'Jobs with a higher priority are dequeued first.',
+    [ExecutorQueuedJobWaitReason.NAMESPACE_FAIR_SHARE]:
+        'Its namespace already has more jobs being processed than the namespaces of the jobs dequeued first.',
+    [ExecutorQueuedJobWaitReason.QUEUE_WEIGHT]:
+        'Multi-queue executors reached the dequeue limit of this queue and prefer other queues.',
+    [ExecutorQueuedJobWaitReason.ENQUEUE_ORDER]: 'Jobs enqueued earlier are dequeued first.',
+}
+
+export interface ExecutorQueuesPanelProps {
+    useExecutorQueues?: typeof defaultUseExecutorQueues
+}
+
+/**
+ * Shows the executor queues along with the next queued jobs of each queue, and why they are
+ * waiting.
+ */
+export const ExecutorQueuesPanel: React.FunctionComponent<ExecutorQueuesPanelProps> = ({
+    useExecutorQueues = defaultUseExecutorQueues,
+}) => {
+    const { data, loading, error } = useExecutorQueues({ first: 10 })
+
+    if (loading && !data) {
+        return <LoadingSpinner />
+    }
+
+    if (error) {
+        return <ErrorAlert prefix="Failed to load executor queues" error={error} />
+    }
+
+    return (
+        <>
+            {data?.executorQueues.map(queue => (
+                <ExecutorQueueNode key={queue.name} queue={queue} />
+            ))}
+        </>
+    )
+}
+
+const ExecutorQueueNode: React.FunctionComponent<{ queue: ExecutorQueueFields }> = ({ queue }) => (
+    <Container className="mb-3">
+        <H3>
+            Queue <code>{queue.name}</code>
+        </H3>
+        <Text className="text-muted">{queue.schedulingPolicy}</Text>
+        <Text>
+            {queue.activeExecutors} active executors, {queue.recentDequeues} of {queue.dequeueLimit} recent
+            dequeues (weight {queue.weight}).
+        </Text>
+        {queue.waitReasons.map(reason => (
+            <Text key={reason} className="text-warning">
+                {reason}
+            </Text>
+        ))}
+        {queue.queuedJobs.length === 0 ? (
+            <Text className="text-muted mb-0">No queued jobs.</Text>
+        ) : (
+            <table className="table mb-0">
+                <thead>
+                    <tr>
+                        <th>Position</th>
+                        <th>Job</th>
+                        <th>Priority</th>
+                        <th>Namespace</th>
+                        <th>Wait reason</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {queue.queuedJobs.map(job => (
+                        <ExecutorQueuedJobNode key={job.jobID} job={job} />
+                    ))}
+                </tbody>
+            </table>
+        )}
+    </Container>
+)
+
+const ExecutorQueuedJobNode: React.FunctionComponent<{ job: ExecutorQueuedJobFields }> = ({ job }) => (
+    <tr>
+        <td>{job.position}</td>
+        <td>#{job.jobID}</td>
+        <td>{job.priority}</td>
+        <td>
+            {job.namespace === null ? (
+                <span className="text-muted">None</span>
+            ) : (
+                <>
+                    {job.namespace} <Badge variant="secondary">{job.namespaceProcessing} processing</Badge>
+                </>
+            )}
+        </td>
+        <td>
+            {job.waitReason === null ? (
+                <Badge variant="success">Next</Badge>
+            ) : (
+                waitReasonDescriptions[job.waitReason]
+            )}
+        </td>
+    </tr>
+)
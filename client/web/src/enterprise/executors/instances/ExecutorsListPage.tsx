@@ -15,6 +15,7 @@ import type { ExecutorFields } from '../../../graphql-operations'
 import { eventLogger } from '../../../tracking/eventLogger'
 
 import { ExecutorNode } from './ExecutorNode'
+import { ExecutorQueuesPanel } from './ExecutorQueuesPanel'
 import { queryExecutors as defaultQueryExecutors } from './useExecutors'
 
 const filters: FilteredConnectionFilter[] = [
@@ -101,6 +102,8 @@ export const ExecutorsListPage: React.FC<ExecutorsListPageProps> = ({ queryExecu
                     withCenteredSummary={true}
                 />
             </Container>
+            <H3>Queues</H3>
+            <ExecutorQueuesPanel />
         </>
     )
 }
@@ -0,0 +1,48 @@
+import type { ApolloError } from '@apollo/client'
+
+import { gql, useQuery } from '@sourcegraph/http-client'
+
+import type { ExecutorQueuesResult, ExecutorQueuesVariables } from '../../../graphql-operations'
+
+const EXECUTOR_QUEUES = gql`
+    query ExecutorQueues($first: Int) {
+        executorQueues {
+            ...ExecutorQueueFields
+        }
+    }
+
+    fragment ExecutorQueueFields on ExecutorQueue {
+        name
+        weight
+        dequeueLimit
+        recentDequeues
+        activeExecutors
+        schedulingPolicy
+        waitReasons
+        queuedJobs(first: $first) {
+            ...ExecutorQueuedJobFields
+        }
+    }
+
+    fragment ExecutorQueuedJobFields on ExecutorQueuedJob {
+        jobID
+        position
+        priority
+        namespace
+        namespaceProcessing
+        waitReason
+    }
+`
+
+export const useExecutorQueues = (
+    variables: ExecutorQueuesVariables
+): {
+    error?: ApolloError
+    loading: boolean
+    data: ExecutorQueuesResult | undefined
+} =>
+    useQuery<ExecutorQueuesResult, ExecutorQueuesVariables>(EXECUTOR_QUEUES, {
+        variables,
+        fetchPolicy: 'cache-and-network',
+        pollInterval: 5000,
+    })
@@ -83,13 +83,15 @@ func doMain() error {
 			return err
 		}
 		return run.Post(ctx, logger, &util.RealCmdRunner{}, arguments.step, executionInput, previousResult, wd, *workspaceFilesPath, addSafe)
+	case "builtin":
+		return run.Builtin(ctx, os.Stdout, arguments.step, executionInput, previousResult, wd)
 	default:
 		return errors.Newf("invalid mode %q", arguments.mode)
 	}
 }
 
 func usage() {
-	fmt.Fprintf(os.Stderr, "Usage: %s <pre|post> <step index> [OPTIONS]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Usage: %s <pre|builtin|post> <step index> [OPTIONS]\n", os.Args[0])
 	fmt.Fprintf(os.Stderr, "OPTIONS:\n")
 	flag.PrintDefaults()
 }
@@ -102,7 +104,7 @@ func parseArgs(arguments []string) (args, error) {
 	}
 
 	mode := arguments[0]
-	if mode != "pre" && mode != "post" {
+	if mode != "pre" && mode != "builtin" && mode != "post" {
 		return args{}, errors.Newf("invalid mode %q", mode)
 	}
 
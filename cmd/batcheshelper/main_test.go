@@ -27,6 +27,14 @@ func TestParseArguments(t *testing.T) {
 				step: 1,
 			},
 		},
+		{
+			name: "Builtin arguments are valid",
+			args: []string{"builtin", "1"},
+			expectedArgs: args{
+				mode: "builtin",
+				step: 1,
+			},
+		},
 		{
 			name: "Post arguments are valid",
 			args: []string{"post", "1"},
@@ -0,0 +1,52 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/builtin"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Builtin executes a built-in step of the Batch Change in place of the
+// container that runs the step script. Like the container, it writes the
+// stdout and stderr of the step to the log files the post step reads.
+func Builtin(
+	ctx context.Context,
+	stdout io.Writer,
+	stepIdx int,
+	executionInput batcheslib.WorkspacesExecutionInput,
+	previousResult execution.AfterStepResult,
+	workingDirectory string,
+) error {
+	step := executionInput.Steps[stepIdx]
+	if !step.IsBuiltin() {
+		return errors.Newf("step %d is not a built-in step", stepIdx+1)
+	}
+
+	stepContext, err := getStepContext(executionInput, previousResult)
+	if err != nil {
+		return err
+	}
+
+	var stepStdout, stepStderr bytes.Buffer
+	runErr := builtin.Run(ctx, step, filepath.Join(workingDirectory, gitDir), executionInput.Path, &stepContext, io.MultiWriter(&stepStdout, stdout))
+	if runErr != nil {
+		fmt.Fprintln(&stepStderr, runErr.Error())
+	}
+
+	if err = os.WriteFile(filepath.Join(workingDirectory, fmt.Sprintf("stdout%d.log", stepIdx)), stepStdout.Bytes(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to write stdout file")
+	}
+	if err = os.WriteFile(filepath.Join(workingDirectory, fmt.Sprintf("stderr%d.log", stepIdx)), stepStderr.Bytes(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to write stderr file")
+	}
+
+	return errors.Wrapf(runErr, "running %s step", step.Kind())
+}
@@ -0,0 +1,100 @@
+package run_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/batcheshelper/run"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+)
+
+func TestBuiltin(t *testing.T) {
+	tests := []struct {
+		name           string
+		step           batcheslib.Step
+		expectedErr    string
+		expectedStdout string
+		expectedStderr string
+		expectedFile   string
+	}{
+		{
+			name: "Write files",
+			step: batcheslib.Step{
+				WriteFiles: map[string]string{"README.md": "# ${{ repository.name }}\n"},
+			},
+			expectedStdout: "README.md: written\n",
+			expectedFile:   "# github.com/sourcegraph/sourcegraph\n",
+		},
+		{
+			name: "Replace",
+			step: batcheslib.Step{
+				Replace: &batcheslib.ReplaceStep{Pattern: "Hello", Replacement: "Goodbye"},
+			},
+			expectedStdout: "README.md: 1 replacements\n",
+			expectedFile:   "# Goodbye\n",
+		},
+		{
+			name: "Failure",
+			step: batcheslib.Step{
+				Replace: &batcheslib.ReplaceStep{Pattern: "(", Replacement: "Goodbye"},
+			},
+			expectedErr:    "running replace step: compiling pattern: error parsing regexp: missing closing ): `(`",
+			expectedStderr: "compiling pattern: error parsing regexp: missing closing ): `(`\n",
+			expectedFile:   "# Hello\n",
+		},
+		{
+			name:        "Container step",
+			step:        batcheslib.Step{Run: "echo hello", Container: "alpine:3"},
+			expectedErr: "step 1 is not a built-in step",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "repository", "docs"), os.ModePerm))
+			readmePath := filepath.Join(dir, "repository", "docs", "README.md")
+			require.NoError(t, os.WriteFile(readmePath, []byte("# Hello\n"), os.ModePerm))
+
+			executionInput := batcheslib.WorkspacesExecutionInput{
+				Repository: batcheslib.WorkspaceRepo{Name: "github.com/sourcegraph/sourcegraph"},
+				Path:       "docs",
+				Steps:      []batcheslib.Step{test.step},
+			}
+
+			var stdout bytes.Buffer
+			err := run.Builtin(context.Background(), &stdout, 0, executionInput, execution.AfterStepResult{}, dir)
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if test.expectedFile == "" {
+				return
+			}
+
+			assert.Equal(t, test.expectedStdout, stdout.String())
+
+			stdoutLog, err := os.ReadFile(filepath.Join(dir, "stdout0.log"))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedStdout, string(stdoutLog))
+
+			stderrLog, err := os.ReadFile(filepath.Join(dir, "stderr0.log"))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedStderr, string(stderrLog))
+
+			content, err := os.ReadFile(readmePath)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedFile, string(content))
+		})
+	}
+}
@@ -135,6 +135,11 @@ func Pre(
 		return err
 	}
 
+	// Built-in steps don't run a script, they're executed by batcheshelper.
+	if step.IsBuiltin() {
+		return nil
+	}
+
 	// Render the step.Run template.
 	var runScript bytes.Buffer
 	if err = template.RenderStepTemplate("step-run", step.Run, &runScript, &stepContext); err != nil {
@@ -104,6 +104,26 @@ func TestPre(t *testing.T) {
 				assert.Equal(t, os.FileMode(0755), stat.Mode().Perm())
 			},
 		},
+		{
+			name: "Built-in step",
+			step: 0,
+			executionInput: batcheslib.WorkspacesExecutionInput{
+				Steps: []batcheslib.Step{
+					{WriteFiles: map[string]string{"README.md": "hello"}},
+				},
+			},
+			previousResult: execution.AfterStepResult{},
+			assertFunc: func(t *testing.T, logEntries []batcheslib.LogEvent, dir string) {
+				require.Len(t, logEntries, 1)
+				assert.Equal(t, batcheslib.LogEventOperationTaskStep, logEntries[0].Operation)
+				assert.Equal(t, batcheslib.LogEventStatusStarted, logEntries[0].Status)
+
+				// Built-in steps don't run a script.
+				dirEntries, err := os.ReadDir(dir)
+				require.NoError(t, err)
+				require.Len(t, dirEntries, 0)
+			},
+		},
 		{
 			name: "File mounts",
 			step: 0,
@@ -50,9 +50,13 @@ const schemeJobToken = "Bearer"
 //	}
 type BaseClient struct {
 	httpClient *http.Client
-	options    BaseClientOptions
-	baseURL    *url.URL
-	logger     log.Logger
+	// streamingHTTPClient is used for requests with a body that is written while the request
+	// is in flight. It does not retry requests, as retrying requires the complete body to be
+	// buffered in memory.
+	streamingHTTPClient *http.Client
+	options             BaseClientOptions
+	baseURL             *url.URL
+	logger              log.Logger
 }
 
 type BaseClientOptions struct {
@@ -84,11 +88,16 @@ func NewBaseClient(logger log.Logger, options BaseClientOptions) (*BaseClient, e
 	if err != nil {
 		return nil, err
 	}
+	streamingHTTPClient, err := httpcli.NewFactory(nil, httpcli.TracedTransportOpt).Client()
+	if err != nil {
+		return nil, err
+	}
 	return &BaseClient{
-		httpClient: httpcli.InternalClient,
-		options:    options,
-		baseURL:    baseURL,
-		logger:     logger,
+		httpClient:          httpcli.InternalClient,
+		streamingHTTPClient: streamingHTTPClient,
+		options:             options,
+		baseURL:             baseURL,
+		logger:              logger,
 	}, nil
 }
 
@@ -158,6 +167,25 @@ func (c *BaseClient) DoAndDrop(ctx context.Context, req *http.Request) error {
 	return err
 }
 
+// DoStreaming performs the given HTTP request, whose body may still be written while the
+// request is in flight, and ignores the response body. Unlike Do, the request is never retried.
+func (c *BaseClient) DoStreaming(ctx context.Context, req *http.Request) error {
+	req.Header.Set("User-Agent", c.options.UserAgent)
+	req = req.WithContext(ctx)
+
+	resp, err := ctxhttp.Do(req.Context(), c.streamingHTTPClient, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return &UnexpectedStatusCodeErr{StatusCode: resp.StatusCode}
+	}
+
+	return nil
+}
+
 // NewRequest creates a new http.Request where only the Authorization HTTP header is set.
 func (c *BaseClient) NewRequest(jobId int, token, method, path string, payload io.Reader) (*http.Request, error) {
 	u := c.newRelativeURL(path)
@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/apiclient"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
 	internalexecutor "github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/executor/logstream"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/version"
@@ -40,6 +41,7 @@ type Client struct {
 // Compile time validation.
 var _ workerutil.Store[types.Job] = &Client{}
 var _ cmdlogger.ExecutionLogEntryStore = &Client{}
+var _ cmdlogger.ExecutionLogStreamer = &Client{}
 
 func New(observationCtx *observation.Context, options Options, metricsGatherer prometheus.Gatherer) (*Client, error) {
 	logger := log.Scoped("executor-api-queue-client")
@@ -398,6 +400,64 @@ func (c *Client) UpdateExecutionLogEntry(ctx context.Context, job types.Job, ent
 	return c.client.DoAndDrop(ctx, req)
 }
 
+// StreamExecutionLogs opens a long-lived request over which the log events of the given job are
+// sent as newline-delimited JSON while the job is running. The request ends once the returned
+// stream is closed.
+func (c *Client) StreamExecutionLogs(ctx context.Context, job types.Job) (cmdlogger.ExecutionLogStream, error) {
+	queue := c.inferQueueName(job)
+
+	pr, pw := io.Pipe()
+	req, err := c.client.NewRequest(job.ID, job.Token, http.MethodPost, fmt.Sprintf("%s/streamExecutionLogs", queue), pr)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	stream := &executionLogStream{
+		writer:  pw,
+		encoder: json.NewEncoder(pw),
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		defer close(stream.done)
+
+		var err error
+		ctx, _, endObservation := c.operations.streamExecutionLogs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+			attribute.String("queueName", queue),
+			attribute.Int("jobID", job.ID),
+		}})
+		defer endObservation(1, observation.Args{})
+
+		err = c.client.DoStreaming(ctx, req)
+		// Unblock pending sends if the request ended before the stream was closed.
+		pr.CloseWithError(err)
+		stream.err = err
+	}()
+
+	return stream, nil
+}
+
+// executionLogStream writes log events to the body of an in-flight request.
+type executionLogStream struct {
+	writer  *io.PipeWriter
+	encoder *json.Encoder
+	done    chan struct{}
+	err     error
+}
+
+func (s *executionLogStream) Send(event logstream.Event) error {
+	return s.encoder.Encode(event)
+}
+
+func (s *executionLogStream) Close() error {
+	if err := s.writer.Close(); err != nil {
+		return err
+	}
+	<-s.done
+	return s.err
+}
+
 // inferQueueName returns the queue name if it is specified on the job, which is the case
 // when an executor is configured to listen to multiple queues. If the queue name is empty,
 // return the specific queue that is configured.
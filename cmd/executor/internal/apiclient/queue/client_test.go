@@ -19,6 +19,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/apiclient"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/apiclient/queue"
 	internalexecutor "github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/executor/logstream"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -727,6 +728,85 @@ func TestUpdateExecutionLogEntryBadResponse(t *testing.T) {
 	})
 }
 
+func TestStreamExecutionLogs(t *testing.T) {
+	var received []logstream.Event
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/.executors/queue/test_queue/streamExecutionLogs", r.URL.Path)
+		assert.Equal(t, "Bearer job-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "42", r.Header.Get("X-Sourcegraph-Job-ID"))
+
+		decoder := json.NewDecoder(r.Body)
+		for {
+			var event logstream.Event
+			if err := decoder.Decode(&event); err != nil {
+				assert.ErrorIs(t, err, io.EOF)
+				break
+			}
+			received = append(received, event)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client, err := newQueueClient(queue.Options{
+		ExecutorName: "deadbeef",
+		QueueName:    "test_queue",
+		BaseClientOptions: apiclient.BaseClientOptions{
+			ExecutorName: "deadbeef",
+			EndpointOptions: apiclient.EndpointOptions{
+				URL:        ts.URL,
+				PathPrefix: "/.executors/queue",
+				Token:      "hunter2",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	stream, err := client.StreamExecutionLogs(context.Background(), types.Job{ID: 42, Token: "job-token"})
+	require.NoError(t, err)
+
+	events := []logstream.Event{
+		logstream.NewEntryEvent(1, internalexecutor.ExecutionLogEntry{Key: "foo", Command: []string{"ls", "-a"}, StartTime: time.Unix(1587396557, 0).UTC(), Out: "stdout: a\n"}),
+		{EntryID: 1, Offset: 10, ExecutionLogEntry: internalexecutor.ExecutionLogEntry{Key: "foo", Command: []string{"ls", "-a"}, StartTime: time.Unix(1587396557, 0).UTC(), Out: "stdout: b\n", ExitCode: intptr(0), DurationMs: intptr(12)}},
+	}
+	for _, event := range events {
+		require.NoError(t, stream.Send(event))
+	}
+	require.NoError(t, stream.Close())
+
+	if diff := cmp.Diff(events, received); diff != "" {
+		t.Fatalf("unexpected events (-want +got):\n%s", diff)
+	}
+}
+
+func TestStreamExecutionLogsBadResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	client, err := newQueueClient(queue.Options{
+		QueueName: "test_queue",
+		BaseClientOptions: apiclient.BaseClientOptions{
+			EndpointOptions: apiclient.EndpointOptions{URL: ts.URL, PathPrefix: "/.executors/queue"},
+		},
+	})
+	require.NoError(t, err)
+
+	stream, err := client.StreamExecutionLogs(context.Background(), types.Job{ID: 42, Token: "job-token"})
+	require.NoError(t, err)
+
+	// Sends fail once the request was rejected.
+	for i := 0; i < 100; i++ {
+		if err := stream.Send(logstream.NewEntryEvent(1, internalexecutor.ExecutionLogEntry{Key: "foo"})); err != nil {
+			break
+		}
+	}
+	assert.Error(t, stream.Close())
+}
+
 type routeSpec struct {
 	expectedMethod       string
 	expectedPath         string
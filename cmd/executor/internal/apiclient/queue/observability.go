@@ -15,6 +15,7 @@ type operations struct {
 	heartbeat               *observation.Operation
 	addExecutionLogEntry    *observation.Operation
 	updateExecutionLogEntry *observation.Operation
+	streamExecutionLogs     *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -41,5 +42,6 @@ func newOperations(observationCtx *observation.Context) *operations {
 		heartbeat:               op("Heartbeat"),
 		addExecutionLogEntry:    op("AddExecutionLogEntry"),
 		updateExecutionLogEntry: op("UpdateExecutionLogEntry"),
+		streamExecutionLogs:     op("StreamExecutionLogs"),
 	}
 }
@@ -31,6 +31,7 @@ type Config struct {
 	QueueNames                                     []string
 	QueuePollInterval                              time.Duration
 	MaximumNumJobs                                 int
+	Runtime                                        string
 	FirecrackerImage                               string
 	FirecrackerKernelImage                         string
 	FirecrackerSandboxImage                        string
@@ -54,6 +55,9 @@ type Config struct {
 	DockerRegistryMirrorURL                        string
 	DockerAddHostGateway                           bool
 	DockerAuthConfig                               types.DockerAuthConfig
+	PodmanOCIRuntime                               string
+	PodmanNetwork                                  string
+	PodmanPidsLimit                                int
 	KubernetesConfigPath                           string
 	KubernetesNodeName                             string
 	KubernetesNodeSelector                         string
@@ -117,7 +121,8 @@ func (c *Config) Load() {
 	c.QueueNamesStr = c.GetOptional("EXECUTOR_QUEUE_NAMES", "The names of multiple queues to listen to, comma-separated.")
 	c.QueuePollInterval = c.GetInterval("EXECUTOR_QUEUE_POLL_INTERVAL", "1s", "Interval between dequeue requests.")
 	c.MaximumNumJobs = c.GetInt("EXECUTOR_MAXIMUM_NUM_JOBS", "1", "Number of virtual machines or containers that can be running at once.")
-	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux" && !IsKubernetes()), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only. Kubernetes is not supported.")
+	c.Runtime = c.GetOptional("EXECUTOR_RUNTIME", "The runtime used to execute jobs. One of 'docker', 'firecracker' or 'podman'. When unset, Firecracker is used if EXECUTOR_USE_FIRECRACKER is enabled and Docker otherwise.")
+	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux" && !IsKubernetes() && (c.Runtime == "" || c.Runtime == RuntimeFirecracker)), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only. Kubernetes is not supported.")
 	c.FirecrackerImage = c.Get("EXECUTOR_FIRECRACKER_IMAGE", DefaultFirecrackerImage, "The base image to use for virtual machines.")
 	c.FirecrackerKernelImage = c.Get("EXECUTOR_FIRECRACKER_KERNEL_IMAGE", DefaultFirecrackerKernelImage, "The base image containing the kernel binary to use for virtual machines.")
 	c.FirecrackerSandboxImage = c.Get("EXECUTOR_FIRECRACKER_SANDBOX_IMAGE", DefaultFirecrackerSandboxImage, "The OCI image for the ignite VM sandbox.")
@@ -152,6 +157,9 @@ func (c *Config) Load() {
 	c.KubernetesResourceRequestCPU = c.GetOptional("EXECUTOR_KUBERNETES_RESOURCE_REQUEST_CPU", "The minimum CPU resource for Kubernetes Jobs.")
 	c.KubernetesResourceRequestMemory = c.Get("EXECUTOR_KUBERNETES_RESOURCE_REQUEST_MEMORY", "12Gi", "The minimum memory resource for Kubernetes Jobs.")
 	c.DockerAddHostGateway = c.GetBool("EXECUTOR_DOCKER_ADD_HOST_GATEWAY", "false", "If true, host.docker.internal will be exposed to the docker commands run by the runtime. Warn: Can be insecure. Only use this if you understand what you're doing. This is mostly used for running against a Sourcegraph on the same host.")
+	c.PodmanOCIRuntime = c.GetOptional("EXECUTOR_PODMAN_OCI_RUNTIME", "The OCI runtime used by Podman to run containers, such as crun or runc. Defaults to the runtime configured for Podman on the host.")
+	c.PodmanNetwork = c.Get("EXECUTOR_PODMAN_NETWORK", "slirp4netns:allow_host_loopback=false", "The network mode of the containers run with Podman. The default allows outbound traffic but no access to services listening on the host. Set to 'none' to disable networking.")
+	c.PodmanPidsLimit = c.GetInt("EXECUTOR_PODMAN_PIDS_LIMIT", "4096", "The maximum number of processes that can run in a container run with Podman. A value of zero sets no limit.")
 	c.dockerAuthConfigStr = c.GetOptional("EXECUTOR_DOCKER_AUTH_CONFIG", "The content of the docker config file including auth for services. If using firecracker, only static credentials are supported, not credential stores nor credential helpers.")
 	c.KubernetesJobDeadline = c.GetInt("KUBERNETES_JOB_DEADLINE", "1200", "The number of seconds after which a Kubernetes job will be terminated.")
 	c.KubernetesKeepJobs = c.GetBool("KUBERNETES_KEEP_JOBS", "false", "If true, Kubernetes jobs will not be deleted after they complete. Useful for debugging.")
@@ -310,6 +318,27 @@ func (c *Config) Validate() error {
 		c.AddError(errors.Wrap(c.kubernetesNodeTolerationsUnmarshalError, "invalid EXECUTOR_KUBERNETES_NODE_TOLERATIONS, failed to parse"))
 	}
 
+	switch c.Runtime {
+	case "":
+	case RuntimeFirecracker:
+		if !c.UseFirecracker {
+			c.AddError(errors.New("EXECUTOR_USE_FIRECRACKER cannot be disabled when EXECUTOR_RUNTIME is 'firecracker'"))
+		}
+	case RuntimeDocker, RuntimePodman:
+		if c.UseFirecracker {
+			c.AddError(errors.Newf("EXECUTOR_USE_FIRECRACKER cannot be enabled when EXECUTOR_RUNTIME is '%s'", c.Runtime))
+		}
+		if IsKubernetes() {
+			c.AddError(errors.Newf("EXECUTOR_RUNTIME '%s' is not supported in Kubernetes", c.Runtime))
+		}
+	default:
+		c.AddError(errors.Newf("EXECUTOR_RUNTIME must be set to one of '%s, %s, %s'", RuntimeDocker, RuntimeFirecracker, RuntimePodman))
+	}
+
+	if c.PodmanPidsLimit < 0 {
+		c.AddError(errors.New("EXECUTOR_PODMAN_PIDS_LIMIT must not be negative"))
+	}
+
 	if c.UseFirecracker {
 		// Validate that firecracker can work on this host.
 		if runtime.GOOS != "linux" {
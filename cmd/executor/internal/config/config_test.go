@@ -74,6 +74,8 @@ func TestConfig_Load(t *testing.T) {
 			return `{"foo": "bar", "faz": "baz"}`
 		case "KUBERNETES_IMAGE_PULL_SECRETS":
 			return "foo,bar"
+		case "EXECUTOR_PODMAN_PIDS_LIMIT":
+			return "100"
 		default:
 			return name
 		}
@@ -184,6 +186,11 @@ func TestConfig_Load(t *testing.T) {
 	assert.Equal(t, "baz", cfg.KubernetesJobPodAnnotations["faz"])
 
 	assert.Equal(t, "foo,bar", cfg.KubernetesImagePullSecrets)
+
+	assert.Equal(t, "EXECUTOR_RUNTIME", cfg.Runtime)
+	assert.Equal(t, "EXECUTOR_PODMAN_OCI_RUNTIME", cfg.PodmanOCIRuntime)
+	assert.Equal(t, "EXECUTOR_PODMAN_NETWORK", cfg.PodmanNetwork)
+	assert.Equal(t, 100, cfg.PodmanPidsLimit)
 }
 
 func TestConfig_Load_Defaults(t *testing.T) {
@@ -239,6 +246,10 @@ func TestConfig_Load_Defaults(t *testing.T) {
 	assert.Nil(t, cfg.KubernetesJobAnnotations)
 	assert.Nil(t, cfg.KubernetesJobPodAnnotations)
 	assert.Empty(t, cfg.KubernetesImagePullSecrets)
+	assert.Empty(t, cfg.Runtime)
+	assert.Empty(t, cfg.PodmanOCIRuntime)
+	assert.Equal(t, "slirp4netns:allow_host_loopback=false", cfg.PodmanNetwork)
+	assert.Equal(t, 4096, cfg.PodmanPidsLimit)
 }
 
 func TestConfig_Validate(t *testing.T) {
@@ -346,7 +357,64 @@ func TestConfig_Validate(t *testing.T) {
 					return defaultValue
 				}
 			},
-			expectedErr: errors.New("EXECUTOR_QUEUE_NAMES contains invalid queue name 'batches;codeintel', valid names are 'batches, codeintel' and should be comma-separated"),
+			expectedErr: errors.New("EXECUTOR_QUEUE_NAMES contains invalid queue name 'batches;codeintel', valid names are 'batches, codeintel, custom' and should be comma-separated"),
+		},
+		{
+			name: "Podman runtime",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_RUNTIME":
+					return "podman"
+				default:
+					return defaultValue
+				}
+			},
+		},
+		{
+			name: "Podman runtime with firecracker",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_RUNTIME":
+					return "podman"
+				case "EXECUTOR_USE_FIRECRACKER":
+					return "true"
+				default:
+					return defaultValue
+				}
+			},
+			expectedErr: errors.New("EXECUTOR_USE_FIRECRACKER cannot be enabled when EXECUTOR_RUNTIME is 'podman'"),
+		},
+		{
+			name: "Invalid runtime",
+			getterFunc: func(name string, defaultValue, description string) string {
+				switch name {
+				case "EXECUTOR_QUEUE_NAME":
+					return "batches"
+				case "EXECUTOR_FRONTEND_URL":
+					return "http://some-url.com"
+				case "EXECUTOR_FRONTEND_PASSWORD":
+					return "some-password"
+				case "EXECUTOR_RUNTIME":
+					return "lxc"
+				case "EXECUTOR_USE_FIRECRACKER":
+					return "false"
+				default:
+					return defaultValue
+				}
+			},
+			expectedErr: errors.New("EXECUTOR_RUNTIME must be set to one of 'docker, firecracker, podman'"),
 		},
 	}
 	for _, test := range tests {
@@ -36,6 +36,13 @@ const (
 	FirecrackerKernelArgs = "console=ttyS0 reboot=k panic=1 pci=off ip=dhcp random.trust_cpu=on i8042.noaux i8042.nomux i8042.nopnp i8042.dumbkbd"
 )
 
+// The values accepted by EXECUTOR_RUNTIME.
+const (
+	RuntimeDocker      = "docker"
+	RuntimeFirecracker = "firecracker"
+	RuntimePodman      = "podman"
+)
+
 var (
 	// DefaultFirecrackerSandboxImage is the isolation image used to run firecracker
 	// from ignite.
@@ -71,6 +78,13 @@ var (
 		"git":    "Use your package manager, or build from source.",
 		"src":    "Run executor install src-cli, or refer to https://github.com/sourcegraph/src-cli to install src-cli yourself.",
 	}
+	// RequiredCLIToolsPodman contains all the programs that are expected to exist in
+	// PATH when running the executor with the podman runtime, and a help text on installation.
+	RequiredCLIToolsPodman = map[string]string{
+		"podman": "Check out https://podman.io/docs/installation on how to install.",
+		"git":    RequiredCLITools["git"],
+		"src":    RequiredCLITools["src"],
+	}
 	// RequiredCLIToolsFirecracker contains all the programs that are expected to
 	// exist in PATH when running the executor with firecracker enabled.
 	RequiredCLIToolsFirecracker = []string{"dmsetup", "losetup", "mkfs.ext4", "strings"}
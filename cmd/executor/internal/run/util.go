@@ -25,7 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 )
 
-func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFirecracker bool, logger log.Logger) queue.TelemetryOptions {
+func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFirecracker, usePodman bool, logger log.Logger) queue.TelemetryOptions {
 	t := queue.TelemetryOptions{
 		OS:              runtime.GOOS,
 		Architecture:    runtime.GOARCH,
@@ -45,9 +45,12 @@ func newQueueTelemetryOptions(ctx context.Context, runner util.CmdRunner, useFir
 			logger.Error("Failed to get src-cli version", log.Error(err))
 		}
 
-		t.DockerVersion, err = util.GetDockerVersion(ctx, runner)
-		if err != nil {
-			logger.Error("Failed to get docker version", log.Error(err))
+		// Podman is not a Docker server, so there is no Docker version to report.
+		if !usePodman {
+			t.DockerVersion, err = util.GetDockerVersion(ctx, runner)
+			if err != nil {
+				logger.Error("Failed to get docker version", log.Error(err))
+			}
 		}
 	}
 
@@ -71,6 +74,7 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 			DockerOptions:      dockerOptions(c),
 			FirecrackerOptions: firecrackerOptions(c),
 			KubernetesOptions:  kubernetesOptions(c),
+			PodmanOptions:      podmanOptions(c),
 		},
 		GitServicePath: "/.executors/git",
 		QueueOptions:   queueOptions(c, queueTelemetryOptions),
@@ -109,6 +113,20 @@ func dockerOptions(c *config.Config) command.DockerOptions {
 	}
 }
 
+func podmanOptions(c *config.Config) runner.PodmanOptions {
+	return runner.PodmanOptions{
+		Enabled: c.Runtime == config.RuntimePodman,
+		ContainerOptions: command.PodmanOptions{
+			DockerAuthConfig: c.DockerAuthConfig,
+			OCIRuntime:       c.PodmanOCIRuntime,
+			Network:          c.PodmanNetwork,
+			PidsLimit:        c.PodmanPidsLimit,
+			AddHostGateway:   c.DockerAddHostGateway,
+			Resources:        resourceOptions(c),
+		},
+	}
+}
+
 func firecrackerOptions(c *config.Config) runner.FirecrackerOptions {
 	var dockerMirrors []string
 	if len(c.DockerRegistryMirrorURL) > 0 {
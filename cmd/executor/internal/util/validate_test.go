@@ -274,6 +274,91 @@ func TestValidateDockerTools(t *testing.T) {
 	}
 }
 
+func TestValidatePodmanTools(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		mockFunc    func(runner *fakeCmdRunner)
+		expectedErr error
+	}{
+		{
+			name: "Podman is valid",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", nil)
+				runner.On("LookPath", "src").
+					Return("", nil)
+			},
+		},
+		{
+			name: "Podman missing",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", exec.ErrNotFound)
+				runner.On("LookPath", "src").
+					Return("", nil)
+			},
+			expectedErr: errors.New("podman not found in PATH, is it installed?\nCheck out https://podman.io/docs/installation on how to install."),
+		},
+		{
+			name: "Podman error",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", errors.New("failed to find podman"))
+			},
+			expectedErr: errors.New("failed to find podman"),
+		},
+		{
+			name: "Docker is not required",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "docker").
+					Return("", exec.ErrNotFound)
+				runner.On("LookPath", "git").
+					Return("", nil)
+				runner.On("LookPath", "podman").
+					Return("", nil)
+				runner.On("LookPath", "src").
+					Return("", nil)
+			},
+		},
+		{
+			name: "Missing all",
+			mockFunc: func(runner *fakeCmdRunner) {
+				runner.On("LookPath", "git").
+					Return("", exec.ErrNotFound)
+				runner.On("LookPath", "podman").
+					Return("", exec.ErrNotFound)
+				runner.On("LookPath", "src").
+					Return("", exec.ErrNotFound)
+			},
+			expectedErr: errors.New("3 errors occurred:\n\t* git not found in PATH, is it installed?\nUse your package manager, or build from source.\n\t* podman not found in PATH, is it installed?\nCheck out https://podman.io/docs/installation on how to install.\n\t* src not found in PATH, is it installed?\nRun executor install src-cli, or refer to https://github.com/sourcegraph/src-cli to install src-cli yourself."),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner := new(fakeCmdRunner)
+			if test.mockFunc != nil {
+				test.mockFunc(runner)
+			}
+
+			err := util.ValidatePodmanTools(runner)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateFirecrackerTools(t *testing.T) {
 	t.Parallel()
 
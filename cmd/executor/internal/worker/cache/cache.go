@@ -0,0 +1,308 @@
+package cache
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Bucket returns the bucket in the files store that holds the caches of the given queue.
+func Bucket(queue string) string {
+	return "cache/" + queue
+}
+
+// ObjectKey returns the key in the files store of the cache with the given key. Cache keys
+// are chosen by the job and may contain arbitrary characters, so they are hashed to produce
+// a key that is safe to use in a URL path.
+func ObjectKey(cacheKey string) string {
+	sum := sha256.Sum256([]byte(cacheKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// Restore downloads the caches declared by the job and extracts them into the cache
+// directory. Caches are an optimization, so failing to restore a cache never fails the
+// job. Any errors are written to the job's execution log instead.
+func Restore(ctx context.Context, logger cmdlogger.Logger, store files.Store, job types.Job, cacheDirectory string) {
+	if len(job.Caches) == 0 {
+		return
+	}
+
+	handle := logger.LogEntry("setup.cache.restore", nil)
+	defer func() {
+		handle.Finalize(0)
+		_ = handle.Close()
+	}()
+
+	for _, c := range job.Caches {
+		start := time.Now()
+		restored, err := restore(ctx, store, job, c, cacheDirectory)
+		if err != nil {
+			fmt.Fprintf(handle, "Failed to restore cache %q: %s\n", c.Key, err)
+		} else if !restored {
+			fmt.Fprintf(handle, "Cache %q not found\n", c.Key)
+		} else {
+			fmt.Fprintf(handle, "Restored cache %q in %s\n", c.Key, time.Since(start))
+		}
+	}
+}
+
+func restore(ctx context.Context, store files.Store, job types.Job, c types.JobCache, cacheDirectory string) (bool, error) {
+	bucket, key := Bucket(job.Queue), ObjectKey(c.Key)
+
+	exists, err := store.Exists(ctx, job, bucket, key)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	content, err := store.Get(ctx, job, bucket, key)
+	if err != nil {
+		return false, err
+	}
+	defer content.Close()
+
+	if err := extract(content, cacheDirectory, c.Paths); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Save archives the paths of each cache declared by the job and uploads them to the files
+// store. Like Restore, failures are written to the job's execution log and never fail the
+// job.
+func Save(ctx context.Context, logger cmdlogger.Logger, store files.Store, job types.Job, cacheDirectory string) {
+	if len(job.Caches) == 0 {
+		return
+	}
+
+	handle := logger.LogEntry("teardown.cache.save", nil)
+	defer func() {
+		handle.Finalize(0)
+		_ = handle.Close()
+	}()
+
+	for _, c := range job.Caches {
+		start := time.Now()
+		if err := save(ctx, store, job, c, cacheDirectory); err != nil {
+			fmt.Fprintf(handle, "Failed to save cache %q: %s\n", c.Key, err)
+		} else {
+			fmt.Fprintf(handle, "Saved cache %q in %s\n", c.Key, time.Since(start))
+		}
+	}
+}
+
+func save(ctx context.Context, store files.Store, job types.Job, c types.JobCache, cacheDirectory string) error {
+	for _, p := range c.Paths {
+		if _, err := resolvePath(cacheDirectory, p); err != nil {
+			return err
+		}
+	}
+
+	// Stream the archive to the files store rather than buffering it on disk or in memory.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(archive(pw, cacheDirectory, c.Paths))
+	}()
+
+	err := store.Upload(ctx, job, Bucket(job.Queue), ObjectKey(c.Key), pr)
+	// Unblock the archiving goroutine if the upload stopped reading early.
+	_ = pr.CloseWithError(err)
+	return err
+}
+
+// archive writes a gzipped tarball of the given paths, relative to the cache directory,
+// to w. Paths that do not exist are skipped. Only regular files and directories are
+// archived; symlinks and other special files are ignored.
+func archive(w io.Writer, cacheDirectory string, paths []string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	for _, p := range paths {
+		root, err := resolvePath(cacheDirectory, p)
+		if err != nil {
+			return err
+		}
+		// 🚨 SECURITY: Do not follow symlinks out of the cache directory, which could
+		// otherwise be used to archive files on the executor host.
+		if containsSymlink(cacheDirectory, root) {
+			continue
+		}
+
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if !d.Type().IsRegular() && !d.IsDir() {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			header, err := tar.FileInfoHeader(info, "")
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(cacheDirectory, path)
+			if err != nil {
+				return err
+			}
+			header.Name = filepath.ToSlash(rel)
+			if d.IsDir() {
+				header.Name += "/"
+			}
+			if err := tw.WriteHeader(header); err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = io.Copy(tw, f)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// extract unpacks the gzipped tarball read from r into the cache directory. Only entries
+// that fall within one of the given paths are written.
+func extract(r io.Reader, cacheDirectory string, paths []string) error {
+	roots := make([]string, 0, len(paths))
+	for _, p := range paths {
+		root, err := resolvePath(cacheDirectory, p)
+		if err != nil {
+			return err
+		}
+		roots = append(roots, root)
+	}
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		// 🚨 SECURITY: The archive is produced by another job that shares this cache key, so
+		// we must not trust it to stay within the declared paths of the cache directory.
+		target, err := resolvePath(cacheDirectory, header.Name)
+		if err != nil || !withinAny(target, roots) || containsSymlink(cacheDirectory, target) {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeFile(target, tr, header.FileInfo().Mode().Perm(), header.ModTime); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeFile(path string, r io.Reader, perm os.FileMode, modTime time.Time) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		return errors.Append(err, f.Close())
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Chtimes(path, modTime, modTime)
+}
+
+// resolvePath returns the absolute path of the given path relative to the cache
+// directory, or an error if it would escape the cache directory. The path "." refers to
+// the cache directory itself.
+func resolvePath(cacheDirectory, path string) (string, error) {
+	resolved := filepath.Join(cacheDirectory, filepath.FromSlash(path))
+	if !withinAny(resolved, []string{cacheDirectory}) {
+		return "", errors.Newf("cache path %q must be within the cache directory", path)
+	}
+	return resolved, nil
+}
+
+// containsSymlink returns true if the target path or any of its parents below the cache
+// directory is a symlink. Writing through a symlink could modify files outside of the
+// cache directory, e.g. if a step of the job created one in the mounted cache.
+func containsSymlink(cacheDirectory, target string) bool {
+	rel, err := filepath.Rel(cacheDirectory, target)
+	if err != nil {
+		return true
+	}
+
+	path := cacheDirectory
+	for _, segment := range strings.Split(rel, string(filepath.Separator)) {
+		path = filepath.Join(path, segment)
+		info, err := os.Lstat(path)
+		if err != nil {
+			// Nothing exists at this path yet, so neither can anything below it.
+			return !errors.Is(err, fs.ErrNotExist)
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func withinAny(path string, roots []string) bool {
+	for _, root := range roots {
+		root = filepath.Clean(root)
+		if path == root || strings.HasPrefix(path, root+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
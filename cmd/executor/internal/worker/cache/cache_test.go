@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestSaveAndRestore(t *testing.T) {
+	job := types.Job{
+		ID:    42,
+		Queue: "codeintel",
+		Caches: []types.JobCache{
+			{Key: "deps", Paths: []string{".cache", "missing"}},
+		},
+	}
+
+	source := t.TempDir()
+	writeTestFile(t, filepath.Join(source, ".cache", "go-build", "a"), "a")
+	writeTestFile(t, filepath.Join(source, ".cache", "b"), "b")
+	writeTestFile(t, filepath.Join(source, "not-cached"), "c")
+	require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(source, ".cache", "link")))
+
+	var uploaded bytes.Buffer
+	store := NewMockStore()
+	store.UploadFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string, content io.Reader) error {
+		assert.Equal(t, "cache/codeintel", bucket)
+		assert.Equal(t, ObjectKey("deps"), key)
+		_, err := io.Copy(&uploaded, content)
+		return err
+	})
+
+	logger := NewMockLogger()
+	logEntry := NewMockLogEntry()
+	logger.LogEntryFunc.SetDefaultReturn(logEntry)
+
+	Save(context.Background(), logger, store, job, source)
+
+	require.Len(t, logger.LogEntryFunc.History(), 1)
+	assert.Equal(t, "teardown.cache.save", logger.LogEntryFunc.History()[0].Arg0)
+	require.Len(t, logEntry.WriteFunc.History(), 1)
+	assert.Contains(t, string(logEntry.WriteFunc.History()[0].Arg0), `Saved cache "deps"`)
+
+	store.ExistsFunc.SetDefaultReturn(true, nil)
+	store.GetFunc.SetDefaultReturn(io.NopCloser(bytes.NewReader(uploaded.Bytes())), nil)
+
+	target := t.TempDir()
+	Restore(context.Background(), logger, store, job, target)
+
+	assert.Equal(t, "a", readTestFile(t, filepath.Join(target, ".cache", "go-build", "a")))
+	assert.Equal(t, "b", readTestFile(t, filepath.Join(target, ".cache", "b")))
+	assert.NoFileExists(t, filepath.Join(target, "not-cached"))
+	assert.NoFileExists(t, filepath.Join(target, ".cache", "link"))
+}
+
+func TestRestore_NotFound(t *testing.T) {
+	job := types.Job{
+		ID:     42,
+		Queue:  "codeintel",
+		Caches: []types.JobCache{{Key: "deps", Paths: []string{".cache"}}},
+	}
+
+	store := NewMockStore()
+	logger := NewMockLogger()
+	logEntry := NewMockLogEntry()
+	logger.LogEntryFunc.SetDefaultReturn(logEntry)
+
+	Restore(context.Background(), logger, store, job, t.TempDir())
+
+	require.Len(t, logger.LogEntryFunc.History(), 1)
+	assert.Equal(t, "setup.cache.restore", logger.LogEntryFunc.History()[0].Arg0)
+	assert.Empty(t, store.GetFunc.History())
+	require.Len(t, logEntry.FinalizeFunc.History(), 1)
+	assert.Equal(t, 0, logEntry.FinalizeFunc.History()[0].Arg0)
+}
+
+func TestRestore_Error(t *testing.T) {
+	job := types.Job{
+		ID:     42,
+		Queue:  "codeintel",
+		Caches: []types.JobCache{{Key: "deps", Paths: []string{".cache"}}},
+	}
+
+	store := NewMockStore()
+	store.ExistsFunc.SetDefaultReturn(false, errors.New("boom"))
+	logger := NewMockLogger()
+	logEntry := NewMockLogEntry()
+	logger.LogEntryFunc.SetDefaultReturn(logEntry)
+
+	Restore(context.Background(), logger, store, job, t.TempDir())
+
+	require.Len(t, logEntry.WriteFunc.History(), 1)
+	assert.Equal(t, "Failed to restore cache \"deps\": boom\n", string(logEntry.WriteFunc.History()[0].Arg0))
+	// Failing to restore a cache does not fail the job.
+	require.Len(t, logEntry.FinalizeFunc.History(), 1)
+	assert.Equal(t, 0, logEntry.FinalizeFunc.History()[0].Arg0)
+}
+
+func TestSave_NoCaches(t *testing.T) {
+	store := NewMockStore()
+	logger := NewMockLogger()
+
+	Save(context.Background(), logger, store, types.Job{ID: 42}, t.TempDir())
+
+	assert.Empty(t, logger.LogEntryFunc.History())
+	assert.Empty(t, store.UploadFunc.History())
+}
+
+func TestExtract_OutsideOfPaths(t *testing.T) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range map[string]string{
+		".cache/ok":        "ok",
+		"../escaped":       "escaped",
+		"undeclared/file":  "undeclared",
+		".cache/../sneaky": "sneaky",
+	} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	parent := t.TempDir()
+	cacheDirectory := filepath.Join(parent, "cache")
+	require.NoError(t, os.Mkdir(cacheDirectory, os.ModePerm))
+
+	require.NoError(t, extract(&buf, cacheDirectory, []string{".cache"}))
+
+	assert.Equal(t, "ok", readTestFile(t, filepath.Join(cacheDirectory, ".cache", "ok")))
+	assert.NoFileExists(t, filepath.Join(parent, "escaped"))
+	assert.NoFileExists(t, filepath.Join(cacheDirectory, "undeclared", "file"))
+	assert.NoFileExists(t, filepath.Join(cacheDirectory, "sneaky"))
+}
+
+func TestExtract_ThroughSymlink(t *testing.T) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: ".cache/file", Mode: 0o644, Size: 4, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("evil"))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	outside := t.TempDir()
+	cacheDirectory := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(cacheDirectory, ".cache")))
+
+	require.NoError(t, extract(&buf, cacheDirectory, []string{".cache"}))
+
+	assert.NoFileExists(t, filepath.Join(outside, "file"))
+}
+
+func TestResolvePath(t *testing.T) {
+	for path, valid := range map[string]bool{
+		".cache":      true,
+		"a/b/c":       true,
+		"a/../b":      true,
+		".":           true,
+		"":            true,
+		"..":          false,
+		"../cache2/a": false,
+	} {
+		_, err := resolvePath("/tmp/cache", path)
+		assert.Equal(t, valid, err == nil, path)
+	}
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(content)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	internalexecutor "github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/executor/logstream"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -47,12 +48,30 @@ type ExecutionLogEntryStore interface {
 	UpdateExecutionLogEntry(ctx context.Context, job types.Job, entryID int, entry internalexecutor.ExecutionLogEntry) error
 }
 
+// ExecutionLogStreamer is implemented by an ExecutionLogEntryStore that can stream the log
+// output of a job to its viewers while the job is running.
+type ExecutionLogStreamer interface {
+	// StreamExecutionLogs opens a stream for the log events of the given job.
+	StreamExecutionLogs(ctx context.Context, job types.Job) (ExecutionLogStream, error)
+}
+
+// ExecutionLogStream sends incremental log events of a job. Streaming is best effort: the
+// log entries written to the ExecutionLogEntryStore remain the source of truth.
+type ExecutionLogStream interface {
+	// Send sends the given event. It is not called concurrently.
+	Send(event logstream.Event) error
+	// Close closes the stream once all events have been sent.
+	Close() error
+}
+
 // NewLogger creates a new logger instance with the given store, job, record,
 // and replacement map.
 // When the log messages are serialized, any occurrence of sensitive values are
 // replace with a non-sensitive value.
 // Each log message is written to the store in a goroutine. The Flush method
 // must be called to ensure all entries are written.
+// If the store implements ExecutionLogStreamer, the log output is additionally
+// streamed while it is written.
 func NewLogger(internalLogger log.Logger, store ExecutionLogEntryStore, job types.Job, replacements map[string]string) Logger {
 	oldnew := make([]string, 0, len(replacements)*2)
 	for k, v := range replacements {
@@ -69,11 +88,26 @@ func NewLogger(internalLogger log.Logger, store ExecutionLogEntryStore, job type
 		errs:           nil,
 	}
 
+	if streamer, ok := store.(ExecutionLogStreamer); ok {
+		stream, err := streamer.StreamExecutionLogs(context.Background(), job)
+		if err != nil {
+			internalLogger.Warn("Failed to open executor log stream for job", log.Int("jobID", job.ID), log.Error(err))
+		} else {
+			l.streamEvents = make(chan logstream.Event, streamEventBufSize)
+			l.streamDone = make(chan struct{})
+			go l.streamEntries(stream)
+		}
+	}
+
 	go l.writeEntries()
 
 	return l
 }
 
+// streamEventBufSize is the maximum number of log events that are not yet sent
+// to the stream. Further events are dropped until the stream catches up.
+const streamEventBufSize = 100
+
 // logEntryBufSize is the maximum number of log entries that are logged by the
 // task execution but not yet written to the database.
 const logEntryBufSize = 50
@@ -110,6 +144,8 @@ func (l *logger) writeEntries() {
 			log.String("commit", l.job.Commit),
 		)
 
+		l.sendStreamEvent(logstream.NewEntryEvent(entryID, initialLogEntry))
+
 		wg.Add(1)
 		go func(handle *entryHandle, entryID int, initialLogEntry internalexecutor.ExecutionLogEntry) {
 			defer wg.Done()
@@ -119,6 +155,69 @@ func (l *logger) writeEntries() {
 	}
 
 	wg.Wait()
+
+	if l.streamEvents != nil {
+		close(l.streamEvents)
+		<-l.streamDone
+	}
+}
+
+// streamEntries sends the queued log events to the given stream until the
+// logger is flushed. After the first failure, the remaining events are
+// discarded, as the persisted log entries remain available to viewers.
+func (l *logger) streamEntries(stream ExecutionLogStream) {
+	defer close(l.streamDone)
+
+	failed := false
+	for event := range l.streamEvents {
+		if failed {
+			continue
+		}
+		if err := stream.Send(event); err != nil {
+			l.internalLogger.Warn("Failed to stream executor log entry for job", log.Int("jobID", l.job.ID), log.Error(err))
+			failed = true
+		}
+	}
+
+	if err := stream.Close(); err != nil && !failed {
+		l.internalLogger.Warn("Failed to close executor log stream for job", log.Int("jobID", l.job.ID), log.Error(err))
+	}
+}
+
+// sendStreamEvent queues the given event to be streamed. It returns false if
+// streaming is disabled or the event was dropped.
+func (l *logger) sendStreamEvent(event logstream.Event) bool {
+	if l.streamEvents == nil {
+		return false
+	}
+
+	select {
+	case l.streamEvents <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+// streamLogEntry streams the output of current that was not yet streamed and
+// returns the entry state that viewers have received.
+func (l *logger) streamLogEntry(entryID int, streamed, current internalexecutor.ExecutionLogEntry) internalexecutor.ExecutionLogEntry {
+	if !entryWasUpdated(streamed, current) {
+		return streamed
+	}
+
+	event := logstream.NewEntryEvent(entryID, current)
+	// Redaction can change output that was already streamed, in which case the
+	// complete output is sent again.
+	if strings.HasPrefix(current.Out, streamed.Out) {
+		event.Offset = len(streamed.Out)
+		event.Out = current.Out[len(streamed.Out):]
+	}
+
+	if !l.sendStreamEvent(event) {
+		return streamed
+	}
+	return current
 }
 
 func (l *logger) appendError(err error) {
@@ -130,14 +229,30 @@ func (l *logger) appendError(err error) {
 func (l *logger) syncLogEntry(handle *entryHandle, entryID int, old internalexecutor.ExecutionLogEntry) {
 	lastWrite := false
 
+	// When streaming, the entry is checked more often than it is written to the
+	// store, so that viewers see the output with a lower latency.
+	interval := syncLogEntryInterval
+	if l.streamEvents != nil {
+		interval = streamLogEntryInterval
+	}
+	streamed := old
+	lastSync := time.Now()
+
 	for !lastWrite {
 		select {
 		case <-handle.done:
 			lastWrite = true
-		case <-time.After(syncLogEntryInterval):
+		case <-time.After(interval):
 		}
 
 		current := handle.CurrentLogEntry()
+		streamed = l.streamLogEntry(entryID, streamed, current)
+
+		if !lastWrite && time.Since(lastSync) < syncLogEntryInterval {
+			continue
+		}
+		lastSync = time.Now()
+
 		if !entryWasUpdated(old, current) {
 			continue
 		}
@@ -180,6 +295,8 @@ func (l *logger) syncLogEntry(handle *entryHandle, entryID int, old internalexec
 
 const syncLogEntryInterval = 1 * time.Second
 
+const streamLogEntryInterval = 100 * time.Millisecond
+
 // If old didn't have exit code or duration and current does, update; we're finished.
 // Otherwise, update if the log text has changed since the last write to the API.
 func entryWasUpdated(old, current internalexecutor.ExecutionLogEntry) bool {
@@ -196,6 +313,10 @@ type logger struct {
 
 	replacer *strings.Replacer
 
+	// streamEvents is nil if log output is not streamed.
+	streamEvents chan logstream.Event
+	streamDone   chan struct{}
+
 	errs   error
 	errsMu sync.Mutex
 }
@@ -3,10 +3,13 @@ package cmdlogger
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log/logtest"
 
 	internalexecutor "github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/executor/logstream"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -101,3 +104,127 @@ func TestLogger_Failure(t *testing.T) {
 		t.Fatalf("incorrect invokation count on UpdateExecutionLogEntry, want=%d have=%d", 1, len(s.UpdateExecutionLogEntryFunc.History()))
 	}
 }
+
+type streamingStore struct {
+	*MockExecutionLogEntryStore
+	stream *recordingStream
+}
+
+func (s streamingStore) StreamExecutionLogs(_ context.Context, _ types.Job) (ExecutionLogStream, error) {
+	return s.stream, nil
+}
+
+type recordingStream struct {
+	sendErr error
+	events  []logstream.Event
+	closed  bool
+}
+
+func (s *recordingStream) Send(event logstream.Event) error {
+	s.events = append(s.events, event)
+	return s.sendErr
+}
+
+func (s *recordingStream) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestLogger_Streaming(t *testing.T) {
+	s := NewMockExecutionLogEntryStore()
+	doneAdding := make(chan struct{})
+	s.AddExecutionLogEntryFunc.SetDefaultHook(func(_ context.Context, _ types.Job, _ internalexecutor.ExecutionLogEntry) (int, error) {
+		close(doneAdding)
+		return 1, nil
+	})
+	stream := &recordingStream{}
+
+	job := types.Job{}
+	internalLogger := logtest.Scoped(t)
+	l := NewLogger(internalLogger, streamingStore{MockExecutionLogEntryStore: s, stream: stream}, job, map[string]string{"secret": "******"})
+
+	e := l.LogEntry("the_key", []string{"cmd", "arg1"})
+
+	// Wait for AddExecutionLogEntry to have been called.
+	<-doneAdding
+	if _, err := e.Write([]byte("stdout: hello\n")); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * streamLogEntryInterval)
+	if _, err := e.Write([]byte("stdout: secret\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	e.Finalize(0)
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !stream.closed {
+		t.Fatal("stream was not closed")
+	}
+	if len(stream.events) < 2 {
+		t.Fatalf("expected at least two streamed events, have=%d", len(stream.events))
+	}
+
+	var have internalexecutor.ExecutionLogEntry
+	for _, event := range stream.events {
+		if event.EntryID != 1 {
+			t.Fatalf("unexpected entry ID, want=%d have=%d", 1, event.EntryID)
+		}
+		if !event.Apply(&have) {
+			t.Fatalf("streamed event could not be applied: %+v", event)
+		}
+	}
+
+	history := s.UpdateExecutionLogEntryFunc.History()
+	want := history[len(history)-1].Arg3
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("unexpected streamed entry (-want +got):\n%s", diff)
+	}
+	if want.Out != "stdout: hello\nstdout: ******\n" {
+		t.Fatalf("unexpected output: %q", want.Out)
+	}
+}
+
+func TestLogger_StreamingFailure(t *testing.T) {
+	s := NewMockExecutionLogEntryStore()
+	doneAdding := make(chan struct{})
+	s.AddExecutionLogEntryFunc.SetDefaultHook(func(_ context.Context, _ types.Job, _ internalexecutor.ExecutionLogEntry) (int, error) {
+		close(doneAdding)
+		return 1, nil
+	})
+	stream := &recordingStream{sendErr: errors.New("failure!!")}
+
+	job := types.Job{}
+	internalLogger := logtest.Scoped(t)
+	l := NewLogger(internalLogger, streamingStore{MockExecutionLogEntryStore: s, stream: stream}, job, map[string]string{})
+
+	e := l.LogEntry("the_key", []string{"cmd", "arg1"})
+
+	// Wait for AddExecutionLogEntry to have been called.
+	<-doneAdding
+	if _, err := e.Write([]byte("log entry")); err != nil {
+		t.Fatal(err)
+	}
+
+	e.Finalize(0)
+	if err := e.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Streaming errors must not fail the job.
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stream.events) != 1 {
+		t.Fatalf("expected streaming to stop after the first failure, have=%d events", len(stream.events))
+	}
+	if len(s.UpdateExecutionLogEntryFunc.History()) != 1 {
+		t.Fatalf("incorrect invokation count on UpdateExecutionLogEntry, want=%d have=%d", 1, len(s.UpdateExecutionLogEntryFunc.History()))
+	}
+}
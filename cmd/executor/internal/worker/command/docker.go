@@ -14,7 +14,20 @@ type DockerOptions struct {
 	DockerAuthConfig types.DockerAuthConfig
 	ConfigPath       string
 	AddHostGateway   bool
-	Resources        ResourceOptions
+	// MountCache mounts the cache directory of the job at CacheMountPath.
+	MountCache bool
+	Resources  ResourceOptions
+}
+
+// CacheMountPath is the path at which containers mount the cache directory of their job.
+// It lives outside of /data so that caches never become part of the repository checkout.
+const CacheMountPath = "/cache"
+
+// CacheDirectory returns the directory on the executor host that holds the caches of the
+// job whose workspace is the given working directory. It is a sibling of the workspace, so
+// it maps onto the host the same way as the workspace when DockerHostMountPath is set.
+func CacheDirectory(workingDir string) string {
+	return workingDir + "-cache"
 }
 
 // ResourceOptions are the resource limits that can be applied to a container or VM.
@@ -80,8 +93,9 @@ func formatDockerCommand(hostDir string, image string, scriptPath string, spec S
 		"--rm",
 		dockerHostGatewayFlag(options.AddHostGateway),
 		dockerResourceFlags(options.Resources),
-		dockerVolumeFlags(hostDir),
+		dockerVolumeFlags(hostDir, options.MountCache),
 		dockerWorkingDirectoryFlags(spec.Dir),
+		dockerCacheEnvFlags(options.MountCache),
 		dockerEnvFlags(spec.Env),
 		dockerEntrypointFlags,
 		image,
@@ -115,10 +129,23 @@ func dockerResourceFlags(options ResourceOptions) []string {
 	return flags
 }
 
-func dockerVolumeFlags(wd string) []string {
+func dockerVolumeFlags(wd string, mountCache bool) []string {
+	if mountCache {
+		return []string{"-v", wd + ":/data", "-v", CacheDirectory(wd) + ":" + CacheMountPath}
+	}
 	return []string{"-v", wd + ":/data"}
 }
 
+// dockerCacheEnvFlags points tools that follow the XDG base directory specification at
+// the mounted cache directory. They come before the environment of the step, so that
+// steps can still override them.
+func dockerCacheEnvFlags(mountCache bool) []string {
+	if !mountCache {
+		return nil
+	}
+	return []string{"-e", "XDG_CACHE_HOME=" + CacheMountPath}
+}
+
 func dockerConfigFlag(dockerConfigPath string) []string {
 	if dockerConfigPath == "" {
 		return nil
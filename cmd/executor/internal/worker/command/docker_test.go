@@ -83,6 +83,46 @@ func TestNewDockerSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "Mount Cache",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+				Env:     []string{"FOO=BAR"},
+			},
+			options: command.DockerOptions{
+				MountCache: true,
+				Resources: command.ResourceOptions{
+					DockerHostMountPath: "/docker/host/mount/path",
+				},
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"docker",
+					"run",
+					"--rm",
+					"-v",
+					"/docker/host/mount/path/workingDirectory:/data",
+					"-v",
+					"/docker/host/mount/path/workingDirectory-cache:/cache",
+					"-w",
+					"/data/some/dir",
+					"-e",
+					"XDG_CACHE_HOME=/cache",
+					"-e",
+					"FOO=BAR",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
 		{
 			name:       "Config Path",
 			workingDir: "/workingDirectory",
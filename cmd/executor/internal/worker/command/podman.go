@@ -0,0 +1,126 @@
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+// PodmanOptions are the options that are specific to running a container with Podman.
+type PodmanOptions struct {
+	DockerAuthConfig types.DockerAuthConfig
+	// AuthFilePath is the path to the registry credentials file passed to Podman.
+	AuthFilePath string
+	// OCIRuntime is the OCI runtime Podman uses to run containers, such as crun or
+	// runc. If empty, the Podman default is used.
+	OCIRuntime string
+	// Network is the network mode of the container, such as slirp4netns or none.
+	Network string
+	// PidsLimit is the maximum number of processes in the container. A value of zero
+	// sets no limit.
+	PidsLimit      int
+	AddHostGateway bool
+	// MountCache mounts the cache directory of the job at CacheMountPath.
+	MountCache bool
+	Resources  ResourceOptions
+}
+
+// NewPodmanSpec constructs the command to run on the host in order to invoke the given
+// spec. If the spec does not specify an image, then the command will be run _directly_
+// on the host. Otherwise, the command will be run inside a one-shot rootless Podman
+// container subject to the resource limits and network mode specified in the given
+// options.
+func NewPodmanSpec(workingDir string, image string, scriptPath string, spec Spec, options PodmanOptions) Spec {
+	// TODO - remove this once src-cli is not required anymore for SSBC.
+	if image == "" {
+		env := spec.Env
+		if options.AuthFilePath != "" {
+			env = append(env, fmt.Sprintf("REGISTRY_AUTH_FILE=%s", options.AuthFilePath))
+		}
+		return Spec{
+			Key:       spec.Key,
+			Command:   spec.Command,
+			Dir:       filepath.Join(workingDir, spec.Dir),
+			Env:       env,
+			Operation: spec.Operation,
+		}
+	}
+
+	hostDir := workingDir
+	if options.Resources.DockerHostMountPath != "" {
+		hostDir = filepath.Join(options.Resources.DockerHostMountPath, filepath.Base(workingDir))
+	}
+
+	return Spec{
+		Key:       spec.Key,
+		Command:   formatPodmanCommand(hostDir, image, scriptPath, spec, options),
+		Operation: spec.Operation,
+	}
+}
+
+func formatPodmanCommand(hostDir string, image string, scriptPath string, spec Spec, options PodmanOptions) []string {
+	return Flatten(
+		"podman",
+		podmanRuntimeFlag(options.OCIRuntime),
+		"run",
+		"--rm",
+		podmanAuthFileFlag(options.AuthFilePath),
+		podmanNetworkFlag(options.Network),
+		dockerHostGatewayFlag(options.AddHostGateway),
+		podmanSecurityFlags,
+		dockerResourceFlags(options.Resources),
+		podmanPidsLimitFlag(options.PidsLimit),
+		podmanVolumeFlags(hostDir, options.MountCache),
+		dockerWorkingDirectoryFlags(spec.Dir),
+		dockerCacheEnvFlags(options.MountCache),
+		dockerEnvFlags(spec.Env),
+		dockerEntrypointFlags,
+		image,
+		filepath.Join("/data", files.ScriptsPath, scriptPath),
+	)
+}
+
+func podmanRuntimeFlag(ociRuntime string) []string {
+	if ociRuntime == "" {
+		return nil
+	}
+	return []string{"--runtime", ociRuntime}
+}
+
+func podmanAuthFileFlag(authFilePath string) []string {
+	if authFilePath == "" {
+		return nil
+	}
+	return []string{"--authfile", authFilePath}
+}
+
+func podmanNetworkFlag(network string) []string {
+	if network == "" {
+		return nil
+	}
+	return []string{"--network", network}
+}
+
+// podmanSecurityFlags prevent processes in the container from gaining privileges
+// beyond those of the (unprivileged) user running the executor, e.g. via setuid binaries.
+var podmanSecurityFlags = []string{"--security-opt", "no-new-privileges"}
+
+func podmanPidsLimitFlag(pidsLimit int) []string {
+	if pidsLimit == 0 {
+		return nil
+	}
+	return []string{"--pids-limit", strconv.Itoa(pidsLimit)}
+}
+
+// podmanVolumeFlags mounts the workspace, and optionally the cache directory, into the
+// container. The Z option relabels them so that they remain accessible to the container on
+// SELinux-enabled hosts.
+func podmanVolumeFlags(wd string, mountCache bool) []string {
+	if mountCache {
+		return []string{"-v", wd + ":/data:Z", "-v", CacheDirectory(wd) + ":" + CacheMountPath + ":Z"}
+	}
+	return []string{"-v", wd + ":/data:Z"}
+}
@@ -0,0 +1,293 @@
+package command_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+)
+
+func TestNewPodmanSpec(t *testing.T) {
+	tests := []struct {
+		name         string
+		workingDir   string
+		image        string
+		scriptPath   string
+		spec         command.Spec
+		options      command.PodmanOptions
+		expectedSpec command.Spec
+	}{
+		{
+			name:       "Converts to podman spec",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "script/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+				Env:     []string{"FOO=BAR"},
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--security-opt",
+					"no-new-privileges",
+					"-v",
+					"/workingDirectory:/data:Z",
+					"-w",
+					"/data/some/dir",
+					"-e",
+					"FOO=BAR",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/script/path",
+				},
+			},
+		},
+		{
+			name:       "OCI runtime",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+			},
+			options: command.PodmanOptions{
+				OCIRuntime: "crun",
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"--runtime",
+					"crun",
+					"run",
+					"--rm",
+					"--security-opt",
+					"no-new-privileges",
+					"-v",
+					"/workingDirectory:/data:Z",
+					"-w",
+					"/data/some/dir",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
+		{
+			name:       "Auth file",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+			},
+			options: command.PodmanOptions{
+				AuthFilePath: "/podman/auth.json",
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--authfile",
+					"/podman/auth.json",
+					"--security-opt",
+					"no-new-privileges",
+					"-v",
+					"/workingDirectory:/data:Z",
+					"-w",
+					"/data/some/dir",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
+		{
+			name:       "Network isolation",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+			},
+			options: command.PodmanOptions{
+				Network:        "none",
+				AddHostGateway: true,
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--network",
+					"none",
+					"--add-host=host.docker.internal:host-gateway",
+					"--security-opt",
+					"no-new-privileges",
+					"-v",
+					"/workingDirectory:/data:Z",
+					"-w",
+					"/data/some/dir",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
+		{
+			name:       "Resource limits",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+				Dir:     "/some/dir",
+			},
+			options: command.PodmanOptions{
+				PidsLimit: 512,
+				Resources: command.ResourceOptions{
+					NumCPUs: 10,
+					Memory:  "10G",
+				},
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--security-opt",
+					"no-new-privileges",
+					"--cpus",
+					"10",
+					"--memory",
+					"10G",
+					"--pids-limit",
+					"512",
+					"-v",
+					"/workingDirectory:/data:Z",
+					"-w",
+					"/data/some/dir",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
+		{
+			name:       "Docker Host Mount Path",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+			},
+			options: command.PodmanOptions{
+				Resources: command.ResourceOptions{
+					DockerHostMountPath: "/host/mount/path",
+				},
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--security-opt",
+					"no-new-privileges",
+					"-v",
+					"/host/mount/path/workingDirectory:/data:Z",
+					"-w",
+					"/data",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
+		{
+			name:       "Mount Cache",
+			workingDir: "/workingDirectory",
+			image:      "some-image",
+			scriptPath: "some/path",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"some", "command"},
+			},
+			options: command.PodmanOptions{
+				MountCache: true,
+			},
+			expectedSpec: command.Spec{
+				Key: "some-key",
+				Command: []string{
+					"podman",
+					"run",
+					"--rm",
+					"--security-opt",
+					"no-new-privileges",
+					"-v",
+					"/workingDirectory:/data:Z",
+					"-v",
+					"/workingDirectory-cache:/cache:Z",
+					"-w",
+					"/data",
+					"-e",
+					"XDG_CACHE_HOME=/cache",
+					"--entrypoint",
+					"/bin/sh",
+					"some-image",
+					"/data/.sourcegraph-executor/some/path",
+				},
+			},
+		},
+		{
+			name:       "src-cli Spec",
+			workingDir: "/workingDirectory",
+			spec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"src", "exec", "-f", "batch.yml"},
+				Dir:     "/some/dir",
+				Env:     []string{"FOO=BAR"},
+			},
+			options: command.PodmanOptions{
+				AuthFilePath: "/podman/auth.json",
+			},
+			expectedSpec: command.Spec{
+				Key:     "some-key",
+				Command: []string{"src", "exec", "-f", "batch.yml"},
+				Dir:     "/workingDirectory/some/dir",
+				Env:     []string{"FOO=BAR", "REGISTRY_AUTH_FILE=/podman/auth.json"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actualSpec := command.NewPodmanSpec(test.workingDir, test.image, test.scriptPath, test.spec, test.options)
+			assert.Equal(t, test.expectedSpec, actualSpec)
+		})
+	}
+}
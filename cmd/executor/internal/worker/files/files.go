@@ -22,6 +22,8 @@ type Store interface {
 	Exists(ctx context.Context, job types.Job, bucket string, key string) (bool, error)
 	// Get retrieves the file.
 	Get(ctx context.Context, job types.Job, bucket string, key string) (io.ReadCloser, error)
+	// Upload writes the content to the file.
+	Upload(ctx context.Context, job types.Job, bucket string, key string, content io.Reader) error
 }
 
 // GetWorkspaceFiles returns the files that should be accessible to jobs within the workspace.
@@ -3,6 +3,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +13,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/ignite"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/janitor"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/util"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cache"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
@@ -146,12 +148,33 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 
 	// Get the commands we will execute.
 	logger.Info("Creating commands")
-	job.Queue = h.options.QueueName
+	// Jobs dequeued by executors listening to multiple queues already carry the name
+	// of their queue.
+	if job.Queue == "" {
+		job.Queue = h.options.QueueName
+	}
 	commands, err := h.jobRuntime.NewRunnerSpecs(ws, job)
 	if err != nil {
 		return errors.Wrap(err, "creating commands")
 	}
 
+	// Restore the caches declared by the job before any step runs. Caches are kept in a
+	// directory next to the workspace on the executor host, which containers mount outside
+	// of the repository checkout.
+	cachesSupported := runtime.SupportsCaches(h.jobRuntime.Name()) && len(job.Caches) > 0
+	cacheDir := command.CacheDirectory(ws.WorkingDirectory())
+	if cachesSupported {
+		if err := os.MkdirAll(cacheDir, os.ModePerm); err != nil {
+			return errors.Wrap(err, "creating cache directory")
+		}
+		defer func() {
+			if err := os.RemoveAll(cacheDir); err != nil {
+				logger.Error("Failed to remove cache directory", log.String("cacheDir", cacheDir), log.Error(err))
+			}
+		}()
+		cache.Restore(ctx, commandLogger, h.filesStore, job, cacheDir)
+	}
+
 	// Run all the things.
 	logger.Info("Running commands")
 	skipKey := ""
@@ -178,6 +201,12 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 		}
 	}
 
+	// Only save the caches of successful jobs, so that a failed step cannot leave a
+	// partially populated cache behind for later jobs.
+	if cachesSupported {
+		cache.Save(ctx, commandLogger, h.filesStore, job, cacheDir)
+	}
+
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/janitor"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
 	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runtime"
 	"github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
@@ -627,3 +628,93 @@ func TestHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_Handle_Caches(t *testing.T) {
+	internalLogger := logtest.Scoped(t)
+	operations := command.NewOperations(&observation.TestContext)
+
+	job := types.Job{
+		ID:             42,
+		RepositoryName: "my-repo",
+		Commit:         "cool-commit",
+		Caches:         []types.JobCache{{Key: "deps", Paths: []string{".cache"}}},
+	}
+	specs := []runner.Spec{
+		{
+			CommandSpecs: []command.Spec{{Key: "my-key", Command: []string{"echo", "hello"}, Operation: operations.Exec}},
+			Image:        "my-image",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		runtimeName     runtime.Name
+		runErr          error
+		expectedRestore bool
+		expectedSave    bool
+	}{
+		{
+			name:            "Docker runtime",
+			runtimeName:     runtime.NameDocker,
+			expectedRestore: true,
+			expectedSave:    true,
+		},
+		{
+			name:            "Failed job does not save caches",
+			runtimeName:     runtime.NameDocker,
+			runErr:          errors.New("failed"),
+			expectedRestore: true,
+		},
+		{
+			name:        "Unsupported runtime",
+			runtimeName: runtime.NameFirecracker,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jobRuntime := NewMockRuntime()
+			jobRunner := NewMockRunner()
+			jobWorkspace := NewMockWorkspace()
+			filesStore := NewMockStore()
+
+			jobRuntime.NameFunc.SetDefaultReturn(test.runtimeName)
+			jobRuntime.PrepareWorkspaceFunc.PushReturn(jobWorkspace, nil)
+			jobRuntime.NewRunnerFunc.PushReturn(jobRunner, nil)
+			jobRuntime.NewRunnerSpecsFunc.PushReturn(specs, nil)
+			jobRunner.RunFunc.PushReturn(test.runErr)
+			workingDirectory := t.TempDir()
+			jobWorkspace.WorkingDirectoryFunc.SetDefaultReturn(workingDirectory)
+
+			h := &handler{
+				nameSet:    janitor.NewNameSet(),
+				jobRuntime: jobRuntime,
+				logStore:   NewMockExecutionLogEntryStore(),
+				filesStore: filesStore,
+				options:    Options{QueueName: "codeintel"},
+				operations: operations,
+			}
+
+			err := h.Handle(context.Background(), internalLogger, job)
+			if test.runErr != nil {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if test.expectedRestore {
+				require.Len(t, filesStore.ExistsFunc.History(), 1)
+				assert.Equal(t, "cache/codeintel", filesStore.ExistsFunc.History()[0].Arg2)
+			} else {
+				require.Len(t, filesStore.ExistsFunc.History(), 0)
+			}
+			if test.expectedSave {
+				require.Len(t, filesStore.UploadFunc.History(), 1)
+				assert.Equal(t, "cache/codeintel", filesStore.UploadFunc.History()[0].Arg2)
+			} else {
+				require.Len(t, filesStore.UploadFunc.History(), 0)
+			}
+			// The cache directory lives outside of the workspace and is removed with it.
+			assert.NoDirExists(t, command.CacheDirectory(workingDirectory))
+		})
+	}
+}
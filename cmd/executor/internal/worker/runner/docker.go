@@ -95,6 +95,8 @@ func (r *dockerRunner) Teardown(ctx context.Context) error {
 }
 
 func (r *dockerRunner) Run(ctx context.Context, spec Spec) error {
-	dockerSpec := command.NewDockerSpec(r.dir, spec.Image, spec.ScriptPath, spec.CommandSpecs[0], r.options)
+	options := r.options
+	options.MountCache = len(spec.Job.Caches) > 0
+	dockerSpec := command.NewDockerSpec(r.dir, spec.Image, spec.ScriptPath, spec.CommandSpecs[0], options)
 	return r.cmd.Run(ctx, r.commandLogger, dockerSpec)
 }
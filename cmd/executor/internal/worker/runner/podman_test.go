@@ -0,0 +1,182 @@
+package runner_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+func TestPodmanRunner_Setup(t *testing.T) {
+	tests := []struct {
+		name               string
+		options            command.PodmanOptions
+		dockerAuthConfig   types.DockerAuthConfig
+		expectedDockerAuth string
+		expectedErr        error
+	}{
+		{
+			name: "Setup default",
+		},
+		{
+			name: "Default docker auth",
+			options: command.PodmanOptions{
+				DockerAuthConfig: types.DockerAuthConfig{
+					Auths: map[string]types.DockerAuthConfigAuth{
+						"index.docker.io": {
+							Auth: []byte("foobar"),
+						},
+					},
+				},
+			},
+			expectedDockerAuth: `{"auths":{"index.docker.io":{"auth":"Zm9vYmFy"}}}`,
+		},
+		{
+			name: "Specific docker auth",
+			options: command.PodmanOptions{
+				DockerAuthConfig: types.DockerAuthConfig{
+					Auths: map[string]types.DockerAuthConfigAuth{
+						"index.docker.io": {
+							Auth: []byte("foobar"),
+						},
+					},
+				},
+			},
+			dockerAuthConfig: types.DockerAuthConfig{
+				Auths: map[string]types.DockerAuthConfigAuth{
+					"index.docker.io": {
+						Auth: []byte("fazbaz"),
+					},
+				},
+			},
+			expectedDockerAuth: `{"auths":{"index.docker.io":{"auth":"ZmF6YmF6"}}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			podmanRunner := runner.NewPodmanRunner(nil, nil, "", test.options, test.dockerAuthConfig)
+
+			ctx := context.Background()
+			err := podmanRunner.Setup(ctx)
+			defer podmanRunner.Teardown(ctx)
+
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, test.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				entries, err := os.ReadDir(podmanRunner.TempDir())
+				require.NoError(t, err)
+				if len(test.expectedDockerAuth) == 0 {
+					require.Len(t, entries, 0)
+				} else {
+					require.Len(t, entries, 1)
+					authEntries, err := os.ReadDir(filepath.Join(podmanRunner.TempDir(), entries[0].Name()))
+					require.NoError(t, err)
+					require.Len(t, authEntries, 1)
+					assert.Equal(t, "auth.json", authEntries[0].Name())
+					f, err := os.ReadFile(filepath.Join(podmanRunner.TempDir(), entries[0].Name(), authEntries[0].Name()))
+					require.NoError(t, err)
+					assert.JSONEq(t, test.expectedDockerAuth, string(f))
+				}
+			}
+		})
+	}
+}
+
+func TestPodmanRunner_Teardown(t *testing.T) {
+	podmanRunner := runner.NewPodmanRunner(nil, nil, "", command.PodmanOptions{}, types.DockerAuthConfig{})
+	ctx := context.Background()
+	err := podmanRunner.Setup(ctx)
+	require.NoError(t, err)
+
+	dir := podmanRunner.TempDir()
+
+	_, err = os.Stat(dir)
+	require.NoError(t, err)
+
+	err = podmanRunner.Teardown(ctx)
+	require.NoError(t, err)
+
+	_, err = os.Stat(dir)
+	require.Error(t, err)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPodmanRunner_Run(t *testing.T) {
+	cmd := runner.NewMockCommand()
+	logger := runner.NewMockLogger()
+	dir := "/some/dir"
+	options := command.PodmanOptions{
+		AuthFilePath:   "/podman/auth.json",
+		OCIRuntime:     "crun",
+		Network:        "slirp4netns:allow_host_loopback=false",
+		PidsLimit:      4096,
+		AddHostGateway: true,
+		Resources: command.ResourceOptions{
+			NumCPUs:   10,
+			Memory:    "1G",
+			DiskSpace: "10G",
+		},
+	}
+	spec := runner.Spec{
+		CommandSpecs: []command.Spec{
+			{
+				Key:     "some-key",
+				Command: []string{"echo", "hello"},
+				Dir:     "/workingdir",
+				Env:     []string{"FOO=bar"},
+			},
+		},
+		Image:      "alpine",
+		ScriptPath: "/some/script",
+	}
+
+	podmanRunner := runner.NewPodmanRunner(cmd, logger, dir, options, types.DockerAuthConfig{})
+
+	cmd.RunFunc.PushReturn(nil)
+
+	err := podmanRunner.Run(context.Background(), spec)
+
+	require.NoError(t, err)
+
+	require.Len(t, cmd.RunFunc.History(), 1)
+	assert.Equal(t, "some-key", cmd.RunFunc.History()[0].Arg2.Key)
+	assert.Equal(t, []string{
+		"podman",
+		"--runtime",
+		"crun",
+		"run",
+		"--rm",
+		"--authfile",
+		"/podman/auth.json",
+		"--network",
+		"slirp4netns:allow_host_loopback=false",
+		"--add-host=host.docker.internal:host-gateway",
+		"--security-opt",
+		"no-new-privileges",
+		"--cpus",
+		"10",
+		"--memory",
+		"1G",
+		"--pids-limit",
+		"4096",
+		"-v",
+		"/some/dir:/data:Z",
+		"-w",
+		"/data/workingdir",
+		"-e",
+		"FOO=bar",
+		"--entrypoint",
+		"/bin/sh",
+		"alpine",
+		"/data/.sourcegraph-executor/some/script",
+	}, cmd.RunFunc.History()[0].Arg2.Command)
+}
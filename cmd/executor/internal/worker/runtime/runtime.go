@@ -89,6 +89,27 @@ func New(
 		}
 	}
 
+	if runnerOpts.PodmanOptions.Enabled {
+		// We explicitly want a Podman runtime. So validation must pass.
+		if err := util.ValidatePodmanTools(runner); err != nil {
+			var errMissingTools *util.ErrMissingTools
+			if errors.As(err, &errMissingTools) {
+				logger.Error("runtime 'podman' is not supported: missing required tools", log.Strings("podmanTools", errMissingTools.Tools))
+			} else {
+				logger.Error("failed to determine if podman tools are configured", log.Error(err))
+			}
+			return nil, err
+		}
+		logger.Info("using runtime 'podman'")
+		return &podmanRuntime{
+			cmd:          cmd,
+			operations:   ops,
+			filesStore:   filesStore,
+			cloneOptions: cloneOpts,
+			podmanOpts:   runnerOpts.PodmanOptions.ContainerOptions,
+		}, nil
+	}
+
 	if runnerOpts.KubernetesOptions.Enabled {
 		configPath := runnerOpts.KubernetesOptions.ConfigPath
 		kubeConfig, err := clientcmd.BuildConfigFromFlags("", configPath)
@@ -149,6 +170,7 @@ const (
 	NameDocker      Name = "docker"
 	NameFirecracker Name = "firecracker"
 	NameKubernetes  Name = "kubernetes"
+	NamePodman      Name = "podman"
 	NameShell       Name = "shell"
 )
 
@@ -158,7 +180,14 @@ func CommandKey(name Name, rawStepKey string, index int) string {
 	case NameKubernetes:
 		return kubernetesKey(rawStepKey, index)
 	default:
-		// shell, docker, and firecracker all use the same key format.
+		// shell, docker, podman, and firecracker all use the same key format.
 		return dockerKey(rawStepKey, index)
 	}
 }
+
+// SupportsCaches returns true if jobs run by the runtime with the given name can declare
+// caches. Caches are restored into and saved from a directory on the executor host, which
+// containers mount at command.CacheMountPath so that jobs can point tools at it.
+func SupportsCaches(name Name) bool {
+	return name == NameDocker || name == NamePodman
+}
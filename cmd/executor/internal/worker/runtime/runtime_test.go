@@ -170,6 +170,42 @@ func TestNew(t *testing.T) {
 			},
 			expectedErr: errors.New("2 errors occurred:\n\t* Cannot find directory /opt/cni/bin. Are the CNI plugins for firecracker installed correctly?\n\t* Cannot find CNI plugins [bandwidth bridge firewall host-local isolation loopback portmap], are the CNI plugins for firecracker installed correctly?\nTo install the CNI plugins used by ignite run \"executor install cni\" or the following:\n  $ mkdir -p /opt/cni/bin\n  $ curl -sSL https://github.com/containernetworking/plugins/releases/download/v0.9.1/cni-plugins-linux-amd64-v0.9.1.tgz | tar -xz -C /opt/cni/bin\n  $ curl -sSL https://github.com/AkihiroSuda/cni-isolation/releases/download/v0.0.4/cni-isolation-amd64.tgz | tar -xz -C /opt/cni/bin"),
 		},
+		{
+			name: "Podman",
+			runnerOpts: runner.Options{
+				PodmanOptions: runner.PodmanOptions{
+					Enabled: true,
+				},
+			},
+			mockFunc: func(cmdRunner *runtime.MockCmdRunner) {
+				cmdRunner.LookPathFunc.SetDefaultReturn("", nil)
+			},
+			expectedName: runtime.NamePodman,
+			assertMockFunc: func(t *testing.T, cmdRunner *runtime.MockCmdRunner) {
+				require.Len(t, cmdRunner.LookPathFunc.History(), 3)
+				assert.Equal(t, "git", cmdRunner.LookPathFunc.History()[0].Arg0)
+				assert.Equal(t, "podman", cmdRunner.LookPathFunc.History()[1].Arg0)
+				assert.Equal(t, "src", cmdRunner.LookPathFunc.History()[2].Arg0)
+			},
+		},
+		{
+			name: "Missing Podman tools",
+			runnerOpts: runner.Options{
+				PodmanOptions: runner.PodmanOptions{
+					Enabled: true,
+				},
+			},
+			mockFunc: func(cmdRunner *runtime.MockCmdRunner) {
+				cmdRunner.LookPathFunc.PushReturn("", nil)
+				cmdRunner.LookPathFunc.PushReturn("", exec.ErrNotFound)
+				cmdRunner.LookPathFunc.PushReturn("", nil)
+			},
+			expectedName: runtime.NamePodman,
+			assertMockFunc: func(t *testing.T, cmdRunner *runtime.MockCmdRunner) {
+				require.Len(t, cmdRunner.LookPathFunc.History(), 3)
+			},
+			expectedErr: errors.New("podman not found in PATH, is it installed?\nCheck out https://podman.io/docs/installation on how to install."),
+		},
 		{
 			name: "No Runtime",
 			mockFunc: func(cmdRunner *runtime.MockCmdRunner) {
@@ -307,6 +343,20 @@ func TestCommandKey(t *testing.T) {
 			index:       1,
 			expectedKey: "step.kubernetes.1",
 		},
+		{
+			name:        "Podman",
+			runtimeName: runtime.NamePodman,
+			key:         "step.1.pre",
+			index:       0,
+			expectedKey: "step.docker.step.1.pre",
+		},
+		{
+			name:        "Podman with index",
+			runtimeName: runtime.NamePodman,
+			key:         "",
+			index:       1,
+			expectedKey: "step.docker.1",
+		},
 		{
 			name:        "Shell",
 			runtimeName: runtime.NameShell,
@@ -0,0 +1,26 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/cmdlogger"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/cmd/executor/internal/worker/files"
+	"github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+// NewPodmanWorkspace creates a new workspace for podman-based execution. Like Docker,
+// Podman bind-mounts a path on the host into the container, so the workspace is set up
+// the same way. As the executor runs unprivileged, the workspace is owned by that user,
+// which rootless Podman maps to root inside the container.
+func NewPodmanWorkspace(
+	ctx context.Context,
+	filesStore files.Store,
+	job types.Job,
+	cmd command.Command,
+	logger cmdlogger.Logger,
+	cloneOpts CloneOptions,
+	operations *command.Operations,
+) (Workspace, error) {
+	return NewDockerWorkspace(ctx, filesStore, job, cmd, logger, cloneOpts, operations)
+}
@@ -39,11 +39,16 @@ type Services struct {
 
 	// Handler for exporting code insights data.
 	CodeInsightsDataExportHandler http.Handler
+	// Handler for scraping the latest values of code insights series.
+	CodeInsightsMetricsHandler http.Handler
 
 	// Handler for exporting search jobs data.
 	SearchJobsDataExportHandler http.Handler
 	SearchJobsLogsHandler       http.Handler
 
+	// Handler for streaming the logs of running executor jobs.
+	ExecutorLogStreamHandler http.Handler
+
 	// Handler for completions stream.
 	NewChatCompletionsStreamHandler NewChatCompletionsStreamHandler
 
@@ -55,6 +60,8 @@ type Services struct {
 
 	PermissionsGitHubWebhook  webhooks.Registerer
 	NewCodeIntelUploadHandler NewCodeIntelUploadHandler
+	CodeIntelLSPHandler       http.Handler
+	CodeIntelExportHandler    http.Handler
 	RankingService            RankingService
 	NewExecutorProxyHandler   NewExecutorProxyHandler
 	NewGitHubAppSetupHandler  NewGitHubAppSetupHandler
@@ -112,16 +119,20 @@ func DefaultServices() Services {
 		BatchesChangesFileUploadHandler: makeNotFoundHandler("batches file upload handler"),
 		SCIMHandler:                     makeNotFoundHandler("SCIM handler"),
 		NewCodeIntelUploadHandler:       func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
+		CodeIntelLSPHandler:             makeNotFoundHandler("code intel LSP"),
+		CodeIntelExportHandler:          makeNotFoundHandler("code intel SCIP export"),
 		RankingService:                  stubRankingService{},
 		NewExecutorProxyHandler:         func() http.Handler { return makeNotFoundHandler("executor proxy") },
 		NewGitHubAppSetupHandler:        func() http.Handler { return makeNotFoundHandler("Sourcegraph GitHub App setup") },
 		NewComputeStreamHandler:         func() http.Handler { return makeNotFoundHandler("compute streaming endpoint") },
 		CodeInsightsDataExportHandler:   makeNotFoundHandler("code insights data export handler"),
+		CodeInsightsMetricsHandler:      makeNotFoundHandler("code insights metrics handler"),
 		NewDotcomLicenseCheckHandler:    func() http.Handler { return makeNotFoundHandler("dotcom license check handler") },
 		NewChatCompletionsStreamHandler: func() http.Handler { return makeNotFoundHandler("chat completions streaming endpoint") },
 		NewCodeCompletionsHandler:       func() http.Handler { return makeNotFoundHandler("code completions streaming endpoint") },
 		SearchJobsDataExportHandler:     makeNotFoundHandler("search jobs data export handler"),
 		SearchJobsLogsHandler:           makeNotFoundHandler("search jobs logs handler"),
+		ExecutorLogStreamHandler:        makeNotFoundHandler("executor log stream handler"),
 	}
 }
 
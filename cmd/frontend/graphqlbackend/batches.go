@@ -571,6 +571,16 @@ type BatchChangesCredentialResolver interface {
 	IsSiteCredential() bool
 }
 
+type ChangesetImpactMetricsResolver interface {
+	CodeHost() string
+	Team() *string
+	TimeToMerge() *int32
+	ReviewLatency() *int32
+	CheckRuns() int32
+	FailedCheckRuns() int32
+	ComputedAt() gqlutil.DateTime
+}
+
 // Only GitHubApps are supported for commit signing for now.
 type CommitSigningConfigResolver interface {
 	ToGitHubApp() (GitHubAppResolver, bool)
@@ -669,6 +679,8 @@ type BatchChangeResolver interface {
 	ChangesetsStats(ctx context.Context) (ChangesetsStatsResolver, error)
 	Changesets(ctx context.Context, args *ListChangesetsArgs) (ChangesetsConnectionResolver, error)
 	ChangesetCountsOverTime(ctx context.Context, args *ChangesetCountsArgs) ([]ChangesetCountsResolver, error)
+	RolloutTiers(ctx context.Context) ([]ChangesetRolloutTierCountsResolver, error)
+	ImpactAnalytics(ctx context.Context) (BatchChangeImpactAnalyticsResolver, error)
 	ClosedAt() *gqlutil.DateTime
 	DiffStat(ctx context.Context) (*DiffStat, error)
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
@@ -823,6 +835,17 @@ type ExternalChangesetResolver interface {
 	ScheduleEstimateAt(ctx context.Context) (*gqlutil.DateTime, error)
 
 	CurrentSpec(ctx context.Context) (VisibleChangesetSpecResolver, error)
+
+	AutoMergeDecision(ctx context.Context) (ChangesetAutoMergeDecisionResolver, error)
+	PublicationBlockedReason(ctx context.Context) (*string, error)
+	Impact(ctx context.Context) (ChangesetImpactMetricsResolver, error)
+}
+
+type ChangesetAutoMergeDecisionResolver interface {
+	// Outcome returns a value of type btypes.ChangesetAutoMergeOutcome.
+	Outcome() string
+	Reason() string
+	EvaluatedAt() gqlutil.DateTime
 }
 
 // Only GitHubApps are supported for commit signing for now.
@@ -861,6 +884,44 @@ type ChangesetCountsResolver interface {
 	OpenPending() int32
 }
 
+type ChangesetRolloutTierCountsResolver interface {
+	Tier() int32
+	Total() int32
+	Unpublished() int32
+	Draft() int32
+	Open() int32
+	Merged() int32
+	Closed() int32
+	Blocked() int32
+}
+
+type BatchChangeImpactAnalyticsResolver interface {
+	ComputedAt() gqlutil.DateTime
+	Overall() BatchChangeImpactStatsResolver
+	ByCodeHost() []BatchChangeImpactStatsResolver
+	ByTeam() []BatchChangeImpactStatsResolver
+}
+
+type BatchChangeImpactStatsResolver interface {
+	Value() *string
+	Changesets() int32
+	Merged() int32
+	Reviewed() int32
+	TimeToMergeP50() *int32
+	TimeToMergeP90() *int32
+	TimeToMergeDistribution() []TimeToMergeBucketResolver
+	ReviewLatencyP50() *int32
+	ReviewLatencyP90() *int32
+	CheckRuns() int32
+	FailedCheckRuns() int32
+	CIFailureRate() float64
+}
+
+type TimeToMergeBucketResolver interface {
+	MaxSeconds() *int32
+	Count() int32
+}
+
 type BatchSpecWorkspaceResolutionResolver interface {
 	State() string
 	StartedAt() *gqlutil.DateTime
@@ -0,0 +1,48 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+type DeadLetterResolver interface {
+	DeadLetterQueues(ctx context.Context) ([]DeadLetterQueueResolver, error)
+	DeadLetterRecords(ctx context.Context, args *DeadLetterRecordsArgs) (DeadLetterRecordConnectionResolver, error)
+
+	RequeueDeadLetterRecords(ctx context.Context, args *DeadLetterRecordsMutationArgs) (int32, error)
+	DeleteDeadLetterRecords(ctx context.Context, args *DeadLetterRecordsMutationArgs) (int32, error)
+}
+
+type DeadLetterRecordsArgs struct {
+	Queue          string
+	FailureMessage *string
+	FailedBefore   *gqlutil.DateTime
+	First          int32
+}
+
+type DeadLetterRecordsMutationArgs struct {
+	Queue          string
+	FailureMessage *string
+	FailedBefore   *gqlutil.DateTime
+	Limit          *int32
+}
+
+type DeadLetterQueueResolver interface {
+	Name() string
+	FailedCount(ctx context.Context) (int32, error)
+}
+
+type DeadLetterRecordConnectionResolver interface {
+	Nodes() []DeadLetterRecordResolver
+	TotalCount() int32
+}
+
+type DeadLetterRecordResolver interface {
+	ID() int32
+	FailureMessage() *string
+	NumFailures() int32
+	NumResets() int32
+	QueuedAt() *gqlutil.DateTime
+	FinishedAt() *gqlutil.DateTime
+}
@@ -0,0 +1,322 @@
+package graphqlbackend
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const executorCustomJobIDKind = "ExecutorCustomJob"
+
+func marshalExecutorCustomJobID(id int) graphql.ID {
+	return relay.MarshalID(executorCustomJobIDKind, int64(id))
+}
+
+func unmarshalExecutorCustomJobID(id graphql.ID) (jobID int, err error) {
+	if kind := relay.UnmarshalKind(id); kind != executorCustomJobIDKind {
+		return 0, errors.Newf("expected graphql ID to have kind %q; got %q", executorCustomJobIDKind, kind)
+	}
+	err = relay.UnmarshalSpec(id, &jobID)
+	return
+}
+
+type ExecutorCustomJobsArgs struct {
+	First int32
+	After *string
+	State *string
+}
+
+func (r *schemaResolver) ExecutorCustomJobs(ctx context.Context, args ExecutorCustomJobsArgs) (*executorCustomJobConnectionResolver, error) {
+	// 🚨 SECURITY: Only site-admins may view custom executor jobs, as they can contain
+	// arbitrary commands and files.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	offset, err := graphqlutil.DecodeIntCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := executorstore.CustomJobListOptions{
+		Offset: offset,
+		Limit:  int(args.First),
+	}
+	if args.State != nil {
+		opts.State = strings.ToLower(*args.State)
+	}
+
+	store := executorstore.NewCustomJobStore(r.db)
+	jobs, err := store.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	totalCount, err := store.Count(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*executorCustomJobResolver, 0, len(jobs))
+	for _, job := range jobs {
+		resolvers = append(resolvers, &executorCustomJobResolver{db: r.db, gitserverClient: r.gitserverClient, job: job})
+	}
+
+	return &executorCustomJobConnectionResolver{
+		resolvers:  resolvers,
+		totalCount: totalCount,
+		nextOffset: graphqlutil.NextOffset(offset, len(jobs), totalCount),
+	}, nil
+}
+
+type ExecutorCustomJobStepInput struct {
+	Image    string
+	Commands []string
+	Dir      *string
+	Env      *[]string
+}
+
+type ExecutorCustomJobFileInput struct {
+	Path    string
+	Content string
+}
+
+type EnqueueExecutorCustomJobArgs struct {
+	Name       string
+	Repository graphql.ID
+	Commit     string
+	Steps      []ExecutorCustomJobStepInput
+	Files      *[]ExecutorCustomJobFileInput
+	Secrets    *[]string
+}
+
+func (r *schemaResolver) EnqueueExecutorCustomJob(ctx context.Context, args EnqueueExecutorCustomJobArgs) (*executorCustomJobResolver, error) {
+	// 🚨 SECURITY: Only site-admins may enqueue custom executor jobs, as they run arbitrary
+	// commands on the executors.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	repoID, err := UnmarshalRepositoryID(args.Repository)
+	if err != nil {
+		return nil, err
+	}
+	// Make sure the repository exists before enqueueing a job that can never succeed.
+	if _, err := r.db.Repos().Get(ctx, repoID); err != nil {
+		return nil, err
+	}
+
+	job := executorstore.CustomJob{
+		Name:         args.Name,
+		CreatorID:    actor.FromContext(ctx).UID,
+		RepositoryID: repoID,
+		Commit:       args.Commit,
+	}
+	for _, step := range args.Steps {
+		s := executorstore.CustomJobStep{Image: step.Image, Commands: step.Commands}
+		if step.Dir != nil {
+			s.Dir = *step.Dir
+		}
+		if step.Env != nil {
+			s.Env = *step.Env
+		}
+		job.Steps = append(job.Steps, s)
+	}
+	if args.Files != nil {
+		job.Files = make(map[string]string, len(*args.Files))
+		for _, file := range *args.Files {
+			if _, ok := job.Files[file.Path]; ok {
+				return nil, errors.Newf("duplicate file path %q", file.Path)
+			}
+			job.Files[file.Path] = file.Content
+		}
+	}
+	if args.Secrets != nil {
+		job.Secrets = *args.Secrets
+	}
+
+	store := executorstore.NewCustomJobStore(r.db)
+	id, err := store.Create(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return executorCustomJobByIDInt(ctx, r.db, r.gitserverClient, id)
+}
+
+type CancelExecutorCustomJobArgs struct {
+	ID graphql.ID
+}
+
+func (r *schemaResolver) CancelExecutorCustomJob(ctx context.Context, args CancelExecutorCustomJobArgs) (*executorCustomJobResolver, error) {
+	// 🚨 SECURITY: Only site-admins may cancel custom executor jobs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalExecutorCustomJobID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	canceled, err := executorstore.NewCustomJobStore(r.db).Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canceled {
+		return nil, errors.New("the job is not queued or processing and cannot be canceled")
+	}
+
+	return executorCustomJobByIDInt(ctx, r.db, r.gitserverClient, id)
+}
+
+func executorCustomJobByID(ctx context.Context, db database.DB, gitserverClient gitserver.Client, gqlID graphql.ID) (*executorCustomJobResolver, error) {
+	// 🚨 SECURITY: Only site-admins may view custom executor jobs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+		return nil, err
+	}
+
+	id, err := unmarshalExecutorCustomJobID(gqlID)
+	if err != nil {
+		return nil, err
+	}
+
+	return executorCustomJobByIDInt(ctx, db, gitserverClient, id)
+}
+
+func executorCustomJobByIDInt(ctx context.Context, db database.DB, gitserverClient gitserver.Client, id int) (*executorCustomJobResolver, error) {
+	job, ok, err := executorstore.NewCustomJobStore(db).GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Newf("executor custom job %d not found", id)
+	}
+
+	return &executorCustomJobResolver{db: db, gitserverClient: gitserverClient, job: job}, nil
+}
+
+type executorCustomJobResolver struct {
+	db              database.DB
+	gitserverClient gitserver.Client
+	job             *executorstore.CustomJob
+}
+
+func (r *executorCustomJobResolver) ID() graphql.ID { return marshalExecutorCustomJobID(r.job.ID) }
+func (r *executorCustomJobResolver) Name() string   { return r.job.Name }
+func (r *executorCustomJobResolver) State() string  { return strings.ToUpper(r.job.State) }
+func (r *executorCustomJobResolver) Commit() string { return r.job.Commit }
+
+func (r *executorCustomJobResolver) Repository(ctx context.Context) (*RepositoryResolver, error) {
+	repo, err := r.db.Repos().Get(ctx, r.job.RepositoryID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return NewRepositoryResolver(r.db, r.gitserverClient, repo), nil
+}
+
+func (r *executorCustomJobResolver) Creator(ctx context.Context) (*UserResolver, error) {
+	// Enqueued by a service, or the user has been deleted.
+	if r.job.CreatorID == 0 {
+		return nil, nil
+	}
+
+	return UserByIDInt32(ctx, r.db, r.job.CreatorID)
+}
+
+func (r *executorCustomJobResolver) Steps() []*executorCustomJobStepResolver {
+	resolvers := make([]*executorCustomJobStepResolver, 0, len(r.job.Steps))
+	for _, step := range r.job.Steps {
+		resolvers = append(resolvers, &executorCustomJobStepResolver{step: step})
+	}
+	return resolvers
+}
+
+func (r *executorCustomJobResolver) Files() []string {
+	paths := make([]string, 0, len(r.job.Files))
+	for path := range r.job.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (r *executorCustomJobResolver) Secrets() []string {
+	if r.job.Secrets == nil {
+		return []string{}
+	}
+	return r.job.Secrets
+}
+
+func (r *executorCustomJobResolver) FailureMessage() *string { return r.job.FailureMessage }
+
+func (r *executorCustomJobResolver) QueuedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.job.QueuedAt}
+}
+
+func (r *executorCustomJobResolver) StartedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.job.StartedAt)
+}
+
+func (r *executorCustomJobResolver) FinishedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.job.FinishedAt)
+}
+
+func (r *executorCustomJobResolver) ExecutionLogs() []ExecutionLogEntryResolver {
+	resolvers := make([]ExecutionLogEntryResolver, 0, len(r.job.ExecutionLogs))
+	for _, entry := range r.job.ExecutionLogs {
+		resolvers = append(resolvers, NewExecutionLogEntryResolver(r.db, entry))
+	}
+	return resolvers
+}
+
+type executorCustomJobStepResolver struct {
+	step executorstore.CustomJobStep
+}
+
+func (r *executorCustomJobStepResolver) Image() string      { return r.step.Image }
+func (r *executorCustomJobStepResolver) Commands() []string { return r.step.Commands }
+func (r *executorCustomJobStepResolver) Dir() string        { return r.step.Dir }
+
+func (r *executorCustomJobStepResolver) Env() []string {
+	if r.step.Env == nil {
+		return []string{}
+	}
+	return r.step.Env
+}
+
+type executorCustomJobConnectionResolver struct {
+	resolvers  []*executorCustomJobResolver
+	totalCount int
+	nextOffset *int32
+}
+
+func (r *executorCustomJobConnectionResolver) Nodes() []*executorCustomJobResolver {
+	return r.resolvers
+}
+
+func (r *executorCustomJobConnectionResolver) TotalCount() int32 {
+	return int32(r.totalCount)
+}
+
+func (r *executorCustomJobConnectionResolver) PageInfo() *graphqlutil.PageInfo {
+	if r.nextOffset == nil {
+		return graphqlutil.HasNextPage(false)
+	}
+	return graphqlutil.EncodeIntCursor(r.nextOffset)
+}
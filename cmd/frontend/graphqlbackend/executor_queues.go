@@ -0,0 +1,169 @@
+package graphqlbackend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// executorQueueSchedulingPolicies describes how the jobs within each executor queue are ordered
+// when they are dequeued.
+var executorQueueSchedulingPolicies = map[string]string{
+	"batches":   "Jobs of different users are dequeued in turns, so that a large batch spec of one user doesn't block the batch specs of other users.",
+	"codeintel": "Auto-indexing jobs enqueued manually are dequeued first. Other jobs of repositories with fewer jobs being processed are dequeued before jobs of repositories with more jobs being processed.",
+	"custom":    "Jobs are dequeued in the order they were enqueued.",
+}
+
+// ExecutorQueueCandidatesLister lists the next queued jobs of the executor queues. It is
+// implemented by the executor queue endpoints.
+type ExecutorQueueCandidatesLister interface {
+	// ListExecutorQueueCandidates returns the next queued jobs of the given executor queue, in the
+	// order in which they are dequeued.
+	ListExecutorQueueCandidates(ctx context.Context, queueName string, limit int) ([]dbworkerstore.DequeueCandidate, error)
+}
+
+func (r *schemaResolver) ExecutorQueues(ctx context.Context) ([]*executorQueueResolver, error) {
+	// 🚨 SECURITY: Only site-admins may view executor details
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	config := executortypes.DequeueConfig(conf.Get().SiteConfiguration)
+	dequeueCache := rcache.New(executortypes.DequeueCachePrefix)
+
+	resolvers := make([]*executorQueueResolver, 0, len(executortypes.ValidQueueNames))
+	for _, name := range executortypes.ValidQueueNames {
+		limit, weight := executortypes.DequeueProperties(config, name)
+
+		dequeues, err := dequeueCache.GetHashAll(name)
+		if err != nil {
+			return nil, err
+		}
+
+		activeExecutors, err := r.db.Executors().Count(ctx, database.ExecutorStoreListOptions{
+			QueueName: name,
+			Active:    true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		resolvers = append(resolvers, &executorQueueResolver{
+			name:            name,
+			weight:          weight,
+			dequeueLimit:    limit,
+			recentDequeues:  len(dequeues),
+			activeExecutors: activeExecutors,
+			candidates:      r.ExecutorQueueCandidatesLister,
+		})
+	}
+
+	return resolvers, nil
+}
+
+type executorQueueResolver struct {
+	name            string
+	weight          int
+	dequeueLimit    int
+	recentDequeues  int
+	activeExecutors int
+	candidates      ExecutorQueueCandidatesLister
+}
+
+func (r *executorQueueResolver) Name() string           { return r.name }
+func (r *executorQueueResolver) Weight() int32          { return int32(r.weight) }
+func (r *executorQueueResolver) DequeueLimit() int32    { return int32(r.dequeueLimit) }
+func (r *executorQueueResolver) RecentDequeues() int32  { return int32(r.recentDequeues) }
+func (r *executorQueueResolver) ActiveExecutors() int32 { return int32(r.activeExecutors) }
+
+func (r *executorQueueResolver) SchedulingPolicy() string {
+	return executorQueueSchedulingPolicies[r.name]
+}
+
+func (r *executorQueueResolver) WaitReasons() []string {
+	reasons := []string{}
+	if r.activeExecutors == 0 {
+		reasons = append(reasons, "No active executor processes jobs of this queue.")
+	}
+	if r.dequeueLimitReached() {
+		reasons = append(reasons, fmt.Sprintf(
+			"Multi-queue executors dequeued %d jobs of this queue in the last %s, reaching the limit of %d dequeues. They prefer other queues with queued jobs until earlier dequeues expire.",
+			r.recentDequeues,
+			executortypes.DequeueTtl,
+			r.dequeueLimit,
+		))
+	}
+	return reasons
+}
+
+func (r *executorQueueResolver) dequeueLimitReached() bool {
+	return r.recentDequeues >= r.dequeueLimit
+}
+
+func (r *executorQueueResolver) QueuedJobs(ctx context.Context, args *struct{ First int32 }) ([]*executorQueuedJobResolver, error) {
+	// The executor queue endpoints are not initialized.
+	if r.candidates == nil {
+		return []*executorQueuedJobResolver{}, nil
+	}
+
+	candidates, err := r.candidates.ListExecutorQueueCandidates(ctx, r.name, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*executorQueuedJobResolver, 0, len(candidates))
+	for _, candidate := range candidates {
+		resolvers = append(resolvers, &executorQueuedJobResolver{
+			candidate:  candidate,
+			waitReason: executorQueuedJobWaitReason(candidates[0], candidate, r.dequeueLimitReached()),
+		})
+	}
+	return resolvers, nil
+}
+
+// executorQueuedJobWaitReason returns why the given candidate is not dequeued before the first
+// candidate of its queue, or nil if it is the first candidate. Candidates are ordered by priority,
+// then by the number of jobs of their namespace being processed, then by the order they were
+// enqueued in.
+func executorQueuedJobWaitReason(first, candidate dbworkerstore.DequeueCandidate, dequeueLimitReached bool) *string {
+	var reason string
+	switch {
+	case dequeueLimitReached:
+		reason = "QUEUE_WEIGHT"
+	case candidate.ID == first.ID:
+		return nil
+	case candidate.Priority < first.Priority:
+		reason = "LOWER_PRIORITY"
+	case candidate.NamespaceProcessing > first.NamespaceProcessing:
+		reason = "NAMESPACE_FAIR_SHARE"
+	default:
+		reason = "ENQUEUE_ORDER"
+	}
+	return &reason
+}
+
+type executorQueuedJobResolver struct {
+	candidate  dbworkerstore.DequeueCandidate
+	waitReason *string
+}
+
+func (r *executorQueuedJobResolver) JobID() int32    { return int32(r.candidate.ID) }
+func (r *executorQueuedJobResolver) Position() int32 { return int32(r.candidate.Position) }
+func (r *executorQueuedJobResolver) Priority() int32 { return int32(r.candidate.Priority) }
+func (r *executorQueuedJobResolver) NamespaceProcessing() int32 {
+	return int32(r.candidate.NamespaceProcessing)
+}
+func (r *executorQueuedJobResolver) WaitReason() *string { return r.waitReason }
+
+func (r *executorQueuedJobResolver) Namespace() *string {
+	if r.candidate.Namespace == "" {
+		return nil
+	}
+	return &r.candidate.Namespace
+}
@@ -0,0 +1,133 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestExecutorQueueWaitReasons(t *testing.T) {
+	tests := []struct {
+		name     string
+		queue    executorQueueResolver
+		expected []string
+	}{
+		{
+			name:     "Nothing holds back jobs",
+			queue:    executorQueueResolver{name: "codeintel", dequeueLimit: 250, recentDequeues: 12, activeExecutors: 2},
+			expected: []string{},
+		},
+		{
+			name:  "No active executors",
+			queue: executorQueueResolver{name: "codeintel", dequeueLimit: 250},
+			expected: []string{
+				"No active executor processes jobs of this queue.",
+			},
+		},
+		{
+			name:  "Dequeue limit reached",
+			queue: executorQueueResolver{name: "batches", dequeueLimit: 50, recentDequeues: 50, activeExecutors: 1},
+			expected: []string{
+				"Multi-queue executors dequeued 50 jobs of this queue in the last 5m0s, reaching the limit of 50 dequeues. They prefer other queues with queued jobs until earlier dequeues expire.",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.queue.WaitReasons())
+		})
+	}
+}
+
+func TestExecutorQueuedJobWaitReason(t *testing.T) {
+	first := dbworkerstore.DequeueCandidate{ID: 1, Position: 1, Priority: 1, Namespace: "alice", NamespaceProcessing: 0}
+
+	tests := []struct {
+		name                string
+		candidate           dbworkerstore.DequeueCandidate
+		dequeueLimitReached bool
+		expected            *string
+	}{
+		{
+			name:      "Next job",
+			candidate: first,
+			expected:  nil,
+		},
+		{
+			name:                "Dequeue limit reached",
+			candidate:           first,
+			dequeueLimitReached: true,
+			expected:            pointers.Ptr("QUEUE_WEIGHT"),
+		},
+		{
+			name:      "Lower priority",
+			candidate: dbworkerstore.DequeueCandidate{ID: 2, Position: 2, Priority: 0, Namespace: "alice"},
+			expected:  pointers.Ptr("LOWER_PRIORITY"),
+		},
+		{
+			name:      "Namespace at fair share",
+			candidate: dbworkerstore.DequeueCandidate{ID: 3, Position: 2, Priority: 1, Namespace: "bob", NamespaceProcessing: 2},
+			expected:  pointers.Ptr("NAMESPACE_FAIR_SHARE"),
+		},
+		{
+			name:      "Enqueued later",
+			candidate: dbworkerstore.DequeueCandidate{ID: 4, Position: 2, Priority: 1, Namespace: "bob"},
+			expected:  pointers.Ptr("ENQUEUE_ORDER"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, executorQueuedJobWaitReason(first, test.candidate, test.dequeueLimitReached))
+		})
+	}
+}
+
+type fakeExecutorQueueCandidatesLister map[string][]dbworkerstore.DequeueCandidate
+
+func (l fakeExecutorQueueCandidatesLister) ListExecutorQueueCandidates(_ context.Context, queueName string, limit int) ([]dbworkerstore.DequeueCandidate, error) {
+	candidates := l[queueName]
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+func TestExecutorQueueQueuedJobs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Endpoints not initialized", func(t *testing.T) {
+		queue := executorQueueResolver{name: "codeintel", dequeueLimit: 250}
+		jobs, err := queue.QueuedJobs(ctx, &struct{ First int32 }{First: 10})
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("Queued jobs", func(t *testing.T) {
+		queue := executorQueueResolver{
+			name:         "codeintel",
+			dequeueLimit: 250,
+			candidates: fakeExecutorQueueCandidatesLister{
+				"codeintel": {
+					{ID: 1, Position: 1, Priority: 1},
+					{ID: 2, Position: 2, Priority: 0},
+					{ID: 3, Position: 3, Priority: 0},
+				},
+				"batches": {{ID: 4, Position: 1}},
+			},
+		}
+		jobs, err := queue.QueuedJobs(ctx, &struct{ First int32 }{First: 2})
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, int32(1), jobs[0].JobID())
+		assert.Nil(t, jobs[0].WaitReason())
+		assert.Equal(t, int32(2), jobs[1].JobID())
+		assert.Equal(t, pointers.Ptr("LOWER_PRIORITY"), jobs[1].WaitReason())
+	})
+}
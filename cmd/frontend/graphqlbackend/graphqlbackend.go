@@ -610,6 +610,18 @@ func NewSchema(
 			schemas = append(schemas, contentLibrary)
 		}
 
+		if deadLetterResolver := optional.DeadLetterResolver; deadLetterResolver != nil {
+			EnterpriseResolvers.deadLetterResolver = deadLetterResolver
+			resolver.DeadLetterResolver = deadLetterResolver
+			schemas = append(schemas, deadLetterSchema)
+		}
+
+		// The executor queues are part of the main schema, only their queued jobs are listed by the
+		// executor queue endpoints.
+		if executorQueueCandidates := optional.ExecutorQueueCandidatesLister; executorQueueCandidates != nil {
+			resolver.ExecutorQueueCandidatesLister = executorQueueCandidates
+		}
+
 		if searchJobsResolver := optional.SearchJobsResolver; searchJobsResolver != nil {
 			EnterpriseResolvers.searchJobsResolver = searchJobsResolver
 			resolver.SearchJobsResolver = searchJobsResolver
@@ -678,6 +690,8 @@ type OptionalResolver struct {
 	SearchContextsResolver
 	WebhooksResolver
 	ContentLibraryResolver
+	DeadLetterResolver
+	ExecutorQueueCandidatesLister
 	*TelemetryRootResolver
 }
 
@@ -752,6 +766,9 @@ func newSchemaResolver(db database.DB, gitserverClient gitserver.Client) *schema
 		"ExecutorSecretAccessLog": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return executorSecretAccessLogByID(ctx, db, id)
 		},
+		executorCustomJobIDKind: func(ctx context.Context, id graphql.ID) (Node, error) {
+			return executorCustomJobByID(ctx, db, r.gitserverClient, id)
+		},
 		teamIDKind: func(ctx context.Context, id graphql.ID) (Node, error) {
 			return teamByID(ctx, db, id)
 		},
@@ -798,6 +815,7 @@ var EnterpriseResolvers = struct {
 	searchContextsResolver      SearchContextsResolver
 	webhooksResolver            WebhooksResolver
 	contentLibraryResolver      ContentLibraryResolver
+	deadLetterResolver          DeadLetterResolver
 	telemetryResolver           *TelemetryRootResolver
 }{}
 
@@ -50,6 +50,11 @@ type InsightsResolver interface {
 	RetryInsightSeriesBackfill(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToFrontOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToBackOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
+
+	// Alerts
+	InsightSeriesAlerts(ctx context.Context, args InsightSeriesAlertsArgs) ([]InsightSeriesAlertResolver, error)
+	CreateInsightSeriesAlert(ctx context.Context, args *CreateInsightSeriesAlertArgs) (InsightSeriesAlertResolver, error)
+	DeleteInsightSeriesAlert(ctx context.Context, args *DeleteInsightSeriesAlertArgs) (*EmptyResponse, error)
 }
 
 type SearchInsightLivePreviewArgs struct {
@@ -239,6 +244,7 @@ type SearchInsightDataSeriesDefinitionResolver interface {
 	GeneratedFromCaptureGroups() (bool, error)
 	IsCalculated() (bool, error)
 	GroupBy() (*string, error)
+	GeneratedFromPreciseReferences() (bool, error)
 }
 
 type InsightPresentation interface {
@@ -422,13 +428,14 @@ type InsightViewFiltersInput struct {
 }
 
 type LineChartSearchInsightDataSeriesInput struct {
-	SeriesId                   *string
-	Query                      string
-	TimeScope                  *TimeScopeInput
-	RepositoryScope            *RepositoryScopeInput
-	Options                    LineChartDataSeriesOptionsInput
-	GeneratedFromCaptureGroups *bool
-	GroupBy                    *string
+	SeriesId                       *string
+	Query                          string
+	TimeScope                      *TimeScopeInput
+	RepositoryScope                *RepositoryScopeInput
+	Options                        LineChartDataSeriesOptionsInput
+	GeneratedFromCaptureGroups     *bool
+	GroupBy                        *string
+	GeneratedFromPreciseReferences *bool
 }
 
 type LineChartDataSeriesOptionsInput struct {
@@ -587,3 +594,53 @@ type AdminBackfillQueueArgs struct {
 	States     *[]string
 	TextSearch *string
 }
+
+type InsightSeriesAlertsArgs struct {
+	InsightViewID graphql.ID
+}
+
+type CreateInsightSeriesAlertArgs struct {
+	Input CreateInsightSeriesAlertInput
+}
+
+type CreateInsightSeriesAlertInput struct {
+	InsightViewID   graphql.ID
+	SeriesID        string
+	Kind            string // enum
+	Threshold       *float64
+	Direction       *string // enum
+	Email           *bool
+	SlackWebhookURL *string
+	WebhookURL      *string
+}
+
+type DeleteInsightSeriesAlertArgs struct {
+	ID graphql.ID
+}
+
+type InsightSeriesAlertResolver interface {
+	ID() graphql.ID
+	SeriesID() string
+	Kind() string // enum
+	Threshold() float64
+	Direction() *string // enum
+	Email() bool
+	SlackWebhookURL() *string
+	WebhookURL() *string
+	CreatedAt() gqlutil.DateTime
+	Events(ctx context.Context, args *InsightSeriesAlertEventsArgs) ([]InsightSeriesAlertEventResolver, error)
+}
+
+type InsightSeriesAlertEventsArgs struct {
+	First int32
+}
+
+type InsightSeriesAlertEventResolver interface {
+	RecordingTime() gqlutil.DateTime
+	Capture() *string
+	Value() float64
+	PreviousValue() *float64
+	Message() string
+	DeliveryError() *string
+	CreatedAt() gqlutil.DateTime
+}
@@ -322,6 +322,11 @@ func (r *NodeResolver) ToExecutorSecretAccessLog() (*executorSecretAccessLogReso
 	return n, ok
 }
 
+func (r *NodeResolver) ToExecutorCustomJob() (*executorCustomJobResolver, bool) {
+	n, ok := r.Node.(*executorCustomJobResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToExternalServiceSyncJob() (*externalServiceSyncJobResolver, bool) {
 	n, ok := r.Node.(*externalServiceSyncJobResolver)
 	return n, ok
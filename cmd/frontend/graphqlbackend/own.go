@@ -23,6 +23,7 @@ const (
 	AssignedOwner                    OwnershipReasonType = "ASSIGNED_OWNER"
 	RecentContributorOwnershipSignal OwnershipReasonType = "RECENT_CONTRIBUTOR_OWNERSHIP_SIGNAL"
 	RecentViewOwnershipSignal        OwnershipReasonType = "RECENT_VIEW_OWNERSHIP_SIGNAL"
+	RecentReviewerOwnershipSignal    OwnershipReasonType = "RECENT_REVIEWER_OWNERSHIP_SIGNAL"
 )
 
 func (args *ListOwnershipArgs) IncludeReason(reason OwnershipReasonType) bool {
@@ -58,6 +59,9 @@ type OwnResolver interface {
 	CodeownersIngestedFiles(context.Context, *CodeownersIngestedFilesArgs) (CodeownersIngestedFileConnectionResolver, error)
 	RepoIngestedCodeowners(context.Context, api.RepoID) (CodeownersIngestedFileResolver, error)
 
+	// Ownership drift queries.
+	RepoOwnershipDriftFindings(context.Context, *RepositoryResolver, *OwnershipDriftFindingsArgs) (OwnershipDriftFindingConnectionResolver, error)
+
 	// Codeowners mutations.
 	AddCodeownersFile(context.Context, *CodeownersFileArgs) (CodeownersIngestedFileResolver, error)
 	UpdateCodeownersFile(context.Context, *CodeownersFileArgs) (CodeownersIngestedFileResolver, error)
@@ -110,6 +114,7 @@ type OwnershipReasonResolver interface {
 	ToCodeownersFileEntry() (CodeownersFileEntryResolver, bool)
 	ToRecentContributorOwnershipSignal() (RecentContributorOwnershipSignalResolver, bool)
 	ToRecentViewOwnershipSignal() (RecentViewOwnershipSignalResolver, bool)
+	ToRecentReviewerOwnershipSignal() (RecentReviewerOwnershipSignalResolver, bool)
 	ToAssignedOwner() (AssignedOwnerResolver, bool)
 }
 
@@ -135,6 +140,11 @@ type RecentViewOwnershipSignalResolver interface {
 	Description() (string, error)
 }
 
+type RecentReviewerOwnershipSignalResolver interface {
+	Title() (string, error)
+	Description() (string, error)
+}
+
 type AssignedOwnerResolver interface {
 	Title() (string, error)
 	Description() (string, error)
@@ -195,6 +205,27 @@ type CodeownersIngestedFileConnectionResolver interface {
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
 }
 
+type OwnershipDriftFindingsArgs struct {
+	Kinds *[]string
+	First *int32
+	After *string
+}
+
+type OwnershipDriftFindingResolver interface {
+	Path() string
+	Kind() string
+	Owner() string
+	Source() string
+	Description() string
+	ComputedAt() gqlutil.DateTime
+}
+
+type OwnershipDriftFindingConnectionResolver interface {
+	Nodes(ctx context.Context) ([]OwnershipDriftFindingResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+}
+
 type SignalConfigurationResolver interface {
 	Name() string
 	Description() string
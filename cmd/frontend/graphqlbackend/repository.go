@@ -739,6 +739,10 @@ func (r *RepositoryResolver) IngestedCodeowners(ctx context.Context) (Codeowners
 	return EnterpriseResolvers.ownResolver.RepoIngestedCodeowners(ctx, r.IDInt32())
 }
 
+func (r *RepositoryResolver) OwnershipDriftFindings(ctx context.Context, args *OwnershipDriftFindingsArgs) (OwnershipDriftFindingConnectionResolver, error) {
+	return EnterpriseResolvers.ownResolver.RepoOwnershipDriftFindings(ctx, r, args)
+}
+
 // isPerforceDepot is a helper to avoid the repetitive error handling of calling r.SourceType, and
 // where we want to only take a custom action if this function returns true. For false we want to
 // ignore and continue on the default behaviour.
@@ -110,6 +110,11 @@ var guardrailsSchema string
 //go:embed content_library.graphql
 var contentLibrary string
 
+// deadLetterSchema is the dead-letter queues raw graphql schema.
+//
+//go:embed dead_letter.graphql
+var deadLetterSchema string
+
 // searchJobSchema is the Sourcegraph Search Job raw graphql schema.
 //
 //go:embed search_jobs.graphql
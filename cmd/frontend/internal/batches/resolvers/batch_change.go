@@ -270,6 +270,41 @@ func (r *batchChangeResolver) ChangesetCountsOverTime(
 	return resolvers, nil
 }
 
+func (r *batchChangeResolver) RolloutTiers(ctx context.Context) ([]graphqlbackend.ChangesetRolloutTierCountsResolver, error) {
+	tiers, err := r.store.ListChangesetRolloutTiers(ctx, r.batchChange.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := []graphqlbackend.ChangesetRolloutTierCountsResolver{}
+	// Batch changes without a rollout only have changesets in tier 0.
+	hasRollout := false
+	for _, tier := range tiers {
+		hasRollout = hasRollout || tier > 0
+	}
+	if !hasRollout {
+		return resolvers, nil
+	}
+
+	cs, _, err := r.store.ListChangesets(ctx, store.ListChangesetsOpts{BatchChangeID: r.batchChange.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range state.CalcRolloutTierCounts(cs, tiers) {
+		resolvers = append(resolvers, &changesetRolloutTierCountsResolver{counts: c})
+	}
+	return resolvers, nil
+}
+
+func (r *batchChangeResolver) ImpactAnalytics(ctx context.Context) (graphqlbackend.BatchChangeImpactAnalyticsResolver, error) {
+	stats, err := r.store.ListBatchChangeImpactStats(ctx, r.batchChange.ID)
+	if err != nil {
+		return nil, err
+	}
+	return newBatchChangeImpactAnalyticsResolver(stats), nil
+}
+
 func (r *batchChangeResolver) DiffStat(ctx context.Context) (*graphqlbackend.DiffStat, error) {
 	diffStat, err := r.store.GetBatchChangeDiffStat(ctx, store.GetBatchChangeDiffStatOpts{BatchChangeID: r.batchChange.ID})
 	if err != nil {
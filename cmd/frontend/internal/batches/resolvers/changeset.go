@@ -16,6 +16,7 @@ import (
 	sgactor "github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	bgql "github.com/sourcegraph/sourcegraph/internal/batches/graphql"
+	"github.com/sourcegraph/sourcegraph/internal/batches/service"
 	"github.com/sourcegraph/sourcegraph/internal/batches/state"
 	"github.com/sourcegraph/sourcegraph/internal/batches/store"
 	"github.com/sourcegraph/sourcegraph/internal/batches/syncer"
@@ -434,6 +435,44 @@ func (r *changesetResolver) CurrentSpec(ctx context.Context) (graphqlbackend.Vis
 	return NewChangesetSpecResolverWithRepo(r.store, r.repo, spec), nil
 }
 
+func (r *changesetResolver) AutoMergeDecision(ctx context.Context) (graphqlbackend.ChangesetAutoMergeDecisionResolver, error) {
+	if r.changeset.OwnedByBatchChangeID == 0 {
+		return nil, nil
+	}
+
+	decision, err := r.store.GetChangesetAutoMergeDecision(ctx, r.changeset.ID)
+	if err == store.ErrNoResults {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &changesetAutoMergeDecisionResolver{decision: decision}, nil
+}
+
+func (r *changesetResolver) PublicationBlockedReason(ctx context.Context) (*string, error) {
+	reason, err := service.New(r.store).PublicationBlockedReason(ctx, r.changeset)
+	if err != nil || reason == "" {
+		return nil, err
+	}
+	return &reason, nil
+}
+
+func (r *changesetResolver) Impact(ctx context.Context) (graphqlbackend.ChangesetImpactMetricsResolver, error) {
+	if !r.changeset.Published() {
+		return nil, nil
+	}
+
+	metrics, err := r.store.GetChangesetImpactMetrics(ctx, r.changeset.ID)
+	if err == store.ErrNoResults {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &changesetImpactMetricsResolver{metrics: metrics}, nil
+}
+
 func (r *changesetResolver) Labels(ctx context.Context) ([]graphqlbackend.ChangesetLabelResolver, error) {
 	if !r.changeset.Published() {
 		return []graphqlbackend.ChangesetLabelResolver{}, nil
@@ -608,3 +647,21 @@ func (r *gitHubCommitVerificationResolver) Signature() string {
 func (r *gitHubCommitVerificationResolver) Payload() string {
 	return r.commitVerification.Payload
 }
+
+var _ graphqlbackend.ChangesetAutoMergeDecisionResolver = &changesetAutoMergeDecisionResolver{}
+
+type changesetAutoMergeDecisionResolver struct {
+	decision *btypes.ChangesetAutoMergeDecision
+}
+
+func (r *changesetAutoMergeDecisionResolver) Outcome() string {
+	return string(r.decision.Outcome)
+}
+
+func (r *changesetAutoMergeDecisionResolver) Reason() string {
+	return r.decision.Reason
+}
+
+func (r *changesetAutoMergeDecisionResolver) EvaluatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.decision.EvaluatedAt}
+}
@@ -20,3 +20,16 @@ func (r *changesetCountsResolver) Open() int32                 { return r.counts
 func (r *changesetCountsResolver) OpenApproved() int32         { return r.counts.OpenApproved }
 func (r *changesetCountsResolver) OpenChangesRequested() int32 { return r.counts.OpenChangesRequested }
 func (r *changesetCountsResolver) OpenPending() int32          { return r.counts.OpenPending }
+
+type changesetRolloutTierCountsResolver struct {
+	counts *state.RolloutTierCounts
+}
+
+func (r *changesetRolloutTierCountsResolver) Tier() int32        { return r.counts.Tier }
+func (r *changesetRolloutTierCountsResolver) Total() int32       { return r.counts.Total }
+func (r *changesetRolloutTierCountsResolver) Unpublished() int32 { return r.counts.Unpublished }
+func (r *changesetRolloutTierCountsResolver) Draft() int32       { return r.counts.Draft }
+func (r *changesetRolloutTierCountsResolver) Open() int32        { return r.counts.Open }
+func (r *changesetRolloutTierCountsResolver) Merged() int32      { return r.counts.Merged }
+func (r *changesetRolloutTierCountsResolver) Closed() int32      { return r.counts.Closed }
+func (r *changesetRolloutTierCountsResolver) Blocked() int32     { return r.counts.Blocked }
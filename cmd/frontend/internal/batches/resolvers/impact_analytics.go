@@ -0,0 +1,165 @@
+package resolvers
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+// newBatchChangeImpactAnalyticsResolver returns the resolver for the given
+// impact stats of a batch change, or nil if there are no stats of all
+// changesets because the analytics weren't computed yet.
+func newBatchChangeImpactAnalyticsResolver(stats []*btypes.BatchChangeImpactStats) graphqlbackend.BatchChangeImpactAnalyticsResolver {
+	r := &batchChangeImpactAnalyticsResolver{
+		byCodeHost: []graphqlbackend.BatchChangeImpactStatsResolver{},
+		byTeam:     []graphqlbackend.BatchChangeImpactStatsResolver{},
+	}
+	for _, s := range stats {
+		switch s.Dimension {
+		case btypes.ImpactDimensionAll:
+			r.overall = s
+		case btypes.ImpactDimensionCodeHost:
+			r.byCodeHost = append(r.byCodeHost, &batchChangeImpactStatsResolver{stats: s})
+		case btypes.ImpactDimensionTeam:
+			r.byTeam = append(r.byTeam, &batchChangeImpactStatsResolver{stats: s})
+		}
+	}
+	if r.overall == nil {
+		return nil
+	}
+	return r
+}
+
+type batchChangeImpactAnalyticsResolver struct {
+	overall    *btypes.BatchChangeImpactStats
+	byCodeHost []graphqlbackend.BatchChangeImpactStatsResolver
+	byTeam     []graphqlbackend.BatchChangeImpactStatsResolver
+}
+
+var _ graphqlbackend.BatchChangeImpactAnalyticsResolver = &batchChangeImpactAnalyticsResolver{}
+
+func (r *batchChangeImpactAnalyticsResolver) ComputedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.overall.ComputedAt}
+}
+
+func (r *batchChangeImpactAnalyticsResolver) Overall() graphqlbackend.BatchChangeImpactStatsResolver {
+	return &batchChangeImpactStatsResolver{stats: r.overall}
+}
+
+func (r *batchChangeImpactAnalyticsResolver) ByCodeHost() []graphqlbackend.BatchChangeImpactStatsResolver {
+	return r.byCodeHost
+}
+
+func (r *batchChangeImpactAnalyticsResolver) ByTeam() []graphqlbackend.BatchChangeImpactStatsResolver {
+	return r.byTeam
+}
+
+type batchChangeImpactStatsResolver struct {
+	stats *btypes.BatchChangeImpactStats
+}
+
+var _ graphqlbackend.BatchChangeImpactStatsResolver = &batchChangeImpactStatsResolver{}
+
+func (r *batchChangeImpactStatsResolver) Value() *string {
+	if r.stats.Value == "" {
+		return nil
+	}
+	return &r.stats.Value
+}
+
+func (r *batchChangeImpactStatsResolver) Changesets() int32      { return r.stats.Changesets }
+func (r *batchChangeImpactStatsResolver) Merged() int32          { return r.stats.Merged }
+func (r *batchChangeImpactStatsResolver) Reviewed() int32        { return r.stats.Reviewed }
+func (r *batchChangeImpactStatsResolver) CheckRuns() int32       { return r.stats.CheckRuns }
+func (r *batchChangeImpactStatsResolver) FailedCheckRuns() int32 { return r.stats.FailedCheckRuns }
+func (r *batchChangeImpactStatsResolver) CIFailureRate() float64 { return r.stats.CIFailureRate() }
+
+func (r *batchChangeImpactStatsResolver) TimeToMergeP50() *int32 {
+	return percentileSeconds(r.stats.TimeToMergeP50, r.stats.Merged)
+}
+
+func (r *batchChangeImpactStatsResolver) TimeToMergeP90() *int32 {
+	return percentileSeconds(r.stats.TimeToMergeP90, r.stats.Merged)
+}
+
+func (r *batchChangeImpactStatsResolver) ReviewLatencyP50() *int32 {
+	return percentileSeconds(r.stats.ReviewLatencyP50, r.stats.Reviewed)
+}
+
+func (r *batchChangeImpactStatsResolver) ReviewLatencyP90() *int32 {
+	return percentileSeconds(r.stats.ReviewLatencyP90, r.stats.Reviewed)
+}
+
+func (r *batchChangeImpactStatsResolver) TimeToMergeDistribution() []graphqlbackend.TimeToMergeBucketResolver {
+	buckets := make([]graphqlbackend.TimeToMergeBucketResolver, 0, len(r.stats.TimeToMergeBuckets))
+	for i, count := range r.stats.TimeToMergeBuckets {
+		bucket := &timeToMergeBucketResolver{count: count}
+		if i < len(btypes.TimeToMergeBucketBounds) {
+			bucket.maxSeconds = durationSeconds(btypes.TimeToMergeBucketBounds[i])
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+type timeToMergeBucketResolver struct {
+	maxSeconds *int32
+	count      int32
+}
+
+var _ graphqlbackend.TimeToMergeBucketResolver = &timeToMergeBucketResolver{}
+
+func (r *timeToMergeBucketResolver) MaxSeconds() *int32 { return r.maxSeconds }
+func (r *timeToMergeBucketResolver) Count() int32       { return r.count }
+
+type changesetImpactMetricsResolver struct {
+	metrics *btypes.ChangesetImpactMetrics
+}
+
+var _ graphqlbackend.ChangesetImpactMetricsResolver = &changesetImpactMetricsResolver{}
+
+func (r *changesetImpactMetricsResolver) CodeHost() string { return r.metrics.CodeHost }
+
+func (r *changesetImpactMetricsResolver) Team() *string {
+	if r.metrics.Team == "" {
+		return nil
+	}
+	return &r.metrics.Team
+}
+
+func (r *changesetImpactMetricsResolver) TimeToMerge() *int32 {
+	if d, ok := r.metrics.TimeToMerge(); ok {
+		return durationSeconds(d)
+	}
+	return nil
+}
+
+func (r *changesetImpactMetricsResolver) ReviewLatency() *int32 {
+	if d, ok := r.metrics.ReviewLatency(); ok {
+		return durationSeconds(d)
+	}
+	return nil
+}
+
+func (r *changesetImpactMetricsResolver) CheckRuns() int32       { return r.metrics.CheckRuns }
+func (r *changesetImpactMetricsResolver) FailedCheckRuns() int32 { return r.metrics.FailedCheckRuns }
+
+func (r *changesetImpactMetricsResolver) ComputedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.metrics.ComputedAt}
+}
+
+// percentileSeconds returns the percentile d in seconds, or nil if there are
+// no changesets the percentile was computed from.
+func percentileSeconds(d time.Duration, count int32) *int32 {
+	if count == 0 {
+		return nil
+	}
+	return durationSeconds(d)
+}
+
+func durationSeconds(d time.Duration) *int32 {
+	seconds := int32(d / time.Second)
+	return &seconds
+}
@@ -0,0 +1,84 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+func TestBatchChangeImpactAnalyticsResolver(t *testing.T) {
+	if r := newBatchChangeImpactAnalyticsResolver(nil); r != nil {
+		t.Fatalf("resolver returned for batch change without stats: %+v", r)
+	}
+
+	computedAt := time.Date(2023, 12, 1, 12, 0, 0, 0, time.UTC)
+	r := newBatchChangeImpactAnalyticsResolver([]*btypes.BatchChangeImpactStats{
+		{
+			Dimension:          btypes.ImpactDimensionAll,
+			Changesets:         3,
+			Merged:             2,
+			TimeToMergeP50:     2 * time.Hour,
+			TimeToMergeP90:     50 * time.Hour,
+			TimeToMergeBuckets: []int32{0, 1, 0, 1, 0},
+			CheckRuns:          4,
+			FailedCheckRuns:    1,
+			ComputedAt:         computedAt,
+		},
+		{Dimension: btypes.ImpactDimensionCodeHost, Value: extsvc.TypeGitHub, Changesets: 3, ComputedAt: computedAt},
+		{Dimension: btypes.ImpactDimensionTeam, Changesets: 1, ComputedAt: computedAt},
+		{Dimension: btypes.ImpactDimensionTeam, Value: "frontend", Changesets: 2, ComputedAt: computedAt},
+	})
+	if r == nil {
+		t.Fatal("no resolver returned")
+	}
+
+	if have := r.ComputedAt().Time; !have.Equal(computedAt) {
+		t.Fatalf("wrong computedAt. want=%s, have=%s", computedAt, have)
+	}
+
+	overall := r.Overall()
+	if overall.Value() != nil {
+		t.Fatalf("overall stats have a value: %q", *overall.Value())
+	}
+	if have := overall.TimeToMergeP50(); have == nil || *have != 7200 {
+		t.Fatalf("wrong timeToMergeP50: %v", have)
+	}
+	if have := overall.ReviewLatencyP50(); have != nil {
+		t.Fatalf("reviewLatencyP50 set without reviewed changesets: %d", *have)
+	}
+	if have, want := overall.CIFailureRate(), 0.25; have != want {
+		t.Fatalf("wrong ciFailureRate. want=%f, have=%f", want, have)
+	}
+
+	type bucket struct {
+		MaxSeconds *int32
+		Count      int32
+	}
+	seconds := func(d time.Duration) *int32 { s := int32(d / time.Second); return &s }
+	var haveBuckets []bucket
+	for _, b := range overall.TimeToMergeDistribution() {
+		haveBuckets = append(haveBuckets, bucket{MaxSeconds: b.MaxSeconds(), Count: b.Count()})
+	}
+	wantBuckets := []bucket{
+		{MaxSeconds: seconds(time.Hour)},
+		{MaxSeconds: seconds(24 * time.Hour), Count: 1},
+		{MaxSeconds: seconds(7 * 24 * time.Hour)},
+		{MaxSeconds: seconds(30 * 24 * time.Hour), Count: 1},
+		{},
+	}
+	if diff := cmp.Diff(wantBuckets, haveBuckets); diff != "" {
+		t.Fatalf("wrong distribution (-want +have):\n%s", diff)
+	}
+
+	if have := len(r.ByCodeHost()); have != 1 {
+		t.Fatalf("wrong number of code host stats. want=1, have=%d", have)
+	}
+	byTeam := r.ByTeam()
+	if len(byTeam) != 2 || byTeam[0].Value() != nil || *byTeam[1].Value() != "frontend" {
+		t.Fatalf("wrong team stats: %+v", byTeam)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/global"
 	"github.com/sourcegraph/sourcegraph/internal/batches/state"
 	"github.com/sourcegraph/sourcegraph/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
@@ -172,11 +173,20 @@ func (h webhook) upsertChangesetEvent(
 	events, _, err := tx.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{
 		ChangesetIDs: []int64{cs.ID},
 	})
+	previousExternalState := cs.ExternalState
 	state.SetDerivedState(ctx, tx.Repos(), h.gitserverClient, cs, events)
 	if err := tx.UpdateChangesetCodeHostState(ctx, cs); err != nil {
 		return err
 	}
 
+	if cs.OwnedByBatchChangeID != 0 && !cs.ExternalState.BlocksRollout() && previousExternalState.BlocksRollout() {
+		// Merging or closing the changeset can unblock the publication of the
+		// changesets in higher rollout tiers of its batch change.
+		if _, err := tx.EnqueueRolloutBlockedChangesets(ctx, cs.OwnedByBatchChangeID, global.DefaultReconcilerEnqueueState()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
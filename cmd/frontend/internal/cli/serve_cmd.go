@@ -279,10 +279,14 @@ func makeExternalAPI(db database.DB, logger sglog.Logger, schema *graphql.Schema
 			BatchesChangesFileUploadHandler: enterprise.BatchesChangesFileUploadHandler,
 			SCIMHandler:                     enterprise.SCIMHandler,
 			NewCodeIntelUploadHandler:       enterprise.NewCodeIntelUploadHandler,
+			CodeIntelLSPHandler:             enterprise.CodeIntelLSPHandler,
+			CodeIntelExportHandler:          enterprise.CodeIntelExportHandler,
 			NewComputeStreamHandler:         enterprise.NewComputeStreamHandler,
 			CodeInsightsDataExportHandler:   enterprise.CodeInsightsDataExportHandler,
+			CodeInsightsMetricsHandler:      enterprise.CodeInsightsMetricsHandler,
 			SearchJobsDataExportHandler:     enterprise.SearchJobsDataExportHandler,
 			SearchJobsLogsHandler:           enterprise.SearchJobsLogsHandler,
+			ExecutorLogStreamHandler:        enterprise.ExecutorLogStreamHandler,
 			NewDotcomLicenseCheckHandler:    enterprise.NewDotcomLicenseCheckHandler,
 			NewChatCompletionsStreamHandler: enterprise.NewChatCompletionsStreamHandler,
 			NewCodeCompletionsHandler:       enterprise.NewCodeCompletionsHandler,
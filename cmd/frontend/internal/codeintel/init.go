@@ -11,6 +11,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel"
 	autoindexinggraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/transport/graphql"
 	codenavgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/transport/graphql"
+	codenavlsp "github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/transport/lsp"
 	policiesgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/policies/transport/graphql"
 	rankinggraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/ranking/transport/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
@@ -91,6 +92,19 @@ func Init(
 		return err
 	}
 
+	// 🚨 SECURITY: The LSP handler is mounted behind the external API auth middleware.
+	lspHandler, err := codenavlsp.NewHandler(
+		observation.NewContext(log.Scoped("codenav.transport.lsp")),
+		codeIntelServices.CodenavService,
+		repoStore,
+		codeIntelServices.GitserverClient,
+		ConfigInst.MaximumIndexesPerMonikerSearch,
+		ConfigInst.HunkCacheSize,
+	)
+	if err != nil {
+		return err
+	}
+
 	policyRootResolver := policiesgraphql.NewRootResolver(
 		scopedContext("policies"),
 		codeIntelServices.PoliciesService,
@@ -133,6 +147,8 @@ func Init(
 		rankingRootResolver,
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
+	enterpriseServices.CodeIntelLSPHandler = lspHandler
+	enterpriseServices.CodeIntelExportHandler = uploadshttp.NewExportHandler(codeIntelServices.UploadsService)
 	enterpriseServices.RankingService = codeIntelServices.RankingService
 	return nil
 }
@@ -0,0 +1,46 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/deadletter/resolvers"
+	batchesstore "github.com/sourcegraph/sourcegraph/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing"
+	codemonitorsbackground "github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/insights/background/queryrunner"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// Init initializes the given enterpriseServices to include the required resolvers for
+// inspecting, requeueing, and deleting the failed records of background job queues.
+func Init(
+	_ context.Context,
+	observationCtx *observation.Context,
+	db database.DB,
+	codeintelServices codeintel.Services,
+	_ conftypes.UnifiedWatchable,
+	enterpriseServices *enterprise.Services,
+) error {
+	// Queues are named after their tables. Only queues whose records can be deleted without
+	// affecting other data are registered: the records of the batch changes reconciler are the
+	// changesets themselves, failed workspace jobs are part of the batch spec they ran for, and
+	// search jobs own the result files they uploaded. Failed uploads are soft deleted, so that
+	// the codeintel janitors clean up their data.
+	queues := map[string]dbworkerstore.DeadLetterStore{
+		"changeset_jobs":             batchesstore.NewBulkOperationWorkerStore(observationCtx, db.Handle()),
+		"lsif_uploads":               codeintelServices.UploadsService.DeadLetterStore(observationCtx),
+		"lsif_indexes":               dbworkerstore.New(observationCtx, db.Handle(), autoindexing.IndexWorkerStoreOptions),
+		"insights_query_runner_jobs": queryrunner.CreateDBWorkerStore(observationCtx, basestore.NewWithHandle(db.Handle())),
+		"cm_trigger_jobs":            codemonitorsbackground.CreateDBWorkerStoreForTriggerJobs(observationCtx, db.CodeMonitors()),
+		"cm_action_jobs":             codemonitorsbackground.CreateDBWorkerStoreForActionJobs(observationCtx, db.CodeMonitors()),
+	}
+
+	enterpriseServices.DeadLetterResolver = resolvers.New(db, queues)
+	return nil
+}
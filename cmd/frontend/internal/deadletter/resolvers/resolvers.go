@@ -0,0 +1,170 @@
+package resolvers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type Resolver struct {
+	db     database.DB
+	queues map[string]dbworkerstore.DeadLetterStore
+}
+
+// New returns a resolver managing the failed records of the given queues, by name.
+func New(db database.DB, queues map[string]dbworkerstore.DeadLetterStore) *Resolver {
+	return &Resolver{db: db, queues: queues}
+}
+
+var _ graphqlbackend.DeadLetterResolver = &Resolver{}
+
+func (r *Resolver) DeadLetterQueues(ctx context.Context) ([]graphqlbackend.DeadLetterQueueResolver, error) {
+	// 🚨 SECURITY: Only site admins may manage the failed records of background jobs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(r.queues))
+	for name := range r.queues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resolvers := make([]graphqlbackend.DeadLetterQueueResolver, 0, len(names))
+	for _, name := range names {
+		resolvers = append(resolvers, &queueResolver{name: name, store: r.queues[name]})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) DeadLetterRecords(ctx context.Context, args *graphqlbackend.DeadLetterRecordsArgs) (graphqlbackend.DeadLetterRecordConnectionResolver, error) {
+	// 🚨 SECURITY: Only site admins may manage the failed records of background jobs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	store, err := r.queue(args.Queue)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := failedRecordsOptions(args.FailureMessage, args.FailedBefore, &args.First)
+	records, err := store.ListFailed(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	totalCount, err := store.CountFailed(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordConnectionResolver{records: records, totalCount: totalCount}, nil
+}
+
+func (r *Resolver) RequeueDeadLetterRecords(ctx context.Context, args *graphqlbackend.DeadLetterRecordsMutationArgs) (int32, error) {
+	// 🚨 SECURITY: Only site admins may manage the failed records of background jobs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return 0, err
+	}
+
+	store, err := r.queue(args.Queue)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := store.RequeueFailed(ctx, failedRecordsOptions(args.FailureMessage, args.FailedBefore, args.Limit))
+	return int32(count), err
+}
+
+func (r *Resolver) DeleteDeadLetterRecords(ctx context.Context, args *graphqlbackend.DeadLetterRecordsMutationArgs) (int32, error) {
+	// 🚨 SECURITY: Only site admins may manage the failed records of background jobs.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return 0, err
+	}
+
+	store, err := r.queue(args.Queue)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := store.DeleteFailed(ctx, failedRecordsOptions(args.FailureMessage, args.FailedBefore, args.Limit))
+	return int32(count), err
+}
+
+func (r *Resolver) queue(name string) (dbworkerstore.DeadLetterStore, error) {
+	store, ok := r.queues[name]
+	if !ok {
+		return nil, errors.Newf("unknown queue %q", name)
+	}
+	return store, nil
+}
+
+func failedRecordsOptions(failureMessage *string, failedBefore *gqlutil.DateTime, limit *int32) dbworkerstore.FailedRecordsOptions {
+	var opts dbworkerstore.FailedRecordsOptions
+	if failureMessage != nil {
+		opts.FailureMessage = *failureMessage
+	}
+	if failedBefore != nil {
+		opts.FailedBefore = failedBefore.Time
+	}
+	if limit != nil {
+		opts.Limit = int(*limit)
+	}
+	return opts
+}
+
+type queueResolver struct {
+	name  string
+	store dbworkerstore.DeadLetterStore
+}
+
+func (r *queueResolver) Name() string { return r.name }
+
+func (r *queueResolver) FailedCount(ctx context.Context) (int32, error) {
+	count, err := r.store.CountFailed(ctx, dbworkerstore.FailedRecordsOptions{})
+	return int32(count), err
+}
+
+type recordConnectionResolver struct {
+	records    []dbworkerstore.FailedRecord
+	totalCount int
+}
+
+func (r *recordConnectionResolver) Nodes() []graphqlbackend.DeadLetterRecordResolver {
+	resolvers := make([]graphqlbackend.DeadLetterRecordResolver, 0, len(r.records))
+	for _, record := range r.records {
+		resolvers = append(resolvers, &recordResolver{record: record})
+	}
+	return resolvers
+}
+
+func (r *recordConnectionResolver) TotalCount() int32 { return int32(r.totalCount) }
+
+type recordResolver struct {
+	record dbworkerstore.FailedRecord
+}
+
+func (r *recordResolver) ID() int32          { return int32(r.record.ID) }
+func (r *recordResolver) NumFailures() int32 { return int32(r.record.NumFailures) }
+func (r *recordResolver) NumResets() int32   { return int32(r.record.NumResets) }
+
+func (r *recordResolver) FailureMessage() *string {
+	if r.record.FailureMessage == "" {
+		return nil
+	}
+	return &r.record.FailureMessage
+}
+
+func (r *recordResolver) QueuedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.record.QueuedAt)
+}
+
+func (r *recordResolver) FinishedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.record.FinishedAt)
+}
@@ -0,0 +1,201 @@
+package resolvers
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	mockassert "github.com/derision-test/go-mockgen/testutil/assert"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	dbworkerstoremocks "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+type testRecord struct {
+	id int
+}
+
+func (r testRecord) RecordID() int { return r.id }
+
+func (r testRecord) RecordUID() string {
+	return strconv.Itoa(r.id)
+}
+
+func TestDeadLetterResolver_NonSiteAdmin(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	store := dbworkerstoremocks.NewMockStore[testRecord]()
+	r := New(db, map[string]dbworkerstore.DeadLetterStore{"test_jobs": store})
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	_, err := r.DeadLetterQueues(ctx)
+	assert.ErrorIs(t, err, auth.ErrMustBeSiteAdmin)
+	_, err = r.DeadLetterRecords(ctx, &graphqlbackend.DeadLetterRecordsArgs{Queue: "test_jobs", First: 50})
+	assert.ErrorIs(t, err, auth.ErrMustBeSiteAdmin)
+	_, err = r.RequeueDeadLetterRecords(ctx, &graphqlbackend.DeadLetterRecordsMutationArgs{Queue: "test_jobs"})
+	assert.ErrorIs(t, err, auth.ErrMustBeSiteAdmin)
+	_, err = r.DeleteDeadLetterRecords(ctx, &graphqlbackend.DeadLetterRecordsMutationArgs{Queue: "test_jobs"})
+	assert.ErrorIs(t, err, auth.ErrMustBeSiteAdmin)
+
+	mockassert.NotCalled(t, store.ListFailedFunc)
+	mockassert.NotCalled(t, store.RequeueFailedFunc)
+	mockassert.NotCalled(t, store.DeleteFailedFunc)
+}
+
+func TestDeadLetterResolver(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	failedAt := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	queuedAt := failedAt.Add(-time.Hour)
+	fooStore := dbworkerstoremocks.NewMockStore[testRecord]()
+	fooStore.CountFailedFunc.SetDefaultReturn(3, nil)
+	fooStore.ListFailedFunc.SetDefaultReturn([]dbworkerstore.FailedRecord{
+		{ID: 7, FailureMessage: "timeout", NumFailures: 3, QueuedAt: &queuedAt, FinishedAt: &failedAt},
+	}, nil)
+	fooStore.RequeueFailedFunc.SetDefaultReturn(2, nil)
+	fooStore.DeleteFailedFunc.SetDefaultReturn(1, nil)
+	barStore := dbworkerstoremocks.NewMockStore[testRecord]()
+
+	r := New(db, map[string]dbworkerstore.DeadLetterStore{"foo_jobs": fooStore, "bar_jobs": barStore})
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+
+	t.Run("queues", func(t *testing.T) {
+		queues, err := r.DeadLetterQueues(ctx)
+		require.NoError(t, err)
+		var names []string
+		for _, q := range queues {
+			names = append(names, q.Name())
+		}
+		assert.Equal(t, []string{"bar_jobs", "foo_jobs"}, names)
+
+		count, err := queues[1].FailedCount(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), count)
+	})
+
+	t.Run("records", func(t *testing.T) {
+		connection, err := r.DeadLetterRecords(ctx, &graphqlbackend.DeadLetterRecordsArgs{
+			Queue:          "foo_jobs",
+			FailureMessage: pointers.Ptr("time"),
+			FailedBefore:   &gqlutil.DateTime{Time: failedAt.Add(time.Hour)},
+			First:          10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), connection.TotalCount())
+		nodes := connection.Nodes()
+		require.Len(t, nodes, 1)
+		assert.Equal(t, int32(7), nodes[0].ID())
+		assert.Equal(t, pointers.Ptr("timeout"), nodes[0].FailureMessage())
+		assert.Equal(t, int32(3), nodes[0].NumFailures())
+
+		expectedOpts := dbworkerstore.FailedRecordsOptions{FailureMessage: "time", FailedBefore: failedAt.Add(time.Hour), Limit: 10}
+		mockassert.CalledOnce(t, fooStore.ListFailedFunc)
+		if diff := cmp.Diff(expectedOpts, fooStore.ListFailedFunc.History()[0].Arg1); diff != "" {
+			t.Errorf("unexpected options (-want +got):\n%s", diff)
+		}
+		mockassert.NotCalled(t, barStore.ListFailedFunc)
+	})
+
+	t.Run("requeue", func(t *testing.T) {
+		count, err := r.RequeueDeadLetterRecords(ctx, &graphqlbackend.DeadLetterRecordsMutationArgs{Queue: "foo_jobs", Limit: pointers.Ptr(int32(5))})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), count)
+		mockassert.CalledOnce(t, fooStore.RequeueFailedFunc)
+		assert.Equal(t, dbworkerstore.FailedRecordsOptions{Limit: 5}, fooStore.RequeueFailedFunc.History()[0].Arg1)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		count, err := r.DeleteDeadLetterRecords(ctx, &graphqlbackend.DeadLetterRecordsMutationArgs{Queue: "foo_jobs", FailureMessage: pointers.Ptr("timeout")})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), count)
+		mockassert.CalledOnce(t, fooStore.DeleteFailedFunc)
+		assert.Equal(t, dbworkerstore.FailedRecordsOptions{FailureMessage: "timeout"}, fooStore.DeleteFailedFunc.History()[0].Arg1)
+	})
+
+	t.Run("unknown queue", func(t *testing.T) {
+		_, err := r.DeleteDeadLetterRecords(ctx, &graphqlbackend.DeadLetterRecordsMutationArgs{Queue: "baz_jobs"})
+		assert.ErrorContains(t, err, `unknown queue "baz_jobs"`)
+	})
+}
+
+func TestDeadLetterSchema(t *testing.T) {
+	users := dbmocks.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+	db := dbmocks.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	failedAt := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	queuedAt := failedAt.Add(-time.Hour)
+	store := dbworkerstoremocks.NewMockStore[testRecord]()
+	store.CountFailedFunc.SetDefaultReturn(1, nil)
+	store.ListFailedFunc.SetDefaultReturn([]dbworkerstore.FailedRecord{
+		{ID: 7, FailureMessage: "timeout", NumFailures: 3, NumResets: 1, QueuedAt: &queuedAt, FinishedAt: &failedAt},
+	}, nil)
+	store.RequeueFailedFunc.SetDefaultReturn(1, nil)
+
+	schema, err := graphqlbackend.NewSchema(db, gitserver.NewMockClient(), []graphqlbackend.OptionalResolver{
+		{DeadLetterResolver: New(db, map[string]dbworkerstore.DeadLetterStore{"test_jobs": store})},
+	})
+	require.NoError(t, err)
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	graphqlbackend.RunTests(t, []*graphqlbackend.Test{
+		{
+			Context: ctx,
+			Schema:  schema,
+			Query: `
+				{
+					deadLetterQueues { name failedCount }
+					deadLetterRecords(queue: "test_jobs", failureMessage: "time", first: 10) {
+						totalCount
+						nodes { id failureMessage numFailures numResets queuedAt finishedAt }
+					}
+				}
+			`,
+			ExpectedResult: `
+				{
+					"deadLetterQueues": [{"name": "test_jobs", "failedCount": 1}],
+					"deadLetterRecords": {
+						"totalCount": 1,
+						"nodes": [{
+							"id": 7,
+							"failureMessage": "timeout",
+							"numFailures": 3,
+							"numResets": 1,
+							"queuedAt": "2023-10-01T11:00:00Z",
+							"finishedAt": "2023-10-01T12:00:00Z"
+						}]
+					}
+				}
+			`,
+		},
+		{
+			Context: ctx,
+			Schema:  schema,
+			Query: `
+				mutation {
+					requeueDeadLetterRecords(queue: "test_jobs", failedBefore: "2023-10-02T00:00:00Z")
+				}
+			`,
+			ExpectedResult: `{"requeueDeadLetterRecords": 1}`,
+		},
+	})
+}
@@ -0,0 +1,323 @@
+package executorqueue
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// autoscalingConfig configures how the recommended number of executors of a queue is computed.
+type autoscalingConfig struct {
+	env.BaseConfig
+
+	// Window is the duration of history from which arrival rates and processing durations
+	// are computed.
+	Window time.Duration
+	// TargetQueueTime is the duration within which queued jobs should start processing.
+	TargetQueueTime time.Duration
+	// JobsPerExecutor is the number of jobs a single executor processes concurrently.
+	JobsPerExecutor int
+	MinExecutors    int
+	MaxExecutors    int
+}
+
+func (c *autoscalingConfig) Load() {
+	c.Window = c.GetInterval("EXECUTORS_AUTOSCALING_WINDOW", "1h", "The duration of history from which the arrival rate and processing durations of executor jobs are computed.")
+	c.TargetQueueTime = c.GetInterval("EXECUTORS_AUTOSCALING_TARGET_QUEUE_TIME", "5m", "The duration within which queued executor jobs should start processing.")
+	c.JobsPerExecutor = c.GetInt("EXECUTORS_AUTOSCALING_JOBS_PER_EXECUTOR", "1", "The number of jobs a single executor processes concurrently, as configured by EXECUTOR_MAXIMUM_NUM_JOBS.")
+	c.MinExecutors = c.GetInt("EXECUTORS_AUTOSCALING_MIN_EXECUTORS", "0", "The minimum number of executors recommended for a queue.")
+	c.MaxExecutors = c.GetInt("EXECUTORS_AUTOSCALING_MAX_EXECUTORS", "10", "The maximum number of executors recommended for a queue.")
+
+	if c.Window <= 0 {
+		c.AddError(errors.New("EXECUTORS_AUTOSCALING_WINDOW must be positive"))
+	}
+	if c.TargetQueueTime <= 0 {
+		c.AddError(errors.New("EXECUTORS_AUTOSCALING_TARGET_QUEUE_TIME must be positive"))
+	}
+	if c.JobsPerExecutor <= 0 {
+		c.AddError(errors.New("EXECUTORS_AUTOSCALING_JOBS_PER_EXECUTOR must be positive"))
+	}
+	if c.MinExecutors < 0 {
+		c.AddError(errors.New("EXECUTORS_AUTOSCALING_MIN_EXECUTORS must not be negative"))
+	}
+	if c.MaxExecutors < c.MinExecutors {
+		c.AddError(errors.New("EXECUTORS_AUTOSCALING_MAX_EXECUTORS must not be less than EXECUTORS_AUTOSCALING_MIN_EXECUTORS"))
+	}
+}
+
+var autoscalingConfigInst = &autoscalingConfig{}
+
+// queueStatisticsStore is the part of the dbworker store of a queue used by the autoscaler.
+type queueStatisticsStore interface {
+	QueueStatistics(ctx context.Context, window time.Duration) (store.QueueStatistics, error)
+}
+
+// autoscalingRecommendation is the recommended number of executors for a queue, along with the
+// statistics it is based on.
+type autoscalingRecommendation struct {
+	Queue           string `json:"queue"`
+	Queued          int    `json:"queued"`
+	Processing      int    `json:"processing"`
+	ActiveExecutors int    `json:"activeExecutors"`
+	// ArrivalRate is the number of jobs queued per second within the window.
+	ArrivalRate float64 `json:"arrivalRate"`
+	// MeanProcessingSeconds and P95ProcessingSeconds are null if no job finished within the window.
+	MeanProcessingSeconds *float64 `json:"meanProcessingSeconds"`
+	P95ProcessingSeconds  *float64 `json:"p95ProcessingSeconds"`
+	RecommendedExecutors  int      `json:"recommendedExecutors"`
+	// ForecastQueueTimeSeconds is the time a job queued now is expected to wait with the active
+	// executors. It is null if it cannot be forecast, because no job finished within the window
+	// or because no executor is active while jobs are waiting.
+	ForecastQueueTimeSeconds *float64 `json:"forecastQueueTimeSeconds"`
+	// RecommendedQueueTimeSeconds is the same forecast with the recommended number of executors.
+	RecommendedQueueTimeSeconds *float64 `json:"recommendedQueueTimeSeconds"`
+}
+
+// autoscalingRefreshInterval is the duration for which recommendations are reused before the
+// statistics of the queues are queried again.
+const autoscalingRefreshInterval = 15 * time.Second
+
+// autoscaler recommends the number of executors for each queue, so that jobs start processing
+// within the target queue time. Recommendations are exported as JSON for custom scalers and as
+// Prometheus metrics, which can back a Kubernetes HPA external metric.
+type autoscaler struct {
+	logger        log.Logger
+	config        autoscalingConfig
+	stores        map[string]queueStatisticsStore
+	queueNames    []string
+	executorStore database.ExecutorStore
+	clock         glock.Clock
+
+	mu              sync.Mutex
+	recommendations []autoscalingRecommendation
+	computedAt      time.Time
+
+	recommendedExecutors *prometheus.Desc
+	forecastQueueTime    *prometheus.Desc
+	arrivalRate          *prometheus.Desc
+	processingDuration   *prometheus.Desc
+}
+
+var _ prometheus.Collector = &autoscaler{}
+
+func newAutoscaler(logger log.Logger, config autoscalingConfig, executorStore database.ExecutorStore, stores map[string]queueStatisticsStore) *autoscaler {
+	return newAutoscalerWithClock(logger, config, executorStore, stores, glock.NewRealClock())
+}
+
+func newAutoscalerWithClock(logger log.Logger, config autoscalingConfig, executorStore database.ExecutorStore, stores map[string]queueStatisticsStore, clock glock.Clock) *autoscaler {
+	queueNames := make([]string, 0, len(stores))
+	for name := range stores {
+		queueNames = append(queueNames, name)
+	}
+	sort.Strings(queueNames)
+
+	return &autoscaler{
+		logger:        logger.Scoped("autoscaler"),
+		config:        config,
+		stores:        stores,
+		queueNames:    queueNames,
+		executorStore: executorStore,
+		clock:         clock,
+
+		recommendedExecutors: prometheus.NewDesc(
+			"src_executors_autoscaling_recommended_executors",
+			"The recommended number of executors for the queue.",
+			[]string{"queue"}, nil,
+		),
+		forecastQueueTime: prometheus.NewDesc(
+			"src_executors_autoscaling_forecast_queue_time_seconds",
+			"The time a job queued now is expected to wait with the active executors of the queue.",
+			[]string{"queue"}, nil,
+		),
+		arrivalRate: prometheus.NewDesc(
+			"src_executors_autoscaling_arrival_rate",
+			"The number of jobs queued per second, averaged over the autoscaling window.",
+			[]string{"queue"}, nil,
+		),
+		processingDuration: prometheus.NewDesc(
+			"src_executors_autoscaling_mean_processing_duration_seconds",
+			"The mean processing duration of the jobs of the queue that finished within the autoscaling window.",
+			[]string{"queue"}, nil,
+		),
+	}
+}
+
+// Recommendations returns the current recommendation for each queue, ordered by queue name.
+func (a *autoscaler) Recommendations(ctx context.Context) ([]autoscalingRecommendation, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.recommendations != nil && a.clock.Since(a.computedAt) < autoscalingRefreshInterval {
+		return a.recommendations, nil
+	}
+
+	recommendations := make([]autoscalingRecommendation, 0, len(a.queueNames))
+	for _, name := range a.queueNames {
+		stats, err := a.stores[name].QueueStatistics(ctx, a.config.Window)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting statistics of queue %q", name)
+		}
+		activeExecutors, err := a.executorStore.Count(ctx, database.ExecutorStoreListOptions{Active: true, QueueName: name})
+		if err != nil {
+			return nil, errors.Wrapf(err, "counting executors of queue %q", name)
+		}
+
+		recommendations = append(recommendations, recommend(a.config, name, stats, activeExecutors))
+	}
+
+	a.recommendations = recommendations
+	a.computedAt = a.clock.Now()
+	return recommendations, nil
+}
+
+// recommend computes the recommended number of executors for a queue. The executors must keep
+// up with the arriving jobs, which by Little's law occupy arrival rate × processing duration
+// job slots on average, and drain the current backlog within the target queue time.
+func recommend(config autoscalingConfig, queue string, stats store.QueueStatistics, activeExecutors int) autoscalingRecommendation {
+	recommendation := autoscalingRecommendation{
+		Queue:           queue,
+		Queued:          stats.Queued,
+		Processing:      stats.Processing,
+		ActiveExecutors: activeExecutors,
+		ArrivalRate:     float64(stats.Arrivals) / config.Window.Seconds(),
+	}
+
+	var slots float64
+	if stats.Processed == 0 {
+		// Without a history of processing durations, offer a slot to each job.
+		slots = float64(stats.Queued + stats.Processing)
+	} else {
+		duration := stats.MeanProcessingDuration.Seconds()
+		recommendation.MeanProcessingSeconds = pointer(duration)
+		recommendation.P95ProcessingSeconds = pointer(stats.P95ProcessingDuration.Seconds())
+
+		steady := math.Max(recommendation.ArrivalRate*duration, float64(stats.Processing))
+		drain := math.Min(float64(stats.Queued)*duration/config.TargetQueueTime.Seconds(), float64(stats.Queued))
+		slots = steady + drain
+	}
+
+	// Tolerate floating point error, so that an exact multiple of the slots of an executor does
+	// not round up to another executor.
+	executors := int(math.Ceil(slots/float64(config.JobsPerExecutor) - 1e-9))
+	if executors < config.MinExecutors {
+		executors = config.MinExecutors
+	}
+	if executors > config.MaxExecutors {
+		executors = config.MaxExecutors
+	}
+	recommendation.RecommendedExecutors = executors
+
+	if stats.Processed > 0 {
+		recommendation.ForecastQueueTimeSeconds = forecastQueueTime(config, stats, activeExecutors)
+		recommendation.RecommendedQueueTimeSeconds = forecastQueueTime(config, stats, executors)
+	}
+
+	return recommendation
+}
+
+// forecastQueueTime returns the time a job queued now waits for the jobs queued before it to be
+// processed by the given number of executors, or nil if no executor would process them.
+func forecastQueueTime(config autoscalingConfig, stats store.QueueStatistics, executors int) *float64 {
+	if stats.Queued == 0 {
+		return pointer(0)
+	}
+	if executors == 0 {
+		return nil
+	}
+
+	slots := float64(executors * config.JobsPerExecutor)
+	return pointer(float64(stats.Queued) * stats.MeanProcessingDuration.Seconds() / slots)
+}
+
+func pointer(value float64) *float64 {
+	return &value
+}
+
+// autoscalingCollectTimeout is the maximum duration of querying recommendations on a scrape.
+const autoscalingCollectTimeout = 10 * time.Second
+
+func (a *autoscaler) Describe(ch chan<- *prometheus.Desc) {
+	ch <- a.recommendedExecutors
+	ch <- a.forecastQueueTime
+	ch <- a.arrivalRate
+	ch <- a.processingDuration
+}
+
+func (a *autoscaler) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(actor.WithInternalActor(context.Background()), autoscalingCollectTimeout)
+	defer cancel()
+
+	recommendations, err := a.Recommendations(ctx)
+	if err != nil {
+		a.logger.Error("failed to compute autoscaling recommendations", log.Error(err))
+		return
+	}
+
+	for _, r := range recommendations {
+		ch <- prometheus.MustNewConstMetric(a.recommendedExecutors, prometheus.GaugeValue, float64(r.RecommendedExecutors), r.Queue)
+		ch <- prometheus.MustNewConstMetric(a.arrivalRate, prometheus.GaugeValue, r.ArrivalRate, r.Queue)
+		if r.ForecastQueueTimeSeconds != nil {
+			ch <- prometheus.MustNewConstMetric(a.forecastQueueTime, prometheus.GaugeValue, *r.ForecastQueueTimeSeconds, r.Queue)
+		}
+		if r.MeanProcessingSeconds != nil {
+			ch <- prometheus.MustNewConstMetric(a.processingDuration, prometheus.GaugeValue, *r.MeanProcessingSeconds, r.Queue)
+		}
+	}
+}
+
+// handleList serves the recommendations of all queues.
+func (a *autoscaler) handleList(w http.ResponseWriter, r *http.Request) {
+	recommendations, err := a.Recommendations(r.Context())
+	if err != nil {
+		a.logger.Error("failed to compute autoscaling recommendations", log.Error(err))
+		http.Error(w, "failed to compute autoscaling recommendations", http.StatusInternalServerError)
+		return
+	}
+
+	a.writeJSON(w, recommendations)
+}
+
+// handleGet serves the recommendation of a single queue.
+func (a *autoscaler) handleGet(w http.ResponseWriter, r *http.Request) {
+	queue := mux.Vars(r)["queueName"]
+	if _, ok := a.stores[queue]; !ok {
+		http.Error(w, "unknown queue", http.StatusNotFound)
+		return
+	}
+
+	recommendations, err := a.Recommendations(r.Context())
+	if err != nil {
+		a.logger.Error("failed to compute autoscaling recommendations", log.Error(err))
+		http.Error(w, "failed to compute autoscaling recommendations", http.StatusInternalServerError)
+		return
+	}
+
+	for _, recommendation := range recommendations {
+		if recommendation.Queue == queue {
+			a.writeJSON(w, recommendation)
+			return
+		}
+	}
+}
+
+func (a *autoscaler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		a.logger.Error("failed to write autoscaling recommendations", log.Error(err))
+	}
+}
@@ -0,0 +1,215 @@
+package executorqueue
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+var testAutoscalingConfig = autoscalingConfig{
+	Window:          time.Hour,
+	TargetQueueTime: 5 * time.Minute,
+	JobsPerExecutor: 2,
+	MinExecutors:    0,
+	MaxExecutors:    10,
+}
+
+func TestRecommend(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          autoscalingConfig
+		stats           store.QueueStatistics
+		activeExecutors int
+		expected        autoscalingRecommendation
+	}{
+		{
+			name:     "Idle",
+			config:   testAutoscalingConfig,
+			expected: autoscalingRecommendation{Queue: "test"},
+		},
+		{
+			name: "Idle with minimum",
+			config: func() autoscalingConfig {
+				config := testAutoscalingConfig
+				config.MinExecutors = 1
+				return config
+			}(),
+			expected: autoscalingRecommendation{Queue: "test", RecommendedExecutors: 1},
+		},
+		{
+			name:            "No history",
+			config:          testAutoscalingConfig,
+			stats:           store.QueueStatistics{Queued: 3, Processing: 2, Arrivals: 5},
+			activeExecutors: 1,
+			expected: autoscalingRecommendation{
+				Queue:                "test",
+				Queued:               3,
+				Processing:           2,
+				ActiveExecutors:      1,
+				ArrivalRate:          5.0 / 3600,
+				RecommendedExecutors: 3,
+			},
+		},
+		{
+			name:   "Steady state",
+			config: testAutoscalingConfig,
+			stats: store.QueueStatistics{
+				Processing:             4,
+				Arrivals:               360,
+				Processed:              360,
+				MeanProcessingDuration: time.Minute,
+				P95ProcessingDuration:  2 * time.Minute,
+			},
+			activeExecutors: 3,
+			expected: autoscalingRecommendation{
+				Queue:                       "test",
+				Processing:                  4,
+				ActiveExecutors:             3,
+				ArrivalRate:                 0.1,
+				MeanProcessingSeconds:       pointer(60),
+				P95ProcessingSeconds:        pointer(120),
+				RecommendedExecutors:        3,
+				ForecastQueueTimeSeconds:    pointer(0),
+				RecommendedQueueTimeSeconds: pointer(0),
+			},
+		},
+		{
+			name:   "Backlog",
+			config: testAutoscalingConfig,
+			stats: store.QueueStatistics{
+				Queued:                 20,
+				Processing:             6,
+				Arrivals:               360,
+				Processed:              300,
+				MeanProcessingDuration: time.Minute,
+				P95ProcessingDuration:  2 * time.Minute,
+			},
+			activeExecutors: 2,
+			expected: autoscalingRecommendation{
+				Queue:                       "test",
+				Queued:                      20,
+				Processing:                  6,
+				ActiveExecutors:             2,
+				ArrivalRate:                 0.1,
+				MeanProcessingSeconds:       pointer(60),
+				P95ProcessingSeconds:        pointer(120),
+				RecommendedExecutors:        5,
+				ForecastQueueTimeSeconds:    pointer(300),
+				RecommendedQueueTimeSeconds: pointer(120),
+			},
+		},
+		{
+			name:   "Beyond the maximum without executors",
+			config: testAutoscalingConfig,
+			stats: store.QueueStatistics{
+				Queued:                 1000,
+				Processed:              10,
+				MeanProcessingDuration: 10 * time.Minute,
+				P95ProcessingDuration:  10 * time.Minute,
+			},
+			expected: autoscalingRecommendation{
+				Queue:                       "test",
+				Queued:                      1000,
+				MeanProcessingSeconds:       pointer(600),
+				P95ProcessingSeconds:        pointer(600),
+				RecommendedExecutors:        10,
+				RecommendedQueueTimeSeconds: pointer(30000),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recommendation := recommend(test.config, "test", test.stats, test.activeExecutors)
+			assert.InDelta(t, test.expected.ArrivalRate, recommendation.ArrivalRate, 1e-9)
+			recommendation.ArrivalRate = test.expected.ArrivalRate
+			assert.Equal(t, test.expected, recommendation)
+		})
+	}
+}
+
+type testStatisticsStore struct {
+	stats store.QueueStatistics
+	calls int
+}
+
+func (s *testStatisticsStore) QueueStatistics(_ context.Context, window time.Duration) (store.QueueStatistics, error) {
+	s.calls++
+	return s.stats, nil
+}
+
+func TestAutoscaler_Recommendations(t *testing.T) {
+	executorStore := dbmocks.NewMockExecutorStore()
+	executorStore.CountFunc.SetDefaultHook(func(_ context.Context, opts database.ExecutorStoreListOptions) (int, error) {
+		if opts.QueueName == "batches" {
+			return 2, nil
+		}
+		return 0, nil
+	})
+
+	batches := &testStatisticsStore{stats: store.QueueStatistics{Queued: 4}}
+	codeintel := &testStatisticsStore{}
+	clock := glock.NewMockClock()
+	a := newAutoscalerWithClock(logtest.Scoped(t), testAutoscalingConfig, executorStore, map[string]queueStatisticsStore{
+		"codeintel": codeintel,
+		"batches":   batches,
+	}, clock)
+
+	recommendations, err := a.Recommendations(context.Background())
+	require.NoError(t, err)
+	require.Len(t, recommendations, 2)
+	assert.Equal(t, "batches", recommendations[0].Queue)
+	assert.Equal(t, 2, recommendations[0].ActiveExecutors)
+	assert.Equal(t, 2, recommendations[0].RecommendedExecutors)
+	assert.Equal(t, "codeintel", recommendations[1].Queue)
+	assert.Equal(t, 0, recommendations[1].RecommendedExecutors)
+
+	// Recommendations are reused within the refresh interval.
+	batches.stats.Queued = 8
+	clock.Advance(autoscalingRefreshInterval / 2)
+	recommendations, err = a.Recommendations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, recommendations[0].RecommendedExecutors)
+	assert.Equal(t, 1, batches.calls)
+
+	clock.Advance(autoscalingRefreshInterval)
+	recommendations, err = a.Recommendations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 4, recommendations[0].RecommendedExecutors)
+	assert.Equal(t, 2, batches.calls)
+}
+
+func TestAutoscaler_HandleGet(t *testing.T) {
+	executorStore := dbmocks.NewMockExecutorStore()
+	a := newAutoscaler(logtest.Scoped(t), testAutoscalingConfig, executorStore, map[string]queueStatisticsStore{
+		"batches": &testStatisticsStore{stats: store.QueueStatistics{Queued: 3}},
+	})
+
+	router := mux.NewRouter()
+	router.Path("/{queueName}/autoscaling").HandlerFunc(a.handleGet)
+
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/batches/autoscaling", nil))
+	require.Equal(t, http.StatusOK, rw.Code)
+
+	var recommendation autoscalingRecommendation
+	require.NoError(t, json.Unmarshal(rw.Body.Bytes(), &recommendation))
+	assert.Equal(t, autoscalingRecommendation{Queue: "batches", Queued: 3, RecommendedExecutors: 2}, recommendation)
+
+	rw = httptest.NewRecorder()
+	router.ServeHTTP(rw, httptest.NewRequest(http.MethodGet, "/unknown/autoscaling", nil))
+	assert.Equal(t, http.StatusNotFound, rw.Code)
+}
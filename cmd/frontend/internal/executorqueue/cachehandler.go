@@ -0,0 +1,138 @@
+package executorqueue
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor/cache"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+)
+
+// cacheHandler serves the job caches that executors restore before and save after running
+// a job. Caches are scoped to the queue of the job, which is checked by jobAuthMiddleware.
+type cacheHandler struct {
+	logger       log.Logger
+	store        store.CacheEntryStore
+	uploadStore  uploadstore.Store
+	maxEntrySize int64
+}
+
+func newCacheHandler(logger log.Logger, cacheStore store.CacheEntryStore, uploadStore uploadstore.Store, maxEntrySize int64) *cacheHandler {
+	return &cacheHandler{
+		logger:       logger.Scoped("cache"),
+		store:        cacheStore,
+		uploadStore:  uploadStore,
+		maxEntrySize: maxEntrySize,
+	}
+}
+
+// cacheVars returns the queue and key of the requested cache. It writes an error response
+// and returns false if the key is malformed.
+func (h *cacheHandler) cacheVars(w http.ResponseWriter, r *http.Request) (queue, key string, ok bool) {
+	vars := mux.Vars(r)
+	queue, key = vars["queueName"], vars["key"]
+	if !cache.IsValidKey(key) {
+		http.Error(w, "invalid cache key", http.StatusBadRequest)
+		return "", "", false
+	}
+	return queue, key, true
+}
+
+func (h *cacheHandler) handleExists(w http.ResponseWriter, r *http.Request) {
+	queue, key, ok := h.cacheVars(w, r)
+	if !ok {
+		return
+	}
+
+	exists, err := h.store.MarkAccessed(r.Context(), queue, key)
+	if err != nil {
+		h.logger.Error("failed to look up cache", log.String("queue", queue), log.Error(err))
+		http.Error(w, "failed to look up cache", http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *cacheHandler) handleGet(w http.ResponseWriter, r *http.Request) {
+	queue, key, ok := h.cacheVars(w, r)
+	if !ok {
+		return
+	}
+
+	exists, err := h.store.MarkAccessed(r.Context(), queue, key)
+	if err != nil {
+		h.logger.Error("failed to look up cache", log.String("queue", queue), log.Error(err))
+		http.Error(w, "failed to look up cache", http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		http.Error(w, "cache not found", http.StatusNotFound)
+		return
+	}
+
+	content, err := h.uploadStore.Get(r.Context(), cache.ObjectKey(queue, key))
+	if err != nil {
+		h.logger.Error("failed to retrieve cache", log.String("queue", queue), log.Error(err))
+		http.Error(w, "failed to retrieve cache", http.StatusInternalServerError)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err := io.Copy(w, content); err != nil {
+		h.logger.Error("failed to write cache", log.String("queue", queue), log.Error(err))
+	}
+}
+
+func (h *cacheHandler) handlePut(w http.ResponseWriter, r *http.Request) {
+	queue, key, ok := h.cacheVars(w, r)
+	if !ok {
+		return
+	}
+
+	tooLarge := fmt.Sprintf("cache exceeds the maximum size of %d bytes", h.maxEntrySize)
+	if r.ContentLength > h.maxEntrySize {
+		http.Error(w, tooLarge, http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Executors stream caches without a content length, so also enforce the limit while
+	// reading the body. Reading one byte past the limit tells us whether it was exceeded.
+	objectKey := cache.ObjectKey(queue, key)
+	size, err := h.uploadStore.Upload(r.Context(), objectKey, io.LimitReader(r.Body, h.maxEntrySize+1))
+	if err != nil {
+		h.logger.Error("failed to upload cache", log.String("queue", queue), log.Error(err))
+		http.Error(w, "failed to upload cache", http.StatusInternalServerError)
+		return
+	}
+	if size > h.maxEntrySize {
+		// The upload replaced any previous archive under the same key, so forget about
+		// the cache entirely.
+		if err := h.uploadStore.Delete(r.Context(), objectKey); err != nil {
+			h.logger.Error("failed to delete oversized cache", log.String("queue", queue), log.Error(err))
+		}
+		if err := h.store.Delete(r.Context(), queue, key); err != nil {
+			h.logger.Error("failed to delete oversized cache", log.String("queue", queue), log.Error(err))
+		}
+		http.Error(w, tooLarge, http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err := h.store.Upsert(r.Context(), queue, key, size); err != nil {
+		h.logger.Error("failed to record cache", log.String("queue", queue), log.Error(err))
+		http.Error(w, "failed to record cache", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,183 @@
+package executorqueue
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+)
+
+const testCacheKey = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+func TestCacheHandler(t *testing.T) {
+	tests := []struct {
+		name                 string
+		method               string
+		key                  string
+		body                 string
+		mockFunc             func(cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore)
+		expectedStatusCode   int
+		expectedResponseBody string
+		assertionFunc        func(t *testing.T, cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore)
+	}{
+		{
+			name:   "Exists",
+			method: http.MethodHead,
+			key:    testCacheKey,
+			mockFunc: func(cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				cacheStore.MarkAccessedFunc.PushReturn(true, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			assertionFunc: func(t *testing.T, cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				require.Len(t, cacheStore.MarkAccessedFunc.History(), 1)
+				assert.Equal(t, "codeintel", cacheStore.MarkAccessedFunc.History()[0].Arg1)
+				assert.Equal(t, testCacheKey, cacheStore.MarkAccessedFunc.History()[0].Arg2)
+			},
+		},
+		{
+			name:               "Does not exist",
+			method:             http.MethodHead,
+			key:                testCacheKey,
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "Get",
+			method: http.MethodGet,
+			key:    testCacheKey,
+			mockFunc: func(cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				cacheStore.MarkAccessedFunc.PushReturn(true, nil)
+				uploadStore.GetFunc.PushReturn(io.NopCloser(strings.NewReader("archive")), nil)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "archive",
+			assertionFunc: func(t *testing.T, cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				require.Len(t, uploadStore.GetFunc.History(), 1)
+				assert.Equal(t, "codeintel/"+testCacheKey, uploadStore.GetFunc.History()[0].Arg1)
+			},
+		},
+		{
+			name:                 "Get missing cache",
+			method:               http.MethodGet,
+			key:                  testCacheKey,
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: "cache not found\n",
+			assertionFunc: func(t *testing.T, cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				require.Len(t, uploadStore.GetFunc.History(), 0)
+			},
+		},
+		{
+			name:   "Put",
+			method: http.MethodPut,
+			key:    testCacheKey,
+			body:   "archive",
+			mockFunc: func(cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				uploadStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+					return io.Copy(io.Discard, r)
+				})
+			},
+			expectedStatusCode: http.StatusOK,
+			assertionFunc: func(t *testing.T, cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				require.Len(t, uploadStore.UploadFunc.History(), 1)
+				assert.Equal(t, "codeintel/"+testCacheKey, uploadStore.UploadFunc.History()[0].Arg1)
+				require.Len(t, cacheStore.UpsertFunc.History(), 1)
+				assert.Equal(t, "codeintel", cacheStore.UpsertFunc.History()[0].Arg1)
+				assert.Equal(t, testCacheKey, cacheStore.UpsertFunc.History()[0].Arg2)
+				assert.Equal(t, int64(7), cacheStore.UpsertFunc.History()[0].Arg3)
+			},
+		},
+		{
+			name:   "Put too large",
+			method: http.MethodPut,
+			key:    testCacheKey,
+			body:   "much too large archive",
+			mockFunc: func(cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				uploadStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+					return io.Copy(io.Discard, r)
+				})
+			},
+			expectedStatusCode:   http.StatusRequestEntityTooLarge,
+			expectedResponseBody: "cache exceeds the maximum size of 10 bytes\n",
+			assertionFunc: func(t *testing.T, cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				// The request sets a content length, so the body is never read.
+				require.Len(t, uploadStore.UploadFunc.History(), 0)
+				require.Len(t, cacheStore.UpsertFunc.History(), 0)
+			},
+		},
+		{
+			name:                 "Invalid key",
+			method:               http.MethodGet,
+			key:                  "not-a-hash",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "invalid cache key\n",
+			assertionFunc: func(t *testing.T, cacheStore *executorstore.MockCacheEntryStore, uploadStore *uploadstoremocks.MockStore) {
+				require.Len(t, cacheStore.MarkAccessedFunc.History(), 0)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cacheStore := executorstore.NewMockCacheEntryStore()
+			uploadStore := uploadstoremocks.NewMockStore()
+			if test.mockFunc != nil {
+				test.mockFunc(cacheStore, uploadStore)
+			}
+
+			h := newCacheHandler(logtest.Scoped(t), cacheStore, uploadStore, 10)
+			router := mux.NewRouter()
+			router.Path("/{queueName}/{key}").Methods(http.MethodGet).HandlerFunc(h.handleGet)
+			router.Path("/{queueName}/{key}").Methods(http.MethodHead).HandlerFunc(h.handleExists)
+			router.Path("/{queueName}/{key}").Methods(http.MethodPut).HandlerFunc(h.handlePut)
+
+			req, err := http.NewRequest(test.method, "/codeintel/"+test.key, strings.NewReader(test.body))
+			require.NoError(t, err)
+
+			rw := httptest.NewRecorder()
+			router.ServeHTTP(rw, req)
+
+			assert.Equal(t, test.expectedStatusCode, rw.Code)
+			b, err := io.ReadAll(rw.Body)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponseBody, string(b))
+
+			if test.assertionFunc != nil {
+				test.assertionFunc(t, cacheStore, uploadStore)
+			}
+		})
+	}
+}
+
+func TestCacheHandler_PutStreamedTooLarge(t *testing.T) {
+	cacheStore := executorstore.NewMockCacheEntryStore()
+	uploadStore := uploadstoremocks.NewMockStore()
+	uploadStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	})
+
+	h := newCacheHandler(logtest.Scoped(t), cacheStore, uploadStore, 10)
+	router := mux.NewRouter()
+	router.Path("/{queueName}/{key}").Methods(http.MethodPut).HandlerFunc(h.handlePut)
+
+	// Wrapping the body hides its length, like an archive streamed by an executor.
+	req, err := http.NewRequest(http.MethodPut, "/codeintel/"+testCacheKey, io.MultiReader(strings.NewReader("much too large archive")))
+	require.NoError(t, err)
+
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rw.Code)
+	require.Len(t, uploadStore.UploadFunc.History(), 1)
+	require.Len(t, uploadStore.DeleteFunc.History(), 1)
+	assert.Equal(t, "codeintel/"+testCacheKey, uploadStore.DeleteFunc.History()[0].Arg1)
+	require.Len(t, cacheStore.DeleteFunc.History(), 1)
+	require.Len(t, cacheStore.UpsertFunc.History(), 0)
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// LogSource loads the persisted execution logs of the job with the given ID on behalf of the
+// user in the context. Finished is true if no more log output will be written for the job. If
+// the job does not exist or the user cannot view it, ErrJobNotFound is returned.
+type LogSource func(ctx context.Context, jobID int) (entries []executor.ExecutionLogEntry, finished bool, err error)
+
+// ErrJobNotFound is returned by a LogSource if the job does not exist or the user cannot view it.
+var ErrJobNotFound = errors.New("job not found")
+
+// IsFinishedState returns true if a job in the given worker state will not run (again).
+func IsFinishedState(state string) bool {
+	switch state {
+	case "queued", "processing", "errored":
+		return false
+	default:
+		return true
+	}
+}
@@ -35,6 +35,7 @@ type MultiHandler struct {
 	metricsStore          metricsstore.DistributedStore
 	CodeIntelQueueHandler QueueHandler[uploadsshared.Index]
 	BatchesQueueHandler   QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]
+	CustomQueueHandler    QueueHandler[*executorstore.CustomJob]
 	DequeueCache          *rcache.Cache
 	dequeueCacheConfig    *schema.DequeueCacheConfig
 	logger                log.Logger
@@ -47,19 +48,17 @@ func NewMultiHandler(
 	metricsStore metricsstore.DistributedStore,
 	codeIntelQueueHandler QueueHandler[uploadsshared.Index],
 	batchesQueueHandler QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob],
+	customQueueHandler QueueHandler[*executorstore.CustomJob],
 ) MultiHandler {
-	siteConfig := conf.Get().SiteConfiguration
 	dequeueCache := rcache.New(executortypes.DequeueCachePrefix)
-	dequeueCacheConfig := executortypes.DequeuePropertiesPerQueue
-	if siteConfig.ExecutorsMultiqueue != nil && siteConfig.ExecutorsMultiqueue.DequeueCacheConfig != nil {
-		dequeueCacheConfig = siteConfig.ExecutorsMultiqueue.DequeueCacheConfig
-	}
+	dequeueCacheConfig := executortypes.DequeueConfig(conf.Get().SiteConfiguration)
 	multiHandler := MultiHandler{
 		executorStore:         executorStore,
 		jobTokenStore:         jobTokenStore,
 		metricsStore:          metricsStore,
 		CodeIntelQueueHandler: codeIntelQueueHandler,
 		BatchesQueueHandler:   batchesQueueHandler,
+		CustomQueueHandler:    customQueueHandler,
 		DequeueCache:          dequeueCache,
 		dequeueCacheConfig:    dequeueCacheConfig,
 		logger:                log.Scoped("executor-multi-queue-handler"),
@@ -186,6 +185,26 @@ func (m *MultiHandler) dequeue(ctx context.Context, req executortypes.DequeueReq
 			logger.Error("Failed to transform record", log.String("queue", selectedQueue), log.Error(err))
 			return executortypes.Job{}, false, err
 		}
+	case m.CustomQueueHandler.Name:
+		record, dequeued, err := m.CustomQueueHandler.Store.Dequeue(ctx, req.ExecutorName, nil)
+		if err != nil {
+			err = errors.Wrapf(err, "dbworkerstore.Dequeue %s", selectedQueue)
+			logger.Error("Failed to dequeue", log.String("queue", selectedQueue), log.Error(err))
+			return executortypes.Job{}, false, err
+		}
+		if !dequeued {
+			// no custom job to dequeue. Even though the queue was populated before, another executor
+			// instance could have dequeued in the meantime
+			return executortypes.Job{}, false, nil
+		}
+
+		job, err = m.CustomQueueHandler.RecordTransformer(ctx, req.Version, record, resourceMetadata)
+		if err != nil {
+			markErr := markRecordAsFailed(ctx, m.CustomQueueHandler.Store, record.RecordID(), err, logger)
+			err = errors.Wrapf(errors.Append(err, markErr), "RecordTransformer %s", selectedQueue)
+			logger.Error("Failed to transform record", log.String("queue", selectedQueue), log.Error(err))
+			return executortypes.Job{}, false, err
+		}
 	}
 	job.Queue = selectedQueue
 
@@ -232,13 +251,7 @@ var DoSelectQueueForDequeueing = func(candidateQueues []string, config *schema.D
 	// pick a queue based on the defined weights
 	var choices []weightedrand.Choice[string, int]
 	for _, queue := range candidateQueues {
-		var weight int
-		switch queue {
-		case "batches":
-			weight = config.Batches.Weight
-		case "codeintel":
-			weight = config.Codeintel.Weight
-		}
+		_, weight := executortypes.DequeueProperties(config, queue)
 		choices = append(choices, weightedrand.NewChoice(queue, weight))
 	}
 	chooser, err := weightedrand.NewChooser(choices...)
@@ -257,13 +270,7 @@ func (m *MultiHandler) SelectEligibleQueues(queues []string) ([]string, error) {
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to check dequeue count for queue '%s'", queue)
 		}
-		var limit int
-		switch queue {
-		case m.BatchesQueueHandler.Name:
-			limit = m.dequeueCacheConfig.Batches.Limit
-		case m.CodeIntelQueueHandler.Name:
-			limit = m.dequeueCacheConfig.Codeintel.Limit
-		}
+		limit, _ := executortypes.DequeueProperties(m.dequeueCacheConfig, queue)
 		if len(dequeues) < limit {
 			candidateQueues = append(candidateQueues, queue)
 		}
@@ -287,6 +294,8 @@ func (m *MultiHandler) SelectNonEmptyQueues(ctx context.Context, queueNames []st
 			count, err = m.BatchesQueueHandler.Store.QueuedCount(ctx, false)
 		case m.CodeIntelQueueHandler.Name:
 			count, err = m.CodeIntelQueueHandler.Store.QueuedCount(ctx, false)
+		case m.CustomQueueHandler.Name:
+			count, err = m.CustomQueueHandler.Store.QueuedCount(ctx, false)
 		}
 		if err != nil {
 			m.logger.Error("fetching queue size", log.Error(err), log.String("queue", queue))
@@ -382,6 +391,8 @@ func (m *MultiHandler) heartbeat(ctx context.Context, executor types.Executor, i
 			known, cancel, err = m.BatchesQueueHandler.Store.Heartbeat(ctx, queue.JobIDs, heartbeatOptions)
 		case m.CodeIntelQueueHandler.Name:
 			known, cancel, err = m.CodeIntelQueueHandler.Store.Heartbeat(ctx, queue.JobIDs, heartbeatOptions)
+		case m.CustomQueueHandler.Name:
+			known, cancel, err = m.CustomQueueHandler.Store.Heartbeat(ctx, queue.JobIDs, heartbeatOptions)
 		}
 
 		if err != nil {
@@ -218,7 +218,7 @@ func TestMultiHandler_HandleDequeue(t *testing.T) {
 			dequeueEvents: []dequeueEvent{
 				{
 					expectedStatusCode:   http.StatusInternalServerError,
-					expectedResponseBody: `{"error":"Invalid queue name(s) 'invalidqueue' found. Supported queue names are 'batches, codeintel'."}`,
+					expectedResponseBody: `{"error":"Invalid queue name(s) 'invalidqueue' found. Supported queue names are 'batches, codeintel, custom'."}`,
 				},
 			},
 		},
@@ -490,6 +490,7 @@ func TestMultiHandler_HandleDequeue(t *testing.T) {
 				metricsstore.NewMockDistributedStore(),
 				handler.QueueHandler[uploadsshared.Index]{Name: "codeintel", Store: codeIntelMockStore, RecordTransformer: transformerFunc[uploadsshared.Index]},
 				handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches", Store: batchesMockStore, RecordTransformer: transformerFunc[*btypes.BatchSpecWorkspaceExecutionJob]},
+				handler.QueueHandler[*executorstore.CustomJob]{Name: "custom"},
 			)
 
 			router := mux.NewRouter()
@@ -828,6 +829,7 @@ func TestMultiHandler_HandleHeartbeat(t *testing.T) {
 				metricsStore,
 				handler.QueueHandler[uploadsshared.Index]{Name: "codeintel", Store: codeIntelMockStore},
 				handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches", Store: batchesMockStore},
+				handler.QueueHandler[*executorstore.CustomJob]{Name: "custom"},
 			)
 
 			router := mux.NewRouter()
@@ -925,6 +927,7 @@ func TestMultiHandler_SelectQueueForDequeueing(t *testing.T) {
 				nil,
 				handler.QueueHandler[uploadsshared.Index]{Name: "codeintel"},
 				handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches"},
+				handler.QueueHandler[*executorstore.CustomJob]{Name: "custom"},
 			)
 
 			selectCounts := make(map[string]int, len(tt.candidateQueues))
@@ -1017,6 +1020,7 @@ func TestMultiHandler_SelectEligibleQueues(t *testing.T) {
 		nil,
 		handler.QueueHandler[uploadsshared.Index]{Name: "codeintel"},
 		handler.QueueHandler[*btypes.BatchSpecWorkspaceExecutionJob]{Name: "batches"},
+		handler.QueueHandler[*executorstore.CustomJob]{Name: "custom"},
 	)
 
 	for _, tt := range tests {
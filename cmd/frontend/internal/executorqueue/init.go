@@ -1,15 +1,31 @@
 package executorqueue
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor/cache"
+	"github.com/sourcegraph/sourcegraph/internal/executor/logstream"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/batches"
+	codeintelqueue "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/codeintel"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/custom"
 )
 
+func LoadConfig() {
+	cache.ConfigInst.Load()
+	autoscalingConfigInst.Load()
+}
+
 // Init initializes the executor endpoints required for use with the executor service.
 func Init(
 	observationCtx *observation.Context,
@@ -27,7 +43,27 @@ func Init(
 
 	logger := log.Scoped("executorqueue")
 
-	queueHandler := newExecutorQueuesHandler(
+	if err := cache.ConfigInst.Validate(); err != nil {
+		return err
+	}
+	if err := autoscalingConfigInst.Validate(); err != nil {
+		return err
+	}
+	cacheUploadStore, err := cache.NewUploadStore(context.Background(), observationCtx, cache.ConfigInst)
+	if err != nil {
+		return err
+	}
+	cacheHandler := newCacheHandler(logger, store.NewCacheEntryStore(db), cacheUploadStore, cache.ConfigInst.MaxEntrySize)
+
+	// Log events are relayed over Redis, as executors and viewers of a job can be connected to
+	// different frontend instances.
+	logStreamHandler := newLogStreamHandler(logger, logstream.NewRedisBroker(redispool.Store.Pool()), map[string]handler.LogSource{
+		"batches":   batches.LogSource(observationCtx, db),
+		"codeintel": codeintelqueue.LogSource(db),
+		"custom":    custom.LogSource(db),
+	})
+
+	queueHandler, candidatesLister := newExecutorQueuesHandler(
 		observationCtx,
 		db,
 		logger,
@@ -35,8 +71,13 @@ func Init(
 		codeintelUploadHandler,
 		batchesWorkspaceFileGetHandler,
 		batchesWorkspaceFileExistsHandler,
+		cacheHandler,
+		logStreamHandler,
+		*autoscalingConfigInst,
 	)
 
 	enterpriseServices.NewExecutorProxyHandler = queueHandler
+	enterpriseServices.ExecutorQueueCandidatesLister = candidatesLister
+	enterpriseServices.ExecutorLogStreamHandler = http.HandlerFunc(logStreamHandler.handleSubscribe)
 	return nil
 }
@@ -0,0 +1,204 @@
+package executorqueue
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/executor/logstream"
+	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// logStreamHandler relays the log events that executors stream while running a job to the
+// viewers of the job. The persisted execution logs remain the source of truth: viewers first
+// receive the persisted logs, and the persisted logs are reloaded periodically to catch up on
+// events that were dropped along the way.
+type logStreamHandler struct {
+	logger            log.Logger
+	broker            logstream.Broker
+	sources           map[string]handler.LogSource
+	reconcileInterval time.Duration
+}
+
+// logStreamReconcileInterval is the interval at which the persisted logs of a job are reloaded
+// while viewers are subscribed to it.
+const logStreamReconcileInterval = 5 * time.Second
+
+func newLogStreamHandler(logger log.Logger, broker logstream.Broker, sources map[string]handler.LogSource) *logStreamHandler {
+	return &logStreamHandler{
+		logger:            logger.Scoped("logstream"),
+		broker:            broker,
+		sources:           sources,
+		reconcileInterval: logStreamReconcileInterval,
+	}
+}
+
+// handlePublish receives the newline-delimited log events of a job from an executor for as long
+// as the job is running. The job token is checked by jobAuthMiddleware.
+func (h *logStreamHandler) handlePublish(w http.ResponseWriter, r *http.Request) {
+	queue := mux.Vars(r)["queueName"]
+	jobID, err := parseJobIdHeader(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	for {
+		var event logstream.Event
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			http.Error(w, "invalid log event", http.StatusBadRequest)
+			return
+		}
+
+		// Events are best effort, so a failure to relay one does not end the stream.
+		if err := h.broker.Publish(r.Context(), queue, int(jobID), event); err != nil {
+			h.logger.Warn("failed to publish log event", log.String("queue", queue), log.Int64("jobID", jobID), log.Error(err))
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleSubscribe streams the logs of a job to a viewer as server-sent events. Each "log"
+// event is a logstream.Event to be applied to the entries received so far. A "done" event is
+// sent once the job has finished.
+func (h *logStreamHandler) handleSubscribe(w http.ResponseWriter, r *http.Request) {
+	queue := mux.Vars(r)["queueName"]
+	source, ok := h.sources[queue]
+	if !ok {
+		http.Error(w, "unknown queue", http.StatusNotFound)
+		return
+	}
+	jobID, err := strconv.Atoi(mux.Vars(r)["jobID"])
+	if err != nil {
+		http.Error(w, "invalid job ID", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Subscribe before loading the persisted logs, so that no output is missed in between.
+	events, err := h.broker.Subscribe(ctx, queue, jobID)
+	if err != nil {
+		h.logger.Error("failed to subscribe to log events", log.String("queue", queue), log.Int("jobID", jobID), log.Error(err))
+		http.Error(w, "failed to subscribe to logs", http.StatusInternalServerError)
+		return
+	}
+
+	entries, finished, err := source(ctx, jobID)
+	if err != nil {
+		if errors.Is(err, handler.ErrJobNotFound) {
+			http.Error(w, "job not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("failed to load execution logs", log.String("queue", queue), log.Int("jobID", jobID), log.Error(err))
+		http.Error(w, "failed to load logs", http.StatusInternalServerError)
+		return
+	}
+
+	eventWriter, err := streamhttp.NewWriter(w)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for i, entry := range entries {
+		if err := eventWriter.Event("log", logstream.NewEntryEvent(i+1, entry)); err != nil {
+			return
+		}
+	}
+
+	ticker := time.NewTicker(h.reconcileInterval)
+	defer ticker.Stop()
+
+	for !finished {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				// The subscription ended, the viewer reconnects to resume.
+				return
+			}
+			if !applyLogEvent(&entries, event) {
+				// Events were missed, the next reconciliation catches up.
+				continue
+			}
+			if err := eventWriter.Event("log", event); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			var persisted []executor.ExecutionLogEntry
+			persisted, finished, err = source(ctx, jobID)
+			if err != nil {
+				h.logger.Warn("failed to reload execution logs", log.String("queue", queue), log.Int("jobID", jobID), log.Error(err))
+				continue
+			}
+
+			for _, entryID := range reconcileLogEntries(&entries, persisted, finished) {
+				if err := eventWriter.Event("log", logstream.NewEntryEvent(entryID, entries[entryID-1])); err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	_ = eventWriter.Event("done", map[string]any{})
+}
+
+// applyLogEvent applies the given event to the entries a viewer has received. It returns
+// false if the event cannot be applied because preceding events were missed.
+func applyLogEvent(entries *[]executor.ExecutionLogEntry, event logstream.Event) bool {
+	switch {
+	case event.EntryID >= 1 && event.EntryID <= len(*entries):
+		return event.Apply(&(*entries)[event.EntryID-1])
+	case event.EntryID == len(*entries)+1 && event.Offset == 0:
+		*entries = append(*entries, event.ExecutionLogEntry)
+		return true
+	default:
+		return false
+	}
+}
+
+// reconcileLogEntries updates the entries a viewer has received with the persisted entries that
+// are ahead of them, and returns the IDs of the updated entries. Streamed output is usually ahead
+// of the persisted output while a job is running; once it has finished, the persisted entries
+// are final.
+func reconcileLogEntries(entries *[]executor.ExecutionLogEntry, persisted []executor.ExecutionLogEntry, final bool) (updated []int) {
+	for i, entry := range persisted {
+		if i < len(*entries) {
+			current := (*entries)[i]
+			if !isAheadOf(entry, current, final) {
+				continue
+			}
+			(*entries)[i] = entry
+		} else {
+			*entries = append(*entries, entry)
+		}
+		updated = append(updated, i+1)
+	}
+	return updated
+}
+
+func isAheadOf(persisted, current executor.ExecutionLogEntry, final bool) bool {
+	if final {
+		return persisted.Out != current.Out || (persisted.ExitCode != nil) != (current.ExitCode != nil)
+	}
+	return len(persisted.Out) > len(current.Out) || (persisted.ExitCode != nil && current.ExitCode == nil)
+}
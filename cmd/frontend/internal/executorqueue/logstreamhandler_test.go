@@ -0,0 +1,136 @@
+package executorqueue
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/executor/logstream"
+)
+
+func TestLogStreamHandler_Publish(t *testing.T) {
+	broker := logstream.NewMemoryBroker()
+	h := newLogStreamHandler(logtest.Scoped(t), broker, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := broker.Subscribe(ctx, "batches", 42)
+	require.NoError(t, err)
+
+	first := logstream.NewEntryEvent(1, executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: hello\n"})
+	second := logstream.Event{EntryID: 1, Offset: 14, ExecutionLogEntry: executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: world\n"}}
+
+	var body strings.Builder
+	for _, event := range []logstream.Event{first, second} {
+		require.NoError(t, json.NewEncoder(&body).Encode(event))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/batches/streamExecutionLogs", strings.NewReader(body.String()))
+	req.Header.Set("X-Sourcegraph-Job-ID", "42")
+	req = mux.SetURLVars(req, map[string]string{"queueName": "batches"})
+	rw := httptest.NewRecorder()
+	h.handlePublish(rw, req)
+
+	assert.Equal(t, http.StatusNoContent, rw.Code)
+	assert.Equal(t, first, <-events)
+	assert.Equal(t, second, <-events)
+}
+
+func TestLogStreamHandler_Subscribe(t *testing.T) {
+	broker := logstream.NewMemoryBroker()
+	exitCode := 0
+
+	calls := 0
+	source := func(ctx context.Context, jobID int) ([]executor.ExecutionLogEntry, bool, error) {
+		if jobID != 42 {
+			return nil, false, handler.ErrJobNotFound
+		}
+
+		calls++
+		if calls == 1 {
+			// Output streamed after the persisted logs were loaded.
+			require.NoError(t, broker.Publish(ctx, "batches", 42, logstream.Event{
+				EntryID:           1,
+				Offset:            14,
+				ExecutionLogEntry: executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: world\n"},
+			}))
+			return []executor.ExecutionLogEntry{{Key: "step.0", Out: "stdout: hello\n"}}, false, nil
+		}
+
+		return []executor.ExecutionLogEntry{{Key: "step.0", Out: "stdout: hello\nstdout: world\n", ExitCode: &exitCode}}, true, nil
+	}
+
+	h := newLogStreamHandler(logtest.Scoped(t), broker, map[string]handler.LogSource{"batches": source})
+	h.reconcileInterval = 50 * time.Millisecond
+
+	t.Run("Not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/executors/batches/jobs/43/logs/stream", nil)
+		req = mux.SetURLVars(req, map[string]string{"queueName": "batches", "jobID": "43"})
+		rw := httptest.NewRecorder()
+		h.handleSubscribe(rw, req)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+
+	t.Run("Unknown queue", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/executors/other/jobs/42/logs/stream", nil)
+		req = mux.SetURLVars(req, map[string]string{"queueName": "other", "jobID": "42"})
+		rw := httptest.NewRecorder()
+		h.handleSubscribe(rw, req)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/executors/batches/jobs/42/logs/stream", nil)
+		req = mux.SetURLVars(req, map[string]string{"queueName": "batches", "jobID": "42"})
+		rw := httptest.NewRecorder()
+		h.handleSubscribe(rw, req)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+
+		var entry executor.ExecutionLogEntry
+		var names []string
+		for _, message := range strings.Split(strings.TrimSpace(rw.Body.String()), "\n\n") {
+			lines := strings.SplitN(message, "\n", 2)
+			require.Len(t, lines, 2)
+			name := strings.TrimPrefix(lines[0], "event: ")
+			names = append(names, name)
+
+			if name == "log" {
+				var event logstream.Event
+				require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(lines[1], "data: ")), &event))
+				require.True(t, event.Apply(&entry))
+			}
+		}
+
+		assert.Equal(t, []string{"log", "log", "log", "done"}, names)
+		assert.Equal(t, executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: hello\nstdout: world\n", ExitCode: &exitCode}, entry)
+	})
+}
+
+func TestReconcileLogEntries(t *testing.T) {
+	exitCode := 0
+	entries := []executor.ExecutionLogEntry{{Key: "step.0", Out: "stdout: a\nstdout: b\n"}}
+
+	// Streamed output that is ahead of the persisted output is kept while the job runs.
+	updated := reconcileLogEntries(&entries, []executor.ExecutionLogEntry{{Key: "step.0", Out: "stdout: a\n"}, {Key: "step.1"}}, false)
+	assert.Equal(t, []int{2}, updated)
+	assert.Equal(t, "stdout: a\nstdout: b\n", entries[0].Out)
+
+	// Once the job has finished, the persisted output is final.
+	updated = reconcileLogEntries(&entries, []executor.ExecutionLogEntry{{Key: "step.0", Out: "stdout: a\n******\n", ExitCode: &exitCode}, {Key: "step.1"}}, true)
+	assert.Equal(t, []int{1}, updated)
+	assert.Equal(t, "stdout: a\n******\n", entries[0].Out)
+}
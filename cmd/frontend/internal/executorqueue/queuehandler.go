@@ -1,6 +1,7 @@
 package executorqueue
 
 import (
+	"context"
 	"crypto/subtle"
 	"fmt"
 	"net/http"
@@ -10,9 +11,11 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/batches"
 	codeintelqueue "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/codeintel"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/queues/custom"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -20,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	metricsstore "github.com/sourcegraph/sourcegraph/internal/metrics/store"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -31,7 +35,10 @@ func newExecutorQueuesHandler(
 	uploadHandler http.Handler,
 	batchesWorkspaceFileGetHandler http.Handler,
 	batchesWorkspaceFileExistsHandler http.Handler,
-) func() http.Handler {
+	cacheHandler *cacheHandler,
+	logStreamHandler *logStreamHandler,
+	autoscalingConfig autoscalingConfig,
+) (func() http.Handler, dequeueCandidatesLister) {
 	metricsStore := metricsstore.NewDistributedStore("executors:")
 	executorStore := db.Executors()
 	jobTokenStore := store.NewJobTokenStore(observationCtx, db)
@@ -43,12 +50,28 @@ func newExecutorQueuesHandler(
 	// Note: In order register a new queue type please change the validate() check code in cmd/executor/config.go
 	codeIntelQueueHandler := codeintelqueue.QueueHandler(observationCtx, db, accessToken)
 	batchesQueueHandler := batches.QueueHandler(observationCtx, db, accessToken)
+	customQueueHandler := custom.QueueHandler(observationCtx, db, accessToken)
 
 	codeintelHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler)
 	batchesHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, batchesQueueHandler)
-	handlers := []handler.ExecutorHandler{codeintelHandler, batchesHandler}
+	customHandler := handler.NewHandler(executorStore, jobTokenStore, metricsStore, customQueueHandler)
+	handlers := []handler.ExecutorHandler{codeintelHandler, batchesHandler, customHandler}
 
-	multiHandler := handler.NewMultiHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler, batchesQueueHandler)
+	multiHandler := handler.NewMultiHandler(executorStore, jobTokenStore, metricsStore, codeIntelQueueHandler, batchesQueueHandler, customQueueHandler)
+
+	autoscaler := newAutoscaler(logger, autoscalingConfig, executorStore, map[string]queueStatisticsStore{
+		codeIntelQueueHandler.Name: codeIntelQueueHandler.Store,
+		batchesQueueHandler.Name:   batchesQueueHandler.Store,
+		customQueueHandler.Name:    customQueueHandler.Store,
+	})
+	observationCtx.Registerer.MustRegister(autoscaler)
+
+	// Let site admins see in which order the queued jobs of each queue are dequeued.
+	candidatesLister := dequeueCandidatesLister{
+		codeIntelQueueHandler.Name: codeIntelQueueHandler.Store,
+		batchesQueueHandler.Name:   batchesQueueHandler.Store,
+		customQueueHandler.Name:    customQueueHandler.Store,
+	}
 
 	// Auth middleware
 	executorAuth := executorAuthMiddleware(logger, accessToken)
@@ -85,6 +108,9 @@ func newExecutorQueuesHandler(
 		queueRouter.Use(withInternalActor, executorAuth)
 		queueRouter.Path("/dequeue").Methods(http.MethodPost).HandlerFunc(multiHandler.HandleDequeue)
 		queueRouter.Path("/heartbeat").Methods(http.MethodPost).HandlerFunc(multiHandler.HandleHeartbeat)
+		// Recommend the number of executors of each queue to autoscalers.
+		queueRouter.Path("/autoscaling").Methods(http.MethodGet).HandlerFunc(autoscaler.handleList)
+		queueRouter.Path("/{queueName}/autoscaling").Methods(http.MethodGet).HandlerFunc(autoscaler.handleGet)
 
 		jobRouter := base.PathPrefix("/queue").Subrouter()
 		// The job routes are treated as internal actor. Additionally, each job comes with a short-lived token that is
@@ -95,6 +121,8 @@ func newExecutorQueuesHandler(
 			handler.SetupRoutes(h, queueRouter)
 			handler.SetupJobRoutes(h, jobRouter)
 		}
+		// Receive the log output of running jobs, which is relayed to the viewers of the jobs.
+		jobRouter.Path("/{queueName}/streamExecutionLogs").Methods(http.MethodPost).HandlerFunc(logStreamHandler.handlePublish)
 
 		// Upload LSIF indexes without a sudo access token or github tokens.
 		lsifRouter := base.PathPrefix("/lsif").Name("executor-lsif").Subrouter()
@@ -109,6 +137,16 @@ func newExecutorQueuesHandler(
 		// The scip route are treated as an internal actor and require the executor access token to authenticate.
 		scipRouter.Use(withInternalActor, executorAuth)
 
+		// Restore and save the caches declared by jobs. This is registered before the generic
+		// files router, as the queue of the cache is part of the path.
+		cacheRouter := base.PathPrefix("/files/cache").Name("executor-cache").Subrouter()
+		cacheRouter.Path("/{queueName}/{key}").Methods(http.MethodGet).HandlerFunc(cacheHandler.handleGet)
+		cacheRouter.Path("/{queueName}/{key}").Methods(http.MethodHead).HandlerFunc(cacheHandler.handleExists)
+		cacheRouter.Path("/{queueName}/{key}").Methods(http.MethodPut).HandlerFunc(cacheHandler.handlePut)
+		// The cache routes are treated as an internal actor. Additionally, each job comes with a short-lived token
+		// that is checked by jobAuthMiddleware, which ensures jobs can only access the caches of their own queue.
+		cacheRouter.Use(withInternalActor, jobAuthMiddleware(logger, routeCache, jobTokenStore, executorStore))
+
 		filesRouter := base.PathPrefix("/files").Name("executor-files").Subrouter()
 		batchChangesRouter := filesRouter.PathPrefix("/batch-changes").Subrouter()
 		batchChangesRouter.Path("/{spec}/{file}").Methods(http.MethodGet).Handler(batchesWorkspaceFileGetHandler)
@@ -119,12 +157,33 @@ func newExecutorQueuesHandler(
 		return base
 	}
 
-	return factory
+	return factory, candidatesLister
+}
+
+// dequeueCandidatesStore is the part of the dbworker store of a queue listing its next queued jobs.
+type dequeueCandidatesStore interface {
+	ListDequeueCandidates(ctx context.Context, limit int) ([]dbworkerstore.DequeueCandidate, error)
+}
+
+// dequeueCandidatesLister lists the next queued jobs of the queues, by name.
+type dequeueCandidatesLister map[string]dequeueCandidatesStore
+
+var _ graphqlbackend.ExecutorQueueCandidatesLister = dequeueCandidatesLister{}
+
+// ListExecutorQueueCandidates returns the next queued jobs of the queue of the given name, in the
+// order in which they are dequeued.
+func (l dequeueCandidatesLister) ListExecutorQueueCandidates(ctx context.Context, queueName string, limit int) ([]dbworkerstore.DequeueCandidate, error) {
+	s, ok := l[queueName]
+	if !ok {
+		return nil, errors.Newf("unknown queue %q", queueName)
+	}
+	return s.ListDequeueCandidates(ctx, limit)
 }
 
 type routeName string
 
 const (
+	routeCache = "cache"
 	routeFiles = "files"
 	routeGit   = "git"
 	routeQueue = "queue"
@@ -264,7 +323,7 @@ func validateJobRequest(
 		queue = "batches"
 	case routeGit:
 		repo = mux.Vars(r)["RepoName"]
-	case routeQueue:
+	case routeCache, routeQueue:
 		queue = mux.Vars(r)["queueName"]
 	default:
 		logger.Error("unsupported route", log.String("route", string(routeName)))
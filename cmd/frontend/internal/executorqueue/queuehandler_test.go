@@ -187,6 +187,42 @@ func TestJobAuthMiddleware(t *testing.T) {
 				require.Len(t, executorStore.GetByHostnameFunc.History(), 0)
 			},
 		},
+		{
+			name:      "Cache Authorized",
+			routeName: routeCache,
+			header: map[string]string{
+				"Authorization":               "Bearer somejobtoken",
+				"X-Sourcegraph-Job-ID":        "42",
+				"X-Sourcegraph-Executor-Name": "test-executor",
+			},
+			mockFunc: func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				jobTokenStore.GetByTokenFunc.PushReturn(executorstore.JobToken{JobID: 42, Queue: "test"}, nil)
+				executorStore.GetByHostnameFunc.PushReturn(types.Executor{}, true, nil)
+			},
+			expectedStatusCode: http.StatusTeapot,
+			assertionFunc: func(t *testing.T, executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				require.Len(t, jobTokenStore.GetByTokenFunc.History(), 1)
+				require.Len(t, executorStore.GetByHostnameFunc.History(), 1)
+			},
+		},
+		{
+			name:      "Cache of another queue",
+			routeName: routeCache,
+			header: map[string]string{
+				"Authorization":               "Bearer somejobtoken",
+				"X-Sourcegraph-Job-ID":        "42",
+				"X-Sourcegraph-Executor-Name": "test-executor",
+			},
+			mockFunc: func(executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				jobTokenStore.GetByTokenFunc.PushReturn(executorstore.JobToken{JobID: 42, Queue: "batches"}, nil)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: "invalid token\n",
+			assertionFunc: func(t *testing.T, executorStore *dbmocks.MockExecutorStore, jobTokenStore *executorstore.MockJobTokenStore) {
+				require.Len(t, jobTokenStore.GetByTokenFunc.History(), 1)
+				require.Len(t, executorStore.GetByHostnameFunc.History(), 0)
+			},
+		},
 		{
 			name:      "No worker hostname provided",
 			routeName: routeQueue,
@@ -0,0 +1,39 @@
+package batches
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	bstore "github.com/sourcegraph/sourcegraph/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// LogSource returns a source for the execution logs of batch spec workspace execution jobs.
+// Users can view the logs of their own jobs, site admins can view the logs of all jobs.
+func LogSource(observationCtx *observation.Context, db database.DB) handler.LogSource {
+	batchesStore := bstore.New(db, observationCtx, nil)
+
+	return func(ctx context.Context, jobID int) ([]executor.ExecutionLogEntry, bool, error) {
+		job, err := batchesStore.GetBatchSpecWorkspaceExecutionJob(ctx, bstore.GetBatchSpecWorkspaceExecutionJobOpts{
+			ID:          int64(jobID),
+			ExcludeRank: true,
+		})
+		if err != nil {
+			if errors.Is(err, bstore.ErrNoResults) {
+				return nil, false, handler.ErrJobNotFound
+			}
+			return nil, false, err
+		}
+
+		// 🚨 SECURITY: Only the creator of the batch spec and site admins can view its logs.
+		if err := auth.CheckSiteAdminOrSameUser(ctx, db, job.UserID); err != nil {
+			return nil, false, handler.ErrJobNotFound
+		}
+
+		return job.ExecutionLogs, handler.IsFinishedState(string(job.State)), nil
+	}
+}
@@ -259,19 +259,32 @@ func transformRecord(ctx context.Context, logger log.Logger, s BatchesStore, job
 				},
 			})
 
-			dockerSteps = append(dockerSteps, apiclient.DockerStep{
-				Key:   executorutil.FormatRunKey(i),
-				Image: step.Container,
-				Dir:   runDir,
-				// Invoke the script file but also write stdout and stderr to separate files, which will then be
-				// consumed by the post step to build the AfterStepResult.
-				Commands: []string{
-					// Hide commands from stderr.
-					"{ set +x; } 2>/dev/null",
-					"{ set -eo pipefail; } 2>/dev/null",
-					fmt.Sprintf(`(exec "%s/step%d.sh" | tee %s/stdout%d.log) 3>&1 1>&2 2>&3 | tee %s/stderr%d.log`, runDirToScriptDir, i, runDirToScriptDir, i, runDirToScriptDir, i),
-				},
-			})
+			if step.IsBuiltin() {
+				// Built-in steps don't need a container, batcheshelper executes them and
+				// writes stdout and stderr to the same files as the script would.
+				dockerSteps = append(dockerSteps, apiclient.DockerStep{
+					Key:   executorutil.FormatRunKey(i),
+					Image: helperImage,
+					Dir:   ".",
+					Commands: []string{
+						shellquote.Join("batcheshelper", "builtin", strconv.Itoa(i)),
+					},
+				})
+			} else {
+				dockerSteps = append(dockerSteps, apiclient.DockerStep{
+					Key:   executorutil.FormatRunKey(i),
+					Image: step.Container,
+					Dir:   runDir,
+					// Invoke the script file but also write stdout and stderr to separate files, which will then be
+					// consumed by the post step to build the AfterStepResult.
+					Commands: []string{
+						// Hide commands from stderr.
+						"{ set +x; } 2>/dev/null",
+						"{ set -eo pipefail; } 2>/dev/null",
+						fmt.Sprintf(`(exec "%s/step%d.sh" | tee %s/stdout%d.log) 3>&1 1>&2 2>&3 | tee %s/stderr%d.log`, runDirToScriptDir, i, runDirToScriptDir, i, runDirToScriptDir, i),
+					},
+				})
+			}
 
 			// This step gets the diff, reads stdout and stderr, renders the outputs and builds the AfterStepResult.
 			dockerSteps = append(dockerSteps, apiclient.DockerStep{
@@ -3,6 +3,7 @@ package batches
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -396,4 +397,60 @@ steps:
 		mockassert.CalledN(t, secs.ListFunc, 9)
 		mockassert.CalledN(t, sal.CreateFunc, 5)
 	})
+
+	t.Run("built-in step", func(t *testing.T) {
+		spec := batcheslib.BatchSpec{}
+		err := yaml.Unmarshal([]byte(`
+steps:
+  - writeFiles:
+      README.md: Hello World
+  - run: echo more lol >> readme.md
+    container: alpine:3
+`), &spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Copy.
+		batchSpec := *batchSpec
+		batchSpec.Spec = &spec
+		store.GetBatchSpecFunc.PushReturn(&batchSpec, nil)
+
+		workspace := *workspace
+		workspace.StepCacheResults = map[int]btypes.StepCacheResult{}
+		store.GetBatchSpecWorkspaceFunc.PushReturn(&workspace, nil)
+
+		workspaceExecutionJob := *workspaceExecutionJob
+		workspaceExecutionJob.Version = 2
+
+		job, err := transformRecord(context.Background(), logtest.Scoped(t), store, &workspaceExecutionJob, "0.0.0-dev")
+		if err != nil {
+			t.Fatalf("unexpected error transforming record: %s", err)
+		}
+
+		helperImage := fmt.Sprintf("%s:%s", conf.ExecutorsBatcheshelperImage(), conf.ExecutorsBatcheshelperImageTag())
+		type dockerStep struct {
+			Key      string
+			Image    string
+			Commands []string
+		}
+		var have []dockerStep
+		for _, step := range job.DockerSteps {
+			have = append(have, dockerStep{Key: step.Key, Image: step.Image, Commands: step.Commands})
+		}
+		want := []dockerStep{
+			{Key: "step.0.pre", Image: helperImage, Commands: []string{"batcheshelper pre 0"}},
+			{Key: "step.0.run", Image: helperImage, Commands: []string{"batcheshelper builtin 0"}},
+			{Key: "step.0.post", Image: helperImage, Commands: []string{"batcheshelper post 0"}},
+			{Key: "step.1.pre", Image: helperImage, Commands: []string{"batcheshelper pre 1"}},
+			{Key: "step.1.run", Image: "alpine:3", Commands: []string{
+				"{ set +x; } 2>/dev/null",
+				"{ set -eo pipefail; } 2>/dev/null",
+				`(exec "../../../../step1.sh" | tee ../../../../stdout1.log) 3>&1 1>&2 2>&3 | tee ../../../../stderr1.log`,
+			}},
+			{Key: "step.1.post", Image: helperImage, Commands: []string{"batcheshelper post 1"}},
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected docker steps (-want +got):\n%s", diff)
+		}
+	})
 }
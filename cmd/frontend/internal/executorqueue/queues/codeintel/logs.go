@@ -0,0 +1,45 @@
+package codeintel
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// LogSource returns a source for the execution logs of auto-indexing jobs. Users can view the
+// logs of the jobs of all repositories they have access to.
+func LogSource(db database.DB) handler.LogSource {
+	return func(ctx context.Context, jobID int) ([]executor.ExecutionLogEntry, bool, error) {
+		authzConds, err := database.AuthzQueryConds(ctx, db)
+		if err != nil {
+			return nil, false, err
+		}
+
+		q := sqlf.Sprintf(indexExecutionLogsQuery, jobID, authzConds)
+
+		var state string
+		var executionLogs []executor.ExecutionLogEntry
+		if err := db.QueryRowContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...).Scan(&state, pq.Array(&executionLogs)); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, false, handler.ErrJobNotFound
+			}
+			return nil, false, err
+		}
+
+		return executionLogs, handler.IsFinishedState(state), nil
+	}
+}
+
+const indexExecutionLogsQuery = `
+SELECT u.state, u.execution_logs
+FROM lsif_indexes u
+JOIN repo ON repo.id = u.repository_id
+WHERE repo.deleted_at IS NULL AND u.id = %s AND %s
+`
@@ -159,6 +159,15 @@ func transformRecord(ctx context.Context, db database.DB, index uploadsshared.In
 		DockerSteps:    dockerSteps,
 		RedactedValues: allRedactedValues,
 	}
+	if len(index.DockerSteps) > 0 || index.Indexer != "" {
+		// Executors that support caches mount the cache directory at /cache, outside of
+		// the workspace, and point XDG_CACHE_HOME at it. Persist all of it, so that the
+		// dependencies downloaded by the steps are reused by later runs.
+		aj.Caches = []apiclient.JobCache{{
+			Key:   fmt.Sprintf("%s:%s:%s", index.RepositoryName, root, index.Indexer),
+			Paths: []string{"."},
+		}}
+	}
 
 	// Append docker auth config.
 	esStore := db.ExecutorSecrets(keyring.Default().ExecutorSecretKey)
@@ -139,6 +139,7 @@ func TestTransformRecord(t *testing.T) {
 					"hunter2":                "PASSWORD_REMOVED",
 					"token-executor hunter2": "token-executor REDACTED",
 				},
+				Caches: []apiclient.JobCache{{Key: "linux:web:lsif-node", Paths: []string{"."}}},
 			}
 			if diff := cmp.Diff(expected, job); diff != "" {
 				t.Errorf("unexpected job (-want +got):\n%s", diff)
@@ -241,6 +242,7 @@ func TestTransformRecordWithoutIndexer(t *testing.T) {
 			"hunter2":                "PASSWORD_REMOVED",
 			"token-executor hunter2": "token-executor REDACTED",
 		},
+		Caches: []apiclient.JobCache{{Key: "linux:.:", Paths: []string{"."}}},
 	}
 	if diff := cmp.Diff(expected, job); diff != "" {
 		t.Errorf("unexpected job (-want +got):\n%s", diff)
@@ -371,6 +373,7 @@ func TestTransformRecordWithSecrets(t *testing.T) {
 					"hunter2":                "PASSWORD_REMOVED",
 					"token-executor hunter2": "token-executor REDACTED",
 				},
+				Caches: []apiclient.JobCache{{Key: "linux:web:lsif-node", Paths: []string{"."}}},
 			}
 			if diff := cmp.Diff(expected, job); diff != "" {
 				t.Errorf("unexpected job (-want +got):\n%s", diff)
@@ -0,0 +1,34 @@
+package custom
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
+)
+
+// LogSource returns a source for the execution logs of custom jobs, which only site admins
+// can view.
+func LogSource(db database.DB) handler.LogSource {
+	jobStore := executorstore.NewCustomJobStore(db)
+
+	return func(ctx context.Context, jobID int) ([]executor.ExecutionLogEntry, bool, error) {
+		// 🚨 SECURITY: Custom jobs are managed by site admins only.
+		if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+			return nil, false, handler.ErrJobNotFound
+		}
+
+		job, ok, err := jobStore.GetByID(ctx, jobID)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			return nil, false, handler.ErrJobNotFound
+		}
+
+		return job.ExecutionLogs, handler.IsFinishedState(job.State), nil
+	}
+}
@@ -0,0 +1,25 @@
+package custom
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue/handler"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
+	apiclient "github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func QueueHandler(observationCtx *observation.Context, db database.DB, _ func() string) handler.QueueHandler[*executorstore.CustomJob] {
+	recordTransformer := func(ctx context.Context, _ string, record *executorstore.CustomJob, _ handler.ResourceMetadata) (apiclient.Job, error) {
+		return transformRecord(ctx, db, record)
+	}
+
+	store := executorstore.NewCustomJobWorkerStore(observationCtx, db.Handle())
+
+	return handler.QueueHandler[*executorstore.CustomJob]{
+		Name:              "custom",
+		Store:             store,
+		RecordTransformer: recordTransformer,
+	}
+}
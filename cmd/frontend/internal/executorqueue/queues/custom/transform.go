@@ -0,0 +1,144 @@
+package custom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
+	apiclient "github.com/sourcegraph/sourcegraph/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// accessLogTransformer sets the approriate fields on the executor secret access log entry
+// for custom jobs. Secrets of jobs enqueued by a service are logged as accessed by a machine
+// user.
+type accessLogTransformer struct {
+	database.ExecutorSecretAccessLogCreator
+	creatorID int32
+}
+
+func (e *accessLogTransformer) Create(ctx context.Context, log *database.ExecutorSecretAccessLog) error {
+	if e.creatorID != 0 {
+		log.UserID = &e.creatorID
+	} else {
+		log.MachineUser = "executor-custom-jobs"
+		log.UserID = nil
+	}
+	return e.ExecutorSecretAccessLogCreator.Create(ctx, log)
+}
+
+func transformRecord(ctx context.Context, db database.DB, job *executorstore.CustomJob) (apiclient.Job, error) {
+	secretStore := &accessLogTransformer{
+		ExecutorSecretAccessLogCreator: db.ExecutorSecretAccessLogs(),
+		creatorID:                      job.CreatorID,
+	}
+	esStore := db.ExecutorSecrets(keyring.Default().ExecutorSecretKey)
+
+	var secrets []*database.ExecutorSecret
+	if len(job.Secrets) > 0 {
+		var err error
+		secrets, _, err = esStore.List(ctx, database.ExecutorSecretScopeCustom, database.ExecutorSecretsListOpts{
+			// Note: No namespace set, custom job secrets are only available in the global namespace.
+			Keys: job.Secrets,
+		})
+		if err != nil {
+			return apiclient.Job{}, err
+		}
+
+		// Unlike auto-indexing, a custom job explicitly asks for its secrets, so running it
+		// without one of them would most likely fail in a confusing way.
+		if missing := missingSecrets(job.Secrets, secrets); len(missing) > 0 {
+			return apiclient.Job{}, errors.Newf("executor secrets %s of the custom scope do not exist", strings.Join(missing, ", "))
+		}
+	}
+
+	// And build the env vars from the secrets.
+	secretEnvVars := make([]string, len(secrets))
+	redactedEnvVars := make(map[string]string, len(secrets))
+	for i, secret := range secrets {
+		// Get the secret value. This also creates an access log entry in the
+		// name of the creator of the job.
+		val, err := secret.Value(ctx, secretStore)
+		if err != nil {
+			return apiclient.Job{}, err
+		}
+
+		secretEnvVars[i] = fmt.Sprintf("%s=%s", secret.Key, val)
+		// We redact secret values as ${{ secrets.NAME }}.
+		redactedEnvVars[val] = fmt.Sprintf("${{ secrets.%s }}", secret.Key)
+	}
+
+	dockerSteps := make([]apiclient.DockerStep, 0, len(job.Steps))
+	for i, step := range job.Steps {
+		dockerSteps = append(dockerSteps, apiclient.DockerStep{
+			Key:      fmt.Sprintf("step.%d", i),
+			Image:    step.Image,
+			Commands: step.Commands,
+			Dir:      step.Dir,
+			Env:      append(append([]string{}, step.Env...), secretEnvVars...),
+		})
+	}
+
+	files := make(map[string]apiclient.VirtualMachineFile, len(job.Files))
+	for path, content := range job.Files {
+		files[path] = apiclient.VirtualMachineFile{Content: []byte(content)}
+	}
+
+	allRedactedValues := map[string]string{}
+	// 🚨 SECURITY: Catch uses of executor secrets from the executor secret store
+	maps.Copy(allRedactedValues, redactedEnvVars)
+
+	aj := apiclient.Job{
+		ID:                  job.ID,
+		RepositoryName:      job.RepositoryName,
+		Commit:              job.Commit,
+		ShallowClone:        true,
+		VirtualMachineFiles: files,
+		DockerSteps:         dockerSteps,
+		RedactedValues:      allRedactedValues,
+	}
+
+	// Append docker auth config.
+	authSecrets, _, err := esStore.List(ctx, database.ExecutorSecretScopeCustom, database.ExecutorSecretsListOpts{
+		Keys: []string{"DOCKER_AUTH_CONFIG"},
+	})
+	if err != nil {
+		return apiclient.Job{}, err
+	}
+	if len(authSecrets) == 1 {
+		val, err := authSecrets[0].Value(ctx, secretStore)
+		if err != nil {
+			return apiclient.Job{}, err
+		}
+		if err := json.Unmarshal([]byte(val), &aj.DockerAuthConfig); err != nil {
+			return aj, err
+		}
+	}
+
+	return aj, nil
+}
+
+// missingSecrets returns the sorted keys of the requested secrets that are not part of the
+// given secrets.
+func missingSecrets(requested []string, secrets []*database.ExecutorSecret) []string {
+	found := make(map[string]struct{}, len(secrets))
+	for _, secret := range secrets {
+		found[secret.Key] = struct{}{}
+	}
+
+	var missing []string
+	for _, key := range requested {
+		if _, ok := found[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
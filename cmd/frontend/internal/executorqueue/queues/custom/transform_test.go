@@ -0,0 +1,133 @@
+package custom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
+	apiclient "github.com/sourcegraph/sourcegraph/internal/executor/types"
+)
+
+func TestTransformRecord(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	db.ExecutorSecretsFunc.SetDefaultReturn(dbmocks.NewMockExecutorSecretStore())
+
+	job := &executorstore.CustomJob{
+		ID:             42,
+		Name:           "Update lockfiles",
+		RepositoryName: "github.com/sourcegraph/sourcegraph",
+		Commit:         "deadbeef",
+		Steps: []executorstore.CustomJobStep{
+			{Image: "alpine:3", Commands: []string{"./update.sh"}, Env: []string{"CI=true"}},
+			{Image: "node:20", Commands: []string{"npm install", "npm test"}, Dir: "client"},
+		},
+		Files: map[string]string{
+			"update.sh": "#!/bin/sh\necho hello",
+		},
+	}
+
+	actual, err := transformRecord(context.Background(), db, job)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := apiclient.Job{
+		ID:             42,
+		RepositoryName: "github.com/sourcegraph/sourcegraph",
+		Commit:         "deadbeef",
+		ShallowClone:   true,
+		VirtualMachineFiles: map[string]apiclient.VirtualMachineFile{
+			"update.sh": {Content: []byte("#!/bin/sh\necho hello")},
+		},
+		DockerSteps: []apiclient.DockerStep{
+			{Key: "step.0", Image: "alpine:3", Commands: []string{"./update.sh"}, Env: []string{"CI=true"}},
+			{Key: "step.1", Image: "node:20", Commands: []string{"npm install", "npm test"}, Dir: "client", Env: []string{}},
+		},
+		RedactedValues: map[string]string{},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected job (-want +got):\n%s", diff)
+	}
+}
+
+func TestTransformRecordWithSecrets(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	secs := dbmocks.NewMockExecutorSecretStore()
+	sal := dbmocks.NewMockExecutorSecretAccessLogStore()
+	db.ExecutorSecretsFunc.SetDefaultReturn(secs)
+	db.ExecutorSecretAccessLogsFunc.SetDefaultReturn(sal)
+	secs.ListFunc.SetDefaultHook(func(ctx context.Context, ess database.ExecutorSecretScope, eslo database.ExecutorSecretsListOpts) ([]*database.ExecutorSecret, int, error) {
+		if ess != database.ExecutorSecretScopeCustom {
+			t.Errorf("unexpected scope %q", ess)
+		}
+		if len(eslo.Keys) == 1 && eslo.Keys[0] == "DOCKER_AUTH_CONFIG" {
+			return nil, 0, nil
+		}
+		return []*database.ExecutorSecret{
+			database.NewMockExecutorSecret(&database.ExecutorSecret{
+				Key:   "GITHUB_TOKEN",
+				Scope: database.ExecutorSecretScopeCustom,
+			}, "banana"),
+		}, 1, nil
+	})
+
+	t.Run("Secrets of a user", func(t *testing.T) {
+		job := &executorstore.CustomJob{
+			ID:        42,
+			CreatorID: 7,
+			Steps:     []executorstore.CustomJobStep{{Image: "alpine:3", Commands: []string{"./run.sh"}}},
+			Secrets:   []string{"GITHUB_TOKEN"},
+		}
+
+		actual, err := transformRecord(context.Background(), db, job)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff := cmp.Diff([]string{"GITHUB_TOKEN=banana"}, actual.DockerSteps[0].Env); diff != "" {
+			t.Errorf("unexpected env (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string]string{"banana": "${{ secrets.GITHUB_TOKEN }}"}, actual.RedactedValues); diff != "" {
+			t.Errorf("unexpected redacted values (-want +got):\n%s", diff)
+		}
+
+		entry := sal.CreateFunc.History()[len(sal.CreateFunc.History())-1].Arg1
+		if entry.UserID == nil || *entry.UserID != 7 || entry.MachineUser != "" {
+			t.Errorf("unexpected access log entry: %+v", entry)
+		}
+	})
+
+	t.Run("Secrets of a service", func(t *testing.T) {
+		job := &executorstore.CustomJob{
+			ID:      43,
+			Steps:   []executorstore.CustomJobStep{{Image: "alpine:3", Commands: []string{"./run.sh"}}},
+			Secrets: []string{"GITHUB_TOKEN"},
+		}
+
+		if _, err := transformRecord(context.Background(), db, job); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		entry := sal.CreateFunc.History()[len(sal.CreateFunc.History())-1].Arg1
+		if entry.UserID != nil || entry.MachineUser != "executor-custom-jobs" {
+			t.Errorf("unexpected access log entry: %+v", entry)
+		}
+	})
+
+	t.Run("Missing secrets", func(t *testing.T) {
+		job := &executorstore.CustomJob{
+			ID:      44,
+			Steps:   []executorstore.CustomJobStep{{Image: "alpine:3", Commands: []string{"./run.sh"}}},
+			Secrets: []string{"NPM_TOKEN", "GITHUB_TOKEN", "AWS_SECRET"},
+		}
+
+		_, err := transformRecord(context.Background(), db, job)
+		if err == nil || err.Error() != "executor secrets AWS_SECRET, NPM_TOKEN of the custom scope do not exist" {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+}
@@ -66,17 +66,23 @@ type Handlers struct {
 
 	// Code intel
 	NewCodeIntelUploadHandler enterprise.NewCodeIntelUploadHandler
+	CodeIntelLSPHandler       http.Handler
+	CodeIntelExportHandler    http.Handler
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
 
 	// Code Insights
 	CodeInsightsDataExportHandler http.Handler
+	CodeInsightsMetricsHandler    http.Handler
 
 	// Search jobs
 	SearchJobsDataExportHandler http.Handler
 	SearchJobsLogsHandler       http.Handler
 
+	// Executors
+	ExecutorLogStreamHandler http.Handler
+
 	// Dotcom license check
 	NewDotcomLicenseCheckHandler enterprise.NewDotcomLicenseCheckHandler
 
@@ -152,6 +158,8 @@ func NewHandler(
 	m.Path("/lsif/upload").Methods("POST").Handler(trace.Route(lsifDeprecationHandler))
 	m.Path("/scip/upload").Methods("POST").Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Path("/scip/upload").Methods("HEAD").Handler(trace.Route(noopHandler))
+	m.Path("/codeintel/lsp").Methods("GET").Handler(trace.Route(handlers.CodeIntelLSPHandler))
+	m.Path("/scip/export").Methods("GET").Handler(trace.Route(handlers.CodeIntelExportHandler))
 	m.Path("/compute/stream").Methods("GET", "POST").Handler(trace.Route(handlers.NewComputeStreamHandler()))
 	m.Path("/blame/" + routevar.Repo + routevar.RepoRevSuffix + "/stream/{Path:.*}").Methods("GET").Handler(trace.Route(handleStreamBlame(logger, db, gitserver.NewClient("http.blamestream"))))
 	// Set up the src-cli version cache handler (this will effectively be a
@@ -160,9 +168,11 @@ func NewHandler(
 	// Return the minimum src-cli version that's compatible with this instance
 	m.Path("/src-cli/{rest:.*}").Methods("GET").Handler(trace.Route(newSrcCliVersionHandler(logger)))
 	m.Path("/insights/export/{id}").Methods("GET").Handler(trace.Route(handlers.CodeInsightsDataExportHandler))
+	m.Path("/insights/metrics").Methods("GET").Handler(trace.Route(handlers.CodeInsightsMetricsHandler))
 	m.Path("/search/stream").Methods("GET").Handler(trace.Route(frontendsearch.StreamHandler(db)))
 	m.Path("/search/export/{id}.csv").Methods("GET").Handler(trace.Route(handlers.SearchJobsDataExportHandler))
 	m.Path("/search/export/{id}.log").Methods("GET").Handler(trace.Route(handlers.SearchJobsLogsHandler))
+	m.Path("/executors/{queueName}/jobs/{jobID}/logs/stream").Methods("GET").Handler(trace.Route(handlers.ExecutorLogStreamHandler))
 
 	m.Path("/completions/stream").Methods("POST").Handler(trace.Route(handlers.NewChatCompletionsStreamHandler()))
 	m.Path("/completions/code").Methods("POST").Handler(trace.Route(handlers.NewCodeCompletionsHandler()))
@@ -0,0 +1,60 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/insights/export"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MetricsHandler exposes the latest value of every code insights series as OpenMetrics gauges,
+// so that they can be scraped by Prometheus.
+type MetricsHandler struct {
+	primaryDB   database.DB
+	seriesStore *store.Store
+}
+
+func NewMetricsHandler(db database.DB, insightsDB edb.InsightsDB) *MetricsHandler {
+	return &MetricsHandler{
+		primaryDB:   db,
+		seriesStore: store.New(insightsDB, store.NewInsightPermissionStore(db)),
+	}
+}
+
+func (h *MetricsHandler) MetricsFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 🚨 SECURITY: the metrics include the series of all users, so only site admins may
+		// scrape them.
+		if err := auth.CheckCurrentUserIsSiteAdmin(r.Context(), h.primaryDB); err != nil {
+			if errors.Is(err, auth.ErrNotAuthenticated) {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+			} else {
+				http.Error(w, err.Error(), http.StatusForbidden)
+			}
+			return
+		}
+		if err := licensing.Check(licensing.FeatureCodeInsights); err != nil {
+			http.Error(w, invalidLicenseError.Error(), http.StatusForbidden)
+			return
+		}
+
+		points, err := h.seriesStore.LatestSeriesPoints(r.Context())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to fetch code insights data: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(export.NewLatestValuesCollector(points))
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{EnableOpenMetrics: true}).ServeHTTP(w, r)
+	}
+}
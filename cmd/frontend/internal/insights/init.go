@@ -40,6 +40,7 @@ func Init(
 	}
 	enterpriseServices.InsightsResolver = resolvers.New(rawInsightsDB, db)
 	enterpriseServices.CodeInsightsDataExportHandler = httpapi.NewExportHandler(db, rawInsightsDB).ExportFunc()
+	enterpriseServices.CodeInsightsMetricsHandler = httpapi.NewMetricsHandler(db, rawInsightsDB).MetricsFunc()
 
 	return nil
 }
@@ -0,0 +1,244 @@
+package resolvers
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const insightSeriesAlertKind = "InsightSeriesAlert"
+
+func (r *Resolver) InsightSeriesAlerts(ctx context.Context, args graphqlbackend.InsightSeriesAlertsArgs) ([]graphqlbackend.InsightSeriesAlertResolver, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, auth.ErrNotAuthenticated
+	}
+	series, err := r.viewSeries(ctx, args.InsightViewID)
+	if err != nil {
+		return nil, err
+	}
+
+	seriesIDs := make([]int, 0, len(series))
+	uniqueIDs := make(map[int]string, len(series))
+	for _, s := range series {
+		seriesIDs = append(seriesIDs, s.InsightSeriesID)
+		uniqueIDs[s.InsightSeriesID] = s.SeriesID
+	}
+
+	// 🚨 SECURITY: alerts are evaluated with the permissions of their creator and deliver to
+	// their creator's actions, so users only ever see their own alerts.
+	alertStore := store.NewAlertStore(r.insightsDB)
+	alerts, err := alertStore.ListAlerts(ctx, store.ListAlertsArgs{SeriesIDs: seriesIDs, UserID: a.UID})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.InsightSeriesAlertResolver, 0, len(alerts))
+	for _, alert := range alerts {
+		resolvers = append(resolvers, &insightSeriesAlertResolver{alert: alert, seriesID: uniqueIDs[alert.SeriesID], alertStore: alertStore})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateInsightSeriesAlert(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertArgs) (graphqlbackend.InsightSeriesAlertResolver, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, auth.ErrNotAuthenticated
+	}
+	input := args.Input
+
+	series, err := r.viewSeries(ctx, input.InsightViewID)
+	if err != nil {
+		return nil, err
+	}
+	var target *types.InsightViewSeries
+	for i := range series {
+		if series[i].SeriesID == input.SeriesID {
+			target = &series[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, errors.New("series not found")
+	}
+
+	alert, err := alertFromInput(input, target)
+	if err != nil {
+		return nil, err
+	}
+	alert.UserID = a.UID
+
+	alertStore := store.NewAlertStore(r.insightsDB)
+	created, err := alertStore.CreateAlert(ctx, alert)
+	if err != nil {
+		return nil, err
+	}
+	return &insightSeriesAlertResolver{alert: created, seriesID: target.SeriesID, alertStore: alertStore}, nil
+}
+
+func (r *Resolver) DeleteInsightSeriesAlert(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertArgs) (*graphqlbackend.EmptyResponse, error) {
+	a := actor.FromContext(ctx)
+	if !a.IsAuthenticated() {
+		return nil, auth.ErrNotAuthenticated
+	}
+	var id int
+	if err := relay.UnmarshalSpec(args.ID, &id); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the alert id")
+	}
+
+	// 🚨 SECURITY: users can only delete their own alerts. We return the same error for alerts
+	// of other users to not leak their existence.
+	alertStore := store.NewAlertStore(r.insightsDB)
+	alerts, err := alertStore.ListAlerts(ctx, store.ListAlertsArgs{ID: id, UserID: a.UID})
+	if err != nil {
+		return nil, err
+	}
+	if len(alerts) == 0 {
+		return nil, errors.New("alert not found")
+	}
+	if err := alertStore.DeleteAlert(ctx, id); err != nil {
+		return nil, err
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+// viewSeries returns the series of an insight view the current user can see.
+func (r *Resolver) viewSeries(ctx context.Context, id graphql.ID) ([]types.InsightViewSeries, error) {
+	var viewID string
+	if err := relay.UnmarshalSpec(id, &viewID); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the insight view id")
+	}
+	if err := PermissionsValidatorFromBase(&r.baseInsightResolver).validateUserAccessForView(ctx, viewID); err != nil {
+		return nil, err
+	}
+	return r.insightStore.Get(ctx, store.InsightQueryArgs{WithoutAuthorization: true, UniqueID: viewID})
+}
+
+func alertFromInput(input graphqlbackend.CreateInsightSeriesAlertInput, series *types.InsightViewSeries) (types.InsightSeriesAlert, error) {
+	alert := types.InsightSeriesAlert{
+		SeriesID:        series.InsightSeriesID,
+		Kind:            types.AlertKind(strings.ToLower(input.Kind)),
+		SlackWebhookURL: input.SlackWebhookURL,
+		WebhookURL:      input.WebhookURL,
+	}
+	if input.Email != nil {
+		alert.NotifyEmail = *input.Email
+	}
+
+	switch alert.Kind {
+	case types.ThresholdAlert, types.PercentageChangeAlert:
+		if input.Threshold == nil {
+			return alert, errors.Newf("a threshold is required for %s alerts", input.Kind)
+		}
+		alert.Threshold = *input.Threshold
+		if alert.Kind == types.PercentageChangeAlert && alert.Threshold <= 0 {
+			return alert, errors.New("the threshold of PERCENTAGE_CHANGE alerts must be positive")
+		}
+	case types.NewCaptureValueAlert:
+		if !series.GeneratedFromCaptureGroups {
+			return alert, errors.New("NEW_CAPTURE_VALUE alerts require a series generated from capture groups")
+		}
+	default:
+		return alert, errors.Newf("unsupported alert kind %q", input.Kind)
+	}
+
+	if alert.Kind == types.ThresholdAlert {
+		direction := types.AlertAbove
+		if input.Direction != nil {
+			direction = types.AlertDirection(strings.ToLower(*input.Direction))
+		}
+		alert.Direction = &direction
+	}
+
+	if !alert.NotifyEmail && alert.SlackWebhookURL == nil && alert.WebhookURL == nil {
+		return alert, errors.New("at least one of email, slackWebhookURL or webhookURL is required")
+	}
+	for _, u := range []*string{alert.SlackWebhookURL, alert.WebhookURL} {
+		if u == nil {
+			continue
+		}
+		if parsed, err := url.Parse(*u); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return alert, errors.Newf("invalid webhook URL %q", *u)
+		}
+	}
+	return alert, nil
+}
+
+type insightSeriesAlertResolver struct {
+	alert      types.InsightSeriesAlert
+	seriesID   string
+	alertStore *store.AlertStore
+}
+
+func (r *insightSeriesAlertResolver) ID() graphql.ID {
+	return relay.MarshalID(insightSeriesAlertKind, r.alert.ID)
+}
+
+func (r *insightSeriesAlertResolver) SeriesID() string { return r.seriesID }
+
+func (r *insightSeriesAlertResolver) Kind() string { return strings.ToUpper(string(r.alert.Kind)) }
+
+func (r *insightSeriesAlertResolver) Threshold() float64 { return r.alert.Threshold }
+
+func (r *insightSeriesAlertResolver) Direction() *string {
+	if r.alert.Direction == nil {
+		return nil
+	}
+	direction := strings.ToUpper(string(*r.alert.Direction))
+	return &direction
+}
+
+func (r *insightSeriesAlertResolver) Email() bool { return r.alert.NotifyEmail }
+
+func (r *insightSeriesAlertResolver) SlackWebhookURL() *string { return r.alert.SlackWebhookURL }
+
+func (r *insightSeriesAlertResolver) WebhookURL() *string { return r.alert.WebhookURL }
+
+func (r *insightSeriesAlertResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.alert.CreatedAt}
+}
+
+func (r *insightSeriesAlertResolver) Events(ctx context.Context, args *graphqlbackend.InsightSeriesAlertEventsArgs) ([]graphqlbackend.InsightSeriesAlertEventResolver, error) {
+	events, err := r.alertStore.ListAlertEvents(ctx, r.alert.ID, int(args.First))
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]graphqlbackend.InsightSeriesAlertEventResolver, 0, len(events))
+	for _, event := range events {
+		resolvers = append(resolvers, &insightSeriesAlertEventResolver{event: event})
+	}
+	return resolvers, nil
+}
+
+type insightSeriesAlertEventResolver struct {
+	event types.InsightSeriesAlertEvent
+}
+
+func (r *insightSeriesAlertEventResolver) RecordingTime() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.event.RecordingTime}
+}
+
+func (r *insightSeriesAlertEventResolver) Capture() *string { return r.event.Capture }
+
+func (r *insightSeriesAlertEventResolver) Value() float64 { return r.event.Value }
+
+func (r *insightSeriesAlertEventResolver) PreviousValue() *float64 { return r.event.PreviousValue }
+
+func (r *insightSeriesAlertEventResolver) Message() string { return r.event.Message }
+
+func (r *insightSeriesAlertEventResolver) DeliveryError() *string { return r.event.DeliveryError }
+
+func (r *insightSeriesAlertEventResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.event.CreatedAt}
+}
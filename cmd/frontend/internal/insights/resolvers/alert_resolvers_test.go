@@ -0,0 +1,96 @@
+package resolvers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestAlertFromInput(t *testing.T) {
+	series := &types.InsightViewSeries{InsightSeriesID: 3, SeriesID: "s1"}
+	captureSeries := &types.InsightViewSeries{InsightSeriesID: 4, SeriesID: "s2", GeneratedFromCaptureGroups: true}
+
+	t.Run("threshold defaults to above", func(t *testing.T) {
+		have, err := alertFromInput(graphqlbackend.CreateInsightSeriesAlertInput{
+			Kind:       "THRESHOLD",
+			Threshold:  pointers.Ptr(10.0),
+			WebhookURL: pointers.Ptr("https://example.com/hook"),
+		}, series)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := types.InsightSeriesAlert{
+			SeriesID:   3,
+			Kind:       types.ThresholdAlert,
+			Threshold:  10,
+			Direction:  pointers.Ptr(types.AlertAbove),
+			WebhookURL: pointers.Ptr("https://example.com/hook"),
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected alert (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("new capture value", func(t *testing.T) {
+		have, err := alertFromInput(graphqlbackend.CreateInsightSeriesAlertInput{
+			Kind:  "NEW_CAPTURE_VALUE",
+			Email: pointers.Ptr(true),
+		}, captureSeries)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.Kind != types.NewCaptureValueAlert || have.Direction != nil || !have.NotifyEmail {
+			t.Errorf("unexpected alert: %+v", have)
+		}
+	})
+
+	for _, tc := range []struct {
+		name   string
+		input  graphqlbackend.CreateInsightSeriesAlertInput
+		series *types.InsightViewSeries
+		err    string
+	}{
+		{
+			name:   "missing threshold",
+			input:  graphqlbackend.CreateInsightSeriesAlertInput{Kind: "THRESHOLD", Email: pointers.Ptr(true)},
+			series: series,
+			err:    "a threshold is required",
+		},
+		{
+			name:   "non-positive percentage",
+			input:  graphqlbackend.CreateInsightSeriesAlertInput{Kind: "PERCENTAGE_CHANGE", Threshold: pointers.Ptr(0.0), Email: pointers.Ptr(true)},
+			series: series,
+			err:    "must be positive",
+		},
+		{
+			name:   "new capture value without capture groups",
+			input:  graphqlbackend.CreateInsightSeriesAlertInput{Kind: "NEW_CAPTURE_VALUE", Email: pointers.Ptr(true)},
+			series: series,
+			err:    "require a series generated from capture groups",
+		},
+		{
+			name:   "no action",
+			input:  graphqlbackend.CreateInsightSeriesAlertInput{Kind: "THRESHOLD", Threshold: pointers.Ptr(1.0)},
+			series: series,
+			err:    "at least one of",
+		},
+		{
+			name:   "invalid webhook URL",
+			input:  graphqlbackend.CreateInsightSeriesAlertInput{Kind: "THRESHOLD", Threshold: pointers.Ptr(1.0), SlackWebhookURL: pointers.Ptr("file:///etc/passwd")},
+			series: series,
+			err:    "invalid webhook URL",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := alertFromInput(tc.input, tc.series)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("unexpected error: want %q, have %v", tc.err, err)
+			}
+		})
+	}
+}
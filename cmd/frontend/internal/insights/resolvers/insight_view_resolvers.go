@@ -11,6 +11,7 @@ import (
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/segmentio/ksuid"
+	"github.com/sourcegraph/scip/bindings/go/scip"
 
 	"github.com/sourcegraph/log"
 
@@ -348,6 +349,10 @@ func (s *searchInsightDataSeriesDefinitionResolver) GroupBy() (*string, error) {
 	return s.series.GroupBy, nil
 }
 
+func (s *searchInsightDataSeriesDefinitionResolver) GeneratedFromPreciseReferences() (bool, error) {
+	return s.series.GenerationMethod == types.PreciseReferences, nil
+}
+
 type insightIntervalTimeScopeResolver struct {
 	unit  string
 	value int32
@@ -666,6 +671,10 @@ func isCaptureGroupSeries(generatedFromCaptureGroups *bool) bool {
 	return *generatedFromCaptureGroups
 }
 
+func isPreciseReferencesSeries(generatedFromPreciseReferences *bool) bool {
+	return generatedFromPreciseReferences != nil && *generatedFromPreciseReferences
+}
+
 func updateCaptureGroupInsight(ctx context.Context, input graphqlbackend.LineChartSearchInsightDataSeriesInput, existingSeries []types.InsightViewSeries, view types.InsightView, tx *store.InsightStore, seriesFillStrategy fillSeriesStrategy) error {
 	if len(existingSeries) == 0 {
 		// This should not happen, but if we somehow have no existing series for an insight, create one.
@@ -773,6 +782,9 @@ func existingSeriesHasChanged(new graphqlbackend.LineChartSearchInsightDataSerie
 			return true
 		}
 	}
+	if isPreciseReferencesSeries(new.GeneratedFromPreciseReferences) != (existing.GenerationMethod == types.PreciseReferences) {
+		return true
+	}
 	return emptyIfNil(new.GroupBy) != emptyIfNil(existing.GroupBy)
 }
 
@@ -1316,7 +1328,14 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	var err error
 	var dynamic bool
 	// Validate the query before creating anything; we don't want faulty insights running pointlessly.
-	if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
+	if isPreciseReferencesSeries(series.GeneratedFromPreciseReferences) {
+		if series.GroupBy != nil || isCaptureGroupSeries(series.GeneratedFromCaptureGroups) {
+			return errors.New("precise references series cannot be generated from capture groups or grouped")
+		}
+		if _, err := scip.ParseSymbol(series.Query); err != nil {
+			return errors.Wrap(err, "symbol validation")
+		}
+	} else if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
 		if _, err := querybuilder.ParseComputeQuery(series.Query, gitserver.NewClient("graphql.insights.computequery")); err != nil {
 			return errors.Wrap(err, "query validation")
 		}
@@ -1342,8 +1361,9 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 
 	// Don't try to match on non-global series, since they are always replaced
 	// Also don't try to match on series that use repo criteria
+	// Also don't try to match on precise references series, since their query is not a search query
 	// TODO: Reconsider matching on criteria based series. If so the edit case would need work to ensure other insights remain the same.
-	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil {
+	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil && !isPreciseReferencesSeries(series.GeneratedFromPreciseReferences) {
 		matchingSeries, foundSeries, err = tx.FindMatchingSeries(ctx, store.MatchSeriesArgs{
 			Query:                     series.Query,
 			StepIntervalUnit:          series.TimeScope.StepInterval.Unit,
@@ -1399,6 +1419,9 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 }
 
 func searchGenerationMethod(series graphqlbackend.LineChartSearchInsightDataSeriesInput) types.GenerationMethod {
+	if isPreciseReferencesSeries(series.GeneratedFromPreciseReferences) {
+		return types.PreciseReferences
+	}
 	if series.GeneratedFromCaptureGroups != nil && *series.GeneratedFromCaptureGroups {
 		if series.GroupBy != nil {
 			return types.MappingCompute
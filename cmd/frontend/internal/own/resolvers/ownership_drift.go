@@ -0,0 +1,144 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	owntypes "github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	_ graphqlbackend.OwnershipDriftFindingResolver           = &ownershipDriftFindingResolver{}
+	_ graphqlbackend.OwnershipDriftFindingConnectionResolver = &ownershipDriftFindingConnectionResolver{}
+)
+
+func (r *ownResolver) RepoOwnershipDriftFindings(ctx context.Context, repo *graphqlbackend.RepositoryResolver, args *graphqlbackend.OwnershipDriftFindingsArgs) (graphqlbackend.OwnershipDriftFindingConnectionResolver, error) {
+	// This endpoint is open to anyone who can see the repository, which the
+	// repository resolver makes sure of.
+	opts := database.ListOwnDriftFindingsOpts{RepoID: repo.IDInt32()}
+	if args.Kinds != nil {
+		for _, kind := range *args.Kinds {
+			opts.Kinds = append(opts.Kinds, driftKindFromEnum(kind))
+		}
+	}
+	if args.After != nil {
+		cursor, err := graphqlutil.DecodeIntCursor(args.After)
+		if err != nil {
+			return nil, err
+		}
+		opts.Cursor = int32(cursor)
+		if int(opts.Cursor) != cursor {
+			return nil, errors.Newf("cursor int32 overflow: %d", cursor)
+		}
+	}
+	if args.First != nil {
+		opts.LimitOffset = &database.LimitOffset{Limit: int(*args.First)}
+	}
+	return &ownershipDriftFindingConnectionResolver{
+		store: r.db.OwnDriftFindings(),
+		opts:  opts,
+	}, nil
+}
+
+type ownershipDriftFindingConnectionResolver struct {
+	store database.OwnDriftFindingStore
+	opts  database.ListOwnDriftFindingsOpts
+
+	once     sync.Once
+	pageInfo *graphqlutil.PageInfo
+	err      error
+
+	findings []*database.OwnDriftFinding
+}
+
+func (r *ownershipDriftFindingConnectionResolver) compute(ctx context.Context) {
+	r.once.Do(func() {
+		findings, next, err := r.store.ListFindings(ctx, r.opts)
+		if err != nil {
+			r.err = err
+			return
+		}
+		r.findings = findings
+		if next > 0 {
+			r.pageInfo = graphqlutil.EncodeIntCursor(&next)
+		} else {
+			r.pageInfo = graphqlutil.HasNextPage(false)
+		}
+	})
+}
+
+func (r *ownershipDriftFindingConnectionResolver) Nodes(ctx context.Context) ([]graphqlbackend.OwnershipDriftFindingResolver, error) {
+	r.compute(ctx)
+	if r.err != nil {
+		return nil, r.err
+	}
+	resolvers := make([]graphqlbackend.OwnershipDriftFindingResolver, 0, len(r.findings))
+	for _, f := range r.findings {
+		resolvers = append(resolvers, &ownershipDriftFindingResolver{finding: f})
+	}
+	return resolvers, nil
+}
+
+func (r *ownershipDriftFindingConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	return r.store.CountFindings(ctx, r.opts)
+}
+
+func (r *ownershipDriftFindingConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	r.compute(ctx)
+	return r.pageInfo, r.err
+}
+
+type ownershipDriftFindingResolver struct {
+	finding *database.OwnDriftFinding
+}
+
+func (r *ownershipDriftFindingResolver) Path() string {
+	return r.finding.FilePath
+}
+
+func (r *ownershipDriftFindingResolver) Kind() string {
+	return driftKindToEnum(r.finding.Kind)
+}
+
+func (r *ownershipDriftFindingResolver) Owner() string {
+	return r.finding.Owner
+}
+
+func (r *ownershipDriftFindingResolver) Source() string {
+	return strings.ToUpper(r.finding.Source)
+}
+
+func (r *ownershipDriftFindingResolver) Description() string {
+	switch r.finding.Kind {
+	case owntypes.DriftKindDeactivatedOwner:
+		return fmt.Sprintf("%s owns this file but is a deactivated user.", r.finding.Owner)
+	case owntypes.DriftKindEmptyTeam:
+		return fmt.Sprintf("%s owns this file but is a team without any active members.", r.finding.Owner)
+	case owntypes.DriftKindStaleOwner:
+		return fmt.Sprintf("%s owns this file but has not contributed to it recently while others have.", r.finding.Owner)
+	}
+	return fmt.Sprintf("%s owns this file but is unlikely to still own it.", r.finding.Owner)
+}
+
+func (r *ownershipDriftFindingResolver) ComputedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.finding.ComputedAt}
+}
+
+// driftKindToEnum converts a drift kind like "stale-owner" to its GraphQL enum
+// value like "STALE_OWNER".
+func driftKindToEnum(kind string) string {
+	return strings.ToUpper(strings.ReplaceAll(kind, "-", "_"))
+}
+
+// driftKindFromEnum converts a GraphQL enum value like "STALE_OWNER" to its
+// drift kind like "stale-owner".
+func driftKindFromEnum(enum string) string {
+	return strings.ToLower(strings.ReplaceAll(enum, "_", "-"))
+}
@@ -0,0 +1,53 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func (r *ownResolver) computeRecentReviewerSignals(ctx context.Context, repo *graphqlbackend.RepositoryResolver, path string) ([]reasonAndReference, error) {
+	reviewers, err := r.ownService().RecentReviewers(ctx, repo.IDInt32(), path)
+	if err != nil {
+		return nil, errors.Wrap(err, "RecentReviewers")
+	}
+	if len(reviewers) == 0 {
+		return nil, nil
+	}
+
+	// Reviewer handles are code host handles, so resolve them in the context of
+	// the code host of the repository if possible.
+	var repoContext *own.RepoContext
+	if spec, err := repo.ExternalRepo(ctx); err == nil {
+		repoContext = &own.RepoContext{
+			Name:         repo.RepoName(),
+			CodeHostKind: spec.ServiceType,
+		}
+	}
+
+	var rrs []reasonAndReference
+	for _, reviewer := range reviewers {
+		rrs = append(rrs, reasonAndReference{
+			reason: ownershipReason{recentReviewsCount: reviewer.ReviewsCount},
+			reference: own.Reference{
+				RepoContext: repoContext,
+				Handle:      reviewer.ReviewerHandle,
+			},
+		})
+	}
+	return rrs, nil
+}
+
+type recentReviewerOwnershipSignal struct {
+	total int32
+}
+
+func (v *recentReviewerOwnershipSignal) Title() (string, error) {
+	return "recent reviewer", nil
+}
+
+func (v *recentReviewerOwnershipSignal) Description() (string, error) {
+	return "Associated because they have reviewed changes to this file in the last 90 days.", nil
+}
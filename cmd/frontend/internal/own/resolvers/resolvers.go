@@ -54,6 +54,8 @@ var (
 	_ graphqlbackend.SimpleOwnReasonResolver                  = &recentContributorOwnershipSignal{}
 	_ graphqlbackend.RecentViewOwnershipSignalResolver        = &recentViewOwnershipSignal{}
 	_ graphqlbackend.SimpleOwnReasonResolver                  = &recentViewOwnershipSignal{}
+	_ graphqlbackend.RecentReviewerOwnershipSignalResolver    = &recentReviewerOwnershipSignal{}
+	_ graphqlbackend.SimpleOwnReasonResolver                  = &recentReviewerOwnershipSignal{}
 	_ graphqlbackend.AssignedOwnerResolver                    = &assignedOwner{}
 	_ graphqlbackend.SimpleOwnReasonResolver                  = &assignedOwner{}
 	_ graphqlbackend.SimpleOwnReasonResolver                  = &codeownersFileEntryResolver{}
@@ -75,6 +77,7 @@ type ownershipReason struct {
 	codeownersSource         codeowners.RulesetSource
 	recentContributionsCount int
 	recentViewsCount         int
+	recentReviewsCount       int
 	assignedOwnerPath        []string
 }
 
@@ -105,6 +108,11 @@ func (o *ownershipReasonResolver) ToRecentViewOwnershipSignal() (res graphqlback
 	return
 }
 
+func (o *ownershipReasonResolver) ToRecentReviewerOwnershipSignal() (res graphqlbackend.RecentReviewerOwnershipSignalResolver, ok bool) {
+	res, ok = o.resolver.(*recentReviewerOwnershipSignal)
+	return
+}
+
 func (o *ownershipReasonResolver) ToAssignedOwner() (res graphqlbackend.AssignedOwnerResolver, ok bool) {
 	res, ok = o.resolver.(*assignedOwner)
 	return
@@ -154,6 +162,15 @@ func (r *ownResolver) GitBlobOwnership(
 		rrs = append(rrs, viewerResolvers...)
 	}
 
+	// Retrieve recent reviewer signals.
+	if args.IncludeReason(graphqlbackend.RecentReviewerOwnershipSignal) {
+		reviewerResolvers, err := r.computeRecentReviewerSignals(ctx, blob.Repository(), blob.Path())
+		if err != nil {
+			return nil, err
+		}
+		rrs = append(rrs, reviewerResolvers...)
+	}
+
 	if args.IncludeReason(graphqlbackend.AssignedOwner) {
 		// Retrieve assigned owners.
 		assignedOwners, err := r.computeAssignedOwners(ctx, blob, repoID)
@@ -206,6 +223,13 @@ func (r *ownResolver) GitCommitOwnership(
 	}
 	rrs = append(rrs, viewerResolvers...)
 
+	// Retrieve recent reviewer signals.
+	reviewerResolvers, err := r.computeRecentReviewerSignals(ctx, commit.Repository(), repoRootPath)
+	if err != nil {
+		return nil, err
+	}
+	rrs = append(rrs, reviewerResolvers...)
+
 	return r.ownershipConnection(ctx, args, rrs, commit.Repository(), "")
 }
 
@@ -231,6 +255,13 @@ func (r *ownResolver) GitTreeOwnership(
 	}
 	rrs = append(rrs, viewerResolvers...)
 
+	// Retrieve recent reviewer signals.
+	reviewerResolvers, err := r.computeRecentReviewerSignals(ctx, tree.Repository(), tree.Path())
+	if err != nil {
+		return nil, err
+	}
+	rrs = append(rrs, reviewerResolvers...)
+
 	// Retrieve assigned owners.
 	assignedOwners, err := r.computeAssignedOwners(ctx, tree, repoID)
 	if err != nil {
@@ -316,24 +347,29 @@ func (ro reasonsAndOwner) String() string {
 		if r.recentViewsCount > 0 {
 			fmt.Fprint(&b, " recent-viewer")
 		}
+		if r.recentReviewsCount > 0 {
+			fmt.Fprint(&b, " recent-reviewer")
+		}
 	}
 	return b.String()
 }
 
 func (ro reasonsAndOwner) order() int {
-	var ownershipReasons, reasons, contributions, views int
+	var ownershipReasons, reasons, contributions, reviews, views int
 	for _, r := range ro.reasons {
 		if len(r.assignedOwnerPath) > 0 || r.codeownersRule != nil {
 			ownershipReasons++
 		}
 		reasons++
 		contributions += r.recentContributionsCount
+		reviews += r.recentReviewsCount
 		views += r.recentViewsCount
 	}
 	// Smaller numbers are ordered in front, so take negative score.
 	return -(100000*ownershipReasons +
 		1000*reasons +
 		10*contributions +
+		10*reviews +
 		views)
 }
 
@@ -573,6 +609,13 @@ func (r *ownershipResolver) Reasons(_ context.Context) ([]graphqlbackend.Ownersh
 				},
 			})
 		}
+		if reason.recentReviewsCount > 0 {
+			rs = append(rs, &ownershipReasonResolver{
+				resolver: &recentReviewerOwnershipSignal{
+					total: int32(reason.recentReviewsCount),
+				},
+			})
+		}
 	}
 	return rs, nil
 }
@@ -54,6 +54,7 @@ type fakeOwnService struct {
 	Ruleset        *codeowners.Ruleset
 	AssignedOwners own.AssignedOwners
 	Teams          own.AssignedTeams
+	Reviewers      []database.RecentReviewerSummary
 }
 
 func (s fakeOwnService) RulesetForRepo(context.Context, api.RepoName, api.RepoID, api.CommitID) (*codeowners.Ruleset, error) {
@@ -82,6 +83,10 @@ func (s fakeOwnService) AssignedTeams(context.Context, api.RepoID, api.CommitID)
 	return s.Teams, nil
 }
 
+func (s fakeOwnService) RecentReviewers(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+	return s.Reviewers, nil
+}
+
 // fakeGitServer is a limited gitserver.Client that returns a file for every Stat call.
 type fakeGitserver struct {
 	gitserver.Client
@@ -932,6 +937,136 @@ func TestOwnership_WithSignals(t *testing.T) {
 	})
 }
 
+func TestOwnership_WithRecentReviewerSignal(t *testing.T) {
+	logger := logtest.Scoped(t)
+	fakeDB := fakedb.New()
+	db := fakeOwnDb()
+	db.UserExternalAccountsFunc.SetDefaultReturn(dbmocks.NewMockUserExternalAccountsStore())
+	fakeDB.Wire(db)
+
+	repoID := api.RepoID(1)
+	own := fakeOwnService{
+		Reviewers: []database.RecentReviewerSummary{
+			{ReviewerHandle: "rudolph", ReviewsCount: 3},
+			{ReviewerHandle: "dasher", ReviewsCount: 1},
+		},
+	}
+	ctx := userCtx(fakeDB.AddUser(types.User{Username: santaName, DisplayName: santaName, SiteAdmin: true}))
+	repos := dbmocks.NewMockRepoStore()
+	db.ReposFunc.SetDefaultReturn(repos)
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: repoID, Name: "github.com/sourcegraph/own"}, nil)
+	backend.Mocks.Repos.ResolveRev = func(_ context.Context, repo *types.Repo, rev string) (api.CommitID, error) {
+		return "deadbeef", nil
+	}
+	git := fakeGitserver{}
+	schema, err := graphqlbackend.NewSchema(db, git, []graphqlbackend.OptionalResolver{{OwnResolver: resolvers.NewWithService(db, git, own, logger)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := `
+		query FetchOwnership($repo: ID!, $revision: String!, $currentPath: String!, $reasons: [OwnershipReasonType!]) {
+			node(id: $repo) {
+				... on Repository {
+					commit(rev: $revision) {
+						blob(path: $currentPath) {
+							ownership(reasons: $reasons) {
+								totalCount
+								nodes {
+									owner {
+										...on Person {
+											displayName
+										}
+									}
+									reasons {
+										... on RecentReviewerOwnershipSignal {
+											title
+											description
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}`
+
+	t.Run("reviewers are ranked", func(t *testing.T) {
+		graphqlbackend.RunTest(t, &graphqlbackend.Test{
+			Schema:  schema,
+			Context: ctx,
+			Query:   query,
+			ExpectedResult: `{
+				"node": {
+					"commit": {
+						"blob": {
+							"ownership": {
+								"totalCount": 2,
+								"nodes": [
+									{
+										"owner": {
+											"displayName": "rudolph"
+										},
+										"reasons": [
+											{
+												"title": "recent reviewer",
+												"description": "Associated because they have reviewed changes to this file in the last 90 days."
+											}
+										]
+									},
+									{
+										"owner": {
+											"displayName": "dasher"
+										},
+										"reasons": [
+											{
+												"title": "recent reviewer",
+												"description": "Associated because they have reviewed changes to this file in the last 90 days."
+											}
+										]
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"repo":        string(graphqlbackend.MarshalRepositoryID(repoID)),
+				"revision":    "revision",
+				"currentPath": "foo/bar.js",
+			},
+		})
+	})
+
+	t.Run("filtered by reason", func(t *testing.T) {
+		graphqlbackend.RunTest(t, &graphqlbackend.Test{
+			Schema:  schema,
+			Context: ctx,
+			Query:   query,
+			ExpectedResult: `{
+				"node": {
+					"commit": {
+						"blob": {
+							"ownership": {
+								"totalCount": 0,
+								"nodes": []
+							}
+						}
+					}
+				}
+			}`,
+			Variables: map[string]any{
+				"repo":        string(graphqlbackend.MarshalRepositoryID(repoID)),
+				"revision":    "revision",
+				"currentPath": "foo/bar.js",
+				"reasons":     []any{"RECENT_VIEW_OWNERSHIP_SIGNAL"},
+			},
+		})
+	})
+}
+
 func TestTreeOwnershipSignals(t *testing.T) {
 	logger := logtest.Scoped(t)
 	fakeDB := fakedb.New()
@@ -1299,6 +1434,18 @@ func Test_SignalConfigurations(t *testing.T) {
 			  "description": "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			  "isEnabled": false,
 			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "recent-reviewers",
+			  "description": "Indexes reviewers of recently merged and open changesets in each file using code host review events.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "ownership-drift",
+			  "description": "Flags files whose declared owners are deactivated users, empty teams, or have not contributed to the file recently while others have.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
 			}
 		  ]
 		}`,
@@ -1361,6 +1508,16 @@ func Test_SignalConfigurations(t *testing.T) {
 				Name:        "analytics",
 				Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			},
+			{
+				ID:          4,
+				Name:        owntypes.SignalRecentReviewers,
+				Description: "Indexes reviewers of recently merged and open changesets in each file using code host review events.",
+			},
+			{
+				ID:          5,
+				Name:        owntypes.OwnershipDrift,
+				Description: "Flags files whose declared owners are deactivated users, empty teams, or have not contributed to the file recently while others have.",
+			},
 		}).Equal(t, configsFromDb)
 
 		readTest := baseReadTest
@@ -1393,6 +1550,18 @@ func Test_SignalConfigurations(t *testing.T) {
 			  "description": "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			  "isEnabled": false,
 			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "recent-reviewers",
+			  "description": "Indexes reviewers of recently merged and open changesets in each file using code host review events.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
+			},
+			{
+			  "name": "ownership-drift",
+			  "description": "Flags files whose declared owners are deactivated users, empty teams, or have not contributed to the file recently while others have.",
+			  "isEnabled": false,
+			  "excludedRepoPatterns": []
 			}
 		  ]
 		}`
@@ -2278,3 +2447,90 @@ func createTeam(t *testing.T, ctx context.Context, db database.DB, teamName stri
 	require.NoError(t, err)
 	return team
 }
+
+func TestOwnershipDriftFindings(t *testing.T) {
+	logger := logtest.Scoped(t)
+	fakeDB := fakedb.New()
+	db := fakeOwnDb()
+	fakeDB.Wire(db)
+	repoID := api.RepoID(1)
+	repos := dbmocks.NewMockRepoStore()
+	db.ReposFunc.SetDefaultReturn(repos)
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: repoID, Name: "github.com/sourcegraph/own"}, nil)
+
+	computedAt := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	findings := dbmocks.NewMockOwnDriftFindingStore()
+	findings.ListFindingsFunc.SetDefaultHook(func(_ context.Context, opts database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error) {
+		assert.Equal(t, repoID, opts.RepoID)
+		assert.Equal(t, []string{owntypes.DriftKindStaleOwner, owntypes.DriftKindEmptyTeam}, opts.Kinds)
+		assert.Equal(t, &database.LimitOffset{Limit: 2}, opts.LimitOffset)
+		return []*database.OwnDriftFinding{
+			{ID: 1, RepoID: repoID, FilePath: "foo/bar.go", Kind: owntypes.DriftKindStaleOwner, Owner: "@alice", Source: owntypes.DriftSourceCodeowners, ComputedAt: computedAt},
+			{ID: 2, RepoID: repoID, FilePath: "foo/baz.go", Kind: owntypes.DriftKindEmptyTeam, Owner: "@ghosts", Source: owntypes.DriftSourceAssigned, ComputedAt: computedAt},
+		}, 3, nil
+	})
+	findings.CountFindingsFunc.SetDefaultReturn(3, nil)
+	db.OwnDriftFindingsFunc.SetDefaultReturn(findings)
+
+	ctx := userCtx(fakeDB.AddUser(types.User{Username: santaName, DisplayName: santaName}))
+	git := fakeGitserver{}
+	schema, err := graphqlbackend.NewSchema(db, git, []graphqlbackend.OptionalResolver{{OwnResolver: resolvers.NewWithService(db, git, fakeOwnService{}, logger)}})
+	require.NoError(t, err)
+
+	graphqlbackend.RunTest(t, &graphqlbackend.Test{
+		Schema:  schema,
+		Context: ctx,
+		Query: `
+			query FetchOwnershipDrift($repo: ID!) {
+				node(id: $repo) {
+					... on Repository {
+						ownershipDriftFindings(kinds: [STALE_OWNER, EMPTY_TEAM], first: 2) {
+							totalCount
+							pageInfo {
+								hasNextPage
+							}
+							nodes {
+								path
+								kind
+								owner
+								source
+								description
+								computedAt
+							}
+						}
+					}
+				}
+			}`,
+		ExpectedResult: `{
+			"node": {
+				"ownershipDriftFindings": {
+					"totalCount": 3,
+					"pageInfo": {
+						"hasNextPage": true
+					},
+					"nodes": [
+						{
+							"path": "foo/bar.go",
+							"kind": "STALE_OWNER",
+							"owner": "@alice",
+							"source": "CODEOWNERS",
+							"description": "@alice owns this file but has not contributed to it recently while others have.",
+							"computedAt": "2023-12-01T00:00:00Z"
+						},
+						{
+							"path": "foo/baz.go",
+							"kind": "EMPTY_TEAM",
+							"owner": "@ghosts",
+							"source": "ASSIGNED",
+							"description": "@ghosts owns this file but is a team without any active members.",
+							"computedAt": "2023-12-01T00:00:00Z"
+						}
+					]
+				}
+			}
+		}`,
+		Variables: map[string]any{
+			"repo": string(graphqlbackend.MarshalRepositoryID(repoID)),
+		},
+	})
+}
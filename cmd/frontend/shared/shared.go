@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/compute"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/contentlibrary"
 	internalcontext "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/context"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/deadletter"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/dotcom"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/internal/embeddings"
 	executor "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/executorqueue"
@@ -71,6 +72,7 @@ var initFunctions = map[string]EnterpriseInitializer{
 	"scim":           scim.Init,
 	"searchcontexts": searchcontexts.Init,
 	"contentLibrary": contentlibrary.Init,
+	"deadLetter":     deadletter.Init,
 	"search":         search.Init,
 	"telemetry":      telemetry.Init,
 }
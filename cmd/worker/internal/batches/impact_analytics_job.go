@@ -0,0 +1,42 @@
+package batches
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/batches/workers"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/recurring"
+)
+
+type impactAnalyticsJob struct{}
+
+func NewImpactAnalyticsJob() job.Job {
+	return &impactAnalyticsJob{}
+}
+
+func (j *impactAnalyticsJob) Description() string {
+	return "computes the impact analytics of batch changes from their changeset events"
+}
+
+func (j *impactAnalyticsJob) Config() []env.Config {
+	return []env.Config{}
+}
+
+func (j *impactAnalyticsJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	bstore, err := InitStore()
+	if err != nil {
+		return nil, err
+	}
+
+	ownService := own.NewService(gitserver.NewClient("batches.impactanalytics"), bstore.DatabaseDB())
+
+	return recurring.NewRoutines(observationCtx, bstore.DatabaseDB(), recurring.Options{
+		Name: "batches_impact_analytics",
+		Jobs: []recurring.Job{workers.NewImpactAnalyticsJob(bstore, ownService)},
+	})
+}
@@ -0,0 +1,29 @@
+package janitor
+
+import (
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// NewBulkOperationDeadLetterJanitor creates a dbworker.DeadLetterJanitor that deletes
+// changeset_jobs that failed longer than the given retention ago.
+//
+// The other batch changes queues are not purged: the records of the reconciler are the
+// changesets themselves, and failed workspace jobs are part of the batch spec they ran for.
+func NewBulkOperationDeadLetterJanitor(observationCtx *observation.Context, logger log.Logger, workerStore dbworkerstore.Store[*types.ChangesetJob], retention time.Duration) *dbworker.DeadLetterJanitor[*types.ChangesetJob] {
+	options := dbworker.DeadLetterJanitorOptions{
+		Name:      "batches_bulk_worker_dead_letter_janitor",
+		Interval:  1 * time.Hour,
+		Retention: retention,
+		BatchSize: 1000,
+		Metrics:   dbworker.NewDeadLetterJanitorMetrics(observationCtx, "batch_changes_bulk_processor"),
+	}
+
+	return dbworker.NewDeadLetterJanitor(logger, workerStore, options)
+}
@@ -1,6 +1,8 @@
 package batches
 
 import (
+	"time"
+
 	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/executorqueue"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -10,6 +12,8 @@ type janitorConfig struct {
 	env.BaseConfig
 
 	MetricsConfig *executorqueue.Config
+
+	FailedBulkOperationRetention time.Duration
 }
 
 var janitorConfigInst = &janitorConfig{}
@@ -17,6 +21,8 @@ var janitorConfigInst = &janitorConfig{}
 func (c *janitorConfig) Load() {
 	c.MetricsConfig = executorqueue.InitMetricsConfig()
 	c.MetricsConfig.Load()
+
+	c.FailedBulkOperationRetention = c.GetInterval("BATCH_CHANGES_FAILED_BULK_OPERATION_RETENTION", "720h", "The duration for which failed bulk operation jobs are kept before they are deleted.")
 }
 
 func (c *janitorConfig) Validate() error {
@@ -83,6 +83,13 @@ func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Con
 			janitorMetrics,
 		),
 
+		janitor.NewBulkOperationDeadLetterJanitor(
+			observationCtx,
+			observationCtx.Logger.Scoped("BulkOperationDeadLetterJanitor"),
+			bulkOperationStore,
+			janitorConfigInst.FailedBulkOperationRetention,
+		),
+
 		janitor.NewSpecExpirer(workCtx, bstore),
 		janitor.NewCacheEntryCleaner(workCtx, bstore),
 		janitor.NewChangesetDetachedCleaner(workCtx, bstore),
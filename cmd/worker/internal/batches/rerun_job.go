@@ -0,0 +1,38 @@
+package batches
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/internal/batches/workers"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/recurring"
+)
+
+type rerunJob struct{}
+
+func NewRerunJob() job.Job {
+	return &rerunJob{}
+}
+
+func (j *rerunJob) Description() string {
+	return "reruns batch changes on the schedule of their rerun policy"
+}
+
+func (j *rerunJob) Config() []env.Config {
+	return []env.Config{}
+}
+
+func (j *rerunJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	bstore, err := InitStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return recurring.NewRoutines(observationCtx, bstore.DatabaseDB(), recurring.Options{
+		Name: "batches_reruns",
+		Jobs: []recurring.Job{workers.NewBatchChangeRerunJob(bstore)},
+	})
+}
@@ -0,0 +1,139 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/recurring"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewBatchChangeRerunJob returns the recurring job that reruns the batch
+// changes whose batch spec declares a rerun policy. Every minute, it advances
+// the reruns that are processing and starts a rerun of each batch change whose
+// schedule is due.
+func NewBatchChangeRerunJob(s *store.Store) recurring.Job {
+	r := &batchChangeRerunner{
+		store:   s,
+		service: service.New(s),
+		clock:   s.Clock(),
+	}
+
+	return recurring.Job{
+		Kind:     "batches-batch-change-rerunner",
+		Schedule: "* * * * *",
+		Handler:  r,
+	}
+}
+
+type batchChangeRerunner struct {
+	store   *store.Store
+	service *service.Service
+	clock   func() time.Time
+}
+
+var _ recurring.Handler = &batchChangeRerunner{}
+
+func (r *batchChangeRerunner) Handle(ctx context.Context, logger log.Logger, _ *recurring.Run) error {
+	var errs error
+
+	processing, _, err := r.store.ListBatchChangeReruns(ctx, store.ListBatchChangeRerunsOpts{State: btypes.BatchChangeRerunStateProcessing})
+	if err != nil {
+		return errors.Wrap(err, "listing processing reruns")
+	}
+
+	// A batch change is only rerun once its previous rerun finished.
+	busy := make(map[int64]struct{}, len(processing))
+	for _, rerun := range processing {
+		if err := r.service.ProcessBatchChangeRerun(ctx, rerun); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "processing rerun %d", rerun.ID))
+		}
+
+		switch rerun.State {
+		case btypes.BatchChangeRerunStateProcessing:
+			busy[rerun.BatchChangeID] = struct{}{}
+		case btypes.BatchChangeRerunStateFailed:
+			logger.Warn("batch change rerun failed", log.Int64("batchChange", rerun.BatchChangeID), log.String("reason", rerun.FailureMessage))
+		}
+	}
+
+	batchChanges, err := r.store.ListRecurringBatchChanges(ctx)
+	if err != nil {
+		return errors.Append(errs, errors.Wrap(err, "listing recurring batch changes"))
+	}
+
+	now := r.clock()
+	for _, batchChange := range batchChanges {
+		if _, ok := busy[batchChange.ID]; ok {
+			continue
+		}
+
+		if err := r.startIfDue(ctx, logger, batchChange, now); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "starting rerun of batch change %d", batchChange.ID))
+		}
+	}
+
+	return errs
+}
+
+// startIfDue starts a rerun of the given batch change if a time of its
+// schedule passed since it was last applied or rerun.
+func (r *batchChangeRerunner) startIfDue(ctx context.Context, logger log.Logger, batchChange *btypes.BatchChange, now time.Time) error {
+	spec, err := r.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return err
+	}
+	if spec.Spec.Rerun == nil {
+		return nil
+	}
+
+	schedule, err := btypes.ParseRerunSchedule(spec.Spec.Rerun.Schedule)
+	if err != nil {
+		// The schedule is validated when the batch spec is created, so this
+		// can only happen for schedules that ran out of upcoming times.
+		logger.Warn("invalid rerun schedule", log.Int64("batchChange", batchChange.ID), log.Error(err))
+		return nil
+	}
+
+	since := batchChange.LastAppliedAt
+	last, err := r.store.GetLastBatchChangeRerun(ctx, batchChange.ID)
+	if err != nil && err != store.ErrNoResults {
+		return err
+	}
+	if last != nil && last.ScheduledAt.After(since) {
+		since = last.ScheduledAt
+	}
+
+	due := dueRerunTime(schedule, since, now)
+	if due.IsZero() {
+		return nil
+	}
+
+	rerun, err := r.service.StartBatchChangeRerun(ctx, batchChange, due)
+	if err != nil {
+		return err
+	}
+	logger.Info("started batch change rerun",
+		log.Int64("batchChange", batchChange.ID),
+		log.Int64("batchSpec", rerun.BatchSpecID),
+		log.Time("scheduledAt", due),
+		log.String("state", string(rerun.State)),
+	)
+	return nil
+}
+
+// dueRerunTime returns the latest time of the given schedule after since and
+// at or before now, or the zero time if the schedule has no such time. Missed
+// schedule times collapse into a single rerun.
+func dueRerunTime(schedule *cronexpr.Expression, since, now time.Time) (due time.Time) {
+	for t := schedule.Next(since.UTC()); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		due = t
+	}
+	return due
+}
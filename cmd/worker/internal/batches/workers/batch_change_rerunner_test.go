@@ -0,0 +1,62 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+)
+
+func TestDueRerunTime(t *testing.T) {
+	schedule := cronexpr.MustParse("0 6 * * *")
+	at := func(s string) time.Time {
+		t.Helper()
+		ts, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ts
+	}
+
+	for _, tc := range []struct {
+		name  string
+		since time.Time
+		now   time.Time
+		want  time.Time
+	}{
+		{
+			name:  "not due yet",
+			since: at("2023-12-01T06:20:00Z"),
+			now:   at("2023-12-02T05:59:00Z"),
+		},
+		{
+			name:  "due",
+			since: at("2023-12-01T06:20:00Z"),
+			now:   at("2023-12-02T06:01:00Z"),
+			want:  at("2023-12-02T06:00:00Z"),
+		},
+		{
+			name:  "due exactly now",
+			since: at("2023-12-01T06:20:00Z"),
+			now:   at("2023-12-02T06:00:00Z"),
+			want:  at("2023-12-02T06:00:00Z"),
+		},
+		{
+			name:  "last rerun at the schedule time",
+			since: at("2023-12-02T06:00:00Z"),
+			now:   at("2023-12-02T12:00:00Z"),
+		},
+		{
+			name:  "missed schedule times collapse into the latest",
+			since: at("2023-12-01T06:20:00Z"),
+			now:   at("2023-12-05T08:00:00Z"),
+			want:  at("2023-12-05T06:00:00Z"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if have := dueRerunTime(schedule, tc.since, tc.now); !have.Equal(tc.want) {
+				t.Fatalf("wrong due time. want=%s, have=%s", tc.want, have)
+			}
+		})
+	}
+}
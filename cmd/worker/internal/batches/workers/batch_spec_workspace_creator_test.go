@@ -1011,6 +1011,10 @@ func (d *dummyWorkspaceResolver) ResolveWorkspacesForBatchSpec(context.Context,
 	return d.workspaces, d.err
 }
 
+func (d *dummyWorkspaceResolver) ResolveRolloutTiers(context.Context, *batcheslib.BatchSpec) (map[api.RepoID]int, error) {
+	return nil, d.err
+}
+
 var testDiff = []byte(`diff README.md README.md
 index 671e50a..851b23a 100644
 --- README.md
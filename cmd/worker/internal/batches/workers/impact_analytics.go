@@ -0,0 +1,227 @@
+package workers
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/state"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/recurring"
+	"github.com/sourcegraph/sourcegraph/lib/batches/git"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewImpactAnalyticsJob returns the recurring job that computes the impact
+// analytics of open batch changes. Every hour, it computes the impact metrics
+// of their published changesets from the changeset events, and aggregates
+// them per batch change, code host and owning team.
+func NewImpactAnalyticsJob(s *store.Store, ownService own.Service) recurring.Job {
+	return recurring.Job{
+		Kind:     "batches-impact-analytics",
+		Schedule: "0 * * * *",
+		Handler: &impactAnalyticsComputer{
+			store: s,
+			teams: &ownTeamResolver{store: s, own: ownService},
+		},
+	}
+}
+
+// changesetTeamResolver resolves the team owning a changeset.
+type changesetTeamResolver interface {
+	// ChangesetTeam returns the team owning most of the files changed by the
+	// changeset, or an empty string if there is no owning team.
+	ChangesetTeam(ctx context.Context, ch *btypes.Changeset) (string, error)
+}
+
+type impactAnalyticsComputer struct {
+	store *store.Store
+	teams changesetTeamResolver
+}
+
+var _ recurring.Handler = &impactAnalyticsComputer{}
+
+func (c *impactAnalyticsComputer) Handle(ctx context.Context, logger log.Logger, _ *recurring.Run) error {
+	var errs error
+
+	opts := store.ListBatchChangesOpts{States: []btypes.BatchChangeState{btypes.BatchChangeStateOpen}}
+	for {
+		batchChanges, next, err := c.store.ListBatchChanges(ctx, opts)
+		if err != nil {
+			return errors.Append(errs, errors.Wrap(err, "listing batch changes"))
+		}
+
+		for _, batchChange := range batchChanges {
+			if err := c.compute(ctx, logger, batchChange); err != nil {
+				errs = errors.Append(errs, errors.Wrapf(err, "computing impact analytics of batch change %d", batchChange.ID))
+			}
+		}
+
+		if next == 0 {
+			return errs
+		}
+		opts.Cursor = next
+	}
+}
+
+// compute computes and stores the impact metrics of the published changesets
+// of the given batch change, and the aggregated impact stats of the batch
+// change.
+func (c *impactAnalyticsComputer) compute(ctx context.Context, logger log.Logger, batchChange *btypes.BatchChange) error {
+	published := btypes.ChangesetPublicationStatePublished
+	cs, _, err := c.store.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID:    batchChange.ID,
+		PublicationState: &published,
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing changesets")
+	}
+
+	eventsByChangeset := make(map[int64][]*btypes.ChangesetEvent, len(cs))
+	if len(cs) > 0 {
+		kinds := append(append([]btypes.ChangesetEventKind{}, state.RequiredEventTypesForHistory...), state.RequiredEventTypesForImpact...)
+		es, _, err := c.store.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{ChangesetIDs: cs.IDs(), Kinds: kinds})
+		if err != nil {
+			return errors.Wrap(err, "listing changeset events")
+		}
+		for _, e := range es {
+			eventsByChangeset[e.ChangesetID] = append(eventsByChangeset[e.ChangesetID], e)
+		}
+	}
+
+	metrics := make([]*btypes.ChangesetImpactMetrics, 0, len(cs))
+	for _, ch := range cs {
+		m, err := state.CalcChangesetImpact(ch, eventsByChangeset[ch.ID])
+		if err != nil {
+			// Changesets that were never synced don't have the data we need
+			// yet, so we leave them out until they are.
+			logger.Debug("skipping changeset without impact metrics", log.Int64("changeset", ch.ID), log.Error(err))
+			continue
+		}
+
+		m.Team, err = c.teams.ChangesetTeam(ctx, ch)
+		if err != nil {
+			// Ownership is best effort: the changeset is still counted, just
+			// without a team.
+			logger.Warn("resolving team of changeset", log.Int64("changeset", ch.ID), log.Error(err))
+		}
+
+		if err := c.store.UpsertChangesetImpactMetrics(ctx, m); err != nil {
+			return errors.Wrapf(err, "storing impact metrics of changeset %d", ch.ID)
+		}
+		metrics = append(metrics, m)
+	}
+
+	return c.store.ReplaceBatchChangeImpactStats(ctx, batchChange.ID, state.CalcImpactStats(batchChange.ID, metrics))
+}
+
+// ownTeamResolver resolves the team owning a changeset from the ownership of
+// the files changed by its current changeset spec. Teams assigned in
+// Sourcegraph take precedence over CODEOWNERS rules, like they do in search.
+type ownTeamResolver struct {
+	store *store.Store
+	own   own.Service
+}
+
+func (r *ownTeamResolver) ChangesetTeam(ctx context.Context, ch *btypes.Changeset) (string, error) {
+	// Imported changesets have no changeset spec we could get the changed
+	// files from.
+	if ch.CurrentSpecID == 0 {
+		return "", nil
+	}
+	spec, err := r.store.GetChangesetSpecByID(ctx, ch.CurrentSpecID)
+	if err != nil {
+		return "", err
+	}
+	if spec.Type != btypes.ChangesetSpecTypeBranch {
+		return "", nil
+	}
+
+	changes, err := git.ChangesInDiff(spec.Diff)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing diff")
+	}
+	var paths []string
+	for _, files := range [][]string{changes.Modified, changes.Added, changes.Deleted, changes.Renamed} {
+		paths = append(paths, files...)
+	}
+	if len(paths) == 0 {
+		return "", nil
+	}
+
+	repo, err := r.store.Repos().Get(ctx, ch.RepoID)
+	if err != nil {
+		return "", err
+	}
+	commit := api.CommitID(spec.BaseRev)
+
+	assigned, err := r.own.AssignedTeams(ctx, repo.ID, commit)
+	if err != nil {
+		return "", errors.Wrap(err, "loading assigned teams")
+	}
+	ruleset, err := r.own.RulesetForRepo(ctx, repo.Name, repo.ID, commit)
+	if err != nil {
+		return "", errors.Wrap(err, "loading CODEOWNERS")
+	}
+
+	teamNames := make(map[int32]string)
+	counts := make(map[string]int)
+	for _, path := range paths {
+		if summaries := assigned.Match(path); len(summaries) > 0 {
+			id := summaries[0].OwnerTeamID
+			name, ok := teamNames[id]
+			if !ok {
+				team, err := r.store.DatabaseDB().Teams().GetTeamByID(ctx, id)
+				if err != nil {
+					return "", errors.Wrapf(err, "loading team %d", id)
+				}
+				name = team.Name
+				teamNames[id] = name
+			}
+			counts[name]++
+			continue
+		}
+
+		if ruleset == nil {
+			continue
+		}
+		rule := ruleset.Match(path)
+		if rule == nil || len(rule.GetOwner()) == 0 {
+			continue
+		}
+		// The first owner of a rule is usually the team, followed by
+		// individual fallbacks.
+		owner := rule.GetOwner()[0]
+		if handle := owner.GetHandle(); handle != "" {
+			counts[strings.TrimPrefix(handle, "@")]++
+		} else if email := owner.GetEmail(); email != "" {
+			counts[email]++
+		}
+	}
+
+	return mostCommonOwner(counts), nil
+}
+
+// mostCommonOwner returns the owner with the highest count, breaking ties by
+// name so that the result is stable.
+func mostCommonOwner(counts map[string]int) string {
+	owners := make([]string, 0, len(counts))
+	for owner := range counts {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		if counts[owners[i]] != counts[owners[j]] {
+			return counts[owners[i]] > counts[owners[j]]
+		}
+		return owners[i] < owners[j]
+	})
+	if len(owners) == 0 {
+		return ""
+	}
+	return owners[0]
+}
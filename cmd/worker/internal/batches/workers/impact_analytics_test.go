@@ -0,0 +1,29 @@
+package workers
+
+import "testing"
+
+func TestMostCommonOwner(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		counts map[string]int
+		want   string
+	}{
+		{name: "no owners"},
+		{
+			name:   "most files",
+			counts: map[string]int{"sourcegraph/frontend": 2, "sourcegraph/backend": 5, "alice@example.com": 1},
+			want:   "sourcegraph/backend",
+		},
+		{
+			name:   "ties broken by name",
+			counts: map[string]int{"sourcegraph/frontend": 3, "sourcegraph/backend": 3},
+			want:   "sourcegraph/backend",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if have := mostCommonOwner(tc.counts); have != tc.want {
+				t.Fatalf("wrong owner. want=%q, have=%q", tc.want, have)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package codeintel
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	"github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeintel"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type autoindexingCoverageReporter struct{}
+
+func NewAutoindexingCoverageReporter() job.Job {
+	return &autoindexingCoverageReporter{}
+}
+
+func (j *autoindexingCoverageReporter) Description() string {
+	return ""
+}
+
+func (j *autoindexingCoverageReporter) Config() []env.Config {
+	return []env.Config{
+		autoindexing.CoverageConfigInst,
+	}
+}
+
+func (j *autoindexingCoverageReporter) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	services, err := codeintel.InitServices(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return autoindexing.NewCoverageReporter(
+		observationCtx,
+		services.AutoIndexingService,
+		services.UploadsService,
+	), nil
+}
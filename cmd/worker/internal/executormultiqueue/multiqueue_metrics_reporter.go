@@ -9,6 +9,7 @@ import (
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing"
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
 	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -43,12 +44,14 @@ func (j *multiqueueMetricsReporterJob) Routines(_ context.Context, observationCt
 	if err != nil {
 		return nil, err
 	}
+	customStore := executorstore.NewCustomJobWorkerStore(observationCtx, db.Handle())
 
 	multiqueueMetricsReporter, err := executorqueue.NewMultiqueueMetricReporter(
 		executortypes.ValidQueueNames,
 		configInst.MetricsConfig,
 		codeIntelStore.QueuedCount,
 		batchesStore.QueuedCount,
+		customStore.QueuedCount,
 	)
 	if err != nil {
 		return nil, err
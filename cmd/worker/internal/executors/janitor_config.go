@@ -15,6 +15,8 @@ type janitorConfig struct {
 
 	CacheCleanupInterval time.Duration
 	CacheDequeueTtl      time.Duration
+
+	JobCacheEvictionInterval time.Duration
 }
 
 var janitorConfigInst = &janitorConfig{}
@@ -25,4 +27,6 @@ func (c *janitorConfig) Load() {
 
 	c.CacheCleanupInterval = c.GetInterval("EXECUTORS_MULTIQUEUE_CACHE_CLEANUP_INTERVAL", executortypes.CleanupInterval.String(), "The frequency with which the multiqueue dequeue cache is cleaned up.")
 	c.CacheDequeueTtl = c.GetInterval("EXECUTORS_MULTIQUEUE_CACHE_DEQUEUE_TTL", executortypes.DequeueTtl.String(), "The duration after which a dequeue is deleted from the multiqueue dequeue cache.")
+
+	c.JobCacheEvictionInterval = c.GetInterval("EXECUTORS_CACHE_EVICTION_INTERVAL", "5m", "The frequency with which job caches are evicted to stay within EXECUTORS_CACHE_MAX_TOTAL_SIZE_MB.")
 }
@@ -2,14 +2,19 @@ package executors
 
 import (
 	"context"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
 	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/executor/cache"
+	executorstore "github.com/sourcegraph/sourcegraph/internal/executor/store"
 	executortypes "github.com/sourcegraph/sourcegraph/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 )
 
 type janitorJob struct{}
@@ -23,7 +28,7 @@ func (j *janitorJob) Description() string {
 }
 
 func (j *janitorJob) Config() []env.Config {
-	return []env.Config{janitorConfigInst}
+	return []env.Config{janitorConfigInst, cache.ConfigInst}
 }
 
 func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
@@ -34,6 +39,11 @@ func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Con
 
 	dequeueCache := rcache.New(executortypes.DequeueCachePrefix)
 
+	cacheUploadStore, err := cache.NewUploadStore(actor.WithInternalActor(context.Background()), observationCtx, cache.ConfigInst)
+	if err != nil {
+		return nil, err
+	}
+
 	routines := []goroutine.BackgroundRoutine{
 		goroutine.NewPeriodicGoroutine(
 			context.Background(),
@@ -45,6 +55,13 @@ func (j *janitorJob) Routines(_ context.Context, observationCtx *observation.Con
 			goroutine.WithInterval(janitorConfigInst.CleanupTaskInterval),
 		),
 		NewMultiqueueCacheCleaner(executortypes.ValidQueueNames, dequeueCache, janitorConfigInst.CacheDequeueTtl, janitorConfigInst.CacheCleanupInterval),
+		cache.NewEvictor(observationCtx, executorstore.NewCacheEntryStore(db), cacheUploadStore, cache.ConfigInst.MaxTotalSize, janitorConfigInst.JobCacheEvictionInterval),
+		// Re-enqueues custom jobs of executors that stopped sending heartbeats.
+		dbworker.NewResetter(observationCtx.Logger, executorstore.NewCustomJobWorkerStore(observationCtx, db.Handle()), dbworker.ResetterOptions{
+			Name:     "executor_custom_job_resetter",
+			Interval: 1 * time.Minute,
+			Metrics:  dbworker.NewResetterMetrics(observationCtx, "executor_custom_job_resetter"),
+		}),
 	}
 
 	return routines, nil
@@ -0,0 +1,60 @@
+package insights
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerinsightsdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/codeinsights"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/insights"
+	"github.com/sourcegraph/sourcegraph/internal/insights/background"
+	"github.com/sourcegraph/sourcegraph/internal/insights/export"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type insightsDataExportJob struct{}
+
+func (s *insightsDataExportJob) Description() string {
+	return "periodically exports the data of all insight series to an upload store"
+}
+
+func (s *insightsDataExportJob) Config() []env.Config {
+	return []env.Config{export.ConfigInst}
+}
+
+func (s *insightsDataExportJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	if !insights.IsEnabled() {
+		observationCtx.Logger.Debug("Code Insights disabled. Disabling insights data export job.")
+		return []goroutine.BackgroundRoutine{}, nil
+	}
+	if export.ConfigInst.Interval == 0 {
+		observationCtx.Logger.Debug("Code Insights data export interval not set. Disabling insights data export job.")
+		return []goroutine.BackgroundRoutine{}, nil
+	}
+	observationCtx.Logger.Debug("Code Insights enabled. Enabling insights data export job.")
+
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	insightsDB, err := workerinsightsdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	uploads, err := export.NewUploadStore(context.Background(), observationCtx, export.ConfigInst)
+	if err != nil {
+		return nil, err
+	}
+
+	return []goroutine.BackgroundRoutine{
+		background.NewInsightsDataExportJob(context.Background(), db, insightsDB, uploads, export.ConfigInst),
+	}, nil
+}
+
+func NewInsightsDataExportJob() job.Job {
+	return &insightsDataExportJob{}
+}
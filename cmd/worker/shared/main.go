@@ -87,11 +87,14 @@ func LoadConfig(registerEnterpriseMigrators oobmigration.RegisterMigratorsFunc)
 		"insights-job":                          workerinsights.NewInsightsJob(),
 		"insights-query-runner-job":             workerinsights.NewInsightsQueryRunnerJob(),
 		"insights-data-retention-job":           workerinsights.NewInsightsDataRetentionJob(),
+		"insights-data-export-job":              workerinsights.NewInsightsDataExportJob(),
 		"batches-janitor":                       batches.NewJanitorJob(),
 		"batches-scheduler":                     batches.NewSchedulerJob(),
 		"batches-reconciler":                    batches.NewReconcilerJob(),
 		"batches-bulk-processor":                batches.NewBulkOperationProcessorJob(),
 		"batches-workspace-resolver":            batches.NewWorkspaceResolverJob(),
+		"batches-rerunner":                      batches.NewRerunJob(),
+		"batches-impact-analytics":              batches.NewImpactAnalyticsJob(),
 		"executors-janitor":                     executors.NewJanitorJob(),
 		"executors-metricsserver":               executors.NewMetricsServerJob(),
 		"executors-multiqueue-metrics-reporter": executormultiqueue.NewMultiqueueMetricsReporterJob(),
@@ -104,6 +107,7 @@ func LoadConfig(registerEnterpriseMigrators oobmigration.RegisterMigratorsFunc)
 
 		"codeintel-policies-repository-matcher":       codeintel.NewPoliciesRepositoryMatcherJob(),
 		"codeintel-autoindexing-summary-builder":      codeintel.NewAutoindexingSummaryBuilder(),
+		"codeintel-autoindexing-coverage-reporter":    codeintel.NewAutoindexingCoverageReporter(),
 		"codeintel-autoindexing-dependency-scheduler": codeintel.NewAutoindexingDependencySchedulerJob(),
 		"codeintel-autoindexing-scheduler":            codeintel.NewAutoindexingSchedulerJob(),
 		"codeintel-commitgraph-updater":               codeintel.NewCommitGraphUpdaterJob(),
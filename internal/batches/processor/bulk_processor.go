@@ -200,6 +200,15 @@ func (b *bulkProcessor) mergeChangeset(ctx context.Context, job *btypes.Changese
 		return nil, errcode.MakeNonRetryable(err)
 	}
 
+	if cs.OwnedByBatchChangeID != 0 && cs.ExternalState == btypes.ChangesetExternalStateMerged {
+		// Merging the changeset can unblock the publication of the changesets
+		// in higher rollout tiers of its batch change.
+		if _, err := b.tx.EnqueueRolloutBlockedChangesets(ctx, cs.OwnedByBatchChangeID, global.DefaultReconcilerEnqueueState()); err != nil {
+			b.logger.Error("EnqueueRolloutBlockedChangesets", log.Error(err))
+			return nil, errcode.MakeNonRetryable(err)
+		}
+	}
+
 	afterDone = func(s *store.Store) { b.enqueueWebhook(ctx, s, webhooks.ChangesetClose) }
 	return afterDone, nil
 }
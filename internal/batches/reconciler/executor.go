@@ -38,6 +38,7 @@ func executePlan(ctx context.Context, logger log.Logger, client gitserver.Client
 		tx:                tx,
 		ch:                plan.Changeset,
 		spec:              plan.ChangesetSpec,
+		delta:             plan.Delta,
 	}
 
 	return e.Run(ctx, plan)
@@ -51,6 +52,7 @@ type executor struct {
 	tx                *store.Store
 	ch                *btypes.Changeset
 	spec              *btypes.ChangesetSpec
+	delta             *ChangesetSpecDelta
 
 	// targetRepo represents the repo where the changeset should be opened.
 	targetRepo *types.Repo
@@ -98,7 +100,7 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 			err = e.importChangeset(ctx)
 
 		case btypes.ReconcilerOperationPush:
-			afterDone, err = e.pushChangesetPatch(ctx, triggerUpdateWebhook)
+			afterDone, err = e.pushChangesetPatch(ctx, e.spec, triggerUpdateWebhook)
 
 		case btypes.ReconcilerOperationPublish:
 			afterDone, err = e.publishChangeset(ctx, false)
@@ -130,6 +132,9 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 		case btypes.ReconcilerOperationReattach:
 			e.reattachChangeset()
 
+		case btypes.ReconcilerOperationRebase:
+			afterDone, err = e.rebaseChangeset(ctx)
+
 		default:
 			err = errors.Errorf("executor operation %q not implemented", op)
 		}
@@ -158,9 +163,9 @@ func (e *executor) Run(ctx context.Context, plan *Plan) (afterDone func(store *s
 
 var errCannotPushToArchivedRepo = errcode.MakeNonRetryable(errors.New("cannot push to an archived repo"))
 
-// pushChangesetPatch creates the commits for the changeset on its codehost. If the option
-// triggerUpdateWebhook is set, it will also enqueue an update webhook for the changeset.
-func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook bool) (afterDone func(store *store.Store), err error) {
+// pushChangesetPatch creates the commits for the diff of the given changeset spec
+// on the codehost of the changeset. If the option triggerUpdateWebhook is set, it will also enqueue an update webhook for the changeset.
+func (e *executor) pushChangesetPatch(ctx context.Context, spec *btypes.ChangesetSpec, triggerUpdateWebhook bool) (afterDone func(store *store.Store), err error) {
 	if triggerUpdateWebhook {
 		afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
 	}
@@ -168,7 +173,7 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 	existingSameBranch, err := e.tx.GetChangeset(ctx, store.GetChangesetOpts{
 		ExternalServiceType: e.ch.ExternalServiceType,
 		RepoID:              e.ch.RepoID,
-		ExternalBranch:      e.spec.HeadRef,
+		ExternalBranch:      spec.HeadRef,
 		// TODO: Do we need to check whether it's published or not?
 	})
 	if err != nil && err != store.ErrNoResults {
@@ -201,7 +206,7 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 	if err != nil {
 		return afterDone, err
 	}
-	opts := css.BuildCommitOpts(e.targetRepo, e.ch, e.spec, pushConf)
+	opts := css.BuildCommitOpts(e.targetRepo, e.ch, spec, pushConf)
 	resp, err := e.pushCommit(ctx, opts)
 	if err != nil {
 		var pce pushCommitError
@@ -236,6 +241,128 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 	return afterDone, err
 }
 
+// rebaseChangeset tries to resolve the merge conflicts of the changeset with
+// its base branch that the code host reported. If the diff of the changeset
+// spec applies cleanly to the current head of the base branch, the rebased
+// commit is force-pushed to the changeset branch. Otherwise, the steps of the
+// workspace are executed again on top of the new base commit, which is only
+// possible for batch changes created through server-side execution.
+//
+// Every attempt is recorded as a changeset event. Each base commit is only
+// attempted once per changeset spec, and at most
+// btypes.MaxChangesetRebaseAttempts commits are attempted.
+func (e *executor) rebaseChangeset(ctx context.Context) (afterDone func(store *store.Store), err error) {
+	events, _, err := e.tx.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{
+		ChangesetIDs: []int64{e.ch.ID},
+		Kinds:        []btypes.ChangesetEventKind{btypes.ChangesetEventKindBatchesRebaseAttempt},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing previous rebase attempts")
+	}
+
+	baseRev, err := e.client.ResolveRevision(ctx, e.targetRepo.Name, e.spec.BaseRef, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving base branch")
+	}
+	if string(baseRev) == e.spec.BaseRev {
+		// Gitserver didn't fetch the new commits of the base branch yet, so
+		// there's nothing to rebase on. The next sync tries again.
+		return nil, nil
+	}
+
+	attempt := &btypes.ChangesetRebaseAttempt{
+		Attempt:         1,
+		ChangesetSpecID: e.spec.ID,
+		BaseRef:         e.spec.BaseRef,
+		BaseRev:         string(baseRev),
+		CreatedAt:       e.tx.Clock()(),
+	}
+	for _, ev := range events {
+		previous, ok := ev.Metadata.(*btypes.ChangesetRebaseAttempt)
+		if !ok || previous.ChangesetSpecID != e.spec.ID {
+			continue
+		}
+		if previous.BaseRev == attempt.BaseRev {
+			return nil, nil
+		}
+		attempt.Attempt++
+	}
+	if attempt.Attempt > btypes.MaxChangesetRebaseAttempts {
+		e.logger.Info("Giving up rebasing conflicting changeset", log.Int64("changeset", e.ch.ID), log.Int("attempts", btypes.MaxChangesetRebaseAttempts))
+		return nil, nil
+	}
+
+	rebased := *e.spec
+	rebased.BaseRev = string(baseRev)
+	afterDone, err = e.pushChangesetPatch(ctx, &rebased, true)
+	switch {
+	case err == nil:
+		attempt.Outcome = btypes.ChangesetRebaseOutcomeRebased
+	case isPatchDoesNotApplyError(err):
+		// The changeset itself is unchanged, so this is not an error of the
+		// reconciler.
+		afterDone = nil
+		if err := e.reexecuteChangeset(ctx, attempt); err != nil {
+			return nil, err
+		}
+	default:
+		return afterDone, err
+	}
+
+	return afterDone, e.tx.UpsertChangesetEvents(ctx, &btypes.ChangesetEvent{
+		ChangesetID: e.ch.ID,
+		Kind:        btypes.ChangesetEventKindBatchesRebaseAttempt,
+		Key:         attempt.Key(),
+		Metadata:    attempt,
+	})
+}
+
+// reexecuteChangeset enqueues a new execution of the workspace that produced
+// the changeset spec, on top of the base commit of the given attempt, and
+// records the outcome in the attempt. Once the execution completes, the
+// changeset spec it produces replaces the current one of the changeset.
+func (e *executor) reexecuteChangeset(ctx context.Context, attempt *btypes.ChangesetRebaseAttempt) error {
+	attempt.Outcome = btypes.ChangesetRebaseOutcomeFailed
+
+	batchSpec, err := e.tx.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: e.spec.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch spec")
+	}
+	if !batchSpec.CreatedFromRaw {
+		attempt.Message = "The diff does not apply to the new base commit. The batch change was not created with server-side execution, so its batch spec needs to be executed and applied again."
+		return nil
+	}
+
+	workspace, err := e.tx.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ChangesetSpecID: e.spec.ID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			attempt.Message = "The diff does not apply to the new base commit, and the workspace that produced the changeset could not be found."
+			return nil
+		}
+		return errors.Wrap(err, "getting batch spec workspace")
+	}
+	attempt.BatchSpecWorkspaceID = workspace.ID
+
+	job, err := e.tx.GetBatchSpecWorkspaceExecutionJob(ctx, store.GetBatchSpecWorkspaceExecutionJobOpts{
+		BatchSpecWorkspaceID: workspace.ID,
+		ExcludeRank:          true,
+	})
+	if err != nil && err != store.ErrNoResults {
+		return errors.Wrap(err, "getting batch spec workspace execution job")
+	}
+	if job != nil && (job.State == btypes.BatchSpecWorkspaceExecutionJobStateQueued || job.State == btypes.BatchSpecWorkspaceExecutionJobStateProcessing) {
+		attempt.Outcome = btypes.ChangesetRebaseOutcomeReexecuting
+		attempt.Message = "The workspace is already being executed again."
+		return nil
+	}
+
+	if err := e.tx.ReexecuteBatchSpecWorkspace(ctx, workspace.ID, attempt.BaseRev); err != nil {
+		return errors.Wrap(err, "enqueueing batch spec workspace execution")
+	}
+	attempt.Outcome = btypes.ChangesetRebaseOutcomeReexecuting
+	return nil
+}
+
 // publishChangeset creates the given changeset on its code host.
 func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDone func(store *store.Store), err error) {
 	afterDoneUpdate := func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
@@ -262,13 +389,21 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 	}
 
 	cs := &sources.Changeset{
-		Title:      e.spec.Title,
-		Body:       body,
-		BaseRef:    e.spec.BaseRef,
-		HeadRef:    e.spec.HeadRef,
-		RemoteRepo: remoteRepo,
-		TargetRepo: e.targetRepo,
-		Changeset:  e.ch,
+		Title:             e.spec.Title,
+		Body:              body,
+		BaseRef:           e.spec.BaseRef,
+		HeadRef:           e.spec.HeadRef,
+		RemoteRepo:        remoteRepo,
+		TargetRepo:        e.targetRepo,
+		RequestedMetadata: sources.ChangesetMetadataFromSpec(e.spec),
+		Changeset:         e.ch,
+	}
+
+	// Make sure the requested metadata can be applied before the changeset is
+	// created, so that we don't publish changesets without it.
+	metadataCss, err := metadataChangesetSource(css, cs)
+	if err != nil {
+		return afterDoneUpdate, err
 	}
 
 	var exists, outdated bool
@@ -315,6 +450,12 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (afterDon
 		}
 	}
 
+	if metadataCss != nil {
+		if err := metadataCss.ApplyChangesetMetadata(ctx, cs); err != nil {
+			return afterDonePublish, errors.Wrap(err, "applying changeset metadata")
+		}
+	}
+
 	// Set the changeset to published.
 	e.ch.PublicationState = btypes.ChangesetPublicationStatePublished
 
@@ -398,13 +539,25 @@ func (e *executor) updateChangeset(ctx context.Context) (afterDone func(store *s
 	// We must construct the sources.Changeset after invoking changesetSource,
 	// since that may change the remoteRepo.
 	cs := sources.Changeset{
-		Title:      e.spec.Title,
-		Body:       body,
-		BaseRef:    e.spec.BaseRef,
-		HeadRef:    e.spec.HeadRef,
-		RemoteRepo: remoteRepo,
-		TargetRepo: e.targetRepo,
-		Changeset:  e.ch,
+		Title:             e.spec.Title,
+		Body:              body,
+		BaseRef:           e.spec.BaseRef,
+		HeadRef:           e.spec.HeadRef,
+		RemoteRepo:        remoteRepo,
+		TargetRepo:        e.targetRepo,
+		RequestedMetadata: sources.ChangesetMetadataFromSpec(e.spec),
+		Changeset:         e.ch,
+	}
+
+	// Only apply the metadata again if it changed: code hosts notify reviewers
+	// and assignees every time they're requested, so reapplying it on every
+	// title or body update would spam them.
+	var metadataCss sources.MetadataChangesetSource
+	if e.delta != nil && e.delta.MetadataChanged {
+		metadataCss, err = metadataChangesetSource(css, &cs)
+		if err != nil {
+			return afterDone, err
+		}
 	}
 
 	if err := css.UpdateChangeset(ctx, &cs); err != nil {
@@ -412,15 +565,35 @@ func (e *executor) updateChangeset(ctx context.Context) (afterDone func(store *s
 			if err := e.handleArchivedRepo(ctx); err != nil {
 				return afterDone, err
 			}
+			// Archived repositories are read-only, so there's no point in
+			// applying the metadata.
+			metadataCss = nil
 		} else {
 			return afterDone, errors.Wrap(err, "updating changeset")
 		}
 	}
 
+	if metadataCss != nil {
+		if err := metadataCss.ApplyChangesetMetadata(ctx, &cs); err != nil {
+			return afterDone, errors.Wrap(err, "applying changeset metadata")
+		}
+	}
+
 	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdate) }
 	return afterDone, nil
 }
 
+// metadataChangesetSource returns the changeset source as a
+// MetadataChangesetSource if the changeset requests metadata, and nil if it
+// doesn't. If the source can't apply metadata, an error naming the requested
+// fields is returned.
+func metadataChangesetSource(css sources.ChangesetSource, cs *sources.Changeset) (sources.MetadataChangesetSource, error) {
+	if cs.RequestedMetadata.IsEmpty() {
+		return nil, nil
+	}
+	return sources.ToMetadataChangesetSource(css, cs.RequestedMetadata)
+}
+
 // reopenChangeset reopens the given changeset attribute on the code host.
 func (e *executor) reopenChangeset(ctx context.Context) (afterDone func(store *store.Store), err error) {
 	afterDone = func(store *store.Store) { e.enqueueWebhook(ctx, store, webhooks.ChangesetUpdateError) }
@@ -626,6 +799,16 @@ func (e pushCommitError) Error() string {
 		e.RepositoryName, e.InternalError, e.Command, strings.TrimSpace(e.CombinedOutput))
 }
 
+// patchDoesNotApply is part of the output of `git apply` if a patch doesn't
+// apply to the base commit.
+const patchDoesNotApply = "patch does not apply"
+
+// isPatchDoesNotApplyError returns true if err was returned by pushCommit
+// because the patch doesn't apply to the base commit.
+func isPatchDoesNotApplyError(err error) bool {
+	return errcode.IsNonRetryable(err) && strings.Contains(err.Error(), patchDoesNotApply)
+}
+
 func (e *executor) pushCommit(ctx context.Context, opts protocol.CreateCommitFromPatchRequest) (*protocol.CreateCommitFromPatchResponse, error) {
 	res, err := e.client.CreateCommitFromPatch(ctx, opts)
 	if err != nil {
@@ -633,7 +816,7 @@ func (e *executor) pushCommit(ctx context.Context, opts protocol.CreateCommitFro
 		if errors.As(err, &e) {
 			// Make "patch does not apply" errors a fatal error. Retrying the changeset
 			// rollout won't help here and just causes noise.
-			if strings.Contains(e.CombinedOutput, "patch does not apply") {
+			if strings.Contains(e.CombinedOutput, patchDoesNotApply) {
 				return nil, errcode.MakeNonRetryable(pushCommitError{e})
 			}
 			return nil, pushCommitError{e}
@@ -20,6 +20,7 @@ import (
 	"github.com/sourcegraph/log/logtest"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/batches/sources"
 	stesting "github.com/sourcegraph/sourcegraph/internal/batches/sources/testing"
 	"github.com/sourcegraph/sourcegraph/internal/batches/store"
@@ -114,6 +115,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 	type testCase struct {
 		changeset      bt.TestChangesetOpts
 		hasCurrentSpec bool
+		specReviewers  []string
 		plan           *Plan
 
 		sourcerMetadata any
@@ -132,6 +134,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 		wantCloseOnCodeHost       bool
 		wantLoadFromCodeHost      bool
 		wantReopenOnCodeHost      bool
+		wantApplyMetadata         bool
 
 		wantGitserverCommit bool
 
@@ -370,6 +373,68 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 
 			wantWebhookType: webhooks.ChangesetUpdate,
 		},
+		"update title does not apply metadata": {
+			hasCurrentSpec: true,
+			specReviewers:  []string{"reviewer"},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalID:       "12345",
+				ExternalBranch:   "head-ref-on-github",
+			},
+
+			plan: &Plan{
+				Ops: Operations{
+					btypes.ReconcilerOperationUpdate,
+				},
+				Delta: &ChangesetSpecDelta{TitleChanged: true},
+			},
+
+			wantUpdateOnCodeHost: true,
+			wantApplyMetadata:    false,
+
+			wantChangeset: bt.ChangesetAssertions{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalID:       githubPR.ID,
+				ExternalBranch:   githubHeadRef,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				DiffStat:         state.DiffStat,
+				Title:            githubPR.Title,
+				Body:             githubPR.Body,
+			},
+
+			wantWebhookType: webhooks.ChangesetUpdate,
+		},
+		"update metadata": {
+			hasCurrentSpec: true,
+			specReviewers:  []string{"reviewer"},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalID:       "12345",
+				ExternalBranch:   "head-ref-on-github",
+			},
+
+			plan: &Plan{
+				Ops: Operations{
+					btypes.ReconcilerOperationUpdate,
+				},
+				Delta: &ChangesetSpecDelta{MetadataChanged: true},
+			},
+
+			wantUpdateOnCodeHost: true,
+			wantApplyMetadata:    true,
+
+			wantChangeset: bt.ChangesetAssertions{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalID:       githubPR.ID,
+				ExternalBranch:   githubHeadRef,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				DiffStat:         state.DiffStat,
+				Title:            githubPR.Title,
+				Body:             githubPR.Body,
+			},
+
+			wantWebhookType: webhooks.ChangesetUpdate,
+		},
 		"update to archived repo": {
 			hasCurrentSpec: true,
 			changeset: bt.TestChangesetOpts{
@@ -677,6 +742,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 					Repo:      repo.ID,
 					BatchSpec: batchSpec.ID,
 					Typ:       btypes.ChangesetSpecTypeBranch,
+					Reviewers: tc.specReviewers,
 				}
 				changesetSpec = bt.CreateChangesetSpec(t, ctx, bstore, specOpts)
 			}
@@ -794,6 +860,10 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				t.Fatalf("wrong CloseChangeset call. wantCalled=%t, wasCalled=%t", want, have)
 			}
 
+			if have, want := fakeSource.ApplyChangesetMetadataCalled, tc.wantApplyMetadata; have != want {
+				t.Fatalf("wrong ApplyChangesetMetadata call. wantCalled=%t, wasCalled=%t", want, have)
+			}
+
 			if tc.wantNonRetryableErr {
 				return
 			}
@@ -916,6 +986,250 @@ func TestExecutor_ExecutePlan_PublishedChangesetDuplicateBranch(t *testing.T) {
 	}
 }
 
+func TestExecutor_ExecutePlan_Rebase(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	bstore := store.New(db, &observation.TestContext, et.TestKey{})
+
+	admin := bt.CreateTestUser(t, db, true)
+	ctx = actor.WithActor(ctx, actor.FromUser(admin.ID))
+
+	repo, extSvc := bt.CreateTestRepo(t, ctx, db)
+
+	state := bt.MockChangesetSyncState(&protocol.RepoInfo{
+		Name: repo.Name,
+		VCS:  protocol.VCSInfo{URL: repo.URI},
+	})
+	defer state.Unmock()
+
+	batchSpec := bt.CreateBatchSpec(t, ctx, bstore, "rebase", admin.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, bstore, "rebase", admin.ID, batchSpec.ID)
+
+	changesetSpec := bt.CreateChangesetSpec(t, ctx, bstore, bt.TestSpecOpts{
+		User:       admin.ID,
+		Repo:       repo.ID,
+		BatchSpec:  batchSpec.ID,
+		HeadRef:    "refs/heads/rebase",
+		BaseRef:    "refs/heads/main",
+		BaseRev:    "old-base",
+		Typ:        btypes.ChangesetSpecTypeBranch,
+		Published:  true,
+		CommitDiff: []byte("testdiff"),
+	})
+
+	pr := buildGithubPR(timeutil.Now(), btypes.ChangesetExternalStateOpen)
+	pr.Mergeable = "CONFLICTING"
+	changeset := bt.CreateChangeset(t, ctx, bstore, bt.TestChangesetOpts{
+		Repo:               repo.ID,
+		BatchChange:        batchChange.ID,
+		OwnedByBatchChange: batchChange.ID,
+		CurrentSpec:        changesetSpec.ID,
+		PublicationState:   btypes.ChangesetPublicationStatePublished,
+		ExternalState:      btypes.ChangesetExternalStateOpen,
+		ExternalID:         pr.ID,
+		ExternalBranch:     changesetSpec.HeadRef,
+		Metadata:           pr,
+	})
+
+	fakeSource := &stesting.FakeChangesetSource{Svc: extSvc, CurrentAuthenticator: &auth.OAuthBearerToken{Token: "token"}}
+	sourcer := stesting.NewFakeSourcer(nil, fakeSource)
+
+	var commitRequests []gitprotocol.CreateCommitFromPatchRequest
+	var commitErr error
+	state.MockClient.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req gitprotocol.CreateCommitFromPatchRequest) (*gitprotocol.CreateCommitFromPatchResponse, error) {
+		commitRequests = append(commitRequests, req)
+		return new(gitprotocol.CreateCommitFromPatchResponse), commitErr
+	})
+	var baseRev api.CommitID
+	state.MockClient.ResolveRevisionFunc.SetDefaultHook(func(context.Context, api.RepoName, string, gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		return baseRev, nil
+	})
+
+	rebase := func(t *testing.T) {
+		t.Helper()
+
+		plan := &Plan{Changeset: changeset, ChangesetSpec: changesetSpec}
+		plan.AddOp(btypes.ReconcilerOperationRebase)
+		if _, err := executePlan(ctx, logtest.Scoped(t), state.MockClient, sourcer, true, bstore, plan); err != nil {
+			t.Fatalf("executing plan failed: %s", err)
+		}
+	}
+
+	attempts := func(t *testing.T) []*btypes.ChangesetRebaseAttempt {
+		t.Helper()
+
+		events, _, err := bstore.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{
+			ChangesetIDs: []int64{changeset.ID},
+			Kinds:        []btypes.ChangesetEventKind{btypes.ChangesetEventKindBatchesRebaseAttempt},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var attempts []*btypes.ChangesetRebaseAttempt
+		for _, ev := range events {
+			attempts = append(attempts, ev.Metadata.(*btypes.ChangesetRebaseAttempt))
+		}
+		return attempts
+	}
+
+	t.Run("base branch did not move", func(t *testing.T) {
+		baseRev = api.CommitID(changesetSpec.BaseRev)
+		rebase(t)
+
+		assert.Empty(t, commitRequests)
+		assert.Empty(t, attempts(t))
+	})
+
+	t.Run("diff applies", func(t *testing.T) {
+		baseRev = "new-base"
+		rebase(t)
+
+		require.Len(t, commitRequests, 1)
+		assert.Equal(t, api.CommitID("new-base"), commitRequests[0].BaseCommit)
+		assert.Equal(t, changesetSpec.HeadRef, commitRequests[0].TargetRef)
+
+		have := attempts(t)
+		require.Len(t, have, 1)
+		assert.Equal(t, 1, have[0].Attempt)
+		assert.Equal(t, "new-base", have[0].BaseRev)
+		assert.Equal(t, btypes.ChangesetRebaseOutcomeRebased, have[0].Outcome)
+	})
+
+	t.Run("same base commit", func(t *testing.T) {
+		rebase(t)
+
+		assert.Len(t, commitRequests, 1)
+		assert.Len(t, attempts(t), 1)
+	})
+
+	t.Run("diff does not apply", func(t *testing.T) {
+		baseRev = "newer-base"
+		commitErr = &gitprotocol.CreateCommitFromPatchError{
+			RepositoryName: string(repo.Name),
+			CombinedOutput: "error: patch failed: README.md:1\nerror: README.md: patch does not apply",
+		}
+		rebase(t)
+
+		assert.Len(t, commitRequests, 2)
+
+		have := attempts(t)
+		require.Len(t, have, 2)
+		attempt := have[1]
+		assert.Equal(t, 2, attempt.Attempt)
+		assert.Equal(t, "newer-base", attempt.BaseRev)
+		// The batch spec wasn't created with server-side execution, so it
+		// can't be executed again.
+		assert.Equal(t, btypes.ChangesetRebaseOutcomeFailed, attempt.Outcome)
+		assert.NotEmpty(t, attempt.Message)
+	})
+
+	t.Run("attempts are capped", func(t *testing.T) {
+		baseRev = "newest-base"
+		rebase(t)
+		baseRev = "even-newer-base"
+		rebase(t)
+
+		assert.Len(t, attempts(t), btypes.MaxChangesetRebaseAttempts)
+	})
+
+	t.Run("diff does not apply with server-side execution", func(t *testing.T) {
+		batchSpec.CreatedFromRaw = true
+		if err := bstore.UpdateBatchSpec(ctx, batchSpec); err != nil {
+			t.Fatal(err)
+		}
+		// Attempts are counted for each changeset spec.
+		changesetSpec = bt.CreateChangesetSpec(t, ctx, bstore, bt.TestSpecOpts{
+			User:       admin.ID,
+			Repo:       repo.ID,
+			BatchSpec:  batchSpec.ID,
+			HeadRef:    "refs/heads/rebase",
+			BaseRef:    "refs/heads/main",
+			BaseRev:    "old-base",
+			Typ:        btypes.ChangesetSpecTypeBranch,
+			Published:  true,
+			CommitDiff: []byte("testdiff"),
+		})
+		changeset.CurrentSpecID = changesetSpec.ID
+
+		otherWorkspace := &btypes.BatchSpecWorkspace{BatchSpecID: batchSpec.ID, RepoID: repo.ID, Branch: "refs/heads/main", Commit: "old-base"}
+		workspace := &btypes.BatchSpecWorkspace{
+			BatchSpecID:       batchSpec.ID,
+			ChangesetSpecIDs:  []int64{changesetSpec.ID},
+			RepoID:            repo.ID,
+			Branch:            "refs/heads/main",
+			Commit:            "old-base",
+			CachedResultFound: true,
+		}
+		if err := bstore.CreateBatchSpecWorkspace(ctx, otherWorkspace, workspace); err != nil {
+			t.Fatal(err)
+		}
+		if err := bstore.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, []int64{otherWorkspace.ID, workspace.ID}); err != nil {
+			t.Fatal(err)
+		}
+		jobs, err := bstore.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, job := range jobs {
+			job.State = btypes.BatchSpecWorkspaceExecutionJobStateCompleted
+			bt.UpdateJobState(t, ctx, bstore, job)
+		}
+
+		executionJob := func(t *testing.T, workspaceID int64) *btypes.BatchSpecWorkspaceExecutionJob {
+			t.Helper()
+
+			job, err := bstore.GetBatchSpecWorkspaceExecutionJob(ctx, store.GetBatchSpecWorkspaceExecutionJobOpts{BatchSpecWorkspaceID: workspaceID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			return job
+		}
+
+		rebase(t)
+
+		var attempt *btypes.ChangesetRebaseAttempt
+		for _, a := range attempts(t) {
+			if a.ChangesetSpecID == changesetSpec.ID {
+				attempt = a
+			}
+		}
+		require.NotNil(t, attempt)
+		assert.Equal(t, 1, attempt.Attempt)
+		assert.Equal(t, btypes.ChangesetRebaseOutcomeReexecuting, attempt.Outcome)
+		assert.Equal(t, workspace.ID, attempt.BatchSpecWorkspaceID)
+
+		// Only the workspace of the changeset is executed again, on top of the
+		// new base commit.
+		reexecuted, err := bstore.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ID: workspace.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, string(baseRev), reexecuted.Commit)
+		assert.False(t, reexecuted.CachedResultFound)
+		assert.Equal(t, []int64{changesetSpec.ID}, reexecuted.ChangesetSpecIDs)
+		assert.Equal(t, btypes.BatchSpecWorkspaceExecutionJobStateQueued, executionJob(t, workspace.ID).State)
+		assert.Equal(t, btypes.BatchSpecWorkspaceExecutionJobStateCompleted, executionJob(t, otherWorkspace.ID).State)
+
+		// While the execution is queued, no other execution is enqueued.
+		queued := executionJob(t, workspace.ID)
+		baseRev = "newest-base"
+		rebase(t)
+
+		assert.Equal(t, queued.ID, executionJob(t, workspace.ID).ID)
+		reexecuted, err = bstore.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ID: workspace.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, "even-newer-base", reexecuted.Commit)
+	})
+}
+
 func TestExecutor_ExecutePlan_AvoidLoadingChangesetSource(t *testing.T) {
 	logger := logtest.Scoped(t)
 	ctx := context.Background()
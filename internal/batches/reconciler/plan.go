@@ -3,6 +3,7 @@ package reconciler
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -15,6 +16,7 @@ var operationPrecedence = map[btypes.ReconcilerOperation]int{
 	btypes.ReconcilerOperationDetach:       0,
 	btypes.ReconcilerOperationArchive:      0,
 	btypes.ReconcilerOperationReattach:     0,
+	btypes.ReconcilerOperationRebase:       0,
 	btypes.ReconcilerOperationImport:       1,
 	btypes.ReconcilerOperationPublish:      1,
 	btypes.ReconcilerOperationPublishDraft: 1,
@@ -254,6 +256,16 @@ func DeterminePlan(previousSpec, currentSpec *btypes.ChangesetSpec, currentChang
 			}
 		}
 
+		// If no new commit is pushed anyway, but the code host reports merge
+		// conflicts with the base branch, we try to rebase the changeset. The
+		// executor decides whether another attempt is worthwhile.
+		if !delta.NeedCommitUpdate() && wantedChangeset.NeedsRebase() {
+			pl.AddOp(btypes.ReconcilerOperationRebase)
+			// Give the code host time to check the new commit for conflicts.
+			pl.AddOp(btypes.ReconcilerOperationSleep)
+			pl.AddOp(btypes.ReconcilerOperationSync)
+		}
+
 	default:
 		return pl, errors.Errorf("unknown changeset publication state: %s", wantedChangeset.PublicationState)
 	}
@@ -299,6 +311,12 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.BaseRef != current.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	if !slices.Equal(previous.Labels, current.Labels) ||
+		!slices.Equal(previous.Reviewers, current.Reviewers) ||
+		!slices.Equal(previous.Assignees, current.Assignees) ||
+		previous.Milestone != current.Milestone {
+		delta.MetadataChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -344,6 +362,7 @@ type ChangesetSpecDelta struct {
 	BodyChanged          bool
 	Undraft              bool
 	BaseRefChanged       bool
+	MetadataChanged      bool
 	DiffChanged          bool
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
@@ -357,7 +376,7 @@ func (d *ChangesetSpecDelta) NeedCommitUpdate() bool {
 }
 
 func (d *ChangesetSpecDelta) NeedCodeHostUpdate() bool {
-	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged
+	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged || d.MetadataChanged
 }
 
 func (d *ChangesetSpecDelta) AttributesChanged() bool {
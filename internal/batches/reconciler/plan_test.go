@@ -6,6 +6,8 @@ import (
 	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
 
@@ -230,6 +232,24 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 			// We expect a no-op here.
 			wantOperations: Operations{},
 		},
+		{
+			name:         "labels changed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true, Labels: []string{"before"}},
+			currentSpec:  &bt.TestSpecOpts{Published: true, Labels: []string{"before", "after"}},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:         "milestone changed on published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true, Milestone: "1.0"},
+			currentSpec:  &bt.TestSpecOpts{Published: true, Milestone: "1.1"},
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
 		{
 			name:         "commit diff changed on published changeset",
 			previousSpec: &bt.TestSpecOpts{Published: true, CommitDiff: []byte("testDiff")},
@@ -243,6 +263,63 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 				btypes.ReconcilerOperationSync,
 			},
 		},
+		{
+			name:         "conflicting published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec:  &bt.TestSpecOpts{Published: true},
+			changeset: bt.TestChangesetOpts{
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				OwnedByBatchChange: 1234,
+				Metadata:           &github.PullRequest{Mergeable: "CONFLICTING"},
+			},
+			wantOperations: Operations{
+				btypes.ReconcilerOperationRebase,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:         "conflicting published changeset with new commit diff",
+			previousSpec: &bt.TestSpecOpts{Published: true, CommitDiff: []byte("testDiff")},
+			currentSpec:  &bt.TestSpecOpts{Published: true, CommitDiff: []byte("newTestDiff")},
+			changeset: bt.TestChangesetOpts{
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				OwnedByBatchChange: 1234,
+				Metadata:           &github.PullRequest{Mergeable: "CONFLICTING"},
+			},
+			// The new commit is pushed on top of the new base commit anyway.
+			wantOperations: Operations{
+				btypes.ReconcilerOperationPush,
+				btypes.ReconcilerOperationSleep,
+				btypes.ReconcilerOperationSync,
+			},
+		},
+		{
+			name:         "conflicting merge request on unowned changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec:  &bt.TestSpecOpts{Published: true},
+			changeset: bt.TestChangesetOpts{
+				ExternalServiceType: extsvc.TypeGitLab,
+				PublicationState:    btypes.ChangesetPublicationStatePublished,
+				ExternalState:       btypes.ChangesetExternalStateOpen,
+				Metadata:            &gitlab.MergeRequest{HasConflicts: true},
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "mergeable published changeset",
+			previousSpec: &bt.TestSpecOpts{Published: true},
+			currentSpec:  &bt.TestSpecOpts{Published: true},
+			changeset: bt.TestChangesetOpts{
+				PublicationState:   btypes.ChangesetPublicationStatePublished,
+				ExternalState:      btypes.ChangesetExternalStateOpen,
+				OwnedByBatchChange: 1234,
+				Metadata:           &github.PullRequest{Mergeable: "MERGEABLE"},
+			},
+			wantOperations: Operations{},
+		},
 		{
 			name:         "commit message changed on published changeset",
 			previousSpec: &bt.TestSpecOpts{Published: true, CommitMessage: "old message"},
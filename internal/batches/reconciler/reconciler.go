@@ -97,6 +97,10 @@ func (r *Reconciler) process(ctx context.Context, logger log.Logger, tx *store.S
 		return nil, err
 	}
 
+	if err := holdBlockedPublication(ctx, tx, curr, plan); err != nil {
+		return nil, err
+	}
+
 	logger.Info("Reconciler processing changeset", log.Int64("changeset", ch.ID), log.String("operations", fmt.Sprintf("%+v", plan.Ops)))
 
 	return executePlan(
@@ -110,6 +114,38 @@ func (r *Reconciler) process(ctx context.Context, logger log.Logger, tx *store.S
 	)
 }
 
+// holdBlockedPublication removes the operations that publish the changeset
+// from the plan if changesets of its batch change in lower rollout tiers
+// aren't merged or closed yet. The changeset stays unpublished until the
+// syncer enqueues it again once they are.
+func holdBlockedPublication(ctx context.Context, tx *store.Store, spec *btypes.ChangesetSpec, plan *Plan) error {
+	if spec == nil || spec.RolloutTier == 0 {
+		return nil
+	}
+	if !plan.Ops.Contains(btypes.ReconcilerOperationPublish) && !plan.Ops.Contains(btypes.ReconcilerOperationPublishDraft) {
+		return nil
+	}
+
+	blockers, err := tx.GetChangesetRolloutBlockers(ctx, plan.Changeset.OwnedByBatchChangeID, spec.RolloutTier)
+	if err != nil {
+		return err
+	}
+	if !blockers.Blocked() {
+		return nil
+	}
+
+	ops := Operations{}
+	for _, op := range plan.Ops {
+		switch op {
+		case btypes.ReconcilerOperationPublish, btypes.ReconcilerOperationPublishDraft, btypes.ReconcilerOperationPush:
+		default:
+			ops = append(ops, op)
+		}
+	}
+	plan.Ops = ops
+	return nil
+}
+
 func loadChangesetSpecs(ctx context.Context, tx *store.Store, ch *btypes.Changeset) (prev, curr *btypes.ChangesetSpec, err error) {
 	if ch.CurrentSpecID != 0 {
 		curr, err = tx.GetChangesetSpecByID(ctx, ch.CurrentSpecID)
@@ -185,3 +185,74 @@ func mockExternalURL(t *testing.T, url string) {
 	conf.Mock(&newConf)
 	t.Cleanup(func() { conf.Mock(oldConf) })
 }
+
+func TestHoldBlockedPublication(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := actor.WithInternalActor(context.Background())
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	store := bstore.New(db, &observation.TestContext, nil)
+
+	admin := bt.CreateTestUser(t, db, true)
+	repo, _ := bt.CreateTestRepo(t, ctx, db)
+
+	batchSpec := bt.CreateBatchSpec(t, ctx, store, "rollout", admin.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, store, "rollout", admin.ID, batchSpec.ID)
+
+	newChangeset := func(headRef string, tier int, publication btypes.ChangesetPublicationState, external btypes.ChangesetExternalState) (*btypes.ChangesetSpec, *btypes.Changeset) {
+		spec := bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+			User:        admin.ID,
+			Repo:        repo.ID,
+			BatchSpec:   batchSpec.ID,
+			HeadRef:     headRef,
+			Published:   true,
+			RolloutTier: tier,
+			Typ:         btypes.ChangesetSpecTypeBranch,
+		})
+		return spec, bt.CreateChangeset(t, ctx, store, bt.TestChangesetOpts{
+			Repo:               repo.ID,
+			BatchChange:        batchChange.ID,
+			OwnedByBatchChange: batchChange.ID,
+			CurrentSpec:        spec.ID,
+			PublicationState:   publication,
+			ExternalState:      external,
+			ReconcilerState:    btypes.ReconcilerStateCompleted,
+		})
+	}
+
+	_, lib := newChangeset("refs/heads/lib", 0, btypes.ChangesetPublicationStatePublished, btypes.ChangesetExternalStateOpen)
+	appSpec, app := newChangeset("refs/heads/app", 1, btypes.ChangesetPublicationStateUnpublished, "")
+
+	publishPlan := func() *Plan {
+		return &Plan{
+			Changeset:     app,
+			ChangesetSpec: appSpec,
+			Ops:           Operations{btypes.ReconcilerOperationPublish, btypes.ReconcilerOperationPush},
+		}
+	}
+
+	plan := publishPlan()
+	if err := holdBlockedPublication(ctx, store, appSpec, plan); err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Ops.IsNone() {
+		t.Fatalf("publication of blocked changeset not held back: %s", plan.Ops)
+	}
+
+	lib.ExternalState = btypes.ChangesetExternalStateMerged
+	if err := store.UpdateChangeset(ctx, lib); err != nil {
+		t.Fatal(err)
+	}
+
+	plan = publishPlan()
+	if err := holdBlockedPublication(ctx, store, appSpec, plan); err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Ops.Equal(publishPlan().Ops) {
+		t.Fatalf("publication of unblocked changeset held back: %s", plan.Ops)
+	}
+}
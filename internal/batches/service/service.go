@@ -56,8 +56,9 @@ func NewWithClock(store *store.Store, clock func() time.Time) *Service {
 		sourcer: sources.NewSourcer(httpcli.NewExternalClientFactory(
 			httpcli.NewLoggingMiddleware(logger.Scoped("sourcer")),
 		)),
-		clock:      clock,
-		operations: newOperations(store.ObservationCtx()),
+		clock:             clock,
+		operations:        newOperations(store.ObservationCtx()),
+		workspaceResolver: NewWorkspaceResolver,
 	}
 
 	return svc
@@ -69,6 +70,10 @@ type Service struct {
 	sourcer    sources.Sourcer
 	operations *operations
 	clock      func() time.Time
+
+	// workspaceResolver is used to resolve the rollout tiers of batch specs
+	// when they're applied.
+	workspaceResolver WorkspaceResolverBuilder
 }
 
 type operations struct {
@@ -95,6 +100,8 @@ type operations struct {
 	applyBatchChange                     *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
+	startBatchChangeRerun                *observation.Operation
+	processBatchChangeRerun              *observation.Operation
 }
 
 var (
@@ -145,6 +152,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 			applyBatchChange:                     op("ApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
+			startBatchChangeRerun:                op("StartBatchChangeRerun"),
+			processBatchChangeRerun:              op("ProcessBatchChangeRerun"),
 		}
 	})
 
@@ -154,7 +163,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 // WithStore returns a copy of the Service with its store attribute set to the
 // given Store.
 func (s *Service) WithStore(store *store.Store) *Service {
-	return &Service{logger: s.logger, store: store, sourcer: s.sourcer, clock: s.clock, operations: s.operations}
+	return &Service{logger: s.logger, store: store, sourcer: s.sourcer, clock: s.clock, operations: s.operations, workspaceResolver: s.workspaceResolver}
 }
 
 // checkViewerCanAdminister checks if the current user can administer a batch change in the context of its creator and the namespace it belongs to, if the namespace is an organization.
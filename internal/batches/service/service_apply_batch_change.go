@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	bgql "github.com/sourcegraph/sourcegraph/internal/batches/graphql"
 	"github.com/sourcegraph/sourcegraph/internal/batches/rewirer"
 	"github.com/sourcegraph/sourcegraph/internal/batches/store"
@@ -98,6 +99,13 @@ func (s *Service) ApplyBatchChange(
 		return batchChange, nil
 	}
 
+	// Resolving the rollout tiers runs searches, so we do it before we start
+	// the transaction.
+	rolloutTiers, err := s.resolveRolloutTiers(ctx, batchSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	// Before we write to the database in a transaction, we cancel all
 	// currently enqueued/errored-and-retryable changesets the batch change might
 	// have.
@@ -181,6 +189,10 @@ func (s *Service) ApplyBatchChange(
 		}
 	}
 
+	if err := tx.UpdateChangesetSpecRolloutTiers(ctx, rolloutTiers); err != nil {
+		return nil, err
+	}
+
 	if len(newChangesets) > 0 {
 		if err = tx.CreateChangeset(ctx, newChangesets...); err != nil {
 			return nil, err
@@ -196,6 +208,45 @@ func (s *Service) ApplyBatchChange(
 	return batchChange, nil
 }
 
+// resolveRolloutTiers resolves the rollout tier of every changeset spec of
+// the given batch spec, keyed by changeset spec ID. It returns nil if the
+// batch spec doesn't order the publication of its changesets.
+func (s *Service) resolveRolloutTiers(ctx context.Context, batchSpec *btypes.BatchSpec) (map[int64]int, error) {
+	if batchSpec.Spec == nil || !batchSpec.Spec.HasRollout() {
+		return nil, nil
+	}
+
+	repoTiers, err := s.workspaceResolver(s.store).ResolveRolloutTiers(ctx, batchSpec.Spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving rollout tiers")
+	}
+
+	specs, _, err := s.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{BatchSpecID: batchSpec.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make(map[int64]int, len(specs))
+	for _, spec := range specs {
+		// Imported changesets aren't published by the batch change, and
+		// changesets in repositories that stopped matching the batch spec
+		// since it was executed are published last.
+		tier, ok := repoTiers[spec.BaseRepoID]
+		if !ok && spec.Type == btypes.ChangesetSpecTypeBranch {
+			tier = maxTier(repoTiers) + 1
+		}
+		tiers[spec.ID] = tier
+	}
+	return tiers, nil
+}
+
+func maxTier(tiers map[api.RepoID]int) (highest int) {
+	for _, tier := range tiers {
+		highest = max(highest, tier)
+	}
+	return highest
+}
+
 func (s *Service) ReconcileBatchChange(
 	ctx context.Context,
 	batchSpec *btypes.BatchSpec,
@@ -11,6 +11,7 @@ import (
 	"github.com/sourcegraph/log/logtest"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/batches/reconciler"
 	bstore "github.com/sourcegraph/sourcegraph/internal/batches/store"
 	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
@@ -19,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -1229,3 +1231,69 @@ func applyAndListChangesets(ctx context.Context, t *testing.T, svc *Service, bat
 
 	return batchChange, changesets
 }
+
+type fakeRolloutResolver struct {
+	WorkspaceResolver
+	tiers map[api.RepoID]int
+}
+
+func (r *fakeRolloutResolver) ResolveRolloutTiers(context.Context, *batcheslib.BatchSpec) (map[api.RepoID]int, error) {
+	return r.tiers, nil
+}
+
+func TestServiceApplyBatchChange_RolloutTiers(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := actor.WithInternalActor(context.Background())
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	admin := bt.CreateTestUser(t, db, true)
+	adminCtx := actor.WithActor(context.Background(), actor.FromUser(admin.ID))
+
+	repos, _ := bt.CreateTestRepos(t, ctx, db, 3)
+
+	store := bstore.New(db, &observation.TestContext, nil)
+	svc := New(store)
+	svc.workspaceResolver = func(*bstore.Store) WorkspaceResolver {
+		return &fakeRolloutResolver{tiers: map[api.RepoID]int{repos[0].ID: 0, repos[1].ID: 2}}
+	}
+
+	batchSpec := bt.CreateBatchSpec(t, ctx, store, "rollout", admin.ID, 0)
+	batchSpec.Spec.On = []batcheslib.OnQueryOrRepository{
+		{Repository: string(repos[0].Name)},
+		{Repository: string(repos[1].Name), DependsOn: []string{string(repos[0].Name)}, Tier: 2},
+	}
+	if err := store.UpdateBatchSpec(ctx, batchSpec); err != nil {
+		t.Fatal(err)
+	}
+
+	var specs []*btypes.ChangesetSpec
+	for _, repo := range repos {
+		specs = append(specs, bt.CreateChangesetSpec(t, ctx, store, bt.TestSpecOpts{
+			User:      admin.ID,
+			Repo:      repo.ID,
+			BatchSpec: batchSpec.ID,
+			HeadRef:   "refs/heads/rollout",
+			Typ:       btypes.ChangesetSpecTypeBranch,
+		}))
+	}
+
+	if _, err := svc.ApplyBatchChange(adminCtx, ApplyBatchChangeOpts{BatchSpecRandID: batchSpec.RandID}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The last repository didn't match the batch spec when it was applied,
+	// so it is placed in the highest tier.
+	for i, want := range []int{0, 2, 3} {
+		spec, err := store.GetChangesetSpecByID(ctx, specs[i].ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if spec.RolloutTier != want {
+			t.Fatalf("changeset spec %d has wrong rollout tier. want=%d, have=%d", i, want, spec.RolloutTier)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// StartBatchChangeRerun starts a rerun of the given batch change for the given
+// schedule time: it creates a copy of the batch spec currently applied to the
+// batch change, enqueues the resolution of its workspaces and records the
+// rerun, which is then advanced by ProcessBatchChangeRerun.
+//
+// The batch spec is created on behalf of the user who last applied the batch
+// change, so that only repositories and secrets visible to them are used. If
+// they can no longer administer the batch change, the rerun is recorded as
+// failed.
+func (s *Service) StartBatchChangeRerun(ctx context.Context, batchChange *btypes.BatchChange, scheduledAt time.Time) (rerun *btypes.BatchChangeRerun, err error) {
+	ctx, _, endObservation := s.operations.startBatchChangeRerun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChange.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rerun = &btypes.BatchChangeRerun{
+		BatchChangeID: batchChange.ID,
+		ScheduledAt:   scheduledAt,
+	}
+
+	ctx = actor.WithActor(ctx, actor.FromUser(batchChange.LastApplierID))
+	if err := s.CheckNamespaceAccess(ctx, batchChange.NamespaceUserID, batchChange.NamespaceOrgID); err != nil {
+		rerun.State = btypes.BatchChangeRerunStateFailed
+		rerun.FailureMessage = fmt.Sprintf("the user who last applied the batch change cannot access its namespace anymore: %s", err)
+		return rerun, s.store.CreateBatchChangeRerun(ctx, rerun)
+	}
+
+	current, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := btypes.NewBatchSpecFromRaw(current.RawSpec)
+	if err != nil {
+		rerun.State = btypes.BatchChangeRerunStateFailed
+		rerun.FailureMessage = fmt.Sprintf("parsing the batch spec failed: %s", err)
+		return rerun, s.store.CreateBatchChangeRerun(ctx, rerun)
+	}
+	spec.NamespaceUserID = batchChange.NamespaceUserID
+	spec.NamespaceOrgID = batchChange.NamespaceOrgID
+	spec.UserID = batchChange.LastApplierID
+	spec.BatchChangeID = batchChange.ID
+
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := s.createBatchSpecForExecution(ctx, tx, createBatchSpecForExecutionOpts{
+		spec:             spec,
+		allowIgnored:     current.AllowIgnored,
+		allowUnsupported: current.AllowUnsupported,
+	}); err != nil {
+		return nil, err
+	}
+
+	rerun.BatchSpecID = spec.ID
+	return rerun, tx.CreateBatchChangeRerun(ctx, rerun)
+}
+
+// ProcessBatchChangeRerun advances the given rerun that is still processing.
+// Once the workspaces of its batch spec are resolved, it executes the
+// workspaces that have no cached results, and once the execution completed it
+// applies the batch spec to the batch change. Changesets keep the publication
+// state they had, and new changesets are published according to the
+// changesetTemplate of the batch spec.
+//
+// The rerun fails without touching the batch change if the batch change was
+// closed or applied with a different batch spec in the meantime, or if any of
+// the workspaces failed.
+func (s *Service) ProcessBatchChangeRerun(ctx context.Context, rerun *btypes.BatchChangeRerun) (err error) {
+	ctx, _, endObservation := s.operations.processBatchChangeRerun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(rerun.ID)),
+		attribute.Int("batchChangeID", int(rerun.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	fail := func(format string, args ...any) error {
+		rerun.State = btypes.BatchChangeRerunStateFailed
+		rerun.FailureMessage = fmt.Sprintf(format, args...)
+		return s.store.UpdateBatchChangeRerun(ctx, rerun)
+	}
+
+	if rerun.BatchSpecID == 0 {
+		return fail("the batch spec of the rerun was deleted")
+	}
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: rerun.BatchChangeID})
+	if err != nil {
+		return err
+	}
+	if batchChange.Closed() {
+		return fail("the batch change was closed")
+	}
+
+	batchSpec, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: rerun.BatchSpecID})
+	if err != nil {
+		return err
+	}
+	current, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return err
+	}
+	if current.RawSpec != batchSpec.RawSpec {
+		return fail("the batch change was applied with a different batch spec during the rerun")
+	}
+
+	ctx = actor.WithActor(ctx, actor.FromUser(batchSpec.UserID))
+
+	resolutionJob, err := s.store.GetBatchSpecResolutionJob(ctx, store.GetBatchSpecResolutionJobOpts{BatchSpecID: batchSpec.ID})
+	if err != nil {
+		return err
+	}
+	switch resolutionJob.State {
+	case btypes.BatchSpecResolutionJobStateErrored, btypes.BatchSpecResolutionJobStateFailed:
+		return fail("%s", ErrBatchSpecResolutionErrored{resolutionJob.FailureMessage})
+	case btypes.BatchSpecResolutionJobStateCompleted:
+	default:
+		// The workspaces are still being resolved.
+		return nil
+	}
+
+	stats, err := s.LoadBatchSpecStats(ctx, batchSpec)
+	if err != nil {
+		return err
+	}
+	if stats.Workspaces == 0 && len(batchSpec.Spec.On) > 0 {
+		// Applying the batch spec would archive all changesets of the batch
+		// change, which is more likely caused by a transient search problem
+		// than by all repositories no longer matching.
+		return fail("no workspaces were resolved")
+	}
+
+	switch state := btypes.ComputeBatchSpecState(batchSpec, stats); state {
+	case btypes.BatchSpecStatePending:
+		// Only workspaces without cached results are executed, the others
+		// are marked as skipped.
+		_, err := s.ExecuteBatchSpec(ctx, ExecuteBatchSpecOpts{BatchSpecRandID: batchSpec.RandID})
+		return err
+
+	case btypes.BatchSpecStateCompleted:
+		if _, err := s.ApplyBatchChange(ctx, ApplyBatchChangeOpts{
+			BatchSpecRandID:     batchSpec.RandID,
+			EnsureBatchChangeID: batchChange.ID,
+		}); err != nil {
+			return fail("applying the batch spec failed: %s", err)
+		}
+		rerun.State = btypes.BatchChangeRerunStateApplied
+		return s.store.UpdateBatchChangeRerun(ctx, rerun)
+
+	case btypes.BatchSpecStateFailed:
+		return fail("%d of %d workspaces failed to execute", stats.Failed, stats.Executions)
+
+	case btypes.BatchSpecStateCanceled:
+		return fail("the execution of the batch spec was canceled")
+
+	default:
+		// The workspaces are still being executed.
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	bstore "github.com/sourcegraph/sourcegraph/internal/batches/store"
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+)
+
+func TestServiceBatchChangeReruns(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := actor.WithInternalActor(context.Background())
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	user := bt.CreateTestUser(t, db, false)
+	otherUser := bt.CreateTestUser(t, db, false)
+	org := bt.CreateTestOrg(t, db, "test-org", otherUser.ID)
+
+	repos, _ := bt.CreateTestRepos(t, ctx, db, 2)
+
+	now := timeutil.Now()
+	clock := func() time.Time { return now }
+	s := bstore.NewWithClock(db, &observation.TestContext, nil, clock)
+	svc := New(s)
+
+	createBatchChange := func(t *testing.T, name string) *btypes.BatchChange {
+		t.Helper()
+
+		spec := bt.CreateBatchSpec(t, ctx, s, name, user.ID, 0)
+		return bt.CreateBatchChange(t, ctx, s, name, user.ID, spec.ID)
+	}
+
+	// resolve simulates the resolution of the workspaces of the batch spec of
+	// the given rerun, with cached results for all workspaces.
+	resolve := func(t *testing.T, rerun *btypes.BatchChangeRerun) {
+		t.Helper()
+
+		if err := s.Exec(ctx, sqlf.Sprintf(
+			"UPDATE batch_spec_resolution_jobs SET state = %s WHERE batch_spec_id = %s",
+			btypes.BatchSpecResolutionJobStateCompleted,
+			rerun.BatchSpecID,
+		)); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, repo := range repos {
+			if err := s.CreateBatchSpecWorkspace(ctx, &btypes.BatchSpecWorkspace{
+				BatchSpecID:       rerun.BatchSpecID,
+				RepoID:            repo.ID,
+				CachedResultFound: true,
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	assertState := func(t *testing.T, rerun *btypes.BatchChangeRerun, want btypes.BatchChangeRerunState) {
+		t.Helper()
+
+		if rerun.State != want {
+			t.Fatalf("wrong state. want=%s, have=%s (failure message: %q)", want, rerun.State, rerun.FailureMessage)
+		}
+	}
+
+	t.Run("rerun is applied", func(t *testing.T) {
+		batchChange := createBatchChange(t, "rerun-applied")
+
+		rerun, err := svc.StartBatchChangeRerun(ctx, batchChange, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertState(t, rerun, btypes.BatchChangeRerunStateProcessing)
+
+		spec, err := s.GetBatchSpec(ctx, bstore.GetBatchSpecOpts{ID: rerun.BatchSpecID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !spec.CreatedFromRaw || spec.UserID != user.ID || spec.BatchChangeID != batchChange.ID {
+			t.Fatalf("batch spec not created for execution on behalf of the last applier: %+v", spec)
+		}
+
+		// The workspaces are still being resolved.
+		if err := svc.ProcessBatchChangeRerun(ctx, rerun); err != nil {
+			t.Fatal(err)
+		}
+		assertState(t, rerun, btypes.BatchChangeRerunStateProcessing)
+
+		resolve(t, rerun)
+
+		// The first pass executes the workspaces, which all have cached
+		// results, and the second pass applies the batch spec.
+		for i := 0; i < 2; i++ {
+			if err := svc.ProcessBatchChangeRerun(ctx, rerun); err != nil {
+				t.Fatal(err)
+			}
+		}
+		assertState(t, rerun, btypes.BatchChangeRerunStateApplied)
+
+		batchChange, err = s.GetBatchChange(ctx, bstore.GetBatchChangeOpts{ID: batchChange.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if batchChange.BatchSpecID != rerun.BatchSpecID {
+			t.Fatalf("batch spec of the rerun not applied. want=%d, have=%d", rerun.BatchSpecID, batchChange.BatchSpecID)
+		}
+	})
+
+	t.Run("batch change applied during the rerun", func(t *testing.T) {
+		batchChange := createBatchChange(t, "rerun-superseded")
+
+		rerun, err := svc.StartBatchChangeRerun(ctx, batchChange, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := bt.CreateBatchSpec(t, ctx, s, "rerun-superseded-2", user.ID, 0)
+		batchChange.BatchSpecID = spec.ID
+		if err := s.UpdateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := svc.ProcessBatchChangeRerun(ctx, rerun); err != nil {
+			t.Fatal(err)
+		}
+		assertState(t, rerun, btypes.BatchChangeRerunStateFailed)
+	})
+
+	t.Run("last applier lost access", func(t *testing.T) {
+		spec := bt.CreateBatchSpec(t, ctx, s, "rerun-no-access", user.ID, 0)
+		batchChange := bt.CreateBatchChange(t, ctx, s, "rerun-no-access", user.ID, spec.ID)
+		batchChange.NamespaceUserID = 0
+		batchChange.NamespaceOrgID = org.ID
+		if err := s.UpdateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+
+		rerun, err := svc.StartBatchChangeRerun(ctx, batchChange, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertState(t, rerun, btypes.BatchChangeRerunStateFailed)
+		if rerun.BatchSpecID != 0 {
+			t.Fatalf("batch spec created for failed rerun")
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"fmt"
+
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -86,3 +89,52 @@ func (ps *UiPublicationStates) prepareAndValidate(mappings btypes.RewirerMapping
 
 	return errs
 }
+
+// PublicationBlockedReason returns why the given changeset isn't published
+// although its changeset spec or UI publication state asks for it to be
+// published, or an empty string if its publication isn't blocked.
+//
+// The publication of a changeset is blocked while changesets of its batch
+// change in lower rollout tiers aren't merged or closed.
+func (s *Service) PublicationBlockedReason(ctx context.Context, ch *btypes.Changeset) (string, error) {
+	if ch.PublicationState != btypes.ChangesetPublicationStateUnpublished || ch.OwnedByBatchChangeID == 0 || ch.CurrentSpecID == 0 {
+		return "", nil
+	}
+
+	spec, err := s.store.GetChangesetSpecByID(ctx, ch.CurrentSpecID)
+	if err != nil {
+		return "", err
+	}
+	if spec.RolloutTier == 0 || !wantsPublication(spec, ch) {
+		return "", nil
+	}
+
+	blockers, err := s.store.GetChangesetRolloutBlockers(ctx, ch.OwnedByBatchChangeID, spec.RolloutTier)
+	if err != nil {
+		return "", err
+	}
+	if !blockers.Blocked() {
+		return "", nil
+	}
+
+	return rolloutBlockedReason(spec.RolloutTier, blockers), nil
+}
+
+func wantsPublication(spec *btypes.ChangesetSpec, ch *btypes.Changeset) bool {
+	if !spec.Published.Nil() {
+		return spec.Published.True() || spec.Published.Draft()
+	}
+	return ch.UiPublicationState != nil && *ch.UiPublicationState != btypes.ChangesetUiPublicationStateUnpublished
+}
+
+func rolloutBlockedReason(tier int, blockers *btypes.ChangesetRolloutBlockers) string {
+	changesets := "a changeset"
+	if blockers.Count > 1 {
+		changesets = fmt.Sprintf("%d changesets", blockers.Count)
+	}
+	tiers := fmt.Sprintf("rollout tier %d", blockers.Tier)
+	if blockers.Tier < tier-1 {
+		tiers = fmt.Sprintf("rollout tiers %d to %d", blockers.Tier, tier-1)
+	}
+	return fmt.Sprintf("Waiting for %s in %s to be merged or closed before publishing this changeset in tier %d.", changesets, tiers, tier)
+}
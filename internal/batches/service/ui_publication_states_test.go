@@ -163,3 +163,76 @@ func TestUiPublicationStates_prepareEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestRolloutBlockedReason(t *testing.T) {
+	for name, tc := range map[string]struct {
+		tier     int
+		blockers btypes.ChangesetRolloutBlockers
+		want     string
+	}{
+		"one changeset in the tier below": {
+			tier:     1,
+			blockers: btypes.ChangesetRolloutBlockers{Tier: 0, Count: 1},
+			want:     "Waiting for a changeset in rollout tier 0 to be merged or closed before publishing this changeset in tier 1.",
+		},
+		"several changesets in lower tiers": {
+			tier:     3,
+			blockers: btypes.ChangesetRolloutBlockers{Tier: 1, Count: 4},
+			want:     "Waiting for 4 changesets in rollout tiers 1 to 2 to be merged or closed before publishing this changeset in tier 3.",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := rolloutBlockedReason(tc.tier, &tc.blockers); have != tc.want {
+				t.Fatalf("wrong reason. want=%q, have=%q", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestWantsPublication(t *testing.T) {
+	published := btypes.ChangesetUiPublicationStatePublished
+	unpublished := btypes.ChangesetUiPublicationStateUnpublished
+
+	for name, tc := range map[string]struct {
+		spec *btypes.ChangesetSpec
+		ch   *btypes.Changeset
+		want bool
+	}{
+		"published in spec": {
+			spec: &btypes.ChangesetSpec{Published: batcheslib.PublishedValue{Val: true}},
+			ch:   &btypes.Changeset{},
+			want: true,
+		},
+		"draft in spec": {
+			spec: &btypes.ChangesetSpec{Published: batcheslib.PublishedValue{Val: "draft"}},
+			ch:   &btypes.Changeset{},
+			want: true,
+		},
+		"unpublished in spec": {
+			spec: &btypes.ChangesetSpec{Published: batcheslib.PublishedValue{Val: false}},
+			ch:   &btypes.Changeset{UiPublicationState: &published},
+			want: false,
+		},
+		"published in UI": {
+			spec: &btypes.ChangesetSpec{},
+			ch:   &btypes.Changeset{UiPublicationState: &published},
+			want: true,
+		},
+		"unpublished in UI": {
+			spec: &btypes.ChangesetSpec{},
+			ch:   &btypes.Changeset{UiPublicationState: &unpublished},
+			want: false,
+		},
+		"no publication state": {
+			spec: &btypes.ChangesetSpec{},
+			ch:   &btypes.Changeset{},
+			want: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := wantsPublication(tc.spec, tc.ch); have != tc.want {
+				t.Fatalf("wrong result. want=%t, have=%t", tc.want, have)
+			}
+		})
+	}
+}
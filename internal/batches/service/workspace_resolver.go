@@ -68,6 +68,13 @@ type WorkspaceResolver interface {
 		workspaces []*RepoWorkspace,
 		err error,
 	)
+	ResolveRolloutTiers(
+		ctx context.Context,
+		batchSpec *batcheslib.BatchSpec,
+	) (
+		tiers map[api.RepoID]int,
+		err error,
+	)
 }
 
 type WorkspaceResolverBuilder func(tx *store.Store) WorkspaceResolver
@@ -168,6 +175,37 @@ func (wr *workspaceResolver) determineRepositories(ctx context.Context, batchSpe
 	return repoRevs, errs
 }
 
+// ResolveRolloutTiers resolves the repositories of every entry of the batch
+// spec's on field separately and computes their rollout tiers.
+func (wr *workspaceResolver) ResolveRolloutTiers(ctx context.Context, batchSpec *batcheslib.BatchSpec) (tiers map[api.RepoID]int, err error) {
+	tr, ctx := trace.New(ctx, "workspaceResolver.ResolveRolloutTiers")
+	defer tr.EndWithErr(&err)
+
+	ids := make(map[string]api.RepoID)
+	names := make([][]string, len(batchSpec.On))
+	for i, on := range batchSpec.On {
+		revs, _, err := wr.resolveRepositoriesOn(ctx, &on)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving %q", on.String())
+		}
+		for _, rev := range revs {
+			ids[string(rev.Repo.Name)] = rev.Repo.ID
+			names[i] = append(names[i], string(rev.Repo.Name))
+		}
+	}
+
+	byName, err := batcheslib.RolloutTiers(batchSpec.On, names)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers = make(map[api.RepoID]int, len(byName))
+	for name, tier := range byName {
+		tiers[ids[name]] = tier
+	}
+	return tiers, nil
+}
+
 // ignoredWorkspaceResolverConcurrency defines the maximum concurrency level at that
 // findIgnoredRepositories will hit gitserver for file info.
 const ignoredWorkspaceResolverConcurrency = 5
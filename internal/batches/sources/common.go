@@ -3,6 +3,7 @@ package sources
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
@@ -60,6 +61,18 @@ type ForkableChangesetSource interface {
 	GetFork(ctx context.Context, targetRepo *types.Repo, namespace, name *string) (*types.Repo, error)
 }
 
+// A MetadataChangesetSource can add labels, reviewers, assignees and a
+// milestone to changesets.
+type MetadataChangesetSource interface {
+	ChangesetSource
+
+	// ApplyChangesetMetadata adds the labels, reviewers and assignees in the
+	// RequestedMetadata of the Changeset to the changeset on the source and
+	// sets its milestone, if one is given. Labels, reviewers and assignees
+	// that were added on the code host are kept.
+	ApplyChangesetMetadata(context.Context, *Changeset) error
+}
+
 // A ChangesetSource can load the latest state of a list of Changesets.
 type ChangesetSource interface {
 	// GitserverPushConfig returns an authenticated push config used for pushing
@@ -115,6 +128,62 @@ func (e ChangesetNotMergeableError) Error() string {
 
 func (e ChangesetNotMergeableError) NonRetryable() bool { return true }
 
+// ChangesetMetadataUnsupportedError is returned if a changeset spec requests
+// metadata that the code host of the changeset doesn't support.
+type ChangesetMetadataUnsupportedError struct {
+	Fields []string
+}
+
+func (e ChangesetMetadataUnsupportedError) Error() string {
+	return fmt.Sprintf("the code host of the changeset doesn't support %s", strings.Join(e.Fields, ", "))
+}
+
+func (e ChangesetMetadataUnsupportedError) NonRetryable() bool { return true }
+
+// ChangesetMetadata is the metadata of a changeset that is applied in addition
+// to its title and body on code hosts that support it.
+type ChangesetMetadata struct {
+	Labels    []string
+	Reviewers []string
+	Assignees []string
+	Milestone string
+}
+
+// ChangesetMetadataFromSpec returns the metadata requested by the given
+// changeset spec.
+func ChangesetMetadataFromSpec(spec *btypes.ChangesetSpec) ChangesetMetadata {
+	return ChangesetMetadata{
+		Labels:    spec.Labels,
+		Reviewers: spec.Reviewers,
+		Assignees: spec.Assignees,
+		Milestone: spec.Milestone,
+	}
+}
+
+// Fields returns the names of the fields that are set, in the order they
+// appear in the changeset template.
+func (m ChangesetMetadata) Fields() []string {
+	var fields []string
+	if len(m.Labels) != 0 {
+		fields = append(fields, "labels")
+	}
+	if len(m.Reviewers) != 0 {
+		fields = append(fields, "reviewers")
+	}
+	if len(m.Assignees) != 0 {
+		fields = append(fields, "assignees")
+	}
+	if m.Milestone != "" {
+		fields = append(fields, "milestone")
+	}
+	return fields
+}
+
+// IsEmpty returns true if no metadata is requested.
+func (m ChangesetMetadata) IsEmpty() bool {
+	return len(m.Fields()) == 0
+}
+
 // A Changeset of an existing Repo.
 type Changeset struct {
 	Title   string
@@ -129,6 +198,10 @@ type Changeset struct {
 	// opened.
 	TargetRepo *types.Repo
 
+	// RequestedMetadata is the metadata the changeset spec requests to be
+	// applied to the changeset.
+	RequestedMetadata ChangesetMetadata
+
 	*btypes.Changeset
 }
 
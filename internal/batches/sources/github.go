@@ -29,7 +29,10 @@ type GitHubSource struct {
 	au     auth.Authenticator
 }
 
-var _ ForkableChangesetSource = GitHubSource{}
+var (
+	_ ForkableChangesetSource = GitHubSource{}
+	_ MetadataChangesetSource = GitHubSource{}
+)
 
 func NewGitHubSource(ctx context.Context, db database.DB, svc *types.ExternalService, cf *httpcli.Factory) (*GitHubSource, error) {
 	rawConfig, err := svc.Config.Decrypt(ctx)
@@ -373,6 +376,71 @@ func (s GitHubSource) MergeChangeset(ctx context.Context, c *Changeset, squash b
 	return c.Changeset.SetMetadata(pr)
 }
 
+// ApplyChangesetMetadata adds the requested labels, reviewers and assignees to
+// the pull request and sets its milestone. Reviewers of the form "org/team"
+// are requested as team reviewers.
+func (s GitHubSource) ApplyChangesetMetadata(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*github.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitHub pull request")
+	}
+
+	repo := c.TargetRepo.Metadata.(*github.Repository)
+	owner, repoName, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting owner and repo name to apply changeset metadata")
+	}
+
+	m := c.RequestedMetadata
+	if len(m.Labels) != 0 {
+		if err := s.client.AddLabelsToIssue(ctx, owner, repoName, pr.Number, m.Labels); err != nil {
+			return errors.Wrap(err, "adding labels")
+		}
+	}
+
+	if len(m.Reviewers) != 0 {
+		var users, teams []string
+		for _, r := range m.Reviewers {
+			if _, team, ok := strings.Cut(r, "/"); ok {
+				teams = append(teams, team)
+			} else {
+				users = append(users, r)
+			}
+		}
+		if err := s.client.RequestPullRequestReviewers(ctx, owner, repoName, pr.Number, users, teams); err != nil {
+			return errors.Wrap(err, "requesting reviewers")
+		}
+	}
+
+	if len(m.Assignees) != 0 {
+		if err := s.client.AddAssigneesToIssue(ctx, owner, repoName, pr.Number, m.Assignees); err != nil {
+			return errors.Wrap(err, "adding assignees")
+		}
+	}
+
+	if m.Milestone != "" {
+		milestones, err := s.client.ListOpenMilestones(ctx, owner, repoName)
+		if err != nil {
+			return errors.Wrap(err, "listing milestones")
+		}
+		var number int64
+		for _, milestone := range milestones {
+			if milestone.Title == m.Milestone {
+				number = milestone.Number
+				break
+			}
+		}
+		if number == 0 {
+			return errors.Newf("no open milestone with the title %q exists in %s", m.Milestone, repo.NameWithOwner)
+		}
+		if err := s.client.SetIssueMilestone(ctx, owner, repoName, pr.Number, number); err != nil {
+			return errors.Wrap(err, "setting milestone")
+		}
+	}
+
+	return nil
+}
+
 func (GitHubSource) IsPushResponseArchived(s string) bool {
 	return strings.Contains(s, "This repository was archived so it is read-only.")
 }
@@ -32,6 +32,7 @@ type GitLabSource struct {
 var _ ChangesetSource = &GitLabSource{}
 var _ DraftChangesetSource = &GitLabSource{}
 var _ ForkableChangesetSource = &GitLabSource{}
+var _ MetadataChangesetSource = &GitLabSource{}
 
 // NewGitLabSource returns a new GitLabSource from the given external service.
 func NewGitLabSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GitLabSource, error) {
@@ -528,6 +529,82 @@ func (s *GitLabSource) UndraftChangeset(ctx context.Context, c *Changeset) error
 	return s.UpdateChangeset(ctx, c)
 }
 
+// ApplyChangesetMetadata adds the requested labels, reviewers and assignees to
+// the merge request and sets its milestone. The milestone must be an active
+// milestone of the project.
+func (s *GitLabSource) ApplyChangesetMetadata(ctx context.Context, c *Changeset) error {
+	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
+	if !ok {
+		return errors.New("Changeset is not a GitLab merge request")
+	}
+	project := c.TargetRepo.Metadata.(*gitlab.Project)
+
+	m := c.RequestedMetadata
+	opts := gitlab.UpdateMergeRequestOpts{
+		AddLabels: strings.Join(m.Labels, ","),
+	}
+
+	// GitLab replaces the reviewers and assignees of a merge request, so we
+	// have to add the existing ones to keep them.
+	var err error
+	if len(m.Reviewers) != 0 {
+		if opts.ReviewerIDs, err = s.mergeUserIDs(ctx, mr.Reviewers, m.Reviewers); err != nil {
+			return errors.Wrap(err, "resolving reviewers")
+		}
+	}
+	if len(m.Assignees) != 0 {
+		if opts.AssigneeIDs, err = s.mergeUserIDs(ctx, mr.Assignees, m.Assignees); err != nil {
+			return errors.Wrap(err, "resolving assignees")
+		}
+	}
+
+	if m.Milestone != "" {
+		milestone, err := s.client.GetActiveMilestoneByTitle(ctx, project, m.Milestone)
+		if err != nil {
+			return errors.Wrapf(err, "getting milestone %q", m.Milestone)
+		}
+		opts.MilestoneID = milestone.ID
+	}
+
+	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, opts)
+	if err != nil {
+		return errors.Wrap(err, "updating GitLab merge request")
+	}
+
+	// The metadata doesn't change the notes, pipelines and events of the merge
+	// request, so we don't need to fetch them again.
+	updated.Notes = mr.Notes
+	updated.Pipelines = mr.Pipelines
+	updated.ResourceStateEvents = mr.ResourceStateEvents
+
+	return c.Changeset.SetMetadata(updated)
+}
+
+// mergeUserIDs returns the IDs of the existing users followed by the IDs of
+// the users with the given usernames that aren't in existing yet.
+func (s *GitLabSource) mergeUserIDs(ctx context.Context, existing []gitlab.User, usernames []string) ([]int32, error) {
+	ids := make([]int32, 0, len(existing)+len(usernames))
+	seen := make(map[string]struct{}, len(existing))
+	for _, u := range existing {
+		ids = append(ids, u.ID)
+		seen[u.Username] = struct{}{}
+	}
+
+	for _, username := range usernames {
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		u, err := s.client.GetUserByUsername(ctx, username)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting user %q", username)
+		}
+		ids = append(ids, u.ID)
+		seen[username] = struct{}{}
+	}
+
+	return ids, nil
+}
+
 // CreateComment posts a comment on the Changeset.
 func (s *GitLabSource) CreateComment(ctx context.Context, c *Changeset, text string) error {
 	project := c.TargetRepo.Metadata.(*gitlab.Project)
@@ -882,6 +882,73 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		}
 	})
 
+	t.Run("ApplyChangesetMetadata", func(t *testing.T) {
+		in := &gitlab.MergeRequest{
+			IID:       2,
+			Reviewers: []gitlab.User{{ID: 1, Username: "alice"}},
+			Notes:     []*gitlab.Note{{ID: 1}},
+		}
+		out := &gitlab.MergeRequest{IID: 2}
+
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Changeset.Metadata = in
+		p.changeset.RequestedMetadata = ChangesetMetadata{
+			Labels:    []string{"batch-change", "dependencies"},
+			Reviewers: []string{"alice", "bob"},
+			Assignees: []string{"bob"},
+			Milestone: "1.0",
+		}
+
+		gitlab.MockGetUserByUsername = func(c *gitlab.Client, ctx context.Context, username string) (*gitlab.User, error) {
+			if username != "bob" {
+				t.Errorf("unexpected user lookup: %q", username)
+			}
+			return &gitlab.User{ID: 2, Username: username}, nil
+		}
+		gitlab.MockGetActiveMilestoneByTitle = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, title string) (*gitlab.Milestone, error) {
+			if have, want := title, "1.0"; have != want {
+				t.Errorf("unexpected milestone title: have=%q want=%q", have, want)
+			}
+			return &gitlab.Milestone{ID: 42, Title: title}, nil
+		}
+		gitlab.MockUpdateMergeRequest = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, opts gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			want := gitlab.UpdateMergeRequestOpts{
+				AddLabels:   "batch-change,dependencies",
+				ReviewerIDs: []int32{1, 2},
+				AssigneeIDs: []int32{2},
+				MilestoneID: 42,
+			}
+			if diff := cmp.Diff(want, opts); diff != "" {
+				t.Errorf("unexpected options (-want +got):\n%s", diff)
+			}
+			return out, nil
+		}
+
+		if err := p.source.ApplyChangesetMetadata(p.ctx, p.changeset); err != nil {
+			t.Errorf("unexpected non-nil error: %+v", err)
+		}
+		if p.changeset.Changeset.Metadata != out {
+			t.Errorf("metadata not correctly updated: have %+v; want %+v", p.changeset.Changeset.Metadata, out)
+		}
+		if diff := cmp.Diff(in.Notes, out.Notes); diff != "" {
+			t.Errorf("notes not retained (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("ApplyChangesetMetadata unknown milestone", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Changeset.Metadata = &gitlab.MergeRequest{IID: 2}
+		p.changeset.RequestedMetadata = ChangesetMetadata{Milestone: "1.0"}
+
+		gitlab.MockGetActiveMilestoneByTitle = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, title string) (*gitlab.Milestone, error) {
+			return nil, gitlab.ErrMilestoneNotFound
+		}
+
+		if err := p.source.ApplyChangesetMetadata(p.ctx, p.changeset); !errors.Is(err, gitlab.ErrMilestoneNotFound) {
+			t.Errorf("unexpected error: have=%+v want=%+v", err, gitlab.ErrMilestoneNotFound)
+		}
+	})
+
 	t.Run("CreateComment", func(t *testing.T) {
 		commentBody := "test-comment"
 		t.Run("invalid metadata", func(t *testing.T) {
@@ -1267,6 +1334,8 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockGetUserByUsername = nil
+	gitlab.MockGetActiveMilestoneByTitle = nil
 
 	versions.MockGetVersions = nil
 }
@@ -324,6 +324,17 @@ func ToDraftChangesetSource(css ChangesetSource) (DraftChangesetSource, error) {
 	return draftCss, nil
 }
 
+// ToMetadataChangesetSource returns the given source as a
+// MetadataChangesetSource. If the source can't apply changeset metadata, a
+// ChangesetMetadataUnsupportedError naming the requested fields is returned.
+func ToMetadataChangesetSource(css ChangesetSource, metadata ChangesetMetadata) (MetadataChangesetSource, error) {
+	metadataCss, ok := css.(MetadataChangesetSource)
+	if !ok {
+		return nil, ChangesetMetadataUnsupportedError{Fields: metadata.Fields()}
+	}
+	return metadataCss, nil
+}
+
 type getBatchChanger interface {
 	GetBatchChange(ctx context.Context, opts store.GetBatchChangeOpts) (*btypes.BatchChange, error)
 }
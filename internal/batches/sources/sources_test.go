@@ -743,6 +743,28 @@ func TestGetRemoteRepo(t *testing.T) {
 	})
 }
 
+func TestToMetadataChangesetSource(t *testing.T) {
+	metadata := ChangesetMetadata{
+		Labels:    []string{"batch-change"},
+		Milestone: "1.0",
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		css := &GitLabSource{}
+		have, err := ToMetadataChangesetSource(css, metadata)
+		assert.Nil(t, err)
+		assert.Same(t, css, have)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		have, err := ToMetadataChangesetSource(NewMockChangesetSource(), metadata)
+		assert.Nil(t, have)
+		assert.Equal(t, ChangesetMetadataUnsupportedError{Fields: []string{"labels", "milestone"}}, err)
+		assert.True(t, errcode.IsNonRetryable(err))
+		assert.Equal(t, "the code host of the changeset doesn't support labels, milestone", err.Error())
+	})
+}
+
 func newMockSourcer(css ChangesetSource) Sourcer {
 	return newSourcer(nil, func(ctx context.Context, tx SourcerStore, cf *httpcli.Factory, extSvc *types.ExternalService) (ChangesetSource, error) {
 		return css, nil
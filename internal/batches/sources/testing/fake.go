@@ -44,21 +44,22 @@ type FakeChangesetSource struct {
 
 	CurrentAuthenticator auth.Authenticator
 
-	CreateDraftChangesetCalled  bool
-	UndraftedChangesetsCalled   bool
-	CreateChangesetCalled       bool
-	UpdateChangesetCalled       bool
-	ListReposCalled             bool
-	ExternalServicesCalled      bool
-	LoadChangesetCalled         bool
-	CloseChangesetCalled        bool
-	ReopenChangesetCalled       bool
-	CreateCommentCalled         bool
-	AuthenticatedUsernameCalled bool
-	ValidateAuthenticatorCalled bool
-	MergeChangesetCalled        bool
-	IsArchivedPushErrorCalled   bool
-	BuildCommitOptsCalled       bool
+	CreateDraftChangesetCalled   bool
+	UndraftedChangesetsCalled    bool
+	CreateChangesetCalled        bool
+	UpdateChangesetCalled        bool
+	ListReposCalled              bool
+	ExternalServicesCalled       bool
+	LoadChangesetCalled          bool
+	CloseChangesetCalled         bool
+	ReopenChangesetCalled        bool
+	CreateCommentCalled          bool
+	AuthenticatedUsernameCalled  bool
+	ValidateAuthenticatorCalled  bool
+	MergeChangesetCalled         bool
+	IsArchivedPushErrorCalled    bool
+	BuildCommitOptsCalled        bool
+	ApplyChangesetMetadataCalled bool
 
 	// The Changeset.HeadRef to be expected in CreateChangeset/UpdateChangeset calls.
 	WantHeadRef string
@@ -99,6 +100,10 @@ type FakeChangesetSource struct {
 	// UndraftedChangesets contains the changesets that were passed to UndraftChangeset
 	UndraftedChangesets []*sources.Changeset
 
+	// AppliedMetadata contains the metadata of the changesets that were passed
+	// to ApplyChangesetMetadata
+	AppliedMetadata []sources.ChangesetMetadata
+
 	// Username is the username returned by AuthenticatedUsername
 	Username string
 
@@ -110,6 +115,7 @@ var (
 	_ sources.ChangesetSource           = &FakeChangesetSource{}
 	_ sources.ArchivableChangesetSource = &FakeChangesetSource{}
 	_ sources.DraftChangesetSource      = &FakeChangesetSource{}
+	_ sources.MetadataChangesetSource   = &FakeChangesetSource{}
 )
 
 func (s *FakeChangesetSource) CreateDraftChangeset(ctx context.Context, c *sources.Changeset) (bool, error) {
@@ -310,6 +316,17 @@ func (s *FakeChangesetSource) MergeChangeset(ctx context.Context, c *sources.Cha
 	return s.Err
 }
 
+func (s *FakeChangesetSource) ApplyChangesetMetadata(ctx context.Context, c *sources.Changeset) error {
+	s.ApplyChangesetMetadataCalled = true
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	s.AppliedMetadata = append(s.AppliedMetadata, c.RequestedMetadata)
+	return nil
+}
+
 func (s *FakeChangesetSource) IsArchivedPushError(output string) bool {
 	s.IsArchivedPushErrorCalled = true
 	return s.IsArchivedPushErrorTrue
@@ -0,0 +1,231 @@
+package state
+
+import (
+	"sort"
+	"time"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+)
+
+// RequiredEventTypesForImpact keeps track of all event kinds required for
+// calculating the impact metrics of a changeset, on top of
+// RequiredEventTypesForHistory.
+var RequiredEventTypesForImpact = []btypes.ChangesetEventKind{
+	// Reviews that don't change the review state.
+	btypes.ChangesetEventKindGitHubReviewCommented,
+	btypes.ChangesetEventKindBitbucketCloudReviewed,
+	btypes.ChangesetEventKindAzureDevOpsPullRequestReviewed,
+	btypes.ChangesetEventKindGerritChangeApproved,
+	btypes.ChangesetEventKindGerritChangeApprovedWithSuggestions,
+	btypes.ChangesetEventKindGerritChangeReviewed,
+	btypes.ChangesetEventKindGerritChangeRejected,
+
+	// Checks.
+	btypes.ChangesetEventKindCheckRun,
+	btypes.ChangesetEventKindCommitStatus,
+	btypes.ChangesetEventKindBitbucketServerCommitStatus,
+	btypes.ChangesetEventKindGitLabPipeline,
+	btypes.ChangesetEventKindBitbucketCloudCommitStatus,
+	btypes.ChangesetEventKindBitbucketCloudRepoCommitStatusCreated,
+	btypes.ChangesetEventKindBitbucketCloudRepoCommitStatusUpdated,
+	btypes.ChangesetEventKindAzureDevOpsPullRequestBuildSucceeded,
+	btypes.ChangesetEventKindAzureDevOpsPullRequestBuildFailed,
+	btypes.ChangesetEventKindAzureDevOpsPullRequestBuildError,
+	btypes.ChangesetEventKindGerritChangeBuildSucceeded,
+	btypes.ChangesetEventKindGerritChangeBuildFailed,
+}
+
+// reviewEventKinds are the event kinds that mean that a changeset was
+// reviewed, no matter the outcome of the review.
+var reviewEventKinds = map[btypes.ChangesetEventKind]struct{}{
+	btypes.ChangesetEventKindGitHubReviewed:                                {},
+	btypes.ChangesetEventKindGitHubReviewCommented:                         {},
+	btypes.ChangesetEventKindBitbucketServerApproved:                       {},
+	btypes.ChangesetEventKindBitbucketServerReviewed:                       {},
+	btypes.ChangesetEventKindGitLabApproved:                                {},
+	btypes.ChangesetEventKindBitbucketCloudApproved:                        {},
+	btypes.ChangesetEventKindBitbucketCloudReviewed:                        {},
+	btypes.ChangesetEventKindBitbucketCloudPullRequestApproved:             {},
+	btypes.ChangesetEventKindAzureDevOpsPullRequestApproved:                {},
+	btypes.ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions: {},
+	btypes.ChangesetEventKindAzureDevOpsPullRequestReviewed:                {},
+	btypes.ChangesetEventKindAzureDevOpsPullRequestRejected:                {},
+	btypes.ChangesetEventKindAzureDevOpsPullRequestWaitingForAuthor:        {},
+	btypes.ChangesetEventKindGerritChangeApproved:                          {},
+	btypes.ChangesetEventKindGerritChangeApprovedWithSuggestions:           {},
+	btypes.ChangesetEventKindGerritChangeReviewed:                          {},
+	btypes.ChangesetEventKindGerritChangeRejected:                          {},
+}
+
+// CalcChangesetImpact calculates the impact metrics of the given published
+// changeset from its events. The team of the metrics is left empty.
+func CalcChangesetImpact(ch *btypes.Changeset, es []*btypes.ChangesetEvent) (*btypes.ChangesetImpactMetrics, error) {
+	events := make(ChangesetEvents, len(es))
+	copy(events, es)
+	sort.Sort(events)
+
+	history, err := computeHistory(ch, events)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &btypes.ChangesetImpactMetrics{
+		ChangesetID: ch.ID,
+		CodeHost:    ch.ExternalServiceType,
+		OpenedAt:    ch.ExternalCreatedAt(),
+	}
+
+	for _, s := range history {
+		if s.externalState == btypes.ChangesetExternalStateMerged {
+			m.MergedAt = s.t
+			break
+		}
+	}
+
+	for _, e := range events {
+		if _, ok := reviewEventKinds[e.Kind]; ok && m.FirstReviewAt.IsZero() {
+			// Reviews from before the changeset was opened, for example of a
+			// draft that was reopened, don't count.
+			if t := e.Timestamp(); !t.IsZero() && !t.Before(m.OpenedAt) {
+				m.FirstReviewAt = t
+			}
+		}
+
+		switch checkEventState(e) {
+		case btypes.ChangesetCheckStatePassed:
+			m.CheckRuns++
+		case btypes.ChangesetCheckStateFailed:
+			m.CheckRuns++
+			m.FailedCheckRuns++
+		}
+	}
+
+	return m, nil
+}
+
+// checkEventState returns the state of the check a changeset event reports
+// on. Check suites are skipped, since their check runs are counted already.
+func checkEventState(e *btypes.ChangesetEvent) btypes.ChangesetCheckState {
+	switch e.Kind {
+	case btypes.ChangesetEventKindAzureDevOpsPullRequestBuildSucceeded,
+		btypes.ChangesetEventKindGerritChangeBuildSucceeded:
+		return btypes.ChangesetCheckStatePassed
+	case btypes.ChangesetEventKindAzureDevOpsPullRequestBuildFailed,
+		btypes.ChangesetEventKindAzureDevOpsPullRequestBuildError,
+		btypes.ChangesetEventKindGerritChangeBuildFailed:
+		return btypes.ChangesetCheckStateFailed
+	}
+
+	switch m := e.Metadata.(type) {
+	case *github.CheckRun:
+		return parseGithubCheckSuiteState(m.Status, m.Conclusion)
+	case *github.CommitStatus:
+		return parseGithubCheckState(m.State)
+	case *bitbucketserver.CommitStatus:
+		return parseBitbucketServerBuildState(m.Status.State)
+	case *gitlab.Pipeline:
+		return parseGitLabPipelineStatus(m.Status)
+	case *bitbucketcloud.PullRequestStatus:
+		return parseBitbucketCloudBuildState(m.State)
+	case *bitbucketcloud.RepoCommitStatusCreatedEvent:
+		return parseBitbucketCloudBuildState(m.CommitStatus.State)
+	case *bitbucketcloud.RepoCommitStatusUpdatedEvent:
+		return parseBitbucketCloudBuildState(m.CommitStatus.State)
+	}
+	return btypes.ChangesetCheckStateUnknown
+}
+
+// CalcImpactStats aggregates the impact metrics of the changesets of a batch
+// change into BatchChangeImpactStats for all changesets, per code host and per
+// team. The stats are ordered by dimension and value.
+func CalcImpactStats(batchChangeID int64, metrics []*btypes.ChangesetImpactMetrics) []*btypes.BatchChangeImpactStats {
+	type key struct {
+		dimension btypes.ImpactDimension
+		value     string
+	}
+	groups := map[key][]*btypes.ChangesetImpactMetrics{
+		// The stats of all changesets always exist, even without changesets.
+		{dimension: btypes.ImpactDimensionAll}: nil,
+	}
+	for _, m := range metrics {
+		for _, k := range []key{
+			{dimension: btypes.ImpactDimensionAll},
+			{dimension: btypes.ImpactDimensionCodeHost, value: m.CodeHost},
+			{dimension: btypes.ImpactDimensionTeam, value: m.Team},
+		} {
+			groups[k] = append(groups[k], m)
+		}
+	}
+
+	stats := make([]*btypes.BatchChangeImpactStats, 0, len(groups))
+	for k, ms := range groups {
+		s := calcImpactStats(ms)
+		s.BatchChangeID = batchChangeID
+		s.Dimension = k.dimension
+		s.Value = k.value
+		stats = append(stats, s)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Dimension != stats[j].Dimension {
+			return stats[i].Dimension < stats[j].Dimension
+		}
+		return stats[i].Value < stats[j].Value
+	})
+
+	return stats
+}
+
+func calcImpactStats(metrics []*btypes.ChangesetImpactMetrics) *btypes.BatchChangeImpactStats {
+	s := &btypes.BatchChangeImpactStats{
+		Changesets:         int32(len(metrics)),
+		TimeToMergeBuckets: make([]int32, len(btypes.TimeToMergeBucketBounds)+1),
+	}
+
+	var timesToMerge, reviewLatencies []time.Duration
+	for _, m := range metrics {
+		if d, ok := m.TimeToMerge(); ok {
+			s.Merged++
+			timesToMerge = append(timesToMerge, d)
+			s.TimeToMergeBuckets[timeToMergeBucket(d)]++
+		}
+		if d, ok := m.ReviewLatency(); ok {
+			s.Reviewed++
+			reviewLatencies = append(reviewLatencies, d)
+		}
+		s.CheckRuns += m.CheckRuns
+		s.FailedCheckRuns += m.FailedCheckRuns
+	}
+
+	s.TimeToMergeP50, s.TimeToMergeP90 = percentile(timesToMerge, 50), percentile(timesToMerge, 90)
+	s.ReviewLatencyP50, s.ReviewLatencyP90 = percentile(reviewLatencies, 50), percentile(reviewLatencies, 90)
+
+	return s
+}
+
+func timeToMergeBucket(d time.Duration) int {
+	for i, bound := range btypes.TimeToMergeBucketBounds {
+		if d < bound {
+			return i
+		}
+	}
+	return len(btypes.TimeToMergeBucketBounds)
+}
+
+// percentile returns the p-th percentile of ds with the nearest-rank method,
+// or 0 if ds is empty. ds is sorted in place.
+func percentile(ds []time.Duration, p int) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	sort.Slice(ds, func(i, j int) bool { return ds[i] < ds[j] })
+	// The nearest rank is ceil(p / 100 * n), and 1-based.
+	rank := (p*len(ds) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return ds[rank-1]
+}
@@ -0,0 +1,203 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
+)
+
+func TestCalcChangesetImpact(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 12, 1, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	t.Run("github", func(t *testing.T) {
+		ch := ghChangeset(1, daysAgo(10))
+		ch.ExternalServiceType = extsvc.TypeGitHub
+
+		checkRun := func(conclusion string) *btypes.ChangesetEvent {
+			return &btypes.ChangesetEvent{ChangesetID: 1, Kind: btypes.ChangesetEventKindCheckRun, Metadata: &github.CheckRun{
+				Status: "COMPLETED", Conclusion: conclusion, ReceivedAt: daysAgo(9),
+			}}
+		}
+		es := []*btypes.ChangesetEvent{
+			// Events are sorted first.
+			event(t, daysAgo(2), btypes.ChangesetEventKindGitHubMerged, 1),
+			ghReview(1, daysAgo(7), "reviewer", "COMMENTED"),
+			ghReview(1, daysAgo(4), "reviewer", "APPROVED"),
+			checkRun("SUCCESS"),
+			checkRun("FAILURE"),
+			checkRun("TIMED_OUT"),
+			{ChangesetID: 1, Kind: btypes.ChangesetEventKindCheckRun, Metadata: &github.CheckRun{Status: "IN_PROGRESS", ReceivedAt: daysAgo(9)}},
+			{ChangesetID: 1, Kind: btypes.ChangesetEventKindCommitStatus, Metadata: &github.CommitStatus{State: "SUCCESS", ReceivedAt: daysAgo(9)}},
+			{ChangesetID: 1, Kind: btypes.ChangesetEventKindCheckSuite, Metadata: &github.CheckSuite{Status: "COMPLETED", Conclusion: "FAILURE", ReceivedAt: daysAgo(9)}},
+		}
+
+		have, err := CalcChangesetImpact(ch, es)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &btypes.ChangesetImpactMetrics{
+			ChangesetID:     1,
+			CodeHost:        extsvc.TypeGitHub,
+			OpenedAt:        daysAgo(10),
+			MergedAt:        daysAgo(2),
+			FirstReviewAt:   daysAgo(7),
+			CheckRuns:       4,
+			FailedCheckRuns: 2,
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("wrong metrics (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("bitbucket server open", func(t *testing.T) {
+		ch := bbsChangeset(2, daysAgo(10))
+		ch.ExternalServiceType = extsvc.TypeBitbucketServer
+
+		es := []*btypes.ChangesetEvent{
+			bbsActivity(2, daysAgo(3), "reviewer", btypes.ChangesetEventKindBitbucketServerApproved),
+			{ChangesetID: 2, Kind: btypes.ChangesetEventKindBitbucketServerCommitStatus, Metadata: &bitbucketserver.CommitStatus{
+				Status: bitbucketserver.BuildStatus{State: "FAILED", DateAdded: int64(timeToUnixMilli(daysAgo(9)))},
+			}},
+		}
+
+		have, err := CalcChangesetImpact(ch, es)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := &btypes.ChangesetImpactMetrics{
+			ChangesetID:     2,
+			CodeHost:        extsvc.TypeBitbucketServer,
+			OpenedAt:        daysAgo(10),
+			FirstReviewAt:   daysAgo(3),
+			CheckRuns:       1,
+			FailedCheckRuns: 1,
+		}
+		if diff := cmp.Diff(want, have, cmpTimeUTC); diff != "" {
+			t.Fatalf("wrong metrics (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("gitlab pipelines", func(t *testing.T) {
+		ch := glChangeset(3, daysAgo(10))
+		ch.ExternalServiceType = extsvc.TypeGitLab
+
+		pipeline := func(status gitlab.PipelineStatus) *btypes.ChangesetEvent {
+			return &btypes.ChangesetEvent{ChangesetID: 3, Kind: btypes.ChangesetEventKindGitLabPipeline, Metadata: &gitlab.Pipeline{Status: status}}
+		}
+		es := []*btypes.ChangesetEvent{
+			pipeline(gitlab.PipelineStatusFailed),
+			pipeline(gitlab.PipelineStatusRunning),
+			pipeline(gitlab.PipelineStatusSuccess),
+		}
+
+		have, err := CalcChangesetImpact(ch, es)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.CheckRuns != 2 || have.FailedCheckRuns != 1 {
+			t.Fatalf("wrong check runs. want=2/1, have=%d/%d", have.CheckRuns, have.FailedCheckRuns)
+		}
+	})
+
+	t.Run("no opened time", func(t *testing.T) {
+		if _, err := CalcChangesetImpact(ghChangeset(4, time.Time{}), nil); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+}
+
+var cmpTimeUTC = cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) })
+
+func TestCalcImpactStats(t *testing.T) {
+	t.Parallel()
+
+	opened := time.Date(2023, 12, 1, 12, 0, 0, 0, time.UTC)
+	metrics := func(id int64, codeHost, team string, timeToMerge, reviewLatency time.Duration, checkRuns, failed int32) *btypes.ChangesetImpactMetrics {
+		m := &btypes.ChangesetImpactMetrics{
+			ChangesetID:     id,
+			CodeHost:        codeHost,
+			Team:            team,
+			OpenedAt:        opened,
+			CheckRuns:       checkRuns,
+			FailedCheckRuns: failed,
+		}
+		if timeToMerge != 0 {
+			m.MergedAt = opened.Add(timeToMerge)
+		}
+		if reviewLatency != 0 {
+			m.FirstReviewAt = opened.Add(reviewLatency)
+		}
+		return m
+	}
+	const day = 24 * time.Hour
+
+	have := CalcImpactStats(42, []*btypes.ChangesetImpactMetrics{
+		metrics(1, extsvc.TypeGitHub, "frontend", 30*time.Minute, 10*time.Minute, 2, 0),
+		metrics(2, extsvc.TypeGitHub, "frontend", 2*day, time.Hour, 4, 1),
+		metrics(3, extsvc.TypeGitHub, "", 40*day, 2*time.Hour, 0, 0),
+		metrics(4, extsvc.TypeGitLab, "backend", 0, 0, 4, 3),
+	})
+
+	want := []*btypes.BatchChangeImpactStats{
+		{
+			BatchChangeID: 42, Dimension: btypes.ImpactDimensionAll,
+			Changesets: 4, Merged: 3, Reviewed: 3,
+			TimeToMergeP50: 2 * day, TimeToMergeP90: 40 * day,
+			TimeToMergeBuckets: []int32{1, 0, 1, 0, 1},
+			ReviewLatencyP50:   time.Hour, ReviewLatencyP90: 2 * time.Hour,
+			CheckRuns: 10, FailedCheckRuns: 4,
+		},
+		{
+			BatchChangeID: 42, Dimension: btypes.ImpactDimensionCodeHost, Value: extsvc.TypeGitHub,
+			Changesets: 3, Merged: 3, Reviewed: 3,
+			TimeToMergeP50: 2 * day, TimeToMergeP90: 40 * day,
+			TimeToMergeBuckets: []int32{1, 0, 1, 0, 1},
+			ReviewLatencyP50:   time.Hour, ReviewLatencyP90: 2 * time.Hour,
+			CheckRuns: 6, FailedCheckRuns: 1,
+		},
+		{
+			BatchChangeID: 42, Dimension: btypes.ImpactDimensionCodeHost, Value: extsvc.TypeGitLab,
+			Changesets:         1,
+			TimeToMergeBuckets: []int32{0, 0, 0, 0, 0},
+			CheckRuns:          4, FailedCheckRuns: 3,
+		},
+		{
+			BatchChangeID: 42, Dimension: btypes.ImpactDimensionTeam, Value: "",
+			Changesets: 1, Merged: 1, Reviewed: 1,
+			TimeToMergeP50: 40 * day, TimeToMergeP90: 40 * day,
+			TimeToMergeBuckets: []int32{0, 0, 0, 0, 1},
+			ReviewLatencyP50:   2 * time.Hour, ReviewLatencyP90: 2 * time.Hour,
+		},
+		{
+			BatchChangeID: 42, Dimension: btypes.ImpactDimensionTeam, Value: "backend",
+			Changesets:         1,
+			TimeToMergeBuckets: []int32{0, 0, 0, 0, 0},
+			CheckRuns:          4, FailedCheckRuns: 3,
+		},
+		{
+			BatchChangeID: 42, Dimension: btypes.ImpactDimensionTeam, Value: "frontend",
+			Changesets: 2, Merged: 2, Reviewed: 2,
+			TimeToMergeP50: 30 * time.Minute, TimeToMergeP90: 2 * day,
+			TimeToMergeBuckets: []int32{1, 0, 1, 0, 0},
+			ReviewLatencyP50:   10 * time.Minute, ReviewLatencyP90: time.Hour,
+			CheckRuns: 6, FailedCheckRuns: 1,
+		},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("wrong stats (-want +have):\n%s", diff)
+	}
+
+	if have := CalcImpactStats(42, nil); len(have) != 1 || have[0].Dimension != btypes.ImpactDimensionAll || have[0].Changesets != 0 {
+		t.Fatalf("wrong stats without changesets: %+v", have)
+	}
+}
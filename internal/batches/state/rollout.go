@@ -0,0 +1,90 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+// RolloutTierCounts represents the states of the changesets in one rollout
+// tier of a batch change.
+type RolloutTierCounts struct {
+	Tier        int32
+	Total       int32
+	Unpublished int32
+	Draft       int32
+	Open        int32
+	Merged      int32
+	Closed      int32
+	// Blocked counts the unpublished changesets of the tier that wait for
+	// changesets in lower tiers to be merged or closed.
+	Blocked int32
+}
+
+func (tc *RolloutTierCounts) String() string {
+	return fmt.Sprintf("Tier %d (Total: %d, Unpublished: %d, Draft: %d, Open: %d, Merged: %d, Closed: %d, Blocked: %d)",
+		tc.Tier,
+		tc.Total,
+		tc.Unpublished,
+		tc.Draft,
+		tc.Open,
+		tc.Merged,
+		tc.Closed,
+		tc.Blocked,
+	)
+}
+
+// CalcRolloutTierCounts calculates RolloutTierCounts for every rollout tier of
+// the given changesets, ordered by tier. tiers holds the rollout tier of every
+// changeset, keyed by changeset ID; changesets without a tier are skipped.
+func CalcRolloutTierCounts(cs []*btypes.Changeset, tiers map[int64]int) []*RolloutTierCounts {
+	byTier := make(map[int]*RolloutTierCounts)
+	// The lowest tier with changesets that block the rollout. The changesets
+	// in the tiers above it are blocked.
+	lowestBlocking := -1
+
+	for _, c := range cs {
+		tier, ok := tiers[c.ID]
+		if !ok {
+			continue
+		}
+
+		counts, ok := byTier[tier]
+		if !ok {
+			counts = &RolloutTierCounts{Tier: int32(tier)}
+			byTier[tier] = counts
+		}
+
+		counts.Total++
+		switch {
+		case c.Unpublished():
+			counts.Unpublished++
+		case c.ExternalState == btypes.ChangesetExternalStateDraft:
+			counts.Draft++
+		case c.ExternalState == btypes.ChangesetExternalStateOpen:
+			counts.Open++
+		case c.ExternalState == btypes.ChangesetExternalStateMerged:
+			counts.Merged++
+		case c.ExternalState == btypes.ChangesetExternalStateClosed,
+			c.ExternalState == btypes.ChangesetExternalStateReadOnly:
+			// Like CalcCounts, we lump read-only into closed.
+			counts.Closed++
+		}
+
+		if c.ExternalState.BlocksRollout() && (lowestBlocking == -1 || tier < lowestBlocking) {
+			lowestBlocking = tier
+		}
+	}
+
+	all := make([]*RolloutTierCounts, 0, len(byTier))
+	for tier, counts := range byTier {
+		if lowestBlocking != -1 && tier > lowestBlocking {
+			counts.Blocked = counts.Unpublished
+		}
+		all = append(all, counts)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Tier < all[j].Tier })
+
+	return all
+}
@@ -0,0 +1,100 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+func TestCalcRolloutTierCounts(t *testing.T) {
+	t.Parallel()
+
+	changeset := func(id int64, publication btypes.ChangesetPublicationState, external btypes.ChangesetExternalState) *btypes.Changeset {
+		return &btypes.Changeset{ID: id, PublicationState: publication, ExternalState: external}
+	}
+	const (
+		published   = btypes.ChangesetPublicationStatePublished
+		unpublished = btypes.ChangesetPublicationStateUnpublished
+	)
+
+	tests := []struct {
+		name       string
+		changesets []*btypes.Changeset
+		tiers      map[int64]int
+		want       []*RolloutTierCounts
+	}{
+		{
+			name: "lowest tier open",
+			changesets: []*btypes.Changeset{
+				changeset(1, published, btypes.ChangesetExternalStateOpen),
+				changeset(2, published, btypes.ChangesetExternalStateMerged),
+				changeset(3, unpublished, ""),
+				changeset(4, unpublished, ""),
+				changeset(5, unpublished, ""),
+			},
+			tiers: map[int64]int{1: 0, 2: 0, 3: 1, 4: 1, 5: 2},
+			want: []*RolloutTierCounts{
+				{Tier: 0, Total: 2, Open: 1, Merged: 1},
+				{Tier: 1, Total: 2, Unpublished: 2, Blocked: 2},
+				{Tier: 2, Total: 1, Unpublished: 1, Blocked: 1},
+			},
+		},
+		{
+			name: "lowest tier merged",
+			changesets: []*btypes.Changeset{
+				changeset(1, published, btypes.ChangesetExternalStateMerged),
+				changeset(2, published, btypes.ChangesetExternalStateDraft),
+				changeset(3, unpublished, ""),
+				changeset(4, unpublished, ""),
+			},
+			tiers: map[int64]int{1: 0, 2: 1, 3: 1, 4: 2},
+			want: []*RolloutTierCounts{
+				{Tier: 0, Total: 1, Merged: 1},
+				// Unpublished changesets in the lowest tier that isn't merged
+				// yet aren't blocked.
+				{Tier: 1, Total: 2, Unpublished: 1, Draft: 1},
+				{Tier: 2, Total: 1, Unpublished: 1, Blocked: 1},
+			},
+		},
+		{
+			name: "lowest tier closed and read-only",
+			changesets: []*btypes.Changeset{
+				changeset(1, published, btypes.ChangesetExternalStateMerged),
+				changeset(2, published, btypes.ChangesetExternalStateClosed),
+				changeset(3, published, btypes.ChangesetExternalStateReadOnly),
+				changeset(4, unpublished, ""),
+				changeset(5, unpublished, ""),
+			},
+			tiers: map[int64]int{1: 0, 2: 0, 3: 0, 4: 1, 5: 2},
+			want: []*RolloutTierCounts{
+				// Closed and read-only changesets can't be merged anymore, so
+				// they don't block the rollout.
+				{Tier: 0, Total: 3, Merged: 1, Closed: 2},
+				{Tier: 1, Total: 1, Unpublished: 1},
+				{Tier: 2, Total: 1, Unpublished: 1, Blocked: 1},
+			},
+		},
+		{
+			name: "changesets without tier",
+			changesets: []*btypes.Changeset{
+				changeset(1, published, btypes.ChangesetExternalStateClosed),
+				changeset(2, published, btypes.ChangesetExternalStateOpen),
+			},
+			tiers: map[int64]int{1: 0},
+			want: []*RolloutTierCounts{
+				{Tier: 0, Total: 1, Closed: 1},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			have := CalcRolloutTierCounts(tc.changesets, tc.tiers)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf("wrong counts (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
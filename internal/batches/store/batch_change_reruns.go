@@ -0,0 +1,251 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchChangeRerunColumns are used by the batch change rerun related Store
+// methods to insert, update and query reruns.
+var batchChangeRerunColumns = SQLColumns{
+	"batch_change_reruns.id",
+	"batch_change_reruns.batch_change_id",
+	"batch_change_reruns.batch_spec_id",
+	"batch_change_reruns.scheduled_at",
+	"batch_change_reruns.state",
+	"batch_change_reruns.failure_message",
+	"batch_change_reruns.created_at",
+	"batch_change_reruns.updated_at",
+}
+
+// CreateBatchChangeRerun creates the given batch change rerun.
+func (s *Store) CreateBatchChangeRerun(ctx context.Context, r *btypes.BatchChangeRerun) (err error) {
+	ctx, _, endObservation := s.operations.createBatchChangeRerun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(r.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = s.now()
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = r.CreatedAt
+	}
+	if r.State == "" {
+		r.State = btypes.BatchChangeRerunStateProcessing
+	}
+
+	q := sqlf.Sprintf(
+		createBatchChangeRerunQueryFmtstr,
+		r.BatchChangeID,
+		dbutil.NullInt64Column(r.BatchSpecID),
+		r.ScheduledAt,
+		r.State,
+		dbutil.NullStringColumn(r.FailureMessage),
+		r.CreatedAt,
+		r.UpdatedAt,
+		sqlf.Join(batchChangeRerunColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeRerun(r, sc)
+	})
+}
+
+const createBatchChangeRerunQueryFmtstr = `
+INSERT INTO batch_change_reruns (
+	batch_change_id,
+	batch_spec_id,
+	scheduled_at,
+	state,
+	failure_message,
+	created_at,
+	updated_at
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+RETURNING %s
+`
+
+// UpdateBatchChangeRerun updates the state and failure message of the given
+// batch change rerun.
+func (s *Store) UpdateBatchChangeRerun(ctx context.Context, r *btypes.BatchChangeRerun) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeRerun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(r.ID)),
+		attribute.String("state", string(r.State)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	r.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateBatchChangeRerunQueryFmtstr,
+		r.State,
+		dbutil.NullStringColumn(r.FailureMessage),
+		r.UpdatedAt,
+		r.ID,
+		sqlf.Join(batchChangeRerunColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeRerun(r, sc)
+	})
+}
+
+const updateBatchChangeRerunQueryFmtstr = `
+UPDATE batch_change_reruns
+SET
+	state = %s,
+	failure_message = %s,
+	updated_at = %s
+WHERE id = %s
+RETURNING %s
+`
+
+// GetLastBatchChangeRerun gets the most recently scheduled rerun of the batch
+// change with the given ID. ErrNoResults is returned if the batch change was
+// never rerun.
+func (s *Store) GetLastBatchChangeRerun(ctx context.Context, batchChangeID int64) (r *btypes.BatchChangeRerun, err error) {
+	ctx, _, endObservation := s.operations.getLastBatchChangeRerun.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getLastBatchChangeRerunQueryFmtstr,
+		sqlf.Join(batchChangeRerunColumns.ToSqlf(), ", "),
+		batchChangeID,
+	)
+
+	var rerun btypes.BatchChangeRerun
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeRerun(&rerun, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rerun.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &rerun, nil
+}
+
+const getLastBatchChangeRerunQueryFmtstr = `
+SELECT %s FROM batch_change_reruns
+WHERE batch_change_id = %s
+ORDER BY scheduled_at DESC
+LIMIT 1
+`
+
+// ListBatchChangeRerunsOpts captures the query options needed for listing
+// batch change reruns.
+type ListBatchChangeRerunsOpts struct {
+	LimitOpts
+	Cursor int64
+
+	BatchChangeID int64
+	State         btypes.BatchChangeRerunState
+}
+
+// ListBatchChangeReruns lists batch change reruns with the given filters,
+// most recent first.
+func (s *Store) ListBatchChangeReruns(ctx context.Context, opts ListBatchChangeRerunsOpts) (rs []*btypes.BatchChangeRerun, next int64, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeReruns.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	preds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.Cursor > 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_reruns.id <= %s", opts.Cursor))
+	}
+	if opts.BatchChangeID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_reruns.batch_change_id = %s", opts.BatchChangeID))
+	}
+	if opts.State != "" {
+		preds = append(preds, sqlf.Sprintf("batch_change_reruns.state = %s", opts.State))
+	}
+
+	q := sqlf.Sprintf(
+		listBatchChangeRerunsQueryFmtstr+opts.LimitOpts.ToDB(),
+		sqlf.Join(batchChangeRerunColumns.ToSqlf(), ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+
+	rs = make([]*btypes.BatchChangeRerun, 0, opts.DBLimit())
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var r btypes.BatchChangeRerun
+		if err := scanBatchChangeRerun(&r, sc); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+
+	if opts.Limit != 0 && len(rs) == opts.DBLimit() {
+		next = rs[len(rs)-1].ID
+		rs = rs[:len(rs)-1]
+	}
+
+	return rs, next, err
+}
+
+const listBatchChangeRerunsQueryFmtstr = `
+SELECT %s FROM batch_change_reruns
+WHERE %s
+ORDER BY batch_change_reruns.id DESC
+`
+
+// ListRecurringBatchChanges lists the open batch changes whose current batch
+// spec declares a rerun policy.
+func (s *Store) ListRecurringBatchChanges(ctx context.Context) (cs []*btypes.BatchChange, err error) {
+	ctx, _, endObservation := s.operations.listRecurringBatchChanges.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listRecurringBatchChangesQueryFmtstr,
+		sqlf.Join(batchChangeColumns, ", "),
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var c btypes.BatchChange
+		if err := scanBatchChange(&c, sc); err != nil {
+			return err
+		}
+		cs = append(cs, &c)
+		return nil
+	})
+	return cs, err
+}
+
+const listRecurringBatchChangesQueryFmtstr = `
+SELECT %s FROM batch_changes
+JOIN batch_specs ON batch_specs.id = batch_changes.batch_spec_id
+LEFT JOIN users namespace_user ON batch_changes.namespace_user_id = namespace_user.id
+LEFT JOIN orgs namespace_org ON batch_changes.namespace_org_id = namespace_org.id
+WHERE
+	batch_changes.closed_at IS NULL AND
+	batch_changes.last_applied_at IS NOT NULL AND
+	batch_specs.spec->'rerun'->>'schedule' IS NOT NULL AND
+	namespace_user.deleted_at IS NULL AND
+	namespace_org.deleted_at IS NULL
+ORDER BY batch_changes.id
+`
+
+func scanBatchChangeRerun(r *btypes.BatchChangeRerun, s dbutil.Scanner) error {
+	return s.Scan(
+		&r.ID,
+		&r.BatchChangeID,
+		&dbutil.NullInt64{N: &r.BatchSpecID},
+		&r.ScheduledAt,
+		&r.State,
+		&dbutil.NullString{S: &r.FailureMessage},
+		&r.CreatedAt,
+		&r.UpdatedAt,
+	)
+}
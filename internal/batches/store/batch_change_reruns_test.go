@@ -0,0 +1,168 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func testStoreBatchChangeReruns(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+
+	createBatchChange := func(t *testing.T, name string, rerun *batcheslib.RerunPolicy, closed bool) *btypes.BatchChange {
+		t.Helper()
+
+		spec := &btypes.BatchSpec{
+			Spec:            &batcheslib.BatchSpec{Name: name, Rerun: rerun},
+			UserID:          user.ID,
+			NamespaceUserID: user.ID,
+		}
+		if err := s.CreateBatchSpec(ctx, spec); err != nil {
+			t.Fatal(err)
+		}
+
+		batchChange := &btypes.BatchChange{
+			Name:            name,
+			BatchSpecID:     spec.ID,
+			CreatorID:       user.ID,
+			LastApplierID:   user.ID,
+			LastAppliedAt:   clock.Now(),
+			NamespaceUserID: user.ID,
+		}
+		if closed {
+			batchChange.ClosedAt = clock.Now()
+		}
+		if err := s.CreateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+		return batchChange
+	}
+
+	recurring := createBatchChange(t, "recurring", &batcheslib.RerunPolicy{Schedule: "@daily"}, false)
+	createBatchChange(t, "not-recurring", nil, false)
+	createBatchChange(t, "closed", &batcheslib.RerunPolicy{Schedule: "@daily"}, true)
+
+	t.Run("ListRecurringBatchChanges", func(t *testing.T) {
+		have, err := s.ListRecurringBatchChanges(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]*btypes.BatchChange{recurring}, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	reruns := []*btypes.BatchChangeRerun{
+		{
+			BatchChangeID: recurring.ID,
+			BatchSpecID:   recurring.BatchSpecID,
+			ScheduledAt:   clock.Now().Add(-2 * 24 * time.Hour),
+		},
+		{
+			BatchChangeID: recurring.ID,
+			BatchSpecID:   recurring.BatchSpecID,
+			ScheduledAt:   clock.Now().Add(-24 * time.Hour),
+		},
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		for _, r := range reruns {
+			if err := s.CreateBatchChangeRerun(ctx, r); err != nil {
+				t.Fatal(err)
+			}
+
+			if r.ID == 0 {
+				t.Fatal("ID should not be zero")
+			}
+			if have, want := r.State, btypes.BatchChangeRerunStateProcessing; have != want {
+				t.Fatalf("State is wrong. want=%s, have=%s", want, have)
+			}
+			if have, want := r.CreatedAt, clock.Now(); !have.Equal(want) {
+				t.Fatalf("CreatedAt is wrong. want=%s, have=%s", want, have)
+			}
+
+			// Only a single rerun of a batch change can be processed at a
+			// time.
+			if r == reruns[0] {
+				r.State = btypes.BatchChangeRerunStateFailed
+				r.FailureMessage = "the batch change was applied during the rerun"
+				if err := s.UpdateBatchChangeRerun(ctx, r); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		clock.Add(1 * time.Second)
+
+		r := reruns[1]
+		r.State = btypes.BatchChangeRerunStateApplied
+		if err := s.UpdateBatchChangeRerun(ctx, r); err != nil {
+			t.Fatal(err)
+		}
+
+		if have, want := r.UpdatedAt, clock.Now(); !have.Equal(want) {
+			t.Fatalf("UpdatedAt is wrong. want=%s, have=%s", want, have)
+		}
+	})
+
+	t.Run("GetLast", func(t *testing.T) {
+		have, err := s.GetLastBatchChangeRerun(ctx, recurring.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(reruns[1], have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		t.Run("NoResults", func(t *testing.T) {
+			_, have := s.GetLastBatchChangeRerun(ctx, 0xdeadbeef)
+			if want := ErrNoResults; have != want {
+				t.Fatalf("have err %v, want %v", have, want)
+			}
+		})
+	})
+
+	t.Run("List", func(t *testing.T) {
+		have, next, err := s.ListBatchChangeReruns(ctx, ListBatchChangeRerunsOpts{BatchChangeID: recurring.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next != 0 {
+			t.Fatalf("have next %d, want 0", next)
+		}
+		if diff := cmp.Diff([]*btypes.BatchChangeRerun{reruns[1], reruns[0]}, have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		t.Run("By state", func(t *testing.T) {
+			have, _, err := s.ListBatchChangeReruns(ctx, ListBatchChangeRerunsOpts{State: btypes.BatchChangeRerunStateFailed})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff([]*btypes.BatchChangeRerun{reruns[0]}, have); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+
+		t.Run("With limit", func(t *testing.T) {
+			have, next, err := s.ListBatchChangeReruns(ctx, ListBatchChangeRerunsOpts{LimitOpts: LimitOpts{Limit: 1}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if next != reruns[0].ID {
+				t.Fatalf("have next %d, want %d", next, reruns[0].ID)
+			}
+			if diff := cmp.Diff([]*btypes.BatchChangeRerun{reruns[1]}, have); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	})
+}
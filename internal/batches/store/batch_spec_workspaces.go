@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"sort"
+	"strconv"
 
 	"github.com/keegancsmith/sqlf"
 	"github.com/lib/pq"
@@ -146,6 +147,9 @@ func (s *Store) CreateBatchSpecWorkspace(ctx context.Context, ws ...*btypes.Batc
 // GetBatchSpecWorkspaceOpts captures the query options needed for getting a BatchSpecWorkspace
 type GetBatchSpecWorkspaceOpts struct {
 	ID int64
+	// ChangesetSpecID selects the workspace whose execution produced the
+	// given changeset spec.
+	ChangesetSpecID int64
 }
 
 // GetBatchSpecWorkspace gets a BatchSpecWorkspace matching the given options.
@@ -181,7 +185,14 @@ LIMIT 1
 func getBatchSpecWorkspaceQuery(opts *GetBatchSpecWorkspaceOpts) *sqlf.Query {
 	preds := []*sqlf.Query{
 		sqlf.Sprintf("repo.deleted_at IS NULL"),
-		sqlf.Sprintf("batch_spec_workspaces.id = %s", opts.ID),
+	}
+
+	if opts.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.id = %s", opts.ID))
+	}
+
+	if opts.ChangesetSpecID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.changeset_spec_ids ? %s", strconv.FormatInt(opts.ChangesetSpecID, 10)))
 	}
 
 	return sqlf.Sprintf(
@@ -503,6 +514,50 @@ func (s *Store) DisableBatchSpecWorkspaceExecutionCache(ctx context.Context, bat
 	return s.Exec(ctx, q)
 }
 
+const reexecuteBatchSpecWorkspaceQueryFmtstr = `
+WITH deleted_jobs AS (
+	DELETE FROM batch_spec_workspace_execution_jobs
+	WHERE batch_spec_workspace_id = %s
+),
+reexecuted_workspace AS (
+	UPDATE
+		batch_spec_workspaces
+	SET
+		commit = %s,
+		skipped = FALSE,
+		cached_result_found = FALSE,
+		step_cache_results = '{}',
+		updated_at = %s
+	WHERE
+		id = %s
+	RETURNING
+		id, batch_spec_id
+)
+INSERT INTO
+	batch_spec_workspace_execution_jobs (batch_spec_workspace_id, user_id, version)
+SELECT
+	reexecuted_workspace.id,
+	batch_specs.user_id,
+	%s
+FROM
+	reexecuted_workspace
+JOIN batch_specs ON batch_specs.id = reexecuted_workspace.batch_spec_id
+`
+
+// ReexecuteBatchSpecWorkspace moves the given workspace onto the given base
+// commit and replaces its execution job with a new queued one, so that its
+// steps are executed again without using cached results. The changeset specs
+// of the workspace are kept until the new execution completes.
+func (s *Store) ReexecuteBatchSpecWorkspace(ctx context.Context, id int64, commit string) (err error) {
+	ctx, _, endObservation := s.operations.reexecuteBatchSpecWorkspace.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(id)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(reexecuteBatchSpecWorkspaceQueryFmtstr, id, commit, s.now(), id, versionForExecution(ctx, s))
+	return s.Exec(ctx, q)
+}
+
 func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) error {
 	var stepCacheResults json.RawMessage
 
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// changesetAutoMergeDecisionColumns are used by the changeset auto-merge
+// decision related Store methods to query and upsert decisions.
+var changesetAutoMergeDecisionColumns = SQLColumns{
+	"changeset_auto_merge_decisions.changeset_id",
+	"changeset_auto_merge_decisions.batch_change_id",
+	"changeset_auto_merge_decisions.batch_spec_id",
+	"changeset_auto_merge_decisions.outcome",
+	"changeset_auto_merge_decisions.reason",
+	"changeset_auto_merge_decisions.changeset_job_id",
+	"changeset_auto_merge_decisions.evaluated_at",
+}
+
+// UpsertChangesetAutoMergeDecision records the given decision, replacing the
+// previous decision on the same changeset.
+func (s *Store) UpsertChangesetAutoMergeDecision(ctx context.Context, d *btypes.ChangesetAutoMergeDecision) (err error) {
+	ctx, _, endObservation := s.operations.upsertChangesetAutoMergeDecision.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(d.ChangesetID)),
+		attribute.String("outcome", string(d.Outcome)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if d.EvaluatedAt.IsZero() {
+		d.EvaluatedAt = s.now()
+	}
+
+	q := sqlf.Sprintf(
+		upsertChangesetAutoMergeDecisionQueryFmtstr,
+		d.ChangesetID,
+		d.BatchChangeID,
+		d.BatchSpecID,
+		d.Outcome,
+		d.Reason,
+		dbutil.NullInt64Column(d.ChangesetJobID),
+		d.EvaluatedAt,
+		sqlf.Join(changesetAutoMergeDecisionColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetAutoMergeDecision(d, sc)
+	})
+}
+
+const upsertChangesetAutoMergeDecisionQueryFmtstr = `
+INSERT INTO changeset_auto_merge_decisions (
+	changeset_id,
+	batch_change_id,
+	batch_spec_id,
+	outcome,
+	reason,
+	changeset_job_id,
+	evaluated_at
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (changeset_id) DO UPDATE SET
+	batch_change_id = EXCLUDED.batch_change_id,
+	batch_spec_id = EXCLUDED.batch_spec_id,
+	outcome = EXCLUDED.outcome,
+	reason = EXCLUDED.reason,
+	changeset_job_id = EXCLUDED.changeset_job_id,
+	evaluated_at = EXCLUDED.evaluated_at
+RETURNING %s
+`
+
+// GetChangesetAutoMergeDecision gets the latest auto-merge decision on the
+// changeset with the given ID. ErrNoResults is returned if the auto-merge
+// policy was never evaluated for the changeset.
+func (s *Store) GetChangesetAutoMergeDecision(ctx context.Context, changesetID int64) (d *btypes.ChangesetAutoMergeDecision, err error) {
+	ctx, _, endObservation := s.operations.getChangesetAutoMergeDecision.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(changesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getChangesetAutoMergeDecisionQueryFmtstr,
+		sqlf.Join(changesetAutoMergeDecisionColumns.ToSqlf(), ", "),
+		changesetID,
+	)
+
+	var decision btypes.ChangesetAutoMergeDecision
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetAutoMergeDecision(&decision, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if decision.ChangesetID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &decision, nil
+}
+
+const getChangesetAutoMergeDecisionQueryFmtstr = `
+SELECT %s FROM changeset_auto_merge_decisions
+WHERE changeset_id = %s
+`
+
+// CountEnqueuedChangesetAutoMerges returns the number of changesets of the
+// given batch change for which the auto-merge policy enqueued a merge job at
+// or after the given time.
+func (s *Store) CountEnqueuedChangesetAutoMerges(ctx context.Context, batchChangeID int64, since time.Time) (count int, err error) {
+	ctx, _, endObservation := s.operations.countEnqueuedChangesetAutoMerges.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err = basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(
+		countEnqueuedChangesetAutoMergesQueryFmtstr,
+		batchChangeID,
+		btypes.ChangesetAutoMergeOutcomeEnqueued,
+		since,
+	)))
+	return count, err
+}
+
+const countEnqueuedChangesetAutoMergesQueryFmtstr = `
+SELECT COUNT(*) FROM changeset_auto_merge_decisions
+WHERE
+	batch_change_id = %s AND
+	outcome = %s AND
+	evaluated_at >= %s
+`
+
+func scanChangesetAutoMergeDecision(d *btypes.ChangesetAutoMergeDecision, s dbutil.Scanner) error {
+	return s.Scan(
+		&d.ChangesetID,
+		&d.BatchChangeID,
+		&d.BatchSpecID,
+		&d.Outcome,
+		&d.Reason,
+		&dbutil.NullInt64{N: &d.ChangesetJobID},
+		&d.EvaluatedAt,
+	)
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+func testStoreChangesetAutoMergeDecisions(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	decisions := []*btypes.ChangesetAutoMergeDecision{
+		{
+			ChangesetID:   1,
+			BatchChangeID: 10,
+			BatchSpecID:   100,
+			Outcome:       btypes.ChangesetAutoMergeOutcomeBlocked,
+			Reason:        "the changeset is not approved",
+		},
+		{
+			ChangesetID:    2,
+			BatchChangeID:  10,
+			BatchSpecID:    100,
+			Outcome:        btypes.ChangesetAutoMergeOutcomeEnqueued,
+			Reason:         "the changeset meets the requirements of the auto-merge policy",
+			ChangesetJobID: 1000,
+		},
+		{
+			ChangesetID:    3,
+			BatchChangeID:  20,
+			BatchSpecID:    200,
+			Outcome:        btypes.ChangesetAutoMergeOutcomeEnqueued,
+			Reason:         "the changeset meets the requirements of the auto-merge policy",
+			ChangesetJobID: 2000,
+		},
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		for _, d := range decisions {
+			if err := s.UpsertChangesetAutoMergeDecision(ctx, d); err != nil {
+				t.Fatal(err)
+			}
+
+			if have, want := d.EvaluatedAt, clock.Now(); !have.Equal(want) {
+				t.Fatalf("EvaluatedAt is wrong. want=%s, have=%s", want, have)
+			}
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		for _, want := range decisions {
+			have, err := s.GetChangesetAutoMergeDecision(ctx, want.ChangesetID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(have, want); diff != "" {
+				t.Fatal(diff)
+			}
+		}
+
+		t.Run("NoResults", func(t *testing.T) {
+			_, have := s.GetChangesetAutoMergeDecision(ctx, 0xdeadbeef)
+			if want := ErrNoResults; have != want {
+				t.Fatalf("have err %v, want %v", have, want)
+			}
+		})
+	})
+
+	t.Run("Count enqueued", func(t *testing.T) {
+		count, err := s.CountEnqueuedChangesetAutoMerges(ctx, 10, clock.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := count, 1; have != want {
+			t.Fatalf("have count: %d, want: %d", have, want)
+		}
+
+		count, err = s.CountEnqueuedChangesetAutoMerges(ctx, 10, clock.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := count, 0; have != want {
+			t.Fatalf("have count: %d, want: %d", have, want)
+		}
+	})
+
+	t.Run("Upsert replaces the previous decision", func(t *testing.T) {
+		d := &btypes.ChangesetAutoMergeDecision{
+			ChangesetID:   2,
+			BatchChangeID: 10,
+			BatchSpecID:   101,
+			Outcome:       btypes.ChangesetAutoMergeOutcomeDeferred,
+			Reason:        "the merge window is closed",
+		}
+		if err := s.UpsertChangesetAutoMergeDecision(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := s.GetChangesetAutoMergeDecision(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(have, d); diff != "" {
+			t.Fatal(diff)
+		}
+
+		count, err := s.CountEnqueuedChangesetAutoMerges(ctx, 10, clock.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := count, 0; have != want {
+			t.Fatalf("have count: %d, want: %d", have, want)
+		}
+	})
+}
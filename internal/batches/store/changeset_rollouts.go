@@ -0,0 +1,159 @@
+package store
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// UpdateChangesetSpecRolloutTiers sets the rollout tiers of the changeset
+// specs with the given IDs.
+func (s *Store) UpdateChangesetSpecRolloutTiers(ctx context.Context, tiers map[int64]int) (err error) {
+	ctx, _, endObservation := s.operations.updateChangesetSpecRolloutTiers.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("Count", len(tiers)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tiers))
+	values := make([]int64, 0, len(tiers))
+	for id, tier := range tiers {
+		ids = append(ids, id)
+		values = append(values, int64(tier))
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(updateChangesetSpecRolloutTiersQueryFmtstr, pq.Array(ids), pq.Array(values)))
+}
+
+const updateChangesetSpecRolloutTiersQueryFmtstr = `
+UPDATE changeset_specs
+SET rollout_tier = tiers.tier
+FROM unnest(%s::bigint[], %s::integer[]) AS tiers(id, tier)
+WHERE changeset_specs.id = tiers.id
+`
+
+// GetChangesetRolloutBlockers returns the changesets of the given batch
+// change in a rollout tier lower than the given tier that block the rollout,
+// see ChangesetExternalState.BlocksRollout. Archived changesets don't block
+// the rollout.
+func (s *Store) GetChangesetRolloutBlockers(ctx context.Context, batchChangeID int64, tier int) (b *btypes.ChangesetRolloutBlockers, err error) {
+	ctx, _, endObservation := s.operations.getChangesetRolloutBlockers.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+		attribute.Int("tier", tier),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	batchChangeIDStr := strconv.Itoa(int(batchChangeID))
+	q := sqlf.Sprintf(
+		getChangesetRolloutBlockersQueryFmtstr,
+		batchChangeID,
+		batchChangeIDStr,
+		archivedInBatchChange(batchChangeIDStr),
+		tier,
+		btypes.ChangesetExternalStateMerged,
+		btypes.ChangesetExternalStateClosed,
+		btypes.ChangesetExternalStateReadOnly,
+	)
+
+	b = &btypes.ChangesetRolloutBlockers{}
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return sc.Scan(&b.Tier, &b.Count)
+	})
+	return b, err
+}
+
+const getChangesetRolloutBlockersQueryFmtstr = `
+SELECT
+	COALESCE(MIN(changeset_specs.rollout_tier), 0),
+	COUNT(*)
+FROM changesets
+JOIN changeset_specs ON changeset_specs.id = changesets.current_spec_id
+WHERE
+	changesets.owned_by_batch_change_id = %s AND
+	changesets.batch_change_ids ? %s AND
+	NOT (%s) AND
+	changeset_specs.rollout_tier < %s AND
+	(changesets.external_state IS NULL OR changesets.external_state NOT IN (%s, %s, %s))
+`
+
+// ListChangesetRolloutTiers returns the rollout tiers of the changesets owned
+// by the given batch change, keyed by changeset ID.
+func (s *Store) ListChangesetRolloutTiers(ctx context.Context, batchChangeID int64) (tiers map[int64]int, err error) {
+	ctx, _, endObservation := s.operations.listChangesetRolloutTiers.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tiers = make(map[int64]int)
+	err = s.query(ctx, sqlf.Sprintf(listChangesetRolloutTiersQueryFmtstr, batchChangeID), func(sc dbutil.Scanner) error {
+		var id int64
+		var tier int
+		if err := sc.Scan(&id, &tier); err != nil {
+			return err
+		}
+		tiers[id] = tier
+		return nil
+	})
+	return tiers, err
+}
+
+const listChangesetRolloutTiersQueryFmtstr = `
+SELECT changesets.id, changeset_specs.rollout_tier
+FROM changesets
+JOIN changeset_specs ON changeset_specs.id = changesets.current_spec_id
+WHERE changesets.owned_by_batch_change_id = %s
+`
+
+// EnqueueRolloutBlockedChangesets enqueues the unpublished changesets of the
+// given batch change in a rollout tier above 0 for the reconciler, so that it
+// publishes the ones that aren't blocked anymore. It returns the number of
+// changesets that were enqueued.
+func (s *Store) EnqueueRolloutBlockedChangesets(ctx context.Context, batchChangeID int64, resetState btypes.ReconcilerState) (count int, err error) {
+	ctx, _, endObservation := s.operations.enqueueRolloutBlockedChangesets.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err = basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(
+		enqueueRolloutBlockedChangesetsQueryFmtstr,
+		resetState.ToDB(),
+		s.now(),
+		batchChangeID,
+		btypes.ChangesetPublicationStateUnpublished,
+		btypes.ReconcilerStateCompleted.ToDB(),
+	)))
+	return count, err
+}
+
+const enqueueRolloutBlockedChangesetsQueryFmtstr = `
+WITH enqueued AS (
+	UPDATE changesets
+	SET
+		reconciler_state = %s,
+		num_resets = 0,
+		num_failures = 0,
+		previous_failure_message = changesets.failure_message,
+		failure_message = NULL,
+		updated_at = %s
+	FROM changeset_specs
+	WHERE
+		changeset_specs.id = changesets.current_spec_id AND
+		changeset_specs.rollout_tier > 0 AND
+		changesets.owned_by_batch_change_id = %s AND
+		changesets.publication_state = %s AND
+		changesets.reconciler_state = %s
+	RETURNING changesets.id
+)
+SELECT COUNT(*) FROM enqueued
+`
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+)
+
+func testStoreChangesetRollouts(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	repo, _ := bt.CreateTestRepo(t, ctx, s.DatabaseDB())
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+	batchSpec := bt.CreateBatchSpec(t, ctx, s, "rollout", user.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, s, "rollout", user.ID, batchSpec.ID)
+
+	newChangeset := func(t *testing.T, headRef string, tier int, publication btypes.ChangesetPublicationState, external btypes.ChangesetExternalState) *btypes.Changeset {
+		t.Helper()
+
+		spec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{
+			User:      user.ID,
+			Repo:      repo.ID,
+			BatchSpec: batchSpec.ID,
+			HeadRef:   headRef,
+			Typ:       btypes.ChangesetSpecTypeBranch,
+		})
+		if err := s.UpdateChangesetSpecRolloutTiers(ctx, map[int64]int{spec.ID: tier}); err != nil {
+			t.Fatal(err)
+		}
+
+		return bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			Repo:               repo.ID,
+			BatchChange:        batchChange.ID,
+			OwnedByBatchChange: batchChange.ID,
+			CurrentSpec:        spec.ID,
+			PublicationState:   publication,
+			ExternalState:      external,
+			ReconcilerState:    btypes.ReconcilerStateCompleted,
+		})
+	}
+
+	lib := newChangeset(t, "lib", 0, btypes.ChangesetPublicationStatePublished, btypes.ChangesetExternalStateOpen)
+	merged := newChangeset(t, "merged", 0, btypes.ChangesetPublicationStatePublished, btypes.ChangesetExternalStateMerged)
+	// Closed and read-only changesets can't be merged anymore, so they don't
+	// block the rollout.
+	closed := newChangeset(t, "closed", 0, btypes.ChangesetPublicationStatePublished, btypes.ChangesetExternalStateClosed)
+	readOnly := newChangeset(t, "read-only", 0, btypes.ChangesetPublicationStatePublished, btypes.ChangesetExternalStateReadOnly)
+	app := newChangeset(t, "app", 1, btypes.ChangesetPublicationStateUnpublished, "")
+	cli := newChangeset(t, "cli", 2, btypes.ChangesetPublicationStateUnpublished, "")
+
+	t.Run("UpdateChangesetSpecRolloutTiers", func(t *testing.T) {
+		spec, err := s.GetChangesetSpecByID(ctx, app.CurrentSpecID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if spec.RolloutTier != 1 {
+			t.Fatalf("wrong rollout tier. want=%d, have=%d", 1, spec.RolloutTier)
+		}
+	})
+
+	t.Run("ListChangesetRolloutTiers", func(t *testing.T) {
+		have, err := s.ListChangesetRolloutTiers(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[int64]int{lib.ID: 0, merged.ID: 0, closed.ID: 0, readOnly.ID: 0, app.ID: 1, cli.ID: 2}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("wrong tiers (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("GetChangesetRolloutBlockers", func(t *testing.T) {
+		for _, tc := range []struct {
+			tier int
+			want btypes.ChangesetRolloutBlockers
+		}{
+			{tier: 0, want: btypes.ChangesetRolloutBlockers{}},
+			{tier: 1, want: btypes.ChangesetRolloutBlockers{Tier: 0, Count: 1}},
+			{tier: 2, want: btypes.ChangesetRolloutBlockers{Tier: 0, Count: 2}},
+		} {
+			have, err := s.GetChangesetRolloutBlockers(ctx, batchChange.ID, tc.tier)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(&tc.want, have); diff != "" {
+				t.Fatalf("tier %d: wrong blockers (-want +have):\n%s", tc.tier, diff)
+			}
+		}
+
+		// Other batch changes don't block the rollout.
+		have, err := s.GetChangesetRolloutBlockers(ctx, batchChange.ID+1000, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have.Blocked() {
+			t.Fatalf("rollout of unknown batch change is blocked: %+v", have)
+		}
+	})
+
+	t.Run("EnqueueRolloutBlockedChangesets", func(t *testing.T) {
+		count, err := s.EnqueueRolloutBlockedChangesets(ctx, batchChange.ID, btypes.ReconcilerStateQueued)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 2 {
+			t.Fatalf("wrong number of enqueued changesets. want=%d, have=%d", 2, count)
+		}
+
+		for _, c := range []*btypes.Changeset{app, cli} {
+			bt.ReloadAndAssertChangeset(t, ctx, s, c, bt.ChangesetAssertions{
+				Repo:               repo.ID,
+				AttachedTo:         []int64{batchChange.ID},
+				OwnedByBatchChange: batchChange.ID,
+				CurrentSpec:        c.CurrentSpecID,
+				PublicationState:   btypes.ChangesetPublicationStateUnpublished,
+				ReconcilerState:    btypes.ReconcilerStateQueued,
+			})
+		}
+
+		// Changesets that are queued already aren't counted again.
+		count, err = s.EnqueueRolloutBlockedChangesets(ctx, batchChange.ID, btypes.ReconcilerStateQueued)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Fatalf("wrong number of enqueued changesets. want=%d, have=%d", 0, count)
+		}
+	})
+}
@@ -45,6 +45,11 @@ var changesetSpecInsertColumns = []string{
 	"commit_author_name",
 	"commit_author_email",
 	"type",
+	"labels",
+	"reviewers",
+	"assignees",
+	"milestone",
+	"rollout_tier",
 }
 
 // changesetSpecColumns are used by the changeset spec related Store methods to
@@ -72,6 +77,11 @@ var changesetSpecColumns = SQLColumns{
 	"changeset_specs.commit_author_name",
 	"changeset_specs.commit_author_email",
 	"changeset_specs.type",
+	"changeset_specs.labels",
+	"changeset_specs.reviewers",
+	"changeset_specs.assignees",
+	"changeset_specs.milestone",
+	"changeset_specs.rollout_tier",
 }
 
 var oneGigabyte = 1000000000
@@ -137,6 +147,11 @@ func (s *Store) CreateChangesetSpec(ctx context.Context, cs ...*btypes.Changeset
 				dbutil.NewNullString(c.CommitAuthorName),
 				dbutil.NewNullString(c.CommitAuthorEmail),
 				c.Type,
+				pq.Array(nonNilStrings(c.Labels)),
+				pq.Array(nonNilStrings(c.Reviewers)),
+				pq.Array(nonNilStrings(c.Assignees)),
+				dbutil.NewNullString(c.Milestone),
+				c.RolloutTier,
 			); err != nil {
 				return err
 			}
@@ -539,6 +554,7 @@ func deleteChangesetSpecsQuery(opts *DeleteChangesetSpecsOpts) *sqlf.Query {
 func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 	var published []byte
 	var typ string
+	var labels, reviewers, assignees []string
 
 	err := s.Scan(
 		&c.ID,
@@ -563,6 +579,11 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &c.CommitAuthorName},
 		&dbutil.NullString{S: &c.CommitAuthorEmail},
 		&typ,
+		pq.Array(&labels),
+		pq.Array(&reviewers),
+		pq.Array(&assignees),
+		&dbutil.NullString{S: &c.Milestone},
+		&c.RolloutTier,
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset spec")
@@ -570,6 +591,11 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 
 	c.Type = btypes.ChangesetSpecType(typ)
 
+	// Empty arrays are scanned as nil, matching specs without metadata.
+	c.Labels = nilIfEmpty(labels)
+	c.Reviewers = nilIfEmpty(reviewers)
+	c.Assignees = nilIfEmpty(assignees)
+
 	if len(published) != 0 {
 		if err := json.Unmarshal(published, &c.Published); err != nil {
 			return err
@@ -579,6 +605,22 @@ func scanChangesetSpec(c *btypes.ChangesetSpec, s dbutil.Scanner) error {
 	return nil
 }
 
+// nonNilStrings returns an empty slice if s is nil, so that it is stored as an
+// empty array rather than NULL.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+func nilIfEmpty(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
 type GetRewirerMappingsOpts struct {
 	BatchSpecID   int64
 	BatchChangeID int64
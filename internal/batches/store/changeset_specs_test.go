@@ -78,6 +78,9 @@ func testStoreChangesetSpecs(t *testing.T, ctx context.Context, s *Store, clock
 			c.CommitAuthorName = "name"
 			c.CommitAuthorEmail = "email"
 			c.Type = btypes.ChangesetSpecTypeBranch
+			c.Labels = []string{"batch-change", "dependencies"}
+			c.Reviewers = []string{"alice"}
+			c.Milestone = "1.0"
 		} else {
 			c.ExternalID = "123456"
 			c.Type = btypes.ChangesetSpecTypeExisting
@@ -807,6 +807,67 @@ func (s *Store) EnqueueChangeset(ctx context.Context, cs *btypes.Changeset, rese
 	return nil
 }
 
+// EnqueueCompletedChangeset enqueues the given changeset for the reconciler
+// unless the reconciler is already processing it or it failed, and reports
+// whether the changeset was enqueued.
+func (s *Store) EnqueueCompletedChangeset(ctx context.Context, cs *btypes.Changeset, resetState btypes.ReconcilerState) (enqueued bool, err error) {
+	ctx, _, endObservation := s.operations.enqueueCompletedChangeset.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("ID", int(cs.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	_, enqueued, err = basestore.ScanFirstInt(s.Store.Query(
+		ctx,
+		s.enqueueChangesetQuery(cs, resetState, btypes.ReconcilerStateCompleted),
+	))
+	return enqueued, err
+}
+
+// ReplaceChangesetSpecs makes each of the given changeset specs the current
+// spec of the changeset whose current spec is one of previousSpecIDs, for the
+// same repository and head ref, and enqueues those changesets for the
+// reconciler. It is used when the workspace that produced previousSpecIDs was
+// executed again after the batch spec was applied.
+func (s *Store) ReplaceChangesetSpecs(ctx context.Context, previousSpecIDs, specIDs []int64) (err error) {
+	ctx, _, endObservation := s.operations.replaceChangesetSpecs.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	if len(previousSpecIDs) == 0 || len(specIDs) == 0 {
+		return nil
+	}
+
+	q := sqlf.Sprintf(
+		replaceChangesetSpecsQueryFmtstr,
+		btypes.ReconcilerStateQueued.ToDB(),
+		s.now(),
+		pq.Array(previousSpecIDs),
+		pq.Array(specIDs),
+	)
+	return s.Exec(ctx, q)
+}
+
+const replaceChangesetSpecsQueryFmtstr = `
+UPDATE changesets
+SET
+	previous_spec_id = changesets.current_spec_id,
+	current_spec_id = new_specs.id,
+	reconciler_state = %s,
+	num_resets = 0,
+	num_failures = 0,
+	previous_failure_message = changesets.failure_message,
+	failure_message = NULL,
+	updated_at = %s
+FROM
+	changeset_specs AS current_specs,
+	changeset_specs AS new_specs
+WHERE
+	changesets.current_spec_id = ANY (%s)
+	AND current_specs.id = changesets.current_spec_id
+	AND new_specs.id = ANY (%s)
+	AND new_specs.base_repo_id = current_specs.base_repo_id
+	AND new_specs.head_ref = current_specs.head_ref
+`
+
 var enqueueChangesetQueryFmtstr = `
 UPDATE changesets
 SET
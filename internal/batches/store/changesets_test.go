@@ -1536,6 +1536,92 @@ func testStoreChangesets(t *testing.T, ctx context.Context, s *Store, clock bt.C
 		})
 	})
 
+	t.Run("EnqueueCompletedChangeset", func(t *testing.T) {
+		completed := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+		})
+		processing := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			ReconcilerState:  btypes.ReconcilerStateProcessing,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+		})
+
+		enqueued, err := s.EnqueueCompletedChangeset(ctx, completed, btypes.ReconcilerStateQueued)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !enqueued {
+			t.Fatal("completed changeset was not enqueued")
+		}
+		bt.ReloadAndAssertChangeset(t, ctx, s, completed, bt.ChangesetAssertions{
+			ReconcilerState:  btypes.ReconcilerStateQueued,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+		})
+
+		enqueued, err = s.EnqueueCompletedChangeset(ctx, processing, btypes.ReconcilerStateQueued)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enqueued {
+			t.Fatal("processing changeset was enqueued")
+		}
+		bt.ReloadAndAssertChangeset(t, ctx, s, processing, bt.ChangesetAssertions{
+			ReconcilerState:  btypes.ReconcilerStateProcessing,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+		})
+	})
+
+	t.Run("ReplaceChangesetSpecs", func(t *testing.T) {
+		previousSpec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{Repo: repo.ID, HeadRef: "refs/heads/replace", Typ: btypes.ChangesetSpecTypeBranch})
+		otherSpec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{Repo: repo.ID, HeadRef: "refs/heads/replace-other", Typ: btypes.ChangesetSpecTypeBranch})
+		newSpec := bt.CreateChangesetSpec(t, ctx, s, bt.TestSpecOpts{Repo: repo.ID, HeadRef: "refs/heads/replace", Typ: btypes.ChangesetSpecTypeBranch})
+
+		replaced := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+			CurrentSpec:      previousSpec.ID,
+		})
+		// The head ref of the current spec of this changeset doesn't match
+		// any of the new specs.
+		untouched := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+			CurrentSpec:      otherSpec.ID,
+		})
+
+		if err := s.ReplaceChangesetSpecs(ctx, []int64{previousSpec.ID, otherSpec.ID}, []int64{newSpec.ID}); err != nil {
+			t.Fatal(err)
+		}
+
+		bt.ReloadAndAssertChangeset(t, ctx, s, replaced, bt.ChangesetAssertions{
+			ReconcilerState:  btypes.ReconcilerStateQueued,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+			CurrentSpec:      newSpec.ID,
+			PreviousSpec:     previousSpec.ID,
+		})
+		bt.ReloadAndAssertChangeset(t, ctx, s, untouched, bt.ChangesetAssertions{
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			PublicationState: btypes.ChangesetPublicationStatePublished,
+			ExternalState:    btypes.ChangesetExternalStateOpen,
+			Repo:             repo.ID,
+			CurrentSpec:      otherSpec.ID,
+		})
+	})
+
 	t.Run("UpdateChangesetBatchChanges", func(t *testing.T) {
 		c1 := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
 			ReconcilerState:  btypes.ReconcilerStateCompleted,
@@ -0,0 +1,283 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// changesetImpactMetricsColumns are used by the impact analytics related
+// Store methods to query changeset impact metrics.
+var changesetImpactMetricsColumns = SQLColumns{
+	"changeset_impact_metrics.changeset_id",
+	"changeset_impact_metrics.code_host",
+	"changeset_impact_metrics.team",
+	"changeset_impact_metrics.opened_at",
+	"changeset_impact_metrics.merged_at",
+	"changeset_impact_metrics.first_review_at",
+	"changeset_impact_metrics.check_runs",
+	"changeset_impact_metrics.failed_check_runs",
+	"changeset_impact_metrics.computed_at",
+}
+
+// batchChangeImpactStatsColumns are used by the impact analytics related
+// Store methods to query batch change impact stats.
+var batchChangeImpactStatsColumns = SQLColumns{
+	"batch_change_impact_stats.batch_change_id",
+	"batch_change_impact_stats.dimension",
+	"batch_change_impact_stats.value",
+	"batch_change_impact_stats.changesets",
+	"batch_change_impact_stats.merged",
+	"batch_change_impact_stats.reviewed",
+	"batch_change_impact_stats.time_to_merge_p50_seconds",
+	"batch_change_impact_stats.time_to_merge_p90_seconds",
+	"batch_change_impact_stats.time_to_merge_buckets",
+	"batch_change_impact_stats.review_latency_p50_seconds",
+	"batch_change_impact_stats.review_latency_p90_seconds",
+	"batch_change_impact_stats.check_runs",
+	"batch_change_impact_stats.failed_check_runs",
+	"batch_change_impact_stats.computed_at",
+}
+
+// UpsertChangesetImpactMetrics creates or replaces the impact metrics of the
+// changeset of the given metrics.
+func (s *Store) UpsertChangesetImpactMetrics(ctx context.Context, m *btypes.ChangesetImpactMetrics) (err error) {
+	ctx, _, endObservation := s.operations.upsertChangesetImpactMetrics.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(m.ChangesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if m.ComputedAt.IsZero() {
+		m.ComputedAt = s.now()
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(
+		upsertChangesetImpactMetricsQueryFmtstr,
+		m.ChangesetID,
+		m.CodeHost,
+		dbutil.NullStringColumn(m.Team),
+		m.OpenedAt,
+		dbutil.NullTimeColumn(m.MergedAt),
+		dbutil.NullTimeColumn(m.FirstReviewAt),
+		m.CheckRuns,
+		m.FailedCheckRuns,
+		m.ComputedAt,
+	))
+}
+
+const upsertChangesetImpactMetricsQueryFmtstr = `
+INSERT INTO changeset_impact_metrics (
+	changeset_id,
+	code_host,
+	team,
+	opened_at,
+	merged_at,
+	first_review_at,
+	check_runs,
+	failed_check_runs,
+	computed_at
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s)
+ON CONFLICT (changeset_id) DO UPDATE SET
+	code_host = EXCLUDED.code_host,
+	team = EXCLUDED.team,
+	opened_at = EXCLUDED.opened_at,
+	merged_at = EXCLUDED.merged_at,
+	first_review_at = EXCLUDED.first_review_at,
+	check_runs = EXCLUDED.check_runs,
+	failed_check_runs = EXCLUDED.failed_check_runs,
+	computed_at = EXCLUDED.computed_at
+`
+
+// GetChangesetImpactMetrics gets the impact metrics of the changeset with the
+// given ID. ErrNoResults is returned if they weren't computed yet.
+func (s *Store) GetChangesetImpactMetrics(ctx context.Context, changesetID int64) (m *btypes.ChangesetImpactMetrics, err error) {
+	ctx, _, endObservation := s.operations.getChangesetImpactMetrics.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("changesetID", int(changesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getChangesetImpactMetricsQueryFmtstr,
+		sqlf.Join(changesetImpactMetricsColumns.ToSqlf(), ", "),
+		changesetID,
+	)
+
+	var metrics btypes.ChangesetImpactMetrics
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanChangesetImpactMetrics(&metrics, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if metrics.ChangesetID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &metrics, nil
+}
+
+const getChangesetImpactMetricsQueryFmtstr = `
+SELECT %s FROM changeset_impact_metrics
+WHERE changeset_id = %s
+`
+
+// ReplaceBatchChangeImpactStats replaces all impact stats of the given batch
+// change with the given stats.
+func (s *Store) ReplaceBatchChangeImpactStats(ctx context.Context, batchChangeID int64, stats []*btypes.BatchChangeImpactStats) (err error) {
+	ctx, _, endObservation := s.operations.replaceBatchChangeImpactStats.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+		attribute.Int("count", len(stats)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(deleteBatchChangeImpactStatsQueryFmtstr, batchChangeID)); err != nil {
+		return err
+	}
+
+	now := s.now()
+	for _, st := range stats {
+		st.BatchChangeID = batchChangeID
+		if st.ComputedAt.IsZero() {
+			st.ComputedAt = now
+		}
+
+		if err := tx.Exec(ctx, sqlf.Sprintf(
+			insertBatchChangeImpactStatsQueryFmtstr,
+			st.BatchChangeID,
+			st.Dimension,
+			st.Value,
+			st.Changesets,
+			st.Merged,
+			st.Reviewed,
+			durationSecondsColumn(st.TimeToMergeP50),
+			durationSecondsColumn(st.TimeToMergeP90),
+			pq.Int32Array(st.TimeToMergeBuckets),
+			durationSecondsColumn(st.ReviewLatencyP50),
+			durationSecondsColumn(st.ReviewLatencyP90),
+			st.CheckRuns,
+			st.FailedCheckRuns,
+			st.ComputedAt,
+		)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const deleteBatchChangeImpactStatsQueryFmtstr = `
+DELETE FROM batch_change_impact_stats WHERE batch_change_id = %s
+`
+
+const insertBatchChangeImpactStatsQueryFmtstr = `
+INSERT INTO batch_change_impact_stats (
+	batch_change_id,
+	dimension,
+	value,
+	changesets,
+	merged,
+	reviewed,
+	time_to_merge_p50_seconds,
+	time_to_merge_p90_seconds,
+	time_to_merge_buckets,
+	review_latency_p50_seconds,
+	review_latency_p90_seconds,
+	check_runs,
+	failed_check_runs,
+	computed_at
+)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+`
+
+// ListBatchChangeImpactStats lists the impact stats of the given batch change,
+// ordered by dimension and value.
+func (s *Store) ListBatchChangeImpactStats(ctx context.Context, batchChangeID int64) (stats []*btypes.BatchChangeImpactStats, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeImpactStats.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listBatchChangeImpactStatsQueryFmtstr,
+		sqlf.Join(batchChangeImpactStatsColumns.ToSqlf(), ", "),
+		batchChangeID,
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var st btypes.BatchChangeImpactStats
+		if err := scanBatchChangeImpactStats(&st, sc); err != nil {
+			return err
+		}
+		stats = append(stats, &st)
+		return nil
+	})
+	return stats, err
+}
+
+const listBatchChangeImpactStatsQueryFmtstr = `
+SELECT %s FROM batch_change_impact_stats
+WHERE batch_change_id = %s
+ORDER BY dimension ASC, value ASC
+`
+
+// durationSecondsColumn returns the whole seconds of d, or NULL if d is 0.
+func durationSecondsColumn(d time.Duration) *int64 {
+	return dbutil.NullInt64Column(int64(d / time.Second))
+}
+
+func scanChangesetImpactMetrics(m *btypes.ChangesetImpactMetrics, s dbutil.Scanner) error {
+	return s.Scan(
+		&m.ChangesetID,
+		&m.CodeHost,
+		&dbutil.NullString{S: &m.Team},
+		&m.OpenedAt,
+		&dbutil.NullTime{Time: &m.MergedAt},
+		&dbutil.NullTime{Time: &m.FirstReviewAt},
+		&m.CheckRuns,
+		&m.FailedCheckRuns,
+		&m.ComputedAt,
+	)
+}
+
+func scanBatchChangeImpactStats(st *btypes.BatchChangeImpactStats, s dbutil.Scanner) error {
+	var timeToMergeP50, timeToMergeP90, reviewLatencyP50, reviewLatencyP90 int64
+	if err := s.Scan(
+		&st.BatchChangeID,
+		&st.Dimension,
+		&st.Value,
+		&st.Changesets,
+		&st.Merged,
+		&st.Reviewed,
+		&dbutil.NullInt64{N: &timeToMergeP50},
+		&dbutil.NullInt64{N: &timeToMergeP90},
+		(*pq.Int32Array)(&st.TimeToMergeBuckets),
+		&dbutil.NullInt64{N: &reviewLatencyP50},
+		&dbutil.NullInt64{N: &reviewLatencyP90},
+		&st.CheckRuns,
+		&st.FailedCheckRuns,
+		&st.ComputedAt,
+	); err != nil {
+		return err
+	}
+
+	st.TimeToMergeP50 = time.Duration(timeToMergeP50) * time.Second
+	st.TimeToMergeP90 = time.Duration(timeToMergeP90) * time.Second
+	st.ReviewLatencyP50 = time.Duration(reviewLatencyP50) * time.Second
+	st.ReviewLatencyP90 = time.Duration(reviewLatencyP90) * time.Second
+	return nil
+}
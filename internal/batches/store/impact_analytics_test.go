@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+func testStoreImpactAnalytics(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	repo, _ := bt.CreateTestRepo(t, ctx, s.DatabaseDB())
+	user := bt.CreateTestUser(t, s.DatabaseDB(), false)
+	batchSpec := bt.CreateBatchSpec(t, ctx, s, "impact", user.ID, 0)
+	batchChange := bt.CreateBatchChange(t, ctx, s, "impact", user.ID, batchSpec.ID)
+	changeset := bt.CreateChangeset(t, ctx, s, bt.TestChangesetOpts{
+		Repo:             repo.ID,
+		BatchChange:      batchChange.ID,
+		PublicationState: btypes.ChangesetPublicationStatePublished,
+		ExternalState:    btypes.ChangesetExternalStateMerged,
+	})
+
+	t.Run("ChangesetImpactMetrics", func(t *testing.T) {
+		if _, err := s.GetChangesetImpactMetrics(ctx, changeset.ID); err != ErrNoResults {
+			t.Fatalf("wrong error for missing metrics. want=%v, have=%v", ErrNoResults, err)
+		}
+
+		opened := clock.Now().Add(-48 * time.Hour)
+		metrics := &btypes.ChangesetImpactMetrics{
+			ChangesetID:     changeset.ID,
+			CodeHost:        extsvc.TypeGitHub,
+			Team:            "batch-changes",
+			OpenedAt:        opened,
+			MergedAt:        opened.Add(24 * time.Hour),
+			CheckRuns:       3,
+			FailedCheckRuns: 1,
+		}
+		if err := s.UpsertChangesetImpactMetrics(ctx, metrics); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err := s.GetChangesetImpactMetrics(ctx, changeset.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(metrics, have); diff != "" {
+			t.Fatalf("wrong metrics (-want +have):\n%s", diff)
+		}
+
+		// Upserting again replaces the metrics.
+		metrics.Team = ""
+		metrics.FirstReviewAt = opened.Add(time.Hour)
+		if err := s.UpsertChangesetImpactMetrics(ctx, metrics); err != nil {
+			t.Fatal(err)
+		}
+		have, err = s.GetChangesetImpactMetrics(ctx, changeset.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(metrics, have); diff != "" {
+			t.Fatalf("wrong metrics after upsert (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("BatchChangeImpactStats", func(t *testing.T) {
+		have, err := s.ListBatchChangeImpactStats(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 0 {
+			t.Fatalf("stats returned before they were computed: %+v", have)
+		}
+
+		stats := []*btypes.BatchChangeImpactStats{
+			{
+				Dimension:          btypes.ImpactDimensionAll,
+				Changesets:         2,
+				Merged:             1,
+				TimeToMergeP50:     24 * time.Hour,
+				TimeToMergeP90:     24 * time.Hour,
+				TimeToMergeBuckets: []int32{0, 1, 0, 0, 0},
+				CheckRuns:          3,
+				FailedCheckRuns:    1,
+			},
+			{
+				Dimension:          btypes.ImpactDimensionCodeHost,
+				Value:              extsvc.TypeGitHub,
+				Changesets:         2,
+				Merged:             1,
+				TimeToMergeP50:     24 * time.Hour,
+				TimeToMergeP90:     24 * time.Hour,
+				TimeToMergeBuckets: []int32{0, 1, 0, 0, 0},
+				CheckRuns:          3,
+				FailedCheckRuns:    1,
+			},
+		}
+		if err := s.ReplaceBatchChangeImpactStats(ctx, batchChange.ID, stats); err != nil {
+			t.Fatal(err)
+		}
+
+		have, err = s.ListBatchChangeImpactStats(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(stats, have); diff != "" {
+			t.Fatalf("wrong stats (-want +have):\n%s", diff)
+		}
+
+		// Replacing removes the stats that aren't computed anymore.
+		stats = stats[:1]
+		if err := s.ReplaceBatchChangeImpactStats(ctx, batchChange.ID, stats); err != nil {
+			t.Fatal(err)
+		}
+		have, err = s.ListBatchChangeImpactStats(ctx, batchChange.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(stats, have); diff != "" {
+			t.Fatalf("wrong stats after replace (-want +have):\n%s", diff)
+		}
+	})
+}
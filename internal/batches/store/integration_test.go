@@ -36,6 +36,10 @@ func TestIntegration(t *testing.T) {
 		t.Run("CodeHosts", storeTest(db, nil, testStoreCodeHost))
 		t.Run("UserDeleteCascades", storeTest(db, nil, testUserDeleteCascades))
 		t.Run("ChangesetJobs", storeTest(db, nil, testStoreChangesetJobs))
+		t.Run("ChangesetAutoMergeDecisions", storeTest(db, nil, testStoreChangesetAutoMergeDecisions))
+		t.Run("BatchChangeReruns", storeTest(db, nil, testStoreBatchChangeReruns))
+		t.Run("ChangesetRollouts", storeTest(db, nil, testStoreChangesetRollouts))
+		t.Run("ImpactAnalytics", storeTest(db, nil, testStoreImpactAnalytics))
 		t.Run("BulkOperations", storeTest(db, nil, testStoreBulkOperations))
 		t.Run("BatchSpecWorkspaces", storeTest(db, nil, testStoreBatchSpecWorkspaces))
 		t.Run("BatchSpecWorkspaceExecutionJobs", storeTest(db, nil, testStoreBatchSpecWorkspaceExecutionJobs))
@@ -222,6 +222,26 @@ type operations struct {
 	createChangesetJob *observation.Operation
 	getChangesetJob    *observation.Operation
 
+	upsertChangesetAutoMergeDecision *observation.Operation
+	getChangesetAutoMergeDecision    *observation.Operation
+	countEnqueuedChangesetAutoMerges *observation.Operation
+
+	createBatchChangeRerun    *observation.Operation
+	updateBatchChangeRerun    *observation.Operation
+	getLastBatchChangeRerun   *observation.Operation
+	listBatchChangeReruns     *observation.Operation
+	listRecurringBatchChanges *observation.Operation
+
+	updateChangesetSpecRolloutTiers *observation.Operation
+	getChangesetRolloutBlockers     *observation.Operation
+	listChangesetRolloutTiers       *observation.Operation
+	enqueueRolloutBlockedChangesets *observation.Operation
+
+	upsertChangesetImpactMetrics  *observation.Operation
+	getChangesetImpactMetrics     *observation.Operation
+	replaceBatchChangeImpactStats *observation.Operation
+	listBatchChangeImpactStats    *observation.Operation
+
 	createChangesetSpec                      *observation.Operation
 	updateChangesetSpecBatchSpecID           *observation.Operation
 	deleteChangesetSpec                      *observation.Operation
@@ -241,6 +261,8 @@ type operations struct {
 	listChangesetSyncData             *observation.Operation
 	listChangesets                    *observation.Operation
 	enqueueChangeset                  *observation.Operation
+	enqueueCompletedChangeset         *observation.Operation
+	replaceChangesetSpecs             *observation.Operation
 	updateChangeset                   *observation.Operation
 	updateChangesetBatchChanges       *observation.Operation
 	updateChangesetUIPublicationState *observation.Operation
@@ -271,6 +293,7 @@ type operations struct {
 	countBatchSpecWorkspaces       *observation.Operation
 	markSkippedBatchSpecWorkspaces *observation.Operation
 	listRetryBatchSpecWorkspaces   *observation.Operation
+	reexecuteBatchSpecWorkspace    *observation.Operation
 
 	createBatchSpecWorkspaceExecutionJobs              *observation.Operation
 	createBatchSpecWorkspaceExecutionJobsForWorkspaces *observation.Operation
@@ -367,6 +390,26 @@ func newOperations(observationCtx *observation.Context) *operations {
 			createChangesetJob: op("CreateChangesetJob"),
 			getChangesetJob:    op("GetChangesetJob"),
 
+			upsertChangesetAutoMergeDecision: op("UpsertChangesetAutoMergeDecision"),
+			getChangesetAutoMergeDecision:    op("GetChangesetAutoMergeDecision"),
+			countEnqueuedChangesetAutoMerges: op("CountEnqueuedChangesetAutoMerges"),
+
+			createBatchChangeRerun:    op("CreateBatchChangeRerun"),
+			updateBatchChangeRerun:    op("UpdateBatchChangeRerun"),
+			getLastBatchChangeRerun:   op("GetLastBatchChangeRerun"),
+			listBatchChangeReruns:     op("ListBatchChangeReruns"),
+			listRecurringBatchChanges: op("ListRecurringBatchChanges"),
+
+			updateChangesetSpecRolloutTiers: op("UpdateChangesetSpecRolloutTiers"),
+			getChangesetRolloutBlockers:     op("GetChangesetRolloutBlockers"),
+			listChangesetRolloutTiers:       op("ListChangesetRolloutTiers"),
+			enqueueRolloutBlockedChangesets: op("EnqueueRolloutBlockedChangesets"),
+
+			upsertChangesetImpactMetrics:  op("UpsertChangesetImpactMetrics"),
+			getChangesetImpactMetrics:     op("GetChangesetImpactMetrics"),
+			replaceBatchChangeImpactStats: op("ReplaceBatchChangeImpactStats"),
+			listBatchChangeImpactStats:    op("ListBatchChangeImpactStats"),
+
 			createChangesetSpec:                      op("CreateChangesetSpec"),
 			updateChangesetSpecBatchSpecID:           op("UpdateChangesetSpecBatchSpecID"),
 			deleteChangesetSpec:                      op("DeleteChangesetSpec"),
@@ -386,6 +429,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 			listChangesetSyncData:             op("ListChangesetSyncData"),
 			listChangesets:                    op("ListChangesets"),
 			enqueueChangeset:                  op("EnqueueChangeset"),
+			enqueueCompletedChangeset:         op("EnqueueCompletedChangeset"),
+			replaceChangesetSpecs:             op("ReplaceChangesetSpecs"),
 			updateChangeset:                   op("UpdateChangeset"),
 			updateChangesetBatchChanges:       op("UpdateChangesetBatchChanges"),
 			updateChangesetUIPublicationState: op("UpdateChangesetUIPublicationState"),
@@ -416,6 +461,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 			countBatchSpecWorkspaces:       op("CountBatchSpecWorkspaces"),
 			markSkippedBatchSpecWorkspaces: op("MarkSkippedBatchSpecWorkspaces"),
 			listRetryBatchSpecWorkspaces:   op("ListRetryBatchSpecWorkspaces"),
+			reexecuteBatchSpecWorkspace:    op("ReexecuteBatchSpecWorkspace"),
 
 			createBatchSpecWorkspaceExecutionJobs:              op("CreateBatchSpecWorkspaceExecutionJobs"),
 			createBatchSpecWorkspaceExecutionJobsForWorkspaces: op("CreateBatchSpecWorkspaceExecutionJobsForWorkspaces"),
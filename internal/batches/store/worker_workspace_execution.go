@@ -51,6 +51,9 @@ var batchSpecWorkspaceExecutionWorkerStoreOptions = dbworkerstore.Options[*btype
 	// This view ranks jobs from different users in a round-robin fashion
 	// so that no single user can clog the queue.
 	ViewName: "batch_spec_workspace_execution_jobs_with_rank batch_spec_workspace_execution_jobs",
+	// Share executors between users, so that the jobs of a user with many
+	// workspaces being processed don't block the jobs of other users.
+	FairShareColumn: "user_id",
 }
 
 // NewBatchSpecWorkspaceExecutionWorkerStore creates a dbworker store that
@@ -258,6 +261,12 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 		}
 	}
 
+	// If the workspace was executed again to resolve merge conflicts of the
+	// changesets it produced, the changesets move on to the new specs.
+	if err := tx.ReplaceChangesetSpecs(ctx, workspace.ChangesetSpecIDs, changesetSpecIDs); err != nil {
+		return false, errors.Wrap(err, "replacing changeset specs")
+	}
+
 	if err = s.setChangesetSpecIDs(ctx, tx, job.BatchSpecWorkspaceID, changesetSpecIDs); err != nil {
 		return false, errors.Wrap(err, "setChangesetSpecIDs")
 	}
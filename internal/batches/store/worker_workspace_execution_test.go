@@ -507,6 +507,55 @@ func TestBatchSpecWorkspaceExecutionWorkerStore_Dequeue_RoundRobin(t *testing.T)
 	}
 }
 
+func TestBatchSpecWorkspaceExecutionWorkerStore_Dequeue_FairShare(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(t))
+
+	repo, _ := bt.CreateTestRepo(t, ctx, db)
+
+	s := New(db, &observation.TestContext, nil)
+	workerStore := dbworkerstore.New(&observation.TestContext, s.Handle(), batchSpecWorkspaceExecutionWorkerStoreOptions)
+
+	user1 := bt.CreateTestUser(t, db, true)
+	user2 := bt.CreateTestUser(t, db, true)
+
+	user1BatchSpec := setupUserBatchSpec(t, ctx, s, user1)
+	user2BatchSpec := setupUserBatchSpec(t, ctx, s, user2)
+
+	dequeue := func() int64 {
+		t.Helper()
+		r, found, err := workerStore.Dequeue(ctx, "test-worker", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !found {
+			t.Fatal("no job dequeued")
+		}
+		return int64(r.RecordID())
+	}
+
+	// The first user executes a large batch spec, and takes all executors
+	// while nobody else has queued jobs.
+	var user1Jobs []int64
+	for i := 0; i < 10; i++ {
+		user1Jobs = append(user1Jobs, setupBatchSpecAssociation(ctx, s, t, user1BatchSpec, repo))
+	}
+	have := []int64{dequeue(), dequeue(), dequeue()}
+
+	// The second user executes a small batch spec afterwards. Its jobs are
+	// dequeued before the remaining jobs of the first user, because the first
+	// user already has more jobs being processed.
+	job1 := setupBatchSpecAssociation(ctx, s, t, user2BatchSpec, repo)
+	job2 := setupBatchSpecAssociation(ctx, s, t, user2BatchSpec, repo)
+	have = append(have, dequeue(), dequeue(), dequeue())
+
+	want := []int64{user1Jobs[0], user1Jobs[1], user1Jobs[2], job1, job2, user1Jobs[3]}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
 func TestBatchSpecWorkspaceExecutionWorkerStore_Dequeue_RoundRobin_NoDoubleDequeue(t *testing.T) {
 	logger := logtest.Scoped(t)
 	ctx := context.Background()
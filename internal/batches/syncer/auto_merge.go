@@ -0,0 +1,228 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/batches/types/scheduler/window"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// autoMergeStore is the subset of the store used to evaluate auto-merge
+// policies. It is satisfied by the transaction SyncChangeset updates the
+// changeset in.
+type autoMergeStore interface {
+	Clock() func() time.Time
+	GetBatchChange(ctx context.Context, opts store.GetBatchChangeOpts) (*btypes.BatchChange, error)
+	GetBatchSpec(ctx context.Context, opts store.GetBatchSpecOpts) (*btypes.BatchSpec, error)
+	GetChangesetJob(ctx context.Context, opts store.GetChangesetJobOpts) (*btypes.ChangesetJob, error)
+	CreateChangesetJob(ctx context.Context, cs ...*btypes.ChangesetJob) error
+	GetChangesetAutoMergeDecision(ctx context.Context, changesetID int64) (*btypes.ChangesetAutoMergeDecision, error)
+	UpsertChangesetAutoMergeDecision(ctx context.Context, d *btypes.ChangesetAutoMergeDecision) error
+	CountEnqueuedChangesetAutoMerges(ctx context.Context, batchChangeID int64, since time.Time) (int, error)
+}
+
+// evaluateAutoMerge evaluates the auto-merge policy of the batch change that
+// owns the given changeset, if any, enqueues a merge job if the changeset
+// meets its requirements and records the decision along with the reason for
+// it.
+//
+// stateChanged must be true if the external, review or check state of the
+// changeset changed in the sync that triggered the evaluation. Blocked
+// changesets are only evaluated again once their state changed or the batch
+// change was applied again, while deferred changesets are evaluated on every
+// sync until a merge job is enqueued.
+func evaluateAutoMerge(ctx context.Context, tx autoMergeStore, c *btypes.Changeset, stateChanged bool) error {
+	// Imported changesets are never merged automatically, and merged or
+	// closed changesets keep their last decision.
+	if c.OwnedByBatchChangeID == 0 || (c.ExternalState != btypes.ChangesetExternalStateOpen && c.ExternalState != btypes.ChangesetExternalStateDraft) {
+		return nil
+	}
+
+	batchChange, err := tx.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: c.OwnedByBatchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch change")
+	}
+	if batchChange.Closed() {
+		return nil
+	}
+
+	batchSpec, err := tx.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch spec")
+	}
+	policy := batchSpec.Spec.AutoMerge
+	if policy == nil {
+		return nil
+	}
+
+	previous, err := tx.GetChangesetAutoMergeDecision(ctx, c.ID)
+	if err != nil && err != store.ErrNoResults {
+		return errors.Wrap(err, "getting previous auto-merge decision")
+	}
+
+	if previous != nil && previous.BatchSpecID == batchSpec.ID {
+		switch previous.Outcome {
+		case btypes.ChangesetAutoMergeOutcomeEnqueued:
+			if previous.ChangesetJobID != 0 {
+				job, err := tx.GetChangesetJob(ctx, store.GetChangesetJobOpts{ID: previous.ChangesetJobID})
+				if err != nil && err != store.ErrNoResults {
+					return errors.Wrap(err, "getting merge job")
+				}
+				if job != nil && job.State != btypes.ChangesetJobStateFailed && job.State != btypes.ChangesetJobStateCompleted {
+					// The merge job is still running, there's nothing to
+					// decide until it finished.
+					return nil
+				}
+				if job != nil && job.State == btypes.ChangesetJobStateFailed && !stateChanged {
+					// Don't retry failed merges until something about the
+					// changeset changed.
+					reason := "the merge job failed"
+					if job.FailureMessage != nil {
+						reason = fmt.Sprintf("%s: %s", reason, *job.FailureMessage)
+					}
+					return recordAutoMergeDecision(ctx, tx, c, batchSpec, btypes.ChangesetAutoMergeOutcomeBlocked, reason, 0)
+				}
+			}
+		case btypes.ChangesetAutoMergeOutcomeBlocked:
+			if !stateChanged {
+				return nil
+			}
+		}
+	}
+
+	if ok, reason := meetsAutoMergeRequirements(policy, c); !ok {
+		return recordAutoMergeDecision(ctx, tx, c, batchSpec, btypes.ChangesetAutoMergeOutcomeBlocked, reason, 0)
+	}
+
+	now := tx.Clock()()
+	n, per, err := autoMergeRate(policy, now)
+	if err != nil {
+		return recordAutoMergeDecision(ctx, tx, c, batchSpec, btypes.ChangesetAutoMergeOutcomeBlocked, err.Error(), 0)
+	}
+	switch {
+	case n == 0:
+		return recordAutoMergeDecision(ctx, tx, c, batchSpec, btypes.ChangesetAutoMergeOutcomeDeferred, "the merge window is closed", 0)
+	case n > 0:
+		enqueued, err := tx.CountEnqueuedChangesetAutoMerges(ctx, batchChange.ID, now.Add(-per))
+		if err != nil {
+			return errors.Wrap(err, "counting enqueued auto-merges")
+		}
+		if enqueued >= n {
+			reason := fmt.Sprintf("the rate limit of the merge window (%d per %s) was reached", n, per)
+			return recordAutoMergeDecision(ctx, tx, c, batchSpec, btypes.ChangesetAutoMergeOutcomeDeferred, reason, 0)
+		}
+	}
+
+	bulkGroup, err := store.RandomID()
+	if err != nil {
+		return err
+	}
+	job := &btypes.ChangesetJob{
+		BulkGroup:     bulkGroup,
+		BatchChangeID: batchChange.ID,
+		UserID:        batchChange.LastApplierID,
+		ChangesetID:   c.ID,
+		JobType:       btypes.ChangesetJobTypeMerge,
+		Payload:       &btypes.ChangesetJobMergePayload{Squash: policy.MergeMethod() == batcheslib.AutoMergeMethodSquash},
+		State:         btypes.ChangesetJobStateQueued,
+	}
+	if err := tx.CreateChangesetJob(ctx, job); err != nil {
+		return errors.Wrap(err, "creating merge job")
+	}
+
+	return recordAutoMergeDecision(ctx, tx, c, batchSpec, btypes.ChangesetAutoMergeOutcomeEnqueued, "the changeset meets the requirements of the auto-merge policy", job.ID)
+}
+
+func recordAutoMergeDecision(ctx context.Context, tx autoMergeStore, c *btypes.Changeset, batchSpec *btypes.BatchSpec, outcome btypes.ChangesetAutoMergeOutcome, reason string, jobID int64) error {
+	return tx.UpsertChangesetAutoMergeDecision(ctx, &btypes.ChangesetAutoMergeDecision{
+		ChangesetID:    c.ID,
+		BatchChangeID:  c.OwnedByBatchChangeID,
+		BatchSpecID:    batchSpec.ID,
+		Outcome:        outcome,
+		Reason:         reason,
+		ChangesetJobID: jobID,
+		EvaluatedAt:    tx.Clock()(),
+	})
+}
+
+// meetsAutoMergeRequirements returns whether the changeset meets the check and
+// review requirements of the policy, and the reason if it doesn't.
+func meetsAutoMergeRequirements(policy *batcheslib.AutoMergePolicy, c *btypes.Changeset) (bool, string) {
+	if c.ExternalState != btypes.ChangesetExternalStateOpen {
+		return false, "the changeset is a draft"
+	}
+
+	switch policy.RequiredChecks() {
+	case batcheslib.AutoMergeChecksPassed:
+		if c.ExternalCheckState != btypes.ChangesetCheckStatePassed {
+			return false, fmt.Sprintf("the checks of the changeset are %s, not passed", checkStateDescription(c.ExternalCheckState))
+		}
+	case batcheslib.AutoMergeChecksPassedOrNone:
+		if c.ExternalCheckState != btypes.ChangesetCheckStatePassed && c.ExternalCheckState != btypes.ChangesetCheckStateUnknown {
+			return false, fmt.Sprintf("the checks of the changeset are %s", checkStateDescription(c.ExternalCheckState))
+		}
+	}
+
+	switch policy.RequiredReview() {
+	case batcheslib.AutoMergeReviewApproved:
+		if c.ExternalReviewState != btypes.ChangesetReviewStateApproved {
+			return false, "the changeset is not approved"
+		}
+	case batcheslib.AutoMergeReviewNotRejected:
+		if c.ExternalReviewState == btypes.ChangesetReviewStateChangesRequested {
+			return false, "changes were requested on the changeset"
+		}
+	}
+
+	return true, ""
+}
+
+func checkStateDescription(s btypes.ChangesetCheckState) string {
+	switch s {
+	case btypes.ChangesetCheckStatePending:
+		return "pending"
+	case btypes.ChangesetCheckStateFailed:
+		return "failing"
+	default:
+		return "unknown"
+	}
+}
+
+// autoMergeRate returns the number of merges the merge windows of the policy
+// allow at the given time per the returned duration. The number is -1 if
+// merges aren't rate limited, and 0 if no window is open.
+func autoMergeRate(policy *batcheslib.AutoMergePolicy, now time.Time) (n int, per time.Duration, err error) {
+	if len(policy.Windows) == 0 {
+		return -1, 0, nil
+	}
+
+	windows := make([]*schema.BatchChangeRolloutWindow, 0, len(policy.Windows))
+	for _, w := range policy.Windows {
+		rate := w.Rate
+		// Numbers in batch specs are decoded as floats, but the only numeric
+		// rate is 0.
+		if f, ok := rate.(float64); ok && f == 0 {
+			rate = 0
+		}
+		windows = append(windows, &schema.BatchChangeRolloutWindow{
+			Rate:  rate,
+			Start: w.Start,
+			End:   w.End,
+			Days:  w.Days,
+		})
+	}
+
+	cfg, err := window.NewConfiguration(&windows)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "invalid merge window")
+	}
+
+	n, per = cfg.ScheduleAt(now).Rate()
+	return n, per, nil
+}
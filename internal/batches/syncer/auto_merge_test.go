@@ -0,0 +1,357 @@
+package syncer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestMeetsAutoMergeRequirements(t *testing.T) {
+	open := func(check btypes.ChangesetCheckState, review btypes.ChangesetReviewState) *btypes.Changeset {
+		return &btypes.Changeset{
+			ExternalState:       btypes.ChangesetExternalStateOpen,
+			ExternalCheckState:  check,
+			ExternalReviewState: review,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		policy    batcheslib.AutoMergePolicy
+		changeset *btypes.Changeset
+		wantOK    bool
+		wantWhy   string
+	}{
+		{
+			name:      "defaults met",
+			changeset: open(btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateApproved),
+			wantOK:    true,
+		},
+		{
+			name: "draft",
+			changeset: &btypes.Changeset{
+				ExternalState:       btypes.ChangesetExternalStateDraft,
+				ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+				ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			},
+			wantWhy: "the changeset is a draft",
+		},
+		{
+			name:      "checks pending",
+			changeset: open(btypes.ChangesetCheckStatePending, btypes.ChangesetReviewStateApproved),
+			wantWhy:   "the checks of the changeset are pending, not passed",
+		},
+		{
+			name:      "no checks with passed required",
+			changeset: open(btypes.ChangesetCheckStateUnknown, btypes.ChangesetReviewStateApproved),
+			wantWhy:   "the checks of the changeset are unknown, not passed",
+		},
+		{
+			name:      "no checks with passed-or-none",
+			policy:    batcheslib.AutoMergePolicy{Checks: batcheslib.AutoMergeChecksPassedOrNone},
+			changeset: open(btypes.ChangesetCheckStateUnknown, btypes.ChangesetReviewStateApproved),
+			wantOK:    true,
+		},
+		{
+			name:      "failing checks with passed-or-none",
+			policy:    batcheslib.AutoMergePolicy{Checks: batcheslib.AutoMergeChecksPassedOrNone},
+			changeset: open(btypes.ChangesetCheckStateFailed, btypes.ChangesetReviewStateApproved),
+			wantWhy:   "the checks of the changeset are failing",
+		},
+		{
+			name:      "failing checks with any",
+			policy:    batcheslib.AutoMergePolicy{Checks: batcheslib.AutoMergeChecksAny},
+			changeset: open(btypes.ChangesetCheckStateFailed, btypes.ChangesetReviewStateApproved),
+			wantOK:    true,
+		},
+		{
+			name:      "not approved",
+			changeset: open(btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStatePending),
+			wantWhy:   "the changeset is not approved",
+		},
+		{
+			name:      "pending review with not-rejected",
+			policy:    batcheslib.AutoMergePolicy{Review: batcheslib.AutoMergeReviewNotRejected},
+			changeset: open(btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStatePending),
+			wantOK:    true,
+		},
+		{
+			name:      "changes requested with not-rejected",
+			policy:    batcheslib.AutoMergePolicy{Review: batcheslib.AutoMergeReviewNotRejected},
+			changeset: open(btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateChangesRequested),
+			wantWhy:   "changes were requested on the changeset",
+		},
+		{
+			name:      "changes requested with any",
+			policy:    batcheslib.AutoMergePolicy{Review: batcheslib.AutoMergeReviewAny},
+			changeset: open(btypes.ChangesetCheckStatePassed, btypes.ChangesetReviewStateChangesRequested),
+			wantOK:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, why := meetsAutoMergeRequirements(&tc.policy, tc.changeset)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.wantWhy, why)
+		})
+	}
+}
+
+func TestAutoMergeRate(t *testing.T) {
+	// A Monday.
+	monday := time.Date(2023, 12, 4, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		windows []batcheslib.AutoMergeWindow
+		at      time.Time
+		wantN   int
+		wantPer time.Duration
+		wantErr bool
+	}{
+		{
+			name:  "no windows",
+			at:    monday,
+			wantN: -1,
+		},
+		{
+			name:    "inside window",
+			windows: []batcheslib.AutoMergeWindow{{Rate: "5/hour", Start: "09:00", End: "17:00", Days: []string{"monday"}}},
+			at:      monday,
+			wantN:   5,
+			wantPer: time.Hour,
+		},
+		{
+			name:    "outside window",
+			windows: []batcheslib.AutoMergeWindow{{Rate: "5/hour", Start: "09:00", End: "17:00", Days: []string{"monday"}}},
+			at:      monday.Add(8 * time.Hour),
+			wantN:   0,
+		},
+		{
+			name:    "unlimited window",
+			windows: []batcheslib.AutoMergeWindow{{Rate: "unlimited"}},
+			at:      monday,
+			wantN:   -1,
+		},
+		{
+			name:    "zero rate decoded from a batch spec",
+			windows: []batcheslib.AutoMergeWindow{{Rate: float64(0)}},
+			at:      monday,
+			wantN:   0,
+		},
+		{
+			name:    "invalid window",
+			windows: []batcheslib.AutoMergeWindow{{Rate: "often"}},
+			at:      monday,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			n, per, err := autoMergeRate(&batcheslib.AutoMergePolicy{Windows: tc.windows}, tc.at)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantN, n)
+			assert.Equal(t, tc.wantPer, per)
+		})
+	}
+}
+
+func TestEvaluateAutoMerge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 12, 4, 10, 0, 0, 0, time.UTC)
+
+	newChangeset := func() *btypes.Changeset {
+		return &btypes.Changeset{
+			ID:                   1,
+			OwnedByBatchChangeID: 2,
+			ExternalState:        btypes.ChangesetExternalStateOpen,
+			ExternalCheckState:   btypes.ChangesetCheckStatePassed,
+			ExternalReviewState:  btypes.ChangesetReviewStateApproved,
+		}
+	}
+
+	t.Run("enqueues a merge job", func(t *testing.T) {
+		s := newFakeAutoMergeStore(now, &batcheslib.AutoMergePolicy{Method: batcheslib.AutoMergeMethodSquash})
+
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), true))
+		if assert.Len(t, s.jobs, 1) {
+			job := s.jobs[0]
+			assert.Equal(t, btypes.ChangesetJobTypeMerge, job.JobType)
+			assert.Equal(t, &btypes.ChangesetJobMergePayload{Squash: true}, job.Payload)
+			assert.Equal(t, int32(3), job.UserID)
+			assert.Equal(t, btypes.ChangesetJobStateQueued, job.State)
+			assert.NotEmpty(t, job.BulkGroup)
+		}
+		assert.Equal(t, &btypes.ChangesetAutoMergeDecision{
+			ChangesetID:    1,
+			BatchChangeID:  2,
+			BatchSpecID:    4,
+			Outcome:        btypes.ChangesetAutoMergeOutcomeEnqueued,
+			Reason:         "the changeset meets the requirements of the auto-merge policy",
+			ChangesetJobID: s.jobs[0].ID,
+			EvaluatedAt:    now,
+		}, s.decisions[1])
+
+		// The job is still queued, so syncing again doesn't enqueue another one.
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), false))
+		assert.Len(t, s.jobs, 1)
+	})
+
+	t.Run("records why a changeset is blocked", func(t *testing.T) {
+		s := newFakeAutoMergeStore(now, &batcheslib.AutoMergePolicy{})
+		c := newChangeset()
+		c.ExternalReviewState = btypes.ChangesetReviewStatePending
+
+		assert.NoError(t, evaluateAutoMerge(ctx, s, c, true))
+		assert.Empty(t, s.jobs)
+		assert.Equal(t, btypes.ChangesetAutoMergeOutcomeBlocked, s.decisions[1].Outcome)
+		assert.Equal(t, "the changeset is not approved", s.decisions[1].Reason)
+
+		// Once approved, the changeset is merged.
+		c.ExternalReviewState = btypes.ChangesetReviewStateApproved
+		assert.NoError(t, evaluateAutoMerge(ctx, s, c, true))
+		assert.Len(t, s.jobs, 1)
+		assert.Equal(t, btypes.ChangesetAutoMergeOutcomeEnqueued, s.decisions[1].Outcome)
+	})
+
+	t.Run("defers outside of the merge window", func(t *testing.T) {
+		s := newFakeAutoMergeStore(now, &batcheslib.AutoMergePolicy{
+			Windows: []batcheslib.AutoMergeWindow{{Rate: "unlimited", Start: "12:00", End: "14:00"}},
+		})
+
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), true))
+		assert.Empty(t, s.jobs)
+		assert.Equal(t, btypes.ChangesetAutoMergeOutcomeDeferred, s.decisions[1].Outcome)
+		assert.Equal(t, "the merge window is closed", s.decisions[1].Reason)
+
+		// Deferred changesets are evaluated again even if nothing changed.
+		s.now = now.Add(3 * time.Hour)
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), false))
+		assert.Len(t, s.jobs, 1)
+	})
+
+	t.Run("defers once the rate limit is reached", func(t *testing.T) {
+		s := newFakeAutoMergeStore(now, &batcheslib.AutoMergePolicy{
+			Windows: []batcheslib.AutoMergeWindow{{Rate: "1/hour"}},
+		})
+
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), true))
+		other := newChangeset()
+		other.ID = 5
+		assert.NoError(t, evaluateAutoMerge(ctx, s, other, true))
+
+		assert.Len(t, s.jobs, 1)
+		assert.Equal(t, btypes.ChangesetAutoMergeOutcomeDeferred, s.decisions[5].Outcome)
+		assert.Equal(t, "the rate limit of the merge window (1 per 1h0m0s) was reached", s.decisions[5].Reason)
+	})
+
+	t.Run("does not retry failed merges until the changeset changed", func(t *testing.T) {
+		s := newFakeAutoMergeStore(now, &batcheslib.AutoMergePolicy{})
+
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), true))
+		failure := "merge conflict"
+		s.jobs[0].State = btypes.ChangesetJobStateFailed
+		s.jobs[0].FailureMessage = &failure
+
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), false))
+		assert.Len(t, s.jobs, 1)
+		assert.Equal(t, btypes.ChangesetAutoMergeOutcomeBlocked, s.decisions[1].Outcome)
+		assert.Equal(t, "the merge job failed: merge conflict", s.decisions[1].Reason)
+
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), true))
+		assert.Len(t, s.jobs, 2)
+	})
+
+	t.Run("ignores imported changesets and batch changes without a policy", func(t *testing.T) {
+		s := newFakeAutoMergeStore(now, &batcheslib.AutoMergePolicy{})
+		c := newChangeset()
+		c.OwnedByBatchChangeID = 0
+		assert.NoError(t, evaluateAutoMerge(ctx, s, c, true))
+
+		s = newFakeAutoMergeStore(now, nil)
+		assert.NoError(t, evaluateAutoMerge(ctx, s, newChangeset(), true))
+		assert.Empty(t, s.jobs)
+		assert.Empty(t, s.decisions)
+	})
+}
+
+type fakeAutoMergeStore struct {
+	now         time.Time
+	batchChange *btypes.BatchChange
+	batchSpec   *btypes.BatchSpec
+	jobs        []*btypes.ChangesetJob
+	decisions   map[int64]*btypes.ChangesetAutoMergeDecision
+}
+
+var _ autoMergeStore = &fakeAutoMergeStore{}
+
+func newFakeAutoMergeStore(now time.Time, policy *batcheslib.AutoMergePolicy) *fakeAutoMergeStore {
+	return &fakeAutoMergeStore{
+		now:         now,
+		batchChange: &btypes.BatchChange{ID: 2, LastApplierID: 3, BatchSpecID: 4},
+		batchSpec:   &btypes.BatchSpec{ID: 4, Spec: &batcheslib.BatchSpec{AutoMerge: policy}},
+		decisions:   map[int64]*btypes.ChangesetAutoMergeDecision{},
+	}
+}
+
+func (s *fakeAutoMergeStore) Clock() func() time.Time {
+	return func() time.Time { return s.now }
+}
+
+func (s *fakeAutoMergeStore) GetBatchChange(_ context.Context, _ store.GetBatchChangeOpts) (*btypes.BatchChange, error) {
+	return s.batchChange, nil
+}
+
+func (s *fakeAutoMergeStore) GetBatchSpec(_ context.Context, _ store.GetBatchSpecOpts) (*btypes.BatchSpec, error) {
+	return s.batchSpec, nil
+}
+
+func (s *fakeAutoMergeStore) GetChangesetJob(_ context.Context, opts store.GetChangesetJobOpts) (*btypes.ChangesetJob, error) {
+	for _, job := range s.jobs {
+		if job.ID == opts.ID {
+			return job, nil
+		}
+	}
+	return nil, store.ErrNoResults
+}
+
+func (s *fakeAutoMergeStore) CreateChangesetJob(_ context.Context, jobs ...*btypes.ChangesetJob) error {
+	for _, job := range jobs {
+		job.ID = int64(len(s.jobs) + 1)
+		s.jobs = append(s.jobs, job)
+	}
+	return nil
+}
+
+func (s *fakeAutoMergeStore) GetChangesetAutoMergeDecision(_ context.Context, changesetID int64) (*btypes.ChangesetAutoMergeDecision, error) {
+	if d, ok := s.decisions[changesetID]; ok {
+		return d, nil
+	}
+	return nil, store.ErrNoResults
+}
+
+func (s *fakeAutoMergeStore) UpsertChangesetAutoMergeDecision(_ context.Context, d *btypes.ChangesetAutoMergeDecision) error {
+	s.decisions[d.ChangesetID] = d
+	return nil
+}
+
+func (s *fakeAutoMergeStore) CountEnqueuedChangesetAutoMerges(_ context.Context, batchChangeID int64, since time.Time) (count int, _ error) {
+	for _, d := range s.decisions {
+		if d.BatchChangeID == batchChangeID && d.Outcome == btypes.ChangesetAutoMergeOutcomeEnqueued && !d.EvaluatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/batches/global"
 	"github.com/sourcegraph/sourcegraph/internal/batches/sources"
 	"github.com/sourcegraph/sourcegraph/internal/batches/state"
 	"github.com/sourcegraph/sourcegraph/internal/batches/store"
@@ -504,6 +505,12 @@ func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64) error {
 // SyncChangeset refreshes the metadata of the given changeset and
 // updates them in the database.
 func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Client, source sources.ChangesetSource, repo *types.Repo, c *btypes.Changeset) (err error) {
+	// Remember whether the code host reported merge conflicts before the
+	// sync, so that the changeset is only reconciled again when conflicts
+	// appear or the base branch moved while they persist.
+	wasConflicting := c.Conflicting()
+	previousBaseRefOid, _ := c.BaseRefOid()
+
 	repoChangeset := &sources.Changeset{TargetRepo: repo, Changeset: c}
 	if err := source.LoadChangeset(ctx, repoChangeset); err != nil {
 		if !errors.HasType(err, sources.ChangesetNotFoundError{}) {
@@ -525,7 +532,13 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 	if err != nil {
 		return err
 	}
+	// Remember the derived state before the sync, so that the auto-merge
+	// policy of the batch change is evaluated again when it changes.
+	previousExternalState, previousReviewState, previousCheckState := c.ExternalState, c.ExternalReviewState, c.ExternalCheckState
 	state.SetDerivedState(ctx, syncStore.Repos(), client, c, events)
+	stateChanged := c.ExternalState != previousExternalState ||
+		c.ExternalReviewState != previousReviewState ||
+		c.ExternalCheckState != previousCheckState
 
 	tx, err := syncStore.Transact(ctx)
 	if err != nil {
@@ -541,5 +554,28 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, client gitserver.Cl
 		return err
 	}
 
-	return tx.UpsertChangesetEvents(ctx, events...)
+	if err := tx.UpsertChangesetEvents(ctx, events...); err != nil {
+		return err
+	}
+
+	if c.NeedsRebase() {
+		baseRefOid, _ := c.BaseRefOid()
+		if !wasConflicting || baseRefOid != previousBaseRefOid {
+			// The reconciler tries to rebase the changeset. If it is being
+			// reconciled already, it syncs the changeset afterwards anyway.
+			if _, err := tx.EnqueueCompletedChangeset(ctx, c, global.DefaultReconcilerEnqueueState()); err != nil {
+				return errors.Wrap(err, "enqueueing conflicting changeset")
+			}
+		}
+	}
+
+	if c.OwnedByBatchChangeID != 0 && !c.ExternalState.BlocksRollout() && previousExternalState.BlocksRollout() {
+		// Merging or closing the changeset can unblock the publication of the
+		// changesets in higher rollout tiers of its batch change.
+		if _, err := tx.EnqueueRolloutBlockedChangesets(ctx, c.OwnedByBatchChangeID, global.DefaultReconcilerEnqueueState()); err != nil {
+			return errors.Wrap(err, "enqueueing changesets blocked by rollout")
+		}
+	}
+
+	return evaluateAutoMerge(ctx, tx, c, stateChanged)
 }
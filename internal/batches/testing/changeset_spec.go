@@ -39,6 +39,13 @@ type TestSpecOpts struct {
 	BaseRev string
 	BaseRef string
 
+	Labels    []string
+	Reviewers []string
+	Assignees []string
+	Milestone string
+
+	RolloutTier int
+
 	Typ btypes.ChangesetSpecType
 }
 
@@ -72,6 +79,11 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 		Diff:              opts.CommitDiff,
 		CommitAuthorEmail: opts.CommitAuthorEmail,
 		CommitAuthorName:  opts.CommitAuthorName,
+		Labels:            opts.Labels,
+		Reviewers:         opts.Reviewers,
+		Assignees:         opts.Assignees,
+		Milestone:         opts.Milestone,
+		RolloutTier:       opts.RolloutTier,
 		DiffStatAdded:     TestChangsetSpecDiffStat.Added,
 		DiffStatDeleted:   TestChangsetSpecDiffStat.Deleted,
 		Type:              opts.Typ,
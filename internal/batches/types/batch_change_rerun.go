@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// BatchChangeRerunState defines the possible states of a BatchChangeRerun.
+type BatchChangeRerunState string
+
+// BatchChangeRerunState constants.
+const (
+	// BatchChangeRerunStateProcessing means that the workspaces of the rerun
+	// are being resolved or executed.
+	BatchChangeRerunStateProcessing BatchChangeRerunState = "PROCESSING"
+	// BatchChangeRerunStateApplied means that the batch spec of the rerun was
+	// applied to the batch change.
+	BatchChangeRerunStateApplied BatchChangeRerunState = "APPLIED"
+	// BatchChangeRerunStateFailed means that the rerun was given up on, and the
+	// batch change was left untouched.
+	BatchChangeRerunStateFailed BatchChangeRerunState = "FAILED"
+)
+
+// Valid returns true if the given BatchChangeRerunState is valid.
+func (s BatchChangeRerunState) Valid() bool {
+	switch s {
+	case BatchChangeRerunStateProcessing,
+		BatchChangeRerunStateApplied,
+		BatchChangeRerunStateFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// BatchChangeRerun is a scheduled run of the batch spec of a batch change,
+// which resolves its workspaces again and applies the result to the batch
+// change.
+type BatchChangeRerun struct {
+	ID            int64
+	BatchChangeID int64
+	// BatchSpecID is the batch spec created for the rerun. It is 0 once the
+	// batch spec was deleted.
+	BatchSpecID int64
+	// ScheduledAt is the schedule time the rerun was started for.
+	ScheduledAt    time.Time
+	State          BatchChangeRerunState
+	FailureMessage string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ParseRerunSchedule parses the cron expression of the rerun policy of a
+// batch spec.
+func ParseRerunSchedule(schedule string) (*cronexpr.Expression, error) {
+	expr, err := cronexpr.Parse(schedule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid rerun schedule %q", schedule)
+	}
+	if expr.Next(time.Now().UTC()).IsZero() {
+		return nil, errors.Newf("rerun schedule %q has no upcoming times", schedule)
+	}
+	return expr, nil
+}
@@ -13,6 +13,11 @@ func NewBatchSpecFromRaw(rawSpec string) (_ *BatchSpec, err error) {
 	c := &BatchSpec{RawSpec: rawSpec}
 
 	c.Spec, err = batcheslib.ParseBatchSpec([]byte(rawSpec))
+	if err == nil && c.Spec.Rerun != nil {
+		if _, err := ParseRerunSchedule(c.Spec.Rerun.Schedule); err != nil {
+			return c, batcheslib.NewValidationError(err)
+		}
+	}
 
 	return c, err
 }
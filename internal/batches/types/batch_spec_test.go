@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -168,3 +169,25 @@ func TestComputeBatchSpecState(t *testing.T) {
 		}
 	}
 }
+
+func TestNewBatchSpecFromRaw_RerunSchedule(t *testing.T) {
+	const rawSpec = `
+name: test-spec
+on:
+  - repositoriesMatchingQuery: file:README.md
+rerun:
+  schedule: %q
+`
+
+	for schedule, wantErr := range map[string]bool{
+		"0 6 * * 1":   false,
+		"@daily":      false,
+		"every day":   true,
+		"0 0 30 2 * ": true,
+	} {
+		_, err := NewBatchSpecFromRaw(fmt.Sprintf(rawSpec, schedule))
+		if have := err != nil; have != wantErr {
+			t.Errorf("schedule %q: unexpected error: %v", schedule, err)
+		}
+	}
+}
@@ -1008,6 +1008,33 @@ func (c *Changeset) BaseRef() (string, error) {
 	}
 }
 
+// Conflicting returns true if the code host reports that the changeset has
+// merge conflicts with its base branch. Code hosts that don't report
+// mergeability never have conflicting changesets.
+func (c *Changeset) Conflicting() bool {
+	switch m := c.Metadata.(type) {
+	case *github.PullRequest:
+		return m.Mergeable == "CONFLICTING"
+	case *gitlab.MergeRequest:
+		return m.HasConflicts
+	default:
+		return false
+	}
+}
+
+// NeedsRebase returns true if the changeset is a published, open changeset
+// owned by a batch change that has merge conflicts with its base branch, and
+// the reconciler should therefore try to rebase it.
+func (c *Changeset) NeedsRebase() bool {
+	if c.OwnedByBatchChangeID == 0 || !c.Published() {
+		return false
+	}
+	if c.ExternalState != ChangesetExternalStateOpen && c.ExternalState != ChangesetExternalStateDraft {
+		return false
+	}
+	return c.Conflicting()
+}
+
 // AttachedTo returns true if the changeset is currently attached to the batch
 // change with the given batchChangeID.
 func (c *Changeset) AttachedTo(batchChangeID int64) bool {
@@ -1546,6 +1573,8 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 			ChangesetEventKindGerritChangeBuildSucceeded:
 			return new(gerrit.Reviewer), nil
 		}
+	case k == ChangesetEventKindBatchesRebaseAttempt:
+		return new(ChangesetRebaseAttempt), nil
 	}
 	return nil, errors.Errorf("changeset event metadata unknown changeset event kind %q", k)
 }
@@ -0,0 +1,48 @@
+package types
+
+import "time"
+
+// ChangesetAutoMergeOutcome is the outcome of evaluating the auto-merge policy
+// of a batch change against one of its changesets.
+type ChangesetAutoMergeOutcome string
+
+// ChangesetAutoMergeOutcome constants.
+const (
+	// ChangesetAutoMergeOutcomeEnqueued means that a merge job was enqueued
+	// for the changeset.
+	ChangesetAutoMergeOutcomeEnqueued ChangesetAutoMergeOutcome = "ENQUEUED"
+	// ChangesetAutoMergeOutcomeBlocked means that the changeset does not meet
+	// the requirements of the policy.
+	ChangesetAutoMergeOutcomeBlocked ChangesetAutoMergeOutcome = "BLOCKED"
+	// ChangesetAutoMergeOutcomeDeferred means that the changeset meets the
+	// requirements of the policy, but the merge window is closed or its rate
+	// limit was reached. The changeset is evaluated again on its next sync.
+	ChangesetAutoMergeOutcomeDeferred ChangesetAutoMergeOutcome = "DEFERRED"
+)
+
+// Valid returns true if the given ChangesetAutoMergeOutcome is valid.
+func (o ChangesetAutoMergeOutcome) Valid() bool {
+	switch o {
+	case ChangesetAutoMergeOutcomeEnqueued,
+		ChangesetAutoMergeOutcomeBlocked,
+		ChangesetAutoMergeOutcomeDeferred:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChangesetAutoMergeDecision records the latest decision of the auto-merge
+// policy of a batch change on one of its changesets, and why it was made.
+type ChangesetAutoMergeDecision struct {
+	ChangesetID   int64
+	BatchChangeID int64
+	// BatchSpecID is the batch spec declaring the policy the decision was
+	// made with.
+	BatchSpecID int64
+	Outcome     ChangesetAutoMergeOutcome
+	Reason      string
+	// ChangesetJobID is the merge job enqueued by the decision, if any.
+	ChangesetJobID int64
+	EvaluatedAt    time.Time
+}
@@ -125,6 +125,11 @@ const (
 	ChangesetEventKindGerritChangeBuildFailed             ChangesetEventKind = "gerrit:change:build_failed"
 	ChangesetEventKindGerritChangeBuildPending            ChangesetEventKind = "gerrit:change:build_pending"
 
+	// ChangesetEventKindBatchesRebaseAttempt records an attempt of the
+	// reconciler to resolve the merge conflicts of a changeset. Its metadata
+	// is a *ChangesetRebaseAttempt.
+	ChangesetEventKindBatchesRebaseAttempt ChangesetEventKind = "batches:rebase_attempt"
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
@@ -370,6 +375,8 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = ev.CreatedDate
 	case *azuredevops.PullRequestMergedEvent:
 		t = ev.CreatedDate
+	case *ChangesetRebaseAttempt:
+		t = ev.CreatedAt
 	}
 
 	return t
@@ -913,6 +920,9 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 	case *azuredevops.PullRequestRejectedEvent:
 		o := o.Metadata.(*azuredevops.PullRequestRejectedEvent)
 		*e = *o
+	case *ChangesetRebaseAttempt:
+		o := o.Metadata.(*ChangesetRebaseAttempt)
+		*e = *o
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
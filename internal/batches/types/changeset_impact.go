@@ -0,0 +1,101 @@
+package types
+
+import "time"
+
+// ChangesetImpactMetrics are the impact metrics of a single published
+// changeset, computed from its changeset events.
+type ChangesetImpactMetrics struct {
+	ChangesetID int64
+	// CodeHost is the type of the code host of the changeset.
+	CodeHost string
+	// Team is the team owning most of the files changed by the changeset, or
+	// empty if there is no owning team.
+	Team     string
+	OpenedAt time.Time
+	// MergedAt is the time the changeset was merged, or zero if it isn't
+	// merged or the merge time isn't known.
+	MergedAt time.Time
+	// FirstReviewAt is the time of the first review of the changeset, or zero
+	// if it wasn't reviewed yet.
+	FirstReviewAt time.Time
+	// CheckRuns is the number of finished check runs, commit statuses,
+	// pipelines and builds of the changeset.
+	CheckRuns       int32
+	FailedCheckRuns int32
+	ComputedAt      time.Time
+}
+
+// TimeToMerge returns how long it took to merge the changeset, and false if
+// it isn't merged.
+func (m *ChangesetImpactMetrics) TimeToMerge() (time.Duration, bool) {
+	if m.MergedAt.IsZero() {
+		return 0, false
+	}
+	return m.MergedAt.Sub(m.OpenedAt), true
+}
+
+// ReviewLatency returns how long it took until the changeset was first
+// reviewed, and false if it wasn't reviewed yet.
+func (m *ChangesetImpactMetrics) ReviewLatency() (time.Duration, bool) {
+	if m.FirstReviewAt.IsZero() {
+		return 0, false
+	}
+	return m.FirstReviewAt.Sub(m.OpenedAt), true
+}
+
+// ImpactDimension is what BatchChangeImpactStats are broken down by.
+type ImpactDimension string
+
+// ImpactDimension constants.
+const (
+	// ImpactDimensionAll are the stats of all changesets of a batch change.
+	ImpactDimensionAll ImpactDimension = "all"
+	// ImpactDimensionCodeHost are the stats per code host type.
+	ImpactDimensionCodeHost ImpactDimension = "code_host"
+	// ImpactDimensionTeam are the stats per owning team.
+	ImpactDimensionTeam ImpactDimension = "team"
+)
+
+// TimeToMergeBucketBounds are the upper bounds of the time to merge buckets of
+// BatchChangeImpactStats. The last bucket counts the changesets that took
+// longer than the last bound.
+var TimeToMergeBucketBounds = []time.Duration{
+	time.Hour,
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+}
+
+// BatchChangeImpactStats are the aggregated impact metrics of the published
+// changesets of a batch change, for one value of a dimension.
+type BatchChangeImpactStats struct {
+	BatchChangeID int64
+	Dimension     ImpactDimension
+	// Value is the code host type or team the stats are for. It's empty for
+	// ImpactDimensionAll and for changesets without an owning team.
+	Value      string
+	Changesets int32
+	Merged     int32
+	Reviewed   int32
+	// The percentiles are only set if Merged and Reviewed respectively are
+	// greater than zero.
+	TimeToMergeP50 time.Duration
+	TimeToMergeP90 time.Duration
+	// TimeToMergeBuckets holds the number of merged changesets per bucket of
+	// TimeToMergeBucketBounds.
+	TimeToMergeBuckets []int32
+	ReviewLatencyP50   time.Duration
+	ReviewLatencyP90   time.Duration
+	CheckRuns          int32
+	FailedCheckRuns    int32
+	ComputedAt         time.Time
+}
+
+// CIFailureRate returns the share of the finished check runs that failed, or
+// 0 if there are none.
+func (s *BatchChangeImpactStats) CIFailureRate() float64 {
+	if s.CheckRuns == 0 {
+		return 0
+	}
+	return float64(s.FailedCheckRuns) / float64(s.CheckRuns)
+}
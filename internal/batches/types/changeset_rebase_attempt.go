@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxChangesetRebaseAttempts is the number of times the reconciler tries to
+// resolve the merge conflicts of a changeset before it gives up, for each
+// changeset spec. Applying a new changeset spec starts over.
+const MaxChangesetRebaseAttempts = 3
+
+// ChangesetRebaseOutcome is the outcome of an attempt of the reconciler to
+// resolve the merge conflicts of a changeset with its base branch.
+type ChangesetRebaseOutcome string
+
+// ChangesetRebaseOutcome constants.
+const (
+	// ChangesetRebaseOutcomeRebased means that the diff of the changeset spec
+	// applied cleanly to the new base commit and was force-pushed to the
+	// changeset branch.
+	ChangesetRebaseOutcomeRebased ChangesetRebaseOutcome = "REBASED"
+	// ChangesetRebaseOutcomeReexecuting means that the diff didn't apply and
+	// the workspace that produced the changeset is executed again on top of
+	// the new base commit.
+	ChangesetRebaseOutcomeReexecuting ChangesetRebaseOutcome = "REEXECUTING"
+	// ChangesetRebaseOutcomeFailed means that the diff didn't apply and the
+	// steps could not be executed again.
+	ChangesetRebaseOutcomeFailed ChangesetRebaseOutcome = "FAILED"
+)
+
+// ChangesetRebaseAttempt is the metadata of a changeset event of kind
+// ChangesetEventKindBatchesRebaseAttempt. Together, the events of a changeset
+// form the history of the attempts to resolve its merge conflicts.
+type ChangesetRebaseAttempt struct {
+	// Attempt counts the attempts for ChangesetSpecID, starting at 1.
+	Attempt         int
+	ChangesetSpecID int64
+	BaseRef         string
+	// BaseRev is the commit of the base branch the changeset was rebased on.
+	BaseRev string
+	Outcome ChangesetRebaseOutcome
+	// BatchSpecWorkspaceID is the workspace that is executed again, if the
+	// outcome is ChangesetRebaseOutcomeReexecuting.
+	BatchSpecWorkspaceID int64  `json:",omitempty"`
+	Message              string `json:",omitempty"`
+	CreatedAt            time.Time
+}
+
+// Key returns the deduplication key of the changeset event recording the
+// attempt. Every base commit is only attempted once per changeset spec.
+func (a *ChangesetRebaseAttempt) Key() string {
+	return fmt.Sprintf("%d:%s", a.ChangesetSpecID, a.BaseRev)
+}
@@ -0,0 +1,31 @@
+package types
+
+// ChangesetRolloutBlockers describes the changesets of a batch change that
+// block the publication of a changeset in a higher rollout tier: every
+// changeset in a lower tier that blocks the rollout.
+type ChangesetRolloutBlockers struct {
+	// Tier is the lowest rollout tier with changesets that block the rollout.
+	Tier int
+	// Count is the number of changesets in lower tiers that block the rollout.
+	Count int
+}
+
+// Blocked returns whether any changesets block the publication.
+func (b *ChangesetRolloutBlockers) Blocked() bool {
+	return b.Count > 0
+}
+
+// BlocksRollout returns whether a changeset in the given external state blocks
+// the publication of the changesets in higher rollout tiers. Merged changesets
+// don't, and neither do closed and read-only ones, which would otherwise block
+// the rollout until they are archived.
+func (s ChangesetExternalState) BlocksRollout() bool {
+	switch s {
+	case ChangesetExternalStateMerged,
+		ChangesetExternalStateClosed,
+		ChangesetExternalStateReadOnly:
+		return false
+	default:
+		return true
+	}
+}
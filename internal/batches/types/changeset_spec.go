@@ -36,6 +36,10 @@ func NewChangesetSpecFromSpec(spec *batcheslib.ChangesetSpec) (*ChangesetSpec, e
 		Title:      spec.Title,
 		Body:       spec.Body,
 		Published:  spec.Published,
+		Labels:     spec.Labels,
+		Reviewers:  spec.Reviewers,
+		Assignees:  spec.Assignees,
+		Milestone:  spec.Milestone,
 	}
 
 	if spec.IsImportingExisting() {
@@ -115,6 +119,18 @@ type ChangesetSpec struct {
 	CommitAuthorName  string
 	CommitAuthorEmail string
 
+	// Labels, Reviewers, Assignees and Milestone are applied to the changeset
+	// on code hosts that support them.
+	Labels    []string
+	Reviewers []string
+	Assignees []string
+	Milestone string
+
+	// RolloutTier is the rollout tier of the changeset, computed from the batch
+	// spec when it is applied. The changeset is only published once all
+	// changesets of the batch change in lower tiers are merged.
+	RolloutTier int
+
 	ForkNamespace *string
 }
 
@@ -731,6 +731,70 @@ func TestChangeset_BaseRef(t *testing.T) {
 	})
 }
 
+func TestChangeset_NeedsRebase(t *testing.T) {
+	conflicting := func(cs *Changeset) *Changeset {
+		if cs.OwnedByBatchChangeID == 0 {
+			cs.OwnedByBatchChangeID = 1
+		}
+		if cs.PublicationState == "" {
+			cs.PublicationState = ChangesetPublicationStatePublished
+		}
+		if cs.ExternalState == "" {
+			cs.ExternalState = ChangesetExternalStateOpen
+		}
+		if cs.Metadata == nil {
+			cs.Metadata = &github.PullRequest{Mergeable: "CONFLICTING"}
+		}
+		return cs
+	}
+
+	for name, tc := range map[string]struct {
+		changeset *Changeset
+		want      bool
+	}{
+		"conflicting GitHub pull request": {
+			changeset: conflicting(&Changeset{}),
+			want:      true,
+		},
+		"conflicting GitLab merge request": {
+			changeset: conflicting(&Changeset{Metadata: &gitlab.MergeRequest{HasConflicts: true}}),
+			want:      true,
+		},
+		"draft": {
+			changeset: conflicting(&Changeset{ExternalState: ChangesetExternalStateDraft}),
+			want:      true,
+		},
+		"mergeability unknown": {
+			changeset: conflicting(&Changeset{Metadata: &github.PullRequest{Mergeable: "UNKNOWN"}}),
+		},
+		"mergeable GitLab merge request": {
+			changeset: conflicting(&Changeset{Metadata: &gitlab.MergeRequest{}}),
+		},
+		"code host without mergeability": {
+			changeset: conflicting(&Changeset{Metadata: &bitbucketserver.PullRequest{}}),
+		},
+		"merged": {
+			changeset: conflicting(&Changeset{ExternalState: ChangesetExternalStateMerged}),
+		},
+		"unpublished": {
+			changeset: conflicting(&Changeset{PublicationState: ChangesetPublicationStateUnpublished}),
+		},
+		"imported": {
+			changeset: func() *Changeset {
+				cs := conflicting(&Changeset{})
+				cs.OwnedByBatchChangeID = 0
+				return cs
+			}(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := tc.changeset.NeedsRebase(); have != tc.want {
+				t.Errorf("unexpected result: have %v; want %v", have, tc.want)
+			}
+		})
+	}
+}
+
 func TestChangeset_Labels(t *testing.T) {
 	for name, tc := range map[string]struct {
 		meta any
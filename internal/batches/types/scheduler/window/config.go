@@ -84,14 +84,21 @@ func (cfg *Configuration) HasRolloutWindows() bool {
 
 // Schedule returns the currently active schedule.
 func (cfg *Configuration) Schedule() *Schedule {
+	return cfg.ScheduleAt(time.Now())
+}
+
+// ScheduleAt returns the schedule active at the given time. Schedules that are
+// used to Take() events must be constructed with a monotonic time, so this
+// should only be used with other times to inspect the rate in effect.
+func (cfg *Configuration) ScheduleAt(at time.Time) *Schedule {
 	// If there are no rollout windows, then we return an unlimited schedule and
 	// have the scheduler check back in periodically in case the configuration
 	// updated. Ten minutes is probably safe enough.
 	if !cfg.HasRolloutWindows() {
-		return newSchedule(time.Now(), 10*time.Minute, rate{n: -1})
+		return newSchedule(at, 10*time.Minute, rate{n: -1})
 	}
 
-	return cfg.scheduleAt(time.Now())
+	return cfg.scheduleAt(at)
 }
 
 // windowFor returns the rollout window for the given time, if any, and the
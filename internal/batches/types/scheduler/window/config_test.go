@@ -197,6 +197,36 @@ func TestConfiguration_Schedule(t *testing.T) {
 	}
 }
 
+func TestConfiguration_ScheduleAt(t *testing.T) {
+	start := timeOfDayFromParts(8, 0)
+	end := timeOfDayFromParts(18, 0)
+	cfg := &Configuration{
+		windows: []Window{
+			{
+				days:  newWeekdaySet(time.Saturday, time.Sunday),
+				rate:  rate{n: 10, unit: ratePerHour},
+				start: &start,
+				end:   &end,
+			},
+		},
+	}
+
+	// Saturday, 2 December 2023.
+	open := time.Date(2023, 12, 2, 12, 0, 0, 0, time.UTC)
+	if n, per := cfg.ScheduleAt(open).Rate(); n != 10 || per != time.Hour {
+		t.Errorf("unexpected rate within the window: have=%d/%v", n, per)
+	}
+
+	closed := time.Date(2023, 12, 2, 20, 0, 0, 0, time.UTC)
+	if n, _ := cfg.ScheduleAt(closed).Rate(); n != 0 {
+		t.Errorf("unexpected rate outside of the window: have=%d", n)
+	}
+
+	if n, _ := (&Configuration{}).ScheduleAt(closed).Rate(); n != -1 {
+		t.Errorf("unexpected rate without windows: have=%d", n)
+	}
+}
+
 func TestConfiguration_currentFor(t *testing.T) {
 	// Let's set up some common windows to simplify defining the test cases.
 
@@ -58,6 +58,16 @@ func (s *Schedule) ValidUntil() time.Time {
 	return s.until
 }
 
+// Rate returns the number of events the schedule allows per the returned
+// duration. The number is -1 if the schedule does not apply any rate limiting,
+// and 0 if no events can occur.
+func (s *Schedule) Rate() (n int, per time.Duration) {
+	if s.rate.IsUnlimited() || s.rate.n == 0 {
+		return s.rate.n, 0
+	}
+	return s.rate.n, s.rate.unit.AsDuration()
+}
+
 // total returns the total number of events the schedule expects to be able to
 // handle while valid. If the schedule does not apply any rate limiting, then
 // this will be -1.
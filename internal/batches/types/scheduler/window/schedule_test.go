@@ -61,6 +61,13 @@ func TestScheduleLimited(t *testing.T) {
 			t.Errorf("unexpected total: have=%v want=%v", have, want)
 		}
 	})
+
+	t.Run("Rate", func(t *testing.T) {
+		n, per := schedule.Rate()
+		if n != 100 || per != time.Second {
+			t.Errorf("unexpected rate: have=%d/%v want=%d/%v", n, per, 100, time.Second)
+		}
+	})
 }
 
 func TestScheduleUnlimited(t *testing.T) {
@@ -95,6 +102,13 @@ func TestScheduleUnlimited(t *testing.T) {
 			t.Errorf("unexpected total: have=%v want=%v", have, want)
 		}
 	})
+
+	t.Run("Rate", func(t *testing.T) {
+		n, per := schedule.Rate()
+		if n != -1 || per != 0 {
+			t.Errorf("unexpected rate: have=%d/%v want=%d/%v", n, per, -1, 0)
+		}
+	})
 }
 
 func TestScheduleZero(t *testing.T) {
@@ -125,4 +139,11 @@ func TestScheduleZero(t *testing.T) {
 			t.Errorf("unexpected total: have=%v want=%v", have, want)
 		}
 	})
+
+	t.Run("Rate", func(t *testing.T) {
+		n, per := schedule.Rate()
+		if n != 0 || per != 0 {
+			t.Errorf("unexpected rate: have=%d/%v want=%d/%v", n, per, 0, 0)
+		}
+	})
 }
@@ -1,6 +1,7 @@
 package autoindexing
 
 import (
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/coverage"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/dependencies"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/scheduler"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/summary"
@@ -24,4 +25,5 @@ type RepoUpdaterClient interface {
 type UploadService interface {
 	dependencies.UploadService
 	summary.UploadService
+	coverage.UploadService
 }
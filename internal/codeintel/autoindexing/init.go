@@ -2,6 +2,7 @@ package autoindexing
 
 import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/coverage"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/dependencies"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/scheduler"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/summary"
@@ -42,6 +43,7 @@ var (
 	DependenciesConfigInst = &dependencies.Config{}
 	SchedulerConfigInst    = &scheduler.Config{}
 	SummaryConfigInst      = &summary.Config{}
+	CoverageConfigInst     = &coverage.Config{}
 )
 
 func NewIndexSchedulers(
@@ -98,6 +100,21 @@ func NewSummaryBuilder(
 	)
 }
 
+func NewCoverageReporter(
+	observationCtx *observation.Context,
+	autoindexingSvc *Service,
+	uploadSvc UploadService,
+) []goroutine.BackgroundRoutine {
+	return background.NewCoverageReporter(
+		scopedContext("coverage", observationCtx),
+		autoindexingSvc.store,
+		autoindexingSvc.repoStore,
+		uploadSvc,
+		autoindexingSvc.gitserverClient,
+		CoverageConfigInst,
+	)
+}
+
 func scopedContext(component string, observationCtx *observation.Context) *observation.Context {
 	return observation.ScopedContext("codeintel", "autoindexing", component, observationCtx)
 }
@@ -0,0 +1,27 @@
+package coverage
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+)
+
+type Config struct {
+	env.BaseConfig
+
+	Interval            time.Duration
+	RepositoryInterval  time.Duration
+	RepositoryBatchSize int
+	MaxDirectoryDepth   int
+	MaxStalenessCommits int
+	RetentionPeriod     time.Duration
+}
+
+func (c *Config) Load() {
+	c.Interval = c.GetInterval("CODEINTEL_AUTOINDEXING_COVERAGE_REPORTER_INTERVAL", "1m", "How frequently to run the precise code intelligence coverage reporter routine.")
+	c.RepositoryInterval = c.GetInterval("CODEINTEL_AUTOINDEXING_COVERAGE_REPOSITORY_INTERVAL", "24h", "How frequently to capture the precise code intelligence coverage of the same repository.")
+	c.RepositoryBatchSize = c.GetInt("CODEINTEL_AUTOINDEXING_COVERAGE_REPOSITORY_BATCH_SIZE", "25", "The number of repositories whose coverage is captured on each run of the coverage reporter.")
+	c.MaxDirectoryDepth = c.GetInt("CODEINTEL_AUTOINDEXING_COVERAGE_MAX_DIRECTORY_DEPTH", "3", "The maximum depth of directories for which coverage is recorded. The repository root has depth zero.")
+	c.MaxStalenessCommits = c.GetInt("CODEINTEL_AUTOINDEXING_COVERAGE_MAX_STALENESS_COMMITS", "1000", "The maximum number of commits counted when determining how far a precise index lags behind the tip of the default branch.")
+	c.RetentionPeriod = c.GetInterval("CODEINTEL_AUTOINDEXING_COVERAGE_RETENTION_PERIOD", "2160h", "How long to retain historic coverage snapshots.")
+}
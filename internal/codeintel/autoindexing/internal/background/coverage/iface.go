@@ -0,0 +1,21 @@
+package coverage
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+)
+
+type UploadService interface {
+	GetUploads(ctx context.Context, opts uploadsshared.GetUploadsOptions) ([]uploadsshared.Upload, int, error)
+	GetUploadDocumentPaths(ctx context.Context, uploadID int) ([]string, error)
+}
+
+type GitserverClient interface {
+	GetDefaultBranch(ctx context.Context, repo api.RepoName, short bool) (string, api.CommitID, error)
+	LsFiles(ctx context.Context, repo api.RepoName, commit api.CommitID, pathspecs ...gitdomain.Pathspec) ([]string, error)
+	Commits(ctx context.Context, repo api.RepoName, opt gitserver.CommitsOptions) ([]*gitdomain.Commit, error)
+}
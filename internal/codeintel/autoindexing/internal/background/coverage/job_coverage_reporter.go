@@ -0,0 +1,255 @@
+package coverage
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/store"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxVisibleUploads bounds the number of uploads considered per repository. Only one upload
+// per root and indexer can be visible at the tip of the default branch, so this limit is
+// generous in practice.
+const maxVisibleUploads = 1000
+
+type reporter struct {
+	store           store.Store
+	repoStore       database.RepoStore
+	uploadSvc       UploadService
+	gitserverClient GitserverClient
+	config          *Config
+	clock           func() time.Time
+}
+
+func NewCoverageReporter(
+	observationCtx *observation.Context,
+	store store.Store,
+	repoStore database.RepoStore,
+	uploadSvc UploadService,
+	gitserverClient GitserverClient,
+	config *Config,
+) goroutine.BackgroundRoutine {
+	r := &reporter{
+		store:           store,
+		repoStore:       repoStore,
+		uploadSvc:       uploadSvc,
+		gitserverClient: gitserverClient,
+		config:          config,
+		clock:           time.Now,
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		// We should use an internal actor when doing cross service calls.
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(r.handle),
+		goroutine.WithName("codeintel.autoindexing-coverage-reporter"),
+		goroutine.WithDescription("records the precise code intelligence coverage of repositories at the tip of their default branch"),
+		goroutine.WithInterval(config.Interval),
+		goroutine.WithOperation(observationCtx.Operation(observation.Op{
+			Name: "codeintel.autoindexing.coverageReporter",
+		})),
+	)
+}
+
+func (r *reporter) handle(ctx context.Context) (errs error) {
+	now := r.clock().UTC()
+
+	repositoryIDs, err := r.store.GetRepositoriesForCoverage(ctx, r.config.RepositoryInterval, r.config.RepositoryBatchSize, now)
+	if err != nil {
+		return errors.Wrap(err, "store.GetRepositoriesForCoverage")
+	}
+
+	for _, repositoryID := range repositoryIDs {
+		if err := r.handleRepository(ctx, repositoryID, now); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "failed to capture coverage of repository %d", repositoryID))
+		}
+	}
+
+	if _, err := r.store.DeleteCoverageSnapshotsBefore(ctx, now.Add(-r.config.RetentionPeriod)); err != nil {
+		errs = errors.Append(errs, errors.Wrap(err, "store.DeleteCoverageSnapshotsBefore"))
+	}
+
+	return errs
+}
+
+func (r *reporter) handleRepository(ctx context.Context, repositoryID int, now time.Time) error {
+	repo, err := r.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		return errors.Wrap(err, "repoStore.Get")
+	}
+
+	_, tip, err := r.gitserverClient.GetDefaultBranch(ctx, repo.Name, true)
+	if err != nil {
+		return errors.Wrap(err, "gitserver.GetDefaultBranch")
+	}
+	if tip == "" {
+		// Empty repository
+		return nil
+	}
+
+	files, err := r.gitserverClient.LsFiles(ctx, repo.Name, tip)
+	if err != nil {
+		return errors.Wrap(err, "gitserver.LsFiles")
+	}
+
+	uploads, _, err := r.uploadSvc.GetUploads(ctx, uploadsshared.GetUploadsOptions{
+		RepositoryID: repositoryID,
+		State:        "completed",
+		VisibleAtTip: true,
+		Limit:        maxVisibleUploads,
+	})
+	if err != nil {
+		return errors.Wrap(err, "uploadSvc.GetUploads")
+	}
+
+	indexes := make([]coveringIndex, 0, len(uploads))
+	for _, upload := range uploads {
+		paths, err := r.uploadSvc.GetUploadDocumentPaths(ctx, upload.ID)
+		if err != nil {
+			return errors.Wrap(err, "uploadSvc.GetUploadDocumentPaths")
+		}
+
+		staleness, err := r.stalenessCommits(ctx, repo.Name, upload.Commit, string(tip))
+		if err != nil {
+			return err
+		}
+
+		indexes = append(indexes, coveringIndex{
+			Indexer:          upload.Indexer,
+			Root:             upload.Root,
+			Paths:            paths,
+			StalenessCommits: staleness,
+		})
+	}
+
+	snapshots := computeCoverage(repositoryID, string(tip), files, indexes, r.config.MaxDirectoryDepth, now)
+	return r.store.InsertCoverageSnapshots(ctx, snapshots)
+}
+
+// stalenessCommits returns the number of commits reachable from the tip of the default branch
+// but not from the given commit, capped at the configured maximum.
+func (r *reporter) stalenessCommits(ctx context.Context, repo api.RepoName, commit, tip string) (int, error) {
+	if commit == tip {
+		return 0, nil
+	}
+
+	commits, err := r.gitserverClient.Commits(ctx, repo, gitserver.CommitsOptions{
+		Range: commit + ".." + tip,
+		N:     uint(r.config.MaxStalenessCommits),
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "gitserver.Commits")
+	}
+
+	return len(commits), nil
+}
+
+// coveringIndex describes a precise index visible at the tip of the default branch.
+type coveringIndex struct {
+	Indexer          string
+	Root             string
+	Paths            []string // relative to Root
+	StalenessCommits int
+}
+
+// computeCoverage returns a coverage snapshot for the repository root and each directory that
+// contains a file and is at most maxDepth levels deep. A file is covered if one of the given
+// indexes has a document for it.
+func computeCoverage(repositoryID int, commit string, files []string, indexes []coveringIndex, maxDepth int, now time.Time) []shared.CoverageSnapshot {
+	fileSet := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		fileSet[file] = struct{}{}
+	}
+
+	coveredBy := map[string][]int{}
+	for i, index := range indexes {
+		for _, documentPath := range index.Paths {
+			file := path.Join(index.Root, documentPath)
+			if _, ok := fileSet[file]; ok {
+				coveredBy[file] = append(coveredBy[file], i)
+			}
+		}
+	}
+
+	type directory struct {
+		snapshot shared.CoverageSnapshot
+		indexers map[string]struct{}
+	}
+	directories := map[string]*directory{}
+
+	for _, file := range files {
+		indexIDs := coveredBy[file]
+
+		for _, dir := range ancestorDirectories(file, maxDepth) {
+			d, ok := directories[dir]
+			if !ok {
+				d = &directory{
+					snapshot: shared.CoverageSnapshot{
+						RepositoryID: repositoryID,
+						Commit:       commit,
+						Path:         dir,
+						Indexers:     []string{},
+						CapturedAt:   now,
+					},
+					indexers: map[string]struct{}{},
+				}
+				directories[dir] = d
+			}
+
+			d.snapshot.TotalFiles++
+			if len(indexIDs) == 0 {
+				continue
+			}
+
+			d.snapshot.IndexedFiles++
+			for _, i := range indexIDs {
+				d.indexers[indexes[i].Indexer] = struct{}{}
+
+				if staleness := indexes[i].StalenessCommits; d.snapshot.StalenessCommits == nil || staleness > *d.snapshot.StalenessCommits {
+					d.snapshot.StalenessCommits = &staleness
+				}
+			}
+		}
+	}
+
+	snapshots := make([]shared.CoverageSnapshot, 0, len(directories))
+	for _, d := range directories {
+		for indexer := range d.indexers {
+			d.snapshot.Indexers = append(d.snapshot.Indexers, indexer)
+		}
+		sort.Strings(d.snapshot.Indexers)
+
+		snapshots = append(snapshots, d.snapshot)
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Path < snapshots[j].Path })
+
+	return snapshots
+}
+
+// ancestorDirectories returns the repository root and the directories containing the given
+// file, outermost first, up to the given depth.
+func ancestorDirectories(file string, maxDepth int) []string {
+	segments := strings.Split(path.Dir(file), "/")
+	if segments[0] == "." {
+		segments = nil
+	}
+
+	directories := []string{""}
+	for i := 1; i <= len(segments) && i <= maxDepth; i++ {
+		directories = append(directories, strings.Join(segments[:i], "/"))
+	}
+
+	return directories
+}
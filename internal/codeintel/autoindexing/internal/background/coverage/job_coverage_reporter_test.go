@@ -0,0 +1,90 @@
+package coverage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/shared"
+)
+
+func TestComputeCoverage(t *testing.T) {
+	now := time.Unix(1587396557, 0).UTC()
+	files := []string{
+		"README.md",
+		"cmd/server/main.go",
+		"cmd/server/main_test.go",
+		"cmd/tool/main.go",
+		"docs/index.md",
+		"web/src/app/index.ts",
+		"web/src/app/deeply/nested/component.ts",
+	}
+	indexes := []coveringIndex{
+		{
+			Indexer: "scip-go",
+			Root:    "cmd",
+			Paths: []string{
+				"server/main.go",
+				"server/main_test.go",
+				"server/deleted.go", // not present at tip
+			},
+			StalenessCommits: 4,
+		},
+		{
+			Indexer:          "scip-go",
+			Root:             "",
+			Paths:            []string{"cmd/server/main.go", "cmd/tool/main.go"},
+			StalenessCommits: 1,
+		},
+		{
+			Indexer:          "scip-typescript",
+			Root:             "web",
+			Paths:            []string{"src/app/index.ts", "src/app/deeply/nested/component.ts"},
+			StalenessCommits: 0,
+		},
+	}
+
+	staleness := func(v int) *int { return &v }
+	snapshot := func(path string, totalFiles, indexedFiles int, indexers []string, stalenessCommits *int) shared.CoverageSnapshot {
+		return shared.CoverageSnapshot{
+			RepositoryID:     50,
+			Commit:           "deadbeef",
+			Path:             path,
+			TotalFiles:       totalFiles,
+			IndexedFiles:     indexedFiles,
+			Indexers:         indexers,
+			StalenessCommits: stalenessCommits,
+			CapturedAt:       now,
+		}
+	}
+
+	expected := []shared.CoverageSnapshot{
+		snapshot("", 7, 5, []string{"scip-go", "scip-typescript"}, staleness(4)),
+		snapshot("cmd", 3, 3, []string{"scip-go"}, staleness(4)),
+		snapshot("cmd/server", 2, 2, []string{"scip-go"}, staleness(4)),
+		snapshot("cmd/tool", 1, 1, []string{"scip-go"}, staleness(1)),
+		snapshot("docs", 1, 0, []string{}, nil),
+		snapshot("web", 2, 2, []string{"scip-typescript"}, staleness(0)),
+		snapshot("web/src", 2, 2, []string{"scip-typescript"}, staleness(0)),
+		snapshot("web/src/app", 2, 2, []string{"scip-typescript"}, staleness(0)),
+	}
+	if diff := cmp.Diff(expected, computeCoverage(50, "deadbeef", files, indexes, 3, now)); diff != "" {
+		t.Errorf("unexpected coverage (-want +got):\n%s", diff)
+	}
+}
+
+func TestAncestorDirectories(t *testing.T) {
+	testCases := map[string][]string{
+		"README.md":            {""},
+		"cmd/main.go":          {"", "cmd"},
+		"a/b/c/d/e/file.go":    {"", "a", "a/b", "a/b/c"},
+		"web/src/app/index.ts": {"", "web", "web/src", "web/src/app"},
+	}
+
+	for file, expected := range testCases {
+		if diff := cmp.Diff(expected, ancestorDirectories(file, 3)); diff != "" {
+			t.Errorf("unexpected directories for %q (-want +got):\n%s", file, diff)
+		}
+	}
+}
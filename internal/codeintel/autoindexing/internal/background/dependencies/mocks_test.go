@@ -1918,6 +1918,16 @@ func (c PackageReferenceScannerNextFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/store)
 // used for unit testing.
 type MockStore struct {
+	// DeleteCoverageSnapshotsBeforeFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteCoverageSnapshotsBefore.
+	DeleteCoverageSnapshotsBeforeFunc *StoreDeleteCoverageSnapshotsBeforeFunc
+	// GetCoverageHistoryFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCoverageHistory.
+	GetCoverageHistoryFunc *StoreGetCoverageHistoryFunc
+	// GetCoverageSnapshotsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCoverageSnapshots.
+	GetCoverageSnapshotsFunc *StoreGetCoverageSnapshotsFunc
 	// GetIndexConfigurationByRepositoryIDFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetIndexConfigurationByRepositoryID.
@@ -1932,10 +1942,17 @@ type MockStore struct {
 	// GetQueuedRepoRevFunc is an instance of a mock function object
 	// controlling the behavior of the method GetQueuedRepoRev.
 	GetQueuedRepoRevFunc *StoreGetQueuedRepoRevFunc
+	// GetRepositoriesForCoverageFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetRepositoriesForCoverage.
+	GetRepositoriesForCoverageFunc *StoreGetRepositoriesForCoverageFunc
 	// GetRepositoriesForIndexScanFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// GetRepositoriesForIndexScan.
 	GetRepositoriesForIndexScanFunc *StoreGetRepositoriesForIndexScanFunc
+	// InsertCoverageSnapshotsFunc is an instance of a mock function object
+	// controlling the behavior of the method InsertCoverageSnapshots.
+	InsertCoverageSnapshotsFunc *StoreInsertCoverageSnapshotsFunc
 	// InsertDependencyIndexingJobFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// InsertDependencyIndexingJob.
@@ -1992,6 +2009,21 @@ type MockStore struct {
 // return zero values for all results, unless overwritten.
 func NewMockStore() *MockStore {
 	return &MockStore{
+		DeleteCoverageSnapshotsBeforeFunc: &StoreDeleteCoverageSnapshotsBeforeFunc{
+			defaultHook: func(context.Context, time.Time) (r0 int, r1 error) {
+				return
+			},
+		},
+		GetCoverageHistoryFunc: &StoreGetCoverageHistoryFunc{
+			defaultHook: func(context.Context, int, string, time.Time, int) (r0 []shared2.CoverageSnapshot, r1 error) {
+				return
+			},
+		},
+		GetCoverageSnapshotsFunc: &StoreGetCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, int, string) (r0 []shared2.CoverageSnapshot, r1 error) {
+				return
+			},
+		},
 		GetIndexConfigurationByRepositoryIDFunc: &StoreGetIndexConfigurationByRepositoryIDFunc{
 			defaultHook: func(context.Context, int) (r0 shared2.IndexConfiguration, r1 bool, r2 error) {
 				return
@@ -2012,11 +2044,21 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetRepositoriesForCoverageFunc: &StoreGetRepositoriesForCoverageFunc{
+			defaultHook: func(context.Context, time.Duration, int, time.Time) (r0 []int, r1 error) {
+				return
+			},
+		},
 		GetRepositoriesForIndexScanFunc: &StoreGetRepositoriesForIndexScanFunc{
 			defaultHook: func(context.Context, time.Duration, bool, *int, int, time.Time) (r0 []int, r1 error) {
 				return
 			},
 		},
+		InsertCoverageSnapshotsFunc: &StoreInsertCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, []shared2.CoverageSnapshot) (r0 error) {
+				return
+			},
+		},
 		InsertDependencyIndexingJobFunc: &StoreInsertDependencyIndexingJobFunc{
 			defaultHook: func(context.Context, int, string, time.Time) (r0 int, r1 error) {
 				return
@@ -2099,6 +2141,21 @@ func NewMockStore() *MockStore {
 // panic on invocation, unless overwritten.
 func NewStrictMockStore() *MockStore {
 	return &MockStore{
+		DeleteCoverageSnapshotsBeforeFunc: &StoreDeleteCoverageSnapshotsBeforeFunc{
+			defaultHook: func(context.Context, time.Time) (int, error) {
+				panic("unexpected invocation of MockStore.DeleteCoverageSnapshotsBefore")
+			},
+		},
+		GetCoverageHistoryFunc: &StoreGetCoverageHistoryFunc{
+			defaultHook: func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error) {
+				panic("unexpected invocation of MockStore.GetCoverageHistory")
+			},
+		},
+		GetCoverageSnapshotsFunc: &StoreGetCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, int, string) ([]shared2.CoverageSnapshot, error) {
+				panic("unexpected invocation of MockStore.GetCoverageSnapshots")
+			},
+		},
 		GetIndexConfigurationByRepositoryIDFunc: &StoreGetIndexConfigurationByRepositoryIDFunc{
 			defaultHook: func(context.Context, int) (shared2.IndexConfiguration, bool, error) {
 				panic("unexpected invocation of MockStore.GetIndexConfigurationByRepositoryID")
@@ -2119,11 +2176,21 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetQueuedRepoRev")
 			},
 		},
+		GetRepositoriesForCoverageFunc: &StoreGetRepositoriesForCoverageFunc{
+			defaultHook: func(context.Context, time.Duration, int, time.Time) ([]int, error) {
+				panic("unexpected invocation of MockStore.GetRepositoriesForCoverage")
+			},
+		},
 		GetRepositoriesForIndexScanFunc: &StoreGetRepositoriesForIndexScanFunc{
 			defaultHook: func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
 				panic("unexpected invocation of MockStore.GetRepositoriesForIndexScan")
 			},
 		},
+		InsertCoverageSnapshotsFunc: &StoreInsertCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, []shared2.CoverageSnapshot) error {
+				panic("unexpected invocation of MockStore.InsertCoverageSnapshots")
+			},
+		},
 		InsertDependencyIndexingJobFunc: &StoreInsertDependencyIndexingJobFunc{
 			defaultHook: func(context.Context, int, string, time.Time) (int, error) {
 				panic("unexpected invocation of MockStore.InsertDependencyIndexingJob")
@@ -2206,6 +2273,15 @@ func NewStrictMockStore() *MockStore {
 // methods delegate to the given implementation, unless overwritten.
 func NewMockStoreFrom(i store.Store) *MockStore {
 	return &MockStore{
+		DeleteCoverageSnapshotsBeforeFunc: &StoreDeleteCoverageSnapshotsBeforeFunc{
+			defaultHook: i.DeleteCoverageSnapshotsBefore,
+		},
+		GetCoverageHistoryFunc: &StoreGetCoverageHistoryFunc{
+			defaultHook: i.GetCoverageHistory,
+		},
+		GetCoverageSnapshotsFunc: &StoreGetCoverageSnapshotsFunc{
+			defaultHook: i.GetCoverageSnapshots,
+		},
 		GetIndexConfigurationByRepositoryIDFunc: &StoreGetIndexConfigurationByRepositoryIDFunc{
 			defaultHook: i.GetIndexConfigurationByRepositoryID,
 		},
@@ -2218,9 +2294,15 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetQueuedRepoRevFunc: &StoreGetQueuedRepoRevFunc{
 			defaultHook: i.GetQueuedRepoRev,
 		},
+		GetRepositoriesForCoverageFunc: &StoreGetRepositoriesForCoverageFunc{
+			defaultHook: i.GetRepositoriesForCoverage,
+		},
 		GetRepositoriesForIndexScanFunc: &StoreGetRepositoriesForIndexScanFunc{
 			defaultHook: i.GetRepositoriesForIndexScan,
 		},
+		InsertCoverageSnapshotsFunc: &StoreInsertCoverageSnapshotsFunc{
+			defaultHook: i.InsertCoverageSnapshots,
+		},
 		InsertDependencyIndexingJobFunc: &StoreInsertDependencyIndexingJobFunc{
 			defaultHook: i.InsertDependencyIndexingJob,
 		},
@@ -2269,38 +2351,37 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 	}
 }
 
-// StoreGetIndexConfigurationByRepositoryIDFunc describes the behavior when
-// the GetIndexConfigurationByRepositoryID method of the parent MockStore
-// instance is invoked.
-type StoreGetIndexConfigurationByRepositoryIDFunc struct {
-	defaultHook func(context.Context, int) (shared2.IndexConfiguration, bool, error)
-	hooks       []func(context.Context, int) (shared2.IndexConfiguration, bool, error)
-	history     []StoreGetIndexConfigurationByRepositoryIDFuncCall
+// StoreDeleteCoverageSnapshotsBeforeFunc describes the behavior when the
+// DeleteCoverageSnapshotsBefore method of the parent MockStore instance is
+// invoked.
+type StoreDeleteCoverageSnapshotsBeforeFunc struct {
+	defaultHook func(context.Context, time.Time) (int, error)
+	hooks       []func(context.Context, time.Time) (int, error)
+	history     []StoreDeleteCoverageSnapshotsBeforeFuncCall
 	mutex       sync.Mutex
 }
 
-// GetIndexConfigurationByRepositoryID delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockStore) GetIndexConfigurationByRepositoryID(v0 context.Context, v1 int) (shared2.IndexConfiguration, bool, error) {
-	r0, r1, r2 := m.GetIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1)
-	m.GetIndexConfigurationByRepositoryIDFunc.appendCall(StoreGetIndexConfigurationByRepositoryIDFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+// DeleteCoverageSnapshotsBefore delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) DeleteCoverageSnapshotsBefore(v0 context.Context, v1 time.Time) (int, error) {
+	r0, r1 := m.DeleteCoverageSnapshotsBeforeFunc.nextHook()(v0, v1)
+	m.DeleteCoverageSnapshotsBeforeFunc.appendCall(StoreDeleteCoverageSnapshotsBeforeFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// GetIndexConfigurationByRepositoryID method of the parent MockStore
-// instance is invoked and the hook queue is empty.
-func (f *StoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int) (shared2.IndexConfiguration, bool, error)) {
+// DeleteCoverageSnapshotsBefore method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) SetDefaultHook(hook func(context.Context, time.Time) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetIndexConfigurationByRepositoryID method of the parent MockStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *StoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int) (shared2.IndexConfiguration, bool, error)) {
+// DeleteCoverageSnapshotsBefore method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) PushHook(hook func(context.Context, time.Time) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2308,20 +2389,20 @@ func (f *StoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook func(contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 shared2.IndexConfiguration, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (shared2.IndexConfiguration, bool, error) {
-		return r0, r1, r2
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreGetIndexConfigurationByRepositoryIDFunc) PushReturn(r0 shared2.IndexConfiguration, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (shared2.IndexConfiguration, bool, error) {
-		return r0, r1, r2
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
-func (f *StoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int) (shared2.IndexConfiguration, bool, error) {
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) nextHook() func(context.Context, time.Time) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2334,86 +2415,82 @@ func (f *StoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func(context.C
 	return hook
 }
 
-func (f *StoreGetIndexConfigurationByRepositoryIDFunc) appendCall(r0 StoreGetIndexConfigurationByRepositoryIDFuncCall) {
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) appendCall(r0 StoreDeleteCoverageSnapshotsBeforeFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// StoreGetIndexConfigurationByRepositoryIDFuncCall objects describing the
-// invocations of this function.
-func (f *StoreGetIndexConfigurationByRepositoryIDFunc) History() []StoreGetIndexConfigurationByRepositoryIDFuncCall {
+// History returns a sequence of StoreDeleteCoverageSnapshotsBeforeFuncCall
+// objects describing the invocations of this function.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) History() []StoreDeleteCoverageSnapshotsBeforeFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreGetIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	history := make([]StoreDeleteCoverageSnapshotsBeforeFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreGetIndexConfigurationByRepositoryIDFuncCall is an object that
-// describes an invocation of method GetIndexConfigurationByRepositoryID on
-// an instance of MockStore.
-type StoreGetIndexConfigurationByRepositoryIDFuncCall struct {
+// StoreDeleteCoverageSnapshotsBeforeFuncCall is an object that describes an
+// invocation of method DeleteCoverageSnapshotsBefore on an instance of
+// MockStore.
+type StoreDeleteCoverageSnapshotsBeforeFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 shared2.IndexConfiguration
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreGetIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
+func (c StoreDeleteCoverageSnapshotsBeforeFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreGetIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c StoreDeleteCoverageSnapshotsBeforeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreGetInferenceScriptFunc describes the behavior when the
-// GetInferenceScript method of the parent MockStore instance is invoked.
-type StoreGetInferenceScriptFunc struct {
-	defaultHook func(context.Context) (string, error)
-	hooks       []func(context.Context) (string, error)
-	history     []StoreGetInferenceScriptFuncCall
+// StoreGetCoverageHistoryFunc describes the behavior when the
+// GetCoverageHistory method of the parent MockStore instance is invoked.
+type StoreGetCoverageHistoryFunc struct {
+	defaultHook func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error)
+	hooks       []func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error)
+	history     []StoreGetCoverageHistoryFuncCall
 	mutex       sync.Mutex
 }
 
-// GetInferenceScript delegates to the next hook function in the queue and
+// GetCoverageHistory delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockStore) GetInferenceScript(v0 context.Context) (string, error) {
-	r0, r1 := m.GetInferenceScriptFunc.nextHook()(v0)
-	m.GetInferenceScriptFunc.appendCall(StoreGetInferenceScriptFuncCall{v0, r0, r1})
+func (m *MockStore) GetCoverageHistory(v0 context.Context, v1 int, v2 string, v3 time.Time, v4 int) ([]shared2.CoverageSnapshot, error) {
+	r0, r1 := m.GetCoverageHistoryFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetCoverageHistoryFunc.appendCall(StoreGetCoverageHistoryFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetInferenceScript
+// SetDefaultHook sets function that is called when the GetCoverageHistory
 // method of the parent MockStore instance is invoked and the hook queue is
 // empty.
-func (f *StoreGetInferenceScriptFunc) SetDefaultHook(hook func(context.Context) (string, error)) {
+func (f *StoreGetCoverageHistoryFunc) SetDefaultHook(hook func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetInferenceScript method of the parent MockStore instance invokes the
+// GetCoverageHistory method of the parent MockStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *StoreGetInferenceScriptFunc) PushHook(hook func(context.Context) (string, error)) {
+func (f *StoreGetCoverageHistoryFunc) PushHook(hook func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2421,20 +2498,20 @@ func (f *StoreGetInferenceScriptFunc) PushHook(hook func(context.Context) (strin
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreGetInferenceScriptFunc) SetDefaultReturn(r0 string, r1 error) {
-	f.SetDefaultHook(func(context.Context) (string, error) {
+func (f *StoreGetCoverageHistoryFunc) SetDefaultReturn(r0 []shared2.CoverageSnapshot, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreGetInferenceScriptFunc) PushReturn(r0 string, r1 error) {
-	f.PushHook(func(context.Context) (string, error) {
+func (f *StoreGetCoverageHistoryFunc) PushReturn(r0 []shared2.CoverageSnapshot, r1 error) {
+	f.PushHook(func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreGetInferenceScriptFunc) nextHook() func(context.Context) (string, error) {
+func (f *StoreGetCoverageHistoryFunc) nextHook() func(context.Context, int, string, time.Time, int) ([]shared2.CoverageSnapshot, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2447,32 +2524,44 @@ func (f *StoreGetInferenceScriptFunc) nextHook() func(context.Context) (string,
 	return hook
 }
 
-func (f *StoreGetInferenceScriptFunc) appendCall(r0 StoreGetInferenceScriptFuncCall) {
+func (f *StoreGetCoverageHistoryFunc) appendCall(r0 StoreGetCoverageHistoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreGetInferenceScriptFuncCall objects
+// History returns a sequence of StoreGetCoverageHistoryFuncCall objects
 // describing the invocations of this function.
-func (f *StoreGetInferenceScriptFunc) History() []StoreGetInferenceScriptFuncCall {
+func (f *StoreGetCoverageHistoryFunc) History() []StoreGetCoverageHistoryFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreGetInferenceScriptFuncCall, len(f.history))
+	history := make([]StoreGetCoverageHistoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreGetInferenceScriptFuncCall is an object that describes an invocation
-// of method GetInferenceScript on an instance of MockStore.
-type StoreGetInferenceScriptFuncCall struct {
+// StoreGetCoverageHistoryFuncCall is an object that describes an invocation
+// of method GetCoverageHistory on an instance of MockStore.
+type StoreGetCoverageHistoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 []shared2.CoverageSnapshot
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2480,47 +2569,45 @@ type StoreGetInferenceScriptFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreGetInferenceScriptFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c StoreGetCoverageHistoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreGetInferenceScriptFuncCall) Results() []interface{} {
+func (c StoreGetCoverageHistoryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreGetLastIndexScanForRepositoryFunc describes the behavior when the
-// GetLastIndexScanForRepository method of the parent MockStore instance is
-// invoked.
-type StoreGetLastIndexScanForRepositoryFunc struct {
-	defaultHook func(context.Context, int) (*time.Time, error)
-	hooks       []func(context.Context, int) (*time.Time, error)
-	history     []StoreGetLastIndexScanForRepositoryFuncCall
+// StoreGetCoverageSnapshotsFunc describes the behavior when the
+// GetCoverageSnapshots method of the parent MockStore instance is invoked.
+type StoreGetCoverageSnapshotsFunc struct {
+	defaultHook func(context.Context, int, string) ([]shared2.CoverageSnapshot, error)
+	hooks       []func(context.Context, int, string) ([]shared2.CoverageSnapshot, error)
+	history     []StoreGetCoverageSnapshotsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetLastIndexScanForRepository delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockStore) GetLastIndexScanForRepository(v0 context.Context, v1 int) (*time.Time, error) {
-	r0, r1 := m.GetLastIndexScanForRepositoryFunc.nextHook()(v0, v1)
-	m.GetLastIndexScanForRepositoryFunc.appendCall(StoreGetLastIndexScanForRepositoryFuncCall{v0, v1, r0, r1})
+// GetCoverageSnapshots delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetCoverageSnapshots(v0 context.Context, v1 int, v2 string) ([]shared2.CoverageSnapshot, error) {
+	r0, r1 := m.GetCoverageSnapshotsFunc.nextHook()(v0, v1, v2)
+	m.GetCoverageSnapshotsFunc.appendCall(StoreGetCoverageSnapshotsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetLastIndexScanForRepository method of the parent MockStore instance is
-// invoked and the hook queue is empty.
-func (f *StoreGetLastIndexScanForRepositoryFunc) SetDefaultHook(hook func(context.Context, int) (*time.Time, error)) {
+// SetDefaultHook sets function that is called when the GetCoverageSnapshots
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetCoverageSnapshotsFunc) SetDefaultHook(hook func(context.Context, int, string) ([]shared2.CoverageSnapshot, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetLastIndexScanForRepository method of the parent MockStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *StoreGetLastIndexScanForRepositoryFunc) PushHook(hook func(context.Context, int) (*time.Time, error)) {
+// GetCoverageSnapshots method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetCoverageSnapshotsFunc) PushHook(hook func(context.Context, int, string) ([]shared2.CoverageSnapshot, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2528,20 +2615,20 @@ func (f *StoreGetLastIndexScanForRepositoryFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreGetLastIndexScanForRepositoryFunc) SetDefaultReturn(r0 *time.Time, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (*time.Time, error) {
+func (f *StoreGetCoverageSnapshotsFunc) SetDefaultReturn(r0 []shared2.CoverageSnapshot, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) ([]shared2.CoverageSnapshot, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreGetLastIndexScanForRepositoryFunc) PushReturn(r0 *time.Time, r1 error) {
-	f.PushHook(func(context.Context, int) (*time.Time, error) {
+func (f *StoreGetCoverageSnapshotsFunc) PushReturn(r0 []shared2.CoverageSnapshot, r1 error) {
+	f.PushHook(func(context.Context, int, string) ([]shared2.CoverageSnapshot, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreGetLastIndexScanForRepositoryFunc) nextHook() func(context.Context, int) (*time.Time, error) {
+func (f *StoreGetCoverageSnapshotsFunc) nextHook() func(context.Context, int, string) ([]shared2.CoverageSnapshot, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2554,36 +2641,38 @@ func (f *StoreGetLastIndexScanForRepositoryFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *StoreGetLastIndexScanForRepositoryFunc) appendCall(r0 StoreGetLastIndexScanForRepositoryFuncCall) {
+func (f *StoreGetCoverageSnapshotsFunc) appendCall(r0 StoreGetCoverageSnapshotsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreGetLastIndexScanForRepositoryFuncCall
-// objects describing the invocations of this function.
-func (f *StoreGetLastIndexScanForRepositoryFunc) History() []StoreGetLastIndexScanForRepositoryFuncCall {
+// History returns a sequence of StoreGetCoverageSnapshotsFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetCoverageSnapshotsFunc) History() []StoreGetCoverageSnapshotsFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreGetLastIndexScanForRepositoryFuncCall, len(f.history))
+	history := make([]StoreGetCoverageSnapshotsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreGetLastIndexScanForRepositoryFuncCall is an object that describes an
-// invocation of method GetLastIndexScanForRepository on an instance of
-// MockStore.
-type StoreGetLastIndexScanForRepositoryFuncCall struct {
+// StoreGetCoverageSnapshotsFuncCall is an object that describes an
+// invocation of method GetCoverageSnapshots on an instance of MockStore.
+type StoreGetCoverageSnapshotsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *time.Time
+	Result0 []shared2.CoverageSnapshot
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2591,45 +2680,48 @@ type StoreGetLastIndexScanForRepositoryFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreGetLastIndexScanForRepositoryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c StoreGetCoverageSnapshotsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreGetLastIndexScanForRepositoryFuncCall) Results() []interface{} {
+func (c StoreGetCoverageSnapshotsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreGetQueuedRepoRevFunc describes the behavior when the
-// GetQueuedRepoRev method of the parent MockStore instance is invoked.
-type StoreGetQueuedRepoRevFunc struct {
-	defaultHook func(context.Context, int) ([]store.RepoRev, error)
-	hooks       []func(context.Context, int) ([]store.RepoRev, error)
-	history     []StoreGetQueuedRepoRevFuncCall
+// StoreGetIndexConfigurationByRepositoryIDFunc describes the behavior when
+// the GetIndexConfigurationByRepositoryID method of the parent MockStore
+// instance is invoked.
+type StoreGetIndexConfigurationByRepositoryIDFunc struct {
+	defaultHook func(context.Context, int) (shared2.IndexConfiguration, bool, error)
+	hooks       []func(context.Context, int) (shared2.IndexConfiguration, bool, error)
+	history     []StoreGetIndexConfigurationByRepositoryIDFuncCall
 	mutex       sync.Mutex
 }
 
-// GetQueuedRepoRev delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockStore) GetQueuedRepoRev(v0 context.Context, v1 int) ([]store.RepoRev, error) {
-	r0, r1 := m.GetQueuedRepoRevFunc.nextHook()(v0, v1)
-	m.GetQueuedRepoRevFunc.appendCall(StoreGetQueuedRepoRevFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// GetIndexConfigurationByRepositoryID delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockStore) GetIndexConfigurationByRepositoryID(v0 context.Context, v1 int) (shared2.IndexConfiguration, bool, error) {
+	r0, r1, r2 := m.GetIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1)
+	m.GetIndexConfigurationByRepositoryIDFunc.appendCall(StoreGetIndexConfigurationByRepositoryIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetQueuedRepoRev
-// method of the parent MockStore instance is invoked and the hook queue is
-// empty.
-func (f *StoreGetQueuedRepoRevFunc) SetDefaultHook(hook func(context.Context, int) ([]store.RepoRev, error)) {
+// SetDefaultHook sets function that is called when the
+// GetIndexConfigurationByRepositoryID method of the parent MockStore
+// instance is invoked and the hook queue is empty.
+func (f *StoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int) (shared2.IndexConfiguration, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetQueuedRepoRev method of the parent MockStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *StoreGetQueuedRepoRevFunc) PushHook(hook func(context.Context, int) ([]store.RepoRev, error)) {
+// GetIndexConfigurationByRepositoryID method of the parent MockStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *StoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int) (shared2.IndexConfiguration, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2637,20 +2729,20 @@ func (f *StoreGetQueuedRepoRevFunc) PushHook(hook func(context.Context, int) ([]
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreGetQueuedRepoRevFunc) SetDefaultReturn(r0 []store.RepoRev, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) ([]store.RepoRev, error) {
-		return r0, r1
+func (f *StoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 shared2.IndexConfiguration, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (shared2.IndexConfiguration, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreGetQueuedRepoRevFunc) PushReturn(r0 []store.RepoRev, r1 error) {
-	f.PushHook(func(context.Context, int) ([]store.RepoRev, error) {
-		return r0, r1
+func (f *StoreGetIndexConfigurationByRepositoryIDFunc) PushReturn(r0 shared2.IndexConfiguration, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (shared2.IndexConfiguration, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *StoreGetQueuedRepoRevFunc) nextHook() func(context.Context, int) ([]store.RepoRev, error) {
+func (f *StoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int) (shared2.IndexConfiguration, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2663,26 +2755,28 @@ func (f *StoreGetQueuedRepoRevFunc) nextHook() func(context.Context, int) ([]sto
 	return hook
 }
 
-func (f *StoreGetQueuedRepoRevFunc) appendCall(r0 StoreGetQueuedRepoRevFuncCall) {
+func (f *StoreGetIndexConfigurationByRepositoryIDFunc) appendCall(r0 StoreGetIndexConfigurationByRepositoryIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreGetQueuedRepoRevFuncCall objects
-// describing the invocations of this function.
-func (f *StoreGetQueuedRepoRevFunc) History() []StoreGetQueuedRepoRevFuncCall {
+// History returns a sequence of
+// StoreGetIndexConfigurationByRepositoryIDFuncCall objects describing the
+// invocations of this function.
+func (f *StoreGetIndexConfigurationByRepositoryIDFunc) History() []StoreGetIndexConfigurationByRepositoryIDFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreGetQueuedRepoRevFuncCall, len(f.history))
+	history := make([]StoreGetIndexConfigurationByRepositoryIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreGetQueuedRepoRevFuncCall is an object that describes an invocation
-// of method GetQueuedRepoRev on an instance of MockStore.
-type StoreGetQueuedRepoRevFuncCall struct {
+// StoreGetIndexConfigurationByRepositoryIDFuncCall is an object that
+// describes an invocation of method GetIndexConfigurationByRepositoryID on
+// an instance of MockStore.
+type StoreGetIndexConfigurationByRepositoryIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2691,55 +2785,56 @@ type StoreGetQueuedRepoRevFuncCall struct {
 	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []store.RepoRev
+	Result0 shared2.IndexConfiguration
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreGetQueuedRepoRevFuncCall) Args() []interface{} {
+func (c StoreGetIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreGetQueuedRepoRevFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c StoreGetIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// StoreGetRepositoriesForIndexScanFunc describes the behavior when the
-// GetRepositoriesForIndexScan method of the parent MockStore instance is
-// invoked.
-type StoreGetRepositoriesForIndexScanFunc struct {
-	defaultHook func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)
-	hooks       []func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)
-	history     []StoreGetRepositoriesForIndexScanFuncCall
+// StoreGetInferenceScriptFunc describes the behavior when the
+// GetInferenceScript method of the parent MockStore instance is invoked.
+type StoreGetInferenceScriptFunc struct {
+	defaultHook func(context.Context) (string, error)
+	hooks       []func(context.Context) (string, error)
+	history     []StoreGetInferenceScriptFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepositoriesForIndexScan delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockStore) GetRepositoriesForIndexScan(v0 context.Context, v1 time.Duration, v2 bool, v3 *int, v4 int, v5 time.Time) ([]int, error) {
-	r0, r1 := m.GetRepositoriesForIndexScanFunc.nextHook()(v0, v1, v2, v3, v4, v5)
-	m.GetRepositoriesForIndexScanFunc.appendCall(StoreGetRepositoriesForIndexScanFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+// GetInferenceScript delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetInferenceScript(v0 context.Context) (string, error) {
+	r0, r1 := m.GetInferenceScriptFunc.nextHook()(v0)
+	m.GetInferenceScriptFunc.appendCall(StoreGetInferenceScriptFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetRepositoriesForIndexScan method of the parent MockStore instance is
-// invoked and the hook queue is empty.
-func (f *StoreGetRepositoriesForIndexScanFunc) SetDefaultHook(hook func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)) {
+// SetDefaultHook sets function that is called when the GetInferenceScript
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetInferenceScriptFunc) SetDefaultHook(hook func(context.Context) (string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepositoriesForIndexScan method of the parent MockStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *StoreGetRepositoriesForIndexScanFunc) PushHook(hook func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)) {
+// GetInferenceScript method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetInferenceScriptFunc) PushHook(hook func(context.Context) (string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2747,20 +2842,20 @@ func (f *StoreGetRepositoriesForIndexScanFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreGetRepositoriesForIndexScanFunc) SetDefaultReturn(r0 []int, r1 error) {
-	f.SetDefaultHook(func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
+func (f *StoreGetInferenceScriptFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context) (string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreGetRepositoriesForIndexScanFunc) PushReturn(r0 []int, r1 error) {
-	f.PushHook(func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
+func (f *StoreGetInferenceScriptFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context) (string, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreGetRepositoriesForIndexScanFunc) nextHook() func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
+func (f *StoreGetInferenceScriptFunc) nextHook() func(context.Context) (string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2773,48 +2868,32 @@ func (f *StoreGetRepositoriesForIndexScanFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *StoreGetRepositoriesForIndexScanFunc) appendCall(r0 StoreGetRepositoriesForIndexScanFuncCall) {
+func (f *StoreGetInferenceScriptFunc) appendCall(r0 StoreGetInferenceScriptFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreGetRepositoriesForIndexScanFuncCall
-// objects describing the invocations of this function.
-func (f *StoreGetRepositoriesForIndexScanFunc) History() []StoreGetRepositoriesForIndexScanFuncCall {
+// History returns a sequence of StoreGetInferenceScriptFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetInferenceScriptFunc) History() []StoreGetInferenceScriptFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreGetRepositoriesForIndexScanFuncCall, len(f.history))
+	history := make([]StoreGetInferenceScriptFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreGetRepositoriesForIndexScanFuncCall is an object that describes an
-// invocation of method GetRepositoriesForIndexScan on an instance of
-// MockStore.
-type StoreGetRepositoriesForIndexScanFuncCall struct {
+// StoreGetInferenceScriptFuncCall is an object that describes an invocation
+// of method GetInferenceScript on an instance of MockStore.
+type StoreGetInferenceScriptFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 time.Duration
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 bool
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 *int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []int
+	Result0 string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2822,47 +2901,47 @@ type StoreGetRepositoriesForIndexScanFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreGetRepositoriesForIndexScanFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+func (c StoreGetInferenceScriptFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreGetRepositoriesForIndexScanFuncCall) Results() []interface{} {
+func (c StoreGetInferenceScriptFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreInsertDependencyIndexingJobFunc describes the behavior when the
-// InsertDependencyIndexingJob method of the parent MockStore instance is
+// StoreGetLastIndexScanForRepositoryFunc describes the behavior when the
+// GetLastIndexScanForRepository method of the parent MockStore instance is
 // invoked.
-type StoreInsertDependencyIndexingJobFunc struct {
-	defaultHook func(context.Context, int, string, time.Time) (int, error)
-	hooks       []func(context.Context, int, string, time.Time) (int, error)
-	history     []StoreInsertDependencyIndexingJobFuncCall
+type StoreGetLastIndexScanForRepositoryFunc struct {
+	defaultHook func(context.Context, int) (*time.Time, error)
+	hooks       []func(context.Context, int) (*time.Time, error)
+	history     []StoreGetLastIndexScanForRepositoryFuncCall
 	mutex       sync.Mutex
 }
 
-// InsertDependencyIndexingJob delegates to the next hook function in the
+// GetLastIndexScanForRepository delegates to the next hook function in the
 // queue and stores the parameter and result values of this invocation.
-func (m *MockStore) InsertDependencyIndexingJob(v0 context.Context, v1 int, v2 string, v3 time.Time) (int, error) {
-	r0, r1 := m.InsertDependencyIndexingJobFunc.nextHook()(v0, v1, v2, v3)
-	m.InsertDependencyIndexingJobFunc.appendCall(StoreInsertDependencyIndexingJobFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockStore) GetLastIndexScanForRepository(v0 context.Context, v1 int) (*time.Time, error) {
+	r0, r1 := m.GetLastIndexScanForRepositoryFunc.nextHook()(v0, v1)
+	m.GetLastIndexScanForRepositoryFunc.appendCall(StoreGetLastIndexScanForRepositoryFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// InsertDependencyIndexingJob method of the parent MockStore instance is
+// GetLastIndexScanForRepository method of the parent MockStore instance is
 // invoked and the hook queue is empty.
-func (f *StoreInsertDependencyIndexingJobFunc) SetDefaultHook(hook func(context.Context, int, string, time.Time) (int, error)) {
+func (f *StoreGetLastIndexScanForRepositoryFunc) SetDefaultHook(hook func(context.Context, int) (*time.Time, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InsertDependencyIndexingJob method of the parent MockStore instance
+// GetLastIndexScanForRepository method of the parent MockStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *StoreInsertDependencyIndexingJobFunc) PushHook(hook func(context.Context, int, string, time.Time) (int, error)) {
+func (f *StoreGetLastIndexScanForRepositoryFunc) PushHook(hook func(context.Context, int) (*time.Time, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2870,20 +2949,20 @@ func (f *StoreInsertDependencyIndexingJobFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreInsertDependencyIndexingJobFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, time.Time) (int, error) {
+func (f *StoreGetLastIndexScanForRepositoryFunc) SetDefaultReturn(r0 *time.Time, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (*time.Time, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreInsertDependencyIndexingJobFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, int, string, time.Time) (int, error) {
+func (f *StoreGetLastIndexScanForRepositoryFunc) PushReturn(r0 *time.Time, r1 error) {
+	f.PushHook(func(context.Context, int) (*time.Time, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreInsertDependencyIndexingJobFunc) nextHook() func(context.Context, int, string, time.Time) (int, error) {
+func (f *StoreGetLastIndexScanForRepositoryFunc) nextHook() func(context.Context, int) (*time.Time, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2896,42 +2975,36 @@ func (f *StoreInsertDependencyIndexingJobFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *StoreInsertDependencyIndexingJobFunc) appendCall(r0 StoreInsertDependencyIndexingJobFuncCall) {
+func (f *StoreGetLastIndexScanForRepositoryFunc) appendCall(r0 StoreGetLastIndexScanForRepositoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreInsertDependencyIndexingJobFuncCall
+// History returns a sequence of StoreGetLastIndexScanForRepositoryFuncCall
 // objects describing the invocations of this function.
-func (f *StoreInsertDependencyIndexingJobFunc) History() []StoreInsertDependencyIndexingJobFuncCall {
+func (f *StoreGetLastIndexScanForRepositoryFunc) History() []StoreGetLastIndexScanForRepositoryFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreInsertDependencyIndexingJobFuncCall, len(f.history))
+	history := make([]StoreGetLastIndexScanForRepositoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreInsertDependencyIndexingJobFuncCall is an object that describes an
-// invocation of method InsertDependencyIndexingJob on an instance of
+// StoreGetLastIndexScanForRepositoryFuncCall is an object that describes an
+// invocation of method GetLastIndexScanForRepository on an instance of
 // MockStore.
-type StoreInsertDependencyIndexingJobFuncCall struct {
+type StoreGetLastIndexScanForRepositoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 *time.Time
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2939,44 +3012,45 @@ type StoreInsertDependencyIndexingJobFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreInsertDependencyIndexingJobFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c StoreGetLastIndexScanForRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreInsertDependencyIndexingJobFuncCall) Results() []interface{} {
+func (c StoreGetLastIndexScanForRepositoryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreInsertIndexesFunc describes the behavior when the InsertIndexes
-// method of the parent MockStore instance is invoked.
-type StoreInsertIndexesFunc struct {
-	defaultHook func(context.Context, []shared1.Index) ([]shared1.Index, error)
-	hooks       []func(context.Context, []shared1.Index) ([]shared1.Index, error)
-	history     []StoreInsertIndexesFuncCall
+// StoreGetQueuedRepoRevFunc describes the behavior when the
+// GetQueuedRepoRev method of the parent MockStore instance is invoked.
+type StoreGetQueuedRepoRevFunc struct {
+	defaultHook func(context.Context, int) ([]store.RepoRev, error)
+	hooks       []func(context.Context, int) ([]store.RepoRev, error)
+	history     []StoreGetQueuedRepoRevFuncCall
 	mutex       sync.Mutex
 }
 
-// InsertIndexes delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockStore) InsertIndexes(v0 context.Context, v1 []shared1.Index) ([]shared1.Index, error) {
-	r0, r1 := m.InsertIndexesFunc.nextHook()(v0, v1)
-	m.InsertIndexesFunc.appendCall(StoreInsertIndexesFuncCall{v0, v1, r0, r1})
+// GetQueuedRepoRev delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetQueuedRepoRev(v0 context.Context, v1 int) ([]store.RepoRev, error) {
+	r0, r1 := m.GetQueuedRepoRevFunc.nextHook()(v0, v1)
+	m.GetQueuedRepoRevFunc.appendCall(StoreGetQueuedRepoRevFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the InsertIndexes method
-// of the parent MockStore instance is invoked and the hook queue is empty.
-func (f *StoreInsertIndexesFunc) SetDefaultHook(hook func(context.Context, []shared1.Index) ([]shared1.Index, error)) {
+// SetDefaultHook sets function that is called when the GetQueuedRepoRev
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetQueuedRepoRevFunc) SetDefaultHook(hook func(context.Context, int) ([]store.RepoRev, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InsertIndexes method of the parent MockStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// GetQueuedRepoRev method of the parent MockStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *StoreInsertIndexesFunc) PushHook(hook func(context.Context, []shared1.Index) ([]shared1.Index, error)) {
+func (f *StoreGetQueuedRepoRevFunc) PushHook(hook func(context.Context, int) ([]store.RepoRev, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2984,20 +3058,20 @@ func (f *StoreInsertIndexesFunc) PushHook(hook func(context.Context, []shared1.I
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreInsertIndexesFunc) SetDefaultReturn(r0 []shared1.Index, r1 error) {
-	f.SetDefaultHook(func(context.Context, []shared1.Index) ([]shared1.Index, error) {
+func (f *StoreGetQueuedRepoRevFunc) SetDefaultReturn(r0 []store.RepoRev, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]store.RepoRev, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreInsertIndexesFunc) PushReturn(r0 []shared1.Index, r1 error) {
-	f.PushHook(func(context.Context, []shared1.Index) ([]shared1.Index, error) {
+func (f *StoreGetQueuedRepoRevFunc) PushReturn(r0 []store.RepoRev, r1 error) {
+	f.PushHook(func(context.Context, int) ([]store.RepoRev, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreInsertIndexesFunc) nextHook() func(context.Context, []shared1.Index) ([]shared1.Index, error) {
+func (f *StoreGetQueuedRepoRevFunc) nextHook() func(context.Context, int) ([]store.RepoRev, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3010,35 +3084,35 @@ func (f *StoreInsertIndexesFunc) nextHook() func(context.Context, []shared1.Inde
 	return hook
 }
 
-func (f *StoreInsertIndexesFunc) appendCall(r0 StoreInsertIndexesFuncCall) {
+func (f *StoreGetQueuedRepoRevFunc) appendCall(r0 StoreGetQueuedRepoRevFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreInsertIndexesFuncCall objects
+// History returns a sequence of StoreGetQueuedRepoRevFuncCall objects
 // describing the invocations of this function.
-func (f *StoreInsertIndexesFunc) History() []StoreInsertIndexesFuncCall {
+func (f *StoreGetQueuedRepoRevFunc) History() []StoreGetQueuedRepoRevFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreInsertIndexesFuncCall, len(f.history))
+	history := make([]StoreGetQueuedRepoRevFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreInsertIndexesFuncCall is an object that describes an invocation of
-// method InsertIndexes on an instance of MockStore.
-type StoreInsertIndexesFuncCall struct {
+// StoreGetQueuedRepoRevFuncCall is an object that describes an invocation
+// of method GetQueuedRepoRev on an instance of MockStore.
+type StoreGetQueuedRepoRevFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []shared1.Index
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []shared1.Index
+	Result0 []store.RepoRev
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3046,44 +3120,47 @@ type StoreInsertIndexesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreInsertIndexesFuncCall) Args() []interface{} {
+func (c StoreGetQueuedRepoRevFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreInsertIndexesFuncCall) Results() []interface{} {
+func (c StoreGetQueuedRepoRevFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreIsQueuedFunc describes the behavior when the IsQueued method of the
-// parent MockStore instance is invoked.
-type StoreIsQueuedFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []StoreIsQueuedFuncCall
+// StoreGetRepositoriesForCoverageFunc describes the behavior when the
+// GetRepositoriesForCoverage method of the parent MockStore instance is
+// invoked.
+type StoreGetRepositoriesForCoverageFunc struct {
+	defaultHook func(context.Context, time.Duration, int, time.Time) ([]int, error)
+	hooks       []func(context.Context, time.Duration, int, time.Time) ([]int, error)
+	history     []StoreGetRepositoriesForCoverageFuncCall
 	mutex       sync.Mutex
 }
 
-// IsQueued delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockStore) IsQueued(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.IsQueuedFunc.nextHook()(v0, v1, v2)
-	m.IsQueuedFunc.appendCall(StoreIsQueuedFuncCall{v0, v1, v2, r0, r1})
+// GetRepositoriesForCoverage delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRepositoriesForCoverage(v0 context.Context, v1 time.Duration, v2 int, v3 time.Time) ([]int, error) {
+	r0, r1 := m.GetRepositoriesForCoverageFunc.nextHook()(v0, v1, v2, v3)
+	m.GetRepositoriesForCoverageFunc.appendCall(StoreGetRepositoriesForCoverageFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IsQueued method of
-// the parent MockStore instance is invoked and the hook queue is empty.
-func (f *StoreIsQueuedFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the
+// GetRepositoriesForCoverage method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetRepositoriesForCoverageFunc) SetDefaultHook(hook func(context.Context, time.Duration, int, time.Time) ([]int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsQueued method of the parent MockStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *StoreIsQueuedFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+// GetRepositoriesForCoverage method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetRepositoriesForCoverageFunc) PushHook(hook func(context.Context, time.Duration, int, time.Time) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3091,20 +3168,20 @@ func (f *StoreIsQueuedFunc) PushHook(hook func(context.Context, int, string) (bo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreIsQueuedFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+func (f *StoreGetRepositoriesForCoverageFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, int, time.Time) ([]int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreIsQueuedFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
+func (f *StoreGetRepositoriesForCoverageFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, int, time.Time) ([]int, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreIsQueuedFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *StoreGetRepositoriesForCoverageFunc) nextHook() func(context.Context, time.Duration, int, time.Time) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3117,38 +3194,42 @@ func (f *StoreIsQueuedFunc) nextHook() func(context.Context, int, string) (bool,
 	return hook
 }
 
-func (f *StoreIsQueuedFunc) appendCall(r0 StoreIsQueuedFuncCall) {
+func (f *StoreGetRepositoriesForCoverageFunc) appendCall(r0 StoreGetRepositoriesForCoverageFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreIsQueuedFuncCall objects describing
-// the invocations of this function.
-func (f *StoreIsQueuedFunc) History() []StoreIsQueuedFuncCall {
+// History returns a sequence of StoreGetRepositoriesForCoverageFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetRepositoriesForCoverageFunc) History() []StoreGetRepositoriesForCoverageFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreIsQueuedFuncCall, len(f.history))
+	history := make([]StoreGetRepositoriesForCoverageFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreIsQueuedFuncCall is an object that describes an invocation of method
-// IsQueued on an instance of MockStore.
-type StoreIsQueuedFuncCall struct {
+// StoreGetRepositoriesForCoverageFuncCall is an object that describes an
+// invocation of method GetRepositoriesForCoverage on an instance of
+// MockStore.
+type StoreGetRepositoriesForCoverageFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 time.Duration
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3156,45 +3237,47 @@ type StoreIsQueuedFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreIsQueuedFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c StoreGetRepositoriesForCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreIsQueuedFuncCall) Results() []interface{} {
+func (c StoreGetRepositoriesForCoverageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreIsQueuedRootIndexerFunc describes the behavior when the
-// IsQueuedRootIndexer method of the parent MockStore instance is invoked.
-type StoreIsQueuedRootIndexerFunc struct {
-	defaultHook func(context.Context, int, string, string, string) (bool, error)
-	hooks       []func(context.Context, int, string, string, string) (bool, error)
-	history     []StoreIsQueuedRootIndexerFuncCall
+// StoreGetRepositoriesForIndexScanFunc describes the behavior when the
+// GetRepositoriesForIndexScan method of the parent MockStore instance is
+// invoked.
+type StoreGetRepositoriesForIndexScanFunc struct {
+	defaultHook func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)
+	hooks       []func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)
+	history     []StoreGetRepositoriesForIndexScanFuncCall
 	mutex       sync.Mutex
 }
 
-// IsQueuedRootIndexer delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockStore) IsQueuedRootIndexer(v0 context.Context, v1 int, v2 string, v3 string, v4 string) (bool, error) {
-	r0, r1 := m.IsQueuedRootIndexerFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.IsQueuedRootIndexerFunc.appendCall(StoreIsQueuedRootIndexerFuncCall{v0, v1, v2, v3, v4, r0, r1})
+// GetRepositoriesForIndexScan delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRepositoriesForIndexScan(v0 context.Context, v1 time.Duration, v2 bool, v3 *int, v4 int, v5 time.Time) ([]int, error) {
+	r0, r1 := m.GetRepositoriesForIndexScanFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.GetRepositoriesForIndexScanFunc.appendCall(StoreGetRepositoriesForIndexScanFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IsQueuedRootIndexer
-// method of the parent MockStore instance is invoked and the hook queue is
-// empty.
-func (f *StoreIsQueuedRootIndexerFunc) SetDefaultHook(hook func(context.Context, int, string, string, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the
+// GetRepositoriesForIndexScan method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetRepositoriesForIndexScanFunc) SetDefaultHook(hook func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsQueuedRootIndexer method of the parent MockStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *StoreIsQueuedRootIndexerFunc) PushHook(hook func(context.Context, int, string, string, string) (bool, error)) {
+// GetRepositoriesForIndexScan method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetRepositoriesForIndexScanFunc) PushHook(hook func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3202,20 +3285,20 @@ func (f *StoreIsQueuedRootIndexerFunc) PushHook(hook func(context.Context, int,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreIsQueuedRootIndexerFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string, string) (bool, error) {
+func (f *StoreGetRepositoriesForIndexScanFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreIsQueuedRootIndexerFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string, string, string) (bool, error) {
+func (f *StoreGetRepositoriesForIndexScanFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
 		return r0, r1
 	})
 }
 
-func (f *StoreIsQueuedRootIndexerFunc) nextHook() func(context.Context, int, string, string, string) (bool, error) {
+func (f *StoreGetRepositoriesForIndexScanFunc) nextHook() func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3228,44 +3311,48 @@ func (f *StoreIsQueuedRootIndexerFunc) nextHook() func(context.Context, int, str
 	return hook
 }
 
-func (f *StoreIsQueuedRootIndexerFunc) appendCall(r0 StoreIsQueuedRootIndexerFuncCall) {
+func (f *StoreGetRepositoriesForIndexScanFunc) appendCall(r0 StoreGetRepositoriesForIndexScanFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreIsQueuedRootIndexerFuncCall objects
-// describing the invocations of this function.
-func (f *StoreIsQueuedRootIndexerFunc) History() []StoreIsQueuedRootIndexerFuncCall {
+// History returns a sequence of StoreGetRepositoriesForIndexScanFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetRepositoriesForIndexScanFunc) History() []StoreGetRepositoriesForIndexScanFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreIsQueuedRootIndexerFuncCall, len(f.history))
+	history := make([]StoreGetRepositoriesForIndexScanFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreIsQueuedRootIndexerFuncCall is an object that describes an
-// invocation of method IsQueuedRootIndexer on an instance of MockStore.
-type StoreIsQueuedRootIndexerFuncCall struct {
+// StoreGetRepositoriesForIndexScanFuncCall is an object that describes an
+// invocation of method GetRepositoriesForIndexScan on an instance of
+// MockStore.
+type StoreGetRepositoriesForIndexScanFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 time.Duration
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 bool
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 string
+	Arg3 *int
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
-	Arg4 string
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3273,46 +3360,46 @@ type StoreIsQueuedRootIndexerFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreIsQueuedRootIndexerFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c StoreGetRepositoriesForIndexScanFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreIsQueuedRootIndexerFuncCall) Results() []interface{} {
+func (c StoreGetRepositoriesForIndexScanFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreMarkRepoRevsAsProcessedFunc describes the behavior when the
-// MarkRepoRevsAsProcessed method of the parent MockStore instance is
+// StoreInsertCoverageSnapshotsFunc describes the behavior when the
+// InsertCoverageSnapshots method of the parent MockStore instance is
 // invoked.
-type StoreMarkRepoRevsAsProcessedFunc struct {
-	defaultHook func(context.Context, []int) error
-	hooks       []func(context.Context, []int) error
-	history     []StoreMarkRepoRevsAsProcessedFuncCall
+type StoreInsertCoverageSnapshotsFunc struct {
+	defaultHook func(context.Context, []shared2.CoverageSnapshot) error
+	hooks       []func(context.Context, []shared2.CoverageSnapshot) error
+	history     []StoreInsertCoverageSnapshotsFuncCall
 	mutex       sync.Mutex
 }
 
-// MarkRepoRevsAsProcessed delegates to the next hook function in the queue
+// InsertCoverageSnapshots delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockStore) MarkRepoRevsAsProcessed(v0 context.Context, v1 []int) error {
-	r0 := m.MarkRepoRevsAsProcessedFunc.nextHook()(v0, v1)
-	m.MarkRepoRevsAsProcessedFunc.appendCall(StoreMarkRepoRevsAsProcessedFuncCall{v0, v1, r0})
+func (m *MockStore) InsertCoverageSnapshots(v0 context.Context, v1 []shared2.CoverageSnapshot) error {
+	r0 := m.InsertCoverageSnapshotsFunc.nextHook()(v0, v1)
+	m.InsertCoverageSnapshotsFunc.appendCall(StoreInsertCoverageSnapshotsFuncCall{v0, v1, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// MarkRepoRevsAsProcessed method of the parent MockStore instance is
+// InsertCoverageSnapshots method of the parent MockStore instance is
 // invoked and the hook queue is empty.
-func (f *StoreMarkRepoRevsAsProcessedFunc) SetDefaultHook(hook func(context.Context, []int) error) {
+func (f *StoreInsertCoverageSnapshotsFunc) SetDefaultHook(hook func(context.Context, []shared2.CoverageSnapshot) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// MarkRepoRevsAsProcessed method of the parent MockStore instance invokes
+// InsertCoverageSnapshots method of the parent MockStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *StoreMarkRepoRevsAsProcessedFunc) PushHook(hook func(context.Context, []int) error) {
+func (f *StoreInsertCoverageSnapshotsFunc) PushHook(hook func(context.Context, []shared2.CoverageSnapshot) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3320,20 +3407,20 @@ func (f *StoreMarkRepoRevsAsProcessedFunc) PushHook(hook func(context.Context, [
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreMarkRepoRevsAsProcessedFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, []int) error {
+func (f *StoreInsertCoverageSnapshotsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, []shared2.CoverageSnapshot) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreMarkRepoRevsAsProcessedFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, []int) error {
+func (f *StoreInsertCoverageSnapshotsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, []shared2.CoverageSnapshot) error {
 		return r0
 	})
 }
 
-func (f *StoreMarkRepoRevsAsProcessedFunc) nextHook() func(context.Context, []int) error {
+func (f *StoreInsertCoverageSnapshotsFunc) nextHook() func(context.Context, []shared2.CoverageSnapshot) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3346,32 +3433,32 @@ func (f *StoreMarkRepoRevsAsProcessedFunc) nextHook() func(context.Context, []in
 	return hook
 }
 
-func (f *StoreMarkRepoRevsAsProcessedFunc) appendCall(r0 StoreMarkRepoRevsAsProcessedFuncCall) {
+func (f *StoreInsertCoverageSnapshotsFunc) appendCall(r0 StoreInsertCoverageSnapshotsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreMarkRepoRevsAsProcessedFuncCall
+// History returns a sequence of StoreInsertCoverageSnapshotsFuncCall
 // objects describing the invocations of this function.
-func (f *StoreMarkRepoRevsAsProcessedFunc) History() []StoreMarkRepoRevsAsProcessedFuncCall {
+func (f *StoreInsertCoverageSnapshotsFunc) History() []StoreInsertCoverageSnapshotsFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreMarkRepoRevsAsProcessedFuncCall, len(f.history))
+	history := make([]StoreInsertCoverageSnapshotsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreMarkRepoRevsAsProcessedFuncCall is an object that describes an
-// invocation of method MarkRepoRevsAsProcessed on an instance of MockStore.
-type StoreMarkRepoRevsAsProcessedFuncCall struct {
+// StoreInsertCoverageSnapshotsFuncCall is an object that describes an
+// invocation of method InsertCoverageSnapshots on an instance of MockStore.
+type StoreInsertCoverageSnapshotsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []int
+	Arg1 []shared2.CoverageSnapshot
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -3379,44 +3466,47 @@ type StoreMarkRepoRevsAsProcessedFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreMarkRepoRevsAsProcessedFuncCall) Args() []interface{} {
+func (c StoreInsertCoverageSnapshotsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreMarkRepoRevsAsProcessedFuncCall) Results() []interface{} {
+func (c StoreInsertCoverageSnapshotsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// StoreQueueRepoRevFunc describes the behavior when the QueueRepoRev method
-// of the parent MockStore instance is invoked.
-type StoreQueueRepoRevFunc struct {
-	defaultHook func(context.Context, int, string) error
-	hooks       []func(context.Context, int, string) error
-	history     []StoreQueueRepoRevFuncCall
+// StoreInsertDependencyIndexingJobFunc describes the behavior when the
+// InsertDependencyIndexingJob method of the parent MockStore instance is
+// invoked.
+type StoreInsertDependencyIndexingJobFunc struct {
+	defaultHook func(context.Context, int, string, time.Time) (int, error)
+	hooks       []func(context.Context, int, string, time.Time) (int, error)
+	history     []StoreInsertDependencyIndexingJobFuncCall
 	mutex       sync.Mutex
 }
 
-// QueueRepoRev delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockStore) QueueRepoRev(v0 context.Context, v1 int, v2 string) error {
-	r0 := m.QueueRepoRevFunc.nextHook()(v0, v1, v2)
-	m.QueueRepoRevFunc.appendCall(StoreQueueRepoRevFuncCall{v0, v1, v2, r0})
-	return r0
+// InsertDependencyIndexingJob delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) InsertDependencyIndexingJob(v0 context.Context, v1 int, v2 string, v3 time.Time) (int, error) {
+	r0, r1 := m.InsertDependencyIndexingJobFunc.nextHook()(v0, v1, v2, v3)
+	m.InsertDependencyIndexingJobFunc.appendCall(StoreInsertDependencyIndexingJobFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the QueueRepoRev method
-// of the parent MockStore instance is invoked and the hook queue is empty.
-func (f *StoreQueueRepoRevFunc) SetDefaultHook(hook func(context.Context, int, string) error) {
+// SetDefaultHook sets function that is called when the
+// InsertDependencyIndexingJob method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreInsertDependencyIndexingJobFunc) SetDefaultHook(hook func(context.Context, int, string, time.Time) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// QueueRepoRev method of the parent MockStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *StoreQueueRepoRevFunc) PushHook(hook func(context.Context, int, string) error) {
+// InsertDependencyIndexingJob method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreInsertDependencyIndexingJobFunc) PushHook(hook func(context.Context, int, string, time.Time) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3424,20 +3514,20 @@ func (f *StoreQueueRepoRevFunc) PushHook(hook func(context.Context, int, string)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreQueueRepoRevFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int, string) error {
-		return r0
+func (f *StoreInsertDependencyIndexingJobFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreQueueRepoRevFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int, string) error {
-		return r0
+func (f *StoreInsertDependencyIndexingJobFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int, string, time.Time) (int, error) {
+		return r0, r1
 	})
 }
 
-func (f *StoreQueueRepoRevFunc) nextHook() func(context.Context, int, string) error {
+func (f *StoreInsertDependencyIndexingJobFunc) nextHook() func(context.Context, int, string, time.Time) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3450,26 +3540,27 @@ func (f *StoreQueueRepoRevFunc) nextHook() func(context.Context, int, string) er
 	return hook
 }
 
-func (f *StoreQueueRepoRevFunc) appendCall(r0 StoreQueueRepoRevFuncCall) {
+func (f *StoreInsertDependencyIndexingJobFunc) appendCall(r0 StoreInsertDependencyIndexingJobFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreQueueRepoRevFuncCall objects
-// describing the invocations of this function.
-func (f *StoreQueueRepoRevFunc) History() []StoreQueueRepoRevFuncCall {
+// History returns a sequence of StoreInsertDependencyIndexingJobFuncCall
+// objects describing the invocations of this function.
+func (f *StoreInsertDependencyIndexingJobFunc) History() []StoreInsertDependencyIndexingJobFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreQueueRepoRevFuncCall, len(f.history))
+	history := make([]StoreInsertDependencyIndexingJobFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreQueueRepoRevFuncCall is an object that describes an invocation of
-// method QueueRepoRev on an instance of MockStore.
-type StoreQueueRepoRevFuncCall struct {
+// StoreInsertDependencyIndexingJobFuncCall is an object that describes an
+// invocation of method InsertDependencyIndexingJob on an instance of
+// MockStore.
+type StoreInsertDependencyIndexingJobFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3479,52 +3570,57 @@ type StoreQueueRepoRevFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreQueueRepoRevFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c StoreInsertDependencyIndexingJobFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreQueueRepoRevFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c StoreInsertDependencyIndexingJobFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreRepositoryExceptionsFunc describes the behavior when the
-// RepositoryExceptions method of the parent MockStore instance is invoked.
-type StoreRepositoryExceptionsFunc struct {
-	defaultHook func(context.Context, int) (bool, bool, error)
-	hooks       []func(context.Context, int) (bool, bool, error)
-	history     []StoreRepositoryExceptionsFuncCall
+// StoreInsertIndexesFunc describes the behavior when the InsertIndexes
+// method of the parent MockStore instance is invoked.
+type StoreInsertIndexesFunc struct {
+	defaultHook func(context.Context, []shared1.Index) ([]shared1.Index, error)
+	hooks       []func(context.Context, []shared1.Index) ([]shared1.Index, error)
+	history     []StoreInsertIndexesFuncCall
 	mutex       sync.Mutex
 }
 
-// RepositoryExceptions delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockStore) RepositoryExceptions(v0 context.Context, v1 int) (bool, bool, error) {
-	r0, r1, r2 := m.RepositoryExceptionsFunc.nextHook()(v0, v1)
-	m.RepositoryExceptionsFunc.appendCall(StoreRepositoryExceptionsFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+// InsertIndexes delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockStore) InsertIndexes(v0 context.Context, v1 []shared1.Index) ([]shared1.Index, error) {
+	r0, r1 := m.InsertIndexesFunc.nextHook()(v0, v1)
+	m.InsertIndexesFunc.appendCall(StoreInsertIndexesFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RepositoryExceptions
-// method of the parent MockStore instance is invoked and the hook queue is
-// empty.
-func (f *StoreRepositoryExceptionsFunc) SetDefaultHook(hook func(context.Context, int) (bool, bool, error)) {
+// SetDefaultHook sets function that is called when the InsertIndexes method
+// of the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreInsertIndexesFunc) SetDefaultHook(hook func(context.Context, []shared1.Index) ([]shared1.Index, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepositoryExceptions method of the parent MockStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *StoreRepositoryExceptionsFunc) PushHook(hook func(context.Context, int) (bool, bool, error)) {
+// InsertIndexes method of the parent MockStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreInsertIndexesFunc) PushHook(hook func(context.Context, []shared1.Index) ([]shared1.Index, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3532,20 +3628,20 @@ func (f *StoreRepositoryExceptionsFunc) PushHook(hook func(context.Context, int)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreRepositoryExceptionsFunc) SetDefaultReturn(r0 bool, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (bool, bool, error) {
-		return r0, r1, r2
+func (f *StoreInsertIndexesFunc) SetDefaultReturn(r0 []shared1.Index, r1 error) {
+	f.SetDefaultHook(func(context.Context, []shared1.Index) ([]shared1.Index, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreRepositoryExceptionsFunc) PushReturn(r0 bool, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (bool, bool, error) {
-		return r0, r1, r2
+func (f *StoreInsertIndexesFunc) PushReturn(r0 []shared1.Index, r1 error) {
+	f.PushHook(func(context.Context, []shared1.Index) ([]shared1.Index, error) {
+		return r0, r1
 	})
 }
 
-func (f *StoreRepositoryExceptionsFunc) nextHook() func(context.Context, int) (bool, bool, error) {
+func (f *StoreInsertIndexesFunc) nextHook() func(context.Context, []shared1.Index) ([]shared1.Index, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3558,86 +3654,80 @@ func (f *StoreRepositoryExceptionsFunc) nextHook() func(context.Context, int) (b
 	return hook
 }
 
-func (f *StoreRepositoryExceptionsFunc) appendCall(r0 StoreRepositoryExceptionsFuncCall) {
+func (f *StoreInsertIndexesFunc) appendCall(r0 StoreInsertIndexesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreRepositoryExceptionsFuncCall objects
+// History returns a sequence of StoreInsertIndexesFuncCall objects
 // describing the invocations of this function.
-func (f *StoreRepositoryExceptionsFunc) History() []StoreRepositoryExceptionsFuncCall {
+func (f *StoreInsertIndexesFunc) History() []StoreInsertIndexesFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreRepositoryExceptionsFuncCall, len(f.history))
+	history := make([]StoreInsertIndexesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreRepositoryExceptionsFuncCall is an object that describes an
-// invocation of method RepositoryExceptions on an instance of MockStore.
-type StoreRepositoryExceptionsFuncCall struct {
+// StoreInsertIndexesFuncCall is an object that describes an invocation of
+// method InsertIndexes on an instance of MockStore.
+type StoreInsertIndexesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 []shared1.Index
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []shared1.Index
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreRepositoryExceptionsFuncCall) Args() []interface{} {
+func (c StoreInsertIndexesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreRepositoryExceptionsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c StoreInsertIndexesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreRepositoryIDsWithConfigurationFunc describes the behavior when the
-// RepositoryIDsWithConfiguration method of the parent MockStore instance is
-// invoked.
-type StoreRepositoryIDsWithConfigurationFunc struct {
-	defaultHook func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)
-	hooks       []func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)
-	history     []StoreRepositoryIDsWithConfigurationFuncCall
+// StoreIsQueuedFunc describes the behavior when the IsQueued method of the
+// parent MockStore instance is invoked.
+type StoreIsQueuedFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []StoreIsQueuedFuncCall
 	mutex       sync.Mutex
 }
 
-// RepositoryIDsWithConfiguration delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockStore) RepositoryIDsWithConfiguration(v0 context.Context, v1 int, v2 int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
-	r0, r1, r2 := m.RepositoryIDsWithConfigurationFunc.nextHook()(v0, v1, v2)
-	m.RepositoryIDsWithConfigurationFunc.appendCall(StoreRepositoryIDsWithConfigurationFuncCall{v0, v1, v2, r0, r1, r2})
-	return r0, r1, r2
+// IsQueued delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore) IsQueued(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.IsQueuedFunc.nextHook()(v0, v1, v2)
+	m.IsQueuedFunc.appendCall(StoreIsQueuedFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// RepositoryIDsWithConfiguration method of the parent MockStore instance is
-// invoked and the hook queue is empty.
-func (f *StoreRepositoryIDsWithConfigurationFunc) SetDefaultHook(hook func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)) {
+// SetDefaultHook sets function that is called when the IsQueued method of
+// the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreIsQueuedFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepositoryIDsWithConfiguration method of the parent MockStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *StoreRepositoryIDsWithConfigurationFunc) PushHook(hook func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)) {
+// IsQueued method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreIsQueuedFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3645,20 +3735,20 @@ func (f *StoreRepositoryIDsWithConfigurationFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreRepositoryIDsWithConfigurationFunc) SetDefaultReturn(r0 []shared1.RepositoryWithAvailableIndexers, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
-		return r0, r1, r2
+func (f *StoreIsQueuedFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreRepositoryIDsWithConfigurationFunc) PushReturn(r0 []shared1.RepositoryWithAvailableIndexers, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
-		return r0, r1, r2
+func (f *StoreIsQueuedFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *StoreRepositoryIDsWithConfigurationFunc) nextHook() func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
+func (f *StoreIsQueuedFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3671,27 +3761,26 @@ func (f *StoreRepositoryIDsWithConfigurationFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *StoreRepositoryIDsWithConfigurationFunc) appendCall(r0 StoreRepositoryIDsWithConfigurationFuncCall) {
+func (f *StoreIsQueuedFunc) appendCall(r0 StoreIsQueuedFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreRepositoryIDsWithConfigurationFuncCall
-// objects describing the invocations of this function.
-func (f *StoreRepositoryIDsWithConfigurationFunc) History() []StoreRepositoryIDsWithConfigurationFuncCall {
+// History returns a sequence of StoreIsQueuedFuncCall objects describing
+// the invocations of this function.
+func (f *StoreIsQueuedFunc) History() []StoreIsQueuedFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreRepositoryIDsWithConfigurationFuncCall, len(f.history))
+	history := make([]StoreIsQueuedFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreRepositoryIDsWithConfigurationFuncCall is an object that describes
-// an invocation of method RepositoryIDsWithConfiguration on an instance of
-// MockStore.
-type StoreRepositoryIDsWithConfigurationFuncCall struct {
+// StoreIsQueuedFuncCall is an object that describes an invocation of method
+// IsQueued on an instance of MockStore.
+type StoreIsQueuedFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3700,60 +3789,56 @@ type StoreRepositoryIDsWithConfigurationFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 int
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []shared1.RepositoryWithAvailableIndexers
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreRepositoryIDsWithConfigurationFuncCall) Args() []interface{} {
+func (c StoreIsQueuedFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreRepositoryIDsWithConfigurationFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c StoreIsQueuedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// StoreSetConfigurationSummaryFunc describes the behavior when the
-// SetConfigurationSummary method of the parent MockStore instance is
-// invoked.
-type StoreSetConfigurationSummaryFunc struct {
-	defaultHook func(context.Context, int, int, map[string]shared1.AvailableIndexer) error
-	hooks       []func(context.Context, int, int, map[string]shared1.AvailableIndexer) error
-	history     []StoreSetConfigurationSummaryFuncCall
+// StoreIsQueuedRootIndexerFunc describes the behavior when the
+// IsQueuedRootIndexer method of the parent MockStore instance is invoked.
+type StoreIsQueuedRootIndexerFunc struct {
+	defaultHook func(context.Context, int, string, string, string) (bool, error)
+	hooks       []func(context.Context, int, string, string, string) (bool, error)
+	history     []StoreIsQueuedRootIndexerFuncCall
 	mutex       sync.Mutex
 }
 
-// SetConfigurationSummary delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockStore) SetConfigurationSummary(v0 context.Context, v1 int, v2 int, v3 map[string]shared1.AvailableIndexer) error {
-	r0 := m.SetConfigurationSummaryFunc.nextHook()(v0, v1, v2, v3)
-	m.SetConfigurationSummaryFunc.appendCall(StoreSetConfigurationSummaryFuncCall{v0, v1, v2, v3, r0})
-	return r0
+// IsQueuedRootIndexer delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) IsQueuedRootIndexer(v0 context.Context, v1 int, v2 string, v3 string, v4 string) (bool, error) {
+	r0, r1 := m.IsQueuedRootIndexerFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.IsQueuedRootIndexerFunc.appendCall(StoreIsQueuedRootIndexerFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// SetConfigurationSummary method of the parent MockStore instance is
-// invoked and the hook queue is empty.
-func (f *StoreSetConfigurationSummaryFunc) SetDefaultHook(hook func(context.Context, int, int, map[string]shared1.AvailableIndexer) error) {
+// SetDefaultHook sets function that is called when the IsQueuedRootIndexer
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreIsQueuedRootIndexerFunc) SetDefaultHook(hook func(context.Context, int, string, string, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetConfigurationSummary method of the parent MockStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *StoreSetConfigurationSummaryFunc) PushHook(hook func(context.Context, int, int, map[string]shared1.AvailableIndexer) error) {
+// IsQueuedRootIndexer method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreIsQueuedRootIndexerFunc) PushHook(hook func(context.Context, int, string, string, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3761,20 +3846,20 @@ func (f *StoreSetConfigurationSummaryFunc) PushHook(hook func(context.Context, i
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreSetConfigurationSummaryFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int, int, map[string]shared1.AvailableIndexer) error {
-		return r0
+func (f *StoreIsQueuedRootIndexerFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string, string) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreSetConfigurationSummaryFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int, int, map[string]shared1.AvailableIndexer) error {
-		return r0
+func (f *StoreIsQueuedRootIndexerFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string, string, string) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *StoreSetConfigurationSummaryFunc) nextHook() func(context.Context, int, int, map[string]shared1.AvailableIndexer) error {
+func (f *StoreIsQueuedRootIndexerFunc) nextHook() func(context.Context, int, string, string, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3787,26 +3872,26 @@ func (f *StoreSetConfigurationSummaryFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *StoreSetConfigurationSummaryFunc) appendCall(r0 StoreSetConfigurationSummaryFuncCall) {
+func (f *StoreIsQueuedRootIndexerFunc) appendCall(r0 StoreIsQueuedRootIndexerFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreSetConfigurationSummaryFuncCall
-// objects describing the invocations of this function.
-func (f *StoreSetConfigurationSummaryFunc) History() []StoreSetConfigurationSummaryFuncCall {
+// History returns a sequence of StoreIsQueuedRootIndexerFuncCall objects
+// describing the invocations of this function.
+func (f *StoreIsQueuedRootIndexerFunc) History() []StoreIsQueuedRootIndexerFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreSetConfigurationSummaryFuncCall, len(f.history))
+	history := make([]StoreIsQueuedRootIndexerFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreSetConfigurationSummaryFuncCall is an object that describes an
-// invocation of method SetConfigurationSummary on an instance of MockStore.
-type StoreSetConfigurationSummaryFuncCall struct {
+// StoreIsQueuedRootIndexerFuncCall is an object that describes an
+// invocation of method IsQueuedRootIndexer on an instance of MockStore.
+type StoreIsQueuedRootIndexerFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3815,56 +3900,63 @@ type StoreSetConfigurationSummaryFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 int
+	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 map[string]shared1.AvailableIndexer
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreSetConfigurationSummaryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c StoreIsQueuedRootIndexerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreSetConfigurationSummaryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
-}
-
-// StoreSetInferenceScriptFunc describes the behavior when the
-// SetInferenceScript method of the parent MockStore instance is invoked.
-type StoreSetInferenceScriptFunc struct {
-	defaultHook func(context.Context, string) error
-	hooks       []func(context.Context, string) error
-	history     []StoreSetInferenceScriptFuncCall
-	mutex       sync.Mutex
+func (c StoreIsQueuedRootIndexerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// SetInferenceScript delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockStore) SetInferenceScript(v0 context.Context, v1 string) error {
-	r0 := m.SetInferenceScriptFunc.nextHook()(v0, v1)
-	m.SetInferenceScriptFunc.appendCall(StoreSetInferenceScriptFuncCall{v0, v1, r0})
+// StoreMarkRepoRevsAsProcessedFunc describes the behavior when the
+// MarkRepoRevsAsProcessed method of the parent MockStore instance is
+// invoked.
+type StoreMarkRepoRevsAsProcessedFunc struct {
+	defaultHook func(context.Context, []int) error
+	hooks       []func(context.Context, []int) error
+	history     []StoreMarkRepoRevsAsProcessedFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkRepoRevsAsProcessed delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) MarkRepoRevsAsProcessed(v0 context.Context, v1 []int) error {
+	r0 := m.MarkRepoRevsAsProcessedFunc.nextHook()(v0, v1)
+	m.MarkRepoRevsAsProcessedFunc.appendCall(StoreMarkRepoRevsAsProcessedFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the SetInferenceScript
-// method of the parent MockStore instance is invoked and the hook queue is
-// empty.
-func (f *StoreSetInferenceScriptFunc) SetDefaultHook(hook func(context.Context, string) error) {
+// SetDefaultHook sets function that is called when the
+// MarkRepoRevsAsProcessed method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreMarkRepoRevsAsProcessedFunc) SetDefaultHook(hook func(context.Context, []int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetInferenceScript method of the parent MockStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *StoreSetInferenceScriptFunc) PushHook(hook func(context.Context, string) error) {
+// MarkRepoRevsAsProcessed method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreMarkRepoRevsAsProcessedFunc) PushHook(hook func(context.Context, []int) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3872,20 +3964,20 @@ func (f *StoreSetInferenceScriptFunc) PushHook(hook func(context.Context, string
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreSetInferenceScriptFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, string) error {
+func (f *StoreMarkRepoRevsAsProcessedFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, []int) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreSetInferenceScriptFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, string) error {
+func (f *StoreMarkRepoRevsAsProcessedFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, []int) error {
 		return r0
 	})
 }
 
-func (f *StoreSetInferenceScriptFunc) nextHook() func(context.Context, string) error {
+func (f *StoreMarkRepoRevsAsProcessedFunc) nextHook() func(context.Context, []int) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3898,32 +3990,32 @@ func (f *StoreSetInferenceScriptFunc) nextHook() func(context.Context, string) e
 	return hook
 }
 
-func (f *StoreSetInferenceScriptFunc) appendCall(r0 StoreSetInferenceScriptFuncCall) {
+func (f *StoreMarkRepoRevsAsProcessedFunc) appendCall(r0 StoreMarkRepoRevsAsProcessedFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreSetInferenceScriptFuncCall objects
-// describing the invocations of this function.
-func (f *StoreSetInferenceScriptFunc) History() []StoreSetInferenceScriptFuncCall {
+// History returns a sequence of StoreMarkRepoRevsAsProcessedFuncCall
+// objects describing the invocations of this function.
+func (f *StoreMarkRepoRevsAsProcessedFunc) History() []StoreMarkRepoRevsAsProcessedFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreSetInferenceScriptFuncCall, len(f.history))
+	history := make([]StoreMarkRepoRevsAsProcessedFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreSetInferenceScriptFuncCall is an object that describes an invocation
-// of method SetInferenceScript on an instance of MockStore.
-type StoreSetInferenceScriptFuncCall struct {
+// StoreMarkRepoRevsAsProcessedFuncCall is an object that describes an
+// invocation of method MarkRepoRevsAsProcessed on an instance of MockStore.
+type StoreMarkRepoRevsAsProcessedFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -3931,46 +4023,44 @@ type StoreSetInferenceScriptFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreSetInferenceScriptFuncCall) Args() []interface{} {
+func (c StoreMarkRepoRevsAsProcessedFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreSetInferenceScriptFuncCall) Results() []interface{} {
+func (c StoreMarkRepoRevsAsProcessedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// StoreSetRepositoryExceptionsFunc describes the behavior when the
-// SetRepositoryExceptions method of the parent MockStore instance is
-// invoked.
-type StoreSetRepositoryExceptionsFunc struct {
-	defaultHook func(context.Context, int, bool, bool) error
-	hooks       []func(context.Context, int, bool, bool) error
-	history     []StoreSetRepositoryExceptionsFuncCall
+// StoreQueueRepoRevFunc describes the behavior when the QueueRepoRev method
+// of the parent MockStore instance is invoked.
+type StoreQueueRepoRevFunc struct {
+	defaultHook func(context.Context, int, string) error
+	hooks       []func(context.Context, int, string) error
+	history     []StoreQueueRepoRevFuncCall
 	mutex       sync.Mutex
 }
 
-// SetRepositoryExceptions delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockStore) SetRepositoryExceptions(v0 context.Context, v1 int, v2 bool, v3 bool) error {
-	r0 := m.SetRepositoryExceptionsFunc.nextHook()(v0, v1, v2, v3)
-	m.SetRepositoryExceptionsFunc.appendCall(StoreSetRepositoryExceptionsFuncCall{v0, v1, v2, v3, r0})
+// QueueRepoRev delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockStore) QueueRepoRev(v0 context.Context, v1 int, v2 string) error {
+	r0 := m.QueueRepoRevFunc.nextHook()(v0, v1, v2)
+	m.QueueRepoRevFunc.appendCall(StoreQueueRepoRevFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// SetRepositoryExceptions method of the parent MockStore instance is
-// invoked and the hook queue is empty.
-func (f *StoreSetRepositoryExceptionsFunc) SetDefaultHook(hook func(context.Context, int, bool, bool) error) {
+// SetDefaultHook sets function that is called when the QueueRepoRev method
+// of the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreQueueRepoRevFunc) SetDefaultHook(hook func(context.Context, int, string) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetRepositoryExceptions method of the parent MockStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *StoreSetRepositoryExceptionsFunc) PushHook(hook func(context.Context, int, bool, bool) error) {
+// QueueRepoRev method of the parent MockStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreQueueRepoRevFunc) PushHook(hook func(context.Context, int, string) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3978,20 +4068,20 @@ func (f *StoreSetRepositoryExceptionsFunc) PushHook(hook func(context.Context, i
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreSetRepositoryExceptionsFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int, bool, bool) error {
+func (f *StoreQueueRepoRevFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, string) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreSetRepositoryExceptionsFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int, bool, bool) error {
+func (f *StoreQueueRepoRevFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, string) error {
 		return r0
 	})
 }
 
-func (f *StoreSetRepositoryExceptionsFunc) nextHook() func(context.Context, int, bool, bool) error {
+func (f *StoreQueueRepoRevFunc) nextHook() func(context.Context, int, string) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4004,26 +4094,26 @@ func (f *StoreSetRepositoryExceptionsFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *StoreSetRepositoryExceptionsFunc) appendCall(r0 StoreSetRepositoryExceptionsFuncCall) {
+func (f *StoreQueueRepoRevFunc) appendCall(r0 StoreQueueRepoRevFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreSetRepositoryExceptionsFuncCall
-// objects describing the invocations of this function.
-func (f *StoreSetRepositoryExceptionsFunc) History() []StoreSetRepositoryExceptionsFuncCall {
+// History returns a sequence of StoreQueueRepoRevFuncCall objects
+// describing the invocations of this function.
+func (f *StoreQueueRepoRevFunc) History() []StoreQueueRepoRevFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreSetRepositoryExceptionsFuncCall, len(f.history))
+	history := make([]StoreQueueRepoRevFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreSetRepositoryExceptionsFuncCall is an object that describes an
-// invocation of method SetRepositoryExceptions on an instance of MockStore.
-type StoreSetRepositoryExceptionsFuncCall struct {
+// StoreQueueRepoRevFuncCall is an object that describes an invocation of
+// method QueueRepoRev on an instance of MockStore.
+type StoreQueueRepoRevFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4032,10 +4122,7 @@ type StoreSetRepositoryExceptionsFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 bool
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 bool
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -4043,47 +4130,45 @@ type StoreSetRepositoryExceptionsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreSetRepositoryExceptionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c StoreQueueRepoRevFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreSetRepositoryExceptionsFuncCall) Results() []interface{} {
+func (c StoreQueueRepoRevFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// StoreTopRepositoriesToConfigureFunc describes the behavior when the
-// TopRepositoriesToConfigure method of the parent MockStore instance is
-// invoked.
-type StoreTopRepositoriesToConfigureFunc struct {
-	defaultHook func(context.Context, int) ([]shared1.RepositoryWithCount, error)
-	hooks       []func(context.Context, int) ([]shared1.RepositoryWithCount, error)
-	history     []StoreTopRepositoriesToConfigureFuncCall
+// StoreRepositoryExceptionsFunc describes the behavior when the
+// RepositoryExceptions method of the parent MockStore instance is invoked.
+type StoreRepositoryExceptionsFunc struct {
+	defaultHook func(context.Context, int) (bool, bool, error)
+	hooks       []func(context.Context, int) (bool, bool, error)
+	history     []StoreRepositoryExceptionsFuncCall
 	mutex       sync.Mutex
 }
 
-// TopRepositoriesToConfigure delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockStore) TopRepositoriesToConfigure(v0 context.Context, v1 int) ([]shared1.RepositoryWithCount, error) {
-	r0, r1 := m.TopRepositoriesToConfigureFunc.nextHook()(v0, v1)
-	m.TopRepositoriesToConfigureFunc.appendCall(StoreTopRepositoriesToConfigureFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// RepositoryExceptions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) RepositoryExceptions(v0 context.Context, v1 int) (bool, bool, error) {
+	r0, r1, r2 := m.RepositoryExceptionsFunc.nextHook()(v0, v1)
+	m.RepositoryExceptionsFunc.appendCall(StoreRepositoryExceptionsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the
-// TopRepositoriesToConfigure method of the parent MockStore instance is
-// invoked and the hook queue is empty.
-func (f *StoreTopRepositoriesToConfigureFunc) SetDefaultHook(hook func(context.Context, int) ([]shared1.RepositoryWithCount, error)) {
+// SetDefaultHook sets function that is called when the RepositoryExceptions
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreRepositoryExceptionsFunc) SetDefaultHook(hook func(context.Context, int) (bool, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// TopRepositoriesToConfigure method of the parent MockStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *StoreTopRepositoriesToConfigureFunc) PushHook(hook func(context.Context, int) ([]shared1.RepositoryWithCount, error)) {
+// RepositoryExceptions method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreRepositoryExceptionsFunc) PushHook(hook func(context.Context, int) (bool, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4091,20 +4176,20 @@ func (f *StoreTopRepositoriesToConfigureFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreTopRepositoriesToConfigureFunc) SetDefaultReturn(r0 []shared1.RepositoryWithCount, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) ([]shared1.RepositoryWithCount, error) {
-		return r0, r1
+func (f *StoreRepositoryExceptionsFunc) SetDefaultReturn(r0 bool, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (bool, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreTopRepositoriesToConfigureFunc) PushReturn(r0 []shared1.RepositoryWithCount, r1 error) {
-	f.PushHook(func(context.Context, int) ([]shared1.RepositoryWithCount, error) {
-		return r0, r1
+func (f *StoreRepositoryExceptionsFunc) PushReturn(r0 bool, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (bool, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *StoreTopRepositoriesToConfigureFunc) nextHook() func(context.Context, int) ([]shared1.RepositoryWithCount, error) {
+func (f *StoreRepositoryExceptionsFunc) nextHook() func(context.Context, int) (bool, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4117,27 +4202,26 @@ func (f *StoreTopRepositoriesToConfigureFunc) nextHook() func(context.Context, i
 	return hook
 }
 
-func (f *StoreTopRepositoriesToConfigureFunc) appendCall(r0 StoreTopRepositoriesToConfigureFuncCall) {
+func (f *StoreRepositoryExceptionsFunc) appendCall(r0 StoreRepositoryExceptionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreTopRepositoriesToConfigureFuncCall
-// objects describing the invocations of this function.
-func (f *StoreTopRepositoriesToConfigureFunc) History() []StoreTopRepositoriesToConfigureFuncCall {
+// History returns a sequence of StoreRepositoryExceptionsFuncCall objects
+// describing the invocations of this function.
+func (f *StoreRepositoryExceptionsFunc) History() []StoreRepositoryExceptionsFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreTopRepositoriesToConfigureFuncCall, len(f.history))
+	history := make([]StoreRepositoryExceptionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreTopRepositoriesToConfigureFuncCall is an object that describes an
-// invocation of method TopRepositoriesToConfigure on an instance of
-// MockStore.
-type StoreTopRepositoriesToConfigureFuncCall struct {
+// StoreRepositoryExceptionsFuncCall is an object that describes an
+// invocation of method RepositoryExceptions on an instance of MockStore.
+type StoreRepositoryExceptionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4146,55 +4230,58 @@ type StoreTopRepositoriesToConfigureFuncCall struct {
 	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []shared1.RepositoryWithCount
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreTopRepositoriesToConfigureFuncCall) Args() []interface{} {
+func (c StoreRepositoryExceptionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreTopRepositoriesToConfigureFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c StoreRepositoryExceptionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// StoreTruncateConfigurationSummaryFunc describes the behavior when the
-// TruncateConfigurationSummary method of the parent MockStore instance is
+// StoreRepositoryIDsWithConfigurationFunc describes the behavior when the
+// RepositoryIDsWithConfiguration method of the parent MockStore instance is
 // invoked.
-type StoreTruncateConfigurationSummaryFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []StoreTruncateConfigurationSummaryFuncCall
+type StoreRepositoryIDsWithConfigurationFunc struct {
+	defaultHook func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)
+	hooks       []func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)
+	history     []StoreRepositoryIDsWithConfigurationFuncCall
 	mutex       sync.Mutex
 }
 
-// TruncateConfigurationSummary delegates to the next hook function in the
+// RepositoryIDsWithConfiguration delegates to the next hook function in the
 // queue and stores the parameter and result values of this invocation.
-func (m *MockStore) TruncateConfigurationSummary(v0 context.Context, v1 int) error {
-	r0 := m.TruncateConfigurationSummaryFunc.nextHook()(v0, v1)
-	m.TruncateConfigurationSummaryFunc.appendCall(StoreTruncateConfigurationSummaryFuncCall{v0, v1, r0})
-	return r0
+func (m *MockStore) RepositoryIDsWithConfiguration(v0 context.Context, v1 int, v2 int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
+	r0, r1, r2 := m.RepositoryIDsWithConfigurationFunc.nextHook()(v0, v1, v2)
+	m.RepositoryIDsWithConfigurationFunc.appendCall(StoreRepositoryIDsWithConfigurationFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
 }
 
 // SetDefaultHook sets function that is called when the
-// TruncateConfigurationSummary method of the parent MockStore instance is
+// RepositoryIDsWithConfiguration method of the parent MockStore instance is
 // invoked and the hook queue is empty.
-func (f *StoreTruncateConfigurationSummaryFunc) SetDefaultHook(hook func(context.Context, int) error) {
+func (f *StoreRepositoryIDsWithConfigurationFunc) SetDefaultHook(hook func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// TruncateConfigurationSummary method of the parent MockStore instance
+// RepositoryIDsWithConfiguration method of the parent MockStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *StoreTruncateConfigurationSummaryFunc) PushHook(hook func(context.Context, int) error) {
+func (f *StoreRepositoryIDsWithConfigurationFunc) PushHook(hook func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4202,20 +4289,20 @@ func (f *StoreTruncateConfigurationSummaryFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreTruncateConfigurationSummaryFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int) error {
-		return r0
+func (f *StoreRepositoryIDsWithConfigurationFunc) SetDefaultReturn(r0 []shared1.RepositoryWithAvailableIndexers, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreTruncateConfigurationSummaryFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int) error {
-		return r0
+func (f *StoreRepositoryIDsWithConfigurationFunc) PushReturn(r0 []shared1.RepositoryWithAvailableIndexers, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *StoreTruncateConfigurationSummaryFunc) nextHook() func(context.Context, int) error {
+func (f *StoreRepositoryIDsWithConfigurationFunc) nextHook() func(context.Context, int, int) ([]shared1.RepositoryWithAvailableIndexers, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4228,82 +4315,89 @@ func (f *StoreTruncateConfigurationSummaryFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *StoreTruncateConfigurationSummaryFunc) appendCall(r0 StoreTruncateConfigurationSummaryFuncCall) {
+func (f *StoreRepositoryIDsWithConfigurationFunc) appendCall(r0 StoreRepositoryIDsWithConfigurationFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreTruncateConfigurationSummaryFuncCall
+// History returns a sequence of StoreRepositoryIDsWithConfigurationFuncCall
 // objects describing the invocations of this function.
-func (f *StoreTruncateConfigurationSummaryFunc) History() []StoreTruncateConfigurationSummaryFuncCall {
+func (f *StoreRepositoryIDsWithConfigurationFunc) History() []StoreRepositoryIDsWithConfigurationFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreTruncateConfigurationSummaryFuncCall, len(f.history))
+	history := make([]StoreRepositoryIDsWithConfigurationFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreTruncateConfigurationSummaryFuncCall is an object that describes an
-// invocation of method TruncateConfigurationSummary on an instance of
+// StoreRepositoryIDsWithConfigurationFuncCall is an object that describes
+// an invocation of method RepositoryIDsWithConfiguration on an instance of
 // MockStore.
-type StoreTruncateConfigurationSummaryFuncCall struct {
+type StoreRepositoryIDsWithConfigurationFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []shared1.RepositoryWithAvailableIndexers
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreTruncateConfigurationSummaryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c StoreRepositoryIDsWithConfigurationFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreTruncateConfigurationSummaryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c StoreRepositoryIDsWithConfigurationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// StoreUpdateIndexConfigurationByRepositoryIDFunc describes the behavior
-// when the UpdateIndexConfigurationByRepositoryID method of the parent
-// MockStore instance is invoked.
-type StoreUpdateIndexConfigurationByRepositoryIDFunc struct {
-	defaultHook func(context.Context, int, []byte) error
-	hooks       []func(context.Context, int, []byte) error
-	history     []StoreUpdateIndexConfigurationByRepositoryIDFuncCall
+// StoreSetConfigurationSummaryFunc describes the behavior when the
+// SetConfigurationSummary method of the parent MockStore instance is
+// invoked.
+type StoreSetConfigurationSummaryFunc struct {
+	defaultHook func(context.Context, int, int, map[string]shared1.AvailableIndexer) error
+	hooks       []func(context.Context, int, int, map[string]shared1.AvailableIndexer) error
+	history     []StoreSetConfigurationSummaryFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateIndexConfigurationByRepositoryID delegates to the next hook
-// function in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockStore) UpdateIndexConfigurationByRepositoryID(v0 context.Context, v1 int, v2 []byte) error {
-	r0 := m.UpdateIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1, v2)
-	m.UpdateIndexConfigurationByRepositoryIDFunc.appendCall(StoreUpdateIndexConfigurationByRepositoryIDFuncCall{v0, v1, v2, r0})
+// SetConfigurationSummary delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) SetConfigurationSummary(v0 context.Context, v1 int, v2 int, v3 map[string]shared1.AvailableIndexer) error {
+	r0 := m.SetConfigurationSummaryFunc.nextHook()(v0, v1, v2, v3)
+	m.SetConfigurationSummaryFunc.appendCall(StoreSetConfigurationSummaryFuncCall{v0, v1, v2, v3, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// UpdateIndexConfigurationByRepositoryID method of the parent MockStore
-// instance is invoked and the hook queue is empty.
-func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int, []byte) error) {
+// SetConfigurationSummary method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreSetConfigurationSummaryFunc) SetDefaultHook(hook func(context.Context, int, int, map[string]shared1.AvailableIndexer) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateIndexConfigurationByRepositoryID method of the parent MockStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int, []byte) error) {
+// SetConfigurationSummary method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreSetConfigurationSummaryFunc) PushHook(hook func(context.Context, int, int, map[string]shared1.AvailableIndexer) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4311,20 +4405,20 @@ func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int, []byte) error {
+func (f *StoreSetConfigurationSummaryFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, int, map[string]shared1.AvailableIndexer) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int, []byte) error {
+func (f *StoreSetConfigurationSummaryFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, int, map[string]shared1.AvailableIndexer) error {
 		return r0
 	})
 }
 
-func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int, []byte) error {
+func (f *StoreSetConfigurationSummaryFunc) nextHook() func(context.Context, int, int, map[string]shared1.AvailableIndexer) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4337,28 +4431,26 @@ func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(contex
 	return hook
 }
 
-func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) appendCall(r0 StoreUpdateIndexConfigurationByRepositoryIDFuncCall) {
+func (f *StoreSetConfigurationSummaryFunc) appendCall(r0 StoreSetConfigurationSummaryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// StoreUpdateIndexConfigurationByRepositoryIDFuncCall objects describing
-// the invocations of this function.
-func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) History() []StoreUpdateIndexConfigurationByRepositoryIDFuncCall {
+// History returns a sequence of StoreSetConfigurationSummaryFuncCall
+// objects describing the invocations of this function.
+func (f *StoreSetConfigurationSummaryFunc) History() []StoreSetConfigurationSummaryFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreUpdateIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	history := make([]StoreSetConfigurationSummaryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreUpdateIndexConfigurationByRepositoryIDFuncCall is an object that
-// describes an invocation of method UpdateIndexConfigurationByRepositoryID
-// on an instance of MockStore.
-type StoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
+// StoreSetConfigurationSummaryFuncCall is an object that describes an
+// invocation of method SetConfigurationSummary on an instance of MockStore.
+type StoreSetConfigurationSummaryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4367,7 +4459,10 @@ type StoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 []byte
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 map[string]shared1.AvailableIndexer
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -4375,45 +4470,45 @@ type StoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreUpdateIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c StoreSetConfigurationSummaryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreUpdateIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+func (c StoreSetConfigurationSummaryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// StoreWithTransactionFunc describes the behavior when the WithTransaction
-// method of the parent MockStore instance is invoked.
-type StoreWithTransactionFunc struct {
-	defaultHook func(context.Context, func(tx store.Store) error) error
-	hooks       []func(context.Context, func(tx store.Store) error) error
-	history     []StoreWithTransactionFuncCall
+// StoreSetInferenceScriptFunc describes the behavior when the
+// SetInferenceScript method of the parent MockStore instance is invoked.
+type StoreSetInferenceScriptFunc struct {
+	defaultHook func(context.Context, string) error
+	hooks       []func(context.Context, string) error
+	history     []StoreSetInferenceScriptFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransaction delegates to the next hook function in the queue and
+// SetInferenceScript delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockStore) WithTransaction(v0 context.Context, v1 func(tx store.Store) error) error {
-	r0 := m.WithTransactionFunc.nextHook()(v0, v1)
-	m.WithTransactionFunc.appendCall(StoreWithTransactionFuncCall{v0, v1, r0})
+func (m *MockStore) SetInferenceScript(v0 context.Context, v1 string) error {
+	r0 := m.SetInferenceScriptFunc.nextHook()(v0, v1)
+	m.SetInferenceScriptFunc.appendCall(StoreSetInferenceScriptFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the WithTransaction
+// SetDefaultHook sets function that is called when the SetInferenceScript
 // method of the parent MockStore instance is invoked and the hook queue is
 // empty.
-func (f *StoreWithTransactionFunc) SetDefaultHook(hook func(context.Context, func(tx store.Store) error) error) {
+func (f *StoreSetInferenceScriptFunc) SetDefaultHook(hook func(context.Context, string) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransaction method of the parent MockStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *StoreWithTransactionFunc) PushHook(hook func(context.Context, func(tx store.Store) error) error) {
+// SetInferenceScript method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreSetInferenceScriptFunc) PushHook(hook func(context.Context, string) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4421,20 +4516,20 @@ func (f *StoreWithTransactionFunc) PushHook(hook func(context.Context, func(tx s
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *StoreWithTransactionFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(tx store.Store) error) error {
+func (f *StoreSetInferenceScriptFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *StoreWithTransactionFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(tx store.Store) error) error {
+func (f *StoreSetInferenceScriptFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string) error {
 		return r0
 	})
 }
 
-func (f *StoreWithTransactionFunc) nextHook() func(context.Context, func(tx store.Store) error) error {
+func (f *StoreSetInferenceScriptFunc) nextHook() func(context.Context, string) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4447,32 +4542,32 @@ func (f *StoreWithTransactionFunc) nextHook() func(context.Context, func(tx stor
 	return hook
 }
 
-func (f *StoreWithTransactionFunc) appendCall(r0 StoreWithTransactionFuncCall) {
+func (f *StoreSetInferenceScriptFunc) appendCall(r0 StoreSetInferenceScriptFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of StoreWithTransactionFuncCall objects
+// History returns a sequence of StoreSetInferenceScriptFuncCall objects
 // describing the invocations of this function.
-func (f *StoreWithTransactionFunc) History() []StoreWithTransactionFuncCall {
+func (f *StoreSetInferenceScriptFunc) History() []StoreSetInferenceScriptFuncCall {
 	f.mutex.Lock()
-	history := make([]StoreWithTransactionFuncCall, len(f.history))
+	history := make([]StoreSetInferenceScriptFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// StoreWithTransactionFuncCall is an object that describes an invocation of
-// method WithTransaction on an instance of MockStore.
-type StoreWithTransactionFuncCall struct {
+// StoreSetInferenceScriptFuncCall is an object that describes an invocation
+// of method SetInferenceScript on an instance of MockStore.
+type StoreSetInferenceScriptFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(tx store.Store) error
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -4480,77 +4575,654 @@ type StoreWithTransactionFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreWithTransactionFuncCall) Args() []interface{} {
+func (c StoreSetInferenceScriptFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreWithTransactionFuncCall) Results() []interface{} {
+func (c StoreSetInferenceScriptFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// MockWorkerStore is a mock implementation of the Store interface (from the
-// package
-// github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store)
-// used for unit testing.
-type MockWorkerStore[T workerutil.Record] struct {
-	// AddExecutionLogEntryFunc is an instance of a mock function object
-	// controlling the behavior of the method AddExecutionLogEntry.
-	AddExecutionLogEntryFunc *WorkerStoreAddExecutionLogEntryFunc[T]
-	// DequeueFunc is an instance of a mock function object controlling the
-	// behavior of the method Dequeue.
-	DequeueFunc *WorkerStoreDequeueFunc[T]
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *WorkerStoreHandleFunc[T]
-	// HeartbeatFunc is an instance of a mock function object controlling
-	// the behavior of the method Heartbeat.
-	HeartbeatFunc *WorkerStoreHeartbeatFunc[T]
-	// MarkCompleteFunc is an instance of a mock function object controlling
-	// the behavior of the method MarkComplete.
-	MarkCompleteFunc *WorkerStoreMarkCompleteFunc[T]
-	// MarkErroredFunc is an instance of a mock function object controlling
-	// the behavior of the method MarkErrored.
-	MarkErroredFunc *WorkerStoreMarkErroredFunc[T]
-	// MarkFailedFunc is an instance of a mock function object controlling
-	// the behavior of the method MarkFailed.
-	MarkFailedFunc *WorkerStoreMarkFailedFunc[T]
-	// MaxDurationInQueueFunc is an instance of a mock function object
-	// controlling the behavior of the method MaxDurationInQueue.
-	MaxDurationInQueueFunc *WorkerStoreMaxDurationInQueueFunc[T]
-	// QueuedCountFunc is an instance of a mock function object controlling
-	// the behavior of the method QueuedCount.
-	QueuedCountFunc *WorkerStoreQueuedCountFunc[T]
-	// RequeueFunc is an instance of a mock function object controlling the
-	// behavior of the method Requeue.
-	RequeueFunc *WorkerStoreRequeueFunc[T]
-	// ResetStalledFunc is an instance of a mock function object controlling
-	// the behavior of the method ResetStalled.
-	ResetStalledFunc *WorkerStoreResetStalledFunc[T]
-	// UpdateExecutionLogEntryFunc is an instance of a mock function object
-	// controlling the behavior of the method UpdateExecutionLogEntry.
-	UpdateExecutionLogEntryFunc *WorkerStoreUpdateExecutionLogEntryFunc[T]
-	// WithFunc is an instance of a mock function object controlling the
-	// behavior of the method With.
-	WithFunc *WorkerStoreWithFunc[T]
+// StoreSetRepositoryExceptionsFunc describes the behavior when the
+// SetRepositoryExceptions method of the parent MockStore instance is
+// invoked.
+type StoreSetRepositoryExceptionsFunc struct {
+	defaultHook func(context.Context, int, bool, bool) error
+	hooks       []func(context.Context, int, bool, bool) error
+	history     []StoreSetRepositoryExceptionsFuncCall
+	mutex       sync.Mutex
 }
 
-// NewMockWorkerStore creates a new mock of the Store interface. All methods
-// return zero values for all results, unless overwritten.
-func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
-	return &MockWorkerStore[T]{
-		AddExecutionLogEntryFunc: &WorkerStoreAddExecutionLogEntryFunc[T]{
-			defaultHook: func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (r0 int, r1 error) {
-				return
-			},
-		},
-		DequeueFunc: &WorkerStoreDequeueFunc[T]{
-			defaultHook: func(context.Context, string, []*sqlf.Query) (r0 T, r1 bool, r2 error) {
-				return
-			},
-		},
-		HandleFunc: &WorkerStoreHandleFunc[T]{
+// SetRepositoryExceptions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) SetRepositoryExceptions(v0 context.Context, v1 int, v2 bool, v3 bool) error {
+	r0 := m.SetRepositoryExceptionsFunc.nextHook()(v0, v1, v2, v3)
+	m.SetRepositoryExceptionsFunc.appendCall(StoreSetRepositoryExceptionsFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// SetRepositoryExceptions method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreSetRepositoryExceptionsFunc) SetDefaultHook(hook func(context.Context, int, bool, bool) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetRepositoryExceptions method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreSetRepositoryExceptionsFunc) PushHook(hook func(context.Context, int, bool, bool) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreSetRepositoryExceptionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, bool, bool) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreSetRepositoryExceptionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, bool, bool) error {
+		return r0
+	})
+}
+
+func (f *StoreSetRepositoryExceptionsFunc) nextHook() func(context.Context, int, bool, bool) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreSetRepositoryExceptionsFunc) appendCall(r0 StoreSetRepositoryExceptionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreSetRepositoryExceptionsFuncCall
+// objects describing the invocations of this function.
+func (f *StoreSetRepositoryExceptionsFunc) History() []StoreSetRepositoryExceptionsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreSetRepositoryExceptionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreSetRepositoryExceptionsFuncCall is an object that describes an
+// invocation of method SetRepositoryExceptions on an instance of MockStore.
+type StoreSetRepositoryExceptionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreSetRepositoryExceptionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreSetRepositoryExceptionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// StoreTopRepositoriesToConfigureFunc describes the behavior when the
+// TopRepositoriesToConfigure method of the parent MockStore instance is
+// invoked.
+type StoreTopRepositoriesToConfigureFunc struct {
+	defaultHook func(context.Context, int) ([]shared1.RepositoryWithCount, error)
+	hooks       []func(context.Context, int) ([]shared1.RepositoryWithCount, error)
+	history     []StoreTopRepositoriesToConfigureFuncCall
+	mutex       sync.Mutex
+}
+
+// TopRepositoriesToConfigure delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) TopRepositoriesToConfigure(v0 context.Context, v1 int) ([]shared1.RepositoryWithCount, error) {
+	r0, r1 := m.TopRepositoriesToConfigureFunc.nextHook()(v0, v1)
+	m.TopRepositoriesToConfigureFunc.appendCall(StoreTopRepositoriesToConfigureFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// TopRepositoriesToConfigure method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreTopRepositoriesToConfigureFunc) SetDefaultHook(hook func(context.Context, int) ([]shared1.RepositoryWithCount, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// TopRepositoriesToConfigure method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreTopRepositoriesToConfigureFunc) PushHook(hook func(context.Context, int) ([]shared1.RepositoryWithCount, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreTopRepositoriesToConfigureFunc) SetDefaultReturn(r0 []shared1.RepositoryWithCount, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]shared1.RepositoryWithCount, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreTopRepositoriesToConfigureFunc) PushReturn(r0 []shared1.RepositoryWithCount, r1 error) {
+	f.PushHook(func(context.Context, int) ([]shared1.RepositoryWithCount, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreTopRepositoriesToConfigureFunc) nextHook() func(context.Context, int) ([]shared1.RepositoryWithCount, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreTopRepositoriesToConfigureFunc) appendCall(r0 StoreTopRepositoriesToConfigureFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreTopRepositoriesToConfigureFuncCall
+// objects describing the invocations of this function.
+func (f *StoreTopRepositoriesToConfigureFunc) History() []StoreTopRepositoriesToConfigureFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreTopRepositoriesToConfigureFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreTopRepositoriesToConfigureFuncCall is an object that describes an
+// invocation of method TopRepositoriesToConfigure on an instance of
+// MockStore.
+type StoreTopRepositoriesToConfigureFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared1.RepositoryWithCount
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreTopRepositoriesToConfigureFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreTopRepositoriesToConfigureFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreTruncateConfigurationSummaryFunc describes the behavior when the
+// TruncateConfigurationSummary method of the parent MockStore instance is
+// invoked.
+type StoreTruncateConfigurationSummaryFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []StoreTruncateConfigurationSummaryFuncCall
+	mutex       sync.Mutex
+}
+
+// TruncateConfigurationSummary delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) TruncateConfigurationSummary(v0 context.Context, v1 int) error {
+	r0 := m.TruncateConfigurationSummaryFunc.nextHook()(v0, v1)
+	m.TruncateConfigurationSummaryFunc.appendCall(StoreTruncateConfigurationSummaryFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// TruncateConfigurationSummary method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreTruncateConfigurationSummaryFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// TruncateConfigurationSummary method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreTruncateConfigurationSummaryFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreTruncateConfigurationSummaryFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreTruncateConfigurationSummaryFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *StoreTruncateConfigurationSummaryFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreTruncateConfigurationSummaryFunc) appendCall(r0 StoreTruncateConfigurationSummaryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreTruncateConfigurationSummaryFuncCall
+// objects describing the invocations of this function.
+func (f *StoreTruncateConfigurationSummaryFunc) History() []StoreTruncateConfigurationSummaryFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreTruncateConfigurationSummaryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreTruncateConfigurationSummaryFuncCall is an object that describes an
+// invocation of method TruncateConfigurationSummary on an instance of
+// MockStore.
+type StoreTruncateConfigurationSummaryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreTruncateConfigurationSummaryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreTruncateConfigurationSummaryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// StoreUpdateIndexConfigurationByRepositoryIDFunc describes the behavior
+// when the UpdateIndexConfigurationByRepositoryID method of the parent
+// MockStore instance is invoked.
+type StoreUpdateIndexConfigurationByRepositoryIDFunc struct {
+	defaultHook func(context.Context, int, []byte) error
+	hooks       []func(context.Context, int, []byte) error
+	history     []StoreUpdateIndexConfigurationByRepositoryIDFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateIndexConfigurationByRepositoryID delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockStore) UpdateIndexConfigurationByRepositoryID(v0 context.Context, v1 int, v2 []byte) error {
+	r0 := m.UpdateIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1, v2)
+	m.UpdateIndexConfigurationByRepositoryIDFunc.appendCall(StoreUpdateIndexConfigurationByRepositoryIDFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateIndexConfigurationByRepositoryID method of the parent MockStore
+// instance is invoked and the hook queue is empty.
+func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int, []byte) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateIndexConfigurationByRepositoryID method of the parent MockStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int, []byte) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []byte) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []byte) error {
+		return r0
+	})
+}
+
+func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int, []byte) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) appendCall(r0 StoreUpdateIndexConfigurationByRepositoryIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// StoreUpdateIndexConfigurationByRepositoryIDFuncCall objects describing
+// the invocations of this function.
+func (f *StoreUpdateIndexConfigurationByRepositoryIDFunc) History() []StoreUpdateIndexConfigurationByRepositoryIDFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreUpdateIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreUpdateIndexConfigurationByRepositoryIDFuncCall is an object that
+// describes an invocation of method UpdateIndexConfigurationByRepositoryID
+// on an instance of MockStore.
+type StoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []byte
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreUpdateIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreUpdateIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// StoreWithTransactionFunc describes the behavior when the WithTransaction
+// method of the parent MockStore instance is invoked.
+type StoreWithTransactionFunc struct {
+	defaultHook func(context.Context, func(tx store.Store) error) error
+	hooks       []func(context.Context, func(tx store.Store) error) error
+	history     []StoreWithTransactionFuncCall
+	mutex       sync.Mutex
+}
+
+// WithTransaction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) WithTransaction(v0 context.Context, v1 func(tx store.Store) error) error {
+	r0 := m.WithTransactionFunc.nextHook()(v0, v1)
+	m.WithTransactionFunc.appendCall(StoreWithTransactionFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransaction
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreWithTransactionFunc) SetDefaultHook(hook func(context.Context, func(tx store.Store) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransaction method of the parent MockStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreWithTransactionFunc) PushHook(hook func(context.Context, func(tx store.Store) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreWithTransactionFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(tx store.Store) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreWithTransactionFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(tx store.Store) error) error {
+		return r0
+	})
+}
+
+func (f *StoreWithTransactionFunc) nextHook() func(context.Context, func(tx store.Store) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreWithTransactionFunc) appendCall(r0 StoreWithTransactionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreWithTransactionFuncCall objects
+// describing the invocations of this function.
+func (f *StoreWithTransactionFunc) History() []StoreWithTransactionFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreWithTransactionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreWithTransactionFuncCall is an object that describes an invocation of
+// method WithTransaction on an instance of MockStore.
+type StoreWithTransactionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(tx store.Store) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreWithTransactionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreWithTransactionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockWorkerStore is a mock implementation of the Store interface (from the
+// package
+// github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store)
+// used for unit testing.
+type MockWorkerStore[T workerutil.Record] struct {
+	// AddExecutionLogEntryFunc is an instance of a mock function object
+	// controlling the behavior of the method AddExecutionLogEntry.
+	AddExecutionLogEntryFunc *WorkerStoreAddExecutionLogEntryFunc[T]
+	// CountFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method CountFailed.
+	CountFailedFunc *WorkerStoreCountFailedFunc[T]
+	// DeleteFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method DeleteFailed.
+	DeleteFailedFunc *WorkerStoreDeleteFailedFunc[T]
+	// DequeueFunc is an instance of a mock function object controlling the
+	// behavior of the method Dequeue.
+	DequeueFunc *WorkerStoreDequeueFunc[T]
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *WorkerStoreHandleFunc[T]
+	// HeartbeatFunc is an instance of a mock function object controlling
+	// the behavior of the method Heartbeat.
+	HeartbeatFunc *WorkerStoreHeartbeatFunc[T]
+	// ListDequeueCandidatesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListDequeueCandidates.
+	ListDequeueCandidatesFunc *WorkerStoreListDequeueCandidatesFunc[T]
+	// ListFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method ListFailed.
+	ListFailedFunc *WorkerStoreListFailedFunc[T]
+	// MarkCompleteFunc is an instance of a mock function object controlling
+	// the behavior of the method MarkComplete.
+	MarkCompleteFunc *WorkerStoreMarkCompleteFunc[T]
+	// MarkErroredFunc is an instance of a mock function object controlling
+	// the behavior of the method MarkErrored.
+	MarkErroredFunc *WorkerStoreMarkErroredFunc[T]
+	// MarkFailedFunc is an instance of a mock function object controlling
+	// the behavior of the method MarkFailed.
+	MarkFailedFunc *WorkerStoreMarkFailedFunc[T]
+	// MaxDurationInQueueFunc is an instance of a mock function object
+	// controlling the behavior of the method MaxDurationInQueue.
+	MaxDurationInQueueFunc *WorkerStoreMaxDurationInQueueFunc[T]
+	// QueueStatisticsFunc is an instance of a mock function object controlling
+	// the behavior of the method QueueStatistics.
+	QueueStatisticsFunc *WorkerStoreQueueStatisticsFunc[T]
+	// QueuedCountFunc is an instance of a mock function object controlling
+	// the behavior of the method QueuedCount.
+	QueuedCountFunc *WorkerStoreQueuedCountFunc[T]
+	// RequeueFunc is an instance of a mock function object controlling the
+	// behavior of the method Requeue.
+	RequeueFunc *WorkerStoreRequeueFunc[T]
+	// RequeueFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method RequeueFailed.
+	RequeueFailedFunc *WorkerStoreRequeueFailedFunc[T]
+	// ResetStalledFunc is an instance of a mock function object controlling
+	// the behavior of the method ResetStalled.
+	ResetStalledFunc *WorkerStoreResetStalledFunc[T]
+	// UpdateExecutionLogEntryFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateExecutionLogEntry.
+	UpdateExecutionLogEntryFunc *WorkerStoreUpdateExecutionLogEntryFunc[T]
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *WorkerStoreWithFunc[T]
+}
+
+// NewMockWorkerStore creates a new mock of the Store interface. All methods
+// return zero values for all results, unless overwritten.
+func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
+	return &MockWorkerStore[T]{
+		AddExecutionLogEntryFunc: &WorkerStoreAddExecutionLogEntryFunc[T]{
+			defaultHook: func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CountFailedFunc: &WorkerStoreCountFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		DeleteFailedFunc: &WorkerStoreDeleteFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		DequeueFunc: &WorkerStoreDequeueFunc[T]{
+			defaultHook: func(context.Context, string, []*sqlf.Query) (r0 T, r1 bool, r2 error) {
+				return
+			},
+		},
+		HandleFunc: &WorkerStoreHandleFunc[T]{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
 			},
@@ -4560,6 +5232,16 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		ListDequeueCandidatesFunc: &WorkerStoreListDequeueCandidatesFunc[T]{
+			defaultHook: func(context.Context, int) (r0 []store1.DequeueCandidate, r1 error) {
+				return
+			},
+		},
+		ListFailedFunc: &WorkerStoreListFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 []store1.FailedRecord, r1 error) {
+				return
+			},
+		},
 		MarkCompleteFunc: &WorkerStoreMarkCompleteFunc[T]{
 			defaultHook: func(context.Context, int, store1.MarkFinalOptions) (r0 bool, r1 error) {
 				return
@@ -4580,6 +5262,11 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		QueueStatisticsFunc: &WorkerStoreQueueStatisticsFunc[T]{
+			defaultHook: func(context.Context, time.Duration) (r0 store1.QueueStatistics, r1 error) {
+				return
+			},
+		},
 		QueuedCountFunc: &WorkerStoreQueuedCountFunc[T]{
 			defaultHook: func(context.Context, bool) (r0 int, r1 error) {
 				return
@@ -4590,6 +5277,11 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		RequeueFailedFunc: &WorkerStoreRequeueFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
 		ResetStalledFunc: &WorkerStoreResetStalledFunc[T]{
 			defaultHook: func(context.Context) (r0 map[int]time.Duration, r1 map[int]time.Duration, r2 error) {
 				return
@@ -4617,6 +5309,16 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.AddExecutionLogEntry")
 			},
 		},
+		CountFailedFunc: &WorkerStoreCountFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockWorkerStore.CountFailed")
+			},
+		},
+		DeleteFailedFunc: &WorkerStoreDeleteFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockWorkerStore.DeleteFailed")
+			},
+		},
 		DequeueFunc: &WorkerStoreDequeueFunc[T]{
 			defaultHook: func(context.Context, string, []*sqlf.Query) (T, bool, error) {
 				panic("unexpected invocation of MockWorkerStore.Dequeue")
@@ -4632,6 +5334,16 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.Heartbeat")
 			},
 		},
+		ListDequeueCandidatesFunc: &WorkerStoreListDequeueCandidatesFunc[T]{
+			defaultHook: func(context.Context, int) ([]store1.DequeueCandidate, error) {
+				panic("unexpected invocation of MockWorkerStore.ListDequeueCandidates")
+			},
+		},
+		ListFailedFunc: &WorkerStoreListFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+				panic("unexpected invocation of MockWorkerStore.ListFailed")
+			},
+		},
 		MarkCompleteFunc: &WorkerStoreMarkCompleteFunc[T]{
 			defaultHook: func(context.Context, int, store1.MarkFinalOptions) (bool, error) {
 				panic("unexpected invocation of MockWorkerStore.MarkComplete")
@@ -4652,6 +5364,11 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.MaxDurationInQueue")
 			},
 		},
+		QueueStatisticsFunc: &WorkerStoreQueueStatisticsFunc[T]{
+			defaultHook: func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+				panic("unexpected invocation of MockWorkerStore.QueueStatistics")
+			},
+		},
 		QueuedCountFunc: &WorkerStoreQueuedCountFunc[T]{
 			defaultHook: func(context.Context, bool) (int, error) {
 				panic("unexpected invocation of MockWorkerStore.QueuedCount")
@@ -4662,6 +5379,11 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.Requeue")
 			},
 		},
+		RequeueFailedFunc: &WorkerStoreRequeueFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockWorkerStore.RequeueFailed")
+			},
+		},
 		ResetStalledFunc: &WorkerStoreResetStalledFunc[T]{
 			defaultHook: func(context.Context) (map[int]time.Duration, map[int]time.Duration, error) {
 				panic("unexpected invocation of MockWorkerStore.ResetStalled")
@@ -4688,6 +5410,12 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		AddExecutionLogEntryFunc: &WorkerStoreAddExecutionLogEntryFunc[T]{
 			defaultHook: i.AddExecutionLogEntry,
 		},
+		CountFailedFunc: &WorkerStoreCountFailedFunc[T]{
+			defaultHook: i.CountFailed,
+		},
+		DeleteFailedFunc: &WorkerStoreDeleteFailedFunc[T]{
+			defaultHook: i.DeleteFailed,
+		},
 		DequeueFunc: &WorkerStoreDequeueFunc[T]{
 			defaultHook: i.Dequeue,
 		},
@@ -4697,6 +5425,12 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		HeartbeatFunc: &WorkerStoreHeartbeatFunc[T]{
 			defaultHook: i.Heartbeat,
 		},
+		ListDequeueCandidatesFunc: &WorkerStoreListDequeueCandidatesFunc[T]{
+			defaultHook: i.ListDequeueCandidates,
+		},
+		ListFailedFunc: &WorkerStoreListFailedFunc[T]{
+			defaultHook: i.ListFailed,
+		},
 		MarkCompleteFunc: &WorkerStoreMarkCompleteFunc[T]{
 			defaultHook: i.MarkComplete,
 		},
@@ -4709,12 +5443,18 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		MaxDurationInQueueFunc: &WorkerStoreMaxDurationInQueueFunc[T]{
 			defaultHook: i.MaxDurationInQueue,
 		},
+		QueueStatisticsFunc: &WorkerStoreQueueStatisticsFunc[T]{
+			defaultHook: i.QueueStatistics,
+		},
 		QueuedCountFunc: &WorkerStoreQueuedCountFunc[T]{
 			defaultHook: i.QueuedCount,
 		},
 		RequeueFunc: &WorkerStoreRequeueFunc[T]{
 			defaultHook: i.Requeue,
 		},
+		RequeueFailedFunc: &WorkerStoreRequeueFailedFunc[T]{
+			defaultHook: i.RequeueFailed,
+		},
 		ResetStalledFunc: &WorkerStoreResetStalledFunc[T]{
 			defaultHook: i.ResetStalled,
 		},
@@ -4737,27 +5477,248 @@ type WorkerStoreAddExecutionLogEntryFunc[T workerutil.Record] struct {
 	mutex       sync.Mutex
 }
 
-// AddExecutionLogEntry delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockWorkerStore[T]) AddExecutionLogEntry(v0 context.Context, v1 int, v2 executor.ExecutionLogEntry, v3 store1.ExecutionLogEntryOptions) (int, error) {
-	r0, r1 := m.AddExecutionLogEntryFunc.nextHook()(v0, v1, v2, v3)
-	m.AddExecutionLogEntryFunc.appendCall(WorkerStoreAddExecutionLogEntryFuncCall[T]{v0, v1, v2, v3, r0, r1})
+// AddExecutionLogEntry delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) AddExecutionLogEntry(v0 context.Context, v1 int, v2 executor.ExecutionLogEntry, v3 store1.ExecutionLogEntryOptions) (int, error) {
+	r0, r1 := m.AddExecutionLogEntryFunc.nextHook()(v0, v1, v2, v3)
+	m.AddExecutionLogEntryFunc.appendCall(WorkerStoreAddExecutionLogEntryFuncCall[T]{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the AddExecutionLogEntry
+// method of the parent MockWorkerStore instance is invoked and the hook
+// queue is empty.
+func (f *WorkerStoreAddExecutionLogEntryFunc[T]) SetDefaultHook(hook func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddExecutionLogEntry method of the parent MockWorkerStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *WorkerStoreAddExecutionLogEntryFunc[T]) PushHook(hook func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreAddExecutionLogEntryFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreAddExecutionLogEntryFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreAddExecutionLogEntryFunc[T]) nextHook() func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreAddExecutionLogEntryFunc[T]) appendCall(r0 WorkerStoreAddExecutionLogEntryFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreAddExecutionLogEntryFuncCall
+// objects describing the invocations of this function.
+func (f *WorkerStoreAddExecutionLogEntryFunc[T]) History() []WorkerStoreAddExecutionLogEntryFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreAddExecutionLogEntryFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreAddExecutionLogEntryFuncCall is an object that describes an
+// invocation of method AddExecutionLogEntry on an instance of
+// MockWorkerStore.
+type WorkerStoreAddExecutionLogEntryFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 executor.ExecutionLogEntry
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 store1.ExecutionLogEntryOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreAddExecutionLogEntryFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreAddExecutionLogEntryFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// WorkerStoreCountFailedFunc describes the behavior when the CountFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreCountFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) (int, error)
+	history     []WorkerStoreCountFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// CountFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) CountFailed(v0 context.Context, v1 store1.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.CountFailedFunc.nextHook()(v0, v1)
+	m.CountFailedFunc.appendCall(WorkerStoreCountFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountFailed method of
+// the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreCountFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountFailed method of the parent MockWorkerStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *WorkerStoreCountFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreCountFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreCountFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreCountFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreCountFailedFunc[T]) appendCall(r0 WorkerStoreCountFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreCountFailedFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreCountFailedFunc[T]) History() []WorkerStoreCountFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreCountFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreCountFailedFuncCall is an object that describes an invocation
+// of method CountFailed on an instance of MockWorkerStore.
+type WorkerStoreCountFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreCountFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreCountFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// WorkerStoreDeleteFailedFunc describes the behavior when the DeleteFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreDeleteFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) (int, error)
+	history     []WorkerStoreDeleteFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// DeleteFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) DeleteFailed(v0 context.Context, v1 store1.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.DeleteFailedFunc.nextHook()(v0, v1)
+	m.DeleteFailedFunc.appendCall(WorkerStoreDeleteFailedFuncCall[T]{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the AddExecutionLogEntry
-// method of the parent MockWorkerStore instance is invoked and the hook
-// queue is empty.
-func (f *WorkerStoreAddExecutionLogEntryFunc[T]) SetDefaultHook(hook func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error)) {
+// SetDefaultHook sets function that is called when the DeleteFailed method
+// of the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreDeleteFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AddExecutionLogEntry method of the parent MockWorkerStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *WorkerStoreAddExecutionLogEntryFunc[T]) PushHook(hook func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error)) {
+// DeleteFailed method of the parent MockWorkerStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *WorkerStoreDeleteFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4765,20 +5726,20 @@ func (f *WorkerStoreAddExecutionLogEntryFunc[T]) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *WorkerStoreAddExecutionLogEntryFunc[T]) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error) {
+func (f *WorkerStoreDeleteFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *WorkerStoreAddExecutionLogEntryFunc[T]) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error) {
+func (f *WorkerStoreDeleteFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *WorkerStoreAddExecutionLogEntryFunc[T]) nextHook() func(context.Context, int, executor.ExecutionLogEntry, store1.ExecutionLogEntryOptions) (int, error) {
+func (f *WorkerStoreDeleteFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4791,39 +5752,30 @@ func (f *WorkerStoreAddExecutionLogEntryFunc[T]) nextHook() func(context.Context
 	return hook
 }
 
-func (f *WorkerStoreAddExecutionLogEntryFunc[T]) appendCall(r0 WorkerStoreAddExecutionLogEntryFuncCall[T]) {
+func (f *WorkerStoreDeleteFailedFunc[T]) appendCall(r0 WorkerStoreDeleteFailedFuncCall[T]) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of WorkerStoreAddExecutionLogEntryFuncCall
-// objects describing the invocations of this function.
-func (f *WorkerStoreAddExecutionLogEntryFunc[T]) History() []WorkerStoreAddExecutionLogEntryFuncCall[T] {
+// History returns a sequence of WorkerStoreDeleteFailedFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreDeleteFailedFunc[T]) History() []WorkerStoreDeleteFailedFuncCall[T] {
 	f.mutex.Lock()
-	history := make([]WorkerStoreAddExecutionLogEntryFuncCall[T], len(f.history))
+	history := make([]WorkerStoreDeleteFailedFuncCall[T], len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// WorkerStoreAddExecutionLogEntryFuncCall is an object that describes an
-// invocation of method AddExecutionLogEntry on an instance of
-// MockWorkerStore.
-type WorkerStoreAddExecutionLogEntryFuncCall[T workerutil.Record] struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
+// WorkerStoreDeleteFailedFuncCall is an object that describes an invocation
+// of method DeleteFailed on an instance of MockWorkerStore.
+type WorkerStoreDeleteFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 executor.ExecutionLogEntry
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 store1.ExecutionLogEntryOptions
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 int
@@ -4834,13 +5786,13 @@ type WorkerStoreAddExecutionLogEntryFuncCall[T workerutil.Record] struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c WorkerStoreAddExecutionLogEntryFuncCall[T]) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c WorkerStoreDeleteFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c WorkerStoreAddExecutionLogEntryFuncCall[T]) Results() []interface{} {
+func (c WorkerStoreDeleteFailedFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -4967,25 +5919,240 @@ type WorkerStoreHandleFunc[T workerutil.Record] struct {
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockWorkerStore[T]) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(WorkerStoreHandleFuncCall[T]{r0})
-	return r0
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(WorkerStoreHandleFuncCall[T]{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockWorkerStore instance is invoked and the hook queue is empty.
+func (f *WorkerStoreHandleFunc[T]) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockWorkerStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *WorkerStoreHandleFunc[T]) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreHandleFunc[T]) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreHandleFunc[T]) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *WorkerStoreHandleFunc[T]) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreHandleFunc[T]) appendCall(r0 WorkerStoreHandleFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreHandleFunc[T]) History() []WorkerStoreHandleFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreHandleFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockWorkerStore.
+type WorkerStoreHandleFuncCall[T workerutil.Record] struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreHandleFuncCall[T]) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreHandleFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// WorkerStoreHeartbeatFunc describes the behavior when the Heartbeat method
+// of the parent MockWorkerStore instance is invoked.
+type WorkerStoreHeartbeatFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
+	hooks       []func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
+	history     []WorkerStoreHeartbeatFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// Heartbeat delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) Heartbeat(v0 context.Context, v1 []string, v2 store1.HeartbeatOptions) ([]string, []string, error) {
+	r0, r1, r2 := m.HeartbeatFunc.nextHook()(v0, v1, v2)
+	m.HeartbeatFunc.appendCall(WorkerStoreHeartbeatFuncCall[T]{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the Heartbeat method of
+// the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Heartbeat method of the parent MockWorkerStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *WorkerStoreHeartbeatFunc[T]) PushHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultReturn(r0 []string, r1 []string, r2 error) {
+	f.SetDefaultHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreHeartbeatFunc[T]) PushReturn(r0 []string, r1 []string, r2 error) {
+	f.PushHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *WorkerStoreHeartbeatFunc[T]) nextHook() func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreHeartbeatFunc[T]) appendCall(r0 WorkerStoreHeartbeatFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreHeartbeatFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreHeartbeatFunc[T]) History() []WorkerStoreHeartbeatFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreHeartbeatFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreHeartbeatFuncCall is an object that describes an invocation of
+// method Heartbeat on an instance of MockWorkerStore.
+type WorkerStoreHeartbeatFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 store1.HeartbeatOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 []string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreHeartbeatFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreHeartbeatFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// WorkerStoreListDequeueCandidatesFunc describes the behavior when the
+// ListDequeueCandidates method of the parent MockWorkerStore instance is
+// invoked.
+type WorkerStoreListDequeueCandidatesFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, int) ([]store1.DequeueCandidate, error)
+	hooks       []func(context.Context, int) ([]store1.DequeueCandidate, error)
+	history     []WorkerStoreListDequeueCandidatesFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// ListDequeueCandidates delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) ListDequeueCandidates(v0 context.Context, v1 int) ([]store1.DequeueCandidate, error) {
+	r0, r1 := m.ListDequeueCandidatesFunc.nextHook()(v0, v1)
+	m.ListDequeueCandidatesFunc.appendCall(WorkerStoreListDequeueCandidatesFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockWorkerStore instance is invoked and the hook queue is empty.
-func (f *WorkerStoreHandleFunc[T]) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the ListDequeueCandidates
+// method of the parent MockWorkerStore instance is invoked and the hook
+// queue is empty.
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) SetDefaultHook(hook func(context.Context, int) ([]store1.DequeueCandidate, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockWorkerStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *WorkerStoreHandleFunc[T]) PushHook(hook func() basestore.TransactableHandle) {
+// ListDequeueCandidates method of the parent MockWorkerStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) PushHook(hook func(context.Context, int) ([]store1.DequeueCandidate, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4993,20 +6160,20 @@ func (f *WorkerStoreHandleFunc[T]) PushHook(hook func() basestore.TransactableHa
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *WorkerStoreHandleFunc[T]) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) SetDefaultReturn(r0 []store1.DequeueCandidate, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]store1.DequeueCandidate, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *WorkerStoreHandleFunc[T]) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) PushReturn(r0 []store1.DequeueCandidate, r1 error) {
+	f.PushHook(func(context.Context, int) ([]store1.DequeueCandidate, error) {
+		return r0, r1
 	})
 }
 
-func (f *WorkerStoreHandleFunc[T]) nextHook() func() basestore.TransactableHandle {
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) nextHook() func(context.Context, int) ([]store1.DequeueCandidate, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5019,72 +6186,80 @@ func (f *WorkerStoreHandleFunc[T]) nextHook() func() basestore.TransactableHandl
 	return hook
 }
 
-func (f *WorkerStoreHandleFunc[T]) appendCall(r0 WorkerStoreHandleFuncCall[T]) {
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) appendCall(r0 WorkerStoreListDequeueCandidatesFuncCall[T]) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of WorkerStoreHandleFuncCall objects
-// describing the invocations of this function.
-func (f *WorkerStoreHandleFunc[T]) History() []WorkerStoreHandleFuncCall[T] {
+// History returns a sequence of WorkerStoreListDequeueCandidatesFuncCall
+// objects describing the invocations of this function.
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) History() []WorkerStoreListDequeueCandidatesFuncCall[T] {
 	f.mutex.Lock()
-	history := make([]WorkerStoreHandleFuncCall[T], len(f.history))
+	history := make([]WorkerStoreListDequeueCandidatesFuncCall[T], len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// WorkerStoreHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockWorkerStore.
-type WorkerStoreHandleFuncCall[T workerutil.Record] struct {
+// WorkerStoreListDequeueCandidatesFuncCall is an object that describes an
+// invocation of method ListDequeueCandidates on an instance of
+// MockWorkerStore.
+type WorkerStoreListDequeueCandidatesFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 []store1.DequeueCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c WorkerStoreHandleFuncCall[T]) Args() []interface{} {
-	return []interface{}{}
+func (c WorkerStoreListDequeueCandidatesFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c WorkerStoreHandleFuncCall[T]) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c WorkerStoreListDequeueCandidatesFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// WorkerStoreHeartbeatFunc describes the behavior when the Heartbeat method
-// of the parent MockWorkerStore instance is invoked.
-type WorkerStoreHeartbeatFunc[T workerutil.Record] struct {
-	defaultHook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
-	hooks       []func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
-	history     []WorkerStoreHeartbeatFuncCall[T]
+// WorkerStoreListFailedFunc describes the behavior when the ListFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreListFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)
+	history     []WorkerStoreListFailedFuncCall[T]
 	mutex       sync.Mutex
 }
 
-// Heartbeat delegates to the next hook function in the queue and stores the
+// ListFailed delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockWorkerStore[T]) Heartbeat(v0 context.Context, v1 []string, v2 store1.HeartbeatOptions) ([]string, []string, error) {
-	r0, r1, r2 := m.HeartbeatFunc.nextHook()(v0, v1, v2)
-	m.HeartbeatFunc.appendCall(WorkerStoreHeartbeatFuncCall[T]{v0, v1, v2, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockWorkerStore[T]) ListFailed(v0 context.Context, v1 store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+	r0, r1 := m.ListFailedFunc.nextHook()(v0, v1)
+	m.ListFailedFunc.appendCall(WorkerStoreListFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Heartbeat method of
+// SetDefaultHook sets function that is called when the ListFailed method of
 // the parent MockWorkerStore instance is invoked and the hook queue is
 // empty.
-func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+func (f *WorkerStoreListFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Heartbeat method of the parent MockWorkerStore instance invokes the hook
+// ListFailed method of the parent MockWorkerStore instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *WorkerStoreHeartbeatFunc[T]) PushHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+func (f *WorkerStoreListFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5092,20 +6267,20 @@ func (f *WorkerStoreHeartbeatFunc[T]) PushHook(hook func(context.Context, []stri
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultReturn(r0 []string, r1 []string, r2 error) {
-	f.SetDefaultHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
-		return r0, r1, r2
+func (f *WorkerStoreListFailedFunc[T]) SetDefaultReturn(r0 []store1.FailedRecord, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *WorkerStoreHeartbeatFunc[T]) PushReturn(r0 []string, r1 []string, r2 error) {
-	f.PushHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
-		return r0, r1, r2
+func (f *WorkerStoreListFailedFunc[T]) PushReturn(r0 []store1.FailedRecord, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+		return r0, r1
 	})
 }
 
-func (f *WorkerStoreHeartbeatFunc[T]) nextHook() func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+func (f *WorkerStoreListFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5118,56 +6293,48 @@ func (f *WorkerStoreHeartbeatFunc[T]) nextHook() func(context.Context, []string,
 	return hook
 }
 
-func (f *WorkerStoreHeartbeatFunc[T]) appendCall(r0 WorkerStoreHeartbeatFuncCall[T]) {
+func (f *WorkerStoreListFailedFunc[T]) appendCall(r0 WorkerStoreListFailedFuncCall[T]) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of WorkerStoreHeartbeatFuncCall objects
+// History returns a sequence of WorkerStoreListFailedFuncCall objects
 // describing the invocations of this function.
-func (f *WorkerStoreHeartbeatFunc[T]) History() []WorkerStoreHeartbeatFuncCall[T] {
+func (f *WorkerStoreListFailedFunc[T]) History() []WorkerStoreListFailedFuncCall[T] {
 	f.mutex.Lock()
-	history := make([]WorkerStoreHeartbeatFuncCall[T], len(f.history))
+	history := make([]WorkerStoreListFailedFuncCall[T], len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// WorkerStoreHeartbeatFuncCall is an object that describes an invocation of
-// method Heartbeat on an instance of MockWorkerStore.
-type WorkerStoreHeartbeatFuncCall[T workerutil.Record] struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
+// WorkerStoreListFailedFuncCall is an object that describes an invocation of
+// method ListFailed on an instance of MockWorkerStore.
+type WorkerStoreListFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 []string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 store1.HeartbeatOptions
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 []store1.FailedRecord
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 []string
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c WorkerStoreHeartbeatFuncCall[T]) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c WorkerStoreListFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c WorkerStoreHeartbeatFuncCall[T]) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c WorkerStoreListFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // WorkerStoreMarkCompleteFunc describes the behavior when the MarkComplete
@@ -5616,6 +6783,112 @@ func (c WorkerStoreMaxDurationInQueueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// WorkerStoreQueueStatisticsFunc describes the behavior when the
+// QueueStatistics method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreQueueStatisticsFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, time.Duration) (store1.QueueStatistics, error)
+	hooks       []func(context.Context, time.Duration) (store1.QueueStatistics, error)
+	history     []WorkerStoreQueueStatisticsFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// QueueStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) QueueStatistics(v0 context.Context, v1 time.Duration) (store1.QueueStatistics, error) {
+	r0, r1 := m.QueueStatisticsFunc.nextHook()(v0, v1)
+	m.QueueStatisticsFunc.appendCall(WorkerStoreQueueStatisticsFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueueStatistics
+// method of the parent MockWorkerStore instance is invoked and the hook
+// queue is empty.
+func (f *WorkerStoreQueueStatisticsFunc[T]) SetDefaultHook(hook func(context.Context, time.Duration) (store1.QueueStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueueStatistics method of the parent MockWorkerStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *WorkerStoreQueueStatisticsFunc[T]) PushHook(hook func(context.Context, time.Duration) (store1.QueueStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreQueueStatisticsFunc[T]) SetDefaultReturn(r0 store1.QueueStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreQueueStatisticsFunc[T]) PushReturn(r0 store1.QueueStatistics, r1 error) {
+	f.PushHook(func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreQueueStatisticsFunc[T]) nextHook() func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreQueueStatisticsFunc[T]) appendCall(r0 WorkerStoreQueueStatisticsFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreQueueStatisticsFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreQueueStatisticsFunc[T]) History() []WorkerStoreQueueStatisticsFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreQueueStatisticsFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreQueueStatisticsFuncCall is an object that describes an
+// invocation of method QueueStatistics on an instance of MockWorkerStore.
+type WorkerStoreQueueStatisticsFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 time.Duration
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 store1.QueueStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreQueueStatisticsFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreQueueStatisticsFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // WorkerStoreQueuedCountFunc describes the behavior when the QueuedCount
 // method of the parent MockWorkerStore instance is invoked.
 type WorkerStoreQueuedCountFunc[T workerutil.Record] struct {
@@ -5832,6 +7105,112 @@ func (c WorkerStoreRequeueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// WorkerStoreRequeueFailedFunc describes the behavior when the RequeueFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreRequeueFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) (int, error)
+	history     []WorkerStoreRequeueFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// RequeueFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) RequeueFailed(v0 context.Context, v1 store1.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.RequeueFailedFunc.nextHook()(v0, v1)
+	m.RequeueFailedFunc.appendCall(WorkerStoreRequeueFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RequeueFailed method
+// of the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreRequeueFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RequeueFailed method of the parent MockWorkerStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *WorkerStoreRequeueFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreRequeueFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreRequeueFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreRequeueFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreRequeueFailedFunc[T]) appendCall(r0 WorkerStoreRequeueFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreRequeueFailedFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreRequeueFailedFunc[T]) History() []WorkerStoreRequeueFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreRequeueFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreRequeueFailedFuncCall is an object that describes an invocation
+// of method RequeueFailed on an instance of MockWorkerStore.
+type WorkerStoreRequeueFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreRequeueFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreRequeueFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // WorkerStoreResetStalledFunc describes the behavior when the ResetStalled
 // method of the parent MockWorkerStore instance is invoked.
 type WorkerStoreResetStalledFunc[T workerutil.Record] struct {
@@ -29,9 +29,14 @@ var IndexWorkerStoreOptions = dbworkerstore.Options[uploadsshared.Index]{
 	ViewName:          "lsif_indexes_with_repository_name u",
 	ColumnExpressions: indexColumnsWithNullRank,
 	Scan:              dbworkerstore.BuildWorkerScan(scanIndex),
-	OrderByExpression: sqlf.Sprintf("(u.enqueuer_user_id > 0) DESC, u.queued_at, u.id"),
-	StalledMaxAge:     stalledIndexMaxAge,
-	MaxNumResets:      indexMaxNumResets,
+	OrderByExpression: sqlf.Sprintf("u.queued_at, u.id"),
+	// Indexes enqueued manually by a user are processed before indexes scheduled automatically.
+	PriorityExpression: sqlf.Sprintf("(u.enqueuer_user_id > 0)::int"),
+	// Share executors between repositories, so that a repository with many indexes cannot block
+	// the indexes of all other repositories.
+	FairShareColumn: "repository_id",
+	StalledMaxAge:   stalledIndexMaxAge,
+	MaxNumResets:    indexMaxNumResets,
 }
 
 var indexColumnsWithNullRank = []*sqlf.Query{
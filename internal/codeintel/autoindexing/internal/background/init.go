@@ -1,6 +1,7 @@
 package background
 
 import (
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/coverage"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/dependencies"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/scheduler"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/background/summary"
@@ -111,3 +112,23 @@ func NewSummaryBuilder(
 		),
 	}
 }
+
+func NewCoverageReporter(
+	observationCtx *observation.Context,
+	store store.Store,
+	repoStore database.RepoStore,
+	uploadSvc coverage.UploadService,
+	gitserverClient coverage.GitserverClient,
+	config *coverage.Config,
+) []goroutine.BackgroundRoutine {
+	return []goroutine.BackgroundRoutine{
+		coverage.NewCoverageReporter(
+			observationCtx,
+			store,
+			repoStore,
+			uploadSvc,
+			gitserverClient,
+			config,
+		),
+	}
+}
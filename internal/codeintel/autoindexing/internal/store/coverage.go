@@ -9,8 +9,10 @@ import (
 	"github.com/lib/pq"
 	"go.opentelemetry.io/otel/attribute"
 
+	autoindexingshared "github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/shared"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
@@ -152,9 +154,224 @@ DELETE FROM cached_available_indexers
 WHERE id NOT IN (SELECT id FROM safe)
 `
 
+// GetRepositoriesForCoverage returns the identifiers of repositories with precise data visible at
+// the tip of their default branch whose coverage was not captured within the given interval. The
+// repositories with the oldest (or no) coverage snapshots are returned first.
+func (s *store) GetRepositoriesForCoverage(ctx context.Context, interval time.Duration, limit int, now time.Time) (_ []int, err error) {
+	ctx, _, endObservation := s.operations.getRepositoriesForCoverage.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("interval", interval.String()),
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanInts(s.db.Query(ctx, sqlf.Sprintf(
+		getRepositoriesForCoverageQuery,
+		now,
+		int(interval/time.Second),
+		limit,
+	)))
+}
+
+const getRepositoriesForCoverageQuery = `
+WITH
+candidates AS (
+	SELECT DISTINCT vt.repository_id
+	FROM lsif_uploads_visible_at_tip vt
+	JOIN repo r ON r.id = vt.repository_id
+	WHERE
+		vt.is_default_branch AND
+		r.deleted_at IS NULL AND
+		r.blocked IS NULL
+),
+last_captured AS (
+	SELECT
+		c.repository_id,
+		(
+			SELECT MAX(s.captured_at)
+			FROM codeintel_coverage_snapshots s
+			WHERE s.repository_id = c.repository_id AND s.path = ''
+		) AS captured_at
+	FROM candidates c
+)
+SELECT lc.repository_id
+FROM last_captured lc
+WHERE lc.captured_at IS NULL OR %s - lc.captured_at >= (%s * '1 second'::interval)
+ORDER BY lc.captured_at NULLS FIRST, lc.repository_id
+LIMIT %s
+`
+
+// InsertCoverageSnapshots inserts the given coverage snapshots. Snapshots captured together
+// should share the same commit and capture time so that they can be read back as a unit.
+func (s *store) InsertCoverageSnapshots(ctx context.Context, snapshots []autoindexingshared.CoverageSnapshot) (err error) {
+	ctx, _, endObservation := s.operations.insertCoverageSnapshots.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numSnapshots", len(snapshots)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return batch.WithInserter(
+		ctx,
+		s.db.Handle(),
+		"codeintel_coverage_snapshots",
+		batch.MaxNumPostgresParameters,
+		[]string{"repository_id", "commit", "path", "total_files", "indexed_files", "indexers", "staleness_commits", "captured_at"},
+		func(inserter *batch.Inserter) error {
+			for _, snapshot := range snapshots {
+				indexers := snapshot.Indexers
+				if indexers == nil {
+					indexers = []string{}
+				}
+
+				if err := inserter.Insert(
+					ctx,
+					snapshot.RepositoryID,
+					snapshot.Commit,
+					snapshot.Path,
+					snapshot.TotalFiles,
+					snapshot.IndexedFiles,
+					pq.Array(indexers),
+					snapshot.StalenessCommits,
+					snapshot.CapturedAt,
+				); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+// GetCoverageSnapshots returns the most recently captured coverage snapshot of the given directory
+// along with the snapshots of its immediate subdirectories captured at the same time. The snapshot
+// of the given directory, if one exists, is the first element of the result.
+func (s *store) GetCoverageSnapshots(ctx context.Context, repositoryID int, path string) (_ []autoindexingshared.CoverageSnapshot, err error) {
+	ctx, _, endObservation := s.operations.getCoverageSnapshots.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("path", path),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	prefix := ""
+	if path != "" {
+		prefix = path + "/"
+	}
+
+	return scanCoverageSnapshots(s.db.Query(ctx, sqlf.Sprintf(
+		getCoverageSnapshotsQuery,
+		repositoryID,
+		repositoryID,
+		path,
+		prefix,
+		prefix,
+		path,
+	)))
+}
+
+const getCoverageSnapshotsQuery = `
+WITH latest AS (
+	SELECT MAX(captured_at) AS captured_at
+	FROM codeintel_coverage_snapshots
+	WHERE repository_id = %s AND path = ''
+)
+SELECT
+	s.id,
+	s.repository_id,
+	s.commit,
+	s.path,
+	s.total_files,
+	s.indexed_files,
+	s.indexers,
+	s.staleness_commits,
+	s.captured_at
+FROM codeintel_coverage_snapshots s
+JOIN latest ON latest.captured_at = s.captured_at
+WHERE
+	s.repository_id = %s AND
+	(
+		s.path = %s OR
+		(
+			s.path != '' AND
+			starts_with(s.path, %s) AND
+			strpos(substr(s.path, char_length(%s) + 1), '/') = 0
+		)
+	)
+ORDER BY s.path = %s DESC, s.path
+`
+
+// GetCoverageHistory returns the coverage snapshots of the given directory captured at or after
+// the given time, most recent first.
+func (s *store) GetCoverageHistory(ctx context.Context, repositoryID int, path string, since time.Time, limit int) (_ []autoindexingshared.CoverageSnapshot, err error) {
+	ctx, _, endObservation := s.operations.getCoverageHistory.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("path", path),
+		attribute.String("since", since.String()),
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanCoverageSnapshots(s.db.Query(ctx, sqlf.Sprintf(getCoverageHistoryQuery, repositoryID, path, since, limit)))
+}
+
+const getCoverageHistoryQuery = `
+SELECT
+	s.id,
+	s.repository_id,
+	s.commit,
+	s.path,
+	s.total_files,
+	s.indexed_files,
+	s.indexers,
+	s.staleness_commits,
+	s.captured_at
+FROM codeintel_coverage_snapshots s
+WHERE
+	s.repository_id = %s AND
+	s.path = %s AND
+	s.captured_at >= %s
+ORDER BY s.captured_at DESC
+LIMIT %s
+`
+
+// DeleteCoverageSnapshotsBefore deletes coverage snapshots captured before the given time and
+// returns the number of deleted records.
+func (s *store) DeleteCoverageSnapshotsBefore(ctx context.Context, before time.Time) (_ int, err error) {
+	ctx, _, endObservation := s.operations.deleteCoverageSnapshotsBefore.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("before", before.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(deleteCoverageSnapshotsBeforeQuery, before)))
+	return count, err
+}
+
+const deleteCoverageSnapshotsBeforeQuery = `
+WITH deleted AS (
+	DELETE FROM codeintel_coverage_snapshots
+	WHERE captured_at < %s
+	RETURNING 1
+)
+SELECT COUNT(*) FROM deleted
+`
+
 //
 //
 
+func scanCoverageSnapshot(s dbutil.Scanner) (snapshot autoindexingshared.CoverageSnapshot, _ error) {
+	return snapshot, s.Scan(
+		&snapshot.ID,
+		&snapshot.RepositoryID,
+		&snapshot.Commit,
+		&snapshot.Path,
+		&snapshot.TotalFiles,
+		&snapshot.IndexedFiles,
+		pq.Array(&snapshot.Indexers),
+		&snapshot.StalenessCommits,
+		&snapshot.CapturedAt,
+	)
+}
+
+var scanCoverageSnapshots = basestore.NewSliceScanner(scanCoverageSnapshot)
+
 func scanRepositoryWithCount(s dbutil.Scanner) (rc shared.RepositoryWithCount, _ error) {
 	return rc, s.Scan(&rc.RepositoryID, &rc.Count)
 }
@@ -7,9 +7,11 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/keegancsmith/sqlf"
 	"github.com/sourcegraph/log/logtest"
 
+	autoindexingshared "github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/shared"
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
@@ -147,3 +149,102 @@ func TestGetLastIndexScanForRepository(t *testing.T) {
 		t.Fatalf("unexpected timestamp for repository. want=%s have=%s", expected, ts)
 	}
 }
+
+func TestGetRepositoriesForCoverage(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	store := New(&observation.TestContext, db)
+
+	now := time.Unix(1587396557, 0).UTC()
+
+	for i := 0; i < 4; i++ {
+		insertRepo(t, db, 50+i, "")
+	}
+	if err := basestore.NewWithHandle(db.Handle()).Exec(ctx, sqlf.Sprintf(`
+		INSERT INTO lsif_uploads_visible_at_tip (upload_id, repository_id, is_default_branch) VALUES
+			(100, 50, true),
+			(101, 51, true),
+			(102, 52, true),
+			(103, 53, false)
+	`)); err != nil {
+		t.Fatalf("unexpected error inserting visible uploads: %s", err)
+	}
+
+	if err := store.InsertCoverageSnapshots(ctx, []autoindexingshared.CoverageSnapshot{
+		{RepositoryID: 50, Commit: makeCommit(1), CapturedAt: now.Add(-time.Hour * 2)},
+		{RepositoryID: 51, Commit: makeCommit(2), CapturedAt: now.Add(-time.Minute * 10)},
+	}); err != nil {
+		t.Fatalf("unexpected error inserting coverage snapshots: %s", err)
+	}
+
+	repositoryIDs, err := store.GetRepositoriesForCoverage(ctx, time.Hour, 10, now)
+	if err != nil {
+		t.Fatalf("unexpected error getting repositories for coverage: %s", err)
+	}
+	if diff := cmp.Diff([]int{52, 50}, repositoryIDs); diff != "" {
+		t.Errorf("unexpected repositories (-want +got):\n%s", diff)
+	}
+}
+
+func TestCoverageSnapshots(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	store := New(&observation.TestContext, db)
+
+	insertRepo(t, db, 50, "")
+
+	t1 := time.Unix(1587396557, 0).UTC()
+	t2 := t1.Add(time.Hour * 24)
+	staleness := func(v int) *int { return &v }
+
+	old := []autoindexingshared.CoverageSnapshot{
+		{RepositoryID: 50, Commit: makeCommit(1), Path: "", TotalFiles: 10, IndexedFiles: 2, Indexers: []string{"scip-go"}, StalenessCommits: staleness(3), CapturedAt: t1},
+		{RepositoryID: 50, Commit: makeCommit(1), Path: "cmd", TotalFiles: 4, IndexedFiles: 2, Indexers: []string{"scip-go"}, StalenessCommits: staleness(3), CapturedAt: t1},
+	}
+	current := []autoindexingshared.CoverageSnapshot{
+		{RepositoryID: 50, Commit: makeCommit(2), Path: "", TotalFiles: 12, IndexedFiles: 6, Indexers: []string{"scip-go", "scip-typescript"}, StalenessCommits: staleness(1), CapturedAt: t2},
+		{RepositoryID: 50, Commit: makeCommit(2), Path: "cmd", TotalFiles: 4, IndexedFiles: 4, Indexers: []string{"scip-go"}, StalenessCommits: staleness(0), CapturedAt: t2},
+		{RepositoryID: 50, Commit: makeCommit(2), Path: "cmd/server", TotalFiles: 2, IndexedFiles: 2, Indexers: []string{"scip-go"}, StalenessCommits: staleness(0), CapturedAt: t2},
+		{RepositoryID: 50, Commit: makeCommit(2), Path: "docs", TotalFiles: 6, IndexedFiles: 0, Indexers: []string{}, CapturedAt: t2},
+		{RepositoryID: 50, Commit: makeCommit(2), Path: "web", TotalFiles: 2, IndexedFiles: 2, Indexers: []string{"scip-typescript"}, StalenessCommits: staleness(1), CapturedAt: t2},
+	}
+	if err := store.InsertCoverageSnapshots(ctx, append(old, current...)); err != nil {
+		t.Fatalf("unexpected error inserting coverage snapshots: %s", err)
+	}
+
+	ignoreID := cmpopts.IgnoreFields(autoindexingshared.CoverageSnapshot{}, "ID")
+
+	root, err := store.GetCoverageSnapshots(ctx, 50, "")
+	if err != nil {
+		t.Fatalf("unexpected error getting coverage snapshots: %s", err)
+	}
+	if diff := cmp.Diff([]autoindexingshared.CoverageSnapshot{current[0], current[1], current[3], current[4]}, root, ignoreID); diff != "" {
+		t.Errorf("unexpected root snapshots (-want +got):\n%s", diff)
+	}
+
+	cmd, err := store.GetCoverageSnapshots(ctx, 50, "cmd")
+	if err != nil {
+		t.Fatalf("unexpected error getting coverage snapshots: %s", err)
+	}
+	if diff := cmp.Diff([]autoindexingshared.CoverageSnapshot{current[1], current[2]}, cmd, ignoreID); diff != "" {
+		t.Errorf("unexpected cmd snapshots (-want +got):\n%s", diff)
+	}
+
+	history, err := store.GetCoverageHistory(ctx, 50, "cmd", t1, 10)
+	if err != nil {
+		t.Fatalf("unexpected error getting coverage history: %s", err)
+	}
+	if diff := cmp.Diff([]autoindexingshared.CoverageSnapshot{current[1], old[1]}, history, ignoreID); diff != "" {
+		t.Errorf("unexpected history (-want +got):\n%s", diff)
+	}
+
+	numDeleted, err := store.DeleteCoverageSnapshotsBefore(ctx, t2)
+	if err != nil {
+		t.Fatalf("unexpected error deleting coverage snapshots: %s", err)
+	}
+	if numDeleted != len(old) {
+		t.Errorf("unexpected number of deleted snapshots. want=%d have=%d", len(old), numDeleted)
+	}
+}
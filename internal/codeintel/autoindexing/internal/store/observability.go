@@ -22,6 +22,11 @@ type operations struct {
 	getLastIndexScanForRepository          *observation.Operation
 	setConfigurationSummary                *observation.Operation
 	truncateConfigurationSummary           *observation.Operation
+	getRepositoriesForCoverage             *observation.Operation
+	insertCoverageSnapshots                *observation.Operation
+	getCoverageSnapshots                   *observation.Operation
+	getCoverageHistory                     *observation.Operation
+	deleteCoverageSnapshotsBefore          *observation.Operation
 	getRepositoriesForIndexScan            *observation.Operation
 	getQueuedRepoRev                       *observation.Operation
 	markRepoRevsAsProcessed                *observation.Operation
@@ -78,6 +83,11 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getLastIndexScanForRepository:          op("GetLastIndexScanForRepository"),
 		setConfigurationSummary:                op("SetConfigurationSummary"),
 		truncateConfigurationSummary:           op("TruncateConfigurationSummary"),
+		getRepositoriesForCoverage:             op("GetRepositoriesForCoverage"),
+		insertCoverageSnapshots:                op("InsertCoverageSnapshots"),
+		getCoverageSnapshots:                   op("GetCoverageSnapshots"),
+		getCoverageHistory:                     op("GetCoverageHistory"),
+		deleteCoverageSnapshotsBefore:          op("DeleteCoverageSnapshotsBefore"),
 		getRepositoriesForIndexScan:            op("GetRepositoriesForIndexScan"),
 		getQueuedRepoRev:                       op("GetQueuedRepoRev"),
 		markRepoRevsAsProcessed:                op("MarkRepoRevsAsProcessed"),
@@ -32,6 +32,11 @@ type Store interface {
 	GetLastIndexScanForRepository(ctx context.Context, repositoryID int) (*time.Time, error)
 	SetConfigurationSummary(ctx context.Context, repositoryID int, numEvents int, availableIndexers map[string]uploadsshared.AvailableIndexer) error
 	TruncateConfigurationSummary(ctx context.Context, numRecordsToRetain int) error
+	GetRepositoriesForCoverage(ctx context.Context, interval time.Duration, limit int, now time.Time) ([]int, error)
+	InsertCoverageSnapshots(ctx context.Context, snapshots []shared.CoverageSnapshot) error
+	GetCoverageSnapshots(ctx context.Context, repositoryID int, path string) ([]shared.CoverageSnapshot, error)
+	GetCoverageHistory(ctx context.Context, repositoryID int, path string, since time.Time, limit int) ([]shared.CoverageSnapshot, error)
+	DeleteCoverageSnapshotsBefore(ctx context.Context, before time.Time) (int, error)
 
 	// Scheduler
 	GetRepositoriesForIndexScan(ctx context.Context, processDelay time.Duration, allowGlobalPolicies bool, repositoryMatchLimit *int, limit int, now time.Time) ([]int, error)
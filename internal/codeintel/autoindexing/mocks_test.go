@@ -23,6 +23,16 @@ import (
 // github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/internal/store)
 // used for unit testing.
 type MockStore struct {
+	// DeleteCoverageSnapshotsBeforeFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteCoverageSnapshotsBefore.
+	DeleteCoverageSnapshotsBeforeFunc *StoreDeleteCoverageSnapshotsBeforeFunc
+	// GetCoverageHistoryFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCoverageHistory.
+	GetCoverageHistoryFunc *StoreGetCoverageHistoryFunc
+	// GetCoverageSnapshotsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCoverageSnapshots.
+	GetCoverageSnapshotsFunc *StoreGetCoverageSnapshotsFunc
 	// GetIndexConfigurationByRepositoryIDFunc is an instance of a mock
 	// function object controlling the behavior of the method
 	// GetIndexConfigurationByRepositoryID.
@@ -37,10 +47,17 @@ type MockStore struct {
 	// GetQueuedRepoRevFunc is an instance of a mock function object
 	// controlling the behavior of the method GetQueuedRepoRev.
 	GetQueuedRepoRevFunc *StoreGetQueuedRepoRevFunc
+	// GetRepositoriesForCoverageFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetRepositoriesForCoverage.
+	GetRepositoriesForCoverageFunc *StoreGetRepositoriesForCoverageFunc
 	// GetRepositoriesForIndexScanFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// GetRepositoriesForIndexScan.
 	GetRepositoriesForIndexScanFunc *StoreGetRepositoriesForIndexScanFunc
+	// InsertCoverageSnapshotsFunc is an instance of a mock function object
+	// controlling the behavior of the method InsertCoverageSnapshots.
+	InsertCoverageSnapshotsFunc *StoreInsertCoverageSnapshotsFunc
 	// InsertDependencyIndexingJobFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// InsertDependencyIndexingJob.
@@ -97,6 +114,21 @@ type MockStore struct {
 // return zero values for all results, unless overwritten.
 func NewMockStore() *MockStore {
 	return &MockStore{
+		DeleteCoverageSnapshotsBeforeFunc: &StoreDeleteCoverageSnapshotsBeforeFunc{
+			defaultHook: func(context.Context, time.Time) (r0 int, r1 error) {
+				return
+			},
+		},
+		GetCoverageHistoryFunc: &StoreGetCoverageHistoryFunc{
+			defaultHook: func(context.Context, int, string, time.Time, int) (r0 []shared.CoverageSnapshot, r1 error) {
+				return
+			},
+		},
+		GetCoverageSnapshotsFunc: &StoreGetCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, int, string) (r0 []shared.CoverageSnapshot, r1 error) {
+				return
+			},
+		},
 		GetIndexConfigurationByRepositoryIDFunc: &StoreGetIndexConfigurationByRepositoryIDFunc{
 			defaultHook: func(context.Context, int) (r0 shared.IndexConfiguration, r1 bool, r2 error) {
 				return
@@ -117,11 +149,21 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetRepositoriesForCoverageFunc: &StoreGetRepositoriesForCoverageFunc{
+			defaultHook: func(context.Context, time.Duration, int, time.Time) (r0 []int, r1 error) {
+				return
+			},
+		},
 		GetRepositoriesForIndexScanFunc: &StoreGetRepositoriesForIndexScanFunc{
 			defaultHook: func(context.Context, time.Duration, bool, *int, int, time.Time) (r0 []int, r1 error) {
 				return
 			},
 		},
+		InsertCoverageSnapshotsFunc: &StoreInsertCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, []shared.CoverageSnapshot) (r0 error) {
+				return
+			},
+		},
 		InsertDependencyIndexingJobFunc: &StoreInsertDependencyIndexingJobFunc{
 			defaultHook: func(context.Context, int, string, time.Time) (r0 int, r1 error) {
 				return
@@ -204,6 +246,21 @@ func NewMockStore() *MockStore {
 // panic on invocation, unless overwritten.
 func NewStrictMockStore() *MockStore {
 	return &MockStore{
+		DeleteCoverageSnapshotsBeforeFunc: &StoreDeleteCoverageSnapshotsBeforeFunc{
+			defaultHook: func(context.Context, time.Time) (int, error) {
+				panic("unexpected invocation of MockStore.DeleteCoverageSnapshotsBefore")
+			},
+		},
+		GetCoverageHistoryFunc: &StoreGetCoverageHistoryFunc{
+			defaultHook: func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error) {
+				panic("unexpected invocation of MockStore.GetCoverageHistory")
+			},
+		},
+		GetCoverageSnapshotsFunc: &StoreGetCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, int, string) ([]shared.CoverageSnapshot, error) {
+				panic("unexpected invocation of MockStore.GetCoverageSnapshots")
+			},
+		},
 		GetIndexConfigurationByRepositoryIDFunc: &StoreGetIndexConfigurationByRepositoryIDFunc{
 			defaultHook: func(context.Context, int) (shared.IndexConfiguration, bool, error) {
 				panic("unexpected invocation of MockStore.GetIndexConfigurationByRepositoryID")
@@ -224,11 +281,21 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetQueuedRepoRev")
 			},
 		},
+		GetRepositoriesForCoverageFunc: &StoreGetRepositoriesForCoverageFunc{
+			defaultHook: func(context.Context, time.Duration, int, time.Time) ([]int, error) {
+				panic("unexpected invocation of MockStore.GetRepositoriesForCoverage")
+			},
+		},
 		GetRepositoriesForIndexScanFunc: &StoreGetRepositoriesForIndexScanFunc{
 			defaultHook: func(context.Context, time.Duration, bool, *int, int, time.Time) ([]int, error) {
 				panic("unexpected invocation of MockStore.GetRepositoriesForIndexScan")
 			},
 		},
+		InsertCoverageSnapshotsFunc: &StoreInsertCoverageSnapshotsFunc{
+			defaultHook: func(context.Context, []shared.CoverageSnapshot) error {
+				panic("unexpected invocation of MockStore.InsertCoverageSnapshots")
+			},
+		},
 		InsertDependencyIndexingJobFunc: &StoreInsertDependencyIndexingJobFunc{
 			defaultHook: func(context.Context, int, string, time.Time) (int, error) {
 				panic("unexpected invocation of MockStore.InsertDependencyIndexingJob")
@@ -311,6 +378,15 @@ func NewStrictMockStore() *MockStore {
 // methods delegate to the given implementation, unless overwritten.
 func NewMockStoreFrom(i store.Store) *MockStore {
 	return &MockStore{
+		DeleteCoverageSnapshotsBeforeFunc: &StoreDeleteCoverageSnapshotsBeforeFunc{
+			defaultHook: i.DeleteCoverageSnapshotsBefore,
+		},
+		GetCoverageHistoryFunc: &StoreGetCoverageHistoryFunc{
+			defaultHook: i.GetCoverageHistory,
+		},
+		GetCoverageSnapshotsFunc: &StoreGetCoverageSnapshotsFunc{
+			defaultHook: i.GetCoverageSnapshots,
+		},
 		GetIndexConfigurationByRepositoryIDFunc: &StoreGetIndexConfigurationByRepositoryIDFunc{
 			defaultHook: i.GetIndexConfigurationByRepositoryID,
 		},
@@ -323,9 +399,15 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetQueuedRepoRevFunc: &StoreGetQueuedRepoRevFunc{
 			defaultHook: i.GetQueuedRepoRev,
 		},
+		GetRepositoriesForCoverageFunc: &StoreGetRepositoriesForCoverageFunc{
+			defaultHook: i.GetRepositoriesForCoverage,
+		},
 		GetRepositoriesForIndexScanFunc: &StoreGetRepositoriesForIndexScanFunc{
 			defaultHook: i.GetRepositoriesForIndexScan,
 		},
+		InsertCoverageSnapshotsFunc: &StoreInsertCoverageSnapshotsFunc{
+			defaultHook: i.InsertCoverageSnapshots,
+		},
 		InsertDependencyIndexingJobFunc: &StoreInsertDependencyIndexingJobFunc{
 			defaultHook: i.InsertDependencyIndexingJob,
 		},
@@ -374,6 +456,345 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 	}
 }
 
+// StoreDeleteCoverageSnapshotsBeforeFunc describes the behavior when the
+// DeleteCoverageSnapshotsBefore method of the parent MockStore instance is
+// invoked.
+type StoreDeleteCoverageSnapshotsBeforeFunc struct {
+	defaultHook func(context.Context, time.Time) (int, error)
+	hooks       []func(context.Context, time.Time) (int, error)
+	history     []StoreDeleteCoverageSnapshotsBeforeFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteCoverageSnapshotsBefore delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) DeleteCoverageSnapshotsBefore(v0 context.Context, v1 time.Time) (int, error) {
+	r0, r1 := m.DeleteCoverageSnapshotsBeforeFunc.nextHook()(v0, v1)
+	m.DeleteCoverageSnapshotsBeforeFunc.appendCall(StoreDeleteCoverageSnapshotsBeforeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteCoverageSnapshotsBefore method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) SetDefaultHook(hook func(context.Context, time.Time) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteCoverageSnapshotsBefore method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) PushHook(hook func(context.Context, time.Time) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) nextHook() func(context.Context, time.Time) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) appendCall(r0 StoreDeleteCoverageSnapshotsBeforeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreDeleteCoverageSnapshotsBeforeFuncCall
+// objects describing the invocations of this function.
+func (f *StoreDeleteCoverageSnapshotsBeforeFunc) History() []StoreDeleteCoverageSnapshotsBeforeFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreDeleteCoverageSnapshotsBeforeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreDeleteCoverageSnapshotsBeforeFuncCall is an object that describes an
+// invocation of method DeleteCoverageSnapshotsBefore on an instance of
+// MockStore.
+type StoreDeleteCoverageSnapshotsBeforeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreDeleteCoverageSnapshotsBeforeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreDeleteCoverageSnapshotsBeforeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetCoverageHistoryFunc describes the behavior when the
+// GetCoverageHistory method of the parent MockStore instance is invoked.
+type StoreGetCoverageHistoryFunc struct {
+	defaultHook func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error)
+	hooks       []func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error)
+	history     []StoreGetCoverageHistoryFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCoverageHistory delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetCoverageHistory(v0 context.Context, v1 int, v2 string, v3 time.Time, v4 int) ([]shared.CoverageSnapshot, error) {
+	r0, r1 := m.GetCoverageHistoryFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetCoverageHistoryFunc.appendCall(StoreGetCoverageHistoryFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCoverageHistory
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetCoverageHistoryFunc) SetDefaultHook(hook func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCoverageHistory method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetCoverageHistoryFunc) PushHook(hook func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetCoverageHistoryFunc) SetDefaultReturn(r0 []shared.CoverageSnapshot, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetCoverageHistoryFunc) PushReturn(r0 []shared.CoverageSnapshot, r1 error) {
+	f.PushHook(func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetCoverageHistoryFunc) nextHook() func(context.Context, int, string, time.Time, int) ([]shared.CoverageSnapshot, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetCoverageHistoryFunc) appendCall(r0 StoreGetCoverageHistoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetCoverageHistoryFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetCoverageHistoryFunc) History() []StoreGetCoverageHistoryFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetCoverageHistoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetCoverageHistoryFuncCall is an object that describes an invocation
+// of method GetCoverageHistory on an instance of MockStore.
+type StoreGetCoverageHistoryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.CoverageSnapshot
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetCoverageHistoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetCoverageHistoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetCoverageSnapshotsFunc describes the behavior when the
+// GetCoverageSnapshots method of the parent MockStore instance is invoked.
+type StoreGetCoverageSnapshotsFunc struct {
+	defaultHook func(context.Context, int, string) ([]shared.CoverageSnapshot, error)
+	hooks       []func(context.Context, int, string) ([]shared.CoverageSnapshot, error)
+	history     []StoreGetCoverageSnapshotsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCoverageSnapshots delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore) GetCoverageSnapshots(v0 context.Context, v1 int, v2 string) ([]shared.CoverageSnapshot, error) {
+	r0, r1 := m.GetCoverageSnapshotsFunc.nextHook()(v0, v1, v2)
+	m.GetCoverageSnapshotsFunc.appendCall(StoreGetCoverageSnapshotsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCoverageSnapshots
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreGetCoverageSnapshotsFunc) SetDefaultHook(hook func(context.Context, int, string) ([]shared.CoverageSnapshot, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCoverageSnapshots method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetCoverageSnapshotsFunc) PushHook(hook func(context.Context, int, string) ([]shared.CoverageSnapshot, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetCoverageSnapshotsFunc) SetDefaultReturn(r0 []shared.CoverageSnapshot, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) ([]shared.CoverageSnapshot, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetCoverageSnapshotsFunc) PushReturn(r0 []shared.CoverageSnapshot, r1 error) {
+	f.PushHook(func(context.Context, int, string) ([]shared.CoverageSnapshot, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetCoverageSnapshotsFunc) nextHook() func(context.Context, int, string) ([]shared.CoverageSnapshot, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetCoverageSnapshotsFunc) appendCall(r0 StoreGetCoverageSnapshotsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetCoverageSnapshotsFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetCoverageSnapshotsFunc) History() []StoreGetCoverageSnapshotsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetCoverageSnapshotsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetCoverageSnapshotsFuncCall is an object that describes an
+// invocation of method GetCoverageSnapshots on an instance of MockStore.
+type StoreGetCoverageSnapshotsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.CoverageSnapshot
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetCoverageSnapshotsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetCoverageSnapshotsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreGetIndexConfigurationByRepositoryIDFunc describes the behavior when
 // the GetIndexConfigurationByRepositoryID method of the parent MockStore
 // instance is invoked.
@@ -804,13 +1225,130 @@ type StoreGetQueuedRepoRevFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c StoreGetQueuedRepoRevFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c StoreGetQueuedRepoRevFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetQueuedRepoRevFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetRepositoriesForCoverageFunc describes the behavior when the
+// GetRepositoriesForCoverage method of the parent MockStore instance is
+// invoked.
+type StoreGetRepositoriesForCoverageFunc struct {
+	defaultHook func(context.Context, time.Duration, int, time.Time) ([]int, error)
+	hooks       []func(context.Context, time.Duration, int, time.Time) ([]int, error)
+	history     []StoreGetRepositoriesForCoverageFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoriesForCoverage delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRepositoriesForCoverage(v0 context.Context, v1 time.Duration, v2 int, v3 time.Time) ([]int, error) {
+	r0, r1 := m.GetRepositoriesForCoverageFunc.nextHook()(v0, v1, v2, v3)
+	m.GetRepositoriesForCoverageFunc.appendCall(StoreGetRepositoriesForCoverageFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoriesForCoverage method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetRepositoriesForCoverageFunc) SetDefaultHook(hook func(context.Context, time.Duration, int, time.Time) ([]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoriesForCoverage method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetRepositoriesForCoverageFunc) PushHook(hook func(context.Context, time.Duration, int, time.Time) ([]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRepositoriesForCoverageFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, int, time.Time) ([]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRepositoriesForCoverageFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, time.Duration, int, time.Time) ([]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRepositoriesForCoverageFunc) nextHook() func(context.Context, time.Duration, int, time.Time) ([]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRepositoriesForCoverageFunc) appendCall(r0 StoreGetRepositoriesForCoverageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRepositoriesForCoverageFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetRepositoriesForCoverageFunc) History() []StoreGetRepositoriesForCoverageFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRepositoriesForCoverageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRepositoriesForCoverageFuncCall is an object that describes an
+// invocation of method GetRepositoriesForCoverage on an instance of
+// MockStore.
+type StoreGetRepositoriesForCoverageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRepositoriesForCoverageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c StoreGetQueuedRepoRevFuncCall) Results() []interface{} {
+func (c StoreGetRepositoriesForCoverageFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -937,6 +1475,112 @@ func (c StoreGetRepositoriesForIndexScanFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreInsertCoverageSnapshotsFunc describes the behavior when the
+// InsertCoverageSnapshots method of the parent MockStore instance is
+// invoked.
+type StoreInsertCoverageSnapshotsFunc struct {
+	defaultHook func(context.Context, []shared.CoverageSnapshot) error
+	hooks       []func(context.Context, []shared.CoverageSnapshot) error
+	history     []StoreInsertCoverageSnapshotsFuncCall
+	mutex       sync.Mutex
+}
+
+// InsertCoverageSnapshots delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) InsertCoverageSnapshots(v0 context.Context, v1 []shared.CoverageSnapshot) error {
+	r0 := m.InsertCoverageSnapshotsFunc.nextHook()(v0, v1)
+	m.InsertCoverageSnapshotsFunc.appendCall(StoreInsertCoverageSnapshotsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// InsertCoverageSnapshots method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreInsertCoverageSnapshotsFunc) SetDefaultHook(hook func(context.Context, []shared.CoverageSnapshot) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InsertCoverageSnapshots method of the parent MockStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *StoreInsertCoverageSnapshotsFunc) PushHook(hook func(context.Context, []shared.CoverageSnapshot) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreInsertCoverageSnapshotsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, []shared.CoverageSnapshot) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreInsertCoverageSnapshotsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, []shared.CoverageSnapshot) error {
+		return r0
+	})
+}
+
+func (f *StoreInsertCoverageSnapshotsFunc) nextHook() func(context.Context, []shared.CoverageSnapshot) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreInsertCoverageSnapshotsFunc) appendCall(r0 StoreInsertCoverageSnapshotsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreInsertCoverageSnapshotsFuncCall
+// objects describing the invocations of this function.
+func (f *StoreInsertCoverageSnapshotsFunc) History() []StoreInsertCoverageSnapshotsFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreInsertCoverageSnapshotsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreInsertCoverageSnapshotsFuncCall is an object that describes an
+// invocation of method InsertCoverageSnapshots on an instance of MockStore.
+type StoreInsertCoverageSnapshotsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []shared.CoverageSnapshot
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreInsertCoverageSnapshotsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreInsertCoverageSnapshotsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // StoreInsertDependencyIndexingJobFunc describes the behavior when the
 // InsertDependencyIndexingJob method of the parent MockStore instance is
 // invoked.
@@ -2927,6 +3571,12 @@ type MockUploadService struct {
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *UploadServiceGetUploadByIDFunc
+	// GetUploadDocumentPathsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadDocumentPaths.
+	GetUploadDocumentPathsFunc *UploadServiceGetUploadDocumentPathsFunc
+	// GetUploadsFunc is an instance of a mock function object controlling
+	// the behavior of the method GetUploads.
+	GetUploadsFunc *UploadServiceGetUploadsFunc
 	// ReferencesForUploadFunc is an instance of a mock function object
 	// controlling the behavior of the method ReferencesForUpload.
 	ReferencesForUploadFunc *UploadServiceReferencesForUploadFunc
@@ -2951,6 +3601,16 @@ func NewMockUploadService() *MockUploadService {
 				return
 			},
 		},
+		GetUploadDocumentPathsFunc: &UploadServiceGetUploadDocumentPathsFunc{
+			defaultHook: func(context.Context, int) (r0 []string, r1 error) {
+				return
+			},
+		},
+		GetUploadsFunc: &UploadServiceGetUploadsFunc{
+			defaultHook: func(context.Context, shared1.GetUploadsOptions) (r0 []shared1.Upload, r1 int, r2 error) {
+				return
+			},
+		},
 		ReferencesForUploadFunc: &UploadServiceReferencesForUploadFunc{
 			defaultHook: func(context.Context, int) (r0 shared1.PackageReferenceScanner, r1 error) {
 				return
@@ -2978,6 +3638,16 @@ func NewStrictMockUploadService() *MockUploadService {
 				panic("unexpected invocation of MockUploadService.GetUploadByID")
 			},
 		},
+		GetUploadDocumentPathsFunc: &UploadServiceGetUploadDocumentPathsFunc{
+			defaultHook: func(context.Context, int) ([]string, error) {
+				panic("unexpected invocation of MockUploadService.GetUploadDocumentPaths")
+			},
+		},
+		GetUploadsFunc: &UploadServiceGetUploadsFunc{
+			defaultHook: func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error) {
+				panic("unexpected invocation of MockUploadService.GetUploads")
+			},
+		},
 		ReferencesForUploadFunc: &UploadServiceReferencesForUploadFunc{
 			defaultHook: func(context.Context, int) (shared1.PackageReferenceScanner, error) {
 				panic("unexpected invocation of MockUploadService.ReferencesForUpload")
@@ -3000,6 +3670,12 @@ func NewMockUploadServiceFrom(i UploadService) *MockUploadService {
 		GetUploadByIDFunc: &UploadServiceGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
+		GetUploadDocumentPathsFunc: &UploadServiceGetUploadDocumentPathsFunc{
+			defaultHook: i.GetUploadDocumentPaths,
+		},
+		GetUploadsFunc: &UploadServiceGetUploadsFunc{
+			defaultHook: i.GetUploads,
+		},
 		ReferencesForUploadFunc: &UploadServiceReferencesForUploadFunc{
 			defaultHook: i.ReferencesForUpload,
 		},
@@ -3341,6 +4017,228 @@ func (c UploadServiceGetUploadByIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// UploadServiceGetUploadDocumentPathsFunc describes the behavior when the
+// GetUploadDocumentPaths method of the parent MockUploadService instance is
+// invoked.
+type UploadServiceGetUploadDocumentPathsFunc struct {
+	defaultHook func(context.Context, int) ([]string, error)
+	hooks       []func(context.Context, int) ([]string, error)
+	history     []UploadServiceGetUploadDocumentPathsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadDocumentPaths delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockUploadService) GetUploadDocumentPaths(v0 context.Context, v1 int) ([]string, error) {
+	r0, r1 := m.GetUploadDocumentPathsFunc.nextHook()(v0, v1)
+	m.GetUploadDocumentPathsFunc.appendCall(UploadServiceGetUploadDocumentPathsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetUploadDocumentPaths method of the parent MockUploadService instance is
+// invoked and the hook queue is empty.
+func (f *UploadServiceGetUploadDocumentPathsFunc) SetDefaultHook(hook func(context.Context, int) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadDocumentPaths method of the parent MockUploadService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UploadServiceGetUploadDocumentPathsFunc) PushHook(hook func(context.Context, int) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadServiceGetUploadDocumentPathsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadServiceGetUploadDocumentPathsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *UploadServiceGetUploadDocumentPathsFunc) nextHook() func(context.Context, int) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadServiceGetUploadDocumentPathsFunc) appendCall(r0 UploadServiceGetUploadDocumentPathsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadServiceGetUploadDocumentPathsFuncCall
+// objects describing the invocations of this function.
+func (f *UploadServiceGetUploadDocumentPathsFunc) History() []UploadServiceGetUploadDocumentPathsFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadServiceGetUploadDocumentPathsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadServiceGetUploadDocumentPathsFuncCall is an object that describes
+// an invocation of method GetUploadDocumentPaths on an instance of
+// MockUploadService.
+type UploadServiceGetUploadDocumentPathsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadServiceGetUploadDocumentPathsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadServiceGetUploadDocumentPathsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// UploadServiceGetUploadsFunc describes the behavior when the GetUploads
+// method of the parent MockUploadService instance is invoked.
+type UploadServiceGetUploadsFunc struct {
+	defaultHook func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error)
+	hooks       []func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error)
+	history     []UploadServiceGetUploadsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploads delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockUploadService) GetUploads(v0 context.Context, v1 shared1.GetUploadsOptions) ([]shared1.Upload, int, error) {
+	r0, r1, r2 := m.GetUploadsFunc.nextHook()(v0, v1)
+	m.GetUploadsFunc.appendCall(UploadServiceGetUploadsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetUploads method of
+// the parent MockUploadService instance is invoked and the hook queue is
+// empty.
+func (f *UploadServiceGetUploadsFunc) SetDefaultHook(hook func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploads method of the parent MockUploadService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *UploadServiceGetUploadsFunc) PushHook(hook func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadServiceGetUploadsFunc) SetDefaultReturn(r0 []shared1.Upload, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadServiceGetUploadsFunc) PushReturn(r0 []shared1.Upload, r1 int, r2 error) {
+	f.PushHook(func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *UploadServiceGetUploadsFunc) nextHook() func(context.Context, shared1.GetUploadsOptions) ([]shared1.Upload, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadServiceGetUploadsFunc) appendCall(r0 UploadServiceGetUploadsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadServiceGetUploadsFuncCall objects
+// describing the invocations of this function.
+func (f *UploadServiceGetUploadsFunc) History() []UploadServiceGetUploadsFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadServiceGetUploadsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadServiceGetUploadsFuncCall is an object that describes an invocation
+// of method GetUploads on an instance of MockUploadService.
+type UploadServiceGetUploadsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared1.GetUploadsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared1.Upload
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadServiceGetUploadsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadServiceGetUploadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // UploadServiceReferencesForUploadFunc describes the behavior when the
 // ReferencesForUpload method of the parent MockUploadService instance is
 // invoked.
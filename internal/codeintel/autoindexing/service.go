@@ -2,6 +2,8 @@ package autoindexing
 
 import (
 	"context"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/sourcegraph/log"
@@ -151,3 +153,32 @@ func (s *Service) RepositoryIDsWithConfiguration(ctx context.Context, offset, li
 func (s *Service) GetLastIndexScanForRepository(ctx context.Context, repositoryID int) (*time.Time, error) {
 	return s.store.GetLastIndexScanForRepository(ctx, repositoryID)
 }
+
+// GetCoverage returns the most recently captured coverage snapshot of the given directory of a
+// repository, along with the snapshots of its immediate subdirectories captured at the same time.
+// The boolean flag is false if the coverage of the directory has not been captured.
+func (s *Service) GetCoverage(ctx context.Context, repositoryID int, dirPath string) (_ shared.CoverageSnapshot, children []shared.CoverageSnapshot, _ bool, err error) {
+	dirPath = normalizeCoveragePath(dirPath)
+
+	snapshots, err := s.store.GetCoverageSnapshots(ctx, repositoryID, dirPath)
+	if err != nil {
+		return shared.CoverageSnapshot{}, nil, false, err
+	}
+	if len(snapshots) == 0 || snapshots[0].Path != dirPath {
+		return shared.CoverageSnapshot{}, nil, false, nil
+	}
+
+	return snapshots[0], snapshots[1:], true, nil
+}
+
+// GetCoverageHistory returns the coverage snapshots of the given directory of a repository captured
+// at or after the given time, most recent first.
+func (s *Service) GetCoverageHistory(ctx context.Context, repositoryID int, dirPath string, since time.Time, limit int) ([]shared.CoverageSnapshot, error) {
+	return s.store.GetCoverageHistory(ctx, repositoryID, normalizeCoveragePath(dirPath), since, limit)
+}
+
+// normalizeCoveragePath converts the given directory into the form stored in coverage snapshots:
+// relative to the repository root and without leading or trailing slashes.
+func normalizeCoveragePath(dirPath string) string {
+	return strings.Trim(path.Clean("/"+dirPath), "/")
+}
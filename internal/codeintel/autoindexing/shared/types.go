@@ -1,6 +1,10 @@
 package shared
 
-import "github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
 
 // IndexConfiguration stores the index configuration for a repository.
 type IndexConfiguration struct {
@@ -13,3 +17,22 @@ type InferenceResult struct {
 	IndexJobs       []config.IndexJob
 	InferenceOutput string
 }
+
+// CoverageSnapshot describes how many files of a directory at the tip of the default branch of a
+// repository were covered by precise code intelligence data at a point in time.
+type CoverageSnapshot struct {
+	ID           int
+	RepositoryID int
+	Commit       string
+	// Path is the directory relative to the repository root, without a trailing slash. The
+	// empty string denotes the repository root.
+	Path         string
+	TotalFiles   int
+	IndexedFiles int
+	Indexers     []string
+	// StalenessCommits is the largest number of commits between the tip of the default branch
+	// and the commit of a precise index covering files in this directory. This value is nil if
+	// no file in the directory is covered.
+	StalenessCommits *int
+	CapturedAt       time.Time
+}
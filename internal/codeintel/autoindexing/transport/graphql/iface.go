@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"context"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/shared"
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
@@ -20,4 +21,8 @@ type AutoIndexingService interface {
 	QueueIndexes(ctx context.Context, repositoryID int, rev, configuration string, force bool, bypassLimit bool) ([]uploadsshared.Index, error)
 	InferIndexConfiguration(ctx context.Context, repositoryID int, commit string, localOverrideScript string, bypassLimit bool) (*shared.InferenceResult, error)
 	InferIndexJobsFromRepositoryStructure(ctx context.Context, repositoryID int, commit string, localOverrideScript string, bypassLimit bool) (*shared.InferenceResult, error)
+
+	// Coverage
+	GetCoverage(ctx context.Context, repositoryID int, path string) (shared.CoverageSnapshot, []shared.CoverageSnapshot, bool, error)
+	GetCoverageHistory(ctx context.Context, repositoryID int, path string, since time.Time, limit int) ([]shared.CoverageSnapshot, error)
 }
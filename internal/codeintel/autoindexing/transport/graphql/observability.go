@@ -8,6 +8,7 @@ import (
 )
 
 type operations struct {
+	codeIntelCoverage                     *observation.Operation
 	codeIntelligenceInferenceScript       *observation.Operation
 	indexConfiguration                    *observation.Operation
 	inferAutoIndexJobsForRepo             *observation.Operation
@@ -33,6 +34,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
+		codeIntelCoverage:                     op("CodeIntelCoverage"),
 		codeIntelligenceInferenceScript:       op("CodeIntelligenceInferenceScript"),
 		indexConfiguration:                    op("IndexConfiguration"),
 		inferAutoIndexJobsForRepo:             op("InferAutoIndexJobsForRepo"),
@@ -0,0 +1,158 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/autoindexing/shared"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
+	uploadsgraphql "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/transport/graphql"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// maxCoverageHistory is the maximum number of historic coverage snapshots returned at once.
+const maxCoverageHistory = 1000
+
+// 🚨 SECURITY: Coverage of repositories the current user cannot see is not returned
+func (r *rootResolver) CodeIntelCoverage(ctx context.Context, args *resolverstubs.CodeIntelCoverageArgs) (_ resolverstubs.CodeIntelCoverageResolver, err error) {
+	ctx, _, endObservation := r.operations.codeIntelCoverage.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", string(args.Repository)),
+		attribute.String("path", args.Path),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	repositoryID, err := resolverstubs.UnmarshalID[int](args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	locationResolver := r.locationResolverFactory.Create()
+	if repository, err := locationResolver.Repository(ctx, api.RepoID(repositoryID)); err != nil || repository == nil {
+		return nil, err
+	}
+
+	snapshot, children, ok, err := r.autoindexSvc.GetCoverage(ctx, repositoryID, args.Path)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newCodeIntelCoverageResolver(r.autoindexSvc, locationResolver, snapshot, children), nil
+}
+
+//
+//
+
+type codeIntelCoverageResolver struct {
+	codeIntelCoverageSnapshotResolver
+	autoindexSvc     AutoIndexingService
+	locationResolver *gitresolvers.CachedLocationResolver
+	children         []shared.CoverageSnapshot
+}
+
+// newCodeIntelCoverageResolver creates a resolver for the given snapshot. If children is nil,
+// the snapshots of immediate subdirectories are fetched on demand.
+func newCodeIntelCoverageResolver(
+	autoindexSvc AutoIndexingService,
+	locationResolver *gitresolvers.CachedLocationResolver,
+	snapshot shared.CoverageSnapshot,
+	children []shared.CoverageSnapshot,
+) resolverstubs.CodeIntelCoverageResolver {
+	return &codeIntelCoverageResolver{
+		codeIntelCoverageSnapshotResolver: codeIntelCoverageSnapshotResolver{snapshot: snapshot},
+		autoindexSvc:                      autoindexSvc,
+		locationResolver:                  locationResolver,
+		children:                          children,
+	}
+}
+
+func (r *codeIntelCoverageResolver) Repository(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	return r.locationResolver.Repository(ctx, api.RepoID(r.snapshot.RepositoryID))
+}
+
+func (r *codeIntelCoverageResolver) Path() string { return r.snapshot.Path }
+
+func (r *codeIntelCoverageResolver) Children(ctx context.Context) ([]resolverstubs.CodeIntelCoverageResolver, error) {
+	children := r.children
+	if children == nil {
+		_, snapshots, _, err := r.autoindexSvc.GetCoverage(ctx, r.snapshot.RepositoryID, r.snapshot.Path)
+		if err != nil {
+			return nil, err
+		}
+		children = snapshots
+	}
+
+	resolvers := make([]resolverstubs.CodeIntelCoverageResolver, 0, len(children))
+	for _, child := range children {
+		resolvers = append(resolvers, newCodeIntelCoverageResolver(r.autoindexSvc, r.locationResolver, child, nil))
+	}
+
+	return resolvers, nil
+}
+
+func (r *codeIntelCoverageResolver) History(ctx context.Context, args *resolverstubs.CodeIntelCoverageHistoryArgs) ([]resolverstubs.CodeIntelCoverageSnapshotResolver, error) {
+	var since time.Time
+	if args.Since != nil {
+		since = args.Since.Time
+	}
+	limit := int(args.First)
+	if limit <= 0 || limit > maxCoverageHistory {
+		limit = maxCoverageHistory
+	}
+
+	snapshots, err := r.autoindexSvc.GetCoverageHistory(ctx, r.snapshot.RepositoryID, r.snapshot.Path, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]resolverstubs.CodeIntelCoverageSnapshotResolver, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		resolvers = append(resolvers, &codeIntelCoverageSnapshotResolver{snapshot: snapshot})
+	}
+
+	return resolvers, nil
+}
+
+type codeIntelCoverageSnapshotResolver struct {
+	snapshot shared.CoverageSnapshot
+}
+
+func (r *codeIntelCoverageSnapshotResolver) Commit() string    { return r.snapshot.Commit }
+func (r *codeIntelCoverageSnapshotResolver) TotalFiles() int32 { return int32(r.snapshot.TotalFiles) }
+func (r *codeIntelCoverageSnapshotResolver) IndexedFiles() int32 {
+	return int32(r.snapshot.IndexedFiles)
+}
+
+func (r *codeIntelCoverageSnapshotResolver) Percentage() float64 {
+	if r.snapshot.TotalFiles == 0 {
+		return 0
+	}
+
+	return 100 * float64(r.snapshot.IndexedFiles) / float64(r.snapshot.TotalFiles)
+}
+
+func (r *codeIntelCoverageSnapshotResolver) Indexers() []resolverstubs.CodeIntelIndexerResolver {
+	resolvers := make([]resolverstubs.CodeIntelIndexerResolver, 0, len(r.snapshot.Indexers))
+	for _, indexer := range r.snapshot.Indexers {
+		resolvers = append(resolvers, uploadsgraphql.NewCodeIntelIndexerResolver(indexer, ""))
+	}
+
+	return resolvers
+}
+
+func (r *codeIntelCoverageSnapshotResolver) StalenessCommits() *int32 {
+	if r.snapshot.StalenessCommits == nil {
+		return nil
+	}
+
+	staleness := int32(*r.snapshot.StalenessCommits)
+	return &staleness
+}
+
+func (r *codeIntelCoverageSnapshotResolver) CapturedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.snapshot.CapturedAt}
+}
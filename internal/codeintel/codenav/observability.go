@@ -20,9 +20,12 @@ type operations struct {
 	getDefinitions         *observation.Operation
 	getRanges              *observation.Operation
 	getStencil             *observation.Operation
+	getDocumentSymbols     *observation.Operation
 	getClosestDumpsForBlob *observation.Operation
 	snapshotForDocument    *observation.Operation
 	visibleUploadsForPath  *observation.Operation
+	previewRename          *observation.Operation
+	countPreciseReferences *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -54,9 +57,12 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getDefinitions:         op("getDefinitions"),
 		getRanges:              op("getRanges"),
 		getStencil:             op("getStencil"),
+		getDocumentSymbols:     op("getDocumentSymbols"),
 		getClosestDumpsForBlob: op("GetClosestDumpsForBlob"),
 		snapshotForDocument:    op("SnapshotForDocument"),
 		visibleUploadsForPath:  op("VisibleUploadsForPath"),
+		previewRename:          op("PreviewRename"),
+		countPreciseReferences: op("CountPreciseReferences"),
 	}
 }
 
@@ -0,0 +1,67 @@
+package codenav
+
+import (
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go/relay"
+
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/git"
+)
+
+// RenameChangesetOptions describes the changesets created from a rename preview.
+type RenameChangesetOptions struct {
+	Branch      string
+	Title       string
+	Body        string
+	Message     string
+	AuthorName  string
+	AuthorEmail string
+}
+
+// RenameChangesetSpecs returns a changeset spec applying the edits of each repository in the
+// given rename preview on top of its base revision. The resulting specs are unpublished and
+// can be attached to a batch spec via the batch changes service.
+func RenameChangesetSpecs(preview RenamePreview, opts RenameChangesetOptions) []*batcheslib.ChangesetSpec {
+	specs := make([]*batcheslib.ChangesetSpec, 0, len(preview.Repositories))
+	for _, repository := range preview.Repositories {
+		repositoryID := string(relay.MarshalID("Repository", repository.RepositoryID))
+
+		specs = append(specs, &batcheslib.ChangesetSpec{
+			BaseRepository: repositoryID,
+			BaseRef:        repository.BaseRef,
+			BaseRev:        repository.BaseRev,
+			HeadRepository: repositoryID,
+			HeadRef:        git.EnsureRefPrefix(opts.Branch),
+			Title:          opts.Title,
+			Body:           opts.Body,
+			Commits: []batcheslib.GitCommitDescription{
+				{
+					Version:     2,
+					Message:     opts.Message,
+					Diff:        []byte(repository.Diff),
+					AuthorName:  opts.AuthorName,
+					AuthorEmail: opts.AuthorEmail,
+				},
+			},
+			Published: batcheslib.PublishedValue{Val: false},
+		})
+	}
+
+	return specs
+}
+
+// DefaultRenameChangesetOptions returns the options used to describe the changesets created
+// from the given rename preview when no explicit options are supplied.
+func DefaultRenameChangesetOptions(preview RenamePreview) RenameChangesetOptions {
+	oldName, newName := preview.OldName, preview.NewName
+
+	return RenameChangesetOptions{
+		Branch:      fmt.Sprintf("rename-%s-to-%s", oldName, newName),
+		Title:       fmt.Sprintf("Rename %s to %s", oldName, newName),
+		Body:        fmt.Sprintf("Renames all occurrences of `%s` to `%s` found by precise code navigation.", oldName, newName),
+		Message:     fmt.Sprintf("Rename %s to %s", oldName, newName),
+		AuthorName:  "Sourcegraph",
+		AuthorEmail: "batch-changes@sourcegraph.com",
+	}
+}
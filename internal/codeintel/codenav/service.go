@@ -818,6 +818,81 @@ func (s *Service) GetStencil(ctx context.Context, args PositionalRequestArgs, re
 	return dedupeRanges(sortedRanges), nil
 }
 
+// GetDocumentSymbols returns the set of non-local symbols defined within the given document. The range
+// of each definition is adjusted to the target commit; definitions that cannot be adjusted are omitted.
+func (s *Service) GetDocumentSymbols(ctx context.Context, args PositionalRequestArgs, requestState RequestState) (symbols []DocumentSymbol, err error) {
+	ctx, trace, endObservation := observeResolver(ctx, &err, s.operations.getDocumentSymbols, serviceObserverThreshold, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("numUploads", len(requestState.GetCacheUploads())),
+		attribute.String("uploads", uploadIDsToString(requestState.GetCacheUploads())),
+	}})
+	defer endObservation()
+
+	uploadsWithPath, err := s.getUploadPaths(ctx, args.Path, requestState)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]struct{}{}
+	for i := range uploadsWithPath {
+		trace.AddEvent("TODO Domain Owner", attribute.Int("uploadID", uploadsWithPath[i].Upload.ID))
+
+		document, err := s.lsifstore.SCIPDocument(
+			ctx,
+			uploadsWithPath[i].Upload.ID,
+			uploadsWithPath[i].TargetPathWithoutRoot,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.SCIPDocument")
+		}
+		if document == nil {
+			continue
+		}
+
+		symbolTable := document.SymbolTable()
+		for _, occurrence := range document.Occurrences {
+			if occurrence.SymbolRoles&int32(scip.SymbolRole_Definition) == 0 || scip.IsLocalSymbol(occurrence.Symbol) {
+				continue
+			}
+			if _, ok := seen[occurrence.Symbol]; ok {
+				// Prefer the definition from the upload closest to the target commit
+				continue
+			}
+
+			r := scip.NewRange(occurrence.Range)
+			rn := shared.Range{
+				Start: shared.Position{Line: int(r.Start.Line), Character: int(r.Start.Character)},
+				End:   shared.Position{Line: int(r.End.Line), Character: int(r.End.Character)},
+			}
+
+			_, adjustedRange, ok, err := s.getSourceRange(ctx, args.RequestArgs, requestState, uploadsWithPath[i].Upload.RepositoryID, uploadsWithPath[i].Upload.Commit, uploadsWithPath[i].TargetPath, rn)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			symbol := DocumentSymbol{
+				Symbol: occurrence.Symbol,
+				Range:  adjustedRange,
+			}
+			if info, ok := symbolTable[occurrence.Symbol]; ok {
+				symbol.DisplayName = info.DisplayName
+				symbol.Kind = info.Kind
+			}
+
+			seen[occurrence.Symbol] = struct{}{}
+			symbols = append(symbols, symbol)
+		}
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numSymbols", len(symbols)))
+
+	return symbols, nil
+}
+
 // TODO(#48681) - do not proxy this
 func (s *Service) GetDumpsByIDs(ctx context.Context, ids []int) ([]uploadsshared.Dump, error) {
 	return s.uploadSvc.GetDumpsByIDs(ctx, ids)
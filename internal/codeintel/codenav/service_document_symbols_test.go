@@ -0,0 +1,85 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestDocumentSymbols(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	// Set up request state
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{}, mockCommit, mockPath, hunkCache)
+	uploads := []uploadsshared.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+		{ID: 51, Commit: "deadbeef", Root: "sub2/"},
+	}
+	mockRequestState.SetUploadsDataLoader(uploads)
+
+	mockLsifStore.SCIPDocumentFunc.PushReturn(&scip.Document{
+		RelativePath: "main.go",
+		Occurrences: []*scip.Occurrence{
+			{Range: []int32{3, 5, 8}, Symbol: "scip-go gomod example v1 `example`/Foo#", SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{4, 1, 4}, Symbol: "scip-go gomod example v1 `example`/Foo#", SymbolRoles: 0},
+			{Range: []int32{5, 1, 2}, Symbol: "local 0", SymbolRoles: int32(scip.SymbolRole_Definition)},
+			{Range: []int32{10, 5, 10, 8}, Symbol: "scip-go gomod example v1 `example`/bar().", SymbolRoles: int32(scip.SymbolRole_Definition)},
+		},
+		Symbols: []*scip.SymbolInformation{
+			{Symbol: "scip-go gomod example v1 `example`/Foo#", DisplayName: "Foo", Kind: scip.SymbolInformation_Struct},
+		},
+	}, nil)
+	mockLsifStore.SCIPDocumentFunc.PushReturn(&scip.Document{
+		RelativePath: "main.go",
+		Occurrences: []*scip.Occurrence{
+			// Duplicate of a definition seen in a previous upload
+			{Range: []int32{3, 5, 8}, Symbol: "scip-go gomod example v1 `example`/Foo#", SymbolRoles: int32(scip.SymbolRole_Definition)},
+		},
+	}, nil)
+
+	mockRequest := PositionalRequestArgs{
+		RequestArgs: RequestArgs{
+			RepositoryID: 42,
+			Commit:       mockCommit,
+		},
+		Path: mockPath,
+	}
+	symbols, err := svc.GetDocumentSymbols(context.Background(), mockRequest, mockRequestState)
+	if err != nil {
+		t.Fatalf("unexpected error querying document symbols: %s", err)
+	}
+
+	expectedSymbols := []DocumentSymbol{
+		{
+			Symbol:      "scip-go gomod example v1 `example`/Foo#",
+			DisplayName: "Foo",
+			Kind:        scip.SymbolInformation_Struct,
+			Range:       shared.Range{Start: shared.Position{Line: 3, Character: 5}, End: shared.Position{Line: 3, Character: 8}},
+		},
+		{
+			Symbol: "scip-go gomod example v1 `example`/bar().",
+			Range:  shared.Range{Start: shared.Position{Line: 10, Character: 5}, End: shared.Position{Line: 10, Character: 8}},
+		},
+	}
+	if diff := cmp.Diff(expectedSymbols, symbols); diff != "" {
+		t.Errorf("unexpected symbols (-want +got):\n%s", diff)
+	}
+}
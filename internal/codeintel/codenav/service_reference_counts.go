@@ -0,0 +1,48 @@
+package codenav
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CountPreciseReferences returns the number of precise references to the given SCIP symbol
+// in the given repository, as seen from the given commit. The references are counted in the
+// uploads visible from the commit, so a commit that was not indexed itself is answered with
+// the closest indexed commit(s) of its history. Zero is returned if no upload is visible.
+func (s *Service) CountPreciseReferences(ctx context.Context, repositoryID int, commit, symbolName string) (_ int, err error) {
+	ctx, trace, endObservation := s.operations.countPreciseReferences.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", commit),
+		attribute.String("symbolName", symbolName),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	uploads, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return 0, errors.Wrap(err, "uploadSvc.InferClosestUploads")
+	}
+	trace.AddEvent("InferClosestUploads", attribute.Int("numUploads", len(uploads)))
+
+	if len(uploads) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]int, 0, len(uploads))
+	for _, upload := range uploads {
+		ids = append(ids, upload.ID)
+	}
+
+	// We only need the total count, so we don't request any locations.
+	monikers := []precise.MonikerData{{Kind: "import", Scheme: "scip", Identifier: symbolName}}
+	_, totalCount, err := s.lsifstore.GetBulkMonikerLocations(ctx, "references", ids, monikers, 0, 0)
+	if err != nil {
+		return 0, errors.Wrap(err, "lsifStore.GetBulkMonikerLocations")
+	}
+
+	return totalCount, nil
+}
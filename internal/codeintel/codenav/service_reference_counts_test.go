@@ -0,0 +1,73 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestCountPreciseReferences(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	const symbolName = "scip-go gomod github.com/sourcegraph/lib v1 `lib`/Deprecated()."
+	mockUploadSvc.InferClosestUploadsFunc.SetDefaultReturn([]uploadsshared.Dump{{ID: 50}, {ID: 51}}, nil)
+	mockLsifStore.GetBulkMonikerLocationsFunc.SetDefaultReturn(nil, 23, nil)
+
+	count, err := svc.CountPreciseReferences(context.Background(), 42, mockCommit, symbolName)
+	if err != nil {
+		t.Fatalf("unexpected error counting references: %s", err)
+	}
+	if count != 23 {
+		t.Errorf("unexpected count. want=%d have=%d", 23, count)
+	}
+
+	if history := mockUploadSvc.InferClosestUploadsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected history length. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg1 != 42 || history[0].Arg2 != mockCommit {
+		t.Errorf("unexpected repository and commit. want=%d@%s have=%d@%s", 42, mockCommit, history[0].Arg1, history[0].Arg2)
+	}
+
+	if history := mockLsifStore.GetBulkMonikerLocationsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected history length. want=%d have=%d", 1, len(history))
+	} else {
+		if history[0].Arg1 != "references" {
+			t.Errorf("unexpected table name. want=%s have=%s", "references", history[0].Arg1)
+		}
+		if diff := cmp.Diff([]int{50, 51}, history[0].Arg2); diff != "" {
+			t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]precise.MonikerData{{Kind: "import", Scheme: "scip", Identifier: symbolName}}, history[0].Arg3); diff != "" {
+			t.Errorf("unexpected monikers (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestCountPreciseReferencesNoUploads(t *testing.T) {
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	svc := newService(&observation.TestContext, defaultMockRepoStore(), mockLsifStore, mockUploadSvc, gitserver.NewMockClient())
+
+	count, err := svc.CountPreciseReferences(context.Background(), 42, mockCommit, "scip-go gomod lib v1 `lib`/Deprecated().")
+	if err != nil {
+		t.Fatalf("unexpected error counting references: %s", err)
+	}
+	if count != 0 {
+		t.Errorf("unexpected count. want=%d have=%d", 0, count)
+	}
+	if history := mockLsifStore.GetBulkMonikerLocationsFunc.History(); len(history) != 0 {
+		t.Errorf("unexpected lsifstore calls. want=%d have=%d", 0, len(history))
+	}
+}
@@ -0,0 +1,460 @@
+package codenav
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// renamePageSize is the number of locations requested per page while gathering the
+	// occurrences of a symbol to rename.
+	renamePageSize = 500
+
+	// maximumRenameOccurrences is the maximum number of occurrences of a single symbol that
+	// can be renamed. A partial rename is never useful, so we refuse to preview a rename
+	// over this limit rather than truncating the result.
+	maximumRenameOccurrences = 10000
+)
+
+// Reasons attached to occurrences that are excluded from a rename.
+const (
+	RenameConflictReasonUnresolvableBranch = "the default branch of the repository could not be resolved"
+	RenameConflictReasonModified           = "the occurrence has been modified since the repository was indexed"
+	RenameConflictReasonMultiline          = "the occurrence spans multiple lines"
+	RenameConflictReasonUnexpectedText     = "the text of the occurrence does not match the name of the symbol"
+	RenameConflictReasonOverlapping        = "the occurrence overlaps with another occurrence"
+)
+
+// PreviewRename computes the edits required to rename the given symbol to newName in every
+// repository with precise data that defines or references it. Occurrences are gathered from
+// the index defining the symbol and from every index referencing it by moniker, then adjusted
+// to the tip of the default branch of their repository. Occurrences that cannot be adjusted,
+// or whose text no longer matches the symbol's name, are reported as conflicts and left as-is.
+//
+// The given request args and state identify the repository and commit from which the rename
+// was requested (conventionally the repository defining the symbol).
+func (s *Service) PreviewRename(ctx context.Context, args RequestArgs, requestState RequestState, symbolName, newName string) (_ RenamePreview, err error) {
+	ctx, trace, endObservation := s.operations.previewRename.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("symbolName", symbolName),
+		attribute.String("newName", newName),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	oldName, err := renamableSymbolName(symbolName)
+	if err != nil {
+		return RenamePreview{}, err
+	}
+	if !isIdentifier(newName) {
+		return RenamePreview{}, errors.Newf("%q is not a valid identifier", newName)
+	}
+	if newName == oldName {
+		return RenamePreview{}, errors.Newf("symbol is already named %q", newName)
+	}
+
+	locations, err := s.gatherRenameLocations(ctx, args, requestState, symbolName)
+	if err != nil {
+		return RenamePreview{}, err
+	}
+	trace.AddEvent("GatheredLocations", attribute.Int("numLocations", len(locations)))
+
+	locationsByRepositoryID := map[int][]shared.UploadLocation{}
+	for _, location := range locations {
+		locationsByRepositoryID[location.Dump.RepositoryID] = append(locationsByRepositoryID[location.Dump.RepositoryID], location)
+	}
+
+	preview := RenamePreview{OldName: oldName, NewName: newName}
+	for _, repositoryLocations := range locationsByRepositoryID {
+		if err := s.previewRepositoryRename(ctx, &preview, repositoryLocations, oldName, newName); err != nil {
+			return RenamePreview{}, err
+		}
+	}
+
+	sort.Slice(preview.Repositories, func(i, j int) bool {
+		return preview.Repositories[i].RepositoryName < preview.Repositories[j].RepositoryName
+	})
+	sort.SliceStable(preview.Conflicts, func(i, j int) bool {
+		return compareRenameConflicts(preview.Conflicts[i], preview.Conflicts[j])
+	})
+	sort.Slice(preview.StaleUploads, func(i, j int) bool {
+		return preview.StaleUploads[i].UploadID < preview.StaleUploads[j].UploadID
+	})
+
+	return preview, nil
+}
+
+// gatherRenameLocations returns the definitions and references of the given symbol from all
+// indexes that define or reference it.
+func (s *Service) gatherRenameLocations(ctx context.Context, args RequestArgs, requestState RequestState, symbolName string) ([]shared.UploadLocation, error) {
+	args.Limit = renamePageSize
+
+	var allLocations []shared.UploadLocation
+	for _, phase := range []struct {
+		operation                 *observation.Operation
+		tableName                 string
+		includeReferencingIndexes bool
+	}{
+		{s.operations.getDefinitions, "definitions", false},
+		{s.operations.getReferences, "references", true},
+	} {
+		cursor := Cursor{}
+		for cursor.Phase != "done" {
+			var locations []shared.UploadLocation
+			var err error
+
+			// N.B.: cursor is purposefully re-assigned here
+			locations, cursor, err = s.gatherLocationsBySymbolNames(
+				ctx,
+				args,
+				requestState,
+				cursor,
+				phase.operation,
+				phase.tableName,
+				phase.includeReferencingIndexes,
+				[]string{symbolName},
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			allLocations = append(allLocations, locations...)
+			if len(allLocations) > maximumRenameOccurrences {
+				return nil, errors.Newf("symbol has more than %d occurrences", maximumRenameOccurrences)
+			}
+		}
+	}
+
+	return allLocations, nil
+}
+
+// previewRepositoryRename adds the edits, conflicts, and stale uploads for the given locations,
+// which all belong to the same repository, to the given preview.
+func (s *Service) previewRepositoryRename(ctx context.Context, preview *RenamePreview, locations []shared.UploadLocation, oldName, newName string) error {
+	repositoryID := locations[0].Dump.RepositoryID
+	repositoryName := locations[0].Dump.RepositoryName
+
+	addConflict := func(path string, rng shared.Range, reason string) {
+		preview.Conflicts = append(preview.Conflicts, RenameConflict{
+			RepositoryID:   repositoryID,
+			RepositoryName: repositoryName,
+			Path:           path,
+			Range:          rng,
+			Reason:         reason,
+		})
+	}
+
+	// 🚨 SECURITY: Occurrences in repositories the current user cannot see are omitted
+	repo, err := s.repoStore.Get(ctx, api.RepoID(repositoryID))
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	baseRef, baseRev, err := s.gitserver.GetDefaultBranch(ctx, repo.Name, false)
+	if err != nil || baseRev == "" {
+		for _, location := range locations {
+			addConflict(location.Path, location.TargetRange, RenameConflictReasonUnresolvableBranch)
+		}
+		return nil
+	}
+
+	// Adjust each location from the commit it is known at to the base revision
+	translator := NewGitTreeTranslator(s.gitserver, &requestArgs{repo: repo, commit: string(baseRev)}, nil)
+	staleUploads := map[int]struct{}{}
+	rangesByPath := map[string][]shared.Range{}
+
+	for _, location := range locations {
+		if location.Dump.Commit != string(baseRev) {
+			if _, ok := staleUploads[location.Dump.ID]; !ok {
+				staleUploads[location.Dump.ID] = struct{}{}
+				preview.StaleUploads = append(preview.StaleUploads, RenameStaleUpload{
+					UploadID:       location.Dump.ID,
+					RepositoryID:   repositoryID,
+					RepositoryName: repositoryName,
+					Commit:         location.Dump.Commit,
+					BaseRev:        string(baseRev),
+				})
+			}
+		}
+
+		rng := location.TargetRange
+		if location.TargetCommit != string(baseRev) {
+			_, adjustedRange, ok, err := translator.GetTargetCommitRangeFromSourceRange(ctx, location.TargetCommit, location.Path, rng, true)
+			if err != nil {
+				return errors.Wrap(err, "gitTreeTranslator.GetTargetCommitRangeFromSourceRange")
+			}
+			if !ok {
+				addConflict(location.Path, rng, RenameConflictReasonModified)
+				continue
+			}
+			rng = adjustedRange
+		}
+
+		rangesByPath[location.Path] = append(rangesByPath[location.Path], rng)
+	}
+
+	paths := make([]string, 0, len(rangesByPath))
+	for path := range rangesByPath {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var edits []RenameEdit
+	var diff strings.Builder
+	for _, path := range paths {
+		content, err := s.gitserver.ReadFile(ctx, repo.Name, baseRev, path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return errors.Wrap(err, "gitserver.ReadFile")
+			}
+
+			for _, rng := range rangesByPath[path] {
+				addConflict(path, rng, RenameConflictReasonModified)
+			}
+			continue
+		}
+
+		fileEdits, conflicts := renameEditsForFile(string(content), rangesByPath[path], oldName)
+		for _, conflict := range conflicts {
+			addConflict(path, conflict.rng, conflict.reason)
+		}
+		if len(fileEdits) == 0 {
+			continue
+		}
+
+		for _, rng := range fileEdits {
+			edits = append(edits, RenameEdit{Path: path, Range: rng})
+		}
+		diff.WriteString(renameFileDiff(path, string(content), fileEdits, newName))
+	}
+
+	if len(edits) > 0 {
+		preview.Repositories = append(preview.Repositories, RenameRepositoryPreview{
+			RepositoryID:   repositoryID,
+			RepositoryName: repositoryName,
+			BaseRef:        baseRef,
+			BaseRev:        string(baseRev),
+			Edits:          edits,
+			Diff:           diff.String(),
+		})
+	}
+
+	return nil
+}
+
+type renameConflict struct {
+	rng    shared.Range
+	reason string
+}
+
+// renameEditsForFile returns the sorted, deduplicated ranges of the given file that can be
+// replaced by the new name of the symbol, along with the ranges that cannot.
+func renameEditsForFile(content string, ranges []shared.Range, oldName string) (edits []shared.Range, conflicts []renameConflict) {
+	sort.Slice(ranges, func(i, j int) bool {
+		return compareRanges(ranges[i], ranges[j])
+	})
+
+	lines := strings.Split(content, "\n")
+	for i, rng := range ranges {
+		if i > 0 && rng == ranges[i-1] {
+			// Definitions are commonly returned as references as well
+			continue
+		}
+		if rng.Start.Line != rng.End.Line {
+			conflicts = append(conflicts, renameConflict{rng, RenameConflictReasonMultiline})
+			continue
+		}
+		if text, ok := textInRange(lines, rng); !ok || text != oldName {
+			conflicts = append(conflicts, renameConflict{rng, RenameConflictReasonUnexpectedText})
+			continue
+		}
+		if n := len(edits); n > 0 && rangesOverlap(edits[n-1], rng) {
+			conflicts = append(conflicts, renameConflict{rng, RenameConflictReasonOverlapping})
+			continue
+		}
+
+		edits = append(edits, rng)
+	}
+
+	return edits, conflicts
+}
+
+// textInRange returns the text of the given single-line range. Character offsets are
+// interpreted as offsets in Unicode code points.
+func textInRange(lines []string, rng shared.Range) (string, bool) {
+	if rng.Start.Line < 0 || rng.Start.Line >= len(lines) {
+		return "", false
+	}
+
+	line := []rune(lines[rng.Start.Line])
+	if rng.Start.Character < 0 || rng.Start.Character > rng.End.Character || rng.End.Character > len(line) {
+		return "", false
+	}
+
+	return string(line[rng.Start.Character:rng.End.Character]), true
+}
+
+// renamableSymbolName returns the name of the given symbol as it appears in source. Only
+// global symbols can be renamed across indexes.
+func renamableSymbolName(symbolName string) (string, error) {
+	if scip.IsLocalSymbol(symbolName) {
+		return "", errors.New("local symbols cannot be renamed across repositories")
+	}
+
+	symbol, err := scip.ParseSymbol(symbolName)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid symbol")
+	}
+	if symbol.Package == nil || len(symbol.Descriptors) == 0 {
+		return "", errors.Newf("symbol %q has no package or descriptors", symbolName)
+	}
+
+	name := symbol.Descriptors[len(symbol.Descriptors)-1].Name
+	if !isIdentifier(name) {
+		return "", errors.Newf("symbol %q does not have a renamable name", symbolName)
+	}
+
+	return name, nil
+}
+
+// isIdentifier returns true if the given name consists of letters, digits, underscores,
+// and dollar signs and does not begin with a digit.
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		if !unicode.IsLetter(r) && r != '_' && r != '$' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func compareRanges(a, b shared.Range) bool {
+	if a.Start.Line != b.Start.Line {
+		return a.Start.Line < b.Start.Line
+	}
+	if a.Start.Character != b.Start.Character {
+		return a.Start.Character < b.Start.Character
+	}
+	if a.End.Line != b.End.Line {
+		return a.End.Line < b.End.Line
+	}
+	return a.End.Character < b.End.Character
+}
+
+// rangesOverlap returns true if the given single-line ranges overlap. The first range must
+// not start after the second.
+func rangesOverlap(a, b shared.Range) bool {
+	return a.End.Line == b.Start.Line && a.End.Character > b.Start.Character
+}
+
+func compareRenameConflicts(a, b RenameConflict) bool {
+	if a.RepositoryName != b.RepositoryName {
+		return a.RepositoryName < b.RepositoryName
+	}
+	if a.Path != b.Path {
+		return a.Path < b.Path
+	}
+	return compareRanges(a.Range, b.Range)
+}
+
+// renameDiffContextLines is the number of unchanged lines surrounding each hunk of a rename diff.
+const renameDiffContextLines = 3
+
+// renameFileDiff returns a unified diff replacing the given ranges of the file with the new name
+// of the symbol. Each range must cover a single line, and ranges must be sorted and disjoint. As
+// the replacements never add or remove lines, each hunk has the same number of lines on both
+// sides.
+func renameFileDiff(path, content string, edits []shared.Range, newName string) string {
+	lines := strings.Split(content, "\n")
+	hasTrailingNewline := strings.HasSuffix(content, "\n")
+	if hasTrailingNewline {
+		lines = lines[:len(lines)-1]
+	}
+
+	editsByLine := map[int][]shared.Range{}
+	var changedLines []int
+	for _, edit := range edits {
+		if _, ok := editsByLine[edit.Start.Line]; !ok {
+			changedLines = append(changedLines, edit.Start.Line)
+		}
+		editsByLine[edit.Start.Line] = append(editsByLine[edit.Start.Line], edit)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "diff --git a/%s b/%s\n", path, path)
+	fmt.Fprintf(&b, "--- a/%s\n", path)
+	fmt.Fprintf(&b, "+++ b/%s\n", path)
+
+	writeLine := func(prefix string, line int, text string) {
+		b.WriteString(prefix)
+		b.WriteString(text)
+		b.WriteString("\n")
+		if line == len(lines)-1 && !hasTrailingNewline {
+			b.WriteString("\\ No newline at end of file\n")
+		}
+	}
+
+	for i := 0; i < len(changedLines); {
+		// Extend the hunk while the context of the next changed line overlaps this one
+		j := i + 1
+		for j < len(changedLines) && changedLines[j]-changedLines[j-1] <= 2*renameDiffContextLines {
+			j++
+		}
+
+		start := max(changedLines[i]-renameDiffContextLines, 0)
+		end := min(changedLines[j-1]+renameDiffContextLines+1, len(lines))
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", start+1, end-start, start+1, end-start)
+
+		for line := start; line < end; line++ {
+			lineEdits, ok := editsByLine[line]
+			if !ok {
+				writeLine(" ", line, lines[line])
+				continue
+			}
+
+			writeLine("-", line, lines[line])
+			writeLine("+", line, replaceRanges(lines[line], lineEdits, newName))
+		}
+
+		i = j
+	}
+
+	return b.String()
+}
+
+// replaceRanges replaces the given sorted and disjoint ranges of the line with the given text.
+func replaceRanges(line string, ranges []shared.Range, text string) string {
+	runes := []rune(line)
+
+	var b strings.Builder
+	offset := 0
+	for _, rng := range ranges {
+		b.WriteString(string(runes[offset:rng.Start.Character]))
+		b.WriteString(text)
+		offset = rng.End.Character
+	}
+	b.WriteString(string(runes[offset:]))
+
+	return b.String()
+}
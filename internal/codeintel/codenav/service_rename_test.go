@@ -0,0 +1,265 @@
+package codenav
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/go-diff/diff"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	sgtypes "github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestPreviewRename(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockRepoStore.GetFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*sgtypes.Repo, error) {
+		return &sgtypes.Repo{ID: id, Name: api.RepoName(map[api.RepoID]string{42: "r42", 43: "r43"}[id])}, nil
+	})
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+	hunkCache, _ := NewHunkCache(50)
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	// Set up request state
+	mockRequestState := RequestState{}
+	mockRequestState.SetLocalCommitCache(mockRepoStore, mockGitserverClient)
+	mockRequestState.SetLocalGitTreeTranslator(mockGitserverClient, &sgtypes.Repo{ID: 42}, mockCommit, mockPath, hunkCache)
+	mockRequestState.GitTreeTranslator = mockedGitTreeTranslator()
+	mockRequestState.SetUploadsDataLoader(nil)
+	mockRequestState.SetMaximumIndexesPerMonikerSearch(50)
+
+	definingUpload := uploadsshared.Dump{ID: 150, RepositoryID: 42, RepositoryName: "r42", Commit: mockCommit}
+	referencingUpload := uploadsshared.Dump{ID: 151, RepositoryID: 43, RepositoryName: "r43", Commit: "cafebabe"}
+	mockUploadSvc.GetDumpsWithDefinitionsForMonikersFunc.SetDefaultReturn([]uploadsshared.Dump{definingUpload}, nil)
+	mockUploadSvc.GetUploadIDsWithReferencesFunc.PushReturn([]int{151}, 1, 1, nil)
+	mockUploadSvc.GetDumpsByIDsFunc.SetDefaultReturn([]uploadsshared.Dump{referencingUpload}, nil)
+	mockGitserverClient.CommitsExistFunc.SetDefaultHook(func(_ context.Context, rcs []api.RepoCommit) (exists []bool, _ error) {
+		for range rcs {
+			exists = append(exists, true)
+		}
+		return exists, nil
+	})
+
+	singleLine := func(line, start, end int) shared.Range {
+		return shared.Range{Start: shared.Position{Line: line, Character: start}, End: shared.Position{Line: line, Character: end}}
+	}
+	mockLsifStore.GetMinimalBulkMonikerLocationsFunc.SetDefaultHook(func(_ context.Context, tableName string, uploadIDs []int, _ map[int]string, _ []precise.MonikerData, _, _ int) ([]shared.Location, int, error) {
+		var locations []shared.Location
+		switch {
+		case tableName == "definitions" && uploadIDs[0] == 150:
+			locations = []shared.Location{
+				{DumpID: 150, Path: "a.go", Range: singleLine(0, 5, 12)},
+			}
+		case tableName == "references" && uploadIDs[0] == 150:
+			locations = []shared.Location{
+				{DumpID: 150, Path: "a.go", Range: singleLine(0, 5, 12)}, // duplicate of definition
+				{DumpID: 150, Path: "a.go", Range: singleLine(1, 8, 15)},
+				{DumpID: 150, Path: "a.go", Range: singleLine(2, 4, 11)}, // unexpected text
+			}
+		case tableName == "references" && uploadIDs[0] == 151:
+			locations = []shared.Location{
+				{DumpID: 151, Path: "b.go", Range: singleLine(2, 5, 12)},
+				{DumpID: 151, Path: "b.go", Range: singleLine(3, 5, 12)}, // modified since indexing
+				{DumpID: 151, Path: "c.go", Range: shared.Range{Start: shared.Position{Line: 0, Character: 3}, End: shared.Position{Line: 1, Character: 2}}},
+			}
+		}
+
+		return locations, len(locations), nil
+	})
+
+	mockGitserverClient.GetDefaultBranchFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, _ bool) (string, api.CommitID, error) {
+		if repo == "r42" {
+			return "refs/heads/main", api.CommitID(mockCommit), nil
+		}
+		return "refs/heads/master", "feedface", nil
+	})
+	mockGitserverClient.DiffPathFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, sourceCommit, targetCommit, path string) ([]*diff.Hunk, error) {
+		if sourceCommit != "cafebabe" || targetCommit != "feedface" || path != "b.go" {
+			return nil, nil
+		}
+
+		return []*diff.Hunk{
+			{
+				OrigStartLine: 1,
+				OrigLines:     4,
+				NewStartLine:  1,
+				NewLines:      5,
+				Body:          []byte("+// Package b\n package b\n \n x := padLeft(y)\n-z := padLeft(w)\n+z := padLeft(w, 1)\n"),
+			},
+		}, nil
+	})
+	mockGitserverClient.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, _ api.CommitID, path string) ([]byte, error) {
+		return []byte(map[string]string{
+			"a.go": "func padLeft(s string) string {\n\treturn padLeft(s)\n\t// padRight\n}\n",
+			"b.go": "// Package b\npackage b\n\nx := padLeft(y)\nz := padLeft(w, 1)",
+			"c.go": "x(padLeft\n)\n",
+		}[path]), nil
+	})
+
+	mockRequest := RequestArgs{RepositoryID: 42, Commit: mockCommit}
+	preview, err := svc.PreviewRename(context.Background(), mockRequest, mockRequestState, "tsc npm leftpad 0.1.0 padLeft().", "leftPad")
+	if err != nil {
+		t.Fatalf("unexpected error previewing rename: %s", err)
+	}
+
+	expectedPreview := RenamePreview{
+		OldName: "padLeft",
+		NewName: "leftPad",
+		Repositories: []RenameRepositoryPreview{
+			{
+				RepositoryID:   42,
+				RepositoryName: "r42",
+				BaseRef:        "refs/heads/main",
+				BaseRev:        mockCommit,
+				Edits: []RenameEdit{
+					{Path: "a.go", Range: singleLine(0, 5, 12)},
+					{Path: "a.go", Range: singleLine(1, 8, 15)},
+				},
+				Diff: "" +
+					"diff --git a/a.go b/a.go\n" +
+					"--- a/a.go\n" +
+					"+++ b/a.go\n" +
+					"@@ -1,4 +1,4 @@\n" +
+					"-func padLeft(s string) string {\n" +
+					"+func leftPad(s string) string {\n" +
+					"-\treturn padLeft(s)\n" +
+					"+\treturn leftPad(s)\n" +
+					" \t// padRight\n" +
+					" }\n",
+			},
+			{
+				RepositoryID:   43,
+				RepositoryName: "r43",
+				BaseRef:        "refs/heads/master",
+				BaseRev:        "feedface",
+				Edits: []RenameEdit{
+					{Path: "b.go", Range: singleLine(3, 5, 12)},
+				},
+				Diff: "" +
+					"diff --git a/b.go b/b.go\n" +
+					"--- a/b.go\n" +
+					"+++ b/b.go\n" +
+					"@@ -1,5 +1,5 @@\n" +
+					" // Package b\n" +
+					" package b\n" +
+					" \n" +
+					"-x := padLeft(y)\n" +
+					"+x := leftPad(y)\n" +
+					" z := padLeft(w, 1)\n" +
+					"\\ No newline at end of file\n",
+			},
+		},
+		Conflicts: []RenameConflict{
+			{RepositoryID: 42, RepositoryName: "r42", Path: "a.go", Range: singleLine(2, 4, 11), Reason: RenameConflictReasonUnexpectedText},
+			{RepositoryID: 43, RepositoryName: "r43", Path: "b.go", Range: singleLine(3, 5, 12), Reason: RenameConflictReasonModified},
+			{RepositoryID: 43, RepositoryName: "r43", Path: "c.go", Range: shared.Range{Start: shared.Position{Line: 0, Character: 3}, End: shared.Position{Line: 1, Character: 2}}, Reason: RenameConflictReasonMultiline},
+		},
+		StaleUploads: []RenameStaleUpload{
+			{UploadID: 151, RepositoryID: 43, RepositoryName: "r43", Commit: "cafebabe", BaseRev: "feedface"},
+		},
+	}
+	if diff := cmp.Diff(expectedPreview, preview); diff != "" {
+		t.Errorf("unexpected preview (-want +got):\n%s", diff)
+	}
+}
+
+func TestPreviewRenameInvalidArguments(t *testing.T) {
+	svc := newService(&observation.TestContext, defaultMockRepoStore(), NewMockLsifStore(), NewMockUploadService(), gitserver.NewMockClient())
+
+	for _, testCase := range []struct {
+		name       string
+		symbolName string
+		newName    string
+	}{
+		{"local symbol", "local 42", "x"},
+		{"malformed symbol", "tsc npm", "x"},
+		{"invalid identifier", "tsc npm leftpad 0.1.0 padLeft().", "left pad"},
+		{"leading digit", "tsc npm leftpad 0.1.0 padLeft().", "2pad"},
+		{"unchanged name", "tsc npm leftpad 0.1.0 padLeft().", "padLeft"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			if _, err := svc.PreviewRename(context.Background(), RequestArgs{}, RequestState{}, testCase.symbolName, testCase.newName); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+func TestRenameFileDiff(t *testing.T) {
+	content := "a\nfoo\nb\nc\nd\ne\nf\ng\nh\ni\nfoo foo\n"
+	edits := []shared.Range{
+		{Start: shared.Position{Line: 1, Character: 0}, End: shared.Position{Line: 1, Character: 3}},
+		{Start: shared.Position{Line: 10, Character: 0}, End: shared.Position{Line: 10, Character: 3}},
+		{Start: shared.Position{Line: 10, Character: 4}, End: shared.Position{Line: 10, Character: 7}},
+	}
+
+	expectedDiff := "" +
+		"diff --git a/x.txt b/x.txt\n" +
+		"--- a/x.txt\n" +
+		"+++ b/x.txt\n" +
+		"@@ -1,5 +1,5 @@\n" +
+		" a\n" +
+		"-foo\n" +
+		"+bar\n" +
+		" b\n" +
+		" c\n" +
+		" d\n" +
+		"@@ -8,4 +8,4 @@\n" +
+		" g\n" +
+		" h\n" +
+		" i\n" +
+		"-foo foo\n" +
+		"+bar bar\n"
+	if diff := cmp.Diff(expectedDiff, renameFileDiff("x.txt", content, edits, "bar")); diff != "" {
+		t.Errorf("unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRenameChangesetSpecs(t *testing.T) {
+	preview := RenamePreview{
+		OldName: "padLeft",
+		NewName: "leftPad",
+		Repositories: []RenameRepositoryPreview{
+			{RepositoryID: 42, RepositoryName: "r42", BaseRef: "refs/heads/main", BaseRev: "deadbeef", Diff: "diff --git a/a.go b/a.go\n"},
+		},
+	}
+
+	specs := RenameChangesetSpecs(preview, DefaultRenameChangesetOptions(preview))
+	if len(specs) != 1 {
+		t.Fatalf("unexpected number of changeset specs. want=%d have=%d", 1, len(specs))
+	}
+
+	rawSpec, err := json.Marshal(specs[0])
+	if err != nil {
+		t.Fatalf("unexpected error marshalling changeset spec: %s", err)
+	}
+	spec, err := batcheslib.ParseChangesetSpec(rawSpec)
+	if err != nil {
+		t.Fatalf("unexpected error parsing changeset spec: %s", err)
+	}
+
+	if spec.HeadRef != "refs/heads/rename-padLeft-to-leftPad" {
+		t.Errorf("unexpected head ref %q", spec.HeadRef)
+	}
+	if spec.BaseRepository != spec.HeadRepository {
+		t.Errorf("unexpected head repository %q", spec.HeadRepository)
+	}
+	if diff := string(spec.Commits[0].Diff); diff != preview.Repositories[0].Diff {
+		t.Errorf("unexpected diff %q", diff)
+	}
+	if !spec.Published.False() {
+		t.Errorf("expected changeset spec to be unpublished")
+	}
+}
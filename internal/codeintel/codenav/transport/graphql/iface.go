@@ -20,6 +20,7 @@ type CodeNavService interface {
 	GetClosestDumpsForBlob(ctx context.Context, repositoryID int, commit, path string, exactPath bool, indexer string) (_ []uploadsshared.Dump, err error)
 	VisibleUploadsForPath(ctx context.Context, requestState codenav.RequestState) ([]uploadsshared.Dump, error)
 	SnapshotForDocument(ctx context.Context, repositoryID int, commit, path string, uploadID int) (data []shared.SnapshotData, err error)
+	PreviewRename(ctx context.Context, args codenav.RequestArgs, requestState codenav.RequestState, symbolName, newName string) (_ codenav.RenamePreview, err error)
 }
 
 type AutoIndexingService interface {
@@ -205,6 +205,9 @@ type MockCodeNavService struct {
 	// GetStencilFunc is an instance of a mock function object controlling
 	// the behavior of the method GetStencil.
 	GetStencilFunc *CodeNavServiceGetStencilFunc
+	// PreviewRenameFunc is an instance of a mock function object
+	// controlling the behavior of the method PreviewRename.
+	PreviewRenameFunc *CodeNavServicePreviewRenameFunc
 	// SnapshotForDocumentFunc is an instance of a mock function object
 	// controlling the behavior of the method SnapshotForDocument.
 	SnapshotForDocumentFunc *CodeNavServiceSnapshotForDocumentFunc
@@ -262,6 +265,11 @@ func NewMockCodeNavService() *MockCodeNavService {
 				return
 			},
 		},
+		PreviewRenameFunc: &CodeNavServicePreviewRenameFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (r0 codenav.RenamePreview, r1 error) {
+				return
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) (r0 []shared1.SnapshotData, r1 error) {
 				return
@@ -324,6 +332,11 @@ func NewStrictMockCodeNavService() *MockCodeNavService {
 				panic("unexpected invocation of MockCodeNavService.GetStencil")
 			},
 		},
+		PreviewRenameFunc: &CodeNavServicePreviewRenameFunc{
+			defaultHook: func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error) {
+				panic("unexpected invocation of MockCodeNavService.PreviewRename")
+			},
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: func(context.Context, int, string, string, int) ([]shared1.SnapshotData, error) {
 				panic("unexpected invocation of MockCodeNavService.SnapshotForDocument")
@@ -369,6 +382,9 @@ func NewMockCodeNavServiceFrom(i CodeNavService) *MockCodeNavService {
 		GetStencilFunc: &CodeNavServiceGetStencilFunc{
 			defaultHook: i.GetStencil,
 		},
+		PreviewRenameFunc: &CodeNavServicePreviewRenameFunc{
+			defaultHook: i.PreviewRename,
+		},
 		SnapshotForDocumentFunc: &CodeNavServiceSnapshotForDocumentFunc{
 			defaultHook: i.SnapshotForDocument,
 		},
@@ -1430,6 +1446,124 @@ func (c CodeNavServiceGetStencilFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeNavServicePreviewRenameFunc describes the behavior when the
+// PreviewRename method of the parent MockCodeNavService instance is
+// invoked.
+type CodeNavServicePreviewRenameFunc struct {
+	defaultHook func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error)
+	hooks       []func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error)
+	history     []CodeNavServicePreviewRenameFuncCall
+	mutex       sync.Mutex
+}
+
+// PreviewRename delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeNavService) PreviewRename(v0 context.Context, v1 codenav.RequestArgs, v2 codenav.RequestState, v3 string, v4 string) (codenav.RenamePreview, error) {
+	r0, r1 := m.PreviewRenameFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.PreviewRenameFunc.appendCall(CodeNavServicePreviewRenameFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the PreviewRename method
+// of the parent MockCodeNavService instance is invoked and the hook queue
+// is empty.
+func (f *CodeNavServicePreviewRenameFunc) SetDefaultHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PreviewRename method of the parent MockCodeNavService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeNavServicePreviewRenameFunc) PushHook(hook func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeNavServicePreviewRenameFunc) SetDefaultReturn(r0 codenav.RenamePreview, r1 error) {
+	f.SetDefaultHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeNavServicePreviewRenameFunc) PushReturn(r0 codenav.RenamePreview, r1 error) {
+	f.PushHook(func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeNavServicePreviewRenameFunc) nextHook() func(context.Context, codenav.RequestArgs, codenav.RequestState, string, string) (codenav.RenamePreview, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeNavServicePreviewRenameFunc) appendCall(r0 CodeNavServicePreviewRenameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeNavServicePreviewRenameFuncCall objects
+// describing the invocations of this function.
+func (f *CodeNavServicePreviewRenameFunc) History() []CodeNavServicePreviewRenameFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeNavServicePreviewRenameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeNavServicePreviewRenameFuncCall is an object that describes an
+// invocation of method PreviewRename on an instance of MockCodeNavService.
+type CodeNavServicePreviewRenameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 codenav.RequestArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 codenav.RequestState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 codenav.RenamePreview
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeNavServicePreviewRenameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeNavServicePreviewRenameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeNavServiceSnapshotForDocumentFunc describes the behavior when the
 // SnapshotForDocument method of the parent MockCodeNavService instance is
 // invoked.
@@ -25,6 +25,7 @@ type operations struct {
 	ranges          *observation.Operation
 	snapshot        *observation.Operation
 	visibleIndexes  *observation.Operation
+	renamePreview   *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -55,6 +56,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		ranges:          op("Ranges"),
 		snapshot:        op("Snapshot"),
 		visibleIndexes:  op("VisibleIndexes"),
+		renamePreview:   op("RenamePreview"),
 	}
 }
 
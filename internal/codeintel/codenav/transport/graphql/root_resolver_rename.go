@@ -0,0 +1,168 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// RenamePreview returns the edits required to rename the given symbol across all repositories
+// with precise data defining or referencing it.
+func (r *gitBlobLSIFDataResolver) RenamePreview(ctx context.Context, args *resolverstubs.RenamePreviewArgs) (_ resolverstubs.SymbolRenamePreviewResolver, err error) {
+	requestArgs := codenav.RequestArgs{
+		RepositoryID: r.requestState.RepositoryID,
+		Commit:       r.requestState.Commit,
+	}
+	ctx, _, endObservation := r.operations.renamePreview.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", requestArgs.RepositoryID),
+		attribute.String("commit", requestArgs.Commit),
+		attribute.String("symbol", args.Symbol),
+		attribute.String("newName", args.NewName),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	preview, err := r.codeNavSvc.PreviewRename(ctx, requestArgs, r.requestState, args.Symbol, args.NewName)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.PreviewRename")
+	}
+
+	opts := codenav.DefaultRenameChangesetOptions(preview)
+	if args.Branch != nil && *args.Branch != "" {
+		opts.Branch = *args.Branch
+	}
+
+	return &symbolRenamePreviewResolver{
+		preview:          preview,
+		changesetOptions: opts,
+		locationResolver: r.locationResolver,
+	}, nil
+}
+
+type symbolRenamePreviewResolver struct {
+	preview          codenav.RenamePreview
+	changesetOptions codenav.RenameChangesetOptions
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *symbolRenamePreviewResolver) Repositories() []resolverstubs.SymbolRenameRepositoryPreviewResolver {
+	resolvers := make([]resolverstubs.SymbolRenameRepositoryPreviewResolver, 0, len(r.preview.Repositories))
+	for _, repository := range r.preview.Repositories {
+		resolvers = append(resolvers, &symbolRenameRepositoryPreviewResolver{
+			repository:       repository,
+			locationResolver: r.locationResolver,
+		})
+	}
+
+	return resolvers
+}
+
+func (r *symbolRenamePreviewResolver) Conflicts() []resolverstubs.SymbolRenameConflictResolver {
+	resolvers := make([]resolverstubs.SymbolRenameConflictResolver, 0, len(r.preview.Conflicts))
+	for _, conflict := range r.preview.Conflicts {
+		resolvers = append(resolvers, &symbolRenameConflictResolver{
+			conflict:         conflict,
+			locationResolver: r.locationResolver,
+		})
+	}
+
+	return resolvers
+}
+
+func (r *symbolRenamePreviewResolver) StaleIndexes() []resolverstubs.SymbolRenameStaleIndexResolver {
+	resolvers := make([]resolverstubs.SymbolRenameStaleIndexResolver, 0, len(r.preview.StaleUploads))
+	for _, upload := range r.preview.StaleUploads {
+		resolvers = append(resolvers, &symbolRenameStaleIndexResolver{
+			upload:           upload,
+			locationResolver: r.locationResolver,
+		})
+	}
+
+	return resolvers
+}
+
+func (r *symbolRenamePreviewResolver) ChangesetSpecs() ([]string, error) {
+	specs := codenav.RenameChangesetSpecs(r.preview, r.changesetOptions)
+
+	rawSpecs := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		rawSpec, err := json.Marshal(spec)
+		if err != nil {
+			return nil, err
+		}
+		rawSpecs = append(rawSpecs, string(rawSpec))
+	}
+
+	return rawSpecs, nil
+}
+
+type symbolRenameRepositoryPreviewResolver struct {
+	repository       codenav.RenameRepositoryPreview
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *symbolRenameRepositoryPreviewResolver) Repository(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	return r.locationResolver.Repository(ctx, api.RepoID(r.repository.RepositoryID))
+}
+
+func (r *symbolRenameRepositoryPreviewResolver) BaseRef() string { return r.repository.BaseRef }
+func (r *symbolRenameRepositoryPreviewResolver) BaseRev() string { return r.repository.BaseRev }
+func (r *symbolRenameRepositoryPreviewResolver) Diff() string    { return r.repository.Diff }
+
+func (r *symbolRenameRepositoryPreviewResolver) Edits() []resolverstubs.SymbolRenameEditResolver {
+	resolvers := make([]resolverstubs.SymbolRenameEditResolver, 0, len(r.repository.Edits))
+	for _, edit := range r.repository.Edits {
+		resolvers = append(resolvers, &symbolRenameEditResolver{edit: edit})
+	}
+
+	return resolvers
+}
+
+type symbolRenameEditResolver struct {
+	edit codenav.RenameEdit
+}
+
+func (r *symbolRenameEditResolver) Path() string { return r.edit.Path }
+func (r *symbolRenameEditResolver) Range() resolverstubs.RangeResolver {
+	return newRangeResolver(convertRange(r.edit.Range))
+}
+
+type symbolRenameConflictResolver struct {
+	conflict         codenav.RenameConflict
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *symbolRenameConflictResolver) Repository(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	return r.locationResolver.Repository(ctx, api.RepoID(r.conflict.RepositoryID))
+}
+
+func (r *symbolRenameConflictResolver) Path() string   { return r.conflict.Path }
+func (r *symbolRenameConflictResolver) Reason() string { return r.conflict.Reason }
+func (r *symbolRenameConflictResolver) Range() resolverstubs.RangeResolver {
+	return newRangeResolver(convertRange(r.conflict.Range))
+}
+
+type symbolRenameStaleIndexResolver struct {
+	upload           codenav.RenameStaleUpload
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *symbolRenameStaleIndexResolver) IndexID() graphql.ID {
+	return resolverstubs.MarshalID("PreciseIndex", fmt.Sprintf("U:%d", r.upload.UploadID))
+}
+
+func (r *symbolRenameStaleIndexResolver) Repository(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	return r.locationResolver.Repository(ctx, api.RepoID(r.upload.RepositoryID))
+}
+
+func (r *symbolRenameStaleIndexResolver) Commit() string  { return r.upload.Commit }
+func (r *symbolRenameStaleIndexResolver) BaseRev() string { return r.upload.BaseRev }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 	"testing"
 
 	mockrequire "github.com/derision-test/go-mockgen/testutil/require"
@@ -351,6 +352,58 @@ func TestDiagnosticsDefaultIllegalLimit(t *testing.T) {
 	}
 }
 
+func TestRenamePreview(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockCodeNavService.PreviewRenameFunc.SetDefaultReturn(codenav.RenamePreview{
+		OldName: "padLeft",
+		NewName: "leftPad",
+		Repositories: []codenav.RenameRepositoryPreview{
+			{RepositoryID: 42, BaseRef: "refs/heads/main", BaseRev: "deadbeef", Diff: "diff --git a/a.go b/a.go\n"},
+		},
+	}, nil)
+	mockRequestState := codenav.RequestState{
+		RepositoryID: 1,
+		Commit:       "deadbeef1",
+		Path:         "/src/main",
+	}
+	mockOperations := newOperations(&observation.TestContext)
+
+	resolver := newGitBlobLSIFDataResolver(
+		mockCodeNavService,
+		nil,
+		mockRequestState,
+		nil,
+		nil,
+		nil,
+		mockOperations,
+	)
+
+	branch := "my-rename"
+	args := &resolverstubs.RenamePreviewArgs{Symbol: "tsc npm leftpad 0.1.0 padLeft().", NewName: "leftPad", Branch: &branch}
+	preview, err := resolver.RenamePreview(context.Background(), args)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockCodeNavService.PreviewRenameFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockCodeNavService.PreviewRenameFunc.History()))
+	}
+	if val := mockCodeNavService.PreviewRenameFunc.History()[0].Arg1; val.RepositoryID != 1 || val.Commit != "deadbeef1" {
+		t.Fatalf("unexpected request args. have=%v", val)
+	}
+
+	specs, err := preview.ChangesetSpecs()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(specs) != 1 {
+		t.Fatalf("unexpected number of changeset specs. want=%d have=%d", 1, len(specs))
+	}
+	if !strings.Contains(specs[0], `"headRef":"refs/heads/my-rename"`) {
+		t.Fatalf("unexpected changeset spec. have=%s", specs[0])
+	}
+}
+
 func TestResolveLocations(t *testing.T) {
 	repos := dbmocks.NewStrictMockRepoStore()
 	repos.GetFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*sgtypes.Repo, error) {
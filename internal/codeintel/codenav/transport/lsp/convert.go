@@ -0,0 +1,148 @@
+package lsp
+
+import (
+	"github.com/sourcegraph/go-lsp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+)
+
+func convertRange(r shared.Range) lsp.Range {
+	return lsp.Range{
+		Start: lsp.Position{Line: r.Start.Line, Character: r.Start.Character},
+		End:   lsp.Position{Line: r.End.Line, Character: r.End.Character},
+	}
+}
+
+// convertLocations converts the given adjusted locations into LSP locations. Each location
+// is addressed by a git:// URI at the commit the location was adjusted to, which may belong
+// to a repository other than the one of the requested document.
+func convertLocations(locations []shared.UploadLocation) []lsp.Location {
+	lspLocations := make([]lsp.Location, 0, len(locations))
+	for _, location := range locations {
+		uri := documentURI{
+			Repo: api.RepoName(location.Dump.RepositoryName),
+			Rev:  location.TargetCommit,
+			Path: location.Path,
+		}
+
+		lspLocations = append(lspLocations, lsp.Location{
+			URI:   lsp.DocumentURI(uri.String()),
+			Range: convertRange(location.TargetRange),
+		})
+	}
+
+	return lspLocations
+}
+
+// convertDocumentSymbols converts the given symbols defined in the document identified by
+// the given URI into LSP symbol information.
+func convertDocumentSymbols(uri lsp.DocumentURI, symbols []codenav.DocumentSymbol) []lsp.SymbolInformation {
+	lspSymbols := make([]lsp.SymbolInformation, 0, len(symbols))
+	for _, symbol := range symbols {
+		name, containerName, kind := describeSymbol(symbol)
+
+		lspSymbols = append(lspSymbols, lsp.SymbolInformation{
+			Name:          name,
+			Kind:          kind,
+			ContainerName: containerName,
+			Location: lsp.Location{
+				URI:   uri,
+				Range: convertRange(symbol.Range),
+			},
+		})
+	}
+
+	return lspSymbols
+}
+
+// describeSymbol returns the display name, the name of the enclosing symbol, and the kind
+// of the given symbol. Indexers are not required to emit symbol information, so names and
+// kinds are derived from the descriptors of the symbol when missing.
+func describeSymbol(symbol codenav.DocumentSymbol) (name, containerName string, kind lsp.SymbolKind) {
+	var descriptors []*scip.Descriptor
+	if parsed, err := scip.ParseSymbol(symbol.Symbol); err == nil {
+		descriptors = parsed.Descriptors
+	}
+
+	name = symbol.DisplayName
+	if name == "" && len(descriptors) > 0 {
+		name = descriptors[len(descriptors)-1].Name
+	}
+	if name == "" {
+		name = symbol.Symbol
+	}
+	if len(descriptors) > 1 {
+		containerName = descriptors[len(descriptors)-2].Name
+	}
+
+	kind = convertSymbolKind(symbol.Kind)
+	if kind == 0 && len(descriptors) > 0 {
+		kind = convertDescriptorSuffix(descriptors[len(descriptors)-1].Suffix)
+	}
+	if kind == 0 {
+		kind = lsp.SKVariable
+	}
+
+	return name, containerName, kind
+}
+
+var symbolKinds = map[scip.SymbolInformation_Kind]lsp.SymbolKind{
+	scip.SymbolInformation_Class:         lsp.SKClass,
+	scip.SymbolInformation_Constant:      lsp.SKConstant,
+	scip.SymbolInformation_Constructor:   lsp.SKConstructor,
+	scip.SymbolInformation_Enum:          lsp.SKEnum,
+	scip.SymbolInformation_EnumMember:    lsp.SKEnumMember,
+	scip.SymbolInformation_Event:         lsp.SKEvent,
+	scip.SymbolInformation_Field:         lsp.SKField,
+	scip.SymbolInformation_File:          lsp.SKFile,
+	scip.SymbolInformation_Function:      lsp.SKFunction,
+	scip.SymbolInformation_Interface:     lsp.SKInterface,
+	scip.SymbolInformation_Method:        lsp.SKMethod,
+	scip.SymbolInformation_Module:        lsp.SKModule,
+	scip.SymbolInformation_Namespace:     lsp.SKNamespace,
+	scip.SymbolInformation_Object:        lsp.SKObject,
+	scip.SymbolInformation_Operator:      lsp.SKOperator,
+	scip.SymbolInformation_Package:       lsp.SKPackage,
+	scip.SymbolInformation_Property:      lsp.SKProperty,
+	scip.SymbolInformation_Struct:        lsp.SKStruct,
+	scip.SymbolInformation_Trait:         lsp.SKInterface,
+	scip.SymbolInformation_Type:          lsp.SKClass,
+	scip.SymbolInformation_TypeAlias:     lsp.SKClass,
+	scip.SymbolInformation_TypeParameter: lsp.SKTypeParameter,
+	scip.SymbolInformation_Variable:      lsp.SKVariable,
+	scip.SymbolInformation_Getter:        lsp.SKMethod,
+	scip.SymbolInformation_Setter:        lsp.SKMethod,
+	scip.SymbolInformation_Macro:         lsp.SKFunction,
+	scip.SymbolInformation_Parameter:     lsp.SKVariable,
+	scip.SymbolInformation_Protocol:      lsp.SKInterface,
+	scip.SymbolInformation_TypeClass:     lsp.SKInterface,
+	scip.SymbolInformation_Union:         lsp.SKStruct,
+}
+
+func convertSymbolKind(kind scip.SymbolInformation_Kind) lsp.SymbolKind {
+	return symbolKinds[kind]
+}
+
+func convertDescriptorSuffix(suffix scip.Descriptor_Suffix) lsp.SymbolKind {
+	switch suffix {
+	case scip.Descriptor_Namespace:
+		return lsp.SKNamespace
+	case scip.Descriptor_Type:
+		return lsp.SKClass
+	case scip.Descriptor_Method:
+		return lsp.SKMethod
+	case scip.Descriptor_Term:
+		return lsp.SKVariable
+	case scip.Descriptor_Macro:
+		return lsp.SKFunction
+	case scip.Descriptor_TypeParameter:
+		return lsp.SKTypeParameter
+	case scip.Descriptor_Parameter:
+		return lsp.SKVariable
+	}
+
+	return 0
+}
@@ -0,0 +1,101 @@
+package lsp
+
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type handler struct {
+	svc                            CodeNavService
+	repoStore                      database.RepoStore
+	gitserverClient                gitserver.Client
+	authChecker                    authz.SubRepoPermissionChecker
+	hunkCache                      codenav.HunkCache
+	maximumIndexesPerMonikerSearch int
+	operations                     *operations
+	logger                         log.Logger
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+}
+
+// NewHandler returns an HTTP handler that upgrades requests to a WebSocket connection and
+// serves precise code navigation over the Language Server Protocol. Each WebSocket text
+// message carries exactly one JSON-RPC 2.0 message. Editors that can only speak LSP over
+// stdio can connect through any generic stdio-to-WebSocket bridge.
+//
+// 🚨 SECURITY: The caller must ensure that the actor is set on the request context. Repository
+// visibility is enforced by the repo store, and sub-repo permissions are checked for every
+// requested document as well as every returned location.
+func NewHandler(
+	observationCtx *observation.Context,
+	svc CodeNavService,
+	repoStore database.RepoStore,
+	gitserverClient gitserver.Client,
+	maxIndexSearch int,
+	hunkCacheSize int,
+) (http.Handler, error) {
+	hunkCache, err := codenav.NewHunkCache(hunkCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &handler{
+		svc:                            svc,
+		repoStore:                      repoStore,
+		gitserverClient:                gitserverClient,
+		authChecker:                    authz.DefaultSubRepoPermsChecker,
+		hunkCache:                      hunkCache,
+		maximumIndexesPerMonikerSearch: maxIndexSearch,
+		operations:                     newOperations(observationCtx),
+		logger:                         observationCtx.Logger,
+	}, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already replied to the client
+		h.logger.Warn("failed to upgrade LSP connection", log.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	ctx := r.Context()
+	s := newSession(h)
+
+	for {
+		messageType, payload, err := conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				h.logger.Warn("failed to read LSP message", log.Error(err))
+			}
+			return
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+
+		resp, exit := s.handleMessage(ctx, payload)
+		if resp != nil {
+			if err := conn.WriteJSON(resp); err != nil {
+				h.logger.Warn("failed to write LSP message", log.Error(err))
+				return
+			}
+		}
+		if exit {
+			_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/websocket"
+	"github.com/sourcegraph/go-lsp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestHandler(t *testing.T) {
+	mockCodeNavService := NewMockCodeNavService()
+	mockCodeNavService.GetClosestDumpsForBlobFunc.SetDefaultReturn([]uploadsshared.Dump{{ID: 50, RepositoryID: 42, Commit: "deadbeef"}}, nil)
+	mockCodeNavService.GetDefinitionsFunc.SetDefaultReturn([]shared.UploadLocation{
+		{
+			Dump:         uploadsshared.Dump{ID: 51, RepositoryID: 43, RepositoryName: "github.com/test/lib"},
+			Path:         "lib.go",
+			TargetCommit: "cafebabe",
+			TargetRange:  shared.Range{Start: shared.Position{Line: 1, Character: 2}, End: shared.Position{Line: 1, Character: 5}},
+		},
+	}, nil)
+
+	mockRepoStore := dbmocks.NewMockRepoStore()
+	mockRepoStore.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{ID: 42, Name: name}, nil
+	})
+	mockGitserverClient := gitserver.NewMockClient()
+	mockGitserverClient.ResolveRevisionFunc.SetDefaultReturn("deadbeef", nil)
+	mockGitserverClient.ReadFileFunc.SetDefaultReturn([]byte("package main\n"), nil)
+
+	handler, err := NewHandler(&observation.TestContext, mockCodeNavService, mockRepoStore, mockGitserverClient, 50, 50)
+	if err != nil {
+		t.Fatalf("unexpected error creating handler: %s", err)
+	}
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error dialing server: %s", err)
+	}
+	defer conn.Close()
+
+	call := func(id uint64, method string, params any) response {
+		rawParams, _ := json.Marshal(params)
+		if err := conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": json.RawMessage(rawParams)}); err != nil {
+			t.Fatalf("unexpected error writing request: %s", err)
+		}
+
+		var resp response
+		if err := conn.ReadJSON(&resp); err != nil {
+			t.Fatalf("unexpected error reading response: %s", err)
+		}
+		if resp.ID == nil || resp.ID.Num != id {
+			t.Fatalf("unexpected response id. want=%d have=%v", id, resp.ID)
+		}
+		return resp
+	}
+
+	position := lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: "git://github.com/test/repo?main#cmd/main.go"},
+		Position:     lsp.Position{Line: 10, Character: 20},
+	}
+
+	// Requests before initialization are rejected
+	if resp := call(1, "textDocument/definition", position); resp.Error == nil || resp.Error.Code != codeServerNotInitialized {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	if resp := call(2, "initialize", lsp.InitializeParams{RootURI: "git://github.com/test/repo?main"}); resp.Error != nil {
+		t.Fatalf("unexpected error initializing: %s", resp.Error)
+	} else {
+		var result lsp.InitializeResult
+		if err := json.Unmarshal(resp.Result, &result); err != nil {
+			t.Fatalf("unexpected error decoding result: %s", err)
+		}
+		if !result.Capabilities.DefinitionProvider || !result.Capabilities.DocumentSymbolProvider {
+			t.Errorf("unexpected capabilities: %+v", result.Capabilities)
+		}
+	}
+
+	resp := call(3, "textDocument/definition", position)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error requesting definitions: %s", resp.Error)
+	}
+	var locations []lsp.Location
+	if err := json.Unmarshal(resp.Result, &locations); err != nil {
+		t.Fatalf("unexpected error decoding result: %s", err)
+	}
+	expectedLocations := []lsp.Location{
+		{
+			URI:   "git://github.com/test/lib?cafebabe#lib.go",
+			Range: lsp.Range{Start: lsp.Position{Line: 1, Character: 2}, End: lsp.Position{Line: 1, Character: 5}},
+		},
+	}
+	if diff := cmp.Diff(expectedLocations, locations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	history := mockCodeNavService.GetDefinitionsFunc.History()
+	if len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	}
+	expectedArgs := codenav.PositionalRequestArgs{
+		RequestArgs: codenav.RequestArgs{RepositoryID: 42, Commit: "deadbeef", Limit: locationsPageSize},
+		Path:        "cmd/main.go",
+		Line:        10,
+		Character:   20,
+	}
+	if diff := cmp.Diff(expectedArgs, history[0].Arg1); diff != "" {
+		t.Errorf("unexpected request args (-want +got):\n%s", diff)
+	}
+
+	// Revisions are resolved once per connection
+	if n := len(mockGitserverClient.ResolveRevisionFunc.History()); n != 1 {
+		t.Errorf("unexpected number of resolved revisions. want=%d have=%d", 1, n)
+	}
+
+	resp = call(4, "textDocument/xcontent", map[string]any{"textDocument": position.TextDocument})
+	if resp.Error != nil {
+		t.Fatalf("unexpected error requesting content: %s", resp.Error)
+	}
+	var item lsp.TextDocumentItem
+	if err := json.Unmarshal(resp.Result, &item); err != nil {
+		t.Fatalf("unexpected error decoding result: %s", err)
+	}
+	if item.Text != "package main\n" || item.LanguageID != "go" {
+		t.Errorf("unexpected document: %+v", item)
+	}
+
+	if resp := call(5, "textDocument/rename", position); resp.Error == nil || resp.Error.Code != codeMethodNotFound {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	if resp := call(6, "shutdown", nil); resp.Error != nil {
+		t.Fatalf("unexpected error shutting down: %s", resp.Error)
+	}
+	if err := conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "method": "exit"}); err != nil {
+		t.Fatalf("unexpected error writing notification: %s", err)
+	}
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		t.Fatalf("expected connection to be closed, got %v", err)
+	}
+}
+
+func TestGatherPages(t *testing.T) {
+	pages := [][]shared.UploadLocation{
+		make([]shared.UploadLocation, 600),
+		make([]shared.UploadLocation, 600),
+		make([]shared.UploadLocation, 600),
+	}
+
+	calls := 0
+	getPage := func(_ context.Context, _ codenav.PositionalRequestArgs, _ codenav.RequestState, _ codenav.Cursor) ([]shared.UploadLocation, codenav.Cursor, error) {
+		page := pages[calls]
+		calls++
+		return page, codenav.Cursor{Phase: "remote"}, nil
+	}
+
+	locations, err := gatherPages(context.Background(), codenav.PositionalRequestArgs{}, codenav.RequestState{}, getPage)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(locations) != maximumLocations {
+		t.Errorf("unexpected number of locations. want=%d have=%d", maximumLocations, len(locations))
+	}
+	if calls != 2 {
+		t.Errorf("unexpected number of pages requested. want=%d have=%d", 2, calls)
+	}
+}
@@ -0,0 +1,62 @@
+package lsp
+
+import (
+	"encoding/json"
+
+	"github.com/sourcegraph/go-lsp"
+)
+
+// request is a JSON-RPC 2.0 request or notification sent by the client. Notifications
+// do not carry an identifier and are never answered.
+type request struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *lsp.ID          `json:"id,omitempty"`
+	Method  string           `json:"method"`
+	Params  *json.RawMessage `json:"params,omitempty"`
+}
+
+// response is a JSON-RPC 2.0 response sent to the client. Exactly one of the result
+// or error fields is set. The identifier is null when the request could not be parsed.
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *lsp.ID         `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *responseError  `json:"error,omitempty"`
+}
+
+// responseError is a JSON-RPC 2.0 error object.
+type responseError struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *responseError) Error() string {
+	return e.Message
+}
+
+// Error codes defined by JSON-RPC 2.0 and the Language Server Protocol.
+const (
+	codeParseError           = -32700
+	codeInvalidRequest       = -32600
+	codeMethodNotFound       = -32601
+	codeInvalidParams        = -32602
+	codeInternalError        = -32603
+	codeServerNotInitialized = -32002
+	codeRequestFailed        = -32803
+)
+
+func newResponseError(code int64, message string) *responseError {
+	return &responseError{Code: code, Message: message}
+}
+
+// unmarshalParams decodes the parameters of the given request into v.
+func unmarshalParams(req *request, v any) error {
+	if req.Params == nil {
+		return newResponseError(codeInvalidParams, "missing params")
+	}
+	if err := json.Unmarshal(*req.Params, v); err != nil {
+		return newResponseError(codeInvalidParams, err.Error())
+	}
+
+	return nil
+}
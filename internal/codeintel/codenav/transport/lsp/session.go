@@ -0,0 +1,487 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/sourcegraph/go-lsp"
+	"github.com/sourcegraph/go-lsp/lspext"
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// locationsPageSize is the number of locations requested from codenav per page when
+	// resolving references and implementations.
+	locationsPageSize = 100
+
+	// maximumLocations bounds the number of reference and implementation locations returned
+	// for a single request. LSP has no notion of pagination, so this caps the amount of work
+	// done for very popular symbols.
+	maximumLocations = 1000
+)
+
+var errDocumentNotFound = newResponseError(codeRequestFailed, "document not found")
+
+// session holds the state of a single LSP connection. A session is initialized with a root
+// URI naming a repository and revision, but documents of any repository visible to the actor
+// may be queried afterwards so that clients can follow cross-repository locations.
+type session struct {
+	*handler
+
+	root         documentURI
+	initialized  bool
+	shuttingDown bool
+
+	// repos and commits cache names and revisions resolved for the lifetime of the connection.
+	// Entries are resolved with the connection's actor, so permissions are enforced on first use.
+	repos   map[api.RepoName]*types.Repo
+	commits map[documentURI]api.CommitID
+}
+
+func newSession(h *handler) *session {
+	return &session{
+		handler: h,
+		repos:   map[api.RepoName]*types.Repo{},
+		commits: map[documentURI]api.CommitID{},
+	}
+}
+
+// handleMessage handles a single JSON-RPC message and returns the response to send back to
+// the client, if any. The returned flag is true when the client has asked the server to exit.
+func (s *session) handleMessage(ctx context.Context, payload []byte) (_ *response, exit bool) {
+	var req request
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return &response{JSONRPC: "2.0", Error: newResponseError(codeParseError, err.Error())}, false
+	}
+	if req.ID == nil {
+		// Notifications (didOpen, didClose, $/cancelRequest, etc.) are not answered. Documents
+		// are always read from gitserver, so there is no client-side state to track.
+		return nil, req.Method == "exit"
+	}
+
+	resp := &response{JSONRPC: "2.0", ID: req.ID}
+
+	result, err := s.handle(ctx, &req)
+	if err != nil {
+		var rerr *responseError
+		if !errors.As(err, &rerr) {
+			s.logger.Error("failed to handle LSP request", log.String("method", req.Method), log.Error(err))
+			rerr = newResponseError(codeInternalError, err.Error())
+		}
+
+		resp.Error = rerr
+		return resp, false
+	}
+
+	if resp.Result, err = json.Marshal(result); err != nil {
+		resp.Error = newResponseError(codeInternalError, err.Error())
+	}
+
+	return resp, false
+}
+
+func (s *session) handle(ctx context.Context, req *request) (any, error) {
+	switch req.Method {
+	case "initialize":
+		return s.initialize(ctx, req)
+	case "shutdown":
+		s.shuttingDown = true
+		return nil, nil
+	}
+
+	if !s.initialized {
+		return nil, newResponseError(codeServerNotInitialized, "server not initialized")
+	}
+	if s.shuttingDown {
+		return nil, newResponseError(codeInvalidRequest, "server is shutting down")
+	}
+
+	switch req.Method {
+	case "textDocument/hover":
+		return s.hover(ctx, req)
+	case "textDocument/definition":
+		return s.definition(ctx, req)
+	case "textDocument/references":
+		return s.references(ctx, req)
+	case "textDocument/implementation":
+		return s.implementation(ctx, req)
+	case "textDocument/documentSymbol":
+		return s.documentSymbol(ctx, req)
+	case "textDocument/xcontent":
+		return s.documentContent(ctx, req)
+	}
+
+	return nil, newResponseError(codeMethodNotFound, "method not supported: "+req.Method)
+}
+
+func (s *session) initialize(ctx context.Context, req *request) (_ *lsp.InitializeResult, err error) {
+	var params lsp.InitializeParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+
+	root, err := parseDocumentURI(params.RootURI)
+	if err != nil {
+		return nil, newResponseError(codeInvalidParams, err.Error())
+	}
+
+	ctx, _, endObservation := s.operations.initialize.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repo", string(root.Repo)),
+		attribute.String("rev", root.Rev),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if _, _, err := s.resolveRevision(ctx, root); err != nil {
+		return nil, err
+	}
+
+	s.root = root
+	s.initialized = true
+
+	syncKind := lsp.TDSKNone
+	return &lsp.InitializeResult{
+		Capabilities: lsp.ServerCapabilities{
+			TextDocumentSync:       &lsp.TextDocumentSyncOptionsOrKind{Kind: &syncKind},
+			HoverProvider:          true,
+			DefinitionProvider:     true,
+			ReferencesProvider:     true,
+			ImplementationProvider: true,
+			DocumentSymbolProvider: true,
+		},
+	}, nil
+}
+
+func (s *session) hover(ctx context.Context, req *request) (_ *lsp.Hover, err error) {
+	var params lsp.TextDocumentPositionParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+
+	doc, err := s.resolveDocument(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	args := doc.positionalArgs(params.Position, 0)
+	ctx, _, endObservation := s.operations.hover.With(ctx, &err, getObservationArgs(args))
+	defer endObservation(1, observation.Args{})
+
+	requestState, err := s.requestState(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	text, rn, exists, err := s.svc.GetHover(ctx, args, requestState)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	lspRange := convertRange(rn)
+	return &lsp.Hover{
+		Contents: []lsp.MarkedString{lsp.RawMarkedString(text)},
+		Range:    &lspRange,
+	}, nil
+}
+
+func (s *session) definition(ctx context.Context, req *request) (_ []lsp.Location, err error) {
+	var params lsp.TextDocumentPositionParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+
+	doc, err := s.resolveDocument(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	args := doc.positionalArgs(params.Position, locationsPageSize)
+	ctx, _, endObservation := s.operations.definition.With(ctx, &err, getObservationArgs(args))
+	defer endObservation(1, observation.Args{})
+
+	requestState, err := s.requestState(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := s.svc.GetDefinitions(ctx, args, requestState)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetDefinitions")
+	}
+
+	return convertLocations(locations), nil
+}
+
+func (s *session) references(ctx context.Context, req *request) (_ []lsp.Location, err error) {
+	var params lsp.ReferenceParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+
+	doc, err := s.resolveDocument(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	args := doc.positionalArgs(params.Position, locationsPageSize)
+	ctx, _, endObservation := s.operations.references.With(ctx, &err, getObservationArgs(args))
+	defer endObservation(1, observation.Args{})
+
+	requestState, err := s.requestState(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := gatherPages(ctx, args, requestState, s.svc.GetReferences)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetReferences")
+	}
+
+	return convertLocations(locations), nil
+}
+
+func (s *session) implementation(ctx context.Context, req *request) (_ []lsp.Location, err error) {
+	var params lsp.TextDocumentPositionParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+
+	doc, err := s.resolveDocument(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	args := doc.positionalArgs(params.Position, locationsPageSize)
+	ctx, _, endObservation := s.operations.implementation.With(ctx, &err, getObservationArgs(args))
+	defer endObservation(1, observation.Args{})
+
+	requestState, err := s.requestState(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := gatherPages(ctx, args, requestState, s.svc.GetImplementations)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetImplementations")
+	}
+
+	return convertLocations(locations), nil
+}
+
+func (s *session) documentSymbol(ctx context.Context, req *request) (_ []lsp.SymbolInformation, err error) {
+	var params lsp.DocumentSymbolParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+
+	doc, err := s.resolveDocument(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	args := doc.positionalArgs(lsp.Position{}, 0)
+	ctx, _, endObservation := s.operations.documentSymbol.With(ctx, &err, getObservationArgs(args))
+	defer endObservation(1, observation.Args{})
+
+	requestState, err := s.requestState(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols, err := s.svc.GetDocumentSymbols(ctx, args, requestState)
+	if err != nil {
+		return nil, errors.Wrap(err, "codeNavSvc.GetDocumentSymbols")
+	}
+
+	return convertDocumentSymbols(params.TextDocument.URI, symbols), nil
+}
+
+// documentContent serves the content of virtual git:// documents from gitserver. This is a
+// Sourcegraph extension to LSP that lets clients open files they don't have on disk.
+func (s *session) documentContent(ctx context.Context, req *request) (_ *lsp.TextDocumentItem, err error) {
+	var params lspext.ContentParams
+	if err := unmarshalParams(req, &params); err != nil {
+		return nil, err
+	}
+
+	doc, err := s.resolveDocument(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, _, endObservation := s.operations.documentContent.With(ctx, &err, getObservationArgs(doc.positionalArgs(lsp.Position{}, 0)))
+	defer endObservation(1, observation.Args{})
+
+	content, err := s.gitserverClient.ReadFile(ctx, doc.repo.Name, doc.commit, doc.path)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, errDocumentNotFound
+		}
+		return nil, err
+	}
+
+	language, _ := inventory.GetLanguageByFilename(doc.path)
+
+	return &lsp.TextDocumentItem{
+		URI:        params.TextDocument.URI,
+		LanguageID: strings.ToLower(language),
+		Text:       string(content),
+	}, nil
+}
+
+// document is a file of a repository at a resolved commit.
+type document struct {
+	repo   *types.Repo
+	commit api.CommitID
+	path   string
+}
+
+func (d *document) positionalArgs(position lsp.Position, limit int) codenav.PositionalRequestArgs {
+	return codenav.PositionalRequestArgs{
+		RequestArgs: codenav.RequestArgs{
+			RepositoryID: int(d.repo.ID),
+			Commit:       string(d.commit),
+			Limit:        limit,
+		},
+		Path:      d.path,
+		Line:      position.Line,
+		Character: position.Character,
+	}
+}
+
+// resolveDocument resolves the repository and revision of the given document URI.
+func (s *session) resolveDocument(ctx context.Context, uri lsp.DocumentURI) (*document, error) {
+	u, err := parseDocumentURI(uri)
+	if err != nil {
+		return nil, newResponseError(codeInvalidParams, err.Error())
+	}
+	if u.Path == "" {
+		return nil, newResponseError(codeInvalidParams, "document URI does not name a file")
+	}
+
+	repo, commit, err := s.resolveRevision(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Do not serve documents hidden from the actor by sub-repo permissions. We do not
+	// distinguish this case from a missing file to avoid leaking the existence of the path.
+	if include, err := authz.FilterActorPath(ctx, s.authChecker, actor.FromContext(ctx), repo.Name, u.Path); err != nil {
+		return nil, err
+	} else if !include {
+		return nil, errDocumentNotFound
+	}
+
+	return &document{
+		repo:   repo,
+		commit: commit,
+		path:   u.Path,
+	}, nil
+}
+
+// resolveRevision resolves the repository and revision named by the given URI to a commit.
+func (s *session) resolveRevision(ctx context.Context, u documentURI) (*types.Repo, api.CommitID, error) {
+	repo, ok := s.repos[u.Repo]
+	if !ok {
+		var err error
+		// 🚨 SECURITY: The repo store only returns repositories visible to the actor.
+		if repo, err = s.repoStore.GetByName(ctx, u.Repo); err != nil {
+			if errcode.IsNotFound(err) {
+				return nil, "", newResponseError(codeRequestFailed, "repository not found")
+			}
+			return nil, "", err
+		}
+
+		s.repos[u.Repo] = repo
+	}
+
+	key := documentURI{Repo: u.Repo, Rev: u.Rev}
+	commit, ok := s.commits[key]
+	if !ok {
+		var err error
+		if commit, err = s.gitserverClient.ResolveRevision(ctx, repo.Name, u.Rev, gitserver.ResolveRevisionOptions{}); err != nil {
+			if errcode.IsNotFound(err) {
+				return nil, "", newResponseError(codeRequestFailed, "revision not found")
+			}
+			return nil, "", err
+		}
+
+		s.commits[key] = commit
+	}
+
+	return repo, commit, nil
+}
+
+// requestState returns the codenav request state for the given document, which is backed by
+// the precise indexes closest to the document's commit.
+func (s *session) requestState(ctx context.Context, doc *document) (codenav.RequestState, error) {
+	uploads, err := s.svc.GetClosestDumpsForBlob(ctx, int(doc.repo.ID), string(doc.commit), doc.path, true, "")
+	if err != nil {
+		return codenav.RequestState{}, errors.Wrap(err, "codeNavSvc.GetClosestDumpsForBlob")
+	}
+
+	return codenav.NewRequestState(
+		uploads,
+		s.repoStore,
+		s.authChecker,
+		s.gitserverClient,
+		doc.repo,
+		string(doc.commit),
+		doc.path,
+		s.maximumIndexesPerMonikerSearch,
+		s.hunkCache,
+	), nil
+}
+
+type getLocationsPageFunc func(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, cursor codenav.Cursor) ([]shared.UploadLocation, codenav.Cursor, error)
+
+// gatherPages requests pages of locations until the result set is exhausted or the maximum
+// number of locations has been collected.
+func gatherPages(ctx context.Context, args codenav.PositionalRequestArgs, requestState codenav.RequestState, getPage getLocationsPageFunc) ([]shared.UploadLocation, error) {
+	var (
+		locations []shared.UploadLocation
+		cursor    codenav.Cursor
+	)
+
+	for len(locations) < maximumLocations {
+		page, nextCursor, err := getPage(ctx, args, requestState, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, page...)
+		if nextCursor.Phase == "done" {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	if len(locations) > maximumLocations {
+		locations = locations[:maximumLocations]
+	}
+
+	return locations, nil
+}
+
+func getObservationArgs(args codenav.PositionalRequestArgs) observation.Args {
+	return observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", args.RepositoryID),
+		attribute.String("commit", args.Commit),
+		attribute.String("path", args.Path),
+		attribute.Int("line", args.Line),
+		attribute.Int("character", args.Character),
+	}}
+}
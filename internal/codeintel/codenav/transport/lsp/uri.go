@@ -0,0 +1,69 @@
+package lsp
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/go-lsp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// uriScheme is the scheme of all document URIs served by this package. Documents are
+// identified as git://<repo name>?<revision>#<path>, which matches the URIs historically
+// used by Sourcegraph's language server proxy. The path is empty for the repository root.
+const uriScheme = "git"
+
+// documentURI identifies a file (or the root directory) of a repository at a revision.
+type documentURI struct {
+	Repo api.RepoName
+	Rev  string
+	Path string
+}
+
+// parseDocumentURI parses the given git:// URI.
+func parseDocumentURI(uri lsp.DocumentURI) (documentURI, error) {
+	u, err := url.Parse(string(uri))
+	if err != nil {
+		return documentURI{}, errors.Wrap(err, "invalid document URI")
+	}
+	if u.Scheme != uriScheme {
+		return documentURI{}, errors.Newf("unsupported document URI scheme %q", u.Scheme)
+	}
+
+	repo := strings.Trim(u.Host+u.Path, "/")
+	if repo == "" {
+		return documentURI{}, errors.Newf("document URI %q does not name a repository", uri)
+	}
+	if u.RawQuery == "" {
+		return documentURI{}, errors.Newf("document URI %q does not name a revision", uri)
+	}
+	rev, err := url.QueryUnescape(u.RawQuery)
+	if err != nil {
+		return documentURI{}, errors.Wrap(err, "invalid document URI revision")
+	}
+
+	return documentURI{
+		Repo: api.RepoName(repo),
+		Rev:  rev,
+		Path: strings.TrimPrefix(u.Fragment, "/"),
+	}, nil
+}
+
+// String formats the URI as a git:// URI.
+func (u documentURI) String() string {
+	repo := string(u.Repo)
+	host, path, _ := strings.Cut(repo, "/")
+	if path != "" {
+		path = "/" + path
+	}
+
+	return (&url.URL{
+		Scheme:   uriScheme,
+		Host:     host,
+		Path:     path,
+		RawQuery: url.QueryEscape(u.Rev),
+		Fragment: u.Path,
+	}).String()
+}
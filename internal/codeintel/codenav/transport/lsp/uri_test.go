@@ -0,0 +1,50 @@
+package lsp
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/go-lsp"
+)
+
+func TestParseDocumentURI(t *testing.T) {
+	testCases := []struct {
+		uri      lsp.DocumentURI
+		expected documentURI
+	}{
+		{uri: "git://github.com/sourcegraph/sourcegraph?main", expected: documentURI{Repo: "github.com/sourcegraph/sourcegraph", Rev: "main"}},
+		{uri: "git://github.com/sourcegraph/sourcegraph?deadbeef#cmd/frontend/main.go", expected: documentURI{Repo: "github.com/sourcegraph/sourcegraph", Rev: "deadbeef", Path: "cmd/frontend/main.go"}},
+		{uri: "git://myrepo?feature%2Fbranch#dir%20with%20spaces/file.go", expected: documentURI{Repo: "myrepo", Rev: "feature/branch", Path: "dir with spaces/file.go"}},
+	}
+
+	for _, testCase := range testCases {
+		u, err := parseDocumentURI(testCase.uri)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", testCase.uri, err)
+		}
+		if diff := cmp.Diff(testCase.expected, u); diff != "" {
+			t.Errorf("unexpected document URI for %q (-want +got):\n%s", testCase.uri, diff)
+		}
+
+		// Formatting and re-parsing should be lossless
+		roundTripped, err := parseDocumentURI(lsp.DocumentURI(u.String()))
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", u.String(), err)
+		}
+		if diff := cmp.Diff(u, roundTripped); diff != "" {
+			t.Errorf("unexpected round-tripped document URI (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestParseDocumentURIErrors(t *testing.T) {
+	for _, uri := range []lsp.DocumentURI{
+		"file:///home/user/main.go",
+		"git://?deadbeef#main.go",
+		"git://github.com/sourcegraph/sourcegraph#main.go",
+	} {
+		if _, err := parseDocumentURI(uri); err == nil {
+			t.Errorf("expected error parsing %q", uri)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package codenav
 import (
 	"strings"
 
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
@@ -81,6 +83,63 @@ type AdjustedCodeIntelligenceRange struct {
 	HoverText       string
 }
 
+// DocumentSymbol is a symbol defined within a particular document. The range of the definition has
+// been adjusted to fit the target (originally requested) commit.
+type DocumentSymbol struct {
+	Symbol      string
+	DisplayName string
+	Kind        scip.SymbolInformation_Kind
+	Range       shared.Range
+}
+
+// RenamePreview describes the text edits required to rename a symbol in every repository with
+// precise data referencing it, along with the occurrences that could not be safely renamed.
+type RenamePreview struct {
+	OldName      string
+	NewName      string
+	Repositories []RenameRepositoryPreview
+	Conflicts    []RenameConflict
+	StaleUploads []RenameStaleUpload
+}
+
+// RenameRepositoryPreview is the set of edits in a single repository. Edit ranges are relative
+// to the base revision, which is the tip of the repository's default branch. The diff applies
+// all edits of the repository to the base revision.
+type RenameRepositoryPreview struct {
+	RepositoryID   int
+	RepositoryName string
+	BaseRef        string
+	BaseRev        string
+	Edits          []RenameEdit
+	Diff           string
+}
+
+// RenameEdit replaces the text within the given range with the new name of the symbol.
+type RenameEdit struct {
+	Path  string
+	Range shared.Range
+}
+
+// RenameConflict is an occurrence of the symbol that is excluded from the rename.
+type RenameConflict struct {
+	RepositoryID   int
+	RepositoryName string
+	Path           string
+	Range          shared.Range
+	Reason         string
+}
+
+// RenameStaleUpload is an upload providing occurrences of the symbol which was created for a
+// commit other than the base revision of its repository. The occurrences of stale uploads are
+// adjusted to the base revision, when possible.
+type RenameStaleUpload struct {
+	UploadID       int
+	RepositoryID   int
+	RepositoryName string
+	Commit         string
+	BaseRev        string
+}
+
 // Cursor is a struct that holds the state necessary to resume a locations query from a second or
 // subsequent request. This struct is used internally as a request-specific context object that is
 // mutated as the locations request is fulfilled. This struct is serialized to JSON then base64
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/graph-gophers/graphql-go"
+
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 )
 
 type AutoindexingServiceResolver interface {
@@ -18,6 +20,9 @@ type AutoindexingServiceResolver interface {
 	// Inference
 	InferAutoIndexJobsForRepo(ctx context.Context, args *InferAutoIndexJobsForRepoArgs) (InferAutoIndexJobsResultResolver, error)
 	QueueAutoIndexJobsForRepo(ctx context.Context, args *QueueAutoIndexJobsForRepoArgs) ([]PreciseIndexResolver, error)
+
+	// Coverage
+	CodeIntelCoverage(ctx context.Context, args *CodeIntelCoverageArgs) (CodeIntelCoverageResolver, error)
 }
 
 type UpdateCodeIntelligenceInferenceScriptArgs struct {
@@ -41,6 +46,11 @@ type QueueAutoIndexJobsForRepoArgs struct {
 	Configuration *string
 }
 
+type CodeIntelCoverageArgs struct {
+	Repository graphql.ID
+	Path       string
+}
+
 type IndexConfigurationResolver interface {
 	Configuration(ctx context.Context) (*string, error)
 	ParsedConfiguration(ctx context.Context) (*[]AutoIndexJobDescriptionResolver, error)
@@ -79,3 +89,26 @@ type IndexerWithCountResolver interface {
 	Indexer() CodeIntelIndexerResolver
 	Count() int32
 }
+
+type CodeIntelCoverageSnapshotResolver interface {
+	Commit() string
+	TotalFiles() int32
+	IndexedFiles() int32
+	Percentage() float64
+	Indexers() []CodeIntelIndexerResolver
+	StalenessCommits() *int32
+	CapturedAt() gqlutil.DateTime
+}
+
+type CodeIntelCoverageResolver interface {
+	CodeIntelCoverageSnapshotResolver
+	Repository(ctx context.Context) (RepositoryResolver, error)
+	Path() string
+	Children(ctx context.Context) ([]CodeIntelCoverageResolver, error)
+	History(ctx context.Context, args *CodeIntelCoverageHistoryArgs) ([]CodeIntelCoverageSnapshotResolver, error)
+}
+
+type CodeIntelCoverageHistoryArgs struct {
+	Since *gqlutil.DateTime
+	First int32
+}
@@ -36,6 +36,7 @@ type GitBlobLSIFDataResolver interface {
 	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
 	VisibleIndexes(ctx context.Context) (_ *[]PreciseIndexResolver, err error)
 	Snapshot(ctx context.Context, args *struct{ IndexID graphql.ID }) (_ *[]SnapshotDataResolver, err error)
+	RenamePreview(ctx context.Context, args *RenamePreviewArgs) (SymbolRenamePreviewResolver, error)
 }
 
 type SnapshotDataResolver interface {
@@ -44,6 +45,46 @@ type SnapshotDataResolver interface {
 	Additional() *[]string
 }
 
+type RenamePreviewArgs struct {
+	Symbol  string
+	NewName string
+	Branch  *string
+}
+
+type SymbolRenamePreviewResolver interface {
+	Repositories() []SymbolRenameRepositoryPreviewResolver
+	Conflicts() []SymbolRenameConflictResolver
+	StaleIndexes() []SymbolRenameStaleIndexResolver
+	ChangesetSpecs() ([]string, error)
+}
+
+type SymbolRenameRepositoryPreviewResolver interface {
+	Repository(ctx context.Context) (RepositoryResolver, error)
+	BaseRef() string
+	BaseRev() string
+	Edits() []SymbolRenameEditResolver
+	Diff() string
+}
+
+type SymbolRenameEditResolver interface {
+	Path() string
+	Range() RangeResolver
+}
+
+type SymbolRenameConflictResolver interface {
+	Repository(ctx context.Context) (RepositoryResolver, error)
+	Path() string
+	Range() RangeResolver
+	Reason() string
+}
+
+type SymbolRenameStaleIndexResolver interface {
+	IndexID() graphql.ID
+	Repository(ctx context.Context) (RepositoryResolver, error)
+	Commit() string
+	BaseRev() string
+}
+
 type LSIFRangesArgs struct {
 	StartLine int32
 	EndLine   int32
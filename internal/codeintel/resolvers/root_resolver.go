@@ -153,6 +153,10 @@ func (r *Resolver) InferAutoIndexJobsForRepo(ctx context.Context, args *InferAut
 	return r.autoIndexingRootResolver.InferAutoIndexJobsForRepo(ctx, args)
 }
 
+func (r *Resolver) CodeIntelCoverage(ctx context.Context, args *CodeIntelCoverageArgs) (_ CodeIntelCoverageResolver, err error) {
+	return r.autoIndexingRootResolver.CodeIntelCoverage(ctx, args)
+}
+
 func (r *Resolver) GitBlobLSIFData(ctx context.Context, args *GitBlobLSIFDataArgs) (_ GitBlobLSIFDataResolver, err error) {
 	return r.codenavResolver.GitBlobLSIFData(ctx, args)
 }
@@ -185,6 +189,14 @@ func (r *Resolver) RepositorySummary(ctx context.Context, id graphql.ID) (_ Code
 	return r.uploadsRootResolver.RepositorySummary(ctx, id)
 }
 
+func (r *Resolver) PreciseRepositoryDependencies(ctx context.Context, args *PreciseRepositoryDependenciesArgs) (_ PreciseDependencyGraphResolver, err error) {
+	return r.uploadsRootResolver.PreciseRepositoryDependencies(ctx, args)
+}
+
+func (r *Resolver) PrecisePackageDependents(ctx context.Context, args *PrecisePackageDependentsArgs) (_ PreciseDependencyGraphResolver, err error) {
+	return r.uploadsRootResolver.PrecisePackageDependents(ctx, args)
+}
+
 func (r *Resolver) IndexConfiguration(ctx context.Context, id graphql.ID) (_ IndexConfigurationResolver, err error) {
 	return r.autoIndexingRootResolver.IndexConfiguration(ctx, id)
 }
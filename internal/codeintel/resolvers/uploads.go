@@ -26,6 +26,10 @@ type UploadsServiceResolver interface {
 	// Coverage
 	CodeIntelSummary(ctx context.Context) (CodeIntelSummaryResolver, error)
 	RepositorySummary(ctx context.Context, id graphql.ID) (CodeIntelRepositorySummaryResolver, error)
+
+	// Dependency graph
+	PreciseRepositoryDependencies(ctx context.Context, args *PreciseRepositoryDependenciesArgs) (PreciseDependencyGraphResolver, error)
+	PrecisePackageDependents(ctx context.Context, args *PrecisePackageDependentsArgs) (PreciseDependencyGraphResolver, error)
 }
 
 type PreciseIndexesQueryArgs struct {
@@ -195,3 +199,42 @@ type RootsWithKeyResolver interface {
 	Root() string
 	ComparisonKey() string
 }
+
+type PreciseRepositoryDependenciesArgs struct {
+	Repository graphql.ID
+	Transitive bool
+	MaxDepth   *int32
+}
+
+type PrecisePackageDependentsArgs struct {
+	Scheme            *string
+	Manager           *string
+	Name              string
+	VersionConstraint *string
+	Transitive        bool
+	MaxDepth          *int32
+}
+
+type PreciseDependencyGraphResolver interface {
+	Nodes() []PreciseDependencyGraphNodeResolver
+	Edges() []PreciseDependencyGraphEdgeResolver
+	Cycles(ctx context.Context) ([][]RepositoryResolver, error)
+}
+
+type PreciseDependencyGraphNodeResolver interface {
+	Repository(ctx context.Context) (RepositoryResolver, error)
+	Depth() int32
+}
+
+type PreciseDependencyGraphEdgeResolver interface {
+	Dependent(ctx context.Context) (RepositoryResolver, error)
+	Dependency(ctx context.Context) (RepositoryResolver, error)
+	Package() PreciseDependencyPackageResolver
+}
+
+type PreciseDependencyPackageResolver interface {
+	Scheme() string
+	Manager() string
+	Name() string
+	Version() string
+}
@@ -117,6 +117,13 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// GetRepositoriesMaxStaleAge.
 	GetRepositoriesMaxStaleAgeFunc *StoreGetRepositoriesMaxStaleAgeFunc
+	// GetRepositoryPackageReferencesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetRepositoryPackageReferences.
+	GetRepositoryPackageReferencesFunc *StoreGetRepositoryPackageReferencesFunc
+	// GetRepositoryPackagesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepositoryPackages.
+	GetRepositoryPackagesFunc *StoreGetRepositoryPackagesFunc
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *StoreGetUploadByIDFunc
@@ -381,6 +388,16 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetRepositoryPackageReferencesFunc: &StoreGetRepositoryPackageReferencesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) (r0 []shared.RepositoryPackage, r1 error) {
+				return
+			},
+		},
+		GetRepositoryPackagesFunc: &StoreGetRepositoryPackagesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) (r0 []shared.RepositoryPackage, r1 error) {
+				return
+			},
+		},
 		GetUploadByIDFunc: &StoreGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (r0 shared.Upload, r1 bool, r2 error) {
 				return
@@ -708,6 +725,16 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetRepositoriesMaxStaleAge")
 			},
 		},
+		GetRepositoryPackageReferencesFunc: &StoreGetRepositoryPackageReferencesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+				panic("unexpected invocation of MockStore.GetRepositoryPackageReferences")
+			},
+		},
+		GetRepositoryPackagesFunc: &StoreGetRepositoryPackagesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+				panic("unexpected invocation of MockStore.GetRepositoryPackages")
+			},
+		},
 		GetUploadByIDFunc: &StoreGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (shared.Upload, bool, error) {
 				panic("unexpected invocation of MockStore.GetUploadByID")
@@ -979,6 +1006,12 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetRepositoriesMaxStaleAgeFunc: &StoreGetRepositoriesMaxStaleAgeFunc{
 			defaultHook: i.GetRepositoriesMaxStaleAge,
 		},
+		GetRepositoryPackageReferencesFunc: &StoreGetRepositoryPackageReferencesFunc{
+			defaultHook: i.GetRepositoryPackageReferences,
+		},
+		GetRepositoryPackagesFunc: &StoreGetRepositoryPackagesFunc{
+			defaultHook: i.GetRepositoryPackages,
+		},
 		GetUploadByIDFunc: &StoreGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
@@ -4211,6 +4244,225 @@ func (c StoreGetRepositoriesMaxStaleAgeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreGetRepositoryPackageReferencesFunc describes the behavior when the
+// GetRepositoryPackageReferences method of the parent MockStore instance is
+// invoked.
+type StoreGetRepositoryPackageReferencesFunc struct {
+	defaultHook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	hooks       []func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	history     []StoreGetRepositoryPackageReferencesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoryPackageReferences delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRepositoryPackageReferences(v0 context.Context, v1 shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	r0, r1 := m.GetRepositoryPackageReferencesFunc.nextHook()(v0, v1)
+	m.GetRepositoryPackageReferencesFunc.appendCall(StoreGetRepositoryPackageReferencesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoryPackageReferences method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetRepositoryPackageReferencesFunc) SetDefaultHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoryPackageReferences method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetRepositoryPackageReferencesFunc) PushHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRepositoryPackageReferencesFunc) SetDefaultReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRepositoryPackageReferencesFunc) PushReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.PushHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRepositoryPackageReferencesFunc) nextHook() func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRepositoryPackageReferencesFunc) appendCall(r0 StoreGetRepositoryPackageReferencesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRepositoryPackageReferencesFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetRepositoryPackageReferencesFunc) History() []StoreGetRepositoryPackageReferencesFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRepositoryPackageReferencesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRepositoryPackageReferencesFuncCall is an object that describes
+// an invocation of method GetRepositoryPackageReferences on an instance of
+// MockStore.
+type StoreGetRepositoryPackageReferencesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetRepositoryPackagesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RepositoryPackage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRepositoryPackageReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetRepositoryPackageReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetRepositoryPackagesFunc describes the behavior when the
+// GetRepositoryPackages method of the parent MockStore instance is invoked.
+type StoreGetRepositoryPackagesFunc struct {
+	defaultHook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	hooks       []func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	history     []StoreGetRepositoryPackagesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoryPackages delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRepositoryPackages(v0 context.Context, v1 shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	r0, r1 := m.GetRepositoryPackagesFunc.nextHook()(v0, v1)
+	m.GetRepositoryPackagesFunc.appendCall(StoreGetRepositoryPackagesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoryPackages method of the parent MockStore instance is invoked
+// and the hook queue is empty.
+func (f *StoreGetRepositoryPackagesFunc) SetDefaultHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoryPackages method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetRepositoryPackagesFunc) PushHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRepositoryPackagesFunc) SetDefaultReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRepositoryPackagesFunc) PushReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.PushHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRepositoryPackagesFunc) nextHook() func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRepositoryPackagesFunc) appendCall(r0 StoreGetRepositoryPackagesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRepositoryPackagesFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetRepositoryPackagesFunc) History() []StoreGetRepositoryPackagesFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRepositoryPackagesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRepositoryPackagesFuncCall is an object that describes an
+// invocation of method GetRepositoryPackages on an instance of MockStore.
+type StoreGetRepositoryPackagesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetRepositoryPackagesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RepositoryPackage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRepositoryPackagesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetRepositoryPackagesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreGetUploadByIDFunc describes the behavior when the GetUploadByID
 // method of the parent MockStore instance is invoked.
 type StoreGetUploadByIDFunc struct {
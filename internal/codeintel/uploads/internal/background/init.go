@@ -88,6 +88,7 @@ func NewJanitor(
 		"ExpiredRecordJanitor":               janitor.NewExpiredRecordJanitor(store, config, observationCtx),
 		"FrontendDBReconciler":               janitor.NewFrontendDBReconciler(store, lsifstore, config, observationCtx),
 		"CodeIntelDBReconciler":              janitor.NewCodeIntelDBReconciler(store, lsifstore, config, observationCtx),
+		"FailedUploadJanitor":                newFailedUploadJanitor(observationCtx, store, config),
 	}
 
 	disabledJobs := map[string]struct{}{}
@@ -107,6 +108,19 @@ func NewJanitor(
 	return jobs
 }
 
+// newFailedUploadJanitor deletes uploads that failed longer than the configured maximum age
+// ago. Failed indexes are not purged here: the ExpiredRecordJanitor deletes them while keeping
+// the most recent failure of each repository, root, and indexer.
+func newFailedUploadJanitor(observationCtx *observation.Context, store uploadsstore.Store, config *janitor.Config) goroutine.BackgroundRoutine {
+	return dbworker.NewDeadLetterJanitor(observationCtx.Logger, store.WorkerutilStore(observationCtx), dbworker.DeadLetterJanitorOptions{
+		Name:      "codeintel_upload_dead_letter_janitor",
+		Interval:  config.Interval,
+		Retention: config.FailedUploadMaxAge,
+		BatchSize: config.FailedIndexBatchSize,
+		Metrics:   dbworker.NewDeadLetterJanitorMetrics(observationCtx, "codeintel_upload"),
+	})
+}
+
 func NewCommitGraphUpdater(
 	store uploadsstore.Store,
 	gitserverClient gitserver.Client,
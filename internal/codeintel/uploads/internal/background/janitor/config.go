@@ -21,6 +21,7 @@ type Config struct {
 	ReconcilerBatchSize             int
 	FailedIndexBatchSize            int
 	FailedIndexMaxAge               time.Duration
+	FailedUploadMaxAge              time.Duration
 }
 
 func (c *Config) Load() {
@@ -42,4 +43,5 @@ func (c *Config) Load() {
 	c.ReconcilerBatchSize = c.GetInt("CODEINTEL_UPLOADS_RECONCILER_BATCH_SIZE", "1000", "The number of uploads to reconcile in one cleanup routine invocation.")
 	c.FailedIndexBatchSize = c.GetInt("CODEINTEL_AUTOINDEXING_FAILED_INDEX_BATCH_SIZE", "1000", "The number of old, failed index records to delete at once.")
 	c.FailedIndexMaxAge = c.GetInterval("CODEINTEL_AUTOINDEXING_FAILED_INDEX_MAX_AGE", "730h", "The maximum age a non-relevant failed index record will remain queryable.")
+	c.FailedUploadMaxAge = c.GetInterval("CODEINTEL_UPLOADS_FAILED_UPLOAD_MAX_AGE", "730h", "The maximum age a failed upload record will remain queryable.")
 }
@@ -275,6 +275,13 @@ type MockStore struct {
 	// object controlling the behavior of the method
 	// GetRepositoriesMaxStaleAge.
 	GetRepositoriesMaxStaleAgeFunc *StoreGetRepositoriesMaxStaleAgeFunc
+	// GetRepositoryPackageReferencesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetRepositoryPackageReferences.
+	GetRepositoryPackageReferencesFunc *StoreGetRepositoryPackageReferencesFunc
+	// GetRepositoryPackagesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepositoryPackages.
+	GetRepositoryPackagesFunc *StoreGetRepositoryPackagesFunc
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *StoreGetUploadByIDFunc
@@ -539,6 +546,16 @@ func NewMockStore() *MockStore {
 				return
 			},
 		},
+		GetRepositoryPackageReferencesFunc: &StoreGetRepositoryPackageReferencesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) (r0 []shared.RepositoryPackage, r1 error) {
+				return
+			},
+		},
+		GetRepositoryPackagesFunc: &StoreGetRepositoryPackagesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) (r0 []shared.RepositoryPackage, r1 error) {
+				return
+			},
+		},
 		GetUploadByIDFunc: &StoreGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (r0 shared.Upload, r1 bool, r2 error) {
 				return
@@ -866,6 +883,16 @@ func NewStrictMockStore() *MockStore {
 				panic("unexpected invocation of MockStore.GetRepositoriesMaxStaleAge")
 			},
 		},
+		GetRepositoryPackageReferencesFunc: &StoreGetRepositoryPackageReferencesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+				panic("unexpected invocation of MockStore.GetRepositoryPackageReferences")
+			},
+		},
+		GetRepositoryPackagesFunc: &StoreGetRepositoryPackagesFunc{
+			defaultHook: func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+				panic("unexpected invocation of MockStore.GetRepositoryPackages")
+			},
+		},
 		GetUploadByIDFunc: &StoreGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (shared.Upload, bool, error) {
 				panic("unexpected invocation of MockStore.GetUploadByID")
@@ -1137,6 +1164,12 @@ func NewMockStoreFrom(i store.Store) *MockStore {
 		GetRepositoriesMaxStaleAgeFunc: &StoreGetRepositoriesMaxStaleAgeFunc{
 			defaultHook: i.GetRepositoriesMaxStaleAge,
 		},
+		GetRepositoryPackageReferencesFunc: &StoreGetRepositoryPackageReferencesFunc{
+			defaultHook: i.GetRepositoryPackageReferences,
+		},
+		GetRepositoryPackagesFunc: &StoreGetRepositoryPackagesFunc{
+			defaultHook: i.GetRepositoryPackages,
+		},
 		GetUploadByIDFunc: &StoreGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
@@ -4369,6 +4402,225 @@ func (c StoreGetRepositoriesMaxStaleAgeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreGetRepositoryPackageReferencesFunc describes the behavior when the
+// GetRepositoryPackageReferences method of the parent MockStore instance is
+// invoked.
+type StoreGetRepositoryPackageReferencesFunc struct {
+	defaultHook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	hooks       []func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	history     []StoreGetRepositoryPackageReferencesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoryPackageReferences delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRepositoryPackageReferences(v0 context.Context, v1 shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	r0, r1 := m.GetRepositoryPackageReferencesFunc.nextHook()(v0, v1)
+	m.GetRepositoryPackageReferencesFunc.appendCall(StoreGetRepositoryPackageReferencesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoryPackageReferences method of the parent MockStore instance is
+// invoked and the hook queue is empty.
+func (f *StoreGetRepositoryPackageReferencesFunc) SetDefaultHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoryPackageReferences method of the parent MockStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *StoreGetRepositoryPackageReferencesFunc) PushHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRepositoryPackageReferencesFunc) SetDefaultReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRepositoryPackageReferencesFunc) PushReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.PushHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRepositoryPackageReferencesFunc) nextHook() func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRepositoryPackageReferencesFunc) appendCall(r0 StoreGetRepositoryPackageReferencesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRepositoryPackageReferencesFuncCall
+// objects describing the invocations of this function.
+func (f *StoreGetRepositoryPackageReferencesFunc) History() []StoreGetRepositoryPackageReferencesFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRepositoryPackageReferencesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRepositoryPackageReferencesFuncCall is an object that describes
+// an invocation of method GetRepositoryPackageReferences on an instance of
+// MockStore.
+type StoreGetRepositoryPackageReferencesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetRepositoryPackagesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RepositoryPackage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRepositoryPackageReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetRepositoryPackageReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreGetRepositoryPackagesFunc describes the behavior when the
+// GetRepositoryPackages method of the parent MockStore instance is invoked.
+type StoreGetRepositoryPackagesFunc struct {
+	defaultHook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	hooks       []func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	history     []StoreGetRepositoryPackagesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoryPackages delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockStore) GetRepositoryPackages(v0 context.Context, v1 shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	r0, r1 := m.GetRepositoryPackagesFunc.nextHook()(v0, v1)
+	m.GetRepositoryPackagesFunc.appendCall(StoreGetRepositoryPackagesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoryPackages method of the parent MockStore instance is invoked
+// and the hook queue is empty.
+func (f *StoreGetRepositoryPackagesFunc) SetDefaultHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoryPackages method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreGetRepositoryPackagesFunc) PushHook(hook func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreGetRepositoryPackagesFunc) SetDefaultReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.SetDefaultHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreGetRepositoryPackagesFunc) PushReturn(r0 []shared.RepositoryPackage, r1 error) {
+	f.PushHook(func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreGetRepositoryPackagesFunc) nextHook() func(context.Context, shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreGetRepositoryPackagesFunc) appendCall(r0 StoreGetRepositoryPackagesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreGetRepositoryPackagesFuncCall objects
+// describing the invocations of this function.
+func (f *StoreGetRepositoryPackagesFunc) History() []StoreGetRepositoryPackagesFuncCall {
+	f.mutex.Lock()
+	history := make([]StoreGetRepositoryPackagesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreGetRepositoryPackagesFuncCall is an object that describes an
+// invocation of method GetRepositoryPackages on an instance of MockStore.
+type StoreGetRepositoryPackagesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.GetRepositoryPackagesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []shared.RepositoryPackage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreGetRepositoryPackagesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreGetRepositoryPackagesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreGetUploadByIDFunc describes the behavior when the GetUploadByID
 // method of the parent MockStore instance is invoked.
 type StoreGetUploadByIDFunc struct {
@@ -8673,6 +8925,12 @@ type MockLSIFStore struct {
 	// object controlling the behavior of the method
 	// DeleteUnreferencedDocuments.
 	DeleteUnreferencedDocumentsFunc *LSIFStoreDeleteUnreferencedDocumentsFunc
+	// GetDocumentPathsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetDocumentPaths.
+	GetDocumentPathsFunc *LSIFStoreGetDocumentPathsFunc
+	// GetSCIPMetadataFunc is an instance of a mock function object
+	// controlling the behavior of the method GetSCIPMetadata.
+	GetSCIPMetadataFunc *LSIFStoreGetSCIPMetadataFunc
 	// IDsWithMetaFunc is an instance of a mock function object controlling
 	// the behavior of the method IDsWithMeta.
 	IDsWithMetaFunc *LSIFStoreIDsWithMetaFunc
@@ -8693,6 +8951,9 @@ type MockLSIFStore struct {
 	// object controlling the behavior of the method
 	// ReconcileCandidatesWithTime.
 	ReconcileCandidatesWithTimeFunc *LSIFStoreReconcileCandidatesWithTimeFunc
+	// ScanDocumentsFunc is an instance of a mock function object
+	// controlling the behavior of the method ScanDocuments.
+	ScanDocumentsFunc *LSIFStoreScanDocumentsFunc
 	// WithTransactionFunc is an instance of a mock function object
 	// controlling the behavior of the method WithTransaction.
 	WithTransactionFunc *LSIFStoreWithTransactionFunc
@@ -8717,6 +8978,16 @@ func NewMockLSIFStore() *MockLSIFStore {
 				return
 			},
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: func(context.Context, int) (r0 []string, r1 error) {
+				return
+			},
+		},
+		GetSCIPMetadataFunc: &LSIFStoreGetSCIPMetadataFunc{
+			defaultHook: func(context.Context, int) (r0 lsifstore.ProcessedMetadata, r1 bool, r2 error) {
+				return
+			},
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: func(context.Context, []int) (r0 []int, r1 error) {
 				return
@@ -8747,6 +9018,11 @@ func NewMockLSIFStore() *MockLSIFStore {
 				return
 			},
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: func(context.Context, int, func(path string, document *scip.Document) error) (r0 error) {
+				return
+			},
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: func(context.Context, func(s lsifstore.Store) error) (r0 error) {
 				return
@@ -8774,6 +9050,16 @@ func NewStrictMockLSIFStore() *MockLSIFStore {
 				panic("unexpected invocation of MockLSIFStore.DeleteUnreferencedDocuments")
 			},
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: func(context.Context, int) ([]string, error) {
+				panic("unexpected invocation of MockLSIFStore.GetDocumentPaths")
+			},
+		},
+		GetSCIPMetadataFunc: &LSIFStoreGetSCIPMetadataFunc{
+			defaultHook: func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error) {
+				panic("unexpected invocation of MockLSIFStore.GetSCIPMetadata")
+			},
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: func(context.Context, []int) ([]int, error) {
 				panic("unexpected invocation of MockLSIFStore.IDsWithMeta")
@@ -8804,6 +9090,11 @@ func NewStrictMockLSIFStore() *MockLSIFStore {
 				panic("unexpected invocation of MockLSIFStore.ReconcileCandidatesWithTime")
 			},
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: func(context.Context, int, func(path string, document *scip.Document) error) error {
+				panic("unexpected invocation of MockLSIFStore.ScanDocuments")
+			},
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: func(context.Context, func(s lsifstore.Store) error) error {
 				panic("unexpected invocation of MockLSIFStore.WithTransaction")
@@ -8825,6 +9116,12 @@ func NewMockLSIFStoreFrom(i lsifstore.Store) *MockLSIFStore {
 		DeleteUnreferencedDocumentsFunc: &LSIFStoreDeleteUnreferencedDocumentsFunc{
 			defaultHook: i.DeleteUnreferencedDocuments,
 		},
+		GetDocumentPathsFunc: &LSIFStoreGetDocumentPathsFunc{
+			defaultHook: i.GetDocumentPaths,
+		},
+		GetSCIPMetadataFunc: &LSIFStoreGetSCIPMetadataFunc{
+			defaultHook: i.GetSCIPMetadata,
+		},
 		IDsWithMetaFunc: &LSIFStoreIDsWithMetaFunc{
 			defaultHook: i.IDsWithMeta,
 		},
@@ -8843,6 +9140,9 @@ func NewMockLSIFStoreFrom(i lsifstore.Store) *MockLSIFStore {
 		ReconcileCandidatesWithTimeFunc: &LSIFStoreReconcileCandidatesWithTimeFunc{
 			defaultHook: i.ReconcileCandidatesWithTime,
 		},
+		ScanDocumentsFunc: &LSIFStoreScanDocumentsFunc{
+			defaultHook: i.ScanDocuments,
+		},
 		WithTransactionFunc: &LSIFStoreWithTransactionFunc{
 			defaultHook: i.WithTransaction,
 		},
@@ -9195,35 +9495,35 @@ func (c LSIFStoreDeleteUnreferencedDocumentsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreIDsWithMetaFunc describes the behavior when the IDsWithMeta
-// method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreIDsWithMetaFunc struct {
-	defaultHook func(context.Context, []int) ([]int, error)
-	hooks       []func(context.Context, []int) ([]int, error)
-	history     []LSIFStoreIDsWithMetaFuncCall
+// LSIFStoreGetDocumentPathsFunc describes the behavior when the
+// GetDocumentPaths method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreGetDocumentPathsFunc struct {
+	defaultHook func(context.Context, int) ([]string, error)
+	hooks       []func(context.Context, int) ([]string, error)
+	history     []LSIFStoreGetDocumentPathsFuncCall
 	mutex       sync.Mutex
 }
 
-// IDsWithMeta delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockLSIFStore) IDsWithMeta(v0 context.Context, v1 []int) ([]int, error) {
-	r0, r1 := m.IDsWithMetaFunc.nextHook()(v0, v1)
-	m.IDsWithMetaFunc.appendCall(LSIFStoreIDsWithMetaFuncCall{v0, v1, r0, r1})
+// GetDocumentPaths delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) GetDocumentPaths(v0 context.Context, v1 int) ([]string, error) {
+	r0, r1 := m.GetDocumentPathsFunc.nextHook()(v0, v1)
+	m.GetDocumentPathsFunc.appendCall(LSIFStoreGetDocumentPathsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IDsWithMeta method
-// of the parent MockLSIFStore instance is invoked and the hook queue is
-// empty.
-func (f *LSIFStoreIDsWithMetaFunc) SetDefaultHook(hook func(context.Context, []int) ([]int, error)) {
+// SetDefaultHook sets function that is called when the GetDocumentPaths
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreGetDocumentPathsFunc) SetDefaultHook(hook func(context.Context, int) ([]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IDsWithMeta method of the parent MockLSIFStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreIDsWithMetaFunc) PushHook(hook func(context.Context, []int) ([]int, error)) {
+// GetDocumentPaths method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreGetDocumentPathsFunc) PushHook(hook func(context.Context, int) ([]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9231,20 +9531,20 @@ func (f *LSIFStoreIDsWithMetaFunc) PushHook(hook func(context.Context, []int) ([
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *LSIFStoreIDsWithMetaFunc) SetDefaultReturn(r0 []int, r1 error) {
-	f.SetDefaultHook(func(context.Context, []int) ([]int, error) {
+func (f *LSIFStoreGetDocumentPathsFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *LSIFStoreIDsWithMetaFunc) PushReturn(r0 []int, r1 error) {
-	f.PushHook(func(context.Context, []int) ([]int, error) {
+func (f *LSIFStoreGetDocumentPathsFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int) ([]string, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreIDsWithMetaFunc) nextHook() func(context.Context, []int) ([]int, error) {
+func (f *LSIFStoreGetDocumentPathsFunc) nextHook() func(context.Context, int) ([]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9257,35 +9557,35 @@ func (f *LSIFStoreIDsWithMetaFunc) nextHook() func(context.Context, []int) ([]in
 	return hook
 }
 
-func (f *LSIFStoreIDsWithMetaFunc) appendCall(r0 LSIFStoreIDsWithMetaFuncCall) {
+func (f *LSIFStoreGetDocumentPathsFunc) appendCall(r0 LSIFStoreGetDocumentPathsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreIDsWithMetaFuncCall objects
+// History returns a sequence of LSIFStoreGetDocumentPathsFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreIDsWithMetaFunc) History() []LSIFStoreIDsWithMetaFuncCall {
+func (f *LSIFStoreGetDocumentPathsFunc) History() []LSIFStoreGetDocumentPathsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreIDsWithMetaFuncCall, len(f.history))
+	history := make([]LSIFStoreGetDocumentPathsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreIDsWithMetaFuncCall is an object that describes an invocation of
-// method IDsWithMeta on an instance of MockLSIFStore.
-type LSIFStoreIDsWithMetaFuncCall struct {
+// LSIFStoreGetDocumentPathsFuncCall is an object that describes an
+// invocation of method GetDocumentPaths on an instance of MockLSIFStore.
+type LSIFStoreGetDocumentPathsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []int
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []int
+	Result0 []string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -9293,45 +9593,264 @@ type LSIFStoreIDsWithMetaFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreIDsWithMetaFuncCall) Args() []interface{} {
+func (c LSIFStoreGetDocumentPathsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreIDsWithMetaFuncCall) Results() []interface{} {
+func (c LSIFStoreGetDocumentPathsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc describes the
-// behavior when the InsertDefinitionsAndReferencesForDocument method of the
-// parent MockLSIFStore instance is invoked.
-type LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc struct {
-	defaultHook func(context.Context, shared.ExportedUpload, string, int, func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error
-	hooks       []func(context.Context, shared.ExportedUpload, string, int, func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error
-	history     []LSIFStoreInsertDefinitionsAndReferencesForDocumentFuncCall
+// LSIFStoreGetSCIPMetadataFunc describes the behavior when the
+// GetSCIPMetadata method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreGetSCIPMetadataFunc struct {
+	defaultHook func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error)
+	hooks       []func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error)
+	history     []LSIFStoreGetSCIPMetadataFuncCall
 	mutex       sync.Mutex
 }
 
-// InsertDefinitionsAndReferencesForDocument delegates to the next hook
-// function in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockLSIFStore) InsertDefinitionsAndReferencesForDocument(v0 context.Context, v1 shared.ExportedUpload, v2 string, v3 int, v4 func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error {
-	r0 := m.InsertDefinitionsAndReferencesForDocumentFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.InsertDefinitionsAndReferencesForDocumentFunc.appendCall(LSIFStoreInsertDefinitionsAndReferencesForDocumentFuncCall{v0, v1, v2, v3, v4, r0})
-	return r0
+// GetSCIPMetadata delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) GetSCIPMetadata(v0 context.Context, v1 int) (lsifstore.ProcessedMetadata, bool, error) {
+	r0, r1, r2 := m.GetSCIPMetadataFunc.nextHook()(v0, v1)
+	m.GetSCIPMetadataFunc.appendCall(LSIFStoreGetSCIPMetadataFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the
-// InsertDefinitionsAndReferencesForDocument method of the parent
-// MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc) SetDefaultHook(hook func(context.Context, shared.ExportedUpload, string, int, func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error) {
+// SetDefaultHook sets function that is called when the GetSCIPMetadata
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreGetSCIPMetadataFunc) SetDefaultHook(hook func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InsertDefinitionsAndReferencesForDocument method of the parent
-// MockLSIFStore instance invokes the hook at the front of the queue and
+// GetSCIPMetadata method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreGetSCIPMetadataFunc) PushHook(hook func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreGetSCIPMetadataFunc) SetDefaultReturn(r0 lsifstore.ProcessedMetadata, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreGetSCIPMetadataFunc) PushReturn(r0 lsifstore.ProcessedMetadata, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *LSIFStoreGetSCIPMetadataFunc) nextHook() func(context.Context, int) (lsifstore.ProcessedMetadata, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreGetSCIPMetadataFunc) appendCall(r0 LSIFStoreGetSCIPMetadataFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreGetSCIPMetadataFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreGetSCIPMetadataFunc) History() []LSIFStoreGetSCIPMetadataFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreGetSCIPMetadataFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreGetSCIPMetadataFuncCall is an object that describes an
+// invocation of method GetSCIPMetadata on an instance of MockLSIFStore.
+type LSIFStoreGetSCIPMetadataFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 lsifstore.ProcessedMetadata
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreGetSCIPMetadataFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreGetSCIPMetadataFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// LSIFStoreIDsWithMetaFunc describes the behavior when the IDsWithMeta
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreIDsWithMetaFunc struct {
+	defaultHook func(context.Context, []int) ([]int, error)
+	hooks       []func(context.Context, []int) ([]int, error)
+	history     []LSIFStoreIDsWithMetaFuncCall
+	mutex       sync.Mutex
+}
+
+// IDsWithMeta delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) IDsWithMeta(v0 context.Context, v1 []int) ([]int, error) {
+	r0, r1 := m.IDsWithMetaFunc.nextHook()(v0, v1)
+	m.IDsWithMetaFunc.appendCall(LSIFStoreIDsWithMetaFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the IDsWithMeta method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreIDsWithMetaFunc) SetDefaultHook(hook func(context.Context, []int) ([]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IDsWithMeta method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreIDsWithMetaFunc) PushHook(hook func(context.Context, []int) ([]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreIDsWithMetaFunc) SetDefaultReturn(r0 []int, r1 error) {
+	f.SetDefaultHook(func(context.Context, []int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreIDsWithMetaFunc) PushReturn(r0 []int, r1 error) {
+	f.PushHook(func(context.Context, []int) ([]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *LSIFStoreIDsWithMetaFunc) nextHook() func(context.Context, []int) ([]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreIDsWithMetaFunc) appendCall(r0 LSIFStoreIDsWithMetaFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreIDsWithMetaFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreIDsWithMetaFunc) History() []LSIFStoreIDsWithMetaFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreIDsWithMetaFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreIDsWithMetaFuncCall is an object that describes an invocation of
+// method IDsWithMeta on an instance of MockLSIFStore.
+type LSIFStoreIDsWithMetaFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreIDsWithMetaFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreIDsWithMetaFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc describes the
+// behavior when the InsertDefinitionsAndReferencesForDocument method of the
+// parent MockLSIFStore instance is invoked.
+type LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc struct {
+	defaultHook func(context.Context, shared.ExportedUpload, string, int, func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error
+	hooks       []func(context.Context, shared.ExportedUpload, string, int, func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error
+	history     []LSIFStoreInsertDefinitionsAndReferencesForDocumentFuncCall
+	mutex       sync.Mutex
+}
+
+// InsertDefinitionsAndReferencesForDocument delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockLSIFStore) InsertDefinitionsAndReferencesForDocument(v0 context.Context, v1 shared.ExportedUpload, v2 string, v3 int, v4 func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error {
+	r0 := m.InsertDefinitionsAndReferencesForDocumentFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.InsertDefinitionsAndReferencesForDocumentFunc.appendCall(LSIFStoreInsertDefinitionsAndReferencesForDocumentFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// InsertDefinitionsAndReferencesForDocument method of the parent
+// MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc) SetDefaultHook(hook func(context.Context, shared.ExportedUpload, string, int, func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InsertDefinitionsAndReferencesForDocument method of the parent
+// MockLSIFStore instance invokes the hook at the front of the queue and
 // discards it. After the queue is empty, the default hook function is
 // invoked for any future action.
 func (f *LSIFStoreInsertDefinitionsAndReferencesForDocumentFunc) PushHook(hook func(context.Context, shared.ExportedUpload, string, int, func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey string, path string, document *scip.Document) error) error) {
@@ -9863,35 +10382,35 @@ func (c LSIFStoreReconcileCandidatesWithTimeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreWithTransactionFunc describes the behavior when the
-// WithTransaction method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreWithTransactionFunc struct {
-	defaultHook func(context.Context, func(s lsifstore.Store) error) error
-	hooks       []func(context.Context, func(s lsifstore.Store) error) error
-	history     []LSIFStoreWithTransactionFuncCall
+// LSIFStoreScanDocumentsFunc describes the behavior when the ScanDocuments
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreScanDocumentsFunc struct {
+	defaultHook func(context.Context, int, func(path string, document *scip.Document) error) error
+	hooks       []func(context.Context, int, func(path string, document *scip.Document) error) error
+	history     []LSIFStoreScanDocumentsFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransaction delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) WithTransaction(v0 context.Context, v1 func(s lsifstore.Store) error) error {
-	r0 := m.WithTransactionFunc.nextHook()(v0, v1)
-	m.WithTransactionFunc.appendCall(LSIFStoreWithTransactionFuncCall{v0, v1, r0})
+// ScanDocuments delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) ScanDocuments(v0 context.Context, v1 int, v2 func(path string, document *scip.Document) error) error {
+	r0 := m.ScanDocumentsFunc.nextHook()(v0, v1, v2)
+	m.ScanDocumentsFunc.appendCall(LSIFStoreScanDocumentsFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the WithTransaction
-// method of the parent MockLSIFStore instance is invoked and the hook queue
-// is empty.
-func (f *LSIFStoreWithTransactionFunc) SetDefaultHook(hook func(context.Context, func(s lsifstore.Store) error) error) {
+// SetDefaultHook sets function that is called when the ScanDocuments method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreScanDocumentsFunc) SetDefaultHook(hook func(context.Context, int, func(path string, document *scip.Document) error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransaction method of the parent MockLSIFStore instance invokes the
+// ScanDocuments method of the parent MockLSIFStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *LSIFStoreWithTransactionFunc) PushHook(hook func(context.Context, func(s lsifstore.Store) error) error) {
+func (f *LSIFStoreScanDocumentsFunc) PushHook(hook func(context.Context, int, func(path string, document *scip.Document) error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9899,20 +10418,20 @@ func (f *LSIFStoreWithTransactionFunc) PushHook(hook func(context.Context, func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *LSIFStoreWithTransactionFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(s lsifstore.Store) error) error {
+func (f *LSIFStoreScanDocumentsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, func(path string, document *scip.Document) error) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *LSIFStoreWithTransactionFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(s lsifstore.Store) error) error {
+func (f *LSIFStoreScanDocumentsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, func(path string, document *scip.Document) error) error {
 		return r0
 	})
 }
 
-func (f *LSIFStoreWithTransactionFunc) nextHook() func(context.Context, func(s lsifstore.Store) error) error {
+func (f *LSIFStoreScanDocumentsFunc) nextHook() func(context.Context, int, func(path string, document *scip.Document) error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9925,32 +10444,35 @@ func (f *LSIFStoreWithTransactionFunc) nextHook() func(context.Context, func(s l
 	return hook
 }
 
-func (f *LSIFStoreWithTransactionFunc) appendCall(r0 LSIFStoreWithTransactionFuncCall) {
+func (f *LSIFStoreScanDocumentsFunc) appendCall(r0 LSIFStoreScanDocumentsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreWithTransactionFuncCall objects
+// History returns a sequence of LSIFStoreScanDocumentsFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreWithTransactionFunc) History() []LSIFStoreWithTransactionFuncCall {
+func (f *LSIFStoreScanDocumentsFunc) History() []LSIFStoreScanDocumentsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreWithTransactionFuncCall, len(f.history))
+	history := make([]LSIFStoreScanDocumentsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreWithTransactionFuncCall is an object that describes an
-// invocation of method WithTransaction on an instance of MockLSIFStore.
-type LSIFStoreWithTransactionFuncCall struct {
+// LSIFStoreScanDocumentsFuncCall is an object that describes an invocation
+// of method ScanDocuments on an instance of MockLSIFStore.
+type LSIFStoreScanDocumentsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 func(s lsifstore.Store) error
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func(path string, document *scip.Document) error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -9958,25 +10480,136 @@ type LSIFStoreWithTransactionFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreWithTransactionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c LSIFStoreScanDocumentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreWithTransactionFuncCall) Results() []interface{} {
+func (c LSIFStoreScanDocumentsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// MockWorkerStore is a mock implementation of the Store interface (from the
-// package
-// github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store)
-// used for unit testing.
-type MockWorkerStore[T workerutil.Record] struct {
-	// AddExecutionLogEntryFunc is an instance of a mock function object
-	// controlling the behavior of the method AddExecutionLogEntry.
-	AddExecutionLogEntryFunc *WorkerStoreAddExecutionLogEntryFunc[T]
-	// DequeueFunc is an instance of a mock function object controlling the
+// LSIFStoreWithTransactionFunc describes the behavior when the
+// WithTransaction method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreWithTransactionFunc struct {
+	defaultHook func(context.Context, func(s lsifstore.Store) error) error
+	hooks       []func(context.Context, func(s lsifstore.Store) error) error
+	history     []LSIFStoreWithTransactionFuncCall
+	mutex       sync.Mutex
+}
+
+// WithTransaction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) WithTransaction(v0 context.Context, v1 func(s lsifstore.Store) error) error {
+	r0 := m.WithTransactionFunc.nextHook()(v0, v1)
+	m.WithTransactionFunc.appendCall(LSIFStoreWithTransactionFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransaction
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreWithTransactionFunc) SetDefaultHook(hook func(context.Context, func(s lsifstore.Store) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransaction method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreWithTransactionFunc) PushHook(hook func(context.Context, func(s lsifstore.Store) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *LSIFStoreWithTransactionFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(s lsifstore.Store) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *LSIFStoreWithTransactionFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(s lsifstore.Store) error) error {
+		return r0
+	})
+}
+
+func (f *LSIFStoreWithTransactionFunc) nextHook() func(context.Context, func(s lsifstore.Store) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreWithTransactionFunc) appendCall(r0 LSIFStoreWithTransactionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreWithTransactionFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreWithTransactionFunc) History() []LSIFStoreWithTransactionFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreWithTransactionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreWithTransactionFuncCall is an object that describes an
+// invocation of method WithTransaction on an instance of MockLSIFStore.
+type LSIFStoreWithTransactionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(s lsifstore.Store) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreWithTransactionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreWithTransactionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockWorkerStore is a mock implementation of the Store interface (from the
+// package
+// github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store)
+// used for unit testing.
+type MockWorkerStore[T workerutil.Record] struct {
+	// AddExecutionLogEntryFunc is an instance of a mock function object
+	// controlling the behavior of the method AddExecutionLogEntry.
+	AddExecutionLogEntryFunc *WorkerStoreAddExecutionLogEntryFunc[T]
+	// CountFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method CountFailed.
+	CountFailedFunc *WorkerStoreCountFailedFunc[T]
+	// DeleteFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method DeleteFailed.
+	DeleteFailedFunc *WorkerStoreDeleteFailedFunc[T]
+	// DequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Dequeue.
 	DequeueFunc *WorkerStoreDequeueFunc[T]
 	// HandleFunc is an instance of a mock function object controlling the
@@ -9985,6 +10618,12 @@ type MockWorkerStore[T workerutil.Record] struct {
 	// HeartbeatFunc is an instance of a mock function object controlling
 	// the behavior of the method Heartbeat.
 	HeartbeatFunc *WorkerStoreHeartbeatFunc[T]
+	// ListDequeueCandidatesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListDequeueCandidates.
+	ListDequeueCandidatesFunc *WorkerStoreListDequeueCandidatesFunc[T]
+	// ListFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method ListFailed.
+	ListFailedFunc *WorkerStoreListFailedFunc[T]
 	// MarkCompleteFunc is an instance of a mock function object controlling
 	// the behavior of the method MarkComplete.
 	MarkCompleteFunc *WorkerStoreMarkCompleteFunc[T]
@@ -9997,12 +10636,18 @@ type MockWorkerStore[T workerutil.Record] struct {
 	// MaxDurationInQueueFunc is an instance of a mock function object
 	// controlling the behavior of the method MaxDurationInQueue.
 	MaxDurationInQueueFunc *WorkerStoreMaxDurationInQueueFunc[T]
+	// QueueStatisticsFunc is an instance of a mock function object controlling
+	// the behavior of the method QueueStatistics.
+	QueueStatisticsFunc *WorkerStoreQueueStatisticsFunc[T]
 	// QueuedCountFunc is an instance of a mock function object controlling
 	// the behavior of the method QueuedCount.
 	QueuedCountFunc *WorkerStoreQueuedCountFunc[T]
 	// RequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Requeue.
 	RequeueFunc *WorkerStoreRequeueFunc[T]
+	// RequeueFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method RequeueFailed.
+	RequeueFailedFunc *WorkerStoreRequeueFailedFunc[T]
 	// ResetStalledFunc is an instance of a mock function object controlling
 	// the behavior of the method ResetStalled.
 	ResetStalledFunc *WorkerStoreResetStalledFunc[T]
@@ -10023,6 +10668,16 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		CountFailedFunc: &WorkerStoreCountFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		DeleteFailedFunc: &WorkerStoreDeleteFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
 		DequeueFunc: &WorkerStoreDequeueFunc[T]{
 			defaultHook: func(context.Context, string, []*sqlf.Query) (r0 T, r1 bool, r2 error) {
 				return
@@ -10038,6 +10693,16 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		ListDequeueCandidatesFunc: &WorkerStoreListDequeueCandidatesFunc[T]{
+			defaultHook: func(context.Context, int) (r0 []store1.DequeueCandidate, r1 error) {
+				return
+			},
+		},
+		ListFailedFunc: &WorkerStoreListFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 []store1.FailedRecord, r1 error) {
+				return
+			},
+		},
 		MarkCompleteFunc: &WorkerStoreMarkCompleteFunc[T]{
 			defaultHook: func(context.Context, int, store1.MarkFinalOptions) (r0 bool, r1 error) {
 				return
@@ -10058,6 +10723,11 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		QueueStatisticsFunc: &WorkerStoreQueueStatisticsFunc[T]{
+			defaultHook: func(context.Context, time.Duration) (r0 store1.QueueStatistics, r1 error) {
+				return
+			},
+		},
 		QueuedCountFunc: &WorkerStoreQueuedCountFunc[T]{
 			defaultHook: func(context.Context, bool) (r0 int, r1 error) {
 				return
@@ -10068,6 +10738,11 @@ func NewMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				return
 			},
 		},
+		RequeueFailedFunc: &WorkerStoreRequeueFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
 		ResetStalledFunc: &WorkerStoreResetStalledFunc[T]{
 			defaultHook: func(context.Context) (r0 map[int]time.Duration, r1 map[int]time.Duration, r2 error) {
 				return
@@ -10095,6 +10770,16 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.AddExecutionLogEntry")
 			},
 		},
+		CountFailedFunc: &WorkerStoreCountFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockWorkerStore.CountFailed")
+			},
+		},
+		DeleteFailedFunc: &WorkerStoreDeleteFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockWorkerStore.DeleteFailed")
+			},
+		},
 		DequeueFunc: &WorkerStoreDequeueFunc[T]{
 			defaultHook: func(context.Context, string, []*sqlf.Query) (T, bool, error) {
 				panic("unexpected invocation of MockWorkerStore.Dequeue")
@@ -10110,6 +10795,16 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.Heartbeat")
 			},
 		},
+		ListDequeueCandidatesFunc: &WorkerStoreListDequeueCandidatesFunc[T]{
+			defaultHook: func(context.Context, int) ([]store1.DequeueCandidate, error) {
+				panic("unexpected invocation of MockWorkerStore.ListDequeueCandidates")
+			},
+		},
+		ListFailedFunc: &WorkerStoreListFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+				panic("unexpected invocation of MockWorkerStore.ListFailed")
+			},
+		},
 		MarkCompleteFunc: &WorkerStoreMarkCompleteFunc[T]{
 			defaultHook: func(context.Context, int, store1.MarkFinalOptions) (bool, error) {
 				panic("unexpected invocation of MockWorkerStore.MarkComplete")
@@ -10130,6 +10825,11 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.MaxDurationInQueue")
 			},
 		},
+		QueueStatisticsFunc: &WorkerStoreQueueStatisticsFunc[T]{
+			defaultHook: func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+				panic("unexpected invocation of MockWorkerStore.QueueStatistics")
+			},
+		},
 		QueuedCountFunc: &WorkerStoreQueuedCountFunc[T]{
 			defaultHook: func(context.Context, bool) (int, error) {
 				panic("unexpected invocation of MockWorkerStore.QueuedCount")
@@ -10140,6 +10840,11 @@ func NewStrictMockWorkerStore[T workerutil.Record]() *MockWorkerStore[T] {
 				panic("unexpected invocation of MockWorkerStore.Requeue")
 			},
 		},
+		RequeueFailedFunc: &WorkerStoreRequeueFailedFunc[T]{
+			defaultHook: func(context.Context, store1.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockWorkerStore.RequeueFailed")
+			},
+		},
 		ResetStalledFunc: &WorkerStoreResetStalledFunc[T]{
 			defaultHook: func(context.Context) (map[int]time.Duration, map[int]time.Duration, error) {
 				panic("unexpected invocation of MockWorkerStore.ResetStalled")
@@ -10166,6 +10871,12 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		AddExecutionLogEntryFunc: &WorkerStoreAddExecutionLogEntryFunc[T]{
 			defaultHook: i.AddExecutionLogEntry,
 		},
+		CountFailedFunc: &WorkerStoreCountFailedFunc[T]{
+			defaultHook: i.CountFailed,
+		},
+		DeleteFailedFunc: &WorkerStoreDeleteFailedFunc[T]{
+			defaultHook: i.DeleteFailed,
+		},
 		DequeueFunc: &WorkerStoreDequeueFunc[T]{
 			defaultHook: i.Dequeue,
 		},
@@ -10175,6 +10886,12 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		HeartbeatFunc: &WorkerStoreHeartbeatFunc[T]{
 			defaultHook: i.Heartbeat,
 		},
+		ListDequeueCandidatesFunc: &WorkerStoreListDequeueCandidatesFunc[T]{
+			defaultHook: i.ListDequeueCandidates,
+		},
+		ListFailedFunc: &WorkerStoreListFailedFunc[T]{
+			defaultHook: i.ListFailed,
+		},
 		MarkCompleteFunc: &WorkerStoreMarkCompleteFunc[T]{
 			defaultHook: i.MarkComplete,
 		},
@@ -10187,12 +10904,18 @@ func NewMockWorkerStoreFrom[T workerutil.Record](i store1.Store[T]) *MockWorkerS
 		MaxDurationInQueueFunc: &WorkerStoreMaxDurationInQueueFunc[T]{
 			defaultHook: i.MaxDurationInQueue,
 		},
+		QueueStatisticsFunc: &WorkerStoreQueueStatisticsFunc[T]{
+			defaultHook: i.QueueStatistics,
+		},
 		QueuedCountFunc: &WorkerStoreQueuedCountFunc[T]{
 			defaultHook: i.QueuedCount,
 		},
 		RequeueFunc: &WorkerStoreRequeueFunc[T]{
 			defaultHook: i.Requeue,
 		},
+		RequeueFailedFunc: &WorkerStoreRequeueFailedFunc[T]{
+			defaultHook: i.RequeueFailed,
+		},
 		ResetStalledFunc: &WorkerStoreResetStalledFunc[T]{
 			defaultHook: i.ResetStalled,
 		},
@@ -10322,6 +11045,218 @@ func (c WorkerStoreAddExecutionLogEntryFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// WorkerStoreCountFailedFunc describes the behavior when the CountFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreCountFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) (int, error)
+	history     []WorkerStoreCountFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// CountFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) CountFailed(v0 context.Context, v1 store1.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.CountFailedFunc.nextHook()(v0, v1)
+	m.CountFailedFunc.appendCall(WorkerStoreCountFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountFailed method of
+// the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreCountFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountFailed method of the parent MockWorkerStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *WorkerStoreCountFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreCountFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreCountFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreCountFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreCountFailedFunc[T]) appendCall(r0 WorkerStoreCountFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreCountFailedFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreCountFailedFunc[T]) History() []WorkerStoreCountFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreCountFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreCountFailedFuncCall is an object that describes an invocation
+// of method CountFailed on an instance of MockWorkerStore.
+type WorkerStoreCountFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreCountFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreCountFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// WorkerStoreDeleteFailedFunc describes the behavior when the DeleteFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreDeleteFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) (int, error)
+	history     []WorkerStoreDeleteFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// DeleteFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) DeleteFailed(v0 context.Context, v1 store1.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.DeleteFailedFunc.nextHook()(v0, v1)
+	m.DeleteFailedFunc.appendCall(WorkerStoreDeleteFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DeleteFailed method
+// of the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreDeleteFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteFailed method of the parent MockWorkerStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *WorkerStoreDeleteFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreDeleteFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreDeleteFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreDeleteFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreDeleteFailedFunc[T]) appendCall(r0 WorkerStoreDeleteFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreDeleteFailedFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreDeleteFailedFunc[T]) History() []WorkerStoreDeleteFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreDeleteFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreDeleteFailedFuncCall is an object that describes an invocation
+// of method DeleteFailed on an instance of MockWorkerStore.
+type WorkerStoreDeleteFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreDeleteFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreDeleteFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // WorkerStoreDequeueFunc describes the behavior when the Dequeue method of
 // the parent MockWorkerStore instance is invoked.
 type WorkerStoreDequeueFunc[T workerutil.Record] struct {
@@ -10445,25 +11380,240 @@ type WorkerStoreHandleFunc[T workerutil.Record] struct {
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockWorkerStore[T]) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(WorkerStoreHandleFuncCall[T]{r0})
-	return r0
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(WorkerStoreHandleFuncCall[T]{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockWorkerStore instance is invoked and the hook queue is empty.
+func (f *WorkerStoreHandleFunc[T]) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockWorkerStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *WorkerStoreHandleFunc[T]) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreHandleFunc[T]) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreHandleFunc[T]) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *WorkerStoreHandleFunc[T]) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreHandleFunc[T]) appendCall(r0 WorkerStoreHandleFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreHandleFunc[T]) History() []WorkerStoreHandleFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreHandleFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockWorkerStore.
+type WorkerStoreHandleFuncCall[T workerutil.Record] struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreHandleFuncCall[T]) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreHandleFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// WorkerStoreHeartbeatFunc describes the behavior when the Heartbeat method
+// of the parent MockWorkerStore instance is invoked.
+type WorkerStoreHeartbeatFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
+	hooks       []func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
+	history     []WorkerStoreHeartbeatFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// Heartbeat delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) Heartbeat(v0 context.Context, v1 []string, v2 store1.HeartbeatOptions) ([]string, []string, error) {
+	r0, r1, r2 := m.HeartbeatFunc.nextHook()(v0, v1, v2)
+	m.HeartbeatFunc.appendCall(WorkerStoreHeartbeatFuncCall[T]{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the Heartbeat method of
+// the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Heartbeat method of the parent MockWorkerStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *WorkerStoreHeartbeatFunc[T]) PushHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultReturn(r0 []string, r1 []string, r2 error) {
+	f.SetDefaultHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreHeartbeatFunc[T]) PushReturn(r0 []string, r1 []string, r2 error) {
+	f.PushHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *WorkerStoreHeartbeatFunc[T]) nextHook() func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreHeartbeatFunc[T]) appendCall(r0 WorkerStoreHeartbeatFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreHeartbeatFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreHeartbeatFunc[T]) History() []WorkerStoreHeartbeatFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreHeartbeatFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreHeartbeatFuncCall is an object that describes an invocation of
+// method Heartbeat on an instance of MockWorkerStore.
+type WorkerStoreHeartbeatFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 store1.HeartbeatOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 []string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreHeartbeatFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreHeartbeatFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// WorkerStoreListDequeueCandidatesFunc describes the behavior when the
+// ListDequeueCandidates method of the parent MockWorkerStore instance is
+// invoked.
+type WorkerStoreListDequeueCandidatesFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, int) ([]store1.DequeueCandidate, error)
+	hooks       []func(context.Context, int) ([]store1.DequeueCandidate, error)
+	history     []WorkerStoreListDequeueCandidatesFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// ListDequeueCandidates delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) ListDequeueCandidates(v0 context.Context, v1 int) ([]store1.DequeueCandidate, error) {
+	r0, r1 := m.ListDequeueCandidatesFunc.nextHook()(v0, v1)
+	m.ListDequeueCandidatesFunc.appendCall(WorkerStoreListDequeueCandidatesFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockWorkerStore instance is invoked and the hook queue is empty.
-func (f *WorkerStoreHandleFunc[T]) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the ListDequeueCandidates
+// method of the parent MockWorkerStore instance is invoked and the hook
+// queue is empty.
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) SetDefaultHook(hook func(context.Context, int) ([]store1.DequeueCandidate, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockWorkerStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *WorkerStoreHandleFunc[T]) PushHook(hook func() basestore.TransactableHandle) {
+// ListDequeueCandidates method of the parent MockWorkerStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) PushHook(hook func(context.Context, int) ([]store1.DequeueCandidate, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10471,20 +11621,20 @@ func (f *WorkerStoreHandleFunc[T]) PushHook(hook func() basestore.TransactableHa
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *WorkerStoreHandleFunc[T]) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) SetDefaultReturn(r0 []store1.DequeueCandidate, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]store1.DequeueCandidate, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *WorkerStoreHandleFunc[T]) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) PushReturn(r0 []store1.DequeueCandidate, r1 error) {
+	f.PushHook(func(context.Context, int) ([]store1.DequeueCandidate, error) {
+		return r0, r1
 	})
 }
 
-func (f *WorkerStoreHandleFunc[T]) nextHook() func() basestore.TransactableHandle {
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) nextHook() func(context.Context, int) ([]store1.DequeueCandidate, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10497,72 +11647,80 @@ func (f *WorkerStoreHandleFunc[T]) nextHook() func() basestore.TransactableHandl
 	return hook
 }
 
-func (f *WorkerStoreHandleFunc[T]) appendCall(r0 WorkerStoreHandleFuncCall[T]) {
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) appendCall(r0 WorkerStoreListDequeueCandidatesFuncCall[T]) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of WorkerStoreHandleFuncCall objects
-// describing the invocations of this function.
-func (f *WorkerStoreHandleFunc[T]) History() []WorkerStoreHandleFuncCall[T] {
+// History returns a sequence of WorkerStoreListDequeueCandidatesFuncCall
+// objects describing the invocations of this function.
+func (f *WorkerStoreListDequeueCandidatesFunc[T]) History() []WorkerStoreListDequeueCandidatesFuncCall[T] {
 	f.mutex.Lock()
-	history := make([]WorkerStoreHandleFuncCall[T], len(f.history))
+	history := make([]WorkerStoreListDequeueCandidatesFuncCall[T], len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// WorkerStoreHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockWorkerStore.
-type WorkerStoreHandleFuncCall[T workerutil.Record] struct {
+// WorkerStoreListDequeueCandidatesFuncCall is an object that describes an
+// invocation of method ListDequeueCandidates on an instance of
+// MockWorkerStore.
+type WorkerStoreListDequeueCandidatesFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 []store1.DequeueCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c WorkerStoreHandleFuncCall[T]) Args() []interface{} {
-	return []interface{}{}
+func (c WorkerStoreListDequeueCandidatesFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c WorkerStoreHandleFuncCall[T]) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c WorkerStoreListDequeueCandidatesFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// WorkerStoreHeartbeatFunc describes the behavior when the Heartbeat method
-// of the parent MockWorkerStore instance is invoked.
-type WorkerStoreHeartbeatFunc[T workerutil.Record] struct {
-	defaultHook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
-	hooks       []func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)
-	history     []WorkerStoreHeartbeatFuncCall[T]
+// WorkerStoreListFailedFunc describes the behavior when the ListFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreListFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)
+	history     []WorkerStoreListFailedFuncCall[T]
 	mutex       sync.Mutex
 }
 
-// Heartbeat delegates to the next hook function in the queue and stores the
+// ListFailed delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockWorkerStore[T]) Heartbeat(v0 context.Context, v1 []string, v2 store1.HeartbeatOptions) ([]string, []string, error) {
-	r0, r1, r2 := m.HeartbeatFunc.nextHook()(v0, v1, v2)
-	m.HeartbeatFunc.appendCall(WorkerStoreHeartbeatFuncCall[T]{v0, v1, v2, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockWorkerStore[T]) ListFailed(v0 context.Context, v1 store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+	r0, r1 := m.ListFailedFunc.nextHook()(v0, v1)
+	m.ListFailedFunc.appendCall(WorkerStoreListFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Heartbeat method of
+// SetDefaultHook sets function that is called when the ListFailed method of
 // the parent MockWorkerStore instance is invoked and the hook queue is
 // empty.
-func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+func (f *WorkerStoreListFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Heartbeat method of the parent MockWorkerStore instance invokes the hook
+// ListFailed method of the parent MockWorkerStore instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *WorkerStoreHeartbeatFunc[T]) PushHook(hook func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error)) {
+func (f *WorkerStoreListFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10570,20 +11728,20 @@ func (f *WorkerStoreHeartbeatFunc[T]) PushHook(hook func(context.Context, []stri
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *WorkerStoreHeartbeatFunc[T]) SetDefaultReturn(r0 []string, r1 []string, r2 error) {
-	f.SetDefaultHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
-		return r0, r1, r2
+func (f *WorkerStoreListFailedFunc[T]) SetDefaultReturn(r0 []store1.FailedRecord, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *WorkerStoreHeartbeatFunc[T]) PushReturn(r0 []string, r1 []string, r2 error) {
-	f.PushHook(func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
-		return r0, r1, r2
+func (f *WorkerStoreListFailedFunc[T]) PushReturn(r0 []store1.FailedRecord, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
+		return r0, r1
 	})
 }
 
-func (f *WorkerStoreHeartbeatFunc[T]) nextHook() func(context.Context, []string, store1.HeartbeatOptions) ([]string, []string, error) {
+func (f *WorkerStoreListFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) ([]store1.FailedRecord, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10596,56 +11754,48 @@ func (f *WorkerStoreHeartbeatFunc[T]) nextHook() func(context.Context, []string,
 	return hook
 }
 
-func (f *WorkerStoreHeartbeatFunc[T]) appendCall(r0 WorkerStoreHeartbeatFuncCall[T]) {
+func (f *WorkerStoreListFailedFunc[T]) appendCall(r0 WorkerStoreListFailedFuncCall[T]) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of WorkerStoreHeartbeatFuncCall objects
+// History returns a sequence of WorkerStoreListFailedFuncCall objects
 // describing the invocations of this function.
-func (f *WorkerStoreHeartbeatFunc[T]) History() []WorkerStoreHeartbeatFuncCall[T] {
+func (f *WorkerStoreListFailedFunc[T]) History() []WorkerStoreListFailedFuncCall[T] {
 	f.mutex.Lock()
-	history := make([]WorkerStoreHeartbeatFuncCall[T], len(f.history))
+	history := make([]WorkerStoreListFailedFuncCall[T], len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// WorkerStoreHeartbeatFuncCall is an object that describes an invocation of
-// method Heartbeat on an instance of MockWorkerStore.
-type WorkerStoreHeartbeatFuncCall[T workerutil.Record] struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
+// WorkerStoreListFailedFuncCall is an object that describes an invocation of
+// method ListFailed on an instance of MockWorkerStore.
+type WorkerStoreListFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 []string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 store1.HeartbeatOptions
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 []store1.FailedRecord
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 []string
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c WorkerStoreHeartbeatFuncCall[T]) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c WorkerStoreListFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c WorkerStoreHeartbeatFuncCall[T]) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c WorkerStoreListFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
 // WorkerStoreMarkCompleteFunc describes the behavior when the MarkComplete
@@ -11094,6 +12244,112 @@ func (c WorkerStoreMaxDurationInQueueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// WorkerStoreQueueStatisticsFunc describes the behavior when the
+// QueueStatistics method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreQueueStatisticsFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, time.Duration) (store1.QueueStatistics, error)
+	hooks       []func(context.Context, time.Duration) (store1.QueueStatistics, error)
+	history     []WorkerStoreQueueStatisticsFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// QueueStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) QueueStatistics(v0 context.Context, v1 time.Duration) (store1.QueueStatistics, error) {
+	r0, r1 := m.QueueStatisticsFunc.nextHook()(v0, v1)
+	m.QueueStatisticsFunc.appendCall(WorkerStoreQueueStatisticsFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueueStatistics
+// method of the parent MockWorkerStore instance is invoked and the hook
+// queue is empty.
+func (f *WorkerStoreQueueStatisticsFunc[T]) SetDefaultHook(hook func(context.Context, time.Duration) (store1.QueueStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueueStatistics method of the parent MockWorkerStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *WorkerStoreQueueStatisticsFunc[T]) PushHook(hook func(context.Context, time.Duration) (store1.QueueStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreQueueStatisticsFunc[T]) SetDefaultReturn(r0 store1.QueueStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreQueueStatisticsFunc[T]) PushReturn(r0 store1.QueueStatistics, r1 error) {
+	f.PushHook(func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreQueueStatisticsFunc[T]) nextHook() func(context.Context, time.Duration) (store1.QueueStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreQueueStatisticsFunc[T]) appendCall(r0 WorkerStoreQueueStatisticsFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreQueueStatisticsFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreQueueStatisticsFunc[T]) History() []WorkerStoreQueueStatisticsFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreQueueStatisticsFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreQueueStatisticsFuncCall is an object that describes an
+// invocation of method QueueStatistics on an instance of MockWorkerStore.
+type WorkerStoreQueueStatisticsFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 time.Duration
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 store1.QueueStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreQueueStatisticsFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreQueueStatisticsFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // WorkerStoreQueuedCountFunc describes the behavior when the QueuedCount
 // method of the parent MockWorkerStore instance is invoked.
 type WorkerStoreQueuedCountFunc[T workerutil.Record] struct {
@@ -11310,6 +12566,112 @@ func (c WorkerStoreRequeueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// WorkerStoreRequeueFailedFunc describes the behavior when the RequeueFailed
+// method of the parent MockWorkerStore instance is invoked.
+type WorkerStoreRequeueFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store1.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store1.FailedRecordsOptions) (int, error)
+	history     []WorkerStoreRequeueFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// RequeueFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockWorkerStore[T]) RequeueFailed(v0 context.Context, v1 store1.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.RequeueFailedFunc.nextHook()(v0, v1)
+	m.RequeueFailedFunc.appendCall(WorkerStoreRequeueFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RequeueFailed method
+// of the parent MockWorkerStore instance is invoked and the hook queue is
+// empty.
+func (f *WorkerStoreRequeueFailedFunc[T]) SetDefaultHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RequeueFailed method of the parent MockWorkerStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *WorkerStoreRequeueFailedFunc[T]) PushHook(hook func(context.Context, store1.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *WorkerStoreRequeueFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *WorkerStoreRequeueFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store1.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *WorkerStoreRequeueFailedFunc[T]) nextHook() func(context.Context, store1.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *WorkerStoreRequeueFailedFunc[T]) appendCall(r0 WorkerStoreRequeueFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of WorkerStoreRequeueFailedFuncCall objects
+// describing the invocations of this function.
+func (f *WorkerStoreRequeueFailedFunc[T]) History() []WorkerStoreRequeueFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]WorkerStoreRequeueFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// WorkerStoreRequeueFailedFuncCall is an object that describes an invocation
+// of method RequeueFailed on an instance of MockWorkerStore.
+type WorkerStoreRequeueFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store1.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c WorkerStoreRequeueFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c WorkerStoreRequeueFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // WorkerStoreResetStalledFunc describes the behavior when the ResetStalled
 // method of the parent MockWorkerStore instance is invoked.
 type WorkerStoreResetStalledFunc[T workerutil.Record] struct {
@@ -0,0 +1,108 @@
+package lsifstore
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func (s *store) GetSCIPMetadata(ctx context.Context, uploadID int) (_ ProcessedMetadata, _ bool, err error) {
+	ctx, _, endObservation := s.operations.getSCIPMetadata.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanFirstMetadata(s.db.Query(ctx, sqlf.Sprintf(getSCIPMetadataQuery, uploadID)))
+}
+
+const getSCIPMetadataQuery = `
+SELECT
+	text_document_encoding,
+	tool_name,
+	tool_version,
+	tool_arguments,
+	protocol_version
+FROM codeintel_scip_metadata
+WHERE upload_id = %s
+`
+
+var scanFirstMetadata = basestore.NewFirstScanner(func(s dbutil.Scanner) (meta ProcessedMetadata, err error) {
+	err = s.Scan(
+		&meta.TextDocumentEncoding,
+		&meta.ToolName,
+		&meta.ToolVersion,
+		pq.Array(&meta.ToolArguments),
+		&meta.ProtocolVersion,
+	)
+	return meta, err
+})
+
+func (s *store) ScanDocuments(ctx context.Context, uploadID int, f func(path string, document *scip.Document) error) (err error) {
+	ctx, trace, endObservation := s.operations.scanDocuments.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rows, err := s.db.Query(ctx, sqlf.Sprintf(getDocumentsByUploadIDQuery, uploadID))
+	if err != nil {
+		return err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	numDocuments := 0
+	for rows.Next() {
+		var path string
+		var compressedSCIPPayload []byte
+		if err := rows.Scan(&path, &compressedSCIPPayload); err != nil {
+			return err
+		}
+
+		scipPayload, err := shared.Decompressor.Decompress(bytes.NewReader(compressedSCIPPayload))
+		if err != nil {
+			return err
+		}
+
+		var document scip.Document
+		if err := proto.Unmarshal(scipPayload, &document); err != nil {
+			return err
+		}
+
+		// The relative path is stripped from the payload during canonicalization so
+		// that renamed documents can share a payload; restore it for the consumer.
+		document.RelativePath = path
+
+		if err := f(path, &document); err != nil {
+			return err
+		}
+		numDocuments++
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numDocuments", numDocuments))
+
+	return nil
+}
+
+func (s *store) GetDocumentPaths(ctx context.Context, uploadID int) (_ []string, err error) {
+	ctx, _, endObservation := s.operations.getDocumentPaths.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return basestore.ScanStrings(s.db.Query(ctx, sqlf.Sprintf(getDocumentPathsQuery, uploadID)))
+}
+
+const getDocumentPathsQuery = `
+SELECT document_path
+FROM codeintel_scip_document_lookup
+WHERE upload_id = %s
+ORDER BY document_path
+`
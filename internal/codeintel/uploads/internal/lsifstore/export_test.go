@@ -0,0 +1,119 @@
+package lsifstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	codeintelshared "github.com/sourcegraph/sourcegraph/internal/codeintel/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetSCIPMetadata(t *testing.T) {
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(t))
+	store := New(&observation.TestContext, codeIntelDB)
+	ctx := context.Background()
+
+	if _, ok, err := store.GetSCIPMetadata(ctx, 42); err != nil {
+		t.Fatalf("unexpected error getting metadata: %s", err)
+	} else if ok {
+		t.Fatalf("unexpected metadata for unknown upload")
+	}
+
+	expected := ProcessedMetadata{
+		TextDocumentEncoding: "UTF8",
+		ToolName:             "scip-test",
+		ToolVersion:          "0.1.0",
+		ToolArguments:        []string{"-p", "src"},
+		ProtocolVersion:      1,
+	}
+	if err := store.InsertMetadata(ctx, 42, expected); err != nil {
+		t.Fatalf("failed to insert metadata: %s", err)
+	}
+
+	meta, ok, err := store.GetSCIPMetadata(ctx, 42)
+	if err != nil {
+		t.Fatalf("unexpected error getting metadata: %s", err)
+	} else if !ok {
+		t.Fatalf("expected metadata")
+	}
+	if diff := cmp.Diff(expected, meta); diff != "" {
+		t.Errorf("unexpected metadata (-want +got):\n%s", diff)
+	}
+}
+
+func TestScanDocuments(t *testing.T) {
+	logger := logtest.Scoped(t)
+	codeIntelDB := codeintelshared.NewCodeIntelDB(logger, dbtest.NewDB(t))
+	store := New(&observation.TestContext, codeIntelDB)
+	ctx := context.Background()
+
+	documents := map[string]*scip.Document{
+		"cmd/main.go": {
+			Symbols: []*scip.SymbolInformation{
+				{
+					Symbol: "scip-go gomod test 0.1.0 `test/cmd`/main().",
+					Relationships: []*scip.Relationship{
+						{Symbol: "scip-go gomod test 0.1.0 `test/lib`/Runner#Run().", IsImplementation: true},
+					},
+				},
+			},
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{3, 5, 9}, Symbol: "scip-go gomod test 0.1.0 `test/cmd`/main().", SymbolRoles: int32(scip.SymbolRole_Definition)},
+			},
+		},
+		"lib/lib.go": {
+			Symbols: []*scip.SymbolInformation{
+				{Symbol: "scip-go gomod test 0.1.0 `test/lib`/Runner#Run()."},
+			},
+		},
+	}
+
+	if err := store.WithTransaction(ctx, func(tx Store) error {
+		scipWriter, err := tx.NewSCIPWriter(ctx, 42)
+		if err != nil {
+			return err
+		}
+		for path, document := range documents {
+			if err := scipWriter.InsertDocument(ctx, path, document); err != nil {
+				return err
+			}
+		}
+		_, err = scipWriter.Flush(ctx)
+		return err
+	}); err != nil {
+		t.Fatalf("failed to write SCIP documents: %s", err)
+	}
+
+	var paths []string
+	if err := store.ScanDocuments(ctx, 42, func(path string, document *scip.Document) error {
+		paths = append(paths, path)
+
+		expected := documents[path]
+		expected.RelativePath = path
+		if diff := cmp.Diff(expected, document, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected document for %q (-want +got):\n%s", path, diff)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error scanning documents: %s", err)
+	}
+
+	if diff := cmp.Diff([]string{"cmd/main.go", "lib/lib.go"}, paths); diff != "" {
+		t.Errorf("unexpected paths (-want +got):\n%s", diff)
+	}
+
+	documentPaths, err := store.GetDocumentPaths(ctx, 42)
+	if err != nil {
+		t.Fatalf("unexpected error getting document paths: %s", err)
+	}
+	if diff := cmp.Diff([]string{"cmd/main.go", "lib/lib.go"}, documentPaths); diff != "" {
+		t.Errorf("unexpected document paths (-want +got):\n%s", diff)
+	}
+}
@@ -15,6 +15,9 @@ type operations struct {
 	deleteLsifDataByUploadIds                 *observation.Operation
 	deleteUnreferencedDocuments               *observation.Operation
 	insertDefinitionsAndReferencesForDocument *observation.Operation
+	getSCIPMetadata                           *observation.Operation
+	scanDocuments                             *observation.Operation
+	getDocumentPaths                          *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -45,5 +48,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 		deleteLsifDataByUploadIds:                 op("DeleteLsifDataByUploadIds"),
 		deleteUnreferencedDocuments:               op("DeleteUnreferencedDocuments"),
 		insertDefinitionsAndReferencesForDocument: op("InsertDefinitionsAndReferencesForDocument"),
+		getSCIPMetadata:                           op("GetSCIPMetadata"),
+		scanDocuments:                             op("ScanDocuments"),
+		getDocumentPaths:                          op("GetDocumentPaths"),
 	}
 }
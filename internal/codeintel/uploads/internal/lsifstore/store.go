@@ -29,6 +29,9 @@ type Store interface {
 
 	// Scan/export document data
 	InsertDefinitionsAndReferencesForDocument(ctx context.Context, upload shared.ExportedUpload, rankingGraphKey string, rankingBatchSize int, f func(ctx context.Context, upload shared.ExportedUpload, rankingBatchSize int, rankingGraphKey, path string, document *scip.Document) error) (err error)
+	GetSCIPMetadata(ctx context.Context, uploadID int) (ProcessedMetadata, bool, error)
+	ScanDocuments(ctx context.Context, uploadID int, f func(path string, document *scip.Document) error) error
+	GetDocumentPaths(ctx context.Context, uploadID int) ([]string, error)
 }
 
 type SCIPWriter interface {
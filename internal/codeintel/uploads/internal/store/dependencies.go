@@ -5,11 +5,13 @@ import (
 	"database/sql"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
@@ -226,3 +228,65 @@ func (s *sliceScanner) Next() (shared.PackageReference, bool, error) {
 func (s *sliceScanner) Close() error {
 	return nil
 }
+
+// GetRepositoryPackages returns the packages provided by the precise indexes visible at the tip
+// of the default branch of each repository matching the given options.
+func (s *store) GetRepositoryPackages(ctx context.Context, opts shared.GetRepositoryPackagesOptions) (_ []shared.RepositoryPackage, err error) {
+	ctx, _, endObservation := s.operations.getRepositoryPackages.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.IntSlice("repositoryIDs", opts.RepositoryIDs),
+		attribute.StringSlice("names", opts.Names),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.getRepositoryPackages(ctx, sqlf.Sprintf("lsif_packages"), opts)
+}
+
+// GetRepositoryPackageReferences returns the packages referenced by the precise indexes visible at
+// the tip of the default branch of each repository matching the given options.
+func (s *store) GetRepositoryPackageReferences(ctx context.Context, opts shared.GetRepositoryPackagesOptions) (_ []shared.RepositoryPackage, err error) {
+	ctx, _, endObservation := s.operations.getRepositoryPackageReferences.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.IntSlice("repositoryIDs", opts.RepositoryIDs),
+		attribute.StringSlice("names", opts.Names),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.getRepositoryPackages(ctx, sqlf.Sprintf("lsif_references"), opts)
+}
+
+func (s *store) getRepositoryPackages(ctx context.Context, tableName *sqlf.Query, opts shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+	if len(opts.RepositoryIDs) == 0 && len(opts.Names) == 0 {
+		return nil, nil
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("vt.is_default_branch")}
+	if len(opts.RepositoryIDs) > 0 {
+		conds = append(conds, sqlf.Sprintf("vt.repository_id = ANY(%s)", pq.Array(opts.RepositoryIDs)))
+	}
+	if len(opts.Names) > 0 {
+		conds = append(conds, sqlf.Sprintf("p.name = ANY(%s)", pq.Array(opts.Names)))
+	}
+
+	return scanRepositoryPackages(s.db.Query(ctx, sqlf.Sprintf(getRepositoryPackagesQuery, tableName, sqlf.Join(conds, " AND "))))
+}
+
+const getRepositoryPackagesQuery = `
+SELECT DISTINCT
+	vt.repository_id,
+	p.scheme,
+	p.manager,
+	p.name,
+	COALESCE(p.version, '') AS version
+FROM lsif_uploads_visible_at_tip vt
+JOIN %s p ON p.dump_id = vt.upload_id
+JOIN repo r ON r.id = vt.repository_id
+WHERE
+	r.deleted_at IS NULL AND
+	r.blocked IS NULL AND
+	%s
+ORDER BY vt.repository_id, p.scheme, p.manager, p.name, version
+`
+
+var scanRepositoryPackages = basestore.NewSliceScanner(func(s dbutil.Scanner) (p shared.RepositoryPackage, _ error) {
+	err := s.Scan(&p.RepositoryID, &p.Scheme, &p.Manager, &p.Name, &p.Version)
+	return p, err
+})
@@ -137,3 +137,57 @@ func TestUpdatePackageReferences(t *testing.T) {
 		t.Errorf("unexpected reference count. want=%d have=%d", 10, count)
 	}
 }
+
+func TestGetRepositoryPackages(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	store := New(&observation.TestContext, db)
+
+	insertUploads(t, db,
+		shared.Upload{ID: 1, RepositoryID: 50},
+		shared.Upload{ID: 2, RepositoryID: 51},
+		shared.Upload{ID: 3, RepositoryID: 52},
+		shared.Upload{ID: 4, RepositoryID: 53, RepositoryName: "DELETED-53"},
+		shared.Upload{ID: 5, RepositoryID: 50, Commit: makeCommit(15)},
+	)
+	insertVisibleAtTip(t, db, 50, 1)
+	insertVisibleAtTip(t, db, 51, 2)
+	insertVisibleAtTipInternal(t, db, 52, false, 3)
+	insertVisibleAtTip(t, db, 53, 4)
+
+	insertPackages(t, store, []shared.Package{
+		{DumpID: 1, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.0.0"},
+		{DumpID: 2, Scheme: "npm", Manager: "npm", Name: "rightpad", Version: "2.0.0"},
+		{DumpID: 3, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.1.0"}, // not on default branch
+		{DumpID: 4, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.2.0"}, // deleted repository
+		{DumpID: 5, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.3.0"}, // not visible at tip
+	})
+	insertPackageReferences(t, store, []shared.PackageReference{
+		{Package: shared.Package{DumpID: 2, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.0.0"}},
+		{Package: shared.Package{DumpID: 1, Scheme: "npm", Manager: "npm", Name: "rightpad", Version: "2.0.0"}},
+		{Package: shared.Package{DumpID: 3, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.0.0"}},
+	})
+
+	packages, err := store.GetRepositoryPackages(context.Background(), shared.GetRepositoryPackagesOptions{Names: []string{"leftpad"}})
+	if err != nil {
+		t.Fatalf("unexpected error getting repository packages: %s", err)
+	}
+	expectedPackages := []shared.RepositoryPackage{
+		{RepositoryID: 50, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.0.0"},
+	}
+	if diff := cmp.Diff(expectedPackages, packages); diff != "" {
+		t.Errorf("unexpected packages (-want +got):\n%s", diff)
+	}
+
+	references, err := store.GetRepositoryPackageReferences(context.Background(), shared.GetRepositoryPackagesOptions{RepositoryIDs: []int{50, 51, 52}})
+	if err != nil {
+		t.Fatalf("unexpected error getting repository package references: %s", err)
+	}
+	expectedReferences := []shared.RepositoryPackage{
+		{RepositoryID: 50, Scheme: "npm", Manager: "npm", Name: "rightpad", Version: "2.0.0"},
+		{RepositoryID: 51, Scheme: "npm", Manager: "npm", Name: "leftpad", Version: "1.0.0"},
+	}
+	if diff := cmp.Diff(expectedReferences, references); diff != "" {
+		t.Errorf("unexpected package references (-want +got):\n%s", diff)
+	}
+}
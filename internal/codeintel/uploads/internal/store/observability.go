@@ -53,6 +53,7 @@ type operations struct {
 	deleteUploadsStuckUploading          *observation.Operation
 	softDeleteExpiredUploadsViaTraversal *observation.Operation
 	softDeleteExpiredUploads             *observation.Operation
+	softDeleteFailedUploads              *observation.Operation
 	hardDeleteUploadsByIDs               *observation.Operation
 	deleteUploadByID                     *observation.Operation
 	insertUpload                         *observation.Operation
@@ -79,7 +80,9 @@ type operations struct {
 	deleteOldAuditLogs *observation.Operation
 
 	// Dependencies
-	insertDependencySyncingJob *observation.Operation
+	insertDependencySyncingJob     *observation.Operation
+	getRepositoryPackages          *observation.Operation
+	getRepositoryPackageReferences *observation.Operation
 
 	reindexUploads                 *observation.Operation
 	reindexUploadByID              *observation.Operation
@@ -155,6 +158,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		softDeleteExpiredUploadsViaTraversal: op("SoftDeleteExpiredUploadsViaTraversal"),
 		deleteUploadsWithoutRepository:       op("DeleteUploadsWithoutRepository"),
 		softDeleteExpiredUploads:             op("SoftDeleteExpiredUploads"),
+		softDeleteFailedUploads:              op("SoftDeleteFailedUploads"),
 		hardDeleteUploadsByIDs:               op("HardDeleteUploadsByIDs"),
 		deleteUploadByID:                     op("DeleteUploadByID"),
 		insertUpload:                         op("InsertUpload"),
@@ -186,7 +190,9 @@ func newOperations(observationCtx *observation.Context) *operations {
 		deleteOldAuditLogs: op("DeleteOldAuditLogs"),
 
 		// Dependencies
-		insertDependencySyncingJob: op("InsertDependencySyncingJob"),
+		insertDependencySyncingJob:     op("InsertDependencySyncingJob"),
+		getRepositoryPackages:          op("GetRepositoryPackages"),
+		getRepositoryPackageReferences: op("GetRepositoryPackageReferences"),
 
 		reindexUploads:                 op("ReindexUploads"),
 		reindexUploadByID:              op("ReindexUploadByID"),
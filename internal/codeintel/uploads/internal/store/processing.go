@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
@@ -173,10 +174,91 @@ updated AS (
 SELECT COUNT(*) FROM updated
 `
 
+// WorkerutilStore returns a dbworker store for uploads. Failed uploads deleted through the
+// returned store are soft deleted, so that the janitors clean up the data of the upload and
+// its record like for any other deleted upload.
 func (s *store) WorkerutilStore(observationCtx *observation.Context) dbworkerstore.Store[shared.Upload] {
-	return dbworkerstore.New(observationCtx, s.db.Handle(), UploadWorkerStoreOptions)
+	return &workerutilStore{
+		Store: dbworkerstore.New(observationCtx, s.db.Handle(), UploadWorkerStoreOptions),
+		store: s,
+	}
+}
+
+type workerutilStore struct {
+	dbworkerstore.Store[shared.Upload]
+	store *store
+}
+
+func (s *workerutilStore) DeleteFailed(ctx context.Context, opts dbworkerstore.FailedRecordsOptions) (int, error) {
+	return s.store.softDeleteFailedUploads(ctx, opts)
+}
+
+// softDeleteFailedUploads soft deletes the failed uploads matching the given options. Failed
+// uploads are never part of the commit graph, so their repositories don't need to be marked
+// as dirty. This method returns the number of deleted uploads.
+func (s *store) softDeleteFailedUploads(ctx context.Context, opts dbworkerstore.FailedRecordsOptions) (_ int, err error) {
+	ctx, trace, endObservation := s.operations.softDeleteFailedUploads.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("failureMessage", opts.FailureMessage),
+		attribute.Stringer("failedBefore", opts.FailedBefore),
+		attribute.Int("limit", opts.Limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	unset, _ := s.db.SetLocal(ctx, "codeintel.lsif_uploads_audit.reason", "failed upload deleted from the dead-letter queue")
+	defer unset(ctx)
+
+	limit := sqlf.Sprintf("")
+	if opts.Limit > 0 {
+		limit = sqlf.Sprintf("LIMIT %s", opts.Limit)
+	}
+
+	count, _, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(
+		softDeleteFailedUploadsQuery,
+		sqlf.Join(opts.ToSQLConds(formatUploadColumns), "AND"),
+		limit,
+	)))
+	if err != nil {
+		return 0, err
+	}
+	trace.AddEvent("SoftDeleteFailedUploads", attribute.Int("count", count))
+
+	return count, nil
 }
 
+const softDeleteFailedUploadsQuery = `
+WITH
+candidates AS (
+	SELECT u.id
+	FROM lsif_uploads u
+	WHERE %s
+
+	-- Lock these rows in a deterministic order so that we don't
+	-- deadlock with other processes updating the lsif_uploads table.
+	ORDER BY u.id
+	%s
+	FOR UPDATE SKIP LOCKED
+),
+deleted AS (
+	UPDATE lsif_uploads u
+	SET state = 'deleted'
+	WHERE id IN (SELECT id FROM candidates)
+	RETURNING 1
+)
+SELECT COUNT(*) FROM deleted
+`
+
+// formatUploadColumns replaces the column placeholders of dbworker queries with the columns
+// of the lsif_uploads table aliased as u.
+func formatUploadColumns(query string, args ...any) *sqlf.Query {
+	return sqlf.Sprintf(uploadColumnReplacer.Replace(query), args...)
+}
+
+var uploadColumnReplacer = strings.NewReplacer(
+	"{state}", "u.state",
+	"{failure_message}", "u.failure_message",
+	"{finished_at}", "u.finished_at",
+)
+
 //
 //
 
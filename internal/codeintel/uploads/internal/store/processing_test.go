@@ -12,7 +12,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 )
 
 func TestInsertUploadUploading(t *testing.T) {
@@ -277,6 +279,39 @@ func TestMarkFailed(t *testing.T) {
 	}
 }
 
+func TestWorkerutilStoreDeleteFailed(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	store := New(&observation.TestContext, db)
+
+	timeout := "connection timeout"
+	invalid := "invalid input"
+	finishedAt := time.Unix(1587396557, 0).UTC()
+
+	insertUploads(t, db,
+		shared.Upload{ID: 1, Commit: makeCommit(1111), State: "failed", FailureMessage: &timeout, FinishedAt: &finishedAt},
+		shared.Upload{ID: 2, Commit: makeCommit(1112), State: "failed", FailureMessage: &invalid, FinishedAt: &finishedAt},
+		shared.Upload{ID: 3, Commit: makeCommit(1113), State: "completed", FailureMessage: &timeout, FinishedAt: &finishedAt},
+	)
+
+	count, err := store.WorkerutilStore(&observation.TestContext).DeleteFailed(context.Background(), dbworkerstore.FailedRecordsOptions{FailureMessage: "timeout"})
+	if err != nil {
+		t.Fatalf("unexpected error deleting failed uploads: %s", err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected count. want=%d have=%d", 1, count)
+	}
+
+	// Failed uploads are soft deleted, so that the janitors clean them up.
+	states, err := basestore.ScanStrings(db.QueryContext(context.Background(), "SELECT state FROM lsif_uploads ORDER BY id"))
+	if err != nil {
+		t.Fatalf("unexpected error querying uploads: %s", err)
+	}
+	if diff := cmp.Diff([]string{"deleted", "failed", "completed"}, states); diff != "" {
+		t.Errorf("unexpected states (-want +got):\n%s", diff)
+	}
+}
+
 func TestDeleteOverlappingDumps(t *testing.T) {
 	logger := logtest.Scoped(t)
 	sqlDB := dbtest.NewDB(t)
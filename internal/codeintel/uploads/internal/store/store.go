@@ -55,6 +55,8 @@ type Store interface {
 	ReferencesForUpload(ctx context.Context, uploadID int) (shared.PackageReferenceScanner, error)
 	UpdatePackages(ctx context.Context, dumpID int, packages []precise.Package) error
 	UpdatePackageReferences(ctx context.Context, dumpID int, references []precise.PackageReference) error
+	GetRepositoryPackages(ctx context.Context, opts shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
+	GetRepositoryPackageReferences(ctx context.Context, opts shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error)
 
 	// Summary
 	GetIndexers(ctx context.Context, opts shared.GetIndexersOptions) ([]string, error)
@@ -14,7 +14,10 @@ import (
 )
 
 type operations struct {
-	inferClosestUploads *observation.Operation
+	inferClosestUploads       *observation.Operation
+	exportSCIPIndex           *observation.Operation
+	getRepositoryDependencies *observation.Operation
+	getPackageDependents      *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -38,7 +41,10 @@ func newOperations(observationCtx *observation.Context) *operations {
 	}
 
 	return &operations{
-		inferClosestUploads: op("InferClosestUploads"),
+		inferClosestUploads:       op("InferClosestUploads"),
+		exportSCIPIndex:           op("ExportSCIPIndex"),
+		getRepositoryDependencies: op("GetRepositoryDependencies"),
+		getPackageDependents:      op("GetPackageDependents"),
 	}
 }
 
@@ -2,9 +2,12 @@ package uploads
 
 import (
 	"context"
+	"io"
 	"time"
 
+	"github.com/sourcegraph/scip/bindings/go/scip"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/lsifstore"
@@ -13,6 +16,7 @@ import (
 	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -81,6 +85,12 @@ func (s *Service) DeleteUploads(ctx context.Context, opts shared.DeleteUploadsOp
 	return s.store.DeleteUploads(ctx, opts)
 }
 
+// DeadLetterStore returns the store managing failed uploads. Deleting failed uploads through
+// it soft deletes them, so that the janitors clean up their data.
+func (s *Service) DeadLetterStore(observationCtx *observation.Context) dbworkerstore.DeadLetterStore {
+	return s.store.WorkerutilStore(observationCtx)
+}
+
 func (s *Service) GetRepositoriesMaxStaleAge(ctx context.Context) (_ time.Duration, err error) {
 	return s.store.GetRepositoriesMaxStaleAge(ctx)
 }
@@ -184,6 +194,12 @@ func (s *Service) GetAuditLogsForUpload(ctx context.Context, uploadID int) ([]sh
 	return s.store.GetAuditLogsForUpload(ctx, uploadID)
 }
 
+// GetUploadDocumentPaths returns the paths of all documents with precise data in the given upload,
+// relative to the root of the upload.
+func (s *Service) GetUploadDocumentPaths(ctx context.Context, uploadID int) ([]string, error) {
+	return s.lsifstore.GetDocumentPaths(ctx, uploadID)
+}
+
 // func (s *Service) GetUploadDocumentsForPath(ctx context.Context, bundleID int, pathPattern string) ([]string, int, error) {
 // 	return s.lsifstore.GetUploadDocumentsForPath(ctx, bundleID, pathPattern)
 // }
@@ -243,3 +259,63 @@ func (s *Service) NumRepositoriesWithCodeIntelligence(ctx context.Context) (int,
 func (s *Service) RepositoryIDsWithErrors(ctx context.Context, offset, limit int) ([]uploadsshared.RepositoryWithCount, int, error) {
 	return s.store.RepositoryIDsWithErrors(ctx, offset, limit)
 }
+
+// ExportSCIPIndex reconstructs a SCIP index from the processed data of the given upload and
+// writes it to w as a serialized Index message. Documents are written one at a time (relying on
+// protobuf concatenation semantics for repeated fields) so that large indexes are never held in
+// memory in their entirety. Document paths are relative to the root of the upload. Documents for
+// which includeDocument returns false are omitted. This method returns false if the upload has
+// no processed SCIP data.
+//
+// External symbols are not exported separately, as they are denormalized into the documents that
+// reference them during processing.
+func (s *Service) ExportSCIPIndex(ctx context.Context, uploadID int, w io.Writer, includeDocument func(path string) (bool, error)) (_ bool, err error) {
+	ctx, _, endObservation := s.operations.exportSCIPIndex.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	meta, ok, err := s.lsifstore.GetSCIPMetadata(ctx, uploadID)
+	if err != nil {
+		return false, errors.Wrap(err, "lsifstore.GetSCIPMetadata")
+	}
+	if !ok {
+		return false, nil
+	}
+
+	writeIndex := func(index *scip.Index) error {
+		payload, err := proto.Marshal(index)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(payload)
+		return err
+	}
+
+	if err := writeIndex(&scip.Index{
+		Metadata: &scip.Metadata{
+			Version: scip.ProtocolVersion(meta.ProtocolVersion),
+			ToolInfo: &scip.ToolInfo{
+				Name:      meta.ToolName,
+				Version:   meta.ToolVersion,
+				Arguments: meta.ToolArguments,
+			},
+			TextDocumentEncoding: scip.TextEncoding(scip.TextEncoding_value[meta.TextDocumentEncoding]),
+		},
+	}); err != nil {
+		return false, err
+	}
+
+	if err := s.lsifstore.ScanDocuments(ctx, uploadID, func(path string, document *scip.Document) error {
+		if ok, err := includeDocument(path); err != nil || !ok {
+			return err
+		}
+
+		return writeIndex(&scip.Index{Documents: []*scip.Document{document}})
+	}); err != nil {
+		return false, errors.Wrap(err, "lsifstore.ScanDocuments")
+	}
+
+	return true, nil
+}
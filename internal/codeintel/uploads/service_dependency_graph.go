@@ -0,0 +1,453 @@
+package uploads
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Masterminds/semver"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// defaultDependencyGraphDepth is the depth of a transitive query that does not specify one.
+	defaultDependencyGraphDepth = 5
+
+	// maxDependencyGraphDepth is the largest depth a transitive query may request.
+	maxDependencyGraphDepth = 20
+
+	// maxDependencyGraphNodes bounds the number of repositories in a single graph. Once reached,
+	// edges to repositories already in the graph are still recorded but no new nodes are added.
+	maxDependencyGraphNodes = 1000
+)
+
+// GetRepositoryDependencies returns the graph of repositories providing the packages referenced
+// by the precise indexes at the tip of the default branch of the given repository.
+func (s *Service) GetRepositoryDependencies(ctx context.Context, repositoryID int, opts shared.DependencyGraphOptions) (_ shared.DependencyGraph, err error) {
+	ctx, _, endObservation := s.operations.getRepositoryDependencies.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.Bool("transitive", opts.Transitive),
+		attribute.Int("maxDepth", opts.MaxDepth),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	g := newDependencyGraphBuilder(s.repoStore)
+	if ok, err := g.addNode(ctx, repositoryID, 0); err != nil || !ok {
+		return shared.DependencyGraph{}, err
+	}
+
+	frontier := []int{repositoryID}
+	for depth := 1; depth <= dependencyGraphDepth(opts) && len(frontier) > 0; depth++ {
+		references, err := s.store.GetRepositoryPackageReferences(ctx, shared.GetRepositoryPackagesOptions{RepositoryIDs: frontier})
+		if err != nil {
+			return shared.DependencyGraph{}, errors.Wrap(err, "store.GetRepositoryPackageReferences")
+		}
+
+		providers, err := s.store.GetRepositoryPackages(ctx, shared.GetRepositoryPackagesOptions{Names: packageNames(references)})
+		if err != nil {
+			return shared.DependencyGraph{}, errors.Wrap(err, "store.GetRepositoryPackages")
+		}
+		providersByPackage := groupRepositoriesByPackage(providers)
+
+		frontier = frontier[:0:0]
+		for _, reference := range references {
+			next, err := g.addReference(ctx, reference, providersByPackage[packageKeyOf(reference)], depth, false)
+			if err != nil {
+				return shared.DependencyGraph{}, err
+			}
+			frontier = append(frontier, next...)
+		}
+	}
+
+	return g.graph(), nil
+}
+
+// GetPackageDependents returns the graph of repositories whose precise indexes at the tip of the
+// default branch reference a package matching the given filter.
+func (s *Service) GetPackageDependents(ctx context.Context, filter shared.PackageFilter, opts shared.DependencyGraphOptions) (_ shared.DependencyGraph, err error) {
+	ctx, _, endObservation := s.operations.getPackageDependents.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("scheme", filter.Scheme),
+		attribute.String("manager", filter.Manager),
+		attribute.String("name", filter.Name),
+		attribute.String("versionConstraint", filter.VersionConstraint),
+		attribute.Bool("transitive", opts.Transitive),
+		attribute.Int("maxDepth", opts.MaxDepth),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if filter.Name == "" {
+		return shared.DependencyGraph{}, errors.New("a package name is required")
+	}
+	matches := newPackageMatcher(filter)
+
+	g := newDependencyGraphBuilder(s.repoStore)
+
+	// Seed the graph with the references to the requested package. Repositories providing the
+	// package sit at depth zero; the repositories referencing it sit at depth one.
+	references, err := s.store.GetRepositoryPackageReferences(ctx, shared.GetRepositoryPackagesOptions{Names: []string{filter.Name}})
+	if err != nil {
+		return shared.DependencyGraph{}, errors.Wrap(err, "store.GetRepositoryPackageReferences")
+	}
+	providers, err := s.store.GetRepositoryPackages(ctx, shared.GetRepositoryPackagesOptions{Names: []string{filter.Name}})
+	if err != nil {
+		return shared.DependencyGraph{}, errors.Wrap(err, "store.GetRepositoryPackages")
+	}
+	for _, provider := range providers {
+		if matches(provider) {
+			if _, err := g.addNode(ctx, provider.RepositoryID, 0); err != nil {
+				return shared.DependencyGraph{}, err
+			}
+		}
+	}
+
+	providersByPackage := groupRepositoriesByPackage(providers)
+
+	var frontier []int
+	for _, reference := range references {
+		if !matches(reference) {
+			continue
+		}
+		next, err := g.addReference(ctx, reference, providersByPackage[packageKeyOf(reference)], 1, true)
+		if err != nil {
+			return shared.DependencyGraph{}, err
+		}
+		frontier = append(frontier, next...)
+	}
+
+	// Walk outwards from the dependents through the packages they provide in turn.
+	for depth := 2; depth <= dependencyGraphDepth(opts) && len(frontier) > 0; depth++ {
+		provided, err := s.store.GetRepositoryPackages(ctx, shared.GetRepositoryPackagesOptions{RepositoryIDs: frontier})
+		if err != nil {
+			return shared.DependencyGraph{}, errors.Wrap(err, "store.GetRepositoryPackages")
+		}
+		providersByPackage := groupRepositoriesByPackage(provided)
+
+		references, err := s.store.GetRepositoryPackageReferences(ctx, shared.GetRepositoryPackagesOptions{Names: packageNames(provided)})
+		if err != nil {
+			return shared.DependencyGraph{}, errors.Wrap(err, "store.GetRepositoryPackageReferences")
+		}
+
+		frontier = frontier[:0:0]
+		for _, reference := range references {
+			providers, ok := providersByPackage[packageKeyOf(reference)]
+			if !ok {
+				continue
+			}
+			next, err := g.addReference(ctx, reference, providers, depth, true)
+			if err != nil {
+				return shared.DependencyGraph{}, err
+			}
+			frontier = append(frontier, next...)
+		}
+	}
+
+	return g.graph(), nil
+}
+
+func dependencyGraphDepth(opts shared.DependencyGraphOptions) int {
+	if !opts.Transitive {
+		return 1
+	}
+	if opts.MaxDepth <= 0 {
+		return defaultDependencyGraphDepth
+	}
+	if opts.MaxDepth > maxDependencyGraphDepth {
+		return maxDependencyGraphDepth
+	}
+	return opts.MaxDepth
+}
+
+// newPackageMatcher returns a function reporting whether a package matches the given filter.
+func newPackageMatcher(filter shared.PackageFilter) func(pkg shared.RepositoryPackage) bool {
+	matchesVersion := func(version string) bool { return true }
+	if filter.VersionConstraint != "" {
+		constraint, err := semver.NewConstraint(filter.VersionConstraint)
+		if err != nil {
+			// Not every package manager uses semantic versions; fall back to an exact match
+			matchesVersion = func(version string) bool { return version == filter.VersionConstraint }
+		} else {
+			matchesVersion = func(version string) bool {
+				v, err := semver.NewVersion(version)
+				if err != nil {
+					return version == filter.VersionConstraint
+				}
+				return constraint.Check(v)
+			}
+		}
+	}
+
+	return func(pkg shared.RepositoryPackage) bool {
+		return pkg.Name == filter.Name &&
+			(filter.Scheme == "" || pkg.Scheme == filter.Scheme) &&
+			(filter.Manager == "" || pkg.Manager == filter.Manager) &&
+			matchesVersion(pkg.Version)
+	}
+}
+
+type packageKey struct {
+	scheme, manager, name, version string
+}
+
+func packageKeyOf(pkg shared.RepositoryPackage) packageKey {
+	return packageKey{pkg.Scheme, pkg.Manager, pkg.Name, pkg.Version}
+}
+
+func groupRepositoriesByPackage(packages []shared.RepositoryPackage) map[packageKey][]int {
+	repositoryIDsByPackage := make(map[packageKey][]int, len(packages))
+	for _, pkg := range packages {
+		key := packageKeyOf(pkg)
+		repositoryIDsByPackage[key] = append(repositoryIDsByPackage[key], pkg.RepositoryID)
+	}
+
+	return repositoryIDsByPackage
+}
+
+func packageNames(packages []shared.RepositoryPackage) []string {
+	seen := make(map[string]struct{}, len(packages))
+	names := make([]string, 0, len(packages))
+	for _, pkg := range packages {
+		if _, ok := seen[pkg.Name]; !ok {
+			seen[pkg.Name] = struct{}{}
+			names = append(names, pkg.Name)
+		}
+	}
+
+	return names
+}
+
+type dependencyGraphBuilder struct {
+	repoStore RepoStore
+	visible   map[int]bool
+	depths    map[int]int
+	nodes     []shared.DependencyGraphNode
+	edges     map[shared.DependencyGraphEdge]struct{}
+}
+
+func newDependencyGraphBuilder(repoStore RepoStore) *dependencyGraphBuilder {
+	return &dependencyGraphBuilder{
+		repoStore: repoStore,
+		visible:   map[int]bool{},
+		depths:    map[int]int{},
+		edges:     map[shared.DependencyGraphEdge]struct{}{},
+	}
+}
+
+// isVisible reports whether the current user can see the given repository.
+func (g *dependencyGraphBuilder) isVisible(ctx context.Context, repositoryID int) (bool, error) {
+	if visible, ok := g.visible[repositoryID]; ok {
+		return visible, nil
+	}
+
+	// 🚨 SECURITY: Repositories the current user cannot see are excluded from the graph
+	if _, err := g.repoStore.Get(ctx, api.RepoID(repositoryID)); err != nil {
+		if !errcode.IsNotFound(err) {
+			return false, err
+		}
+		g.visible[repositoryID] = false
+		return false, nil
+	}
+
+	g.visible[repositoryID] = true
+	return true, nil
+}
+
+// addNode adds a visible repository to the graph at the given depth. The returned flag is true
+// only when the repository is visible and was not already part of the graph.
+func (g *dependencyGraphBuilder) addNode(ctx context.Context, repositoryID, depth int) (bool, error) {
+	if _, ok := g.depths[repositoryID]; ok {
+		return false, nil
+	}
+	if visible, err := g.isVisible(ctx, repositoryID); err != nil || !visible {
+		return false, err
+	}
+	if len(g.nodes) >= maxDependencyGraphNodes {
+		return false, nil
+	}
+
+	g.depths[repositoryID] = depth
+	g.nodes = append(g.nodes, shared.DependencyGraphNode{RepositoryID: repositoryID, Depth: depth})
+	return true, nil
+}
+
+// addReference records the edges between the repository referencing a package and each of the
+// repositories providing it. When walking towards dependents, the referencing repository is the
+// node added at the given depth; otherwise the providers are. The newly added repositories are
+// returned so that the caller can expand from them.
+func (g *dependencyGraphBuilder) addReference(ctx context.Context, reference shared.RepositoryPackage, providerIDs []int, depth int, towardsDependents bool) (added []int, _ error) {
+	if visible, err := g.isVisible(ctx, reference.RepositoryID); err != nil || !visible {
+		return nil, err
+	}
+	if towardsDependents {
+		if ok, err := g.addNode(ctx, reference.RepositoryID, depth); err != nil {
+			return nil, err
+		} else if ok {
+			added = append(added, reference.RepositoryID)
+		}
+		if _, ok := g.depths[reference.RepositoryID]; !ok {
+			return added, nil
+		}
+	}
+
+	edge := shared.DependencyGraphEdge{
+		DependentID: reference.RepositoryID,
+		Scheme:      reference.Scheme,
+		Manager:     reference.Manager,
+		Name:        reference.Name,
+		Version:     reference.Version,
+	}
+
+	resolved := false
+	for _, providerID := range providerIDs {
+		if providerID == reference.RepositoryID {
+			// Indexes of the same repository referencing one another are not dependencies
+			resolved = true
+			continue
+		}
+
+		if !towardsDependents {
+			if ok, err := g.addNode(ctx, providerID, depth); err != nil {
+				return nil, err
+			} else if ok {
+				added = append(added, providerID)
+			}
+		}
+		if _, ok := g.depths[providerID]; !ok {
+			continue
+		}
+
+		resolved = true
+		edge.DependencyID = providerID
+		g.edges[edge] = struct{}{}
+	}
+
+	if !resolved {
+		edge.DependencyID = 0
+		g.edges[edge] = struct{}{}
+	}
+
+	return added, nil
+}
+
+func (g *dependencyGraphBuilder) graph() shared.DependencyGraph {
+	nodes := make([]shared.DependencyGraphNode, len(g.nodes))
+	copy(nodes, g.nodes)
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Depth != nodes[j].Depth {
+			return nodes[i].Depth < nodes[j].Depth
+		}
+		return nodes[i].RepositoryID < nodes[j].RepositoryID
+	})
+
+	edges := make([]shared.DependencyGraphEdge, 0, len(g.edges))
+	for edge := range g.edges {
+		edges = append(edges, edge)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		a, b := edges[i], edges[j]
+		if a.DependentID != b.DependentID {
+			return a.DependentID < b.DependentID
+		}
+		if a.DependencyID != b.DependencyID {
+			return a.DependencyID < b.DependencyID
+		}
+		return packageKey{a.Scheme, a.Manager, a.Name, a.Version}.less(packageKey{b.Scheme, b.Manager, b.Name, b.Version})
+	})
+
+	return shared.DependencyGraph{
+		Nodes:  nodes,
+		Edges:  edges,
+		Cycles: findDependencyCycles(edges),
+	}
+}
+
+func (k packageKey) less(other packageKey) bool {
+	if k.scheme != other.scheme {
+		return k.scheme < other.scheme
+	}
+	if k.manager != other.manager {
+		return k.manager < other.manager
+	}
+	if k.name != other.name {
+		return k.name < other.name
+	}
+	return k.version < other.version
+}
+
+// findDependencyCycles returns the strongly connected components of the graph formed by the
+// given edges that contain more than one repository. Each component is sorted by repository
+// identifier, and components are ordered by their smallest member.
+func findDependencyCycles(edges []shared.DependencyGraphEdge) [][]int {
+	successors := map[int][]int{}
+	for _, edge := range edges {
+		if edge.DependencyID != 0 {
+			successors[edge.DependentID] = append(successors[edge.DependentID], edge.DependencyID)
+		}
+	}
+
+	vertices := make([]int, 0, len(successors))
+	for vertex := range successors {
+		vertices = append(vertices, vertex)
+	}
+	sort.Ints(vertices)
+
+	// Tarjan's strongly connected components algorithm
+	var (
+		index    = 0
+		indexes  = map[int]int{}
+		lowlinks = map[int]int{}
+		onStack  = map[int]bool{}
+		stack    []int
+		cycles   [][]int
+	)
+
+	var visit func(v int)
+	visit = func(v int) {
+		indexes[v] = index
+		lowlinks[v] = index
+		index++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range successors[v] {
+			if _, ok := indexes[w]; !ok {
+				visit(w)
+				lowlinks[v] = min(lowlinks[v], lowlinks[w])
+			} else if onStack[w] {
+				lowlinks[v] = min(lowlinks[v], indexes[w])
+			}
+		}
+
+		if lowlinks[v] != indexes[v] {
+			return
+		}
+
+		var component []int
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		if len(component) > 1 {
+			sort.Ints(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, v := range vertices {
+		if _, ok := indexes[v]; !ok {
+			visit(v)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
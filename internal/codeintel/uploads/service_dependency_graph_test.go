@@ -0,0 +1,198 @@
+package uploads
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestGetRepositoryDependencies(t *testing.T) {
+	svc := newDependencyGraphTestService()
+
+	direct, err := svc.GetRepositoryDependencies(context.Background(), 1, shared.DependencyGraphOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependencies: %s", err)
+	}
+	expectedDirect := shared.DependencyGraph{
+		Nodes: []shared.DependencyGraphNode{
+			{RepositoryID: 1, Depth: 0},
+			{RepositoryID: 2, Depth: 1},
+		},
+		Edges: []shared.DependencyGraphEdge{
+			{DependentID: 1, DependencyID: 0, Scheme: "npm", Manager: "npm", Name: "private-lib", Version: "1.0.0"},
+			{DependentID: 1, DependencyID: 0, Scheme: "npm", Manager: "npm", Name: "unindexed", Version: "0.1.0"},
+			{DependentID: 1, DependencyID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+		},
+	}
+	if diff := cmp.Diff(expectedDirect, direct); diff != "" {
+		t.Errorf("unexpected direct dependencies (-want +got):\n%s", diff)
+	}
+
+	transitive, err := svc.GetRepositoryDependencies(context.Background(), 1, shared.DependencyGraphOptions{Transitive: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependencies: %s", err)
+	}
+	expectedTransitive := shared.DependencyGraph{
+		Nodes: []shared.DependencyGraphNode{
+			{RepositoryID: 1, Depth: 0},
+			{RepositoryID: 2, Depth: 1},
+			{RepositoryID: 3, Depth: 2},
+		},
+		Edges: []shared.DependencyGraphEdge{
+			{DependentID: 1, DependencyID: 0, Scheme: "npm", Manager: "npm", Name: "private-lib", Version: "1.0.0"},
+			{DependentID: 1, DependencyID: 0, Scheme: "npm", Manager: "npm", Name: "unindexed", Version: "0.1.0"},
+			{DependentID: 1, DependencyID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+			{DependentID: 2, DependencyID: 3, Scheme: "npm", Manager: "npm", Name: "lib-b", Version: "2.0.0"},
+			{DependentID: 3, DependencyID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+		},
+		Cycles: [][]int{{2, 3}},
+	}
+	if diff := cmp.Diff(expectedTransitive, transitive); diff != "" {
+		t.Errorf("unexpected transitive dependencies (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetRepositoryDependenciesInvisibleRepository(t *testing.T) {
+	svc := newDependencyGraphTestService()
+
+	graph, err := svc.GetRepositoryDependencies(context.Background(), 4, shared.DependencyGraphOptions{Transitive: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependencies: %s", err)
+	}
+	if diff := cmp.Diff(shared.DependencyGraph{}, graph); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetPackageDependents(t *testing.T) {
+	svc := newDependencyGraphTestService()
+
+	direct, err := svc.GetPackageDependents(context.Background(), shared.PackageFilter{Name: "lib-a", VersionConstraint: "^1.0"}, shared.DependencyGraphOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependents: %s", err)
+	}
+	expectedDirect := shared.DependencyGraph{
+		Nodes: []shared.DependencyGraphNode{
+			{RepositoryID: 2, Depth: 0},
+			{RepositoryID: 1, Depth: 1},
+			{RepositoryID: 3, Depth: 1},
+		},
+		Edges: []shared.DependencyGraphEdge{
+			{DependentID: 1, DependencyID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+			{DependentID: 3, DependencyID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+		},
+	}
+	if diff := cmp.Diff(expectedDirect, direct); diff != "" {
+		t.Errorf("unexpected direct dependents (-want +got):\n%s", diff)
+	}
+
+	transitive, err := svc.GetPackageDependents(context.Background(), shared.PackageFilter{Name: "lib-b"}, shared.DependencyGraphOptions{Transitive: true})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependents: %s", err)
+	}
+	expectedTransitive := shared.DependencyGraph{
+		Nodes: []shared.DependencyGraphNode{
+			{RepositoryID: 3, Depth: 0},
+			{RepositoryID: 2, Depth: 1},
+			{RepositoryID: 1, Depth: 2},
+		},
+		Edges: []shared.DependencyGraphEdge{
+			{DependentID: 1, DependencyID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+			{DependentID: 2, DependencyID: 3, Scheme: "npm", Manager: "npm", Name: "lib-b", Version: "2.0.0"},
+			{DependentID: 3, DependencyID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+		},
+		Cycles: [][]int{{2, 3}},
+	}
+	if diff := cmp.Diff(expectedTransitive, transitive); diff != "" {
+		t.Errorf("unexpected transitive dependents (-want +got):\n%s", diff)
+	}
+
+	none, err := svc.GetPackageDependents(context.Background(), shared.PackageFilter{Name: "lib-a", VersionConstraint: ">= 2"}, shared.DependencyGraphOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependents: %s", err)
+	}
+	expectedNone := shared.DependencyGraph{
+		Nodes: []shared.DependencyGraphNode{},
+		Edges: []shared.DependencyGraphEdge{},
+	}
+	if diff := cmp.Diff(expectedNone, none); diff != "" {
+		t.Errorf("unexpected dependents (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindDependencyCycles(t *testing.T) {
+	cycles := findDependencyCycles([]shared.DependencyGraphEdge{
+		{DependentID: 1, DependencyID: 2},
+		{DependentID: 2, DependencyID: 3},
+		{DependentID: 3, DependencyID: 1},
+		{DependentID: 3, DependencyID: 4},
+		{DependentID: 4, DependencyID: 0},
+		{DependentID: 5, DependencyID: 6},
+		{DependentID: 6, DependencyID: 5},
+	})
+
+	if diff := cmp.Diff([][]int{{1, 2, 3}, {5, 6}}, cycles); diff != "" {
+		t.Errorf("unexpected cycles (-want +got):\n%s", diff)
+	}
+}
+
+// newDependencyGraphTestService returns a service over the following repositories:
+//
+//   - repo 1 references lib-a@1.0.0, private-lib@1.0.0, and unindexed@0.1.0
+//   - repo 2 provides lib-a@1.0.0 and references lib-b@2.0.0
+//   - repo 3 provides lib-b@2.0.0 and references lib-a@1.0.0
+//   - repo 4 provides private-lib@1.0.0 but is not visible to the current user
+func newDependencyGraphTestService() *Service {
+	packages := []shared.RepositoryPackage{
+		{RepositoryID: 2, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+		{RepositoryID: 3, Scheme: "npm", Manager: "npm", Name: "lib-b", Version: "2.0.0"},
+		{RepositoryID: 4, Scheme: "npm", Manager: "npm", Name: "private-lib", Version: "1.0.0"},
+	}
+	references := []shared.RepositoryPackage{
+		{RepositoryID: 1, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+		{RepositoryID: 1, Scheme: "npm", Manager: "npm", Name: "private-lib", Version: "1.0.0"},
+		{RepositoryID: 1, Scheme: "npm", Manager: "npm", Name: "unindexed", Version: "0.1.0"},
+		{RepositoryID: 2, Scheme: "npm", Manager: "npm", Name: "lib-b", Version: "2.0.0"},
+		{RepositoryID: 3, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+		{RepositoryID: 4, Scheme: "npm", Manager: "npm", Name: "lib-a", Version: "1.0.0"},
+	}
+
+	filter := func(rows []shared.RepositoryPackage, opts shared.GetRepositoryPackagesOptions) (filtered []shared.RepositoryPackage) {
+		for _, row := range rows {
+			if len(opts.RepositoryIDs) > 0 && !slices.Contains(opts.RepositoryIDs, row.RepositoryID) {
+				continue
+			}
+			if len(opts.Names) > 0 && !slices.Contains(opts.Names, row.Name) {
+				continue
+			}
+			filtered = append(filtered, row)
+		}
+		return filtered
+	}
+
+	mockStore := NewMockStore()
+	mockStore.GetRepositoryPackagesFunc.SetDefaultHook(func(_ context.Context, opts shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return filter(packages, opts), nil
+	})
+	mockStore.GetRepositoryPackageReferencesFunc.SetDefaultHook(func(_ context.Context, opts shared.GetRepositoryPackagesOptions) ([]shared.RepositoryPackage, error) {
+		return filter(references, opts), nil
+	})
+
+	mockRepoStore := NewMockRepoStore()
+	mockRepoStore.GetFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*types.Repo, error) {
+		if id == 4 {
+			return nil, &database.RepoNotFoundErr{ID: id}
+		}
+		return &types.Repo{ID: id}, nil
+	})
+
+	return newService(&observation.TestContext, mockStore, mockRepoStore, NewMockLSIFStore(), nil)
+}
@@ -0,0 +1,117 @@
+package uploads
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/internal/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestExportSCIPIndex(t *testing.T) {
+	mockLSIFStore := NewMockLSIFStore()
+	mockLSIFStore.GetSCIPMetadataFunc.SetDefaultReturn(lsifstore.ProcessedMetadata{
+		TextDocumentEncoding: "UTF8",
+		ToolName:             "scip-go",
+		ToolVersion:          "0.1.0",
+		ToolArguments:        []string{"--module-name", "test"},
+		ProtocolVersion:      0,
+	}, true, nil)
+	mockLSIFStore.ScanDocumentsFunc.SetDefaultHook(func(_ context.Context, _ int, f func(path string, document *scip.Document) error) error {
+		for _, path := range []string{"cmd/main.go", "internal/secret.go", "lib/lib.go"} {
+			if err := f(path, &scip.Document{
+				RelativePath: path,
+				Occurrences: []*scip.Occurrence{
+					{Range: []int32{1, 2, 3}, Symbol: "local 0", SymbolRoles: int32(scip.SymbolRole_Definition)},
+				},
+				Symbols: []*scip.SymbolInformation{
+					{
+						Symbol:        "scip-go gomod test 0.1.0 `test`/" + path + ".",
+						Relationships: []*scip.Relationship{{Symbol: "scip-go gomod test 0.1.0 `test`/Iface#", IsImplementation: true}},
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	svc := newService(&observation.TestContext, NewMockStore(), NewMockRepoStore(), mockLSIFStore, nil)
+
+	var buf bytes.Buffer
+	ok, err := svc.ExportSCIPIndex(context.Background(), 42, &buf, func(path string) (bool, error) {
+		return path != "internal/secret.go", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error exporting index: %s", err)
+	}
+	if !ok {
+		t.Fatalf("expected index to be exported")
+	}
+
+	var index scip.Index
+	if err := proto.Unmarshal(buf.Bytes(), &index); err != nil {
+		t.Fatalf("unexpected error decoding index: %s", err)
+	}
+
+	expectedDocument := func(path string) *scip.Document {
+		return &scip.Document{
+			RelativePath: path,
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{1, 2, 3}, Symbol: "local 0", SymbolRoles: int32(scip.SymbolRole_Definition)},
+			},
+			Symbols: []*scip.SymbolInformation{
+				{
+					Symbol:        "scip-go gomod test 0.1.0 `test`/" + path + ".",
+					Relationships: []*scip.Relationship{{Symbol: "scip-go gomod test 0.1.0 `test`/Iface#", IsImplementation: true}},
+				},
+			},
+		}
+	}
+	expectedIndex := &scip.Index{
+		Metadata: &scip.Metadata{
+			Version: scip.ProtocolVersion_UnspecifiedProtocolVersion,
+			ToolInfo: &scip.ToolInfo{
+				Name:      "scip-go",
+				Version:   "0.1.0",
+				Arguments: []string{"--module-name", "test"},
+			},
+			TextDocumentEncoding: scip.TextEncoding_UTF8,
+		},
+		Documents: []*scip.Document{
+			expectedDocument("cmd/main.go"),
+			expectedDocument("lib/lib.go"),
+		},
+	}
+	if diff := cmp.Diff(expectedIndex, &index, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected index (-want +got):\n%s", diff)
+	}
+}
+
+func TestExportSCIPIndexUnknownUpload(t *testing.T) {
+	mockLSIFStore := NewMockLSIFStore()
+	svc := newService(&observation.TestContext, NewMockStore(), NewMockRepoStore(), mockLSIFStore, nil)
+
+	var buf bytes.Buffer
+	ok, err := svc.ExportSCIPIndex(context.Background(), 42, &buf, func(path string) (bool, error) { return true, nil })
+	if err != nil {
+		t.Fatalf("unexpected error exporting index: %s", err)
+	}
+	if ok {
+		t.Fatalf("expected no index to be exported")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("unexpected payload written: %d bytes", buf.Len())
+	}
+	if len(mockLSIFStore.ScanDocumentsFunc.History()) != 0 {
+		t.Errorf("unexpected document scan")
+	}
+}
@@ -214,6 +214,71 @@ type PackageReference struct {
 	Package
 }
 
+// RepositoryPackage pairs a package scheme+manager+name+version with a repository whose
+// precise indexes visible at the tip of the default branch provide or reference it.
+type RepositoryPackage struct {
+	RepositoryID int
+	Scheme       string
+	Manager      string
+	Name         string
+	Version      string
+}
+
+// GetRepositoryPackagesOptions filters the packages provided or referenced by repositories. At
+// least one of the fields must be set.
+type GetRepositoryPackagesOptions struct {
+	RepositoryIDs []int
+	Names         []string
+}
+
+// DependencyGraphOptions controls how far a dependency graph query expands from its starting point.
+type DependencyGraphOptions struct {
+	// Transitive expands the graph past the direct dependencies or dependents.
+	Transitive bool
+
+	// MaxDepth bounds the number of edges between the starting point and any node when
+	// Transitive is set. A zero value selects the default depth.
+	MaxDepth int
+}
+
+// PackageFilter selects the packages whose dependents are returned by a dependency graph query.
+type PackageFilter struct {
+	Scheme  string
+	Manager string
+	Name    string
+
+	// VersionConstraint is a semantic version constraint (e.g. ">= 1.2, < 2"). Versions that
+	// do not parse as semantic versions match only when equal to the constraint.
+	VersionConstraint string
+}
+
+// DependencyGraph is a set of repositories connected by the packages their precise indexes
+// reference and provide.
+type DependencyGraph struct {
+	Nodes []DependencyGraphNode
+	Edges []DependencyGraphEdge
+
+	// Cycles holds the repository identifiers of each strongly connected set of nodes.
+	Cycles [][]int
+}
+
+// DependencyGraphNode is a repository along with its distance from the starting point of the query.
+type DependencyGraphNode struct {
+	RepositoryID int
+	Depth        int
+}
+
+// DependencyGraphEdge records that the dependent repository references a package. DependencyID
+// is the repository providing that package, or zero if no visible repository provides it.
+type DependencyGraphEdge struct {
+	DependentID  int
+	DependencyID int
+	Scheme       string
+	Manager      string
+	Name         string
+	Version      string
+}
+
 // PackageReferenceScanner allows for on-demand scanning of PackageReference values.
 //
 // A scanner for this type was introduced as a memory optimization. Instead of reading a
@@ -34,6 +34,8 @@ type UploadsService interface {
 	GetRecentIndexesSummary(ctx context.Context, repositoryID int) ([]uploadshared.IndexesWithRepositoryNamespace, error)
 	NumRepositoriesWithCodeIntelligence(ctx context.Context) (int, error)
 	RepositoryIDsWithErrors(ctx context.Context, offset, limit int) (_ []uploadshared.RepositoryWithCount, totalCount int, err error)
+	GetRepositoryDependencies(ctx context.Context, repositoryID int, opts uploadshared.DependencyGraphOptions) (_ uploadshared.DependencyGraph, err error)
+	GetPackageDependents(ctx context.Context, filter uploadshared.PackageFilter, opts uploadshared.DependencyGraphOptions) (_ uploadshared.DependencyGraph, err error)
 }
 
 type AutoIndexingService interface {
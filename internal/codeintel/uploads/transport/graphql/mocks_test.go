@@ -53,12 +53,19 @@ type MockUploadsService struct {
 	// function object controlling the behavior of the method
 	// GetLastUploadRetentionScanForRepository.
 	GetLastUploadRetentionScanForRepositoryFunc *UploadsServiceGetLastUploadRetentionScanForRepositoryFunc
+	// GetPackageDependentsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPackageDependents.
+	GetPackageDependentsFunc *UploadsServiceGetPackageDependentsFunc
 	// GetRecentIndexesSummaryFunc is an instance of a mock function object
 	// controlling the behavior of the method GetRecentIndexesSummary.
 	GetRecentIndexesSummaryFunc *UploadsServiceGetRecentIndexesSummaryFunc
 	// GetRecentUploadsSummaryFunc is an instance of a mock function object
 	// controlling the behavior of the method GetRecentUploadsSummary.
 	GetRecentUploadsSummaryFunc *UploadsServiceGetRecentUploadsSummaryFunc
+	// GetRepositoryDependenciesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetRepositoryDependencies.
+	GetRepositoryDependenciesFunc *UploadsServiceGetRepositoryDependenciesFunc
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *UploadsServiceGetUploadByIDFunc
@@ -148,6 +155,11 @@ func NewMockUploadsService() *MockUploadsService {
 				return
 			},
 		},
+		GetPackageDependentsFunc: &UploadsServiceGetPackageDependentsFunc{
+			defaultHook: func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (r0 shared.DependencyGraph, r1 error) {
+				return
+			},
+		},
 		GetRecentIndexesSummaryFunc: &UploadsServiceGetRecentIndexesSummaryFunc{
 			defaultHook: func(context.Context, int) (r0 []shared.IndexesWithRepositoryNamespace, r1 error) {
 				return
@@ -158,6 +170,11 @@ func NewMockUploadsService() *MockUploadsService {
 				return
 			},
 		},
+		GetRepositoryDependenciesFunc: &UploadsServiceGetRepositoryDependenciesFunc{
+			defaultHook: func(context.Context, int, shared.DependencyGraphOptions) (r0 shared.DependencyGraph, r1 error) {
+				return
+			},
+		},
 		GetUploadByIDFunc: &UploadsServiceGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (r0 shared.Upload, r1 bool, r2 error) {
 				return
@@ -265,6 +282,11 @@ func NewStrictMockUploadsService() *MockUploadsService {
 				panic("unexpected invocation of MockUploadsService.GetLastUploadRetentionScanForRepository")
 			},
 		},
+		GetPackageDependentsFunc: &UploadsServiceGetPackageDependentsFunc{
+			defaultHook: func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+				panic("unexpected invocation of MockUploadsService.GetPackageDependents")
+			},
+		},
 		GetRecentIndexesSummaryFunc: &UploadsServiceGetRecentIndexesSummaryFunc{
 			defaultHook: func(context.Context, int) ([]shared.IndexesWithRepositoryNamespace, error) {
 				panic("unexpected invocation of MockUploadsService.GetRecentIndexesSummary")
@@ -275,6 +297,11 @@ func NewStrictMockUploadsService() *MockUploadsService {
 				panic("unexpected invocation of MockUploadsService.GetRecentUploadsSummary")
 			},
 		},
+		GetRepositoryDependenciesFunc: &UploadsServiceGetRepositoryDependenciesFunc{
+			defaultHook: func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+				panic("unexpected invocation of MockUploadsService.GetRepositoryDependencies")
+			},
+		},
 		GetUploadByIDFunc: &UploadsServiceGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (shared.Upload, bool, error) {
 				panic("unexpected invocation of MockUploadsService.GetUploadByID")
@@ -361,12 +388,18 @@ func NewMockUploadsServiceFrom(i UploadsService) *MockUploadsService {
 		GetLastUploadRetentionScanForRepositoryFunc: &UploadsServiceGetLastUploadRetentionScanForRepositoryFunc{
 			defaultHook: i.GetLastUploadRetentionScanForRepository,
 		},
+		GetPackageDependentsFunc: &UploadsServiceGetPackageDependentsFunc{
+			defaultHook: i.GetPackageDependents,
+		},
 		GetRecentIndexesSummaryFunc: &UploadsServiceGetRecentIndexesSummaryFunc{
 			defaultHook: i.GetRecentIndexesSummary,
 		},
 		GetRecentUploadsSummaryFunc: &UploadsServiceGetRecentUploadsSummaryFunc{
 			defaultHook: i.GetRecentUploadsSummary,
 		},
+		GetRepositoryDependenciesFunc: &UploadsServiceGetRepositoryDependenciesFunc{
+			defaultHook: i.GetRepositoryDependencies,
+		},
 		GetUploadByIDFunc: &UploadsServiceGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
@@ -1616,6 +1649,120 @@ func (c UploadsServiceGetLastUploadRetentionScanForRepositoryFuncCall) Results()
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// UploadsServiceGetPackageDependentsFunc describes the behavior when the
+// GetPackageDependents method of the parent MockUploadsService instance is
+// invoked.
+type UploadsServiceGetPackageDependentsFunc struct {
+	defaultHook func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error)
+	hooks       []func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error)
+	history     []UploadsServiceGetPackageDependentsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPackageDependents delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockUploadsService) GetPackageDependents(v0 context.Context, v1 shared.PackageFilter, v2 shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+	r0, r1 := m.GetPackageDependentsFunc.nextHook()(v0, v1, v2)
+	m.GetPackageDependentsFunc.appendCall(UploadsServiceGetPackageDependentsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPackageDependents
+// method of the parent MockUploadsService instance is invoked and the hook
+// queue is empty.
+func (f *UploadsServiceGetPackageDependentsFunc) SetDefaultHook(hook func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPackageDependents method of the parent MockUploadsService instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *UploadsServiceGetPackageDependentsFunc) PushHook(hook func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadsServiceGetPackageDependentsFunc) SetDefaultReturn(r0 shared.DependencyGraph, r1 error) {
+	f.SetDefaultHook(func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadsServiceGetPackageDependentsFunc) PushReturn(r0 shared.DependencyGraph, r1 error) {
+	f.PushHook(func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+		return r0, r1
+	})
+}
+
+func (f *UploadsServiceGetPackageDependentsFunc) nextHook() func(context.Context, shared.PackageFilter, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadsServiceGetPackageDependentsFunc) appendCall(r0 UploadsServiceGetPackageDependentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of UploadsServiceGetPackageDependentsFuncCall
+// objects describing the invocations of this function.
+func (f *UploadsServiceGetPackageDependentsFunc) History() []UploadsServiceGetPackageDependentsFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadsServiceGetPackageDependentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadsServiceGetPackageDependentsFuncCall is an object that describes an
+// invocation of method GetPackageDependents on an instance of
+// MockUploadsService.
+type UploadsServiceGetPackageDependentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 shared.PackageFilter
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 shared.DependencyGraphOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 shared.DependencyGraph
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadsServiceGetPackageDependentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadsServiceGetPackageDependentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // UploadsServiceGetRecentIndexesSummaryFunc describes the behavior when the
 // GetRecentIndexesSummary method of the parent MockUploadsService instance
 // is invoked.
@@ -1840,6 +1987,121 @@ func (c UploadsServiceGetRecentUploadsSummaryFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// UploadsServiceGetRepositoryDependenciesFunc describes the behavior when
+// the GetRepositoryDependencies method of the parent MockUploadsService
+// instance is invoked.
+type UploadsServiceGetRepositoryDependenciesFunc struct {
+	defaultHook func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error)
+	hooks       []func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error)
+	history     []UploadsServiceGetRepositoryDependenciesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoryDependencies delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockUploadsService) GetRepositoryDependencies(v0 context.Context, v1 int, v2 shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+	r0, r1 := m.GetRepositoryDependenciesFunc.nextHook()(v0, v1, v2)
+	m.GetRepositoryDependenciesFunc.appendCall(UploadsServiceGetRepositoryDependenciesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepositoryDependencies method of the parent MockUploadsService
+// instance is invoked and the hook queue is empty.
+func (f *UploadsServiceGetRepositoryDependenciesFunc) SetDefaultHook(hook func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoryDependencies method of the parent MockUploadsService
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *UploadsServiceGetRepositoryDependenciesFunc) PushHook(hook func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *UploadsServiceGetRepositoryDependenciesFunc) SetDefaultReturn(r0 shared.DependencyGraph, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *UploadsServiceGetRepositoryDependenciesFunc) PushReturn(r0 shared.DependencyGraph, r1 error) {
+	f.PushHook(func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+		return r0, r1
+	})
+}
+
+func (f *UploadsServiceGetRepositoryDependenciesFunc) nextHook() func(context.Context, int, shared.DependencyGraphOptions) (shared.DependencyGraph, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *UploadsServiceGetRepositoryDependenciesFunc) appendCall(r0 UploadsServiceGetRepositoryDependenciesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// UploadsServiceGetRepositoryDependenciesFuncCall objects describing the
+// invocations of this function.
+func (f *UploadsServiceGetRepositoryDependenciesFunc) History() []UploadsServiceGetRepositoryDependenciesFuncCall {
+	f.mutex.Lock()
+	history := make([]UploadsServiceGetRepositoryDependenciesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// UploadsServiceGetRepositoryDependenciesFuncCall is an object that
+// describes an invocation of method GetRepositoryDependencies on an
+// instance of MockUploadsService.
+type UploadsServiceGetRepositoryDependenciesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 shared.DependencyGraphOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 shared.DependencyGraph
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c UploadsServiceGetRepositoryDependenciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c UploadsServiceGetRepositoryDependenciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // UploadsServiceGetUploadByIDFunc describes the behavior when the
 // GetUploadByID method of the parent MockUploadsService instance is
 // invoked.
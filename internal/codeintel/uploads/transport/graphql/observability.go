@@ -17,6 +17,9 @@ type operations struct {
 	reindexPreciseIndex   *observation.Operation
 	reindexPreciseIndexes *observation.Operation
 	repositorySummary     *observation.Operation
+
+	preciseRepositoryDependencies *observation.Operation
+	precisePackageDependents      *observation.Operation
 }
 
 func newOperations(observationCtx *observation.Context) *operations {
@@ -45,5 +48,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 		reindexPreciseIndex:   op("ReindexPreciseIndex"),
 		reindexPreciseIndexes: op("ReindexPreciseIndexes"),
 		repositorySummary:     op("RepositorySummary"),
+
+		preciseRepositoryDependencies: op("PreciseRepositoryDependencies"),
+		precisePackageDependents:      op("PrecisePackageDependents"),
 	}
 }
@@ -0,0 +1,178 @@
+package graphql
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	resolverstubs "github.com/sourcegraph/sourcegraph/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/shared/resolvers/gitresolvers"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// 🚨 SECURITY: Repositories the current user cannot see are excluded from the graph by the service
+func (r *rootResolver) PreciseRepositoryDependencies(ctx context.Context, args *resolverstubs.PreciseRepositoryDependenciesArgs) (_ resolverstubs.PreciseDependencyGraphResolver, err error) {
+	opts := dependencyGraphOptions(args.Transitive, args.MaxDepth)
+	ctx, _, endObservation := r.operations.preciseRepositoryDependencies.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("repository", string(args.Repository)),
+		attribute.Bool("transitive", opts.Transitive),
+		attribute.Int("maxDepth", opts.MaxDepth),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	repositoryID, err := resolverstubs.UnmarshalID[int](args.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	graph, err := r.uploadSvc.GetRepositoryDependencies(ctx, repositoryID, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "uploadSvc.GetRepositoryDependencies")
+	}
+
+	return newPreciseDependencyGraphResolver(graph, r.locationResolverFactory.Create()), nil
+}
+
+// 🚨 SECURITY: Repositories the current user cannot see are excluded from the graph by the service
+func (r *rootResolver) PrecisePackageDependents(ctx context.Context, args *resolverstubs.PrecisePackageDependentsArgs) (_ resolverstubs.PreciseDependencyGraphResolver, err error) {
+	filter := shared.PackageFilter{Name: args.Name}
+	if args.Scheme != nil {
+		filter.Scheme = *args.Scheme
+	}
+	if args.Manager != nil {
+		filter.Manager = *args.Manager
+	}
+	if args.VersionConstraint != nil {
+		filter.VersionConstraint = *args.VersionConstraint
+	}
+	opts := dependencyGraphOptions(args.Transitive, args.MaxDepth)
+
+	ctx, _, endObservation := r.operations.precisePackageDependents.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("scheme", filter.Scheme),
+		attribute.String("manager", filter.Manager),
+		attribute.String("name", filter.Name),
+		attribute.String("versionConstraint", filter.VersionConstraint),
+		attribute.Bool("transitive", opts.Transitive),
+		attribute.Int("maxDepth", opts.MaxDepth),
+	}})
+	endObservation.OnCancel(ctx, 1, observation.Args{})
+
+	graph, err := r.uploadSvc.GetPackageDependents(ctx, filter, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "uploadSvc.GetPackageDependents")
+	}
+
+	return newPreciseDependencyGraphResolver(graph, r.locationResolverFactory.Create()), nil
+}
+
+func dependencyGraphOptions(transitive bool, maxDepth *int32) shared.DependencyGraphOptions {
+	opts := shared.DependencyGraphOptions{Transitive: transitive}
+	if maxDepth != nil {
+		opts.MaxDepth = int(*maxDepth)
+	}
+
+	return opts
+}
+
+//
+//
+
+type preciseDependencyGraphResolver struct {
+	graph            shared.DependencyGraph
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func newPreciseDependencyGraphResolver(graph shared.DependencyGraph, locationResolver *gitresolvers.CachedLocationResolver) resolverstubs.PreciseDependencyGraphResolver {
+	return &preciseDependencyGraphResolver{
+		graph:            graph,
+		locationResolver: locationResolver,
+	}
+}
+
+func (r *preciseDependencyGraphResolver) Nodes() []resolverstubs.PreciseDependencyGraphNodeResolver {
+	resolvers := make([]resolverstubs.PreciseDependencyGraphNodeResolver, 0, len(r.graph.Nodes))
+	for _, node := range r.graph.Nodes {
+		resolvers = append(resolvers, &preciseDependencyGraphNodeResolver{
+			node:             node,
+			locationResolver: r.locationResolver,
+		})
+	}
+
+	return resolvers
+}
+
+func (r *preciseDependencyGraphResolver) Edges() []resolverstubs.PreciseDependencyGraphEdgeResolver {
+	resolvers := make([]resolverstubs.PreciseDependencyGraphEdgeResolver, 0, len(r.graph.Edges))
+	for _, edge := range r.graph.Edges {
+		resolvers = append(resolvers, &preciseDependencyGraphEdgeResolver{
+			edge:             edge,
+			locationResolver: r.locationResolver,
+		})
+	}
+
+	return resolvers
+}
+
+func (r *preciseDependencyGraphResolver) Cycles(ctx context.Context) ([][]resolverstubs.RepositoryResolver, error) {
+	cycles := make([][]resolverstubs.RepositoryResolver, 0, len(r.graph.Cycles))
+	for _, cycle := range r.graph.Cycles {
+		repositories := make([]resolverstubs.RepositoryResolver, 0, len(cycle))
+		for _, repositoryID := range cycle {
+			repository, err := r.locationResolver.Repository(ctx, api.RepoID(repositoryID))
+			if err != nil {
+				return nil, err
+			}
+			if repository != nil {
+				repositories = append(repositories, repository)
+			}
+		}
+
+		cycles = append(cycles, repositories)
+	}
+
+	return cycles, nil
+}
+
+type preciseDependencyGraphNodeResolver struct {
+	node             shared.DependencyGraphNode
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *preciseDependencyGraphNodeResolver) Repository(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	return r.locationResolver.Repository(ctx, api.RepoID(r.node.RepositoryID))
+}
+
+func (r *preciseDependencyGraphNodeResolver) Depth() int32 { return int32(r.node.Depth) }
+
+type preciseDependencyGraphEdgeResolver struct {
+	edge             shared.DependencyGraphEdge
+	locationResolver *gitresolvers.CachedLocationResolver
+}
+
+func (r *preciseDependencyGraphEdgeResolver) Dependent(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	return r.locationResolver.Repository(ctx, api.RepoID(r.edge.DependentID))
+}
+
+func (r *preciseDependencyGraphEdgeResolver) Dependency(ctx context.Context) (resolverstubs.RepositoryResolver, error) {
+	if r.edge.DependencyID == 0 {
+		return nil, nil
+	}
+
+	return r.locationResolver.Repository(ctx, api.RepoID(r.edge.DependencyID))
+}
+
+func (r *preciseDependencyGraphEdgeResolver) Package() resolverstubs.PreciseDependencyPackageResolver {
+	return &preciseDependencyPackageResolver{edge: r.edge}
+}
+
+type preciseDependencyPackageResolver struct {
+	edge shared.DependencyGraphEdge
+}
+
+func (r *preciseDependencyPackageResolver) Scheme() string  { return r.edge.Scheme }
+func (r *preciseDependencyPackageResolver) Manager() string { return r.edge.Manager }
+func (r *preciseDependencyPackageResolver) Name() string    { return r.edge.Name }
+func (r *preciseDependencyPackageResolver) Version() string { return r.edge.Version }
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+)
+
+type exportHandler struct {
+	svc         ExportService
+	authChecker authz.SubRepoPermissionChecker
+	logger      log.Logger
+}
+
+func newExportHandler(svc ExportService, authChecker authz.SubRepoPermissionChecker) http.Handler {
+	return &exportHandler{
+		svc:         svc,
+		authChecker: authChecker,
+		logger:      log.Scoped("uploads.exportHandler"),
+	}
+}
+
+// ServeHTTP writes the SCIP index reconstructed from the processed data of the upload
+// identified by the `upload` query parameter.
+//
+// 🚨 SECURITY: Uploads attached to repositories the current actor cannot see are treated as
+// missing, and documents hidden by sub-repo permissions are omitted from the exported index.
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	uploadID, err := strconv.Atoi(getQuery(r, "upload"))
+	if err != nil {
+		http.Error(w, "upload must be a numeric identifier", http.StatusBadRequest)
+		return
+	}
+
+	upload, ok, err := h.svc.GetUploadByID(ctx, uploadID)
+	if err != nil {
+		h.logger.Error("failed to get upload", log.Int("uploadID", uploadID), log.Error(err))
+		http.Error(w, "failed to get upload", http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		http.Error(w, fmt.Sprintf("upload %d not found", uploadID), http.StatusNotFound)
+		return
+	}
+	if upload.State != "completed" {
+		http.Error(w, fmt.Sprintf("upload %d has not been processed (state: %s)", uploadID, upload.State), http.StatusConflict)
+		return
+	}
+
+	// Response headers are only committed once the first part of the index is written so
+	// that we can still respond with an error status before then.
+	writer := &exportWriter{w: w, filename: fmt.Sprintf("upload-%d.scip", uploadID)}
+
+	ok, err = h.svc.ExportSCIPIndex(ctx, uploadID, writer, h.includeDocument(ctx, upload))
+	if err != nil {
+		h.logger.Error("failed to export SCIP index", log.Int("uploadID", uploadID), log.Error(err))
+		if !writer.written {
+			http.Error(w, "failed to export SCIP index", http.StatusInternalServerError)
+		}
+		return
+	}
+	if !ok {
+		http.Error(w, fmt.Sprintf("upload %d has no precise data", uploadID), http.StatusNotFound)
+		return
+	}
+}
+
+func (h *exportHandler) includeDocument(ctx context.Context, upload shared.Upload) func(path string) (bool, error) {
+	a := actor.FromContext(ctx)
+	repo := api.RepoName(upload.RepositoryName)
+
+	return func(path string) (bool, error) {
+		// Document paths are relative to the root of the upload
+		return authz.FilterActorPath(ctx, h.authChecker, a, repo, upload.Root+path)
+	}
+}
+
+// exportWriter proxies writes to the underlying response writer. The response headers
+// describing the exported file are set immediately before the first write.
+type exportWriter struct {
+	w        http.ResponseWriter
+	filename string
+	written  bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.w.Header().Set("Content-Type", "application/x-protobuf+scip")
+		w.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.filename))
+		w.written = true
+	}
+
+	return w.w.Write(p)
+}
@@ -0,0 +1,123 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestExportHandler(t *testing.T) {
+	mockExportService := NewMockExportService()
+	mockExportService.GetUploadByIDFunc.SetDefaultHook(func(_ context.Context, id int) (shared.Upload, bool, error) {
+		switch id {
+		case 42:
+			return shared.Upload{ID: 42, State: "completed", RepositoryName: "github.com/test/test", Root: "sub/"}, true, nil
+		case 43:
+			return shared.Upload{ID: 43, State: "queued"}, true, nil
+		default:
+			return shared.Upload{}, false, nil
+		}
+	})
+
+	var includedPaths []string
+	mockExportService.ExportSCIPIndexFunc.SetDefaultHook(func(_ context.Context, _ int, w io.Writer, includeDocument func(path string) (bool, error)) (bool, error) {
+		for _, path := range []string{"public/a.go", "private/b.go"} {
+			if ok, err := includeDocument(path); err != nil {
+				return false, err
+			} else if ok {
+				includedPaths = append(includedPaths, path)
+			}
+		}
+
+		_, err := w.Write([]byte("payload"))
+		return true, err
+	})
+
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoFunc.SetDefaultReturn(true, nil)
+	checker.PermissionsFunc.SetDefaultHook(func(_ context.Context, _ int32, content authz.RepoContent) (authz.Perms, error) {
+		if content.Path == "sub/private/b.go" {
+			return authz.None, nil
+		}
+		return authz.Read, nil
+	})
+
+	handler := newExportHandler(mockExportService, checker)
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+	serve := func(query string) *httptest.ResponseRecorder {
+		r, err := http.NewRequestWithContext(ctx, "GET", "/export?"+query, nil)
+		if err != nil {
+			t.Fatalf("unexpected error constructing request: %s", err)
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve("upload=42")
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code. want=%d have=%d", http.StatusOK, w.Code)
+	}
+	if body := w.Body.String(); body != "payload" {
+		t.Errorf("unexpected body. want=%q have=%q", "payload", body)
+	}
+	if contentType := w.Header().Get("Content-Type"); contentType != "application/x-protobuf+scip" {
+		t.Errorf("unexpected content type %q", contentType)
+	}
+	if diff := cmp.Diff([]string{"public/a.go"}, includedPaths); diff != "" {
+		t.Errorf("unexpected included paths (-want +got):\n%s", diff)
+	}
+
+	for query, expectedCode := range map[string]int{
+		"upload=foo": http.StatusBadRequest,
+		"upload=43":  http.StatusConflict,
+		"upload=44":  http.StatusNotFound,
+	} {
+		if w := serve(query); w.Code != expectedCode {
+			t.Errorf("unexpected status code for %q. want=%d have=%d", query, expectedCode, w.Code)
+		}
+	}
+}
+
+func TestExportHandlerErrors(t *testing.T) {
+	mockExportService := NewMockExportService()
+	mockExportService.GetUploadByIDFunc.SetDefaultReturn(shared.Upload{ID: 42, State: "completed"}, true, nil)
+	handler := newExportHandler(mockExportService, authz.NewMockSubRepoPermissionChecker())
+
+	serve := func() *httptest.ResponseRecorder {
+		r, err := http.NewRequest("GET", "/export?upload=42", nil)
+		if err != nil {
+			t.Fatalf("unexpected error constructing request: %s", err)
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	// No precise data
+	mockExportService.ExportSCIPIndexFunc.PushReturn(false, nil)
+	if w := serve(); w.Code != http.StatusNotFound {
+		t.Errorf("unexpected status code. want=%d have=%d", http.StatusNotFound, w.Code)
+	} else if disposition := w.Header().Get("Content-Disposition"); disposition != "" {
+		t.Errorf("unexpected content disposition %q", disposition)
+	}
+
+	// Failure before any data is written
+	mockExportService.ExportSCIPIndexFunc.PushReturn(false, errors.New("uh-oh"))
+	if w := serve(); w.Code != http.StatusInternalServerError {
+		t.Errorf("unexpected status code. want=%d have=%d", http.StatusInternalServerError, w.Code)
+	}
+}
@@ -2,8 +2,10 @@ package http
 
 import (
 	"context"
+	"io"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -11,3 +13,8 @@ type RepoStore interface {
 	GetByName(ctx context.Context, name api.RepoName) (*types.Repo, error)
 	ResolveRev(ctx context.Context, repo *types.Repo, rev string) (api.CommitID, error)
 }
+
+type ExportService interface {
+	GetUploadByID(ctx context.Context, id int) (shared.Upload, bool, error)
+	ExportSCIPIndex(ctx context.Context, uploadID int, w io.Writer, includeDocument func(path string) (bool, error)) (bool, error)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads"
 	"github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/transport/http/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -55,3 +56,9 @@ func GetHandler(svc *uploads.Service, db database.DB, gitserverClient gitserver.
 	}
 	return handler
 }
+
+// NewExportHandler returns a handler that streams the SCIP index reconstructed from the
+// processed data of a single upload.
+func NewExportHandler(svc *uploads.Service) http.Handler {
+	return newExportHandler(svc, authz.DefaultSubRepoPermsChecker)
+}
@@ -8,8 +8,10 @@ package http
 
 import (
 	"context"
+	"io"
 	"sync"
 
+	shared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
 	uploadhandler "github.com/sourcegraph/sourcegraph/internal/uploadhandler"
 )
 
@@ -781,3 +783,290 @@ func (c DBStoreWithTransactionFuncCall[T]) Args() []interface{} {
 func (c DBStoreWithTransactionFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
+
+// MockExportService is a mock implementation of the ExportService interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/transport/http)
+// used for unit testing.
+type MockExportService struct {
+	// ExportSCIPIndexFunc is an instance of a mock function object
+	// controlling the behavior of the method ExportSCIPIndex.
+	ExportSCIPIndexFunc *ExportServiceExportSCIPIndexFunc
+	// GetUploadByIDFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUploadByID.
+	GetUploadByIDFunc *ExportServiceGetUploadByIDFunc
+}
+
+// NewMockExportService creates a new mock of the ExportService interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockExportService() *MockExportService {
+	return &MockExportService{
+		ExportSCIPIndexFunc: &ExportServiceExportSCIPIndexFunc{
+			defaultHook: func(context.Context, int, io.Writer, func(path string) (bool, error)) (r0 bool, r1 error) {
+				return
+			},
+		},
+		GetUploadByIDFunc: &ExportServiceGetUploadByIDFunc{
+			defaultHook: func(context.Context, int) (r0 shared.Upload, r1 bool, r2 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockExportService creates a new mock of the ExportService
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockExportService() *MockExportService {
+	return &MockExportService{
+		ExportSCIPIndexFunc: &ExportServiceExportSCIPIndexFunc{
+			defaultHook: func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error) {
+				panic("unexpected invocation of MockExportService.ExportSCIPIndex")
+			},
+		},
+		GetUploadByIDFunc: &ExportServiceGetUploadByIDFunc{
+			defaultHook: func(context.Context, int) (shared.Upload, bool, error) {
+				panic("unexpected invocation of MockExportService.GetUploadByID")
+			},
+		},
+	}
+}
+
+// NewMockExportServiceFrom creates a new mock of the MockExportService
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockExportServiceFrom(i ExportService) *MockExportService {
+	return &MockExportService{
+		ExportSCIPIndexFunc: &ExportServiceExportSCIPIndexFunc{
+			defaultHook: i.ExportSCIPIndex,
+		},
+		GetUploadByIDFunc: &ExportServiceGetUploadByIDFunc{
+			defaultHook: i.GetUploadByID,
+		},
+	}
+}
+
+// ExportServiceExportSCIPIndexFunc describes the behavior when the
+// ExportSCIPIndex method of the parent MockExportService instance is
+// invoked.
+type ExportServiceExportSCIPIndexFunc struct {
+	defaultHook func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error)
+	hooks       []func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error)
+	history     []ExportServiceExportSCIPIndexFuncCall
+	mutex       sync.Mutex
+}
+
+// ExportSCIPIndex delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockExportService) ExportSCIPIndex(v0 context.Context, v1 int, v2 io.Writer, v3 func(path string) (bool, error)) (bool, error) {
+	r0, r1 := m.ExportSCIPIndexFunc.nextHook()(v0, v1, v2, v3)
+	m.ExportSCIPIndexFunc.appendCall(ExportServiceExportSCIPIndexFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ExportSCIPIndex
+// method of the parent MockExportService instance is invoked and the hook
+// queue is empty.
+func (f *ExportServiceExportSCIPIndexFunc) SetDefaultHook(hook func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ExportSCIPIndex method of the parent MockExportService instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ExportServiceExportSCIPIndexFunc) PushHook(hook func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ExportServiceExportSCIPIndexFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ExportServiceExportSCIPIndexFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *ExportServiceExportSCIPIndexFunc) nextHook() func(context.Context, int, io.Writer, func(path string) (bool, error)) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ExportServiceExportSCIPIndexFunc) appendCall(r0 ExportServiceExportSCIPIndexFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ExportServiceExportSCIPIndexFuncCall
+// objects describing the invocations of this function.
+func (f *ExportServiceExportSCIPIndexFunc) History() []ExportServiceExportSCIPIndexFuncCall {
+	f.mutex.Lock()
+	history := make([]ExportServiceExportSCIPIndexFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ExportServiceExportSCIPIndexFuncCall is an object that describes an
+// invocation of method ExportSCIPIndex on an instance of MockExportService.
+type ExportServiceExportSCIPIndexFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 io.Writer
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 func(path string) (bool, error)
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ExportServiceExportSCIPIndexFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ExportServiceExportSCIPIndexFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ExportServiceGetUploadByIDFunc describes the behavior when the
+// GetUploadByID method of the parent MockExportService instance is invoked.
+type ExportServiceGetUploadByIDFunc struct {
+	defaultHook func(context.Context, int) (shared.Upload, bool, error)
+	hooks       []func(context.Context, int) (shared.Upload, bool, error)
+	history     []ExportServiceGetUploadByIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUploadByID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockExportService) GetUploadByID(v0 context.Context, v1 int) (shared.Upload, bool, error) {
+	r0, r1, r2 := m.GetUploadByIDFunc.nextHook()(v0, v1)
+	m.GetUploadByIDFunc.appendCall(ExportServiceGetUploadByIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetUploadByID method
+// of the parent MockExportService instance is invoked and the hook queue is
+// empty.
+func (f *ExportServiceGetUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (shared.Upload, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUploadByID method of the parent MockExportService instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ExportServiceGetUploadByIDFunc) PushHook(hook func(context.Context, int) (shared.Upload, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ExportServiceGetUploadByIDFunc) SetDefaultReturn(r0 shared.Upload, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (shared.Upload, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ExportServiceGetUploadByIDFunc) PushReturn(r0 shared.Upload, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (shared.Upload, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ExportServiceGetUploadByIDFunc) nextHook() func(context.Context, int) (shared.Upload, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ExportServiceGetUploadByIDFunc) appendCall(r0 ExportServiceGetUploadByIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ExportServiceGetUploadByIDFuncCall objects
+// describing the invocations of this function.
+func (f *ExportServiceGetUploadByIDFunc) History() []ExportServiceGetUploadByIDFuncCall {
+	f.mutex.Lock()
+	history := make([]ExportServiceGetUploadByIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ExportServiceGetUploadByIDFuncCall is an object that describes an
+// invocation of method GetUploadByID on an instance of MockExportService.
+type ExportServiceGetUploadByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 shared.Upload
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ExportServiceGetUploadByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ExportServiceGetUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
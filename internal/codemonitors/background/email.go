@@ -29,7 +29,7 @@ func SendEmailForNewSearchResult(ctx context.Context, db database.DB, userID int
 	if MockSendEmailForNewSearchResult != nil {
 		return MockSendEmailForNewSearchResult(ctx, db, userID, data)
 	}
-	return sendEmail(ctx, db, userID, newSearchResultsEmailTemplates, data)
+	return SendEmail(ctx, db, "code-monitor", userID, newSearchResultsEmailTemplates, data)
 }
 
 var (
@@ -118,7 +118,9 @@ func NewTestTemplateDataForNewSearchResults(monitorDescription string) *Template
 	}
 }
 
-func sendEmail(ctx context.Context, db database.DB, userID int32, template txtypes.Templates, data any) error {
+// SendEmail renders template with data and sends it to the verified primary email address of
+// the given user. source identifies the sender of the email in logs and metrics.
+func SendEmail(ctx context.Context, db database.DB, source string, userID int32, template txtypes.Templates, data any) error {
 	email, verified, err := db.UserEmails().GetPrimaryEmail(ctx, userID)
 	if err != nil {
 		if errcode.IsNotFound(err) {
@@ -130,7 +132,7 @@ func sendEmail(ctx context.Context, db database.DB, userID int32, template txtyp
 		return errors.Newf("unable to send email to user ID %d's unverified primary email address", userID)
 	}
 
-	if err := txemail.Send(ctx, "code-monitor", txtypes.Message{
+	if err := txemail.Send(ctx, source, txtypes.Message{
 		To:       []string{email},
 		Template: template,
 		Data:     data,
@@ -17,7 +17,7 @@ import (
 )
 
 func sendSlackNotification(ctx context.Context, url string, args actionArgs) error {
-	return postSlackWebhook(ctx, httpcli.ExternalDoer, url, slackPayload(args))
+	return PostSlackWebhook(ctx, httpcli.ExternalDoer, url, slackPayload(args))
 }
 
 func slackPayload(args actionArgs) *slack.WebhookMessage {
@@ -142,8 +142,11 @@ func truncateResults(results []*searchresult.CommitMatch, maxResults int) (_ []*
 	return output, totalCount, totalCount - outputCount
 }
 
+// PostSlackWebhook posts msg to the Slack incoming webhook at url. It returns a StatusCodeError
+// if Slack doesn't respond with 200 OK.
+//
 // adapted from slack.PostWebhookCustomHTTPContext
-func postSlackWebhook(ctx context.Context, doer httpcli.Doer, url string, msg *slack.WebhookMessage) error {
+func PostSlackWebhook(ctx context.Context, doer httpcli.Doer, url string, msg *slack.WebhookMessage) error {
 	raw, err := json.Marshal(msg)
 	if err != nil {
 		return errors.Wrap(err, "marshal failed")
@@ -189,5 +192,5 @@ func SendTestSlackWebhook(ctx context.Context, doer httpcli.Doer, description, u
 		),
 	}}}
 
-	return postSlackWebhook(ctx, doer, url, testMessage)
+	return PostSlackWebhook(ctx, doer, url, testMessage)
 }
@@ -14,10 +14,12 @@ import (
 )
 
 func sendWebhookNotification(ctx context.Context, url string, args actionArgs) error {
-	return postWebhook(ctx, httpcli.ExternalDoer, url, generateWebhookPayload(args))
+	return PostWebhook(ctx, httpcli.ExternalDoer, url, generateWebhookPayload(args))
 }
 
-func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload webhookPayload) error {
+// PostWebhook posts the JSON encoding of payload to url. It returns a StatusCodeError if the
+// webhook doesn't respond with 200 OK.
+func PostWebhook(ctx context.Context, doer httpcli.Doer, url string, payload any) error {
 	raw, err := json.Marshal(payload)
 	if err != nil {
 		return errors.Wrap(err, "marshal failed")
@@ -53,7 +55,7 @@ func SendTestWebhook(ctx context.Context, doer httpcli.Doer, description string,
 		MonitorDescription: description,
 		Query:              "test query",
 	}
-	return postWebhook(ctx, doer, u, generateWebhookPayload(args))
+	return PostWebhook(ctx, doer, u, generateWebhookPayload(args))
 }
 
 type webhookPayload struct {
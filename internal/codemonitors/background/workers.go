@@ -40,7 +40,7 @@ func newTriggerQueryRunner(ctx context.Context, observationCtx *observation.Cont
 		MaximumRuntimePerJob: time.Minute,
 	}
 
-	store := createDBWorkerStoreForTriggerJobs(observationCtx, db)
+	store := CreateDBWorkerStoreForTriggerJobs(observationCtx, db)
 
 	worker := dbworker.NewWorker[*database.TriggerJob](ctx, store, &queryRunner{db: db}, options)
 	return worker
@@ -63,7 +63,7 @@ func newTriggerQueryEnqueuer(ctx context.Context, store database.CodeMonitorStor
 }
 
 func newTriggerQueryResetter(_ context.Context, observationCtx *observation.Context, s database.CodeMonitorStore, metrics codeMonitorsMetrics) *dbworker.Resetter[*database.TriggerJob] {
-	workerStore := createDBWorkerStoreForTriggerJobs(observationCtx, s)
+	workerStore := CreateDBWorkerStoreForTriggerJobs(observationCtx, s)
 
 	options := dbworker.ResetterOptions{
 		Name:     "code_monitors_trigger_jobs_worker_resetter",
@@ -101,14 +101,14 @@ func newActionRunner(ctx context.Context, observationCtx *observation.Context, s
 		Metrics:           metrics.workerMetrics,
 	}
 
-	store := createDBWorkerStoreForActionJobs(observationCtx, s)
+	store := CreateDBWorkerStoreForActionJobs(observationCtx, s)
 
 	worker := dbworker.NewWorker[*database.ActionJob](ctx, store, &actionRunner{s}, options)
 	return worker
 }
 
 func newActionJobResetter(_ context.Context, observationCtx *observation.Context, s database.CodeMonitorStore, metrics codeMonitorsMetrics) *dbworker.Resetter[*database.ActionJob] {
-	workerStore := createDBWorkerStoreForActionJobs(observationCtx, s)
+	workerStore := CreateDBWorkerStoreForActionJobs(observationCtx, s)
 
 	options := dbworker.ResetterOptions{
 		Name:     "code_monitors_action_jobs_worker_resetter",
@@ -122,7 +122,7 @@ func newActionJobResetter(_ context.Context, observationCtx *observation.Context
 	return dbworker.NewResetter(observationCtx.Logger, workerStore, options)
 }
 
-func createDBWorkerStoreForTriggerJobs(observationCtx *observation.Context, s basestore.ShareableStore) dbworkerstore.Store[*database.TriggerJob] {
+func CreateDBWorkerStoreForTriggerJobs(observationCtx *observation.Context, s basestore.ShareableStore) dbworkerstore.Store[*database.TriggerJob] {
 	observationCtx = observation.ContextWithLogger(observationCtx.Logger.Scoped("triggerJobs.dbworker.Store"), observationCtx)
 
 	return dbworkerstore.New(observationCtx, s.Handle(), dbworkerstore.Options[*database.TriggerJob]{
@@ -137,7 +137,7 @@ func createDBWorkerStoreForTriggerJobs(observationCtx *observation.Context, s ba
 	})
 }
 
-func createDBWorkerStoreForActionJobs(observationCtx *observation.Context, s database.CodeMonitorStore) dbworkerstore.Store[*database.ActionJob] {
+func CreateDBWorkerStoreForActionJobs(observationCtx *observation.Context, s database.CodeMonitorStore) dbworkerstore.Store[*database.ActionJob] {
 	observationCtx = observation.ContextWithLogger(observationCtx.Logger.Scoped("actionJobs.dbworker.Store"), observationCtx)
 
 	return dbworkerstore.New(observationCtx, s.Handle(), dbworkerstore.Options[*database.ActionJob]{
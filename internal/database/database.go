@@ -46,8 +46,10 @@ type DB interface {
 	OutboundWebhooks(encryption.Key) OutboundWebhookStore
 	OutboundWebhookJobs(encryption.Key) OutboundWebhookJobStore
 	OutboundWebhookLogs(encryption.Key) OutboundWebhookLogStore
+	OwnDriftFindings() OwnDriftFindingStore
 	OwnershipStats() OwnershipStatsStore
 	RecentContributionSignals() RecentContributionSignalStore
+	RecentReviewSignals() RecentReviewSignalStore
 	Perms() PermsStore
 	Permissions() PermissionStore
 	PermissionSyncJobs() PermissionSyncJobStore
@@ -230,6 +232,10 @@ func (d *db) OutboundWebhookLogs(key encryption.Key) OutboundWebhookLogStore {
 	return OutboundWebhookLogsWith(d.Store, key)
 }
 
+func (d *db) OwnDriftFindings() OwnDriftFindingStore {
+	return OwnDriftFindingStoreWith(d.Store)
+}
+
 func (d *db) OwnershipStats() OwnershipStatsStore {
 	return &ownershipStats{d.Store}
 }
@@ -238,6 +244,10 @@ func (d *db) RecentContributionSignals() RecentContributionSignalStore {
 	return RecentContributionSignalStoreWith(d.Store)
 }
 
+func (d *db) RecentReviewSignals() RecentReviewSignalStore {
+	return RecentReviewSignalStoreWith(d.Store)
+}
+
 func (d *db) Permissions() PermissionStore {
 	return PermissionsWith(d.Store)
 }
@@ -15121,6 +15121,9 @@ type MockDB struct {
 	// OutboundWebhooksFunc is an instance of a mock function object
 	// controlling the behavior of the method OutboundWebhooks.
 	OutboundWebhooksFunc *DBOutboundWebhooksFunc
+	// OwnDriftFindingsFunc is an instance of a mock function object controlling
+	// the behavior of the method OwnDriftFindings.
+	OwnDriftFindingsFunc *DBOwnDriftFindingsFunc
 	// OwnSignalConfigurationsFunc is an instance of a mock function object
 	// controlling the behavior of the method OwnSignalConfigurations.
 	OwnSignalConfigurationsFunc *DBOwnSignalConfigurationsFunc
@@ -15149,6 +15152,9 @@ type MockDB struct {
 	// object controlling the behavior of the method
 	// RecentContributionSignals.
 	RecentContributionSignalsFunc *DBRecentContributionSignalsFunc
+	// RecentReviewSignalsFunc is an instance of a mock function object
+	// controlling the behavior of the method RecentReviewSignals.
+	RecentReviewSignalsFunc *DBRecentReviewSignalsFunc
 	// RecentViewSignalFunc is an instance of a mock function object
 	// controlling the behavior of the method RecentViewSignal.
 	RecentViewSignalFunc *DBRecentViewSignalFunc
@@ -15389,6 +15395,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		OwnDriftFindingsFunc: &DBOwnDriftFindingsFunc{
+			defaultHook: func() (r0 database.OwnDriftFindingStore) {
+				return
+			},
+		},
 		OwnSignalConfigurationsFunc: &DBOwnSignalConfigurationsFunc{
 			defaultHook: func() (r0 database.SignalConfigurationStore) {
 				return
@@ -15434,6 +15445,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		RecentReviewSignalsFunc: &DBRecentReviewSignalsFunc{
+			defaultHook: func() (r0 database.RecentReviewSignalStore) {
+				return
+			},
+		},
 		RecentViewSignalFunc: &DBRecentViewSignalFunc{
 			defaultHook: func() (r0 database.RecentViewSignalStore) {
 				return
@@ -15726,6 +15742,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.OutboundWebhooks")
 			},
 		},
+		OwnDriftFindingsFunc: &DBOwnDriftFindingsFunc{
+			defaultHook: func() database.OwnDriftFindingStore {
+				panic("unexpected invocation of MockDB.OwnDriftFindings")
+			},
+		},
 		OwnSignalConfigurationsFunc: &DBOwnSignalConfigurationsFunc{
 			defaultHook: func() database.SignalConfigurationStore {
 				panic("unexpected invocation of MockDB.OwnSignalConfigurations")
@@ -15771,6 +15792,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.RecentContributionSignals")
 			},
 		},
+		RecentReviewSignalsFunc: &DBRecentReviewSignalsFunc{
+			defaultHook: func() database.RecentReviewSignalStore {
+				panic("unexpected invocation of MockDB.RecentReviewSignals")
+			},
+		},
 		RecentViewSignalFunc: &DBRecentViewSignalFunc{
 			defaultHook: func() database.RecentViewSignalStore {
 				panic("unexpected invocation of MockDB.RecentViewSignal")
@@ -16001,6 +16027,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		OutboundWebhooksFunc: &DBOutboundWebhooksFunc{
 			defaultHook: i.OutboundWebhooks,
 		},
+		OwnDriftFindingsFunc: &DBOwnDriftFindingsFunc{
+			defaultHook: i.OwnDriftFindings,
+		},
 		OwnSignalConfigurationsFunc: &DBOwnSignalConfigurationsFunc{
 			defaultHook: i.OwnSignalConfigurations,
 		},
@@ -16028,6 +16057,9 @@ func NewMockDBFrom(i database.DB) *MockDB {
 		RecentContributionSignalsFunc: &DBRecentContributionSignalsFunc{
 			defaultHook: i.RecentContributionSignals,
 		},
+		RecentReviewSignalsFunc: &DBRecentReviewSignalsFunc{
+			defaultHook: i.RecentReviewSignals,
+		},
 		RecentViewSignalFunc: &DBRecentViewSignalFunc{
 			defaultHook: i.RecentViewSignal,
 		},
@@ -19194,6 +19226,105 @@ func (c DBOutboundWebhooksFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBOwnDriftFindingsFunc describes the behavior when the OwnDriftFindings
+// method of the parent MockDB instance is invoked.
+type DBOwnDriftFindingsFunc struct {
+	defaultHook func() database.OwnDriftFindingStore
+	hooks       []func() database.OwnDriftFindingStore
+	history     []DBOwnDriftFindingsFuncCall
+	mutex       sync.Mutex
+}
+
+// OwnDriftFindings delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDB) OwnDriftFindings() database.OwnDriftFindingStore {
+	r0 := m.OwnDriftFindingsFunc.nextHook()()
+	m.OwnDriftFindingsFunc.appendCall(DBOwnDriftFindingsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the OwnDriftFindings
+// method of the parent MockDB instance is invoked and the hook queue is
+// empty.
+func (f *DBOwnDriftFindingsFunc) SetDefaultHook(hook func() database.OwnDriftFindingStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// OwnDriftFindings method of the parent MockDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBOwnDriftFindingsFunc) PushHook(hook func() database.OwnDriftFindingStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBOwnDriftFindingsFunc) SetDefaultReturn(r0 database.OwnDriftFindingStore) {
+	f.SetDefaultHook(func() database.OwnDriftFindingStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBOwnDriftFindingsFunc) PushReturn(r0 database.OwnDriftFindingStore) {
+	f.PushHook(func() database.OwnDriftFindingStore {
+		return r0
+	})
+}
+
+func (f *DBOwnDriftFindingsFunc) nextHook() func() database.OwnDriftFindingStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBOwnDriftFindingsFunc) appendCall(r0 DBOwnDriftFindingsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBOwnDriftFindingsFuncCall objects
+// describing the invocations of this function.
+func (f *DBOwnDriftFindingsFunc) History() []DBOwnDriftFindingsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBOwnDriftFindingsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBOwnDriftFindingsFuncCall is an object that describes an invocation of
+// method OwnDriftFindings on an instance of MockDB.
+type DBOwnDriftFindingsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.OwnDriftFindingStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBOwnDriftFindingsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBOwnDriftFindingsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBOwnSignalConfigurationsFunc describes the behavior when the
 // OwnSignalConfigurations method of the parent MockDB instance is invoked.
 type DBOwnSignalConfigurationsFunc struct {
@@ -20117,6 +20248,105 @@ func (c DBRecentContributionSignalsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBRecentReviewSignalsFunc describes the behavior when the
+// RecentReviewSignals method of the parent MockDB instance is invoked.
+type DBRecentReviewSignalsFunc struct {
+	defaultHook func() database.RecentReviewSignalStore
+	hooks       []func() database.RecentReviewSignalStore
+	history     []DBRecentReviewSignalsFuncCall
+	mutex       sync.Mutex
+}
+
+// RecentReviewSignals delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDB) RecentReviewSignals() database.RecentReviewSignalStore {
+	r0 := m.RecentReviewSignalsFunc.nextHook()()
+	m.RecentReviewSignalsFunc.appendCall(DBRecentReviewSignalsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RecentReviewSignals
+// method of the parent MockDB instance is invoked and the hook queue is
+// empty.
+func (f *DBRecentReviewSignalsFunc) SetDefaultHook(hook func() database.RecentReviewSignalStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RecentReviewSignals method of the parent MockDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBRecentReviewSignalsFunc) PushHook(hook func() database.RecentReviewSignalStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBRecentReviewSignalsFunc) SetDefaultReturn(r0 database.RecentReviewSignalStore) {
+	f.SetDefaultHook(func() database.RecentReviewSignalStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBRecentReviewSignalsFunc) PushReturn(r0 database.RecentReviewSignalStore) {
+	f.PushHook(func() database.RecentReviewSignalStore {
+		return r0
+	})
+}
+
+func (f *DBRecentReviewSignalsFunc) nextHook() func() database.RecentReviewSignalStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBRecentReviewSignalsFunc) appendCall(r0 DBRecentReviewSignalsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBRecentReviewSignalsFuncCall objects
+// describing the invocations of this function.
+func (f *DBRecentReviewSignalsFunc) History() []DBRecentReviewSignalsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBRecentReviewSignalsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBRecentReviewSignalsFuncCall is an object that describes an invocation of
+// method RecentReviewSignals on an instance of MockDB.
+type DBRecentReviewSignalsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RecentReviewSignalStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBRecentReviewSignalsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBRecentReviewSignalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBRecentViewSignalFunc describes the behavior when the RecentViewSignal
 // method of the parent MockDB instance is invoked.
 type DBRecentViewSignalFunc struct {
@@ -49833,6 +50063,538 @@ func (c OutboundWebhookStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockOwnDriftFindingStore is a mock implementation of the
+// OwnDriftFindingStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockOwnDriftFindingStore struct {
+	// CountFindingsFunc is an instance of a mock function object controlling the
+	// behavior of the method CountFindings.
+	CountFindingsFunc *OwnDriftFindingStoreCountFindingsFunc
+	// ListFindingsFunc is an instance of a mock function object controlling the
+	// behavior of the method ListFindings.
+	ListFindingsFunc *OwnDriftFindingStoreListFindingsFunc
+	// ReplaceFindingsFunc is an instance of a mock function object controlling
+	// the behavior of the method ReplaceFindings.
+	ReplaceFindingsFunc *OwnDriftFindingStoreReplaceFindingsFunc
+	// WithTransactFunc is an instance of a mock function object controlling the
+	// behavior of the method WithTransact.
+	WithTransactFunc *OwnDriftFindingStoreWithTransactFunc
+}
+
+// NewMockOwnDriftFindingStore creates a new mock of the OwnDriftFindingStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockOwnDriftFindingStore() *MockOwnDriftFindingStore {
+	return &MockOwnDriftFindingStore{
+		CountFindingsFunc: &OwnDriftFindingStoreCountFindingsFunc{
+			defaultHook: func(context.Context, database.ListOwnDriftFindingsOpts) (r0 int32, r1 error) {
+				return
+			},
+		},
+		ListFindingsFunc: &OwnDriftFindingStoreListFindingsFunc{
+			defaultHook: func(context.Context, database.ListOwnDriftFindingsOpts) (r0 []*database.OwnDriftFinding, r1 int32, r2 error) {
+				return
+			},
+		},
+		ReplaceFindingsFunc: &OwnDriftFindingStoreReplaceFindingsFunc{
+			defaultHook: func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) (r0 error) {
+				return
+			},
+		},
+		WithTransactFunc: &OwnDriftFindingStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.OwnDriftFindingStore) error) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockOwnDriftFindingStore creates a new mock of the
+// OwnDriftFindingStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockOwnDriftFindingStore() *MockOwnDriftFindingStore {
+	return &MockOwnDriftFindingStore{
+		CountFindingsFunc: &OwnDriftFindingStoreCountFindingsFunc{
+			defaultHook: func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error) {
+				panic("unexpected invocation of MockOwnDriftFindingStore.CountFindings")
+			},
+		},
+		ListFindingsFunc: &OwnDriftFindingStoreListFindingsFunc{
+			defaultHook: func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error) {
+				panic("unexpected invocation of MockOwnDriftFindingStore.ListFindings")
+			},
+		},
+		ReplaceFindingsFunc: &OwnDriftFindingStoreReplaceFindingsFunc{
+			defaultHook: func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error {
+				panic("unexpected invocation of MockOwnDriftFindingStore.ReplaceFindings")
+			},
+		},
+		WithTransactFunc: &OwnDriftFindingStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.OwnDriftFindingStore) error) error {
+				panic("unexpected invocation of MockOwnDriftFindingStore.WithTransact")
+			},
+		},
+	}
+}
+
+// NewMockOwnDriftFindingStoreFrom creates a new mock of the
+// MockOwnDriftFindingStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockOwnDriftFindingStoreFrom(i database.OwnDriftFindingStore) *MockOwnDriftFindingStore {
+	return &MockOwnDriftFindingStore{
+		CountFindingsFunc: &OwnDriftFindingStoreCountFindingsFunc{
+			defaultHook: i.CountFindings,
+		},
+		ListFindingsFunc: &OwnDriftFindingStoreListFindingsFunc{
+			defaultHook: i.ListFindings,
+		},
+		ReplaceFindingsFunc: &OwnDriftFindingStoreReplaceFindingsFunc{
+			defaultHook: i.ReplaceFindings,
+		},
+		WithTransactFunc: &OwnDriftFindingStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
+	}
+}
+
+// OwnDriftFindingStoreCountFindingsFunc describes the behavior when the
+// CountFindings method of the parent MockOwnDriftFindingStore instance is
+// invoked.
+type OwnDriftFindingStoreCountFindingsFunc struct {
+	defaultHook func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error)
+	hooks       []func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error)
+	history     []OwnDriftFindingStoreCountFindingsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountFindings delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockOwnDriftFindingStore) CountFindings(v0 context.Context, v1 database.ListOwnDriftFindingsOpts) (int32, error) {
+	r0, r1 := m.CountFindingsFunc.nextHook()(v0, v1)
+	m.CountFindingsFunc.appendCall(OwnDriftFindingStoreCountFindingsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountFindings method
+// of the parent MockOwnDriftFindingStore instance is invoked and the hook
+// queue is empty.
+func (f *OwnDriftFindingStoreCountFindingsFunc) SetDefaultHook(hook func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountFindings method of the parent MockOwnDriftFindingStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnDriftFindingStoreCountFindingsFunc) PushHook(hook func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OwnDriftFindingStoreCountFindingsFunc) SetDefaultReturn(r0 int32, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OwnDriftFindingStoreCountFindingsFunc) PushReturn(r0 int32, r1 error) {
+	f.PushHook(func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error) {
+		return r0, r1
+	})
+}
+
+func (f *OwnDriftFindingStoreCountFindingsFunc) nextHook() func(context.Context, database.ListOwnDriftFindingsOpts) (int32, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OwnDriftFindingStoreCountFindingsFunc) appendCall(r0 OwnDriftFindingStoreCountFindingsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OwnDriftFindingStoreCountFindingsFuncCall
+// objects describing the invocations of this function.
+func (f *OwnDriftFindingStoreCountFindingsFunc) History() []OwnDriftFindingStoreCountFindingsFuncCall {
+	f.mutex.Lock()
+	history := make([]OwnDriftFindingStoreCountFindingsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OwnDriftFindingStoreCountFindingsFuncCall is an object that describes an
+// invocation of method CountFindings on an instance of
+// MockOwnDriftFindingStore.
+type OwnDriftFindingStoreCountFindingsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 database.ListOwnDriftFindingsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int32
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OwnDriftFindingStoreCountFindingsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OwnDriftFindingStoreCountFindingsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// OwnDriftFindingStoreListFindingsFunc describes the behavior when the
+// ListFindings method of the parent MockOwnDriftFindingStore instance is
+// invoked.
+type OwnDriftFindingStoreListFindingsFunc struct {
+	defaultHook func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error)
+	hooks       []func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error)
+	history     []OwnDriftFindingStoreListFindingsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListFindings delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockOwnDriftFindingStore) ListFindings(v0 context.Context, v1 database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error) {
+	r0, r1, r2 := m.ListFindingsFunc.nextHook()(v0, v1)
+	m.ListFindingsFunc.appendCall(OwnDriftFindingStoreListFindingsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListFindings method
+// of the parent MockOwnDriftFindingStore instance is invoked and the hook
+// queue is empty.
+func (f *OwnDriftFindingStoreListFindingsFunc) SetDefaultHook(hook func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListFindings method of the parent MockOwnDriftFindingStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnDriftFindingStoreListFindingsFunc) PushHook(hook func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OwnDriftFindingStoreListFindingsFunc) SetDefaultReturn(r0 []*database.OwnDriftFinding, r1 int32, r2 error) {
+	f.SetDefaultHook(func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OwnDriftFindingStoreListFindingsFunc) PushReturn(r0 []*database.OwnDriftFinding, r1 int32, r2 error) {
+	f.PushHook(func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *OwnDriftFindingStoreListFindingsFunc) nextHook() func(context.Context, database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OwnDriftFindingStoreListFindingsFunc) appendCall(r0 OwnDriftFindingStoreListFindingsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OwnDriftFindingStoreListFindingsFuncCall
+// objects describing the invocations of this function.
+func (f *OwnDriftFindingStoreListFindingsFunc) History() []OwnDriftFindingStoreListFindingsFuncCall {
+	f.mutex.Lock()
+	history := make([]OwnDriftFindingStoreListFindingsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OwnDriftFindingStoreListFindingsFuncCall is an object that describes an
+// invocation of method ListFindings on an instance of
+// MockOwnDriftFindingStore.
+type OwnDriftFindingStoreListFindingsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 database.ListOwnDriftFindingsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.OwnDriftFinding
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int32
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OwnDriftFindingStoreListFindingsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OwnDriftFindingStoreListFindingsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// OwnDriftFindingStoreReplaceFindingsFunc describes the behavior when the
+// ReplaceFindings method of the parent MockOwnDriftFindingStore instance is
+// invoked.
+type OwnDriftFindingStoreReplaceFindingsFunc struct {
+	defaultHook func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error
+	hooks       []func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error
+	history     []OwnDriftFindingStoreReplaceFindingsFuncCall
+	mutex       sync.Mutex
+}
+
+// ReplaceFindings delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockOwnDriftFindingStore) ReplaceFindings(v0 context.Context, v1 api.RepoID, v2 []database.OwnDriftFinding, v3 time.Time) error {
+	r0 := m.ReplaceFindingsFunc.nextHook()(v0, v1, v2, v3)
+	m.ReplaceFindingsFunc.appendCall(OwnDriftFindingStoreReplaceFindingsFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ReplaceFindings
+// method of the parent MockOwnDriftFindingStore instance is invoked and the
+// hook queue is empty.
+func (f *OwnDriftFindingStoreReplaceFindingsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReplaceFindings method of the parent MockOwnDriftFindingStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnDriftFindingStoreReplaceFindingsFunc) PushHook(hook func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OwnDriftFindingStoreReplaceFindingsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OwnDriftFindingStoreReplaceFindingsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error {
+		return r0
+	})
+}
+
+func (f *OwnDriftFindingStoreReplaceFindingsFunc) nextHook() func(context.Context, api.RepoID, []database.OwnDriftFinding, time.Time) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OwnDriftFindingStoreReplaceFindingsFunc) appendCall(r0 OwnDriftFindingStoreReplaceFindingsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OwnDriftFindingStoreReplaceFindingsFuncCall
+// objects describing the invocations of this function.
+func (f *OwnDriftFindingStoreReplaceFindingsFunc) History() []OwnDriftFindingStoreReplaceFindingsFuncCall {
+	f.mutex.Lock()
+	history := make([]OwnDriftFindingStoreReplaceFindingsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OwnDriftFindingStoreReplaceFindingsFuncCall is an object that describes an
+// invocation of method ReplaceFindings on an instance of
+// MockOwnDriftFindingStore.
+type OwnDriftFindingStoreReplaceFindingsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method invocation.
+	Arg2 []database.OwnDriftFinding
+	// Arg3 is the value of the 4th argument passed to this method invocation.
+	Arg3 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OwnDriftFindingStoreReplaceFindingsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OwnDriftFindingStoreReplaceFindingsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// OwnDriftFindingStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockOwnDriftFindingStore instance is
+// invoked.
+type OwnDriftFindingStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(store database.OwnDriftFindingStore) error) error
+	hooks       []func(context.Context, func(store database.OwnDriftFindingStore) error) error
+	history     []OwnDriftFindingStoreWithTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockOwnDriftFindingStore) WithTransact(v0 context.Context, v1 func(store database.OwnDriftFindingStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(OwnDriftFindingStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockOwnDriftFindingStore instance is invoked and the hook
+// queue is empty.
+func (f *OwnDriftFindingStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(store database.OwnDriftFindingStore) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransact method of the parent MockOwnDriftFindingStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnDriftFindingStoreWithTransactFunc) PushHook(hook func(context.Context, func(store database.OwnDriftFindingStore) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *OwnDriftFindingStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(store database.OwnDriftFindingStore) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *OwnDriftFindingStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(store database.OwnDriftFindingStore) error) error {
+		return r0
+	})
+}
+
+func (f *OwnDriftFindingStoreWithTransactFunc) nextHook() func(context.Context, func(store database.OwnDriftFindingStore) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *OwnDriftFindingStoreWithTransactFunc) appendCall(r0 OwnDriftFindingStoreWithTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of OwnDriftFindingStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *OwnDriftFindingStoreWithTransactFunc) History() []OwnDriftFindingStoreWithTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]OwnDriftFindingStoreWithTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// OwnDriftFindingStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of
+// MockOwnDriftFindingStore.
+type OwnDriftFindingStoreWithTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 func(store database.OwnDriftFindingStore) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c OwnDriftFindingStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c OwnDriftFindingStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockOwnershipStatsStore is a mock implementation of the
 // OwnershipStatsStore interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
@@ -58536,6 +59298,531 @@ func (c RecentContributionSignalStoreWithTransactFuncCall) Results() []interface
 	return []interface{}{c.Result0}
 }
 
+// MockRecentReviewSignalStore is a mock implementation of the
+// RecentReviewSignalStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRecentReviewSignalStore struct {
+	// AddReviewFunc is an instance of a mock function object controlling the
+	// behavior of the method AddReview.
+	AddReviewFunc *RecentReviewSignalStoreAddReviewFunc
+	// ClearSignalsFunc is an instance of a mock function object controlling the
+	// behavior of the method ClearSignals.
+	ClearSignalsFunc *RecentReviewSignalStoreClearSignalsFunc
+	// FindRecentReviewersFunc is an instance of a mock function object
+	// controlling the behavior of the method FindRecentReviewers.
+	FindRecentReviewersFunc *RecentReviewSignalStoreFindRecentReviewersFunc
+	// WithTransactFunc is an instance of a mock function object controlling the
+	// behavior of the method WithTransact.
+	WithTransactFunc *RecentReviewSignalStoreWithTransactFunc
+}
+
+// NewMockRecentReviewSignalStore creates a new mock of the
+// RecentReviewSignalStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockRecentReviewSignalStore() *MockRecentReviewSignalStore {
+	return &MockRecentReviewSignalStore{
+		AddReviewFunc: &RecentReviewSignalStoreAddReviewFunc{
+			defaultHook: func(context.Context, database.Review) (r0 error) {
+				return
+			},
+		},
+		ClearSignalsFunc: &RecentReviewSignalStoreClearSignalsFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 error) {
+				return
+			},
+		},
+		FindRecentReviewersFunc: &RecentReviewSignalStoreFindRecentReviewersFunc{
+			defaultHook: func(context.Context, api.RepoID, string) (r0 []database.RecentReviewerSummary, r1 error) {
+				return
+			},
+		},
+		WithTransactFunc: &RecentReviewSignalStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.RecentReviewSignalStore) error) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRecentReviewSignalStore creates a new mock of the
+// RecentReviewSignalStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRecentReviewSignalStore() *MockRecentReviewSignalStore {
+	return &MockRecentReviewSignalStore{
+		AddReviewFunc: &RecentReviewSignalStoreAddReviewFunc{
+			defaultHook: func(context.Context, database.Review) error {
+				panic("unexpected invocation of MockRecentReviewSignalStore.AddReview")
+			},
+		},
+		ClearSignalsFunc: &RecentReviewSignalStoreClearSignalsFunc{
+			defaultHook: func(context.Context, api.RepoID) error {
+				panic("unexpected invocation of MockRecentReviewSignalStore.ClearSignals")
+			},
+		},
+		FindRecentReviewersFunc: &RecentReviewSignalStoreFindRecentReviewersFunc{
+			defaultHook: func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+				panic("unexpected invocation of MockRecentReviewSignalStore.FindRecentReviewers")
+			},
+		},
+		WithTransactFunc: &RecentReviewSignalStoreWithTransactFunc{
+			defaultHook: func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+				panic("unexpected invocation of MockRecentReviewSignalStore.WithTransact")
+			},
+		},
+	}
+}
+
+// NewMockRecentReviewSignalStoreFrom creates a new mock of the
+// MockRecentReviewSignalStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRecentReviewSignalStoreFrom(i database.RecentReviewSignalStore) *MockRecentReviewSignalStore {
+	return &MockRecentReviewSignalStore{
+		AddReviewFunc: &RecentReviewSignalStoreAddReviewFunc{
+			defaultHook: i.AddReview,
+		},
+		ClearSignalsFunc: &RecentReviewSignalStoreClearSignalsFunc{
+			defaultHook: i.ClearSignals,
+		},
+		FindRecentReviewersFunc: &RecentReviewSignalStoreFindRecentReviewersFunc{
+			defaultHook: i.FindRecentReviewers,
+		},
+		WithTransactFunc: &RecentReviewSignalStoreWithTransactFunc{
+			defaultHook: i.WithTransact,
+		},
+	}
+}
+
+// RecentReviewSignalStoreAddReviewFunc describes the behavior when the
+// AddReview method of the parent MockRecentReviewSignalStore instance is
+// invoked.
+type RecentReviewSignalStoreAddReviewFunc struct {
+	defaultHook func(context.Context, database.Review) error
+	hooks       []func(context.Context, database.Review) error
+	history     []RecentReviewSignalStoreAddReviewFuncCall
+	mutex       sync.Mutex
+}
+
+// AddReview delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) AddReview(v0 context.Context, v1 database.Review) error {
+	r0 := m.AddReviewFunc.nextHook()(v0, v1)
+	m.AddReviewFunc.appendCall(RecentReviewSignalStoreAddReviewFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AddReview method of
+// the parent MockRecentReviewSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *RecentReviewSignalStoreAddReviewFunc) SetDefaultHook(hook func(context.Context, database.Review) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AddReview method of the parent MockRecentReviewSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreAddReviewFunc) PushHook(hook func(context.Context, database.Review) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreAddReviewFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, database.Review) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreAddReviewFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, database.Review) error {
+		return r0
+	})
+}
+
+func (f *RecentReviewSignalStoreAddReviewFunc) nextHook() func(context.Context, database.Review) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreAddReviewFunc) appendCall(r0 RecentReviewSignalStoreAddReviewFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RecentReviewSignalStoreAddReviewFuncCall
+// objects describing the invocations of this function.
+func (f *RecentReviewSignalStoreAddReviewFunc) History() []RecentReviewSignalStoreAddReviewFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreAddReviewFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreAddReviewFuncCall is an object that describes an
+// invocation of method AddReview on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreAddReviewFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 database.Review
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreAddReviewFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreAddReviewFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RecentReviewSignalStoreClearSignalsFunc describes the behavior when the
+// ClearSignals method of the parent MockRecentReviewSignalStore instance is
+// invoked.
+type RecentReviewSignalStoreClearSignalsFunc struct {
+	defaultHook func(context.Context, api.RepoID) error
+	hooks       []func(context.Context, api.RepoID) error
+	history     []RecentReviewSignalStoreClearSignalsFuncCall
+	mutex       sync.Mutex
+}
+
+// ClearSignals delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) ClearSignals(v0 context.Context, v1 api.RepoID) error {
+	r0 := m.ClearSignalsFunc.nextHook()(v0, v1)
+	m.ClearSignalsFunc.appendCall(RecentReviewSignalStoreClearSignalsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the ClearSignals method
+// of the parent MockRecentReviewSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *RecentReviewSignalStoreClearSignalsFunc) SetDefaultHook(hook func(context.Context, api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ClearSignals method of the parent MockRecentReviewSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreClearSignalsFunc) PushHook(hook func(context.Context, api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreClearSignalsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreClearSignalsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *RecentReviewSignalStoreClearSignalsFunc) nextHook() func(context.Context, api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreClearSignalsFunc) appendCall(r0 RecentReviewSignalStoreClearSignalsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RecentReviewSignalStoreClearSignalsFuncCall
+// objects describing the invocations of this function.
+func (f *RecentReviewSignalStoreClearSignalsFunc) History() []RecentReviewSignalStoreClearSignalsFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreClearSignalsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreClearSignalsFuncCall is an object that describes an
+// invocation of method ClearSignals on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreClearSignalsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreClearSignalsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreClearSignalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RecentReviewSignalStoreFindRecentReviewersFunc describes the behavior when
+// the FindRecentReviewers method of the parent MockRecentReviewSignalStore
+// instance is invoked.
+type RecentReviewSignalStoreFindRecentReviewersFunc struct {
+	defaultHook func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)
+	hooks       []func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)
+	history     []RecentReviewSignalStoreFindRecentReviewersFuncCall
+	mutex       sync.Mutex
+}
+
+// FindRecentReviewers delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) FindRecentReviewers(v0 context.Context, v1 api.RepoID, v2 string) ([]database.RecentReviewerSummary, error) {
+	r0, r1 := m.FindRecentReviewersFunc.nextHook()(v0, v1, v2)
+	m.FindRecentReviewersFunc.appendCall(RecentReviewSignalStoreFindRecentReviewersFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FindRecentReviewers
+// method of the parent MockRecentReviewSignalStore instance is invoked and
+// the hook queue is empty.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindRecentReviewers method of the parent MockRecentReviewSignalStore
+// instance invokes the hook at the front of the queue and discards it. After
+// the queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) PushHook(hook func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) SetDefaultReturn(r0 []database.RecentReviewerSummary, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) PushReturn(r0 []database.RecentReviewerSummary, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+		return r0, r1
+	})
+}
+
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) nextHook() func(context.Context, api.RepoID, string) ([]database.RecentReviewerSummary, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) appendCall(r0 RecentReviewSignalStoreFindRecentReviewersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RecentReviewSignalStoreFindRecentReviewersFuncCall objects describing the
+// invocations of this function.
+func (f *RecentReviewSignalStoreFindRecentReviewersFunc) History() []RecentReviewSignalStoreFindRecentReviewersFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreFindRecentReviewersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreFindRecentReviewersFuncCall is an object that
+// describes an invocation of method FindRecentReviewers on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreFindRecentReviewersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []database.RecentReviewerSummary
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreFindRecentReviewersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreFindRecentReviewersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RecentReviewSignalStoreWithTransactFunc describes the behavior when the
+// WithTransact method of the parent MockRecentReviewSignalStore instance is
+// invoked.
+type RecentReviewSignalStoreWithTransactFunc struct {
+	defaultHook func(context.Context, func(store database.RecentReviewSignalStore) error) error
+	hooks       []func(context.Context, func(store database.RecentReviewSignalStore) error) error
+	history     []RecentReviewSignalStoreWithTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRecentReviewSignalStore) WithTransact(v0 context.Context, v1 func(store database.RecentReviewSignalStore) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(RecentReviewSignalStoreWithTransactFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockRecentReviewSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *RecentReviewSignalStoreWithTransactFunc) SetDefaultHook(hook func(context.Context, func(store database.RecentReviewSignalStore) error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithTransact method of the parent MockRecentReviewSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RecentReviewSignalStoreWithTransactFunc) PushHook(hook func(context.Context, func(store database.RecentReviewSignalStore) error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RecentReviewSignalStoreWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RecentReviewSignalStoreWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+		return r0
+	})
+}
+
+func (f *RecentReviewSignalStoreWithTransactFunc) nextHook() func(context.Context, func(store database.RecentReviewSignalStore) error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RecentReviewSignalStoreWithTransactFunc) appendCall(r0 RecentReviewSignalStoreWithTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RecentReviewSignalStoreWithTransactFuncCall
+// objects describing the invocations of this function.
+func (f *RecentReviewSignalStoreWithTransactFunc) History() []RecentReviewSignalStoreWithTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]RecentReviewSignalStoreWithTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RecentReviewSignalStoreWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of
+// MockRecentReviewSignalStore.
+type RecentReviewSignalStoreWithTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 func(store database.RecentReviewSignalStore) error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RecentReviewSignalStoreWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RecentReviewSignalStoreWithTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockRecentViewSignalStore is a mock implementation of the
 // RecentViewSignalStore interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
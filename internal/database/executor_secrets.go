@@ -65,6 +65,7 @@ type ExecutorSecretScope string
 const (
 	ExecutorSecretScopeBatches   ExecutorSecretScope = "batches"
 	ExecutorSecretScopeCodeIntel ExecutorSecretScope = "codeintel"
+	ExecutorSecretScopeCustom    ExecutorSecretScope = "custom"
 )
 
 // ExecutorSecretNotFoundErr is returned when a secret cannot be found.
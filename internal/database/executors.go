@@ -56,8 +56,11 @@ type ExecutorStore interface {
 type ExecutorStoreListOptions struct {
 	Query  string
 	Active bool
-	Offset int
-	Limit  int
+	// QueueName, if set, only matches executors processing jobs of the given queue, either as
+	// their only queue or as one of the queues of a multi-queue executor.
+	QueueName string
+	Offset    int
+	Limit     int
 }
 
 type executorStore struct {
@@ -110,10 +113,13 @@ func (s *executorStore) count(ctx context.Context, opts ExecutorStoreListOptions
 }
 
 func executorStoreListOptionsConditions(opts ExecutorStoreListOptions, now time.Time) *sqlf.Query {
-	conds := make([]*sqlf.Query, 0, 2)
+	conds := make([]*sqlf.Query, 0, 3)
 	if opts.Query != "" {
 		conds = append(conds, makeExecutorSearchCondition(opts.Query))
 	}
+	if opts.QueueName != "" {
+		conds = append(conds, sqlf.Sprintf("(h.queue_name = %s OR %s = ANY(h.queue_names))", opts.QueueName, opts.QueueName))
+	}
 	if opts.Active {
 		conds = append(conds, sqlf.Sprintf("%s - h.last_seen_at <= '15 minutes'::interval", now))
 	}
@@ -68,9 +68,15 @@ func TestExecutorsList(t *testing.T) {
 		}
 	}
 
+	// Turn the last executor into a multi-queue executor.
+	if _, err := db.Handle().ExecContext(ctx, `UPDATE executor_heartbeats SET queue_name = NULL, queue_names = ARRAY['q0', 'q1'] WHERE id = 10`); err != nil {
+		t.Fatalf("failed to set up executors for test: %s", err)
+	}
+
 	type testCase struct {
 		query       string
 		active      bool
+		queueName   string
 		expectedIDs []int
 	}
 	testCases := []testCase{
@@ -83,22 +89,26 @@ func TestExecutorsList(t *testing.T) {
 		{query: "i2", expectedIDs: []int{8, 2}},            // test search by ignite version
 		{query: "s2", expectedIDs: []int{9, 2}},            // test search by src-cli version
 		{active: true, expectedIDs: []int{5, 4, 3, 2, 1}},
+		{queueName: "q1", expectedIDs: []int{10, 1}}, // test filter by single and multi-queue executors
+		{queueName: "q1", active: true, expectedIDs: []int{1}},
 	}
 
 	runTest := func(testCase testCase, lo, hi int) (errors int) {
 		name := fmt.Sprintf(
-			"query=%q active=%v offset=%d",
+			"query=%q active=%v queueName=%q offset=%d",
 			testCase.query,
 			testCase.active,
+			testCase.queueName,
 			lo,
 		)
 
 		t.Run(name, func(t *testing.T) {
 			opts := ExecutorStoreListOptions{
-				Query:  testCase.query,
-				Active: testCase.active,
-				Limit:  3,
-				Offset: lo,
+				Query:     testCase.query,
+				Active:    testCase.active,
+				QueueName: testCase.queueName,
+				Limit:     3,
+				Offset:    lo,
 			}
 			executors, err := store.list(ctx, opts, now)
 			if err != nil {
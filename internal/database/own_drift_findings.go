@@ -0,0 +1,187 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// OwnDriftFindingStore stores the findings of the ownership drift analysis,
+// that is declared owners of files who are unlikely to still own them.
+type OwnDriftFindingStore interface {
+	// ReplaceFindings replaces all the findings of the given repository with
+	// the given ones, computed at the given time.
+	ReplaceFindings(ctx context.Context, repoID api.RepoID, findings []OwnDriftFinding, computedAt time.Time) error
+	// ListFindings lists the findings matching the given options, ordered by
+	// ID. The ID of the first finding of the next page is returned, or 0 if
+	// there are no more findings.
+	ListFindings(ctx context.Context, opts ListOwnDriftFindingsOpts) (_ []*OwnDriftFinding, next int32, err error)
+	// CountFindings counts the findings matching the given options.
+	CountFindings(ctx context.Context, opts ListOwnDriftFindingsOpts) (int32, error)
+	WithTransact(context.Context, func(store OwnDriftFindingStore) error) error
+}
+
+func OwnDriftFindingStoreWith(other basestore.ShareableStore) OwnDriftFindingStore {
+	return &ownDriftFindingStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+// OwnDriftFinding flags a declared owner of a single file. Kind is one of the
+// drift kinds in internal/own/types.
+type OwnDriftFinding struct {
+	ID         int32
+	RepoID     api.RepoID
+	FilePath   string
+	Kind       string
+	Owner      string
+	Source     string
+	ComputedAt time.Time
+}
+
+type ListOwnDriftFindingsOpts struct {
+	*LimitOffset
+
+	// Only return findings past this cursor (finding ID).
+	Cursor int32
+	// Required. Scopes the list operation to the given repository.
+	RepoID api.RepoID
+	// Only return findings of the given kinds, if any.
+	Kinds []string
+	// Only return findings of the given file paths, if any.
+	FilePaths []string
+}
+
+func (opts ListOwnDriftFindingsOpts) sql() []*sqlf.Query {
+	conds := []*sqlf.Query{
+		sqlf.Sprintf("p.repo_id = %s", opts.RepoID),
+		sqlf.Sprintf("f.id >= %s", opts.Cursor),
+	}
+	if len(opts.Kinds) > 0 {
+		conds = append(conds, sqlf.Sprintf("f.kind = ANY(%s)", pq.Array(opts.Kinds)))
+	}
+	if len(opts.FilePaths) > 0 {
+		conds = append(conds, sqlf.Sprintf("p.absolute_path = ANY(%s)", pq.Array(opts.FilePaths)))
+	}
+	return conds
+}
+
+type ownDriftFindingStore struct {
+	*basestore.Store
+}
+
+func (s *ownDriftFindingStore) WithTransact(ctx context.Context, f func(store OwnDriftFindingStore) error) error {
+	return s.Store.WithTransact(ctx, func(tx *basestore.Store) error {
+		return f(OwnDriftFindingStoreWith(tx))
+	})
+}
+
+const clearDriftFindingsFmtstr = `
+	WITH rps AS (
+		SELECT id FROM repo_paths WHERE repo_id = %s
+	)
+	DELETE FROM own_drift_findings
+	WHERE file_path_id IN (SELECT * FROM rps)
+`
+
+const insertDriftFindingsFmtstr = `
+	INSERT INTO own_drift_findings (file_path_id, kind, owner, source, computed_at)
+	SELECT file_path_id, kind, owner, source, %s
+	FROM unnest(%s::integer[], %s::text[], %s::text[], %s::text[]) AS f(file_path_id, kind, owner, source)
+	ON CONFLICT (file_path_id, kind, owner) DO NOTHING
+`
+
+func (s *ownDriftFindingStore) ReplaceFindings(ctx context.Context, repoID api.RepoID, findings []OwnDriftFinding, computedAt time.Time) error {
+	return s.Store.WithTransact(ctx, func(store *basestore.Store) error {
+		if err := store.Exec(ctx, sqlf.Sprintf(clearDriftFindingsFmtstr, repoID)); err != nil {
+			return errors.Wrap(err, "clearing findings")
+		}
+		if len(findings) == 0 {
+			return nil
+		}
+
+		var files []string
+		seen := make(map[string]bool)
+		for _, f := range findings {
+			if !seen[f.FilePath] {
+				seen[f.FilePath] = true
+				files = append(files, f.FilePath)
+			}
+		}
+		pathIDs, err := ensureRepoPaths(ctx, store, files, repoID)
+		if err != nil {
+			return errors.Wrap(err, "cannot insert repo paths")
+		}
+		idsByPath := make(map[string]int, len(files))
+		for i, file := range files {
+			idsByPath[file] = pathIDs[i]
+		}
+
+		ids := make([]int, 0, len(findings))
+		kinds := make([]string, 0, len(findings))
+		owners := make([]string, 0, len(findings))
+		sources := make([]string, 0, len(findings))
+		for _, f := range findings {
+			ids = append(ids, idsByPath[f.FilePath])
+			kinds = append(kinds, f.Kind)
+			owners = append(owners, f.Owner)
+			sources = append(sources, f.Source)
+		}
+		q := sqlf.Sprintf(insertDriftFindingsFmtstr, computedAt, pq.Array(ids), pq.Array(kinds), pq.Array(owners), pq.Array(sources))
+		return store.Exec(ctx, q)
+	})
+}
+
+const listDriftFindingsFmtstr = `
+	SELECT f.id, p.repo_id, p.absolute_path, f.kind, f.owner, f.source, f.computed_at
+	FROM own_drift_findings AS f
+	INNER JOIN repo_paths AS p
+	ON p.id = f.file_path_id
+	WHERE %s
+	ORDER BY f.id ASC
+	%s
+`
+
+func (s *ownDriftFindingStore) ListFindings(ctx context.Context, opts ListOwnDriftFindingsOpts) (_ []*OwnDriftFinding, next int32, err error) {
+	if opts.LimitOffset != nil && opts.Limit > 0 {
+		opts.Limit++
+	}
+	q := sqlf.Sprintf(listDriftFindingsFmtstr, sqlf.Join(opts.sql(), "AND"), opts.LimitOffset.SQL())
+	findings, err := scanOwnDriftFindings(s.Query(ctx, q))
+	if err != nil {
+		return nil, 0, err
+	}
+	if opts.LimitOffset != nil && opts.Limit > 0 && len(findings) == opts.Limit {
+		next = findings[len(findings)-1].ID
+		findings = findings[:len(findings)-1]
+	}
+	return findings, next, nil
+}
+
+const countDriftFindingsFmtstr = `
+	SELECT COUNT(*)
+	FROM own_drift_findings AS f
+	INNER JOIN repo_paths AS p
+	ON p.id = f.file_path_id
+	WHERE %s
+`
+
+func (s *ownDriftFindingStore) CountFindings(ctx context.Context, opts ListOwnDriftFindingsOpts) (int32, error) {
+	// Disable cursor for counting.
+	opts.Cursor = 0
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countDriftFindingsFmtstr, sqlf.Join(opts.sql(), "AND"))))
+	return int32(count), err
+}
+
+var scanOwnDriftFindings = basestore.NewSliceScanner(func(scanner dbutil.Scanner) (*OwnDriftFinding, error) {
+	var f OwnDriftFinding
+	if err := scanner.Scan(&f.ID, &f.RepoID, &f.FilePath, &f.Kind, &f.Owner, &f.Source, &f.ComputedAt); err != nil {
+		return nil, err
+	}
+	return &f, nil
+})
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestOwnDriftFindingStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	store := OwnDriftFindingStoreWith(db)
+
+	ctx := context.Background()
+	repo := mustCreate(ctx, t, db, &types.Repo{Name: "a/b"})
+	otherRepo := mustCreate(ctx, t, db, &types.Repo{Name: "a/c"})
+
+	computedAt := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, store.ReplaceFindings(ctx, repo.ID, []OwnDriftFinding{
+		{FilePath: "dir/file1.go", Kind: "deactivated-owner", Owner: "@alice", Source: "codeowners"},
+		{FilePath: "dir/file1.go", Kind: "stale-owner", Owner: "bob@example.com", Source: "codeowners"},
+		{FilePath: "dir/file2.go", Kind: "empty-team", Owner: "ghosts", Source: "assigned"},
+		// Duplicates are ignored.
+		{FilePath: "dir/file2.go", Kind: "empty-team", Owner: "ghosts", Source: "codeowners"},
+	}, computedAt))
+	require.NoError(t, store.ReplaceFindings(ctx, otherRepo.ID, []OwnDriftFinding{
+		{FilePath: "dir/file1.go", Kind: "deactivated-owner", Owner: "@alice", Source: "codeowners"},
+	}, computedAt))
+
+	list := func(t *testing.T, opts ListOwnDriftFindingsOpts) []OwnDriftFinding {
+		t.Helper()
+		findings, _, err := store.ListFindings(ctx, opts)
+		require.NoError(t, err)
+		var got []OwnDriftFinding
+		for _, f := range findings {
+			assert.Equal(t, computedAt, f.ComputedAt.UTC())
+			got = append(got, OwnDriftFinding{RepoID: f.RepoID, FilePath: f.FilePath, Kind: f.Kind, Owner: f.Owner, Source: f.Source})
+		}
+		return got
+	}
+
+	t.Run("list all", func(t *testing.T) {
+		got := list(t, ListOwnDriftFindingsOpts{RepoID: repo.ID})
+		assert.Equal(t, []OwnDriftFinding{
+			{RepoID: repo.ID, FilePath: "dir/file1.go", Kind: "deactivated-owner", Owner: "@alice", Source: "codeowners"},
+			{RepoID: repo.ID, FilePath: "dir/file1.go", Kind: "stale-owner", Owner: "bob@example.com", Source: "codeowners"},
+			{RepoID: repo.ID, FilePath: "dir/file2.go", Kind: "empty-team", Owner: "ghosts", Source: "assigned"},
+		}, got)
+		count, err := store.CountFindings(ctx, ListOwnDriftFindingsOpts{RepoID: repo.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), count)
+	})
+
+	t.Run("filter by kind and path", func(t *testing.T) {
+		got := list(t, ListOwnDriftFindingsOpts{RepoID: repo.ID, Kinds: []string{"stale-owner", "empty-team"}, FilePaths: []string{"dir/file1.go"}})
+		assert.Equal(t, []OwnDriftFinding{
+			{RepoID: repo.ID, FilePath: "dir/file1.go", Kind: "stale-owner", Owner: "bob@example.com", Source: "codeowners"},
+		}, got)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		first, next, err := store.ListFindings(ctx, ListOwnDriftFindingsOpts{RepoID: repo.ID, LimitOffset: &LimitOffset{Limit: 2}})
+		require.NoError(t, err)
+		require.Len(t, first, 2)
+		require.NotZero(t, next)
+		rest, next, err := store.ListFindings(ctx, ListOwnDriftFindingsOpts{RepoID: repo.ID, LimitOffset: &LimitOffset{Limit: 2}, Cursor: next})
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Zero(t, next)
+		assert.Equal(t, "empty-team", rest[0].Kind)
+	})
+
+	t.Run("replace findings", func(t *testing.T) {
+		require.NoError(t, store.ReplaceFindings(ctx, repo.ID, nil, computedAt))
+		count, err := store.CountFindings(ctx, ListOwnDriftFindingsOpts{RepoID: repo.ID})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		// Findings of other repositories are kept.
+		count, err = store.CountFindings(ctx, ListOwnDriftFindingsOpts{RepoID: otherRepo.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), count)
+	})
+}
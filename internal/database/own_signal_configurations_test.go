@@ -37,6 +37,16 @@ func Test_LoadConfigurations(t *testing.T) {
 			Name:        "analytics",
 			Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 		},
+		{
+			ID:          4,
+			Name:        "recent-reviewers",
+			Description: "Indexes reviewers of recently merged and open changesets in each file using code host review events.",
+		},
+		{
+			ID:          5,
+			Name:        "ownership-drift",
+			Description: "Flags files whose declared owners are deactivated users, empty teams, or have not contributed to the file recently while others have.",
+		},
 	}).Equal(t, configurations)
 
 	t.Run("load by name", func(t *testing.T) {
@@ -85,6 +95,16 @@ func Test_LoadConfigurations(t *testing.T) {
 				Name:        "analytics",
 				Description: "Indexes ownership data to present in aggregated views like Admin > Analytics > Own and Repo > Ownership",
 			},
+			{
+				ID:          4,
+				Name:        "recent-reviewers",
+				Description: "Indexes reviewers of recently merged and open changesets in each file using code host review events.",
+			},
+			{
+				ID:          5,
+				Name:        "ownership-drift",
+				Description: "Flags files whose declared owners are deactivated users, empty teams, or have not contributed to the file recently while others have.",
+			},
 		}).Equal(t, configurations)
 	})
 }
@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type RecentReviewSignalStore interface {
+	AddReview(ctx context.Context, review Review) error
+	FindRecentReviewers(ctx context.Context, repoID api.RepoID, path string) ([]RecentReviewerSummary, error)
+	ClearSignals(ctx context.Context, repoID api.RepoID) error
+	WithTransact(context.Context, func(store RecentReviewSignalStore) error) error
+}
+
+func RecentReviewSignalStoreWith(other basestore.ShareableStore) RecentReviewSignalStore {
+	return &recentReviewSignalStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+// Review is a single review of a changeset by a reviewer, which is
+// attributed to every file changed by the changeset.
+type Review struct {
+	RepoID         api.RepoID
+	ReviewerHandle string
+	Timestamp      time.Time
+	FilesChanged   []string
+}
+
+type RecentReviewerSummary struct {
+	ReviewerHandle string
+	ReviewsCount   int
+	LastReviewedAt time.Time
+}
+
+type recentReviewSignalStore struct {
+	*basestore.Store
+}
+
+func (s *recentReviewSignalStore) WithTransact(ctx context.Context, f func(store RecentReviewSignalStore) error) error {
+	return s.Store.WithTransact(ctx, func(tx *basestore.Store) error {
+		return f(RecentReviewSignalStoreWith(tx))
+	})
+}
+
+const clearReviewSignalsFmtstr = `
+	WITH rps AS (
+		SELECT id FROM repo_paths WHERE repo_id = %s
+	)
+	DELETE FROM own_aggregate_recent_review
+	WHERE reviewed_file_path_id IN (SELECT * FROM rps)
+`
+
+func (s *recentReviewSignalStore) ClearSignals(ctx context.Context, repoID api.RepoID) error {
+	return s.Exec(ctx, sqlf.Sprintf(clearReviewSignalsFmtstr, repoID))
+}
+
+const insertRecentReviewFmtstr = `
+	WITH RECURSIVE ancestors AS (
+		SELECT id, parent_id
+		FROM repo_paths
+		WHERE id = ANY(%s)
+		UNION
+		SELECT p.id, p.parent_id
+		FROM repo_paths p
+		JOIN ancestors a ON p.id = a.parent_id
+	)
+	INSERT INTO own_aggregate_recent_review (reviewer_handle, reviewed_file_path_id, reviews_count, last_reviewed_at)
+	SELECT %s, id, 1, %s
+	FROM ancestors
+	ON CONFLICT (reviewed_file_path_id, reviewer_handle) DO UPDATE
+	SET
+		reviews_count = own_aggregate_recent_review.reviews_count + 1,
+		last_reviewed_at = GREATEST(own_aggregate_recent_review.last_reviewed_at, EXCLUDED.last_reviewed_at)
+`
+
+// AddReview counts the given review for each file it changed, and for all
+// their ancestor directories up to the repository root.
+//
+// Unlike recent contributions, a review is counted once per directory no matter
+// how many files within that directory it changed.
+func (s *recentReviewSignalStore) AddReview(ctx context.Context, review Review) error {
+	if review.ReviewerHandle == "" || len(review.FilesChanged) == 0 {
+		return nil
+	}
+	pathIDs, err := ensureRepoPaths(ctx, s.Store, review.FilesChanged, review.RepoID)
+	if err != nil {
+		return errors.Wrap(err, "cannot insert repo paths")
+	}
+	return s.Exec(ctx, sqlf.Sprintf(insertRecentReviewFmtstr, pq.Array(pathIDs), review.ReviewerHandle, review.Timestamp))
+}
+
+const findRecentReviewersFmtstr = `
+	SELECT g.reviewer_handle, g.reviews_count, g.last_reviewed_at
+	FROM own_aggregate_recent_review AS g
+	INNER JOIN repo_paths AS p
+	ON p.id = g.reviewed_file_path_id
+	WHERE p.repo_id = %s
+	AND p.absolute_path = %s
+	ORDER BY g.reviews_count DESC, g.last_reviewed_at DESC
+`
+
+// FindRecentReviewers returns all recent reviewers for given `repoID` and
+// `path`, most frequent reviewers first. Empty string `path` designates the
+// repo root.
+func (s *recentReviewSignalStore) FindRecentReviewers(ctx context.Context, repoID api.RepoID, path string) ([]RecentReviewerSummary, error) {
+	return scanRecentReviewerSummaries(s.Query(ctx, sqlf.Sprintf(findRecentReviewersFmtstr, repoID, path)))
+}
+
+var scanRecentReviewerSummaries = basestore.NewSliceScanner(func(scanner dbutil.Scanner) (RecentReviewerSummary, error) {
+	var rrs RecentReviewerSummary
+	if err := scanner.Scan(&rrs.ReviewerHandle, &rrs.ReviewsCount, &rrs.LastReviewedAt); err != nil {
+		return RecentReviewerSummary{}, err
+	}
+	return rrs, nil
+})
@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRecentReviewSignalStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	store := RecentReviewSignalStoreWith(db)
+
+	ctx := context.Background()
+	repo := mustCreate(ctx, t, db, &types.Repo{Name: "a/b"})
+
+	earlier := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC)
+	for _, review := range []Review{
+		{
+			RepoID:         repo.ID,
+			ReviewerHandle: "alice",
+			Timestamp:      earlier,
+			FilesChanged:   []string{"file1.txt", "dir/file2.txt", "dir/file3.txt"},
+		},
+		{
+			RepoID:         repo.ID,
+			ReviewerHandle: "alice",
+			Timestamp:      later,
+			FilesChanged:   []string{"dir/subdir/file.txt"},
+		},
+		{
+			RepoID:         repo.ID,
+			ReviewerHandle: "bob",
+			Timestamp:      later,
+			FilesChanged:   []string{"file1.txt", "dir2/file2.txt"},
+		},
+		{
+			RepoID:         repo.ID,
+			ReviewerHandle: "bob",
+			Timestamp:      earlier,
+			FilesChanged:   []string{"file1.txt"},
+		},
+	} {
+		require.NoError(t, store.AddReview(ctx, review))
+	}
+
+	for p, w := range map[string][]RecentReviewerSummary{
+		// A review changing several files in a directory counts once.
+		"dir": {
+			{ReviewerHandle: "alice", ReviewsCount: 2, LastReviewedAt: later},
+		},
+		"file1.txt": {
+			{ReviewerHandle: "bob", ReviewsCount: 2, LastReviewedAt: later},
+			{ReviewerHandle: "alice", ReviewsCount: 1, LastReviewedAt: earlier},
+		},
+		"": {
+			{ReviewerHandle: "bob", ReviewsCount: 2, LastReviewedAt: later},
+			{ReviewerHandle: "alice", ReviewsCount: 2, LastReviewedAt: later},
+		},
+	} {
+		path := p
+		want := w
+		t.Run(path, func(t *testing.T) {
+			got, err := store.FindRecentReviewers(ctx, repo.ID, path)
+			require.NoError(t, err)
+			for i := range got {
+				got[i].LastReviewedAt = got[i].LastReviewedAt.UTC()
+			}
+			if path == "" {
+				// Both reviewers have the same count and last review.
+				assert.ElementsMatch(t, want, got)
+			} else {
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+
+	t.Run("clear signals", func(t *testing.T) {
+		require.NoError(t, store.ClearSignals(ctx, repo.ID))
+		got, err := store.FindRecentReviewers(ctx, repo.ID, "")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
@@ -617,6 +617,10 @@ type ReposListOptions struct {
 	// A set of filters to select only repos with the given set of topics
 	TopicFilters []RepoTopicFilter
 
+	// A set of filters to select only repos whose precise code intelligence data
+	// at the tip of the default branch references the given packages
+	DependsOnFilters []RepoDependsOnFilter
+
 	// CaseSensitivePatterns determines if IncludePatterns and ExcludePattern are treated
 	// with case sensitivity or not.
 	CaseSensitivePatterns bool
@@ -770,6 +774,47 @@ type RepoTopicFilter struct {
 	Negated bool
 }
 
+type RepoDependsOnFilter struct {
+	Package string
+	// If Version is empty, a reference to any version of the package matches.
+	// The version only applies to the direct references to the package.
+	Version string
+	// If negated is true, this filter will select only repos
+	// that do _not_ depend on the associated package
+	Negated bool
+}
+
+// dependsOnFilterQuery selects the repositories that depend on a package,
+// directly or transitively, according to the precise indexes at the tip of
+// their default branch. It starts with the repositories that reference the
+// package and repeatedly adds the repositories that reference a package
+// provided by one of them, like the dependents graph of the uploads service.
+const dependsOnFilterQuery = `
+repo.id IN (
+	WITH RECURSIVE dependents(repository_id) AS (
+		SELECT vt.repository_id
+		FROM lsif_uploads_visible_at_tip vt
+		JOIN lsif_references r ON r.dump_id = vt.upload_id
+		WHERE
+			vt.is_default_branch AND
+			r.name = %s AND
+			%s
+		UNION
+		SELECT vt.repository_id
+		FROM dependents d
+		JOIN lsif_uploads_visible_at_tip pvt ON pvt.repository_id = d.repository_id AND pvt.is_default_branch
+		JOIN lsif_packages p ON p.dump_id = pvt.upload_id
+		JOIN lsif_references r ON
+			r.scheme = p.scheme AND
+			r.manager = p.manager AND
+			r.name = p.name AND
+			r.version IS NOT DISTINCT FROM p.version
+		JOIN lsif_uploads_visible_at_tip vt ON vt.upload_id = r.dump_id AND vt.is_default_branch
+	)
+	SELECT repository_id FROM dependents
+)
+`
+
 type RepoListOrderBy []RepoListSort
 
 func (r RepoListOrderBy) SQL() *sqlf.Query {
@@ -1226,6 +1271,23 @@ func (s *repoStore) listSQL(ctx context.Context, tr trace.Trace, opt ReposListOp
 		where = append(where, sqlf.Join(ands, "AND"))
 	}
 
+	if len(opt.DependsOnFilters) > 0 {
+		var ands []*sqlf.Query
+		for _, filter := range opt.DependsOnFilters {
+			versionCond := sqlf.Sprintf("TRUE")
+			if filter.Version != "" {
+				versionCond = sqlf.Sprintf("r.version = %s", filter.Version)
+			}
+
+			cond := sqlf.Sprintf(dependsOnFilterQuery, filter.Package, versionCond)
+			if filter.Negated {
+				cond = sqlf.Sprintf("NOT (%s)", cond)
+			}
+			ands = append(ands, cond)
+		}
+		where = append(where, sqlf.Join(ands, "AND"))
+	}
+
 	baseConds := sqlf.Sprintf("TRUE")
 	if !opt.IncludeDeleted {
 		baseConds = sqlf.Sprintf("repo.deleted_at IS NULL")
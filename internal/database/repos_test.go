@@ -1388,6 +1388,90 @@ func TestRepos_List_topics(t *testing.T) {
 	}
 }
 
+func TestRepos_List_dependsOn(t *testing.T) {
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := actor.WithInternalActor(context.Background())
+
+	ids := func(id int) func(r *types.Repo) {
+		return func(r *types.Repo) {
+			r.ExternalRepo.ID = strconv.Itoa(id)
+			r.Name = api.RepoName(strconv.Itoa(id))
+		}
+	}
+
+	r1 := typestest.MakeGithubRepo().With(ids(1))
+	r2 := typestest.MakeGithubRepo().With(ids(2))
+	r3 := typestest.MakeGithubRepo().With(ids(3))
+	r4 := typestest.MakeGithubRepo().With(ids(4))
+	r5 := typestest.MakeGithubRepo().With(ids(5))
+	if err := db.Repos().Create(ctx, r1, r2, r3, r4, r5); err != nil {
+		t.Fatal(err)
+	}
+
+	exec := func(q *sqlf.Query) {
+		t.Helper()
+		if _, err := db.Handle().ExecContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	type pkg struct{ name, version string }
+	// index adds a precise index visible at the tip of the default branch of
+	// the given repository, providing and referencing the given packages.
+	index := func(uploadID int, repo *types.Repo, provides, references []pkg) {
+		t.Helper()
+		exec(sqlf.Sprintf(
+			"INSERT INTO lsif_uploads (id, repository_id, commit, indexer, num_parts, uploaded_parts, state) VALUES (%s, %s, %s, 'scip-typescript', 1, '{}', 'completed')",
+			uploadID, repo.ID, fmt.Sprintf("%040d", uploadID),
+		))
+		exec(sqlf.Sprintf("INSERT INTO lsif_uploads_visible_at_tip (repository_id, upload_id, is_default_branch) VALUES (%s, %s, true)", repo.ID, uploadID))
+		for _, p := range provides {
+			exec(sqlf.Sprintf("INSERT INTO lsif_packages (dump_id, scheme, manager, name, version) VALUES (%s, 'scip-typescript', 'npm', %s, %s)", uploadID, p.name, p.version))
+		}
+		for _, p := range references {
+			exec(sqlf.Sprintf("INSERT INTO lsif_references (dump_id, scheme, manager, name, version) VALUES (%s, 'scip-typescript', 'npm', %s, %s)", uploadID, p.name, p.version))
+		}
+	}
+
+	// r1 provides lib and references left-pad@1.0.0, r2 references lib and so
+	// depends on left-pad transitively, and r3 references left-pad@2.0.0.
+	// r4 references lib-other, which nothing provides, and r5 isn't indexed.
+	index(1, r1, []pkg{{"lib", "1.0.0"}}, []pkg{{"left-pad", "1.0.0"}})
+	index(2, r2, nil, []pkg{{"lib", "1.0.0"}})
+	index(3, r3, nil, []pkg{{"left-pad", "2.0.0"}})
+	index(4, r4, nil, []pkg{{"lib-other", "1.0.0"}})
+
+	tests := []struct {
+		name string
+		opt  ReposListOptions
+		want []*types.Repo
+	}{
+		{"direct", ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "lib"}}}, []*types.Repo{r2}},
+		{"transitive", ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "left-pad"}}}, []*types.Repo{r1, r2, r3}},
+		{"version", ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "left-pad", Version: "1.0.0"}}}, []*types.Repo{r1, r2}},
+		{"other version", ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "left-pad", Version: "2.0.0"}}}, []*types.Repo{r3}},
+		{"unknown version", ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "left-pad", Version: "3.0.0"}}}, nil},
+		{"negated", ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "left-pad", Negated: true}}}, []*types.Repo{r4, r5}},
+		{"negated version", ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "left-pad", Version: "1.0.0", Negated: true}}}, []*types.Repo{r3, r4, r5}},
+		{
+			"left-pad not lib",
+			ReposListOptions{DependsOnFilters: []RepoDependsOnFilter{{Package: "left-pad"}, {Package: "lib", Negated: true}}},
+			[]*types.Repo{r1, r3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repos, err := db.Repos().List(ctx, test.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			require.Equal(t, test.want, repos)
+		})
+	}
+}
+
 func TestRepos_ListMinimalRepos(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// evictionBatchSize is the number of cache entries considered for eviction at once.
+const evictionBatchSize = 100
+
+type evictor struct {
+	logger       log.Logger
+	store        store.CacheEntryStore
+	uploadStore  uploadstore.Store
+	maxTotalSize int64
+}
+
+// NewEvictor returns a background routine that deletes the caches accessed least recently
+// until the total size of all caches is within the configured budget.
+func NewEvictor(observationCtx *observation.Context, cacheStore store.CacheEntryStore, uploadStore uploadstore.Store, maxTotalSize int64, interval time.Duration) goroutine.BackgroundRoutine {
+	e := &evictor{
+		logger:       observationCtx.Logger.Scoped("cache-evictor"),
+		store:        cacheStore,
+		uploadStore:  uploadStore,
+		maxTotalSize: maxTotalSize,
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(context.Background()),
+		goroutine.HandlerFunc(e.evict),
+		goroutine.WithName("executor.cache-evictor"),
+		goroutine.WithDescription("evicts the least recently used executor job caches"),
+		goroutine.WithInterval(interval),
+		goroutine.WithOperation(observationCtx.Operation(observation.Op{
+			Name: "executor.cache.evict",
+		})),
+	)
+}
+
+func (e *evictor) evict(ctx context.Context) error {
+	totalSize, err := e.store.TotalSize(ctx)
+	if err != nil {
+		return errors.Wrap(err, "store.TotalSize")
+	}
+
+	for totalSize > e.maxTotalSize {
+		entries, err := e.store.LeastRecentlyAccessed(ctx, evictionBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "store.LeastRecentlyAccessed")
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		for _, entry := range entries {
+			if totalSize <= e.maxTotalSize {
+				break
+			}
+
+			// Delete the archive before the entry, so that a failure leaves behind an
+			// entry that is retried on the next run rather than an orphaned archive.
+			if err := e.uploadStore.Delete(ctx, ObjectKey(entry.Queue, entry.Key)); err != nil {
+				return errors.Wrap(err, "uploadStore.Delete")
+			}
+			if err := e.store.Delete(ctx, entry.Queue, entry.Key); err != nil {
+				return errors.Wrap(err, "store.Delete")
+			}
+
+			e.logger.Debug("Evicted executor cache",
+				log.String("queue", entry.Queue),
+				log.String("key", entry.Key),
+				log.Int64("sizeBytes", entry.SizeBytes))
+			totalSize -= entry.SizeBytes
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+)
+
+func TestEvictor(t *testing.T) {
+	cacheStore := store.NewMockCacheEntryStore()
+	cacheStore.TotalSizeFunc.SetDefaultReturn(100, nil)
+	cacheStore.LeastRecentlyAccessedFunc.PushReturn([]store.CacheEntry{
+		{Queue: "codeintel", Key: "a", SizeBytes: 30},
+		{Queue: "batches", Key: "b", SizeBytes: 20},
+		{Queue: "codeintel", Key: "c", SizeBytes: 40},
+	}, nil)
+	uploadStore := mocks.NewMockStore()
+
+	e := &evictor{
+		logger:       logtest.Scoped(t),
+		store:        cacheStore,
+		uploadStore:  uploadStore,
+		maxTotalSize: 60,
+	}
+	require.NoError(t, e.evict(context.Background()))
+
+	// Evicting the two least recently accessed caches is enough to get below the budget.
+	require.Len(t, uploadStore.DeleteFunc.History(), 2)
+	assert.Equal(t, "codeintel/a", uploadStore.DeleteFunc.History()[0].Arg1)
+	assert.Equal(t, "batches/b", uploadStore.DeleteFunc.History()[1].Arg1)
+	require.Len(t, cacheStore.DeleteFunc.History(), 2)
+	assert.Equal(t, "codeintel", cacheStore.DeleteFunc.History()[0].Arg1)
+	assert.Equal(t, "a", cacheStore.DeleteFunc.History()[0].Arg2)
+}
+
+func TestEvictor_WithinBudget(t *testing.T) {
+	cacheStore := store.NewMockCacheEntryStore()
+	cacheStore.TotalSizeFunc.SetDefaultReturn(50, nil)
+	uploadStore := mocks.NewMockStore()
+
+	e := &evictor{
+		logger:       logtest.Scoped(t),
+		store:        cacheStore,
+		uploadStore:  uploadStore,
+		maxTotalSize: 60,
+	}
+	require.NoError(t, e.evict(context.Background()))
+
+	assert.Empty(t, cacheStore.LeastRecentlyAccessedFunc.History())
+	assert.Empty(t, uploadStore.DeleteFunc.History())
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+)
+
+// NewUploadStore creates the upload store holding the job caches saved by executors.
+func NewUploadStore(ctx context.Context, observationCtx *observation.Context, conf *Config) (uploadstore.Store, error) {
+	c := uploadstore.Config{
+		Backend:      conf.Backend,
+		ManageBucket: conf.ManageBucket,
+		Bucket:       conf.Bucket,
+		S3: uploadstore.S3Config{
+			Region:          conf.S3Region,
+			Endpoint:        conf.S3Endpoint,
+			UsePathStyle:    conf.S3UsePathStyle,
+			AccessKeyID:     conf.S3AccessKeyID,
+			SecretAccessKey: conf.S3SecretAccessKey,
+			SessionToken:    conf.S3SessionToken,
+		},
+		GCS: uploadstore.GCSConfig{
+			ProjectID:               conf.GCSProjectID,
+			CredentialsFile:         conf.GCSCredentialsFile,
+			CredentialsFileContents: conf.GCSCredentialsFileContents,
+		},
+	}
+	return uploadstore.CreateLazy(ctx, c, uploadstore.NewOperations(observationCtx, "executors", "cache"))
+}
+
+// ObjectKey returns the key of the archive of the given cache in the upload store.
+func ObjectKey(queue, key string) string {
+	return queue + "/" + key
+}
+
+// validKeyPattern matches the keys executors send for caches, which are hex-encoded SHA-256
+// hashes of the cache key declared by the job.
+var validKeyPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// IsValidKey returns true if the given key is a valid cache key.
+func IsValidKey(key string) bool {
+	return validKeyPattern.MatchString(key)
+}
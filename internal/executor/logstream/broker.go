@@ -0,0 +1,143 @@
+package logstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Broker relays the events of a job from the Sourcegraph instance an executor streams them to,
+// to the instances serving the viewers of the job.
+type Broker interface {
+	// Publish sends the given event to all current subscribers of the job.
+	Publish(ctx context.Context, queueName string, jobID int, event Event) error
+	// Subscribe returns a channel of the events published for the given job. The channel is
+	// closed once the given context is canceled or the subscription fails. Events are dropped
+	// when the subscriber does not keep up with the publisher.
+	Subscribe(ctx context.Context, queueName string, jobID int) (<-chan Event, error)
+}
+
+// subscriberBufferSize is the number of events buffered for a subscriber before events are dropped.
+const subscriberBufferSize = 256
+
+func channelName(queueName string, jobID int) string {
+	return fmt.Sprintf("executor-log-stream:%s:%d", queueName, jobID)
+}
+
+type redisBroker struct {
+	pool *redis.Pool
+}
+
+// NewRedisBroker creates a broker that relays events over Redis pub/sub, so that events reach
+// subscribers on all instances sharing the given pool.
+func NewRedisBroker(pool *redis.Pool) Broker {
+	return &redisBroker{pool: pool}
+}
+
+func (b *redisBroker) Publish(ctx context.Context, queueName string, jobID int, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	conn, err := b.pool.GetContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "getting redis connection")
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("PUBLISH", channelName(queueName, jobID), payload)
+	return errors.Wrap(err, "publishing log stream event")
+}
+
+func (b *redisBroker) Subscribe(ctx context.Context, queueName string, jobID int) (<-chan Event, error) {
+	conn, err := b.pool.GetContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting redis connection")
+	}
+
+	psc := redis.PubSubConn{Conn: conn}
+	if err := psc.Subscribe(channelName(queueName, jobID)); err != nil {
+		psc.Close()
+		return nil, errors.Wrap(err, "subscribing to log stream")
+	}
+
+	events := make(chan Event, subscriberBufferSize)
+	go func() {
+		defer close(events)
+		defer psc.Close()
+
+		for {
+			// ReceiveContext returns an error once the context is canceled.
+			switch v := psc.ReceiveContext(ctx).(type) {
+			case redis.Message:
+				var event Event
+				if err := json.Unmarshal(v.Data, &event); err != nil {
+					continue
+				}
+				select {
+				case events <- event:
+				default:
+				}
+			case error:
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+type memoryBroker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan Event]struct{}
+}
+
+// NewMemoryBroker creates a broker that relays events to subscribers within the same process.
+func NewMemoryBroker() Broker {
+	return &memoryBroker{subscribers: map[string]map[chan Event]struct{}{}}
+}
+
+func (b *memoryBroker) Publish(_ context.Context, queueName string, jobID int, event Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for subscriber := range b.subscribers[channelName(queueName, jobID)] {
+		select {
+		case subscriber <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+func (b *memoryBroker) Subscribe(ctx context.Context, queueName string, jobID int) (<-chan Event, error) {
+	name := channelName(queueName, jobID)
+	events := make(chan Event, subscriberBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[name] == nil {
+		b.subscribers[name] = map[chan Event]struct{}{}
+	}
+	b.subscribers[name][events] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers[name], events)
+		if len(b.subscribers[name]) == 0 {
+			delete(b.subscribers, name)
+		}
+		close(events)
+	}()
+
+	return events, nil
+}
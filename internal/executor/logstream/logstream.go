@@ -0,0 +1,45 @@
+// Package logstream relays the log output of executor jobs to the viewers of the jobs while the
+// jobs are running.
+//
+// Executors stream incremental log events to the Sourcegraph instance over a long-lived
+// connection, in addition to persisting the execution log entries of a job. Streamed events are
+// best effort: they can be dropped when a viewer is too slow or when the connection breaks, so the
+// persisted execution log entries remain the source of truth.
+package logstream
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+)
+
+// Event is an incremental update of an execution log entry of a job.
+type Event struct {
+	// EntryID is the position of the entry in the execution logs of the job, starting at 1, as
+	// returned when the entry was added to the job.
+	EntryID int `json:"entryID"`
+	// Offset is the byte offset in the output of the entry at which the output of this event
+	// starts. Output after the offset is replaced by the output of this event.
+	Offset int `json:"offset"`
+
+	// ExecutionLogEntry holds the current state of the entry. Out only contains the output
+	// starting at Offset.
+	executor.ExecutionLogEntry
+}
+
+// Apply updates the given entry with the event. It returns false if the event cannot be applied
+// because events between the entry and this event are missing. In that case, the entry is not
+// modified.
+func (e Event) Apply(entry *executor.ExecutionLogEntry) bool {
+	if e.Offset < 0 || e.Offset > len(entry.Out) {
+		return false
+	}
+
+	out := entry.Out[:e.Offset] + e.Out
+	*entry = e.ExecutionLogEntry
+	entry.Out = out
+	return true
+}
+
+// NewEntryEvent returns an event that replaces the complete output of the entry with the given ID.
+func NewEntryEvent(entryID int, entry executor.ExecutionLogEntry) Event {
+	return Event{EntryID: entryID, ExecutionLogEntry: entry}
+}
@@ -0,0 +1,85 @@
+package logstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+)
+
+func TestEventApply(t *testing.T) {
+	exitCode := 0
+
+	tests := []struct {
+		name     string
+		entry    executor.ExecutionLogEntry
+		event    Event
+		applied  bool
+		expected executor.ExecutionLogEntry
+	}{
+		{
+			name:     "Append",
+			entry:    executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: hello\n"},
+			event:    Event{EntryID: 1, Offset: 14, ExecutionLogEntry: executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: world\n"}},
+			applied:  true,
+			expected: executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: hello\nstdout: world\n"},
+		},
+		{
+			name:     "Replace and finish",
+			entry:    executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: secret\n"},
+			event:    Event{EntryID: 1, Offset: 8, ExecutionLogEntry: executor.ExecutionLogEntry{Key: "step.0", Out: "******\n", ExitCode: &exitCode}},
+			applied:  true,
+			expected: executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: ******\n", ExitCode: &exitCode},
+		},
+		{
+			name:     "Missed events",
+			entry:    executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: hello\n"},
+			event:    Event{EntryID: 1, Offset: 28, ExecutionLogEntry: executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: again\n"}},
+			applied:  false,
+			expected: executor.ExecutionLogEntry{Key: "step.0", Out: "stdout: hello\n"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entry := test.entry
+			assert.Equal(t, test.applied, test.event.Apply(&entry))
+			assert.Equal(t, test.expected, entry)
+		})
+	}
+}
+
+func TestMemoryBroker(t *testing.T) {
+	broker := NewMemoryBroker()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := broker.Subscribe(ctx, "batches", 42)
+	require.NoError(t, err)
+
+	other, err := broker.Subscribe(ctx, "codeintel", 42)
+	require.NoError(t, err)
+
+	event := NewEntryEvent(1, executor.ExecutionLogEntry{Key: "step.0", Out: "hello"})
+	require.NoError(t, broker.Publish(context.Background(), "batches", 42, event))
+
+	select {
+	case received := <-events:
+		assert.Equal(t, event, received)
+	case <-time.After(time.Second):
+		t.Fatal("event not received")
+	}
+
+	select {
+	case <-other:
+		t.Fatal("unexpected event for other queue")
+	default:
+	}
+
+	cancel()
+	for range events {
+	}
+}
@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// CacheEntryStore is the store for interacting with the executor_cache_entries table.
+type CacheEntryStore interface {
+	// Upsert records that the cache with the given key was saved for the given queue.
+	Upsert(ctx context.Context, queue string, key string, sizeBytes int64) error
+	// MarkAccessed bumps the last access time of the matching cache entry. It returns
+	// false if the entry does not exist.
+	MarkAccessed(ctx context.Context, queue string, key string) (bool, error)
+	// TotalSize returns the sum of the sizes of all cache entries.
+	TotalSize(ctx context.Context) (int64, error)
+	// LeastRecentlyAccessed returns up to limit cache entries, ordered by their last
+	// access time, oldest first.
+	LeastRecentlyAccessed(ctx context.Context, limit int) ([]CacheEntry, error)
+	// Delete deletes the matching cache entry.
+	Delete(ctx context.Context, queue string, key string) error
+}
+
+// CacheEntry is a job cache that was saved by an executor.
+type CacheEntry struct {
+	ID             int
+	Queue          string
+	Key            string
+	SizeBytes      int64
+	CreatedAt      time.Time
+	LastAccessedAt time.Time
+}
+
+type cacheEntryStore struct {
+	*basestore.Store
+}
+
+// NewCacheEntryStore creates a new CacheEntryStore.
+func NewCacheEntryStore(db database.DB) CacheEntryStore {
+	return &cacheEntryStore{
+		Store: basestore.NewWithHandle(db.Handle()),
+	}
+}
+
+func (s *cacheEntryStore) Upsert(ctx context.Context, queue string, key string, sizeBytes int64) error {
+	return s.Exec(ctx, sqlf.Sprintf(upsertCacheEntryQuery, queue, key, sizeBytes))
+}
+
+const upsertCacheEntryQuery = `
+INSERT INTO executor_cache_entries (queue, key, size_bytes)
+VALUES (%s, %s, %s)
+ON CONFLICT (queue, key) DO UPDATE SET
+	size_bytes = EXCLUDED.size_bytes,
+	last_accessed_at = NOW()
+`
+
+func (s *cacheEntryStore) MarkAccessed(ctx context.Context, queue string, key string) (bool, error) {
+	_, ok, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(markAccessedCacheEntryQuery, queue, key)))
+	return ok, err
+}
+
+const markAccessedCacheEntryQuery = `
+UPDATE executor_cache_entries SET last_accessed_at = NOW()
+WHERE queue = %s AND key = %s
+RETURNING id
+`
+
+func (s *cacheEntryStore) TotalSize(ctx context.Context) (int64, error) {
+	size, _, err := basestore.ScanFirstInt64(s.Query(ctx, sqlf.Sprintf(totalSizeCacheEntryQuery)))
+	return size, err
+}
+
+const totalSizeCacheEntryQuery = `
+SELECT COALESCE(SUM(size_bytes), 0) FROM executor_cache_entries
+`
+
+func (s *cacheEntryStore) LeastRecentlyAccessed(ctx context.Context, limit int) ([]CacheEntry, error) {
+	return scanCacheEntries(s.Query(ctx, sqlf.Sprintf(leastRecentlyAccessedCacheEntryQuery, limit)))
+}
+
+const leastRecentlyAccessedCacheEntryQuery = `
+SELECT id, queue, key, size_bytes, created_at, last_accessed_at
+FROM executor_cache_entries
+ORDER BY last_accessed_at, id
+LIMIT %s
+`
+
+var scanCacheEntries = basestore.NewSliceScanner(func(s dbutil.Scanner) (entry CacheEntry, _ error) {
+	err := s.Scan(
+		&entry.ID,
+		&entry.Queue,
+		&entry.Key,
+		&entry.SizeBytes,
+		&entry.CreatedAt,
+		&entry.LastAccessedAt,
+	)
+	return entry, err
+})
+
+func (s *cacheEntryStore) Delete(ctx context.Context, queue string, key string) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteCacheEntryQuery, queue, key))
+}
+
+const deleteCacheEntryQuery = `
+DELETE FROM executor_cache_entries WHERE queue = %s AND key = %s
+`
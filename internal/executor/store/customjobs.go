@@ -0,0 +1,355 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// CustomJob is a general-purpose job run by executors of the custom queue. Custom jobs are
+// enqueued by site admins or services, and run a series of container steps in a workspace
+// holding a checkout of a repository.
+type CustomJob struct {
+	ID              int
+	State           string
+	FailureMessage  *string
+	QueuedAt        time.Time
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
+	ProcessAfter    *time.Time
+	NumResets       int
+	NumFailures     int
+	LastHeartbeatAt time.Time
+	ExecutionLogs   []executor.ExecutionLogEntry
+	WorkerHostname  string
+	Cancel          bool
+
+	// Name identifies the job in logs and the UI.
+	Name string
+	// CreatorID is the user who enqueued the job, or zero if the job was enqueued by a service.
+	CreatorID int32
+
+	RepositoryID   api.RepoID
+	RepositoryName string
+	Commit         string
+
+	// Steps are the container steps of the job, in the order they run.
+	Steps []CustomJobStep
+	// Files is a map from workspace-relative paths to the contents of files written into the
+	// workspace before the steps run.
+	Files map[string]string
+	// Secrets are the keys of the executor secrets of the custom scope passed to the steps as
+	// environment variables.
+	Secrets []string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (j *CustomJob) RecordID() int {
+	return j.ID
+}
+
+func (j *CustomJob) RecordUID() string {
+	return strconv.Itoa(j.ID)
+}
+
+// CustomJobStep is a single container step of a custom job.
+type CustomJobStep struct {
+	// Image is the container image the step runs in.
+	Image string `json:"image"`
+	// Commands are run in order in the container, stopping at the first failing command.
+	Commands []string `json:"commands"`
+	// Dir is the working directory of the step, relative to the root of the workspace.
+	Dir string `json:"dir,omitempty"`
+	// Env is a set of NAME=value pairs passed to the step.
+	Env []string `json:"env,omitempty"`
+}
+
+// Validate returns an error if the job cannot be enqueued.
+func (j CustomJob) Validate() error {
+	if j.Name == "" {
+		return errors.New("missing name")
+	}
+	if j.RepositoryID == 0 {
+		return errors.New("missing repository")
+	}
+	if j.Commit == "" {
+		return errors.New("missing commit")
+	}
+	if len(j.Steps) == 0 {
+		return errors.New("at least one step is required")
+	}
+	for i, step := range j.Steps {
+		if step.Image == "" {
+			return errors.Newf("step %d: missing image", i)
+		}
+		if len(step.Commands) == 0 {
+			return errors.Newf("step %d: at least one command is required", i)
+		}
+		if !isWorkspacePath(step.Dir) {
+			return errors.Newf("step %d: dir %q must be relative and within the workspace", i, step.Dir)
+		}
+		for _, env := range step.Env {
+			if !strings.Contains(env, "=") {
+				return errors.Newf("step %d: env %q must be of the form NAME=value", i, env)
+			}
+		}
+	}
+	for path := range j.Files {
+		if path == "" || !isWorkspacePath(path) {
+			return errors.Newf("file path %q must be relative and within the workspace", path)
+		}
+	}
+	return nil
+}
+
+// isWorkspacePath returns true if the given path is relative and does not escape the workspace.
+func isWorkspacePath(path string) bool {
+	if filepath.IsAbs(path) {
+		return false
+	}
+	cleaned := filepath.Clean(path)
+	return cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
+
+// CustomJobWorkerStoreOptions are the options of the dbworker store of the custom queue.
+var CustomJobWorkerStoreOptions = dbworkerstore.Options[*CustomJob]{
+	Name:              "executor_custom_job_worker_store",
+	TableName:         "executor_custom_jobs",
+	ViewName:          "executor_custom_jobs j",
+	ColumnExpressions: customJobColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(scanCustomJob),
+	OrderByExpression: sqlf.Sprintf("j.queued_at, j.id"),
+	StalledMaxAge:     25 * time.Second,
+	MaxNumResets:      3,
+	// Custom jobs are not idempotent in general, so they are not retried.
+	MaxNumRetries: 0,
+}
+
+// NewCustomJobWorkerStore creates a dbworker store that wraps the executor_custom_jobs table.
+func NewCustomJobWorkerStore(observationCtx *observation.Context, handle basestore.TransactableHandle) dbworkerstore.Store[*CustomJob] {
+	return dbworkerstore.New(observationCtx, handle, CustomJobWorkerStoreOptions)
+}
+
+// CustomJobStore is the store for enqueueing and inspecting the jobs of the custom queue.
+type CustomJobStore interface {
+	// Create validates and enqueues the given job, and returns its ID.
+	Create(ctx context.Context, job CustomJob) (int, error)
+	// GetByID returns the job with the given ID and a boolean flag indicating its existence.
+	GetByID(ctx context.Context, id int) (*CustomJob, bool, error)
+	// List returns the jobs matching the given options, most recently queued first.
+	List(ctx context.Context, opts CustomJobListOptions) ([]*CustomJob, error)
+	// Count returns the number of jobs matching the given options.
+	Count(ctx context.Context, opts CustomJobListOptions) (int, error)
+	// Cancel cancels the job with the given ID. Queued jobs are canceled right away, jobs being
+	// processed are canceled by the executor processing them on its next heartbeat. It returns
+	// false if the job does not exist or has already finished.
+	Cancel(ctx context.Context, id int) (bool, error)
+}
+
+// CustomJobListOptions filters the jobs returned by CustomJobStore.List and CustomJobStore.Count.
+type CustomJobListOptions struct {
+	// State, when set, only matches jobs in the given state.
+	State string
+	// RepositoryID, when set, only matches jobs of the given repository.
+	RepositoryID api.RepoID
+
+	Offset int
+	Limit  int
+}
+
+type customJobStore struct {
+	*basestore.Store
+}
+
+// NewCustomJobStore creates a new CustomJobStore.
+func NewCustomJobStore(db database.DB) CustomJobStore {
+	return &customJobStore{
+		Store: basestore.NewWithHandle(db.Handle()),
+	}
+}
+
+func (s *customJobStore) Create(ctx context.Context, job CustomJob) (int, error) {
+	if err := job.Validate(); err != nil {
+		return 0, err
+	}
+
+	files := job.Files
+	if files == nil {
+		files = map[string]string{}
+	}
+	secrets := job.Secrets
+	if secrets == nil {
+		secrets = []string{}
+	}
+
+	id, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(
+		createCustomJobQuery,
+		job.Name,
+		dbutil.NullInt32Column(job.CreatorID),
+		job.RepositoryID,
+		job.Commit,
+		dbutil.JSONMessage(&job.Steps),
+		dbutil.JSONMessage(&files),
+		pq.Array(secrets),
+	)))
+	return id, err
+}
+
+const createCustomJobQuery = `
+INSERT INTO executor_custom_jobs (name, creator_id, repository_id, commit, steps, files, secrets)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+RETURNING id
+`
+
+func (s *customJobStore) GetByID(ctx context.Context, id int) (*CustomJob, bool, error) {
+	jobs, err := scanCustomJobs(s.Query(ctx, sqlf.Sprintf(
+		listCustomJobsQuery,
+		sqlf.Join(customJobColumns, ", "),
+		sqlf.Sprintf("j.id = %s", id),
+		1,
+		0,
+	)))
+	if err != nil || len(jobs) == 0 {
+		return nil, false, err
+	}
+	return jobs[0], true, nil
+}
+
+func (s *customJobStore) List(ctx context.Context, opts CustomJobListOptions) ([]*CustomJob, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	return scanCustomJobs(s.Query(ctx, sqlf.Sprintf(
+		listCustomJobsQuery,
+		sqlf.Join(customJobColumns, ", "),
+		customJobListConds(opts),
+		limit,
+		opts.Offset,
+	)))
+}
+
+const listCustomJobsQuery = `
+SELECT %s
+FROM executor_custom_jobs j
+WHERE %s
+ORDER BY j.queued_at DESC, j.id DESC
+LIMIT %s OFFSET %s
+`
+
+func (s *customJobStore) Count(ctx context.Context, opts CustomJobListOptions) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countCustomJobsQuery, customJobListConds(opts))))
+	return count, err
+}
+
+const countCustomJobsQuery = `
+SELECT COUNT(*) FROM executor_custom_jobs j WHERE %s
+`
+
+func customJobListConds(opts CustomJobListOptions) *sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.State != "" {
+		conds = append(conds, sqlf.Sprintf("j.state = %s", opts.State))
+	}
+	if opts.RepositoryID != 0 {
+		conds = append(conds, sqlf.Sprintf("j.repository_id = %s", opts.RepositoryID))
+	}
+	return sqlf.Join(conds, " AND ")
+}
+
+func (s *customJobStore) Cancel(ctx context.Context, id int) (bool, error) {
+	_, ok, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(cancelCustomJobQuery, id)))
+	return ok, err
+}
+
+const cancelCustomJobQuery = `
+UPDATE executor_custom_jobs
+SET
+	cancel = TRUE,
+	-- Jobs being processed are canceled by the executor on its next heartbeat.
+	state = CASE WHEN state = 'processing' THEN state ELSE 'canceled' END,
+	finished_at = CASE WHEN state = 'processing' THEN finished_at ELSE NOW() END,
+	updated_at = NOW()
+WHERE id = %s AND state IN ('queued', 'errored', 'processing')
+RETURNING id
+`
+
+var customJobColumns = []*sqlf.Query{
+	sqlf.Sprintf("j.id"),
+	sqlf.Sprintf("j.state"),
+	sqlf.Sprintf("j.failure_message"),
+	sqlf.Sprintf("j.queued_at"),
+	sqlf.Sprintf("j.started_at"),
+	sqlf.Sprintf("j.finished_at"),
+	sqlf.Sprintf("j.process_after"),
+	sqlf.Sprintf("j.num_resets"),
+	sqlf.Sprintf("j.num_failures"),
+	sqlf.Sprintf("j.last_heartbeat_at"),
+	sqlf.Sprintf("j.execution_logs"),
+	sqlf.Sprintf("j.worker_hostname"),
+	sqlf.Sprintf("j.cancel"),
+	sqlf.Sprintf("j.name"),
+	sqlf.Sprintf("j.creator_id"),
+	sqlf.Sprintf("j.repository_id"),
+	sqlf.Sprintf("(SELECT r.name FROM repo r WHERE r.id = j.repository_id)"),
+	sqlf.Sprintf("j.commit"),
+	sqlf.Sprintf("j.steps"),
+	sqlf.Sprintf("j.files"),
+	sqlf.Sprintf("j.secrets"),
+	sqlf.Sprintf("j.created_at"),
+	sqlf.Sprintf("j.updated_at"),
+}
+
+func scanCustomJob(s dbutil.Scanner) (*CustomJob, error) {
+	var job CustomJob
+	var executionLogs []executor.ExecutionLogEntry
+	if err := s.Scan(
+		&job.ID,
+		&job.State,
+		&job.FailureMessage,
+		&job.QueuedAt,
+		&job.StartedAt,
+		&job.FinishedAt,
+		&job.ProcessAfter,
+		&job.NumResets,
+		&job.NumFailures,
+		&dbutil.NullTime{Time: &job.LastHeartbeatAt},
+		pq.Array(&executionLogs),
+		&job.WorkerHostname,
+		&job.Cancel,
+		&job.Name,
+		&dbutil.NullInt32{N: &job.CreatorID},
+		&job.RepositoryID,
+		&job.RepositoryName,
+		&job.Commit,
+		dbutil.JSONMessage(&job.Steps),
+		dbutil.JSONMessage(&job.Files),
+		pq.Array(&job.Secrets),
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	job.ExecutionLogs = append(job.ExecutionLogs, executionLogs...)
+	return &job, nil
+}
+
+var scanCustomJobs = basestore.NewSliceScanner(scanCustomJob)
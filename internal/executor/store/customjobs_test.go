@@ -0,0 +1,160 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bt "github.com/sourcegraph/sourcegraph/internal/batches/testing"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/executor/store"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+func TestCustomJob_Validate(t *testing.T) {
+	valid := func() store.CustomJob {
+		return store.CustomJob{
+			Name:         "Update lockfiles",
+			RepositoryID: 1,
+			Commit:       "deadbeef",
+			Steps:        []store.CustomJobStep{{Image: "alpine:3", Commands: []string{"true"}, Dir: "client", Env: []string{"CI=true"}}},
+			Files:        map[string]string{"scripts/run.sh": "#!/bin/sh"},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(job *store.CustomJob)
+		expectedErr string
+	}{
+		{
+			name:   "Valid",
+			modify: func(job *store.CustomJob) {},
+		},
+		{
+			name:        "No name",
+			modify:      func(job *store.CustomJob) { job.Name = "" },
+			expectedErr: "missing name",
+		},
+		{
+			name:        "No repository",
+			modify:      func(job *store.CustomJob) { job.RepositoryID = 0 },
+			expectedErr: "missing repository",
+		},
+		{
+			name:        "No steps",
+			modify:      func(job *store.CustomJob) { job.Steps = nil },
+			expectedErr: "at least one step is required",
+		},
+		{
+			name:        "Step without image",
+			modify:      func(job *store.CustomJob) { job.Steps[0].Image = "" },
+			expectedErr: "step 0: missing image",
+		},
+		{
+			name:        "Step dir outside of the workspace",
+			modify:      func(job *store.CustomJob) { job.Steps[0].Dir = "../other" },
+			expectedErr: `step 0: dir "../other" must be relative and within the workspace`,
+		},
+		{
+			name:        "Invalid env",
+			modify:      func(job *store.CustomJob) { job.Steps[0].Env = []string{"CI"} },
+			expectedErr: `step 0: env "CI" must be of the form NAME=value`,
+		},
+		{
+			name:        "Absolute file path",
+			modify:      func(job *store.CustomJob) { job.Files = map[string]string{"/etc/passwd": ""} },
+			expectedErr: `file path "/etc/passwd" must be relative and within the workspace`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job := valid()
+			test.modify(&job)
+
+			err := job.Validate()
+			if test.expectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCustomJobStore(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	jobStore := store.NewCustomJobStore(db)
+
+	repo := bt.TestRepo(t, database.ExternalServicesWith(logger, db), extsvc.KindGitHub)
+
+	ctx := context.Background()
+	require.NoError(t, database.ReposWith(logger, db).Create(ctx, repo))
+
+	job := store.CustomJob{
+		Name:         "Update lockfiles",
+		RepositoryID: repo.ID,
+		Commit:       "deadbeef",
+		Steps:        []store.CustomJobStep{{Image: "node:20", Commands: []string{"npm install"}}},
+		Files:        map[string]string{"run.sh": "#!/bin/sh"},
+		Secrets:      []string{"NPM_TOKEN"},
+	}
+
+	first, err := jobStore.Create(ctx, job)
+	require.NoError(t, err)
+	second, err := jobStore.Create(ctx, job)
+	require.NoError(t, err)
+
+	t.Run("GetByID", func(t *testing.T) {
+		have, ok, err := jobStore.GetByID(ctx, first)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		assert.Equal(t, "queued", have.State)
+		assert.Equal(t, job.Name, have.Name)
+		assert.Zero(t, have.CreatorID)
+		assert.Equal(t, repo.ID, have.RepositoryID)
+		assert.Equal(t, string(repo.Name), have.RepositoryName)
+		assert.Equal(t, job.Steps, have.Steps)
+		assert.Equal(t, job.Files, have.Files)
+		assert.Equal(t, job.Secrets, have.Secrets)
+
+		_, ok, err = jobStore.GetByID(ctx, second+1)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		canceled, err := jobStore.Cancel(ctx, first)
+		require.NoError(t, err)
+		assert.True(t, canceled)
+
+		// Jobs that finished cannot be canceled anymore.
+		canceled, err = jobStore.Cancel(ctx, first)
+		require.NoError(t, err)
+		assert.False(t, canceled)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		jobs, err := jobStore.List(ctx, store.CustomJobListOptions{})
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, second, jobs[0].ID)
+		assert.Equal(t, first, jobs[1].ID)
+
+		jobs, err = jobStore.List(ctx, store.CustomJobListOptions{State: "canceled"})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, first, jobs[0].ID)
+
+		count, err := jobStore.Count(ctx, store.CustomJobListOptions{State: "queued"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
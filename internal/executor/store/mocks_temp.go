@@ -11,6 +11,664 @@ import (
 	"sync"
 )
 
+// MockCacheEntryStore is a mock implementation of the CacheEntryStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/executor/store) used for unit
+// testing.
+type MockCacheEntryStore struct {
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *CacheEntryStoreDeleteFunc
+	// LeastRecentlyAccessedFunc is an instance of a mock function object
+	// controlling the behavior of the method LeastRecentlyAccessed.
+	LeastRecentlyAccessedFunc *CacheEntryStoreLeastRecentlyAccessedFunc
+	// MarkAccessedFunc is an instance of a mock function object controlling
+	// the behavior of the method MarkAccessed.
+	MarkAccessedFunc *CacheEntryStoreMarkAccessedFunc
+	// TotalSizeFunc is an instance of a mock function object controlling
+	// the behavior of the method TotalSize.
+	TotalSizeFunc *CacheEntryStoreTotalSizeFunc
+	// UpsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Upsert.
+	UpsertFunc *CacheEntryStoreUpsertFunc
+}
+
+// NewMockCacheEntryStore creates a new mock of the CacheEntryStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockCacheEntryStore() *MockCacheEntryStore {
+	return &MockCacheEntryStore{
+		DeleteFunc: &CacheEntryStoreDeleteFunc{
+			defaultHook: func(context.Context, string, string) (r0 error) {
+				return
+			},
+		},
+		LeastRecentlyAccessedFunc: &CacheEntryStoreLeastRecentlyAccessedFunc{
+			defaultHook: func(context.Context, int) (r0 []CacheEntry, r1 error) {
+				return
+			},
+		},
+		MarkAccessedFunc: &CacheEntryStoreMarkAccessedFunc{
+			defaultHook: func(context.Context, string, string) (r0 bool, r1 error) {
+				return
+			},
+		},
+		TotalSizeFunc: &CacheEntryStoreTotalSizeFunc{
+			defaultHook: func(context.Context) (r0 int64, r1 error) {
+				return
+			},
+		},
+		UpsertFunc: &CacheEntryStoreUpsertFunc{
+			defaultHook: func(context.Context, string, string, int64) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockCacheEntryStore creates a new mock of the CacheEntryStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockCacheEntryStore() *MockCacheEntryStore {
+	return &MockCacheEntryStore{
+		DeleteFunc: &CacheEntryStoreDeleteFunc{
+			defaultHook: func(context.Context, string, string) error {
+				panic("unexpected invocation of MockCacheEntryStore.Delete")
+			},
+		},
+		LeastRecentlyAccessedFunc: &CacheEntryStoreLeastRecentlyAccessedFunc{
+			defaultHook: func(context.Context, int) ([]CacheEntry, error) {
+				panic("unexpected invocation of MockCacheEntryStore.LeastRecentlyAccessed")
+			},
+		},
+		MarkAccessedFunc: &CacheEntryStoreMarkAccessedFunc{
+			defaultHook: func(context.Context, string, string) (bool, error) {
+				panic("unexpected invocation of MockCacheEntryStore.MarkAccessed")
+			},
+		},
+		TotalSizeFunc: &CacheEntryStoreTotalSizeFunc{
+			defaultHook: func(context.Context) (int64, error) {
+				panic("unexpected invocation of MockCacheEntryStore.TotalSize")
+			},
+		},
+		UpsertFunc: &CacheEntryStoreUpsertFunc{
+			defaultHook: func(context.Context, string, string, int64) error {
+				panic("unexpected invocation of MockCacheEntryStore.Upsert")
+			},
+		},
+	}
+}
+
+// NewMockCacheEntryStoreFrom creates a new mock of the MockCacheEntryStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockCacheEntryStoreFrom(i CacheEntryStore) *MockCacheEntryStore {
+	return &MockCacheEntryStore{
+		DeleteFunc: &CacheEntryStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		LeastRecentlyAccessedFunc: &CacheEntryStoreLeastRecentlyAccessedFunc{
+			defaultHook: i.LeastRecentlyAccessed,
+		},
+		MarkAccessedFunc: &CacheEntryStoreMarkAccessedFunc{
+			defaultHook: i.MarkAccessed,
+		},
+		TotalSizeFunc: &CacheEntryStoreTotalSizeFunc{
+			defaultHook: i.TotalSize,
+		},
+		UpsertFunc: &CacheEntryStoreUpsertFunc{
+			defaultHook: i.Upsert,
+		},
+	}
+}
+
+// CacheEntryStoreDeleteFunc describes the behavior when the Delete method
+// of the parent MockCacheEntryStore instance is invoked.
+type CacheEntryStoreDeleteFunc struct {
+	defaultHook func(context.Context, string, string) error
+	hooks       []func(context.Context, string, string) error
+	history     []CacheEntryStoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCacheEntryStore) Delete(v0 context.Context, v1 string, v2 string) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1, v2)
+	m.DeleteFunc.appendCall(CacheEntryStoreDeleteFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockCacheEntryStore instance is invoked and the hook queue is
+// empty.
+func (f *CacheEntryStoreDeleteFunc) SetDefaultHook(hook func(context.Context, string, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockCacheEntryStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *CacheEntryStoreDeleteFunc) PushHook(hook func(context.Context, string, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CacheEntryStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CacheEntryStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string) error {
+		return r0
+	})
+}
+
+func (f *CacheEntryStoreDeleteFunc) nextHook() func(context.Context, string, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CacheEntryStoreDeleteFunc) appendCall(r0 CacheEntryStoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CacheEntryStoreDeleteFuncCall objects
+// describing the invocations of this function.
+func (f *CacheEntryStoreDeleteFunc) History() []CacheEntryStoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]CacheEntryStoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CacheEntryStoreDeleteFuncCall is an object that describes an invocation
+// of method Delete on an instance of MockCacheEntryStore.
+type CacheEntryStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CacheEntryStoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CacheEntryStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CacheEntryStoreLeastRecentlyAccessedFunc describes the behavior when the
+// LeastRecentlyAccessed method of the parent MockCacheEntryStore instance
+// is invoked.
+type CacheEntryStoreLeastRecentlyAccessedFunc struct {
+	defaultHook func(context.Context, int) ([]CacheEntry, error)
+	hooks       []func(context.Context, int) ([]CacheEntry, error)
+	history     []CacheEntryStoreLeastRecentlyAccessedFuncCall
+	mutex       sync.Mutex
+}
+
+// LeastRecentlyAccessed delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCacheEntryStore) LeastRecentlyAccessed(v0 context.Context, v1 int) ([]CacheEntry, error) {
+	r0, r1 := m.LeastRecentlyAccessedFunc.nextHook()(v0, v1)
+	m.LeastRecentlyAccessedFunc.appendCall(CacheEntryStoreLeastRecentlyAccessedFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// LeastRecentlyAccessed method of the parent MockCacheEntryStore instance
+// is invoked and the hook queue is empty.
+func (f *CacheEntryStoreLeastRecentlyAccessedFunc) SetDefaultHook(hook func(context.Context, int) ([]CacheEntry, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LeastRecentlyAccessed method of the parent MockCacheEntryStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CacheEntryStoreLeastRecentlyAccessedFunc) PushHook(hook func(context.Context, int) ([]CacheEntry, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CacheEntryStoreLeastRecentlyAccessedFunc) SetDefaultReturn(r0 []CacheEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]CacheEntry, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CacheEntryStoreLeastRecentlyAccessedFunc) PushReturn(r0 []CacheEntry, r1 error) {
+	f.PushHook(func(context.Context, int) ([]CacheEntry, error) {
+		return r0, r1
+	})
+}
+
+func (f *CacheEntryStoreLeastRecentlyAccessedFunc) nextHook() func(context.Context, int) ([]CacheEntry, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CacheEntryStoreLeastRecentlyAccessedFunc) appendCall(r0 CacheEntryStoreLeastRecentlyAccessedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CacheEntryStoreLeastRecentlyAccessedFuncCall objects describing the
+// invocations of this function.
+func (f *CacheEntryStoreLeastRecentlyAccessedFunc) History() []CacheEntryStoreLeastRecentlyAccessedFuncCall {
+	f.mutex.Lock()
+	history := make([]CacheEntryStoreLeastRecentlyAccessedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CacheEntryStoreLeastRecentlyAccessedFuncCall is an object that describes
+// an invocation of method LeastRecentlyAccessed on an instance of
+// MockCacheEntryStore.
+type CacheEntryStoreLeastRecentlyAccessedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []CacheEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CacheEntryStoreLeastRecentlyAccessedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CacheEntryStoreLeastRecentlyAccessedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CacheEntryStoreMarkAccessedFunc describes the behavior when the
+// MarkAccessed method of the parent MockCacheEntryStore instance is
+// invoked.
+type CacheEntryStoreMarkAccessedFunc struct {
+	defaultHook func(context.Context, string, string) (bool, error)
+	hooks       []func(context.Context, string, string) (bool, error)
+	history     []CacheEntryStoreMarkAccessedFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkAccessed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCacheEntryStore) MarkAccessed(v0 context.Context, v1 string, v2 string) (bool, error) {
+	r0, r1 := m.MarkAccessedFunc.nextHook()(v0, v1, v2)
+	m.MarkAccessedFunc.appendCall(CacheEntryStoreMarkAccessedFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MarkAccessed method
+// of the parent MockCacheEntryStore instance is invoked and the hook queue
+// is empty.
+func (f *CacheEntryStoreMarkAccessedFunc) SetDefaultHook(hook func(context.Context, string, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkAccessed method of the parent MockCacheEntryStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CacheEntryStoreMarkAccessedFunc) PushHook(hook func(context.Context, string, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CacheEntryStoreMarkAccessedFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CacheEntryStoreMarkAccessedFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, string, string) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *CacheEntryStoreMarkAccessedFunc) nextHook() func(context.Context, string, string) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CacheEntryStoreMarkAccessedFunc) appendCall(r0 CacheEntryStoreMarkAccessedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CacheEntryStoreMarkAccessedFuncCall objects
+// describing the invocations of this function.
+func (f *CacheEntryStoreMarkAccessedFunc) History() []CacheEntryStoreMarkAccessedFuncCall {
+	f.mutex.Lock()
+	history := make([]CacheEntryStoreMarkAccessedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CacheEntryStoreMarkAccessedFuncCall is an object that describes an
+// invocation of method MarkAccessed on an instance of MockCacheEntryStore.
+type CacheEntryStoreMarkAccessedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CacheEntryStoreMarkAccessedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CacheEntryStoreMarkAccessedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CacheEntryStoreTotalSizeFunc describes the behavior when the TotalSize
+// method of the parent MockCacheEntryStore instance is invoked.
+type CacheEntryStoreTotalSizeFunc struct {
+	defaultHook func(context.Context) (int64, error)
+	hooks       []func(context.Context) (int64, error)
+	history     []CacheEntryStoreTotalSizeFuncCall
+	mutex       sync.Mutex
+}
+
+// TotalSize delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCacheEntryStore) TotalSize(v0 context.Context) (int64, error) {
+	r0, r1 := m.TotalSizeFunc.nextHook()(v0)
+	m.TotalSizeFunc.appendCall(CacheEntryStoreTotalSizeFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the TotalSize method of
+// the parent MockCacheEntryStore instance is invoked and the hook queue is
+// empty.
+func (f *CacheEntryStoreTotalSizeFunc) SetDefaultHook(hook func(context.Context) (int64, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// TotalSize method of the parent MockCacheEntryStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CacheEntryStoreTotalSizeFunc) PushHook(hook func(context.Context) (int64, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CacheEntryStoreTotalSizeFunc) SetDefaultReturn(r0 int64, r1 error) {
+	f.SetDefaultHook(func(context.Context) (int64, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CacheEntryStoreTotalSizeFunc) PushReturn(r0 int64, r1 error) {
+	f.PushHook(func(context.Context) (int64, error) {
+		return r0, r1
+	})
+}
+
+func (f *CacheEntryStoreTotalSizeFunc) nextHook() func(context.Context) (int64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CacheEntryStoreTotalSizeFunc) appendCall(r0 CacheEntryStoreTotalSizeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CacheEntryStoreTotalSizeFuncCall objects
+// describing the invocations of this function.
+func (f *CacheEntryStoreTotalSizeFunc) History() []CacheEntryStoreTotalSizeFuncCall {
+	f.mutex.Lock()
+	history := make([]CacheEntryStoreTotalSizeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CacheEntryStoreTotalSizeFuncCall is an object that describes an
+// invocation of method TotalSize on an instance of MockCacheEntryStore.
+type CacheEntryStoreTotalSizeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CacheEntryStoreTotalSizeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CacheEntryStoreTotalSizeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CacheEntryStoreUpsertFunc describes the behavior when the Upsert method
+// of the parent MockCacheEntryStore instance is invoked.
+type CacheEntryStoreUpsertFunc struct {
+	defaultHook func(context.Context, string, string, int64) error
+	hooks       []func(context.Context, string, string, int64) error
+	history     []CacheEntryStoreUpsertFuncCall
+	mutex       sync.Mutex
+}
+
+// Upsert delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCacheEntryStore) Upsert(v0 context.Context, v1 string, v2 string, v3 int64) error {
+	r0 := m.UpsertFunc.nextHook()(v0, v1, v2, v3)
+	m.UpsertFunc.appendCall(CacheEntryStoreUpsertFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Upsert method of the
+// parent MockCacheEntryStore instance is invoked and the hook queue is
+// empty.
+func (f *CacheEntryStoreUpsertFunc) SetDefaultHook(hook func(context.Context, string, string, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Upsert method of the parent MockCacheEntryStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *CacheEntryStoreUpsertFunc) PushHook(hook func(context.Context, string, string, int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CacheEntryStoreUpsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CacheEntryStoreUpsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, string, int64) error {
+		return r0
+	})
+}
+
+func (f *CacheEntryStoreUpsertFunc) nextHook() func(context.Context, string, string, int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CacheEntryStoreUpsertFunc) appendCall(r0 CacheEntryStoreUpsertFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CacheEntryStoreUpsertFuncCall objects
+// describing the invocations of this function.
+func (f *CacheEntryStoreUpsertFunc) History() []CacheEntryStoreUpsertFuncCall {
+	f.mutex.Lock()
+	history := make([]CacheEntryStoreUpsertFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CacheEntryStoreUpsertFuncCall is an object that describes an invocation
+// of method Upsert on an instance of MockCacheEntryStore.
+type CacheEntryStoreUpsertFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CacheEntryStoreUpsertFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CacheEntryStoreUpsertFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // MockJobTokenStore is a mock implementation of the JobTokenStore interface
 // (from the package
 // github.com/sourcegraph/sourcegraph/internal/executor/store) used for unit
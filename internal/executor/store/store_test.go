@@ -382,3 +382,45 @@ func TestJobTokenStore_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheEntryStore(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	cacheStore := store.NewCacheEntryStore(db)
+
+	ctx := context.Background()
+
+	exists, err := cacheStore.MarkAccessed(ctx, "codeintel", "a")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, cacheStore.Upsert(ctx, "codeintel", "a", 10))
+	require.NoError(t, cacheStore.Upsert(ctx, "codeintel", "b", 20))
+	require.NoError(t, cacheStore.Upsert(ctx, "batches", "a", 30))
+	// Saving the same cache again replaces the size of the entry.
+	require.NoError(t, cacheStore.Upsert(ctx, "codeintel", "a", 15))
+
+	totalSize, err := cacheStore.TotalSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(65), totalSize)
+
+	// Restoring a cache makes it the most recently accessed entry.
+	exists, err = cacheStore.MarkAccessed(ctx, "codeintel", "b")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	entries, err := cacheStore.LeastRecentlyAccessed(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "batches", entries[0].Queue)
+	assert.Equal(t, "a", entries[0].Key)
+	assert.Equal(t, "codeintel", entries[1].Queue)
+	assert.Equal(t, "a", entries[1].Key)
+	assert.Equal(t, int64(15), entries[1].SizeBytes)
+
+	require.NoError(t, cacheStore.Delete(ctx, "batches", "a"))
+
+	totalSize, err = cacheStore.TotalSize(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(35), totalSize)
+}
@@ -21,4 +21,43 @@ var DequeuePropertiesPerQueue = &schema.DequeueCacheConfig{
 		Limit:  250,
 		Weight: 1,
 	},
+	Custom: &schema.Custom{
+		Limit:  50,
+		Weight: 1,
+	},
+}
+
+// DequeueConfig returns the dequeue cache configuration of multi-queue executors, which may be
+// overridden in the site configuration.
+func DequeueConfig(siteConfig schema.SiteConfiguration) *schema.DequeueCacheConfig {
+	if siteConfig.ExecutorsMultiqueue != nil && siteConfig.ExecutorsMultiqueue.DequeueCacheConfig != nil {
+		return siteConfig.ExecutorsMultiqueue.DequeueCacheConfig
+	}
+	return DequeuePropertiesPerQueue
+}
+
+// DequeueProperties returns the maximum number of dequeues in the expiration window and the weight
+// of the given queue. Queues missing from the given configuration fall back to the defaults.
+func DequeueProperties(config *schema.DequeueCacheConfig, queue string) (limit, weight int) {
+	switch queue {
+	case "batches":
+		batches := DequeuePropertiesPerQueue.Batches
+		if config != nil && config.Batches != nil {
+			batches = config.Batches
+		}
+		return batches.Limit, batches.Weight
+	case "codeintel":
+		codeintel := DequeuePropertiesPerQueue.Codeintel
+		if config != nil && config.Codeintel != nil {
+			codeintel = config.Codeintel
+		}
+		return codeintel.Limit, codeintel.Weight
+	case "custom":
+		custom := DequeuePropertiesPerQueue.Custom
+		if config != nil && config.Custom != nil {
+			custom = config.Custom
+		}
+		return custom.Limit, custom.Weight
+	}
+	return 0, 0
 }
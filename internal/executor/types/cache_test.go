@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestDequeueProperties(t *testing.T) {
+	config := DequeueConfig(schema.SiteConfiguration{
+		ExecutorsMultiqueue: &schema.ExecutorsMultiqueue{
+			DequeueCacheConfig: &schema.DequeueCacheConfig{
+				Batches: &schema.Batches{Limit: 10, Weight: 2},
+			},
+		},
+	})
+
+	limit, weight := DequeueProperties(config, "batches")
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 2, weight)
+
+	// Queues missing from the site configuration use the defaults.
+	limit, weight = DequeueProperties(config, "codeintel")
+	assert.Equal(t, 250, limit)
+	assert.Equal(t, 1, weight)
+
+	limit, weight = DequeueProperties(config, "custom")
+	assert.Equal(t, 50, limit)
+	assert.Equal(t, 1, weight)
+
+	limit, weight = DequeueProperties(DequeueConfig(schema.SiteConfiguration{}), "batches")
+	assert.Equal(t, 50, limit)
+	assert.Equal(t, 4, weight)
+
+	limit, weight = DequeueProperties(config, "unknown")
+	assert.Equal(t, 0, limit)
+	assert.Equal(t, 0, weight)
+}
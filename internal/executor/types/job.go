@@ -71,6 +71,13 @@ type Job struct {
 	// takes precedence over a potentially configured EXECUTOR_DOCKER_AUTH_CONFIG environment
 	// variable.
 	DockerAuthConfig DockerAuthConfig `json:"dockerAuthConfig,omitempty"`
+
+	// Caches describe directories within the cache directory of the job that are
+	// restored from the files store before the steps of the job run, and saved back
+	// to the files store once all steps have completed successfully. Container
+	// runtimes mount the cache directory at /cache, outside of the workspace. Caches are only supported
+	// by executors that understand version 2 of the job payload.
+	Caches []JobCache `json:"caches,omitempty"`
 }
 
 func (j Job) MarshalJSON() ([]byte, error) {
@@ -90,6 +97,7 @@ func (j Job) MarshalJSON() ([]byte, error) {
 			CliSteps:            j.CliSteps,
 			RedactedValues:      j.RedactedValues,
 			DockerAuthConfig:    j.DockerAuthConfig,
+			Caches:              j.Caches,
 		}
 		v2.VirtualMachineFiles = make(map[string]v2VirtualMachineFile, len(j.VirtualMachineFiles))
 		for k, v := range j.VirtualMachineFiles {
@@ -151,6 +159,7 @@ func (j *Job) UnmarshalJSON(data []byte) error {
 		j.CliSteps = v2.CliSteps
 		j.RedactedValues = v2.RedactedValues
 		j.DockerAuthConfig = v2.DockerAuthConfig
+		j.Caches = v2.Caches
 		return nil
 	}
 	var v1 v1Job
@@ -201,6 +210,7 @@ type v2Job struct {
 	CliSteps            []CliStep                       `json:"cliSteps"`
 	RedactedValues      map[string]string               `json:"redactedValues"`
 	DockerAuthConfig    DockerAuthConfig                `json:"dockerAuthConfig,omitempty"`
+	Caches              []JobCache                      `json:"caches,omitempty"`
 }
 
 type v1Job struct {
@@ -298,6 +308,19 @@ type CliStep struct {
 	Env []string `json:"env"`
 }
 
+// JobCache describes a set of paths within the cache directory of the job that are
+// persisted between runs of jobs sharing the same cache key.
+type JobCache struct {
+	// Key identifies the cache entry within the queue of the job. Jobs that declare
+	// the same key restore the content saved by the most recent successful job.
+	Key string `json:"key"`
+
+	// Paths are the directories or files, relative to the cache directory, that are
+	// restored before and saved after the job runs. The path "." refers to the whole
+	// cache directory.
+	Paths []string `json:"paths"`
+}
+
 // DockerAuthConfig represents a subset of the docker cli config with the necessary
 // fields to make authentication work.
 type DockerAuthConfig struct {
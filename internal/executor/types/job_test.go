@@ -57,6 +57,12 @@ func TestJob_MarshalJSON(t *testing.T) {
 				RedactedValues: map[string]string{
 					"password": "foo",
 				},
+				Caches: []JobCache{
+					{
+						Key:   "deps",
+						Paths: []string{".cache/go-build"},
+					},
+				},
 			},
 			expected: `{
 		"version": 2,
@@ -93,7 +99,11 @@ func TestJob_MarshalJSON(t *testing.T) {
 		}],
 		"redactedValues": {
 			"password": "foo"
-		}
+		},
+		"caches": [{
+			"key": "deps",
+			"paths": [".cache/go-build"]
+		}]
 	}`,
 		},
 		{
@@ -223,7 +233,11 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 	}],
 	"redactedValues": {
 		"password": "foo"
-	}
+	},
+	"caches": [{
+		"key": "deps",
+		"paths": [".cache/go-build"]
+	}]
 }`,
 			expected: Job{
 				Version:             2,
@@ -262,6 +276,12 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 				RedactedValues: map[string]string{
 					"password": "foo",
 				},
+				Caches: []JobCache{
+					{
+						Key:   "deps",
+						Paths: []string{".cache/go-build"},
+					},
+				},
 			},
 		},
 		{
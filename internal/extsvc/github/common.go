@@ -242,6 +242,10 @@ type PullRequest struct {
 	BaseRefName    string
 	Number         int64
 	ReviewDecision string
+	// Mergeable is one of MERGEABLE, CONFLICTING or UNKNOWN. GitHub computes
+	// it lazily, so it is UNKNOWN until GitHub finished checking the pull
+	// request for merge conflicts.
+	Mergeable      string
 	Author         Actor
 	BaseRepository PullRequestRepo
 	HeadRepository PullRequestRepo
@@ -1465,6 +1469,7 @@ fragment pr on PullRequest {
   headRefName
   baseRefName
   reviewDecision
+  mergeable
   %s
   author {
     ...actor
@@ -880,6 +880,78 @@ func (c *V3Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return &updatedRef, nil
 }
 
+// AddLabelsToIssue adds the given labels to an issue or pull request. Labels
+// that don't exist in the repository yet are created.
+//
+// API docs: https://docs.github.com/en/rest/issues/labels#add-labels-to-an-issue
+func (c *V3Client) AddLabelsToIssue(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	payload := struct {
+		Labels []string `json:"labels"`
+	}{Labels: labels}
+	_, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/labels", owner, repo, number), payload, nil)
+	return err
+}
+
+// AddAssigneesToIssue adds the given users to the assignees of an issue or
+// pull request.
+//
+// API docs: https://docs.github.com/en/rest/issues/assignees#add-assignees-to-an-issue
+func (c *V3Client) AddAssigneesToIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	payload := struct {
+		Assignees []string `json:"assignees"`
+	}{Assignees: assignees}
+	_, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/issues/%d/assignees", owner, repo, number), payload, nil)
+	return err
+}
+
+// RequestPullRequestReviewers requests a review of a pull request from the
+// given users and teams. Teams are identified by their slug.
+//
+// API docs: https://docs.github.com/en/rest/pulls/review-requests#request-reviewers-for-a-pull-request
+func (c *V3Client) RequestPullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	payload := struct {
+		Reviewers     []string `json:"reviewers,omitempty"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{Reviewers: reviewers, TeamReviewers: teamReviewers}
+	_, err := c.post(ctx, fmt.Sprintf("repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number), payload, nil)
+	return err
+}
+
+// Milestone is a milestone of a repository.
+type Milestone struct {
+	Number int64  `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+}
+
+// ListOpenMilestones lists the open milestones of a repository.
+//
+// API docs: https://docs.github.com/en/rest/issues/milestones#list-milestones
+func (c *V3Client) ListOpenMilestones(ctx context.Context, owner, repo string) ([]*Milestone, error) {
+	var all []*Milestone
+	for page := 1; ; page++ {
+		var milestones []*Milestone
+		if _, err := c.get(ctx, fmt.Sprintf("repos/%s/%s/milestones?state=open&per_page=100&page=%d", owner, repo, page), &milestones); err != nil {
+			return nil, err
+		}
+		all = append(all, milestones...)
+		if len(milestones) < 100 {
+			return all, nil
+		}
+	}
+}
+
+// SetIssueMilestone sets the milestone of an issue or pull request.
+//
+// API docs: https://docs.github.com/en/rest/issues/issues#update-an-issue
+func (c *V3Client) SetIssueMilestone(ctx context.Context, owner, repo string, number, milestone int64) error {
+	payload := struct {
+		Milestone int64 `json:"milestone"`
+	}{Milestone: milestone}
+	_, err := c.patch(ctx, fmt.Sprintf("repos/%s/%s/issues/%d", owner, repo, number), payload, nil)
+	return err
+}
+
 // GetAppInstallation gets information of a GitHub App installation.
 //
 // API docs: https://docs.github.com/en/rest/reference/apps#get-an-installation-for-the-authenticated-app
@@ -702,6 +702,45 @@ func (c *V4Client) UpdateRef(ctx context.Context, owner, repo, ref, commit strin
 	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).UpdateRef(ctx, owner, repo, ref, commit)
 }
 
+// AddLabelsToIssue adds the given labels to an issue or pull request.
+func (c *V4Client) AddLabelsToIssue(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	logger := c.log.Scoped("AddLabelsToIssue")
+	// The REST API creates labels that don't exist yet, while the GraphQL API
+	// requires the IDs of existing labels.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).AddLabelsToIssue(ctx, owner, repo, number, labels)
+}
+
+// AddAssigneesToIssue adds the given users to the assignees of an issue or
+// pull request.
+func (c *V4Client) AddAssigneesToIssue(ctx context.Context, owner, repo string, number int64, assignees []string) error {
+	logger := c.log.Scoped("AddAssigneesToIssue")
+	// The GraphQL API requires the node IDs of the users, while the REST API
+	// accepts their logins.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).AddAssigneesToIssue(ctx, owner, repo, number, assignees)
+}
+
+// RequestPullRequestReviewers requests a review of a pull request from the
+// given users and teams.
+func (c *V4Client) RequestPullRequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers, teamReviewers []string) error {
+	logger := c.log.Scoped("RequestPullRequestReviewers")
+	// The GraphQL API requires the node IDs of the users and teams, while the
+	// REST API accepts their logins and slugs.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).RequestPullRequestReviewers(ctx, owner, repo, number, reviewers, teamReviewers)
+}
+
+// ListOpenMilestones lists the open milestones of a repository.
+func (c *V4Client) ListOpenMilestones(ctx context.Context, owner, repo string) ([]*Milestone, error) {
+	logger := c.log.Scoped("ListOpenMilestones")
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).ListOpenMilestones(ctx, owner, repo)
+}
+
+// SetIssueMilestone sets the milestone of an issue or pull request.
+func (c *V4Client) SetIssueMilestone(ctx context.Context, owner, repo string, number, milestone int64) error {
+	logger := c.log.Scoped("SetIssueMilestone")
+	// The GraphQL API has no mutation to set the milestone of a pull request.
+	return NewV3Client(logger, c.urn, c.apiURL, c.auth, c.httpClient).SetIssueMilestone(ctx, owner, repo, number, milestone)
+}
+
 type RecentCommittersParams struct {
 	// Repository name
 	Name string
@@ -48,10 +48,14 @@ type MergeRequest struct {
 	WorkInProgress          bool              `json:"work_in_progress"`
 	Draft                   bool              `json:"draft"`
 	ForceRemoveSourceBranch bool              `json:"force_remove_source_branch"`
+	HasConflicts            bool              `json:"has_conflicts"`
 	// We only get a partial User object back from the REST API. For example, it lacks
 	// `Email` and `Identities`. If we need more, we need to issue an additional API
 	// request. Otherwise, we should use a different type here.
 	Author User `json:"author"`
+	// Assignees and Reviewers are partial User objects as well.
+	Assignees []User `json:"assignees"`
+	Reviewers []User `json:"reviewers"`
 
 	DiffRefs DiffRefs `json:"diff_refs"`
 
@@ -223,6 +227,14 @@ type UpdateMergeRequestOpts struct {
 	Description        string                       `json:"description,omitempty"`
 	StateEvent         UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
 	RemoveSourceBranch bool                         `json:"remove_source_branch,omitempty"`
+	// AddLabels is a comma-separated list of labels to add to the merge
+	// request. Labels that don't exist yet are created.
+	AddLabels string `json:"add_labels,omitempty"`
+	// AssigneeIDs and ReviewerIDs replace the assignees and reviewers of the
+	// merge request.
+	AssigneeIDs []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
+	MilestoneID ID      `json:"milestone_id,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
@@ -0,0 +1,49 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Milestone is a milestone of a project.
+type Milestone struct {
+	ID    ID     `json:"id"`
+	IID   ID     `json:"iid"`
+	Title string `json:"title"`
+	State string `json:"state"`
+}
+
+// ErrMilestoneNotFound is returned by GetActiveMilestoneByTitle if the project
+// has no active milestone with the given title.
+var ErrMilestoneNotFound = errors.New("milestone not found")
+
+// GetActiveMilestoneByTitle returns the active milestone of the project with
+// the given title.
+func (c *Client) GetActiveMilestoneByTitle(ctx context.Context, project *Project, title string) (*Milestone, error) {
+	if MockGetActiveMilestoneByTitle != nil {
+		return MockGetActiveMilestoneByTitle(c, ctx, project, title)
+	}
+
+	values := make(url.Values)
+	values.Add("title", title)
+	values.Add("state", "active")
+	u := &url.URL{Path: fmt.Sprintf("projects/%d/milestones", project.ID), RawQuery: values.Encode()}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to get milestone by title")
+	}
+
+	var milestones []*Milestone
+	if _, _, err := c.do(ctx, req, &milestones); err != nil {
+		return nil, errors.Wrap(err, "sending request to get milestone by title")
+	}
+	if len(milestones) == 0 {
+		return nil, ErrMilestoneNotFound
+	}
+	return milestones[0], nil
+}
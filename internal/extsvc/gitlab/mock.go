@@ -11,6 +11,14 @@ var MockListUsers func(c *Client, ctx context.Context, urlStr string) (users []*
 // MockGetUser, if non-nil, will be called instead of Client.GetUser
 var MockGetUser func(c *Client, ctx context.Context, id string) (*AuthUser, error)
 
+// MockGetUserByUsername, if non-nil, will be called instead of
+// Client.GetUserByUsername
+var MockGetUserByUsername func(c *Client, ctx context.Context, username string) (*User, error)
+
+// MockGetActiveMilestoneByTitle, if non-nil, will be called instead of
+// Client.GetActiveMilestoneByTitle
+var MockGetActiveMilestoneByTitle func(c *Client, ctx context.Context, project *Project, title string) (*Milestone, error)
+
 // MockGetProject, if non-nil, will be called instead of Client.GetProject
 var MockGetProject func(c *Client, ctx context.Context, op GetProjectOp) (*Project, error)
 
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/peterhellberg/link"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type User struct {
@@ -84,3 +87,32 @@ func (c *Client) GetUser(ctx context.Context, id string) (*AuthUser, error) {
 	}
 	return &usr, nil
 }
+
+// ErrUserNotFound is returned by GetUserByUsername if no user with the given
+// username exists.
+var ErrUserNotFound = errors.New("user not found")
+
+// GetUserByUsername returns the user with the given username.
+func (c *Client) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	if MockGetUserByUsername != nil {
+		return MockGetUserByUsername(c, ctx, username)
+	}
+
+	values := make(url.Values)
+	values.Add("username", username)
+	u := &url.URL{Path: "users", RawQuery: values.Encode()}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to get user by username")
+	}
+
+	var users []*User
+	if _, _, err := c.do(ctx, req, &users); err != nil {
+		return nil, errors.Wrap(err, "sending request to get user by username")
+	}
+	if len(users) == 0 {
+		return nil, ErrUserNotFound
+	}
+	return users[0], nil
+}
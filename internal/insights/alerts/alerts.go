@@ -0,0 +1,131 @@
+// Package alerts evaluates the alert rules of code insights series and delivers the fired
+// alerts through the same email, Slack and webhook actions as code monitors.
+package alerts
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+)
+
+// Trigger is a value of a series an alert fires on.
+type Trigger struct {
+	// Capture is the capture group value the alert fires on, or the empty string for series
+	// that aren't generated from capture groups.
+	Capture       string
+	Value         float64
+	PreviousValue *float64
+}
+
+// Evaluate returns the values of current the alert fires on, ordered by capture.
+//
+// previous are the values of the series at its previous recording time, and must be nil if the
+// series was never recorded before. Values missing from current or previous are zero, as
+// repositories without results aren't recorded. known are the capture group values recorded
+// before, and are only used by NewCaptureValueAlert alerts.
+func Evaluate(alert types.InsightSeriesAlert, current, previous store.CaptureValues, known map[string]struct{}) []Trigger {
+	var triggers []Trigger
+	for _, capture := range captures(current, previous) {
+		value := current[capture]
+		var previousValue *float64
+		if previous != nil {
+			v := previous[capture]
+			previousValue = &v
+		}
+
+		if fires(alert, capture, value, previousValue, known) {
+			triggers = append(triggers, Trigger{Capture: capture, Value: value, PreviousValue: previousValue})
+		}
+	}
+	return triggers
+}
+
+func fires(alert types.InsightSeriesAlert, capture string, value float64, previous *float64, known map[string]struct{}) bool {
+	switch alert.Kind {
+	case types.ThresholdAlert:
+		// Threshold alerts fire when the value crosses the threshold, not every time it is
+		// beyond it. The first recording of a series counts as a crossing.
+		if alert.Direction != nil && *alert.Direction == types.AlertBelow {
+			return value <= alert.Threshold && (previous == nil || *previous > alert.Threshold)
+		}
+		return value >= alert.Threshold && (previous == nil || *previous < alert.Threshold)
+
+	case types.PercentageChangeAlert:
+		// A change from zero has no meaningful percentage, so we ignore it.
+		if previous == nil || *previous == 0 || value == *previous {
+			return false
+		}
+		return math.Abs(percentageChange(*previous, value)) >= alert.Threshold
+
+	case types.NewCaptureValueAlert:
+		// Without any previous recording every value is new, which isn't worth an alert.
+		if previous == nil || capture == "" || value == 0 {
+			return false
+		}
+		_, ok := known[capture]
+		return !ok
+	}
+	return false
+}
+
+// captures returns the sorted union of the captures of current and previous.
+func captures(current, previous store.CaptureValues) []string {
+	set := make(map[string]struct{}, len(current))
+	for capture := range current {
+		set[capture] = struct{}{}
+	}
+	for capture := range previous {
+		set[capture] = struct{}{}
+	}
+	sorted := make([]string, 0, len(set))
+	for capture := range set {
+		sorted = append(sorted, capture)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func percentageChange(previous, value float64) float64 {
+	return (value - previous) / previous * 100
+}
+
+// Message returns the human readable description of a fired alert of a series with the given
+// query.
+func Message(query string, alert types.InsightSeriesAlert, trigger Trigger) string {
+	subject := fmt.Sprintf("Series %q", query)
+	if trigger.Capture != "" {
+		subject = fmt.Sprintf("Series %q (%q)", query, trigger.Capture)
+	}
+
+	switch alert.Kind {
+	case types.ThresholdAlert:
+		direction := types.AlertAbove
+		if alert.Direction != nil {
+			direction = *alert.Direction
+		}
+		msg := fmt.Sprintf("%s is %s, %s the threshold of %s", subject, formatValue(trigger.Value), direction, formatValue(alert.Threshold))
+		if trigger.PreviousValue != nil {
+			msg += fmt.Sprintf(" (previously %s)", formatValue(*trigger.PreviousValue))
+		}
+		return msg + "."
+
+	case types.PercentageChangeAlert:
+		var previous float64
+		if trigger.PreviousValue != nil {
+			previous = *trigger.PreviousValue
+		}
+		return fmt.Sprintf("%s changed by %+.1f%% from %s to %s.", subject, percentageChange(previous, trigger.Value), formatValue(previous), formatValue(trigger.Value))
+
+	case types.NewCaptureValueAlert:
+		return fmt.Sprintf("Series %q recorded the new value %q (%s).", query, trigger.Capture, formatValue(trigger.Value))
+	}
+	return subject + " fired an alert."
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
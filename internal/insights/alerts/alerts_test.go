@@ -0,0 +1,158 @@
+package alerts
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestEvaluate(t *testing.T) {
+	above := types.InsightSeriesAlert{Kind: types.ThresholdAlert, Threshold: 10, Direction: pointers.Ptr(types.AlertAbove)}
+	below := types.InsightSeriesAlert{Kind: types.ThresholdAlert, Threshold: 10, Direction: pointers.Ptr(types.AlertBelow)}
+	change := types.InsightSeriesAlert{Kind: types.PercentageChangeAlert, Threshold: 50}
+	newCapture := types.InsightSeriesAlert{Kind: types.NewCaptureValueAlert}
+
+	testCases := []struct {
+		name     string
+		alert    types.InsightSeriesAlert
+		current  store.CaptureValues
+		previous store.CaptureValues
+		known    map[string]struct{}
+		want     []Trigger
+	}{
+		{
+			name:     "crosses above threshold",
+			alert:    above,
+			current:  store.CaptureValues{"": 12},
+			previous: store.CaptureValues{"": 8},
+			want:     []Trigger{{Value: 12, PreviousValue: pointers.Ptr(8.0)}},
+		},
+		{
+			name:     "stays above threshold",
+			alert:    above,
+			current:  store.CaptureValues{"": 12},
+			previous: store.CaptureValues{"": 11},
+		},
+		{
+			name:    "above threshold on first recording",
+			alert:   above,
+			current: store.CaptureValues{"": 10},
+			want:    []Trigger{{Value: 10}},
+		},
+		{
+			name:     "threshold without direction is above",
+			alert:    types.InsightSeriesAlert{Kind: types.ThresholdAlert, Threshold: 10},
+			current:  store.CaptureValues{"": 12},
+			previous: store.CaptureValues{"": 8},
+			want:     []Trigger{{Value: 12, PreviousValue: pointers.Ptr(8.0)}},
+		},
+		{
+			name:     "crosses below threshold",
+			alert:    below,
+			current:  store.CaptureValues{"": 3},
+			previous: store.CaptureValues{"": 20},
+			want:     []Trigger{{Value: 3, PreviousValue: pointers.Ptr(20.0)}},
+		},
+		{
+			name:     "missing capture drops to zero",
+			alert:    below,
+			current:  store.CaptureValues{"1.21": 15},
+			previous: store.CaptureValues{"1.20": 14, "1.21": 15},
+			want:     []Trigger{{Capture: "1.20", Value: 0, PreviousValue: pointers.Ptr(14.0)}},
+		},
+		{
+			name:     "percentage increase",
+			alert:    change,
+			current:  store.CaptureValues{"": 15},
+			previous: store.CaptureValues{"": 10},
+			want:     []Trigger{{Value: 15, PreviousValue: pointers.Ptr(10.0)}},
+		},
+		{
+			name:     "percentage decrease",
+			alert:    change,
+			current:  store.CaptureValues{"": 4},
+			previous: store.CaptureValues{"": 10},
+			want:     []Trigger{{Value: 4, PreviousValue: pointers.Ptr(10.0)}},
+		},
+		{
+			name:     "small percentage change",
+			alert:    change,
+			current:  store.CaptureValues{"": 14},
+			previous: store.CaptureValues{"": 10},
+		},
+		{
+			name:     "change from zero",
+			alert:    change,
+			current:  store.CaptureValues{"": 14},
+			previous: store.CaptureValues{"": 0},
+		},
+		{
+			name:    "change without previous recording",
+			alert:   change,
+			current: store.CaptureValues{"": 14},
+		},
+		{
+			name:     "new capture value",
+			alert:    newCapture,
+			current:  store.CaptureValues{"1.20": 3, "1.21": 1},
+			previous: store.CaptureValues{"1.20": 3},
+			known:    map[string]struct{}{"1.19": {}, "1.20": {}},
+			want:     []Trigger{{Capture: "1.21", Value: 1, PreviousValue: pointers.Ptr(0.0)}},
+		},
+		{
+			name:     "capture value recorded before",
+			alert:    newCapture,
+			current:  store.CaptureValues{"1.19": 2},
+			previous: store.CaptureValues{"1.20": 3},
+			known:    map[string]struct{}{"1.19": {}, "1.20": {}},
+		},
+		{
+			name:    "new capture value without previous recording",
+			alert:   newCapture,
+			current: store.CaptureValues{"1.21": 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			have := Evaluate(tc.alert, tc.current, tc.previous, tc.known)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Errorf("unexpected triggers (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	testCases := []struct {
+		alert   types.InsightSeriesAlert
+		trigger Trigger
+		want    string
+	}{
+		{
+			alert:   types.InsightSeriesAlert{Kind: types.ThresholdAlert, Threshold: 10, Direction: pointers.Ptr(types.AlertBelow)},
+			trigger: Trigger{Value: 3, PreviousValue: pointers.Ptr(20.0)},
+			want:    `Series "TODO" is 3, below the threshold of 10 (previously 20).`,
+		},
+		{
+			alert:   types.InsightSeriesAlert{Kind: types.PercentageChangeAlert, Threshold: 50},
+			trigger: Trigger{Capture: "1.21", Value: 15, PreviousValue: pointers.Ptr(10.0)},
+			want:    `Series "TODO" ("1.21") changed by +50.0% from 10 to 15.`,
+		},
+		{
+			alert:   types.InsightSeriesAlert{Kind: types.NewCaptureValueAlert},
+			trigger: Trigger{Capture: "1.21", Value: 1.5},
+			want:    `Series "TODO" recorded the new value "1.21" (1.5).`,
+		},
+	}
+
+	for _, tc := range testCases {
+		if have := Message("TODO", tc.alert, tc.trigger); have != tc.want {
+			t.Errorf("unexpected message: want=%q have=%q", tc.want, have)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Evaluator evaluates the alerts of a series after a snapshot of it was recorded, and stores
+// and delivers the alerts that fire.
+type Evaluator struct {
+	logger      log.Logger
+	alertStore  *store.AlertStore
+	seriesStore *store.Store
+	notifier    *notifier
+}
+
+func NewEvaluator(logger log.Logger, db database.DB, insightsDB database.InsightsDB) *Evaluator {
+	return &Evaluator{
+		logger:      logger,
+		alertStore:  store.NewAlertStore(insightsDB),
+		seriesStore: store.New(insightsDB, store.NewInsightPermissionStore(db)),
+		notifier:    newNotifier(db),
+	}
+}
+
+// EvaluateSnapshot evaluates every alert of the series against its latest snapshot, recorded at
+// recordTime.
+func (e *Evaluator) EvaluateSnapshot(ctx context.Context, series *types.InsightSeries, recordTime time.Time) (errs error) {
+	alerts, err := e.alertStore.ListAlerts(ctx, store.ListAlertsArgs{SeriesIDs: []int{series.ID}})
+	if err != nil {
+		return err
+	}
+	for _, alert := range alerts {
+		// 🚨 SECURITY: alerts are evaluated as their owner, so that they only include the values
+		// of the repositories the owner can see.
+		userCtx := actor.WithActor(ctx, actor.FromUser(alert.UserID))
+		if err := e.evaluate(userCtx, series, alert, recordTime); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "alert %d", alert.ID))
+		}
+	}
+	return errs
+}
+
+func (e *Evaluator) evaluate(ctx context.Context, series *types.InsightSeries, alert types.InsightSeriesAlert, recordTime time.Time) error {
+	snapshotTime, current, err := e.seriesStore.LatestSnapshotValues(ctx, series.SeriesID)
+	if err != nil {
+		return err
+	}
+	if snapshotTime.IsZero() {
+		// The snapshot found no results in any repository the owner can see.
+		snapshotTime = recordTime
+	}
+	if !series.GeneratedFromCaptureGroups {
+		// Series without results have a value of zero, which threshold alerts may fire on.
+		if _, ok := current[""]; !ok {
+			current[""] = 0
+		}
+	}
+	previousTime, previous, err := e.seriesStore.PreviousRecordedValues(ctx, series.SeriesID, snapshotTime)
+	if err != nil {
+		return err
+	}
+	if previousTime.IsZero() {
+		previous = nil
+	}
+
+	var known map[string]struct{}
+	if alert.Kind == types.NewCaptureValueAlert && series.GeneratedFromCaptureGroups {
+		if known, err = e.seriesStore.RecordedCaptures(ctx, series.SeriesID, snapshotTime); err != nil {
+			return err
+		}
+	}
+
+	var errs error
+	for _, trigger := range Evaluate(alert, current, previous, known) {
+		var capture *string
+		if series.GeneratedFromCaptureGroups {
+			capture = &trigger.Capture
+		}
+
+		// Snapshots are recorded more often than points, and are compared to the same previous
+		// recording until the next one, so we only alert once per recording interval.
+		fired, err := e.alertStore.HasAlertEventSince(ctx, alert.ID, capture, previousTime)
+		if err != nil {
+			errs = errors.Append(errs, err)
+			continue
+		}
+		if fired {
+			continue
+		}
+
+		event := types.InsightSeriesAlertEvent{
+			AlertID:       alert.ID,
+			RecordingTime: snapshotTime,
+			Capture:       capture,
+			Value:         trigger.Value,
+			PreviousValue: trigger.PreviousValue,
+			Message:       Message(series.Query, alert, trigger),
+		}
+		if err := e.notifier.notify(ctx, alert, newNotification(alert, series, event)); err != nil {
+			e.logger.Warn("failed to deliver insight series alert", log.Int("alertID", alert.ID), log.Error(err))
+			msg := err.Error()
+			event.DeliveryError = &msg
+		}
+		if _, err := e.alertStore.CreateAlertEvent(ctx, event); err != nil {
+			errs = errors.Append(errs, err)
+		}
+	}
+	return errs
+}
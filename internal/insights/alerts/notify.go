@@ -0,0 +1,98 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	cmbackground "github.com/sourcegraph/sourcegraph/internal/codemonitors/background"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// notifier delivers fired alerts to the actions configured on them.
+type notifier struct {
+	db        database.DB
+	doer      httpcli.Doer
+	sendEmail func(ctx context.Context, db database.DB, source string, userID int32, template txtypes.Templates, data any) error
+}
+
+func newNotifier(db database.DB) *notifier {
+	return &notifier{
+		db:        db,
+		doer:      httpcli.ExternalDoer,
+		sendEmail: cmbackground.SendEmail,
+	}
+}
+
+// notification is a fired alert, as delivered to emails, Slack and webhooks.
+type notification struct {
+	Kind          types.AlertKind `json:"kind"`
+	SeriesID      string          `json:"seriesID"`
+	Query         string          `json:"query"`
+	Capture       *string         `json:"capture,omitempty"`
+	Value         float64         `json:"value"`
+	PreviousValue *float64        `json:"previousValue,omitempty"`
+	RecordingTime time.Time       `json:"recordingTime"`
+	Message       string          `json:"message"`
+	InsightsURL   string          `json:"insightsURL"`
+}
+
+func newNotification(alert types.InsightSeriesAlert, series *types.InsightSeries, event types.InsightSeriesAlertEvent) notification {
+	return notification{
+		Kind:          alert.Kind,
+		SeriesID:      series.SeriesID,
+		Query:         series.Query,
+		Capture:       event.Capture,
+		Value:         event.Value,
+		PreviousValue: event.PreviousValue,
+		RecordingTime: event.RecordingTime,
+		Message:       event.Message,
+		InsightsURL:   conf.ExternalURL() + "/insights",
+	}
+}
+
+var alertEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `Sourcegraph code insights alert: {{.Query}}`,
+	Text: `{{.Message}}
+
+View your code insights: {{.InsightsURL}}
+`,
+	HTML: `<p>{{.Message}}</p>
+<p><a href="{{.InsightsURL}}">View your code insights</a></p>
+`,
+})
+
+// notify delivers n to every action of the alert. It returns the errors of the actions that
+// failed, so that the other actions are still attempted.
+func (n *notifier) notify(ctx context.Context, alert types.InsightSeriesAlert, msg notification) (errs error) {
+	if alert.NotifyEmail {
+		if err := n.sendEmail(ctx, n.db, "code-insights-alert", alert.UserID, alertEmailTemplates, msg); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "email"))
+		}
+	}
+	if alert.SlackWebhookURL != nil {
+		if err := cmbackground.PostSlackWebhook(ctx, n.doer, *alert.SlackWebhookURL, slackMessage(msg)); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "Slack webhook"))
+		}
+	}
+	if alert.WebhookURL != nil {
+		if err := cmbackground.PostWebhook(ctx, n.doer, *alert.WebhookURL, msg); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "webhook"))
+		}
+	}
+	return errs
+}
+
+func slackMessage(msg notification) *slack.WebhookMessage {
+	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", "*Code insights alert*\n"+msg.Message, false, false), nil, nil),
+		slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", "<"+msg.InsightsURL+"|View your code insights>", false, false), nil, nil),
+	}}}
+}
@@ -0,0 +1,105 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestNotify(t *testing.T) {
+	ctx := context.Background()
+
+	var slackBody, webhookBody []byte
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/slack":
+			slackBody = body
+		case "/webhook":
+			webhookBody = body
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	var emailedUserID int32
+	n := &notifier{
+		db:   dbmocks.NewMockDB(),
+		doer: s.Client(),
+		sendEmail: func(_ context.Context, _ database.DB, _ string, userID int32, _ txtypes.Templates, _ any) error {
+			emailedUserID = userID
+			return nil
+		},
+	}
+
+	series := &types.InsightSeries{SeriesID: "s1", Query: "TODO"}
+	event := types.InsightSeriesAlertEvent{
+		RecordingTime: time.Date(2023, 12, 5, 0, 0, 0, 0, time.UTC),
+		Value:         12,
+		PreviousValue: pointers.Ptr(8.0),
+		Message:       `Series "TODO" is 12, above the threshold of 10 (previously 8).`,
+	}
+
+	t.Run("delivers to all actions", func(t *testing.T) {
+		alert := types.InsightSeriesAlert{
+			Kind:            types.ThresholdAlert,
+			UserID:          7,
+			NotifyEmail:     true,
+			SlackWebhookURL: pointers.Ptr(s.URL + "/slack"),
+			WebhookURL:      pointers.Ptr(s.URL + "/webhook"),
+		}
+		if err := n.notify(ctx, alert, newNotification(alert, series, event)); err != nil {
+			t.Fatal(err)
+		}
+
+		if emailedUserID != 7 {
+			t.Errorf("unexpected email recipient: %d", emailedUserID)
+		}
+		if !strings.Contains(string(slackBody), "above the threshold of 10") {
+			t.Errorf("unexpected Slack message: %s", slackBody)
+		}
+
+		var payload notification
+		if err := json.Unmarshal(webhookBody, &payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.SeriesID != "s1" || payload.Value != 12 || payload.Kind != types.ThresholdAlert {
+			t.Errorf("unexpected webhook payload: %s", webhookBody)
+		}
+	})
+
+	t.Run("attempts all actions on errors", func(t *testing.T) {
+		webhookBody = nil
+		failing := *n
+		failing.sendEmail = func(context.Context, database.DB, string, int32, txtypes.Templates, any) error {
+			return errors.New("no verified email")
+		}
+		alert := types.InsightSeriesAlert{
+			Kind:            types.ThresholdAlert,
+			NotifyEmail:     true,
+			SlackWebhookURL: pointers.Ptr(s.URL + "/missing"),
+			WebhookURL:      pointers.Ptr(s.URL + "/webhook"),
+		}
+
+		err := failing.notify(ctx, alert, newNotification(alert, series, event))
+		if err == nil || !strings.Contains(err.Error(), "no verified email") || !strings.Contains(err.Error(), "Slack webhook") {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if webhookBody == nil {
+			t.Error("expected webhook to be delivered")
+		}
+	})
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	edb "github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/env"
 	internalGitserver "github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/insights/alerts"
 	"github.com/sourcegraph/sourcegraph/internal/insights/background/limiter"
 	"github.com/sourcegraph/sourcegraph/internal/insights/background/pings"
 	"github.com/sourcegraph/sourcegraph/internal/insights/background/queryrunner"
@@ -33,15 +35,21 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 )
 
+// failedJobRetention is the duration for which failed query runner and data retention jobs are
+// kept before they are deleted.
+var failedJobRetention = env.MustGetDuration("INSIGHTS_FAILED_JOB_RETENTION", 720*time.Hour, "The duration for which failed code insights query runner and data retention jobs are kept before they are deleted.")
+
 type RepoStore interface {
 	GetByName(ctx context.Context, name api.RepoName) (*types.Repo, error)
 }
 
 // GetBackgroundJobs is the main entrypoint which starts background jobs for code insights. It is
-// called from the worker service.
-func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB) []goroutine.BackgroundRoutine {
+// called from the worker service. The precise references counter is used to backfill precise
+// references series.
+func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB, preciseReferences queryrunner.PreciseReferencesCounter) []goroutine.BackgroundRoutine {
 	insightPermStore := store.NewInsightPermissionStore(mainAppDB)
 	insightsStore := store.New(insightsDB, insightPermStore)
 
@@ -80,7 +88,7 @@ func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB databas
 		historicRateLimiter := limiter.HistoricalWorkRate()
 		backfillConfig := pipeline.BackfillerConfig{
 			CompressionPlan:         compression.NewGitserverFilter(logger, gitserverClient.Scoped("compressionfilter")),
-			SearchHandlers:          queryrunner.GetSearchHandlers(),
+			SearchHandlers:          queryrunner.GetSearchHandlers(preciseReferences),
 			InsightStore:            insightsStore,
 			CommitClient:            gitserver.NewGitCommitClient(gitserverClient.Scoped("commitclient")),
 			SearchPlanWorkerLimit:   1,
@@ -119,7 +127,7 @@ func GetBackgroundJobs(ctx context.Context, logger log.Logger, mainAppDB databas
 
 // GetBackgroundQueryRunnerJob is the main entrypoint for starting the background jobs for code
 // insights query runner. It is called from the worker service.
-func GetBackgroundQueryRunnerJob(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB) []goroutine.BackgroundRoutine {
+func GetBackgroundQueryRunnerJob(ctx context.Context, logger log.Logger, mainAppDB database.DB, insightsDB edb.InsightsDB, preciseReferences queryrunner.PreciseReferencesCounter) []goroutine.BackgroundRoutine {
 	insightPermStore := store.NewInsightPermissionStore(mainAppDB)
 	insightsStore := store.New(insightsDB, insightPermStore)
 
@@ -138,9 +146,10 @@ func GetBackgroundQueryRunnerJob(ctx context.Context, logger log.Logger, mainApp
 	return []goroutine.BackgroundRoutine{
 		// Register the query-runner worker and resetter, which executes search queries and records
 		// results to the insights DB.
-		queryrunner.NewWorker(ctx, logger.Scoped("queryrunner.Worker"), workerStore, insightsStore, repoStore, queryRunnerWorkerMetrics, seachQueryLimiter),
+		queryrunner.NewWorker(ctx, logger.Scoped("queryrunner.Worker"), workerStore, insightsStore, repoStore, queryRunnerWorkerMetrics, seachQueryLimiter, preciseReferences, alerts.NewEvaluator(logger.Scoped("alerts"), mainAppDB, insightsDB)),
 		queryrunner.NewResetter(ctx, logger.Scoped("queryrunner.Resetter"), workerStore, queryRunnerResetterMetrics),
 		queryrunner.NewCleaner(ctx, observationCtx, workerBaseStore),
+		newDeadLetterJanitor(observationCtx, logger.Scoped("queryrunner.DeadLetterJanitor"), workerStore, "query_runner_worker"),
 	}
 }
 
@@ -156,9 +165,22 @@ func GetBackgroundDataRetentionJob(ctx context.Context, observationCtx *observat
 		retention.NewWorker(ctx, observationCtx.Logger.Scoped("Worker"), dbWorkerStore, insightsStore, workerMetrics),
 		retention.NewResetter(ctx, observationCtx.Logger.Scoped("Resetter"), dbWorkerStore, resetterMetrics),
 		retention.NewCleaner(ctx, observationCtx, workerBaseStore),
+		newDeadLetterJanitor(observationCtx, observationCtx.Logger.Scoped("DeadLetterJanitor"), dbWorkerStore, "insights_data_retention"),
 	}
 }
 
+// newDeadLetterJanitor returns a janitor deleting the jobs of the given worker store that failed
+// longer than failedJobRetention ago. The cleaners of the workers only delete completed jobs.
+func newDeadLetterJanitor[T workerutil.Record](observationCtx *observation.Context, logger log.Logger, workerStore dbworkerstore.Store[T], workerName string) goroutine.BackgroundRoutine {
+	return dbworker.NewDeadLetterJanitor(logger, workerStore, dbworker.DeadLetterJanitorOptions{
+		Name:      workerName + "_dead_letter_janitor",
+		Interval:  1 * time.Hour,
+		Retention: failedJobRetention,
+		BatchSize: 1000,
+		Metrics:   dbworker.NewDeadLetterJanitorMetrics(observationCtx, workerName),
+	})
+}
+
 // newWorkerMetrics returns a basic set of metrics to be used for a worker and its resetter:
 //
 //   - WorkerMetrics records worker operations & number of jobs.
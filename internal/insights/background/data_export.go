@@ -0,0 +1,42 @@
+package background
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/insights/export"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewInsightsDataExportJob will periodically export the full history of all series to the given
+// upload store, and delete the exports that are older than the configured retention.
+func NewInsightsDataExportJob(ctx context.Context, postgres database.DB, insightsdb edb.InsightsDB, uploads uploadstore.Store, conf *export.Config) goroutine.BackgroundRoutine {
+	logger := log.Scoped("InsightsDataExportJob")
+	seriesStore := store.New(insightsdb, store.NewInsightPermissionStore(postgres))
+
+	return goroutine.NewPeriodicGoroutine(
+		// The export is meant to be joined with other data by site admins, so it includes the
+		// points of all repositories.
+		actor.WithInternalActor(ctx),
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			key, err := export.Upload(ctx, seriesStore, uploads, conf.Format, time.Now())
+			if err != nil {
+				return errors.Wrap(err, "exporting series points")
+			}
+			logger.Info("exported code insights data", log.String("key", key))
+
+			return errors.Wrap(uploads.ExpireObjects(ctx, export.KeyPrefix, conf.Retention), "expiring exports")
+		}),
+		goroutine.WithName("insights.data_export"),
+		goroutine.WithDescription("exports the data of all series to an upload store"),
+		goroutine.WithInterval(conf.Interval),
+	)
+}
@@ -145,6 +145,11 @@ func (ie *InsightEnqueuer) EnqueueSingle(
 	var err error
 
 	basicQuery := querybuilder.BasicQuery(series.Query)
+	if series.GenerationMethod == types.PreciseReferences {
+		// The query of a precise references series is a SCIP symbol, not a search query. Its jobs
+		// only select the repositories to count the references in.
+		basicQuery = ""
+	}
 	var modifiedQuery querybuilder.BasicQuery
 	var finalQuery string
 
@@ -0,0 +1,117 @@
+package queryrunner
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query/querybuilder"
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PreciseReferencesCounter counts the precise references to a SCIP symbol in a repository at
+// a commit. It is implemented by the codenav service.
+type PreciseReferencesCounter interface {
+	CountPreciseReferences(ctx context.Context, repositoryID int, commit, symbolName string) (int, error)
+}
+
+// revisionResolver resolves a revision of a repository to a commit.
+type revisionResolver interface {
+	ResolveRevision(ctx context.Context, repo api.RepoName, spec string, opt gitserver.ResolveRevisionOptions) (api.CommitID, error)
+}
+
+// makePreciseReferencesHandler returns the handler of precise references series. The query of
+// such a series is the SCIP symbol to count the references to, and the search query of a job
+// only selects the repositories (and for historical jobs, the revision) to count them in. Like
+// search series, repositories without any reference are not recorded.
+func makePreciseReferencesHandler(repoExecutor query.RepoQueryExecutor, revisions revisionResolver, counter PreciseReferencesCounter) InsightsHandler {
+	return func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error) {
+		recordings, err := generatePreciseReferencesRecordings(ctx, job, series, recordTime, repoExecutor, revisions, counter, log.Scoped("PreciseReferencesRecordingsGenerator"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "preciseReferencesHandler")
+		}
+		return recordings, nil
+	}
+}
+
+func generatePreciseReferencesRecordings(
+	ctx context.Context,
+	job *SearchJob,
+	series *types.InsightSeries,
+	recordTime time.Time,
+	repoExecutor query.RepoQueryExecutor,
+	revisions revisionResolver,
+	counter PreciseReferencesCounter,
+	logger log.Logger,
+) ([]store.RecordSeriesPointArgs, error) {
+	revision, err := searchQueryRevision(job.SearchQuery)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := repoExecutor.ExecuteRepoList(ctx, job.SearchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := authz.DefaultSubRepoPermsChecker
+	var recordings []store.RecordSeriesPointArgs
+
+	for _, repo := range repos {
+		// sub-repo permissions filtering. If the repo supports it, then it should be excluded from the results
+		subRepoEnabled, subRepoErr := authz.SubRepoEnabledForRepoID(ctx, checker, repo.ID)
+		if subRepoErr != nil {
+			logger.Error("sub-repo permissions check errored", log.String("seriesID", job.SeriesID), log.String("repo", string(repo.Name)), log.Error(subRepoErr))
+			continue
+		}
+		if subRepoEnabled {
+			continue
+		}
+
+		commit, err := revisions.ResolveRevision(ctx, repo.Name, revision, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+		if err != nil {
+			if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+				continue // no error - repo may not be cloned yet (or not even pushed to code host yet)
+			}
+			return nil, errors.Wrapf(err, "resolving revision %q of %s", revision, repo.Name)
+		}
+
+		count, err := counter.CountPreciseReferences(ctx, int(repo.ID), string(commit), series.Query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "counting precise references in %s", repo.Name)
+		}
+		if count == 0 {
+			continue
+		}
+		recordings = append(recordings, toRecording(job, float64(count), recordTime, string(repo.Name), repo.ID, nil)...)
+	}
+
+	return recordings, nil
+}
+
+// searchQueryRevision returns the revision the repository filter of the given search query is
+// pinned to, or an empty string (the default branch) if it isn't.
+func searchQueryRevision(searchQuery string) (string, error) {
+	plan, err := querybuilder.ParseQuery(searchQuery, "literal")
+	if err != nil {
+		return "", errors.Wrap(err, "ParseQuery")
+	}
+	for _, basic := range plan {
+		repos, _ := basic.Parameters.Repositories()
+		for _, repo := range repos {
+			for _, rev := range repo.Revs {
+				if rev.RevSpec != "" {
+					return rev.RevSpec, nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
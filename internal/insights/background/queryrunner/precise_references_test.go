@@ -0,0 +1,108 @@
+package queryrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+type fakeRepoQueryExecutor []itypes.MinimalRepo
+
+func (f fakeRepoQueryExecutor) ExecuteRepoList(context.Context, string) ([]itypes.MinimalRepo, error) {
+	return f, nil
+}
+
+type fakeRevisionResolver map[api.RepoName]api.CommitID
+
+func (f fakeRevisionResolver) ResolveRevision(_ context.Context, repo api.RepoName, spec string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+	commit, ok := f[repo]
+	if !ok {
+		return "", &gitdomain.RevisionNotFoundError{Repo: repo, Spec: spec}
+	}
+	if spec != "" {
+		return api.CommitID(spec), nil
+	}
+	return commit, nil
+}
+
+type fakePreciseReferencesCounter map[string]int
+
+func (f fakePreciseReferencesCounter) CountPreciseReferences(_ context.Context, _ int, commit, _ string) (int, error) {
+	return f[commit], nil
+}
+
+func TestGeneratePreciseReferencesRecordings(t *testing.T) {
+	date := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	series := &types.InsightSeries{
+		SeriesID:         "testseries1",
+		Query:            "scip-go gomod github.com/sourcegraph/lib v1 `lib`/Deprecated().",
+		GenerationMethod: types.PreciseReferences,
+	}
+	repos := fakeRepoQueryExecutor{
+		{ID: 11, Name: "github.com/sourcegraph/sourcegraph"},
+		{ID: 12, Name: "github.com/sourcegraph/zoekt"},
+		// Not cloned yet.
+		{ID: 13, Name: "github.com/sourcegraph/uncloned"},
+	}
+	revisions := fakeRevisionResolver{
+		"github.com/sourcegraph/sourcegraph": "head11",
+		"github.com/sourcegraph/zoekt":       "head12",
+	}
+	counter := fakePreciseReferencesCounter{"head11": 7, "abc123": 3}
+
+	t.Run("current recording", func(t *testing.T) {
+		job := SearchJob{
+			SeriesID:    "testseries1",
+			SearchQuery: "fork:no archived:no patterntype:literal count:99999999",
+			RecordTime:  &date,
+			PersistMode: "record",
+		}
+
+		recordings, err := generatePreciseReferencesRecordings(context.Background(), &job, series, date, repos, revisions, counter, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Repositories without references are not recorded.
+		autogold.Expect([]string{"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC  7.000000"}).Equal(t, stringify(recordings))
+	})
+
+	t.Run("historical recording", func(t *testing.T) {
+		job := SearchJob{
+			SeriesID:    "testseries1",
+			SearchQuery: "fork:yes archived:yes patterntype:literal count:99999999 repo:^github\\.com/sourcegraph/sourcegraph$@abc123",
+			RecordTime:  &date,
+			PersistMode: "record",
+		}
+
+		recordings, err := generatePreciseReferencesRecordings(context.Background(), &job, series, date, repos[:1], revisions, counter, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect([]string{"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC  3.000000"}).Equal(t, stringify(recordings))
+	})
+}
+
+func TestSearchQueryRevision(t *testing.T) {
+	for query, want := range map[string]string{
+		"fork:no archived:no count:all":                                "",
+		"count:all repo:^github\\.com/sourcegraph/sourcegraph$":        "",
+		"count:all repo:^github\\.com/sourcegraph/sourcegraph$@abc123": "abc123",
+	} {
+		have, err := searchQueryRevision(query)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", query, err)
+		}
+		if have != want {
+			t.Errorf("wrong revision for %q. want=%q have=%q", query, want, have)
+		}
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/insights/discovery"
+	"github.com/sourcegraph/sourcegraph/internal/insights/query"
 	"github.com/sourcegraph/sourcegraph/internal/insights/query/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/internal/insights/types"
@@ -20,7 +22,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 )
 
-func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
+// GetSearchHandlers returns the handlers of all generation methods of series that are
+// recorded in the background. Precise references series are only handled if a
+// PreciseReferencesCounter is given.
+func GetSearchHandlers(preciseReferences PreciseReferencesCounter) map[types.GenerationMethod]InsightsHandler {
 	searchStream := func(ctx context.Context, query string) (*streaming.TabulationResult, error) {
 		tr, ctx := trace.New(ctx, "CodeInsightsSearch.searchStream")
 		defer tr.End()
@@ -56,12 +61,16 @@ func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
 		return streamResults, nil
 	}
 
-	return map[types.GenerationMethod]InsightsHandler{
+	handlers := map[types.GenerationMethod]InsightsHandler{
 		types.MappingCompute: makeMappingComputeHandler(computeTextExtraSearch),
 		types.SearchCompute:  makeComputeHandler(computeSearchStream),
 		types.Search:         makeSearchHandler(searchStream),
 	}
-
+	if preciseReferences != nil {
+		repoExecutor := query.NewStreamingRepoQueryExecutor(log.Scoped("PreciseReferencesRepoExecutor"))
+		handlers[types.PreciseReferences] = makePreciseReferencesHandler(repoExecutor, gitserver.NewClient("insights.precisereferences"), preciseReferences)
+	}
+	return handlers
 }
 
 func toRecording(record *SearchJob, value float64, recordTime time.Time, repoName string, repoID api.RepoID, capture *string) []store.RecordSeriesPointArgs {
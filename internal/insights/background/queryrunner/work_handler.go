@@ -36,6 +36,15 @@ type workHandler struct {
 	seriesCache map[string]*types.InsightSeries
 
 	searchHandlers map[types.GenerationMethod]InsightsHandler
+
+	// alerter, if set, evaluates the alert rules of a series after a snapshot of it is recorded.
+	alerter SnapshotAlerter
+}
+
+// SnapshotAlerter evaluates the alert rules of a series after a snapshot of the series was
+// recorded. It is implemented by the alerts package.
+type SnapshotAlerter interface {
+	EvaluateSnapshot(ctx context.Context, series *types.InsightSeries, recordTime time.Time) error
 }
 
 type InsightsHandler func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error)
@@ -142,7 +151,18 @@ func (r *workHandler) Handle(ctx context.Context, logger log.Logger, record *Job
 		return err
 	}
 
-	return r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime)
+	if err := r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime); err != nil {
+		return err
+	}
+
+	if r.alerter != nil && store.PersistMode(job.PersistMode) == store.SnapshotMode {
+		// Alerts are best effort: failing to evaluate them must not fail, and so retry, the
+		// snapshot that was already recorded.
+		if err := r.alerter.EvaluateSnapshot(ctx, series, recordTime); err != nil {
+			logger.Warn("failed to evaluate insight series alerts", log.String("seriesUniqueId", series.SeriesID), log.Error(err))
+		}
+	}
+	return nil
 }
 
 func TranslateIncompleteReasons(err error) store.IncompleteReason {
@@ -39,7 +39,7 @@ import (
 
 // NewWorker returns a worker that will execute search queries and insert information about the
 // results into the code insights database.
-func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreExtra, insightsStore *store.Store, repoStore discovery.RepoStore, metrics workerutil.WorkerObservability, limiter *ratelimit.InstrumentedLimiter) *workerutil.Worker[*Job] {
+func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreExtra, insightsStore *store.Store, repoStore discovery.RepoStore, metrics workerutil.WorkerObservability, limiter *ratelimit.InstrumentedLimiter, preciseReferences PreciseReferencesCounter, alerter SnapshotAlerter) *workerutil.Worker[*Job] {
 	numHandlers := conf.Get().InsightsQueryWorkerConcurrency
 	if numHandlers <= 0 {
 		// Default concurrency is set to 5.
@@ -76,7 +76,8 @@ func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreE
 		limiter:         limiter,
 		metadadataStore: store.NewInsightStoreWith(insightsStore),
 		seriesCache:     sharedCache,
-		searchHandlers:  GetSearchHandlers(),
+		searchHandlers:  GetSearchHandlers(preciseReferences),
+		alerter:         alerter,
 		logger:          log.Scoped("insights.queryRunner.Handler"),
 	}, options)
 }
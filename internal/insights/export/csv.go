@@ -0,0 +1,49 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+)
+
+type csvWriter struct {
+	w   *csv.Writer
+	row []string
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w), row: make([]string, len(columns))}
+	if err := cw.w.Write(columns); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (w *csvWriter) Write(p store.ExportedSeriesPoint) error {
+	w.row[0] = p.SeriesID
+	w.row[1] = p.Query
+	w.row[2] = p.Time.UTC().Format(time.RFC3339)
+	w.row[3] = ""
+	if p.RepoID != nil {
+		w.row[3] = strconv.Itoa(*p.RepoID)
+	}
+	w.row[4] = emptyStringIfNil(p.RepoName)
+	w.row[5] = strconv.FormatFloat(p.Value, 'f', -1, 64)
+	w.row[6] = emptyStringIfNil(p.Capture)
+	return w.w.Write(w.row)
+}
+
+func (w *csvWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func emptyStringIfNil(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
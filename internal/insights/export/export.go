@@ -0,0 +1,67 @@
+// Package export exports the data of code insights series outside of Sourcegraph, so that it
+// can be joined with other metrics: the latest value of every series as OpenMetrics gauges, and
+// the full history of every series as files in one of the supported formats.
+package export
+
+import (
+	"io"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Format is a file format series points can be exported to.
+type Format string
+
+const (
+	FormatCSV     Format = "csv"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat returns the format with the given name, ignoring case.
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(name)); format {
+	case FormatCSV, FormatParquet:
+		return format, nil
+	default:
+		return "", errors.Errorf("unsupported insights export format %q: must be csv or parquet", name)
+	}
+}
+
+// Extension returns the file extension of the format, without the leading dot.
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// Writer writes series points to a file of a given format.
+type Writer interface {
+	// Write writes a single series point.
+	Write(p store.ExportedSeriesPoint) error
+	// Close flushes the points that are still buffered and writes the end of the file, if the
+	// format has one. It doesn't close the underlying io.Writer.
+	Close() error
+}
+
+// NewWriter returns a Writer writing series points to w in the given format.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatParquet:
+		return newParquetWriter(w)
+	default:
+		return nil, errors.Errorf("unsupported insights export format %q", format)
+	}
+}
+
+// columns are the columns of the exported files, in order.
+var columns = []string{
+	"series_id",
+	"query",
+	"time",
+	"repo_id",
+	"repo_name",
+	"value",
+	"capture",
+}
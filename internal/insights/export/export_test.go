@@ -0,0 +1,207 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+var testPoints = []store.ExportedSeriesPoint{
+	{
+		SeriesID: "s1",
+		Query:    "TODO",
+		Time:     time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+		RepoID:   pointers.Ptr(1),
+		RepoName: pointers.Ptr("github.com/a/a"),
+		Value:    3,
+	},
+	{
+		SeriesID: "s2",
+		Query:    `go\s(\d\.\d+)`,
+		Time:     time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC),
+		RepoID:   pointers.Ptr(1),
+		RepoName: pointers.Ptr("github.com/a/a"),
+		Value:    1.5,
+		Capture:  pointers.Ptr("1.21"),
+	},
+	{
+		SeriesID: "s2",
+		Query:    `go\s(\d\.\d+)`,
+		Time:     time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC),
+		Value:    7,
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]Format{"csv": FormatCSV, "Parquet": FormatParquet} {
+		have, err := ParseFormat(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != want {
+			t.Errorf("unexpected format for %q: want=%q have=%q", name, want, have)
+		}
+	}
+
+	if _, err := ParseFormat("xlsx"); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writeAll(t, FormatCSV, &buf)
+
+	want := `series_id,query,time,repo_id,repo_name,value,capture
+s1,TODO,2023-12-01T00:00:00Z,1,github.com/a/a,3,
+s2,go\s(\d\.\d+),2023-12-02T00:00:00Z,1,github.com/a/a,1.5,1.21
+s2,go\s(\d\.\d+),2023-12-02T00:00:00Z,,,7,
+`
+	if have := buf.String(); have != want {
+		t.Errorf("unexpected csv:\nwant:\n%s\nhave:\n%s", want, have)
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writeAll(t, FormatParquet, &buf)
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf.Bytes()), nil, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer table.Release()
+
+	if have := table.NumRows(); have != int64(len(testPoints)) {
+		t.Fatalf("unexpected number of rows: want=%d have=%d", len(testPoints), have)
+	}
+	if have := table.NumCols(); have != int64(len(columns)) {
+		t.Fatalf("unexpected number of columns: want=%d have=%d", len(columns), have)
+	}
+
+	values := table.Column(5).Data().Chunk(0).(*array.Float64).Float64Values()
+	captures := table.Column(6).Data().Chunk(0).(*array.String)
+	for i, p := range testPoints {
+		if values[i] != p.Value {
+			t.Errorf("unexpected value in row %d: want=%v have=%v", i, p.Value, values[i])
+		}
+		if (p.Capture == nil) != captures.IsNull(i) {
+			t.Errorf("unexpected capture in row %d: want=%v have=%q", i, p.Capture, captures.Value(i))
+		}
+	}
+}
+
+func TestLatestValuesCollector(t *testing.T) {
+	want := `
+# HELP src_insights_series_latest_recording_timestamp_seconds The latest recording time of a code insights series.
+# TYPE src_insights_series_latest_recording_timestamp_seconds gauge
+src_insights_series_latest_recording_timestamp_seconds{series_id="s1"} 1.7013888e+09
+src_insights_series_latest_recording_timestamp_seconds{series_id="s2"} 1.7014752e+09
+# HELP src_insights_series_latest_value The value of a code insights series at its latest recording time.
+# TYPE src_insights_series_latest_value gauge
+src_insights_series_latest_value{capture="",repo="",series_id="s2"} 7
+src_insights_series_latest_value{capture="",repo="github.com/a/a",series_id="s1"} 3
+src_insights_series_latest_value{capture="1.21",repo="github.com/a/a",series_id="s2"} 1.5
+`
+	if err := testutil.CollectAndCompare(NewLatestValuesCollector(testPoints), strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 12, 5, 10, 30, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		var uploaded bytes.Buffer
+		uploads := uploadstoremocks.NewMockStore()
+		uploads.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+			return io.Copy(&uploaded, r)
+		})
+
+		key, err := Upload(ctx, fakeIterator(testPoints), uploads, FormatCSV, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "insights-export/2023-12-05T10-30-00Z.csv"; key != want {
+			t.Errorf("unexpected key: want=%q have=%q", want, key)
+		}
+		if have := uploads.UploadFunc.History()[0].Arg1; have != key {
+			t.Errorf("unexpected uploaded key: want=%q have=%q", key, have)
+		}
+
+		var want bytes.Buffer
+		writeAll(t, FormatCSV, &want)
+		if uploaded.String() != want.String() {
+			t.Errorf("unexpected upload:\nwant:\n%s\nhave:\n%s", want.String(), uploaded.String())
+		}
+	})
+
+	t.Run("upload error", func(t *testing.T) {
+		uploads := uploadstoremocks.NewMockStore()
+		uploads.UploadFunc.SetDefaultReturn(0, errors.New("bucket not found"))
+
+		if _, err := Upload(ctx, fakeIterator(testPoints), uploads, FormatParquet, now); err == nil || !strings.Contains(err.Error(), "bucket not found") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("iterator error", func(t *testing.T) {
+		uploads := uploadstoremocks.NewMockStore()
+		uploads.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+			return io.Copy(io.Discard, r)
+		})
+
+		_, err := Upload(ctx, failingIterator{}, uploads, FormatCSV, now)
+		if err == nil || !strings.Contains(err.Error(), "connection reset") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+type fakeIterator []store.ExportedSeriesPoint
+
+func (it fakeIterator) ForEachSeriesPoint(_ context.Context, fn func(store.ExportedSeriesPoint) error) error {
+	for _, p := range it {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type failingIterator struct{}
+
+func (failingIterator) ForEachSeriesPoint(context.Context, func(store.ExportedSeriesPoint) error) error {
+	return errors.New("connection reset")
+}
+
+func writeAll(t *testing.T, format Format, buf *bytes.Buffer) {
+	t.Helper()
+
+	w, err := NewWriter(format, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range testPoints {
+		if err := w.Write(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
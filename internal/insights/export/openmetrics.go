@@ -0,0 +1,68 @@
+package export
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+)
+
+var (
+	latestValueDesc = prometheus.NewDesc(
+		"src_insights_series_latest_value",
+		"The value of a code insights series at its latest recording time.",
+		[]string{"series_id", "repo", "capture"},
+		nil,
+	)
+	latestRecordingTimeDesc = prometheus.NewDesc(
+		"src_insights_series_latest_recording_timestamp_seconds",
+		"The latest recording time of a code insights series.",
+		[]string{"series_id"},
+		nil,
+	)
+)
+
+// LatestValuesCollector is a prometheus.Collector exposing the given latest points of code
+// insights series as gauges. The points are expected to be the result of
+// store.LatestSeriesPoints, so the collector is meant to be registered in a registry that only
+// lives for the duration of a scrape.
+//
+// The gauges don't carry the recording times as sample timestamps: series are only recorded
+// every so often, and Prometheus rejects samples older than its head block. The recording
+// times are exposed as a separate gauge instead.
+type LatestValuesCollector struct {
+	points []store.ExportedSeriesPoint
+}
+
+var _ prometheus.Collector = &LatestValuesCollector{}
+
+func NewLatestValuesCollector(points []store.ExportedSeriesPoint) *LatestValuesCollector {
+	return &LatestValuesCollector{points: points}
+}
+
+func (c *LatestValuesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- latestValueDesc
+	ch <- latestRecordingTimeDesc
+}
+
+func (c *LatestValuesCollector) Collect(ch chan<- prometheus.Metric) {
+	recorded := make(map[string]struct{})
+	for _, p := range c.points {
+		ch <- prometheus.MustNewConstMetric(
+			latestValueDesc,
+			prometheus.GaugeValue,
+			p.Value,
+			p.SeriesID, emptyStringIfNil(p.RepoName), emptyStringIfNil(p.Capture),
+		)
+
+		if _, ok := recorded[p.SeriesID]; ok {
+			continue
+		}
+		recorded[p.SeriesID] = struct{}{}
+		ch <- prometheus.MustNewConstMetric(
+			latestRecordingTimeDesc,
+			prometheus.GaugeValue,
+			float64(p.Time.Unix()),
+			p.SeriesID,
+		)
+	}
+}
@@ -0,0 +1,105 @@
+package export
+
+import (
+	"io"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// parquetRowGroupSize is the number of points buffered in memory before they are written to
+// the file as a row group.
+const parquetRowGroupSize = 64 * 1024
+
+var parquetSchema = arrow.NewSchema([]arrow.Field{
+	{Name: columns[0], Type: arrow.BinaryTypes.String},
+	{Name: columns[1], Type: arrow.BinaryTypes.String},
+	{Name: columns[2], Type: arrow.FixedWidthTypes.Timestamp_ms},
+	{Name: columns[3], Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: columns[4], Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: columns[5], Type: arrow.PrimitiveTypes.Float64},
+	{Name: columns[6], Type: arrow.BinaryTypes.String, Nullable: true},
+}, nil)
+
+type parquetWriter struct {
+	w    *pqarrow.FileWriter
+	b    *array.RecordBuilder
+	rows int
+}
+
+func newParquetWriter(w io.Writer) (_ *parquetWriter, err error) {
+	// The parquet writer panics if it fails to write the header of the file.
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("writing parquet header: %v", r)
+		}
+	}()
+
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Snappy),
+		parquet.WithMaxRowGroupLength(parquetRowGroupSize),
+	)
+	// The parquet writer closes its sink if it can, so we hide the Close method of w from it.
+	fw, err := pqarrow.NewFileWriter(parquetSchema, struct{ io.Writer }{w}, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	return &parquetWriter{
+		w: fw,
+		b: array.NewRecordBuilder(memory.DefaultAllocator, parquetSchema),
+	}, nil
+}
+
+func (w *parquetWriter) Write(p store.ExportedSeriesPoint) error {
+	w.b.Field(0).(*array.StringBuilder).Append(p.SeriesID)
+	w.b.Field(1).(*array.StringBuilder).Append(p.Query)
+	w.b.Field(2).(*array.TimestampBuilder).Append(arrow.Timestamp(p.Time.UnixMilli()))
+	if p.RepoID != nil {
+		w.b.Field(3).(*array.Int32Builder).Append(int32(*p.RepoID))
+	} else {
+		w.b.Field(3).AppendNull()
+	}
+	appendNullableString(w.b.Field(4).(*array.StringBuilder), p.RepoName)
+	w.b.Field(5).(*array.Float64Builder).Append(p.Value)
+	appendNullableString(w.b.Field(6).(*array.StringBuilder), p.Capture)
+
+	w.rows++
+	if w.rows < parquetRowGroupSize {
+		return nil
+	}
+	return w.flush()
+}
+
+// flush writes the buffered points as a row group.
+func (w *parquetWriter) flush() error {
+	if w.rows == 0 {
+		return nil
+	}
+	rec := w.b.NewRecord()
+	defer rec.Release()
+	w.rows = 0
+	return w.w.Write(rec)
+}
+
+func (w *parquetWriter) Close() error {
+	defer w.b.Release()
+	if err := w.flush(); err != nil {
+		return err
+	}
+	return w.w.Close()
+}
+
+func appendNullableString(b *array.StringBuilder, s *string) {
+	if s == nil {
+		b.AppendNull()
+		return
+	}
+	b.Append(*s)
+}
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// KeyPrefix is the prefix of the keys of the exports in the upload store.
+const KeyPrefix = "insights-export/"
+
+// SeriesPointsIterator iterates over all series points. It is implemented by *store.Store.
+type SeriesPointsIterator interface {
+	ForEachSeriesPoint(ctx context.Context, fn func(store.ExportedSeriesPoint) error) error
+}
+
+// Upload exports all series points in the given format to a new object of the upload store,
+// and returns its key. The points are streamed to the upload store as they are read from the
+// database.
+func Upload(ctx context.Context, points SeriesPointsIterator, uploads uploadstore.Store, format Format, now time.Time) (string, error) {
+	key := fmt.Sprintf("%s%s.%s", KeyPrefix, now.UTC().Format("2006-01-02T15-04-05Z"), format.Extension())
+
+	pr, pw := io.Pipe()
+	written := make(chan error, 1)
+	go func() {
+		err := write(ctx, points, format, pw)
+		// The upload ends when the pipe is closed, successfully or not.
+		pw.CloseWithError(err)
+		written <- err
+	}()
+
+	_, uploadErr := uploads.Upload(ctx, key, pr)
+	// Unblock the writer if the upload stopped reading early.
+	pr.CloseWithError(errors.New("upload ended"))
+	writeErr := <-written
+	if uploadErr != nil {
+		return "", errors.Wrap(uploadErr, "uploading series points")
+	}
+	if writeErr != nil {
+		return "", errors.Wrap(writeErr, "writing series points")
+	}
+	return key, nil
+}
+
+func write(ctx context.Context, points SeriesPointsIterator, format Format, w io.Writer) error {
+	fw, err := NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+	if err := points.ForEachSeriesPoint(ctx, fw.Write); err != nil {
+		return err
+	}
+	return fw.Close()
+}
@@ -220,6 +220,11 @@ func makeHistoricalSearchJobFunc(logger log.Logger, commitClient GitCommitClient
 	return func(ctx context.Context, bctx *buildSeriesContext) (err error, job *queryrunner.SearchJob, preempted []store.RecordSeriesPointArgs) {
 		logger.Debug("making search job")
 		rawQuery := bctx.series.Query
+		if bctx.series.GenerationMethod == types.PreciseReferences {
+			// The query of a precise references series is a SCIP symbol, not a search query. Its
+			// jobs only select the repository and revision to count the references in.
+			rawQuery = ""
+		}
 		containsRepo, err := querybuilder.ContainsField(rawQuery, query.FieldRepo)
 		if err != nil {
 			return err, nil, nil
@@ -0,0 +1,261 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AlertStore stores the alert rules of insight series and the history of fired alerts.
+type AlertStore struct {
+	*basestore.Store
+}
+
+// NewAlertStore returns a new AlertStore backed by the given Postgres db.
+func NewAlertStore(db edb.InsightsDB) *AlertStore {
+	return &AlertStore{Store: basestore.NewWithHandle(db.Handle())}
+}
+
+// With creates a new AlertStore with the given basestore.Shareable store as the underlying
+// basestore.Store.
+func (s *AlertStore) With(other basestore.ShareableStore) *AlertStore {
+	return &AlertStore{Store: s.Store.With(other)}
+}
+
+func (s *AlertStore) Transact(ctx context.Context) (*AlertStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &AlertStore{Store: txBase}, err
+}
+
+// ListAlertsArgs filters the alerts returned by ListAlerts. Zero values don't filter.
+type ListAlertsArgs struct {
+	ID        int
+	SeriesIDs []int
+	UserID    int32
+}
+
+// ListAlerts returns the alerts matching args, ordered by ID.
+func (s *AlertStore) ListAlerts(ctx context.Context, args ListAlertsArgs) ([]types.InsightSeriesAlert, error) {
+	preds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if args.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("id = %s", args.ID))
+	}
+	if len(args.SeriesIDs) > 0 {
+		preds = append(preds, sqlf.Sprintf("series_id = ANY(%s)", pq.Array(args.SeriesIDs)))
+	}
+	if args.UserID != 0 {
+		preds = append(preds, sqlf.Sprintf("user_id = %s", args.UserID))
+	}
+
+	alerts, err := scanAlerts(s.Query(ctx, sqlf.Sprintf(listAlertsSql, sqlf.Join(preds, "AND"))))
+	return alerts, errors.Wrap(err, "listing insight series alerts")
+}
+
+const listAlertsSql = `
+SELECT id, series_id, user_id, kind, threshold, direction, notify_email, slack_webhook_url, webhook_url, created_at
+FROM insight_series_alerts
+WHERE %s
+ORDER BY id
+`
+
+// CreateAlert stores a new alert and returns it with its ID and creation time set.
+func (s *AlertStore) CreateAlert(ctx context.Context, alert types.InsightSeriesAlert) (types.InsightSeriesAlert, error) {
+	created, _, err := basestore.NewFirstScanner(scanAlert)(s.Query(ctx, sqlf.Sprintf(createAlertSql,
+		alert.SeriesID,
+		alert.UserID,
+		alert.Kind,
+		alert.Threshold,
+		alert.Direction,
+		alert.NotifyEmail,
+		alert.SlackWebhookURL,
+		alert.WebhookURL,
+	)))
+	return created, errors.Wrap(err, "creating insight series alert")
+}
+
+const createAlertSql = `
+INSERT INTO insight_series_alerts (series_id, user_id, kind, threshold, direction, notify_email, slack_webhook_url, webhook_url)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING id, series_id, user_id, kind, threshold, direction, notify_email, slack_webhook_url, webhook_url, created_at
+`
+
+// DeleteAlert deletes an alert and its history.
+func (s *AlertStore) DeleteAlert(ctx context.Context, id int) error {
+	return s.Exec(ctx, sqlf.Sprintf("DELETE FROM insight_series_alerts WHERE id = %s", id))
+}
+
+// CreateAlertEvent records a fired alert.
+func (s *AlertStore) CreateAlertEvent(ctx context.Context, event types.InsightSeriesAlertEvent) (types.InsightSeriesAlertEvent, error) {
+	created, _, err := basestore.NewFirstScanner(scanAlertEvent)(s.Query(ctx, sqlf.Sprintf(createAlertEventSql,
+		event.AlertID,
+		event.RecordingTime,
+		event.Capture,
+		event.Value,
+		event.PreviousValue,
+		event.Message,
+		event.DeliveryError,
+	)))
+	return created, errors.Wrap(err, "creating insight series alert event")
+}
+
+const createAlertEventSql = `
+INSERT INTO insight_series_alert_events (alert_id, recording_time, capture, value, previous_value, message, delivery_error)
+VALUES (%s, %s, %s, %s, %s, %s, %s)
+RETURNING id, alert_id, recording_time, capture, value, previous_value, message, delivery_error, created_at
+`
+
+// ListAlertEvents returns the latest events of an alert, most recent first.
+func (s *AlertStore) ListAlertEvents(ctx context.Context, alertID int, limit int) ([]types.InsightSeriesAlertEvent, error) {
+	events, err := basestore.NewSliceScanner(scanAlertEvent)(s.Query(ctx, sqlf.Sprintf(listAlertEventsSql, alertID, limit)))
+	return events, errors.Wrap(err, "listing insight series alert events")
+}
+
+const listAlertEventsSql = `
+SELECT id, alert_id, recording_time, capture, value, previous_value, message, delivery_error, created_at
+FROM insight_series_alert_events
+WHERE alert_id = %s
+ORDER BY recording_time DESC, id DESC
+LIMIT %s
+`
+
+// HasAlertEventSince returns whether the alert already fired for the given capture on a
+// snapshot recorded after since.
+func (s *AlertStore) HasAlertEventSince(ctx context.Context, alertID int, capture *string, since time.Time) (bool, error) {
+	exists, _, err := basestore.ScanFirstBool(s.Query(ctx, sqlf.Sprintf(hasAlertEventSinceSql, alertID, capture, since)))
+	return exists, errors.Wrap(err, "checking insight series alert events")
+}
+
+const hasAlertEventSinceSql = `
+SELECT EXISTS (
+	SELECT 1 FROM insight_series_alert_events
+	WHERE alert_id = %s AND capture IS NOT DISTINCT FROM %s AND recording_time > %s
+)
+`
+
+func scanAlerts(rows basestore.Rows, queryErr error) ([]types.InsightSeriesAlert, error) {
+	return basestore.NewSliceScanner(scanAlert)(rows, queryErr)
+}
+
+func scanAlert(sc dbutil.Scanner) (a types.InsightSeriesAlert, err error) {
+	err = sc.Scan(
+		&a.ID,
+		&a.SeriesID,
+		&a.UserID,
+		&a.Kind,
+		&a.Threshold,
+		&a.Direction,
+		&a.NotifyEmail,
+		&a.SlackWebhookURL,
+		&a.WebhookURL,
+		&a.CreatedAt,
+	)
+	return a, err
+}
+
+func scanAlertEvent(sc dbutil.Scanner) (e types.InsightSeriesAlertEvent, err error) {
+	err = sc.Scan(
+		&e.ID,
+		&e.AlertID,
+		&e.RecordingTime,
+		&e.Capture,
+		&e.Value,
+		&e.PreviousValue,
+		&e.Message,
+		&e.DeliveryError,
+		&e.CreatedAt,
+	)
+	return e, err
+}
+
+// CaptureValues are the values of a series at a single recording time, summed over
+// repositories and keyed by capture group value. The values of series that aren't generated
+// from capture groups are keyed by the empty string.
+type CaptureValues map[string]float64
+
+// LatestSnapshotValues returns the values of the latest snapshot of a series, and the time the
+// snapshot was recorded at. The time is zero if the series has no snapshot.
+//
+// 🚨 SECURITY: the values only include the repositories the user in the context can see.
+func (s *Store) LatestSnapshotValues(ctx context.Context, seriesID string) (time.Time, CaptureValues, error) {
+	return s.captureValues(ctx, snapshotsTable, seriesID, time.Time{})
+}
+
+// PreviousRecordedValues returns the values of a series at its latest recording time before
+// the given time, and that recording time. The time is zero if the series has no recording
+// before the given time.
+//
+// 🚨 SECURITY: the values only include the repositories the user in the context can see.
+func (s *Store) PreviousRecordedValues(ctx context.Context, seriesID string, before time.Time) (time.Time, CaptureValues, error) {
+	return s.captureValues(ctx, recordingTable, seriesID, before)
+}
+
+func (s *Store) captureValues(ctx context.Context, table, seriesID string, before time.Time) (time.Time, CaptureValues, error) {
+	preds, err := s.exportPermissionPreds(ctx)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	if !before.IsZero() {
+		preds = append(preds, sqlf.Sprintf("sp.time < %s", before))
+	}
+
+	var recordingTime time.Time
+	values := CaptureValues{}
+	err = s.query(ctx, sqlf.Sprintf(captureValuesSql, quote(table), seriesID, sqlf.Join(preds, "AND"), sqlf.Join(preds, "AND")), func(sc scanner) error {
+		var capture string
+		var value float64
+		if err := sc.Scan(&recordingTime, &capture, &value); err != nil {
+			return err
+		}
+		values[capture] = value
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, nil, errors.Wrap(err, "fetching series values")
+	}
+	return recordingTime, values, nil
+}
+
+const captureValuesSql = `
+WITH sp AS (
+	SELECT * FROM %s sp WHERE sp.series_id = %s
+),
+latest AS (
+	SELECT MAX(sp.time) AS time FROM sp WHERE %s
+)
+SELECT sp.time, COALESCE(sp.capture, ''), SUM(sp.value)
+FROM sp JOIN latest ON latest.time = sp.time
+WHERE %s
+GROUP BY sp.time, COALESCE(sp.capture, '')
+`
+
+// RecordedCaptures returns the capture group values recorded for a series before the given
+// time, including archived points.
+func (s *Store) RecordedCaptures(ctx context.Context, seriesID string, before time.Time) (map[string]struct{}, error) {
+	captures := make(map[string]struct{})
+	err := s.query(ctx, sqlf.Sprintf(recordedCapturesSql, seriesID, before, seriesID, before), func(sc scanner) error {
+		var capture string
+		if err := sc.Scan(&capture); err != nil {
+			return err
+		}
+		captures[capture] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching recorded captures")
+	}
+	return captures, nil
+}
+
+const recordedCapturesSql = `
+SELECT DISTINCT capture FROM series_points WHERE series_id = %s AND time < %s AND capture IS NOT NULL
+UNION
+SELECT DISTINCT capture FROM archived_series_points WHERE series_id = %s AND time < %s AND capture IS NOT NULL
+`
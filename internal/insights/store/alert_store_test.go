@@ -0,0 +1,194 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestAlertStore(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	insightsDB := edb.NewInsightsDB(dbtest.NewInsightsDB(logger, t), logger)
+
+	series := setupSeries(ctx, NewInsightStore(insightsDB), t)
+	alertStore := NewAlertStore(insightsDB)
+
+	alert, err := alertStore.CreateAlert(ctx, types.InsightSeriesAlert{
+		SeriesID:   series.ID,
+		UserID:     7,
+		Kind:       types.ThresholdAlert,
+		Threshold:  10,
+		Direction:  pointers.Ptr(types.AlertAbove),
+		WebhookURL: pointers.Ptr("https://example.com/hook"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alert.ID == 0 || alert.CreatedAt.IsZero() {
+		t.Fatalf("expected created alert to have an ID and creation time, got %+v", alert)
+	}
+
+	t.Run("list alerts", func(t *testing.T) {
+		for _, args := range []ListAlertsArgs{{}, {ID: alert.ID}, {SeriesIDs: []int{series.ID}}, {UserID: 7}} {
+			have, err := alertStore.ListAlerts(ctx, args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff([]types.InsightSeriesAlert{alert}, have); diff != "" {
+				t.Errorf("unexpected alerts for %+v (-want +have):\n%s", args, diff)
+			}
+		}
+
+		have, err := alertStore.ListAlerts(ctx, ListAlertsArgs{UserID: 8})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 0 {
+			t.Errorf("expected no alerts of another user, got %d", len(have))
+		}
+	})
+
+	t.Run("events", func(t *testing.T) {
+		recordingTime := time.Date(2023, 12, 5, 0, 0, 0, 0, time.UTC)
+		if _, err := alertStore.CreateAlertEvent(ctx, types.InsightSeriesAlertEvent{
+			AlertID:       alert.ID,
+			RecordingTime: recordingTime,
+			Value:         12,
+			PreviousValue: pointers.Ptr(8.0),
+			Message:       "crossed",
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		events, err := alertStore.ListAlertEvents(ctx, alert.ID, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(events) != 1 || events[0].Value != 12 || events[0].Message != "crossed" {
+			t.Errorf("unexpected events: %+v", events)
+		}
+
+		for since, want := range map[time.Time]bool{
+			recordingTime.Add(-time.Hour): true,
+			recordingTime:                 false,
+		} {
+			fired, err := alertStore.HasAlertEventSince(ctx, alert.ID, nil, since)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fired != want {
+				t.Errorf("unexpected event since %s: want=%v have=%v", since, want, fired)
+			}
+		}
+		if fired, err := alertStore.HasAlertEventSince(ctx, alert.ID, pointers.Ptr("1.21"), time.Time{}); err != nil || fired {
+			t.Errorf("expected no event for another capture, got %v (err=%v)", fired, err)
+		}
+	})
+
+	t.Run("delete alert", func(t *testing.T) {
+		if err := alertStore.DeleteAlert(ctx, alert.ID); err != nil {
+			t.Fatal(err)
+		}
+		have, err := alertStore.ListAlerts(ctx, ListAlertsArgs{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 0 {
+			t.Errorf("expected no alerts, got %d", len(have))
+		}
+	})
+}
+
+func TestCaptureValues(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	insightsDB := edb.NewInsightsDB(dbtest.NewInsightsDB(logger, t), logger)
+
+	permissionStore := NewMockInsightPermissionStore()
+	permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn(nil, nil)
+	seriesStore := New(insightsDB, permissionStore)
+	series := setupSeries(ctx, NewInsightStore(insightsDB), t)
+
+	older := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	previous := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC)
+
+	point := func(t time.Time, repoID api.RepoID, value float64, capture string, mode PersistMode) RecordSeriesPointArgs {
+		repoName := "github.com/a/" + string(rune('a'+repoID))
+		return RecordSeriesPointArgs{
+			SeriesID:    series.SeriesID,
+			Point:       SeriesPoint{Time: t, Value: value, Capture: &capture},
+			RepoID:      &repoID,
+			RepoName:    &repoName,
+			PersistMode: mode,
+		}
+	}
+	if err := seriesStore.RecordSeriesPoints(ctx, []RecordSeriesPointArgs{
+		point(older, 1, 1, "1.19", RecordMode),
+		point(previous, 1, 2, "1.20", RecordMode),
+		point(previous, 2, 3, "1.20", RecordMode),
+		point(snapshot, 1, 4, "1.20", SnapshotMode),
+		point(snapshot, 2, 5, "1.21", SnapshotMode),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("snapshot", func(t *testing.T) {
+		at, values, err := seriesStore.LatestSnapshotValues(ctx, series.SeriesID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !at.Equal(snapshot) {
+			t.Errorf("unexpected snapshot time: %s", at)
+		}
+		if diff := cmp.Diff(CaptureValues{"1.20": 4, "1.21": 5}, values); diff != "" {
+			t.Errorf("unexpected values (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("previous recording", func(t *testing.T) {
+		at, values, err := seriesStore.PreviousRecordedValues(ctx, series.SeriesID, snapshot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !at.Equal(previous) {
+			t.Errorf("unexpected recording time: %s", at)
+		}
+		if diff := cmp.Diff(CaptureValues{"1.20": 5}, values); diff != "" {
+			t.Errorf("unexpected values (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("respects repo permissions", func(t *testing.T) {
+		permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn([]api.RepoID{2}, nil)
+		defer permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn(nil, nil)
+
+		_, values, err := seriesStore.PreviousRecordedValues(ctx, series.SeriesID, snapshot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(CaptureValues{"1.20": 2}, values); diff != "" {
+			t.Errorf("unexpected values (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("recorded captures", func(t *testing.T) {
+		captures, err := seriesStore.RecordedCaptures(ctx, series.SeriesID, snapshot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(map[string]struct{}{"1.19": {}, "1.20": {}}, captures); diff != "" {
+			t.Errorf("unexpected captures (-want +have):\n%s", diff)
+		}
+	})
+}
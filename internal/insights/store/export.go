@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ExportedSeriesPoint is a series point with the metadata needed to export it outside of
+// Sourcegraph. Unlike SeriesPointForExport, it isn't tied to an insight view.
+type ExportedSeriesPoint struct {
+	SeriesID string
+	Query    string
+	Time     time.Time
+	RepoID   *int
+	RepoName *string
+	Value    float64
+	Capture  *string
+}
+
+// LatestSeriesPoints returns the points recorded at the latest recording time of every series
+// that isn't deleted, ordered by series, repository and capture.
+func (s *Store) LatestSeriesPoints(ctx context.Context) (_ []ExportedSeriesPoint, err error) {
+	preds, err := s.exportPermissionPreds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []ExportedSeriesPoint
+	err = s.query(ctx, sqlf.Sprintf(latestSeriesPointsSql, sqlf.Join(preds, "AND")), func(sc scanner) error {
+		var p ExportedSeriesPoint
+		if err := scanExportedSeriesPoint(&p, sc); err != nil {
+			return err
+		}
+		points = append(points, p)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching latest series points")
+	}
+	return points, nil
+}
+
+const latestSeriesPointsSql = `
+WITH sp AS (
+	SELECT * FROM series_points
+	UNION ALL
+	SELECT * FROM series_points_snapshots
+),
+latest AS (
+	SELECT series_id, MAX(time) AS time FROM sp GROUP BY series_id
+)
+SELECT DISTINCT ON (sp.series_id, rn.name, sp.capture)
+	i.series_id, i.query, sp.time, sp.repo_id, rn.name, sp.value, sp.capture
+FROM sp
+	JOIN latest ON latest.series_id = sp.series_id AND latest.time = sp.time
+	JOIN insight_series i ON i.series_id = sp.series_id
+	LEFT OUTER JOIN repo_names rn ON rn.id = sp.repo_name_id
+WHERE i.deleted_at IS NULL AND %s
+ORDER BY sp.series_id, rn.name, sp.capture
+`
+
+// ForEachSeriesPoint calls fn with every point, archived or not, of every series that isn't
+// deleted. The points are ordered by series and time, and are streamed from the database so
+// that the full history never has to be held in memory.
+func (s *Store) ForEachSeriesPoint(ctx context.Context, fn func(ExportedSeriesPoint) error) (err error) {
+	preds, err := s.exportPermissionPreds(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.query(ctx, sqlf.Sprintf(allSeriesPointsSql, sqlf.Join(preds, "AND")), func(sc scanner) error {
+		var p ExportedSeriesPoint
+		if err := scanExportedSeriesPoint(&p, sc); err != nil {
+			return err
+		}
+		return fn(p)
+	})
+	return errors.Wrap(err, "fetching series points")
+}
+
+const allSeriesPointsSql = `
+WITH sp AS (
+	SELECT * FROM archived_series_points
+	UNION ALL
+	SELECT * FROM series_points
+	UNION ALL
+	SELECT * FROM series_points_snapshots
+)
+SELECT i.series_id, i.query, sp.time, sp.repo_id, rn.name, sp.value, sp.capture
+FROM sp
+	JOIN insight_series i ON i.series_id = sp.series_id
+	LEFT OUTER JOIN repo_names rn ON rn.id = sp.repo_name_id
+WHERE i.deleted_at IS NULL AND %s
+ORDER BY sp.series_id, sp.time, rn.name, sp.capture
+`
+
+// exportPermissionPreds returns the predicates excluding the points of the repositories the
+// current user can't see.
+func (s *Store) exportPermissionPreds(ctx context.Context) ([]*sqlf.Query, error) {
+	// 🚨 SECURITY: exported points aren't filtered by insight visibility, so callers must only
+	// export for site admins or internal actors. We still enforce repo permissions here, as we
+	// store repository data at this level.
+	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUnauthorizedRepoIDs")
+	}
+	if len(denylist) == 0 {
+		return []*sqlf.Query{sqlf.Sprintf("true")}, nil
+	}
+	excludedRepoIDs := make([]*sqlf.Query, 0, len(denylist))
+	for _, repoID := range denylist {
+		excludedRepoIDs = append(excludedRepoIDs, sqlf.Sprintf("%d", repoID))
+	}
+	return []*sqlf.Query{sqlf.Sprintf("(sp.repo_id IS NULL OR sp.repo_id NOT IN (%s))", sqlf.Join(excludedRepoIDs, ","))}, nil
+}
+
+func scanExportedSeriesPoint(p *ExportedSeriesPoint, sc scanner) error {
+	return sc.Scan(
+		&p.SeriesID,
+		&p.Query,
+		&p.Time,
+		&p.RepoID,
+		&p.RepoName,
+		&p.Value,
+		&p.Capture,
+	)
+}
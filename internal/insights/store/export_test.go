@@ -0,0 +1,124 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	edb "github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/lib/pointers"
+)
+
+func TestExportSeriesPoints(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	insightsDB := edb.NewInsightsDB(dbtest.NewInsightsDB(logger, t), logger)
+
+	permissionStore := NewMockInsightPermissionStore()
+	permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn(nil, nil)
+
+	insightStore := NewInsightStore(insightsDB)
+	seriesStore := New(insightsDB, permissionStore)
+	series := setupSeries(ctx, insightStore, t)
+
+	older := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	latest := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	point := func(t time.Time, repoID api.RepoID, repoName string, value float64, capture *string) RecordSeriesPointArgs {
+		return RecordSeriesPointArgs{
+			SeriesID:    series.SeriesID,
+			Point:       SeriesPoint{Time: t, Value: value, Capture: capture},
+			RepoID:      &repoID,
+			RepoName:    &repoName,
+			PersistMode: RecordMode,
+		}
+	}
+	if err := seriesStore.RecordSeriesPoints(ctx, []RecordSeriesPointArgs{
+		point(older, 1, "github.com/a/a", 1, pointers.Ptr("v1")),
+		point(older, 2, "github.com/b/b", 2, pointers.Ptr("v1")),
+		point(latest, 1, "github.com/a/a", 3, pointers.Ptr("v1")),
+		point(latest, 1, "github.com/a/a", 4, pointers.Ptr("v2")),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	exported := func(t time.Time, repoID int, repoName string, value float64, capture string) ExportedSeriesPoint {
+		return ExportedSeriesPoint{
+			SeriesID: series.SeriesID,
+			Query:    series.Query,
+			Time:     t,
+			RepoID:   &repoID,
+			RepoName: &repoName,
+			Value:    value,
+			Capture:  &capture,
+		}
+	}
+	normalize := func(points []ExportedSeriesPoint) []ExportedSeriesPoint {
+		for i := range points {
+			points[i].Time = points[i].Time.UTC()
+		}
+		return points
+	}
+
+	t.Run("latest points", func(t *testing.T) {
+		have, err := seriesStore.LatestSeriesPoints(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []ExportedSeriesPoint{
+			exported(latest, 1, "github.com/a/a", 3, "v1"),
+			exported(latest, 1, "github.com/a/a", 4, "v2"),
+		}
+		if diff := cmp.Diff(want, normalize(have)); diff != "" {
+			t.Errorf("unexpected points (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("all points", func(t *testing.T) {
+		var have []ExportedSeriesPoint
+		if err := seriesStore.ForEachSeriesPoint(ctx, func(p ExportedSeriesPoint) error {
+			have = append(have, p)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		want := []ExportedSeriesPoint{
+			exported(older, 1, "github.com/a/a", 1, "v1"),
+			exported(older, 2, "github.com/b/b", 2, "v1"),
+			exported(latest, 1, "github.com/a/a", 3, "v1"),
+			exported(latest, 1, "github.com/a/a", 4, "v2"),
+		}
+		if diff := cmp.Diff(want, normalize(have)); diff != "" {
+			t.Errorf("unexpected points (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("respects repo permissions", func(t *testing.T) {
+		permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn([]api.RepoID{1}, nil)
+		defer permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn(nil, nil)
+
+		have, err := seriesStore.LatestSeriesPoints(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(have) != 0 {
+			t.Errorf("expected no latest points, got %d", len(have))
+		}
+
+		var count int
+		if err := seriesStore.ForEachSeriesPoint(ctx, func(p ExportedSeriesPoint) error {
+			count++
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Errorf("expected 1 point, got %d", count)
+		}
+	})
+}
@@ -131,8 +131,62 @@ const (
 	SearchCompute  GenerationMethod = "search-compute"
 	LanguageStats  GenerationMethod = "language-stats"
 	MappingCompute GenerationMethod = "mapping-compute"
+	// PreciseReferences series count the precise code intelligence references to the SCIP
+	// symbol stored as the query of the series.
+	PreciseReferences GenerationMethod = "precise-references"
 )
 
+// InsightSeriesAlert is an alert rule of an insight series. Alerts are evaluated every time a
+// snapshot of the series is recorded.
+type InsightSeriesAlert struct {
+	ID              int
+	SeriesID        int // the ID of the insight_series row, not its unique series ID
+	UserID          int32
+	Kind            AlertKind
+	Threshold       float64
+	Direction       *AlertDirection
+	NotifyEmail     bool
+	SlackWebhookURL *string
+	WebhookURL      *string
+	CreatedAt       time.Time
+}
+
+// AlertKind is the condition an insight series alert fires on.
+type AlertKind string
+
+const (
+	// ThresholdAlert fires when a value of the series crosses the threshold of the alert.
+	ThresholdAlert AlertKind = "threshold"
+	// PercentageChangeAlert fires when a value of the series changed by at least the threshold
+	// percentage since the previous recording.
+	PercentageChangeAlert AlertKind = "percentage_change"
+	// NewCaptureValueAlert fires when a capture group series records a value that was never
+	// recorded before.
+	NewCaptureValueAlert AlertKind = "new_capture_value"
+)
+
+// AlertDirection is the direction in which a value has to cross the threshold of a
+// ThresholdAlert.
+type AlertDirection string
+
+const (
+	AlertAbove AlertDirection = "above"
+	AlertBelow AlertDirection = "below"
+)
+
+// InsightSeriesAlertEvent is a fired insight series alert.
+type InsightSeriesAlertEvent struct {
+	ID            int
+	AlertID       int
+	RecordingTime time.Time
+	Capture       *string
+	Value         float64
+	PreviousValue *float64
+	Message       string
+	DeliveryError *string
+	CreatedAt     time.Time
+}
+
 type Dashboard struct {
 	ID           int
 	Title        string
@@ -24,6 +24,7 @@ type fakeGitServer struct {
 	gitserver.Client
 	files        []string
 	fileContents map[string]string
+	commits      []gitserver.CommitLog
 }
 
 func (f fakeGitServer) LsFiles(ctx context.Context, repo api.RepoName, commit api.CommitID, pathspecs ...gitdomain.Pathspec) ([]string, error) {
@@ -34,6 +35,10 @@ func (f fakeGitServer) ResolveRevision(ctx context.Context, repo api.RepoName, s
 	return api.CommitID(""), nil
 }
 
+func (f fakeGitServer) CommitLog(ctx context.Context, repo api.RepoName, after time.Time) ([]gitserver.CommitLog, error) {
+	return f.commits, nil
+}
+
 func (f fakeGitServer) ReadFile(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) ([]byte, error) {
 	if f.fileContents == nil {
 		return nil, os.ErrNotExist
@@ -187,8 +187,12 @@ func (h *handler) Handle(ctx context.Context, lgr log.Logger, record *Job) error
 	switch record.ConfigName {
 	case types.SignalRecentContributors:
 		delegate = handleRecentContributors
+	case types.SignalRecentReviewers:
+		delegate = handleRecentReviewers
 	case types.Analytics:
 		delegate = handleAnalytics
+	case types.OwnershipDrift:
+		delegate = handleOwnershipDrift
 	default:
 		return errcode.MakeNonRetryable(errors.New("unsupported own index job type"))
 	}
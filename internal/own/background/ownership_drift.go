@@ -0,0 +1,351 @@
+package background
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	logger "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/own"
+	owntypes "github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func handleOwnershipDrift(ctx context.Context, lgr logger.Logger, repoId api.RepoID, db database.DB, subRepoPermsCache *rcache.Cache) error {
+	// 🚨 SECURITY: we use the internal actor because the background indexer is not associated with any user, and needs
+	// to see all repos and files
+	internalCtx := actor.WithInternalActor(ctx)
+
+	indexer := newOwnershipDriftIndexer(gitserver.NewClient("own.ownershipdrift"), db, lgr, subRepoPermsCache)
+	return indexer.indexRepo(internalCtx, repoId, authz.DefaultSubRepoPermsChecker)
+}
+
+// defaultStaleOwnerMonths is the number of months after which a declared owner
+// who has not contributed to a file, while others have, is flagged as stale if
+// not configured otherwise in the site configuration.
+const defaultStaleOwnerMonths = 6
+
+func staleOwnerMonths() int {
+	if months := conf.Get().SiteConfiguration.OwnDriftStaleOwnerMonths; months > 0 {
+		return months
+	}
+	return defaultStaleOwnerMonths
+}
+
+// ownershipDriftIndexer flags the declared owners of the files of a repository,
+// either in CODEOWNERS or assigned in Sourcegraph, who are unlikely to still own
+// them. That is owners who are deactivated users or teams without any active
+// members, and owners who have not contributed to a file recently while other
+// authors have, so that the inferred ownership differs from the declared one.
+type ownershipDriftIndexer struct {
+	client            gitserver.Client
+	db                database.DB
+	logger            logger.Logger
+	subRepoPermsCache rcache.Cache
+	clock             func() time.Time
+}
+
+func newOwnershipDriftIndexer(client gitserver.Client, db database.DB, lgr logger.Logger, subRepoPermsCache *rcache.Cache) *ownershipDriftIndexer {
+	return &ownershipDriftIndexer{client: client, db: db, logger: lgr, subRepoPermsCache: *subRepoPermsCache, clock: time.Now}
+}
+
+var driftFindingsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "own_ownership_drift_findings_total",
+}, []string{"kind"})
+
+func (r *ownershipDriftIndexer) indexRepo(ctx context.Context, repoId api.RepoID, checker authz.SubRepoPermissionChecker) error {
+	// If the repo has sub-repo perms enabled, skip indexing.
+	isSubRepoPermsRepo, err := isSubRepoPermsRepo(ctx, repoId, r.subRepoPermsCache, checker)
+	if err != nil {
+		return errcode.MakeNonRetryable(err)
+	} else if isSubRepoPermsRepo {
+		r.logger.Debug("skipping own ownership drift due to the repo having subrepo perms enabled", logger.Int32("repoID", int32(repoId)))
+		return nil
+	}
+
+	repo, err := r.db.Repos().Get(ctx, repoId)
+	if err != nil {
+		return errors.Wrap(err, "repoStore.Get")
+	}
+	files, err := r.client.LsFiles(ctx, repo.Name, "HEAD")
+	if err != nil {
+		return errors.Wrap(err, "ls-files")
+	}
+	commitID, err := r.client.ResolveRevision(ctx, repo.Name, "HEAD", gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+	if err != nil {
+		return errcode.MakeNonRetryable(errors.Wrapf(err, "cannot resolve HEAD"))
+	}
+
+	ownService := own.NewService(r.client, r.db)
+	ruleset, err := ownService.RulesetForRepo(ctx, repo.Name, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "RulesetForRepo")
+	}
+	assignedOwners, err := ownService.AssignedOwnership(ctx, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "AssignedOwnership")
+	}
+	assignedTeams, err := ownService.AssignedTeams(ctx, repo.ID, commitID)
+	if err != nil {
+		return errors.Wrap(err, "AssignedTeams")
+	}
+
+	now := r.clock()
+	commitLog, err := r.client.CommitLog(ctx, repo.Name, now.AddDate(0, -staleOwnerMonths(), 0))
+	if err != nil {
+		return errors.Wrap(err, "CommitLog")
+	}
+	recentAuthors := make(map[string]map[string]bool)
+	for _, commit := range commitLog {
+		email := strings.ToLower(commit.AuthorEmail)
+		for _, f := range commit.ChangedFiles {
+			if recentAuthors[f] == nil {
+				recentAuthors[f] = make(map[string]bool)
+			}
+			recentAuthors[f][email] = true
+		}
+	}
+
+	resolver := newDeclaredOwnerResolver(r.db)
+	var findings []database.OwnDriftFinding
+	for _, f := range files {
+		var declared []declaredOwner
+		if ruleset != nil {
+			for _, o := range ruleset.Match(f).GetOwner() {
+				declared = append(declared, declaredOwner{source: owntypes.DriftSourceCodeowners, handle: o.GetHandle(), email: o.GetEmail()})
+			}
+		}
+		for _, o := range assignedOwners.Match(f) {
+			declared = append(declared, declaredOwner{source: owntypes.DriftSourceAssigned, userID: o.OwnerUserID})
+		}
+		for _, t := range assignedTeams.Match(f) {
+			declared = append(declared, declaredOwner{source: owntypes.DriftSourceAssigned, teamID: t.OwnerTeamID})
+		}
+		for _, d := range declared {
+			o, err := resolver.resolve(ctx, d)
+			if err != nil {
+				return errors.Wrapf(err, "resolving owner %v", d)
+			}
+			var kind string
+			switch {
+			case o.deactivated:
+				kind = owntypes.DriftKindDeactivatedOwner
+			case o.emptyTeam:
+				kind = owntypes.DriftKindEmptyTeam
+			case o.isStaleFor(recentAuthors[f]):
+				kind = owntypes.DriftKindStaleOwner
+			default:
+				continue
+			}
+			findings = append(findings, database.OwnDriftFinding{
+				RepoID:   repoId,
+				FilePath: f,
+				Kind:     kind,
+				Owner:    o.name,
+				Source:   d.source,
+			})
+		}
+	}
+
+	if err := r.db.OwnDriftFindings().ReplaceFindings(ctx, repoId, findings, now); err != nil {
+		return errors.Wrap(err, "ReplaceFindings")
+	}
+	r.logger.Info("ownership drift findings inserted", logger.Int("count", len(findings)), logger.Int("repo_id", int(repoId)))
+	for _, f := range findings {
+		driftFindingsCounter.WithLabelValues(f.Kind).Inc()
+	}
+	return nil
+}
+
+// declaredOwner is an owner of a file as declared either in CODEOWNERS, by
+// handle or email, or assigned in Sourcegraph, by user or team ID.
+type declaredOwner struct {
+	source string
+	handle string
+	email  string
+	userID int32
+	teamID int32
+}
+
+// resolvedOwner is what is known about a declared owner.
+type resolvedOwner struct {
+	// name is how the owner is shown in findings.
+	name        string
+	deactivated bool
+	emptyTeam   bool
+	// emails are the emails an individual owner authors commits with. It is
+	// empty for teams and owners that could not be resolved, which are never
+	// flagged as stale.
+	emails []string
+}
+
+// isStaleFor returns true if none of the emails of the owner are among the
+// given recent authors of a file, while there are other recent authors.
+func (o resolvedOwner) isStaleFor(recentAuthors map[string]bool) bool {
+	if len(o.emails) == 0 || len(recentAuthors) == 0 {
+		return false
+	}
+	for _, email := range o.emails {
+		if recentAuthors[strings.ToLower(email)] {
+			return false
+		}
+	}
+	return true
+}
+
+// declaredOwnerResolver resolves declared owners to users and teams, including
+// deactivated users which the regular user lookups skip. Resolved owners are
+// cached, as the same owners are usually declared for many files.
+type declaredOwnerResolver struct {
+	store *basestore.Store
+	cache map[declaredOwner]resolvedOwner
+}
+
+func newDeclaredOwnerResolver(db database.DB) *declaredOwnerResolver {
+	return &declaredOwnerResolver{
+		store: basestore.NewWithHandle(db.Handle()),
+		cache: make(map[declaredOwner]resolvedOwner),
+	}
+}
+
+func (r *declaredOwnerResolver) resolve(ctx context.Context, d declaredOwner) (resolvedOwner, error) {
+	// The source does not matter for resolution.
+	key := d
+	key.source = ""
+	if o, ok := r.cache[key]; ok {
+		return o, nil
+	}
+	o, err := r.resolveUncached(ctx, d)
+	if err != nil {
+		return resolvedOwner{}, err
+	}
+	r.cache[key] = o
+	return o, nil
+}
+
+func (r *declaredOwnerResolver) resolveUncached(ctx context.Context, d declaredOwner) (resolvedOwner, error) {
+	switch {
+	case d.userID != 0:
+		o, _, err := r.user(ctx, sqlf.Sprintf("u.id = %s", d.userID))
+		return o, err
+	case d.teamID != 0:
+		o, _, err := r.team(ctx, sqlf.Sprintf("t.id = %s", d.teamID))
+		return o, err
+	case d.handle != "":
+		// Handles refer to users first, and to teams otherwise, as in the rest
+		// of code ownership.
+		o, ok, err := r.user(ctx, sqlf.Sprintf("u.username = %s", d.handle))
+		if err != nil || ok {
+			return o, err
+		}
+		o, ok, err = r.team(ctx, sqlf.Sprintf("t.name = %s", d.handle))
+		if err != nil || ok {
+			return o, err
+		}
+		return resolvedOwner{name: "@" + d.handle}, nil
+	case d.email != "":
+		o, ok, err := r.user(ctx, sqlf.Sprintf("u.id IN (SELECT user_id FROM user_emails WHERE email = %s AND verified_at IS NOT NULL)", d.email))
+		if err != nil {
+			return resolvedOwner{}, err
+		}
+		if ok {
+			o.name = d.email
+			o.emails = appendMissing(o.emails, d.email)
+			return o, nil
+		}
+		// Even without a user, commits are authored with the declared email.
+		return resolvedOwner{name: d.email, emails: []string{d.email}}, nil
+	}
+	return resolvedOwner{}, nil
+}
+
+const declaredOwnerUserFmtstr = `
+	SELECT
+		u.username,
+		u.deleted_at IS NOT NULL,
+		ARRAY(SELECT e.email FROM user_emails e WHERE e.user_id = u.id AND e.verified_at IS NOT NULL)
+	FROM users u
+	WHERE %s
+	-- Prefer active users if an username has been reused.
+	ORDER BY u.deleted_at IS NOT NULL, u.id
+	LIMIT 1
+`
+
+func (r *declaredOwnerResolver) user(ctx context.Context, cond *sqlf.Query) (resolvedOwner, bool, error) {
+	var username string
+	var o resolvedOwner
+	err := r.store.QueryRow(ctx, sqlf.Sprintf(declaredOwnerUserFmtstr, cond)).Scan(&username, &o.deactivated, pq.Array(&o.emails))
+	if errors.Is(err, sql.ErrNoRows) {
+		return resolvedOwner{}, false, nil
+	}
+	if err != nil {
+		return resolvedOwner{}, false, err
+	}
+	o.name = "@" + username
+	return o, true, nil
+}
+
+const declaredOwnerTeamFmtstr = `
+	WITH RECURSIVE team AS (
+		SELECT t.id, t.name
+		FROM teams t
+		WHERE %s
+		LIMIT 1
+	), descendants AS (
+		SELECT id FROM team
+		UNION
+		SELECT t.id
+		FROM teams t
+		JOIN descendants d ON t.parent_team_id = d.id
+	)
+	SELECT
+		team.name,
+		NOT EXISTS (
+			SELECT 1
+			FROM team_members tm
+			JOIN users u ON u.id = tm.user_id
+			WHERE tm.team_id IN (SELECT id FROM descendants)
+			AND u.deleted_at IS NULL
+		)
+	FROM team
+`
+
+func (r *declaredOwnerResolver) team(ctx context.Context, cond *sqlf.Query) (resolvedOwner, bool, error) {
+	var name string
+	var o resolvedOwner
+	err := r.store.QueryRow(ctx, sqlf.Sprintf(declaredOwnerTeamFmtstr, cond)).Scan(&name, &o.emptyTeam)
+	if errors.Is(err, sql.ErrNoRows) {
+		return resolvedOwner{}, false, nil
+	}
+	if err != nil {
+		return resolvedOwner{}, false, err
+	}
+	o.name = "@" + name
+	return o, true, nil
+}
+
+func appendMissing(emails []string, email string) []string {
+	for _, e := range emails {
+		if strings.EqualFold(e, email) {
+			return emails
+		}
+	}
+	return append(emails, email)
+}
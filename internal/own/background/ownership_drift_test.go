@@ -0,0 +1,102 @@
+package background
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	owntypes "github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestOwnershipDriftIndexer(t *testing.T) {
+	rcache.SetupForTest(t)
+	obsCtx := observation.TestContextTB(t)
+	logger := obsCtx.Logger
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	var repoID api.RepoID = 1
+	require.NoError(t, db.Repos().Create(ctx, &types.Repo{Name: "repo", ID: repoID}))
+	newUser := func(username string) *types.User {
+		user, err := db.Users().Create(ctx, database.NewUser{Username: username, Email: username + "@example.com", EmailIsVerified: true})
+		require.NoError(t, err)
+		return user
+	}
+	alice := newUser("alice")
+	bob := newUser("bob")
+	carol := newUser("carol")
+	require.NoError(t, db.Users().Delete(ctx, bob.ID))
+	newTeam := func(name string, parentID int32) *types.Team {
+		team, err := db.Teams().CreateTeam(ctx, &types.Team{Name: name, ParentTeamID: parentID})
+		require.NoError(t, err)
+		return team
+	}
+	newTeam("ghosts", 0)
+	formerTeam := newTeam("former", 0)
+	require.NoError(t, db.Teams().CreateTeamMember(ctx, &types.TeamMember{TeamID: formerTeam.ID, UserID: bob.ID}))
+	parentTeam := newTeam("parent", 0)
+	childTeam := newTeam("child", parentTeam.ID)
+	require.NoError(t, db.Teams().CreateTeamMember(ctx, &types.TeamMember{TeamID: childTeam.ID, UserID: carol.ID}))
+	require.NoError(t, db.AssignedOwners().Insert(ctx, bob.ID, repoID, "assigned.go", alice.ID))
+
+	now := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	client := fakeGitServer{
+		files: []string{
+			"dir/touched-by-others.go",
+			"dir/touched-by-owner.go",
+			"dir/untouched.go",
+			"old/file.go",
+			"ghost/file.go",
+			"former/file.go",
+			"parent/file.go",
+			"mail/file.go",
+			"assigned.go",
+		},
+		fileContents: map[string]string{
+			"CODEOWNERS": `/dir/ @alice
+/old/ @bob
+/ghost/ @ghosts
+/former/ @former
+/parent/ @parent
+/mail/ dave@example.com
+`,
+		},
+		commits: []gitserver.CommitLog{
+			{AuthorEmail: "carol@example.com", Timestamp: now.AddDate(0, -1, 0), ChangedFiles: []string{"dir/touched-by-others.go", "dir/touched-by-owner.go", "mail/file.go"}},
+			{AuthorEmail: "Alice@Example.com", Timestamp: now.AddDate(0, -2, 0), ChangedFiles: []string{"dir/touched-by-owner.go"}},
+		},
+	}
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(false, nil)
+	indexer := newOwnershipDriftIndexer(client, db, logger, rcache.New("test_own_signal"))
+	indexer.clock = func() time.Time { return now }
+	require.NoError(t, indexer.indexRepo(ctx, repoID, checker))
+
+	findings, _, err := db.OwnDriftFindings().ListFindings(ctx, database.ListOwnDriftFindingsOpts{RepoID: repoID})
+	require.NoError(t, err)
+	var got []database.OwnDriftFinding
+	for _, f := range findings {
+		assert.True(t, f.ComputedAt.Equal(now))
+		got = append(got, database.OwnDriftFinding{RepoID: f.RepoID, FilePath: f.FilePath, Kind: f.Kind, Owner: f.Owner, Source: f.Source})
+	}
+	assert.ElementsMatch(t, []database.OwnDriftFinding{
+		{RepoID: repoID, FilePath: "dir/touched-by-others.go", Kind: owntypes.DriftKindStaleOwner, Owner: "@alice", Source: owntypes.DriftSourceCodeowners},
+		{RepoID: repoID, FilePath: "old/file.go", Kind: owntypes.DriftKindDeactivatedOwner, Owner: "@bob", Source: owntypes.DriftSourceCodeowners},
+		{RepoID: repoID, FilePath: "ghost/file.go", Kind: owntypes.DriftKindEmptyTeam, Owner: "@ghosts", Source: owntypes.DriftSourceCodeowners},
+		{RepoID: repoID, FilePath: "former/file.go", Kind: owntypes.DriftKindEmptyTeam, Owner: "@former", Source: owntypes.DriftSourceCodeowners},
+		{RepoID: repoID, FilePath: "mail/file.go", Kind: owntypes.DriftKindStaleOwner, Owner: "dave@example.com", Source: owntypes.DriftSourceCodeowners},
+		{RepoID: repoID, FilePath: "assigned.go", Kind: owntypes.DriftKindDeactivatedOwner, Owner: "@bob", Source: owntypes.DriftSourceAssigned},
+	}, got)
+}
@@ -0,0 +1,225 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	logger "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/lib/batches/git"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func handleRecentReviewers(ctx context.Context, lgr logger.Logger, repoId api.RepoID, db database.DB, subRepoPermsCache *rcache.Cache) error {
+	// 🚨 SECURITY: we use the internal actor because the background indexer is not associated with any user, and needs
+	// to see all repos and files
+	internalCtx := actor.WithInternalActor(ctx)
+
+	indexer := newRecentReviewersIndexer(db, lgr, subRepoPermsCache)
+	return indexer.indexRepo(internalCtx, repoId, authz.DefaultSubRepoPermsChecker)
+}
+
+// recentReviewersIndexer computes the recent reviewers signal of a repository
+// from the review events of its changesets, which are synced from the code
+// hosts and kept up to date by their webhooks. Only changesets created by batch
+// changes are considered, since imported changesets do not have a diff we could
+// get the reviewed files from.
+type recentReviewersIndexer struct {
+	db                database.DB
+	logger            logger.Logger
+	subRepoPermsCache rcache.Cache
+	clock             func() time.Time
+}
+
+func newRecentReviewersIndexer(db database.DB, lgr logger.Logger, subRepoPermsCache *rcache.Cache) *recentReviewersIndexer {
+	return &recentReviewersIndexer{db: db, logger: lgr, subRepoPermsCache: *subRepoPermsCache, clock: time.Now}
+}
+
+var reviewCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "src",
+	Name:      "own_recent_reviewers_reviews_indexed_total",
+})
+
+// reviewEventKinds are the kinds of changeset events that are reviews.
+var reviewEventKinds = []btypes.ChangesetEventKind{
+	btypes.ChangesetEventKindGitHubReviewed,
+	btypes.ChangesetEventKindBitbucketServerApproved,
+	btypes.ChangesetEventKindBitbucketServerReviewed,
+	btypes.ChangesetEventKindGitLabApproved,
+	btypes.ChangesetEventKindBitbucketCloudApproved,
+	btypes.ChangesetEventKindBitbucketCloudChangesRequested,
+	btypes.ChangesetEventKindBitbucketCloudPullRequestApproved,
+	btypes.ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated,
+	btypes.ChangesetEventKindAzureDevOpsPullRequestApproved,
+	btypes.ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions,
+	btypes.ChangesetEventKindAzureDevOpsPullRequestWaitingForAuthor,
+}
+
+func (r *recentReviewersIndexer) indexRepo(ctx context.Context, repoId api.RepoID, checker authz.SubRepoPermissionChecker) error {
+	// If the repo has sub-repo perms enabled, skip indexing.
+	isSubRepoPermsRepo, err := isSubRepoPermsRepo(ctx, repoId, r.subRepoPermsCache, checker)
+	if err != nil {
+		return errcode.MakeNonRetryable(err)
+	} else if isSubRepoPermsRepo {
+		r.logger.Debug("skipping own reviewer signal due to the repo having subrepo perms enabled", logger.Int32("repoID", int32(repoId)))
+		return nil
+	}
+
+	store := basestore.NewWithHandle(r.db.Handle())
+	reviews, err := r.recentReviews(ctx, store, repoId, r.clock().AddDate(0, 0, -90))
+	if err != nil {
+		return errors.Wrap(err, "recentReviews")
+	}
+
+	err = r.db.RecentReviewSignals().WithTransact(ctx, func(tx database.RecentReviewSignalStore) error {
+		if err := tx.ClearSignals(ctx, repoId); err != nil {
+			return errors.Wrap(err, "ClearSignals")
+		}
+		for _, review := range reviews {
+			if err := tx.AddReview(ctx, review); err != nil {
+				return errors.Wrapf(err, "AddReview %v", review)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	r.logger.Info("reviews inserted", logger.Int("count", len(reviews)), logger.Int("repo_id", int(repoId)))
+	reviewCounter.Add(float64(len(reviews)))
+	return nil
+}
+
+const recentReviewEventsFmtstr = `
+SELECT ce.changeset_id, ce.kind, ce.metadata
+FROM changeset_events ce
+JOIN changesets c ON c.id = ce.changeset_id
+WHERE c.repo_id = %s
+AND c.current_spec_id IS NOT NULL
+AND ce.kind = ANY(%s)
+AND ce.updated_at > %s
+`
+
+const changesetDiffsFmtstr = `
+SELECT c.id, cs.diff
+FROM changesets c
+JOIN changeset_specs cs ON cs.id = c.current_spec_id
+WHERE c.id = ANY(%s)
+AND cs.type = %s
+`
+
+type changesetReviewer struct {
+	changesetID int64
+	handle      string
+}
+
+// recentReviews returns a review for every reviewer of every changeset in the
+// repository that was reviewed since the given time. Multiple reviews of the
+// same changeset by the same reviewer count once, at the time of the latest.
+func (r *recentReviewersIndexer) recentReviews(ctx context.Context, store *basestore.Store, repoId api.RepoID, since time.Time) ([]database.Review, error) {
+	kinds := make([]string, 0, len(reviewEventKinds))
+	for _, k := range reviewEventKinds {
+		kinds = append(kinds, string(k))
+	}
+	events, err := basestore.NewSliceScanner(scanReviewEvent)(store.Query(ctx, sqlf.Sprintf(recentReviewEventsFmtstr, repoId, pq.Array(kinds), since)))
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[changesetReviewer]time.Time)
+	var changesetIDs []int64
+	for _, e := range events {
+		switch state, err := e.ReviewState(); {
+		case err != nil:
+			return nil, err
+		case state != btypes.ChangesetReviewStateApproved &&
+			state != btypes.ChangesetReviewStateChangesRequested &&
+			state != btypes.ChangesetReviewStateCommented:
+			continue
+		}
+		handle := e.ReviewAuthor()
+		ts := e.Timestamp()
+		if handle == "" || ts.Before(since) {
+			continue
+		}
+		key := changesetReviewer{changesetID: e.ChangesetID, handle: handle}
+		if prev, ok := latest[key]; !ok {
+			changesetIDs = append(changesetIDs, e.ChangesetID)
+		} else if prev.After(ts) {
+			continue
+		}
+		latest[key] = ts
+	}
+	if len(latest) == 0 {
+		return nil, nil
+	}
+
+	files := make(map[int64][]string)
+	q := sqlf.Sprintf(changesetDiffsFmtstr, pq.Array(changesetIDs), btypes.ChangesetSpecTypeBranch)
+	err = basestore.NewCallbackScanner(func(s dbutil.Scanner) (bool, error) {
+		var id int64
+		var diff []byte
+		if err := s.Scan(&id, &diff); err != nil {
+			return false, err
+		}
+		changes, err := git.ChangesInDiff(diff)
+		if err != nil {
+			// A diff we cannot parse should not prevent indexing the other reviews.
+			r.logger.Warn("cannot parse changeset diff", logger.Int64("changesetID", id), logger.Error(err))
+			return true, nil
+		}
+		for _, fs := range [][]string{changes.Modified, changes.Added, changes.Deleted, changes.Renamed} {
+			files[id] = append(files[id], fs...)
+		}
+		return true, nil
+	})(store.Query(ctx, q))
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]database.Review, 0, len(latest))
+	for key, ts := range latest {
+		if len(files[key.changesetID]) == 0 {
+			continue
+		}
+		reviews = append(reviews, database.Review{
+			RepoID:         repoId,
+			ReviewerHandle: key.handle,
+			Timestamp:      ts,
+			FilesChanged:   files[key.changesetID],
+		})
+	}
+	return reviews, nil
+}
+
+func scanReviewEvent(s dbutil.Scanner) (*btypes.ChangesetEvent, error) {
+	var e btypes.ChangesetEvent
+	var metadata json.RawMessage
+	if err := s.Scan(&e.ChangesetID, &e.Kind, &metadata); err != nil {
+		return nil, err
+	}
+	meta, err := btypes.NewChangesetEventMetadata(e.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(metadata, meta); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %q metadata", e.Kind)
+	}
+	e.Metadata = meta
+	return &e, nil
+}
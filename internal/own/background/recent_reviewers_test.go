@@ -0,0 +1,100 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	btypes "github.com/sourcegraph/sourcegraph/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const testReviewDiff = `diff --git a/dir/file1.go b/dir/file1.go
+index 0000000..1111111 100644
+--- a/dir/file1.go
++++ b/dir/file1.go
+@@ -1 +1 @@
+-a
++b
+diff --git a/dir/subdir/file2.go b/dir/subdir/file2.go
+index 0000000..1111111 100644
+--- a/dir/subdir/file2.go
++++ b/dir/subdir/file2.go
+@@ -1 +1 @@
+-a
++b
+`
+
+func Test_RecentReviewersIndexFromChangesetEvents(t *testing.T) {
+	rcache.SetupForTest(t)
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	require.NoError(t, db.Repos().Create(ctx, &types.Repo{ID: 1, Name: "own/repo1"}))
+
+	now := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	store := basestore.NewWithHandle(db.Handle())
+	var specID, changesetID int64
+	require.NoError(t, store.QueryRow(ctx, sqlf.Sprintf(
+		`INSERT INTO changeset_specs (rand_id, repo_id, type, diff) VALUES ('spec', 1, %s, %s) RETURNING id`,
+		btypes.ChangesetSpecTypeBranch, []byte(testReviewDiff),
+	)).Scan(&specID))
+	require.NoError(t, store.QueryRow(ctx, sqlf.Sprintf(
+		`INSERT INTO changesets (repo_id, external_service_type, computed_state, current_spec_id) VALUES (1, %s, 'OPEN', %s) RETURNING id`,
+		extsvc.TypeGitHub, specID,
+	)).Scan(&changesetID))
+
+	for key, review := range map[string]*github.PullRequestReview{
+		"alice-commented": {Author: github.Actor{Login: "alice"}, State: "COMMENTED", UpdatedAt: now.AddDate(0, 0, -10)},
+		"alice-approved":  {Author: github.Actor{Login: "alice"}, State: "APPROVED", UpdatedAt: now.AddDate(0, 0, -5)},
+		"bob-pending":     {Author: github.Actor{Login: "bob"}, State: "PENDING", UpdatedAt: now.AddDate(0, 0, -5)},
+		"carol-outdated":  {Author: github.Actor{Login: "carol"}, State: "APPROVED", UpdatedAt: now.AddDate(0, 0, -100)},
+	} {
+		metadata, err := json.Marshal(review)
+		require.NoError(t, err)
+		require.NoError(t, store.Exec(ctx, sqlf.Sprintf(
+			`INSERT INTO changeset_events (changeset_id, kind, key, metadata) VALUES (%s, %s, %s, %s)`,
+			changesetID, btypes.ChangesetEventKindGitHubReviewed, key, metadata,
+		)))
+	}
+
+	indexer := newRecentReviewersIndexer(db, logger, rcache.New("testing_own_signals"))
+	indexer.clock = func() time.Time { return now }
+	checker := authz.NewMockSubRepoPermissionChecker()
+	checker.EnabledFunc.SetDefaultReturn(true)
+	checker.EnabledForRepoIDFunc.SetDefaultReturn(false, nil)
+	require.NoError(t, indexer.indexRepo(ctx, api.RepoID(1), checker))
+
+	for _, path := range []string{"", "dir", "dir/file1.go", "dir/subdir/file2.go"} {
+		got, err := db.RecentReviewSignals().FindRecentReviewers(ctx, 1, path)
+		require.NoError(t, err)
+		require.Len(t, got, 1, path)
+		assert.Equal(t, "alice", got[0].ReviewerHandle, path)
+		assert.Equal(t, 1, got[0].ReviewsCount, path)
+		assert.True(t, got[0].LastReviewedAt.Equal(now.AddDate(0, 0, -5)), path)
+	}
+
+	t.Run("reindexing replaces the signals", func(t *testing.T) {
+		require.NoError(t, indexer.indexRepo(ctx, api.RepoID(1), checker))
+		got, err := db.RecentReviewSignals().FindRecentReviewers(ctx, 1, "dir")
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, 1, got[0].ReviewsCount)
+	})
+}
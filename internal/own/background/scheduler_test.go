@@ -43,7 +43,9 @@ func TestOwnRepoIndexSchedulerJob_JobsAutoIndex(t *testing.T) {
 
 	wantJobCountByName := map[string]int{
 		types.SignalRecentContributors: 3,
+		types.SignalRecentReviewers:    0, // Turned off by default
 		types.Analytics:                0, // Turned off by default
+		types.OwnershipDrift:           0, // Turned off by default
 	}
 
 	for _, jobType := range QueuePerRepoIndexJobs {
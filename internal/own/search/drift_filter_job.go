@@ -0,0 +1,198 @@
+package search
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewFileHasDriftJob creates a filter job to post-filter results for the
+// file:has.drift() predicate, based on the findings of the ownership drift
+// analysis. An empty kind stands for findings of any kind.
+func NewFileHasDriftJob(child job.Job, includeKinds, excludeKinds []string) job.Job {
+	return &fileHasDriftJob{
+		child:        child,
+		includeKinds: includeKinds,
+		excludeKinds: excludeKinds,
+	}
+}
+
+type fileHasDriftJob struct {
+	child job.Job
+
+	includeKinds []string
+	excludeKinds []string
+}
+
+func (s *fileHasDriftJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, s)
+	defer finish(alert, err)
+
+	var maxAlerter search.MaxAlerter
+
+	findings := newDriftFindingsCache(clients.DB)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		var err error
+		event.Results, err = applyDriftFiltering(ctx, findings, s.includeKinds, s.excludeKinds, event.Results)
+		if err != nil {
+			maxAlerter.Add(search.AlertForOwnershipSearchError())
+		}
+		stream.Send(event)
+	})
+
+	alert, err = s.child.Run(ctx, clients, filteredStream)
+	maxAlerter.Add(alert)
+	return maxAlerter.Alert, err
+}
+
+func (s *fileHasDriftJob) Name() string {
+	return "FileHasDriftFilterJob"
+}
+
+func (s *fileHasDriftJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res,
+			attribute.StringSlice("includeKinds", s.includeKinds),
+			attribute.StringSlice("excludeKinds", s.excludeKinds),
+		)
+	}
+	return res
+}
+
+func (s *fileHasDriftJob) Children() []job.Describer {
+	return []job.Describer{s.child}
+}
+
+func (s *fileHasDriftJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *s
+	cp.child = job.Map(s.child, fn)
+	return &cp
+}
+
+func applyDriftFiltering(
+	ctx context.Context,
+	findings *driftFindingsCache,
+	includeKinds []string,
+	excludeKinds []string,
+	matches []result.Match,
+) ([]result.Match, error) {
+	var errs error
+
+	filtered := matches[:0]
+
+matchesLoop:
+	for _, m := range matches {
+		var (
+			filePaths []string
+			repoID    api.RepoID
+		)
+		switch mm := m.(type) {
+		case *result.FileMatch:
+			filePaths = []string{mm.File.Path}
+			repoID = mm.Repo.ID
+		case *result.CommitMatch:
+			filePaths = mm.ModifiedFiles
+			repoID = mm.Repo.ID
+		}
+		if len(filePaths) == 0 {
+			continue matchesLoop
+		}
+		kindsByPath, err := findings.GetFromCacheOrFetch(ctx, repoID)
+		if err != nil {
+			errs = errors.Append(errs, err)
+			continue matchesLoop
+		}
+		// As for ownership, for multiple files in a single result (CommitMatch case) we:
+		// * exclude a result if none of the files has all included kinds of findings,
+		// * exclude a result if any of the files has any excluded kind of findings.
+		var fileMatchesIncludeKinds bool
+		for _, path := range filePaths {
+			kinds := kindsByPath[path]
+			if len(includeKinds) > 0 && hasAllDriftKinds(kinds, includeKinds) {
+				fileMatchesIncludeKinds = true
+			}
+			if len(excludeKinds) > 0 && hasAnyDriftKind(kinds, excludeKinds) {
+				continue matchesLoop
+			}
+		}
+		if len(includeKinds) > 0 && !fileMatchesIncludeKinds {
+			continue matchesLoop
+		}
+
+		filtered = append(filtered, m)
+	}
+
+	return filtered, errs
+}
+
+// hasAllDriftKinds returns true if the given kinds of findings of a file
+// contain all the wanted kinds, an empty wanted kind matching any finding.
+func hasAllDriftKinds(kinds map[string]bool, wanted []string) bool {
+	for _, k := range wanted {
+		if (k == "" && len(kinds) == 0) || (k != "" && !kinds[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasAnyDriftKind returns true if the given kinds of findings of a file
+// contain any of the wanted kinds, an empty wanted kind matching any finding.
+func hasAnyDriftKind(kinds map[string]bool, wanted []string) bool {
+	for _, k := range wanted {
+		if (k == "" && len(kinds) > 0) || (k != "" && kinds[k]) {
+			return true
+		}
+	}
+	return false
+}
+
+// driftFindingsCache holds the kinds of ownership drift findings by file path
+// for each repository seen during a search.
+type driftFindingsCache struct {
+	store database.OwnDriftFindingStore
+
+	mu    sync.Mutex
+	repos map[api.RepoID]map[string]map[string]bool
+}
+
+func newDriftFindingsCache(db database.DB) *driftFindingsCache {
+	return &driftFindingsCache{
+		store: db.OwnDriftFindings(),
+		repos: make(map[api.RepoID]map[string]map[string]bool),
+	}
+}
+
+func (c *driftFindingsCache) GetFromCacheOrFetch(ctx context.Context, repoID api.RepoID) (map[string]map[string]bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if kindsByPath, ok := c.repos[repoID]; ok {
+		return kindsByPath, nil
+	}
+	findings, _, err := c.store.ListFindings(ctx, database.ListOwnDriftFindingsOpts{RepoID: repoID})
+	if err != nil {
+		return nil, err
+	}
+	kindsByPath := make(map[string]map[string]bool)
+	for _, f := range findings {
+		if kindsByPath[f.FilePath] == nil {
+			kindsByPath[f.FilePath] = make(map[string]bool)
+		}
+		kindsByPath[f.FilePath][f.Kind] = true
+	}
+	c.repos[repoID] = kindsByPath
+	return kindsByPath, nil
+}
@@ -0,0 +1,121 @@
+package search
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestApplyDriftFiltering(t *testing.T) {
+	repo := types.MinimalRepo{ID: 42, Name: "github.com/sourcegraph/sourcegraph"}
+	fileMatch := func(path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{Path: path, Repo: repo}}
+	}
+	commitMatch := func(paths ...string) *result.CommitMatch {
+		return &result.CommitMatch{Repo: repo, ModifiedFiles: paths}
+	}
+
+	newDB := func() (*dbmocks.MockDB, *dbmocks.MockOwnDriftFindingStore) {
+		store := dbmocks.NewMockOwnDriftFindingStore()
+		store.ListFindingsFunc.SetDefaultHook(func(_ context.Context, opts database.ListOwnDriftFindingsOpts) ([]*database.OwnDriftFinding, int32, error) {
+			if opts.RepoID != repo.ID {
+				return nil, 0, nil
+			}
+			return []*database.OwnDriftFinding{
+				{RepoID: repo.ID, FilePath: "stale.go", Kind: "stale-owner"},
+				{RepoID: repo.ID, FilePath: "both.go", Kind: "stale-owner"},
+				{RepoID: repo.ID, FilePath: "both.go", Kind: "deactivated-owner"},
+				{RepoID: repo.ID, FilePath: "team.go", Kind: "empty-team"},
+			}, 0, nil
+		})
+		db := dbmocks.NewMockDB()
+		db.OwnDriftFindingsFunc.SetDefaultReturn(store)
+		return db, store
+	}
+
+	tests := []struct {
+		name         string
+		includeKinds []string
+		excludeKinds []string
+		matches      []result.Match
+		want         []string
+	}{
+		{
+			name:         "any kind",
+			includeKinds: []string{""},
+			matches:      []result.Match{fileMatch("stale.go"), fileMatch("clean.go"), fileMatch("team.go")},
+			want:         []string{"stale.go", "team.go"},
+		},
+		{
+			name:         "single kind",
+			includeKinds: []string{"stale-owner"},
+			matches:      []result.Match{fileMatch("stale.go"), fileMatch("both.go"), fileMatch("team.go")},
+			want:         []string{"stale.go", "both.go"},
+		},
+		{
+			name:         "all included kinds",
+			includeKinds: []string{"stale-owner", "deactivated-owner"},
+			matches:      []result.Match{fileMatch("stale.go"), fileMatch("both.go")},
+			want:         []string{"both.go"},
+		},
+		{
+			name:         "excluded kind",
+			excludeKinds: []string{"deactivated-owner"},
+			matches:      []result.Match{fileMatch("stale.go"), fileMatch("both.go"), fileMatch("clean.go")},
+			want:         []string{"stale.go", "clean.go"},
+		},
+		{
+			name:         "excluded any kind",
+			excludeKinds: []string{""},
+			matches:      []result.Match{fileMatch("stale.go"), fileMatch("clean.go")},
+			want:         []string{"clean.go"},
+		},
+		{
+			name:         "commit matches",
+			includeKinds: []string{"empty-team"},
+			excludeKinds: []string{"deactivated-owner"},
+			matches: []result.Match{
+				commitMatch("clean.go", "team.go"),
+				commitMatch("team.go", "both.go"),
+				commitMatch("clean.go"),
+			},
+			want: []string{"clean.go,team.go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _ := newDB()
+			findings := newDriftFindingsCache(db)
+			matches, err := applyDriftFiltering(context.Background(), findings, tt.includeKinds, tt.excludeKinds, tt.matches)
+			require.NoError(t, err)
+			var got []string
+			for _, m := range matches {
+				switch mm := m.(type) {
+				case *result.FileMatch:
+					got = append(got, mm.File.Path)
+				case *result.CommitMatch:
+					got = append(got, strings.Join(mm.ModifiedFiles, ","))
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("findings are fetched once per repository", func(t *testing.T) {
+		db, store := newDB()
+		findings := newDriftFindingsCache(db)
+		_, err := applyDriftFiltering(context.Background(), findings, []string{""}, nil, []result.Match{fileMatch("stale.go"), fileMatch("team.go"), commitMatch("both.go")})
+		require.NoError(t, err)
+		assert.Len(t, store.ListFindingsFunc.History(), 1)
+		assert.Equal(t, api.RepoID(42), store.ListFindingsFunc.History()[0].Arg1.RepoID)
+	})
+}
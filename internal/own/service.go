@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -11,6 +12,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/own/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // Service gives access to code ownership data.
@@ -36,6 +39,12 @@ type Service interface {
 	// team of 'src/test' in a given repo transitively owns all files within the
 	// directory tree at that root like 'src/test/com/sourcegraph/Test.java'.
 	AssignedTeams(context.Context, api.RepoID, api.CommitID) (AssignedTeams, error)
+
+	// RecentReviewers returns the ranked reviewers of recent changes to the given
+	// path in a repository, the most relevant reviewer first. Empty path designates
+	// the repository root. No reviewers are returned if the recent-reviewers signal
+	// is disabled in the ownership signal configuration.
+	RecentReviewers(ctx context.Context, repoID api.RepoID, path string) ([]database.RecentReviewerSummary, error)
 }
 
 type AssignedOwners map[string][]database.AssignedOwnerSummary
@@ -168,3 +177,29 @@ func (s *service) AssignedTeams(ctx context.Context, repoID api.RepoID, _ api.Co
 	}
 	return assignedTeams, nil
 }
+
+func (s *service) RecentReviewers(ctx context.Context, repoID api.RepoID, path string) ([]database.RecentReviewerSummary, error) {
+	enabled, err := s.db.OwnSignalConfigurations().IsEnabled(ctx, types.SignalRecentReviewers)
+	if err != nil {
+		return nil, errors.Wrap(err, "IsEnabled")
+	}
+	if !enabled {
+		return nil, nil
+	}
+	reviewers, err := s.db.RecentReviewSignals().FindRecentReviewers(ctx, repoID, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindRecentReviewers")
+	}
+	// Reviewers who reviewed more changes come first. Among reviewers with the
+	// same number of reviews, those who reviewed more recently come first.
+	sort.SliceStable(reviewers, func(i, j int) bool {
+		if reviewers[i].ReviewsCount != reviewers[j].ReviewsCount {
+			return reviewers[i].ReviewsCount > reviewers[j].ReviewsCount
+		}
+		if !reviewers[i].LastReviewedAt.Equal(reviewers[j].LastReviewedAt) {
+			return reviewers[i].LastReviewedAt.After(reviewers[j].LastReviewedAt)
+		}
+		return reviewers[i].ReviewerHandle < reviewers[j].ReviewerHandle
+	})
+	return reviewers, nil
+}
@@ -521,3 +521,37 @@ func createTeam(t *testing.T, ctx context.Context, db database.DB, teamName stri
 	require.NoError(t, err)
 	return team
 }
+
+func TestRecentReviewers(t *testing.T) {
+	earlier := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	later := time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC)
+	store := dbmocks.NewMockRecentReviewSignalStore()
+	store.FindRecentReviewersFunc.SetDefaultReturn([]database.RecentReviewerSummary{
+		{ReviewerHandle: "carol", ReviewsCount: 1, LastReviewedAt: later},
+		{ReviewerHandle: "bob", ReviewsCount: 3, LastReviewedAt: earlier},
+		{ReviewerHandle: "alice", ReviewsCount: 3, LastReviewedAt: later},
+	}, nil)
+	configStore := dbmocks.NewMockSignalConfigurationStore()
+	db := dbmocks.NewMockDB()
+	db.RecentReviewSignalsFunc.SetDefaultReturn(store)
+	db.OwnSignalConfigurationsFunc.SetDefaultReturn(configStore)
+
+	t.Run("disabled signal", func(t *testing.T) {
+		configStore.IsEnabledFunc.SetDefaultReturn(false, nil)
+		got, err := NewService(gitserver.NewMockClient(), db).RecentReviewers(context.Background(), repoID, "src")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.Empty(t, store.FindRecentReviewersFunc.History())
+	})
+
+	t.Run("ranks reviewers", func(t *testing.T) {
+		configStore.IsEnabledFunc.SetDefaultReturn(true, nil)
+		got, err := NewService(gitserver.NewMockClient(), db).RecentReviewers(context.Background(), repoID, "src")
+		require.NoError(t, err)
+		var handles []string
+		for _, r := range got {
+			handles = append(handles, r.ReviewerHandle)
+		}
+		assert.Equal(t, []string{"alice", "bob", "carol"}, handles)
+	})
+}
@@ -20,5 +20,26 @@ type CodeownersFile struct {
 const (
 	SignalRecentContributors = "recent-contributors"
 	SignalRecentViews        = "recent-views"
+	SignalRecentReviewers    = "recent-reviewers"
 	Analytics                = "analytics"
+	OwnershipDrift           = "ownership-drift"
+)
+
+// Kinds of findings of the ownership drift analysis.
+const (
+	// DriftKindDeactivatedOwner flags a declared owner that is a deactivated
+	// (soft-deleted) user.
+	DriftKindDeactivatedOwner = "deactivated-owner"
+	// DriftKindEmptyTeam flags a declared owner that is a team without any
+	// active members, including in its child teams.
+	DriftKindEmptyTeam = "empty-team"
+	// DriftKindStaleOwner flags a declared owner who has not contributed to a
+	// file recently while others have.
+	DriftKindStaleOwner = "stale-owner"
+)
+
+// Sources of declared owners flagged by the ownership drift analysis.
+const (
+	DriftSourceCodeowners = "codeowners"
+	DriftSourceAssigned   = "assigned"
 )
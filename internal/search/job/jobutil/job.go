@@ -157,6 +157,7 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 
 		if resultTypes.Has(result.TypeCommit) || resultTypes.Has(result.TypeDiff) {
 			_, _, own := isOwnershipSearch(b)
+			_, _, drift := isDriftSearch(b)
 			diff := resultTypes.Has(result.TypeDiff)
 			repoOptionsCopy := repoOptions
 			repoOptionsCopy.OnlyCloned = true
@@ -165,7 +166,7 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 				RepoOpts:             repoOptionsCopy,
 				Diff:                 diff,
 				Limit:                int(fileMatchLimit),
-				IncludeModifiedFiles: authz.SubRepoEnabled(authz.DefaultSubRepoPermsChecker) || own,
+				IncludeModifiedFiles: authz.SubRepoEnabled(authz.DefaultSubRepoPermsChecker) || own || drift,
 			})
 		}
 
@@ -203,6 +204,12 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 		}
 	}
 
+	{ // Apply file:has.drift() post-search filter
+		if includeKinds, excludeKinds, ok := isDriftSearch(b); ok {
+			basicJob = ownsearch.NewFileHasDriftJob(basicJob, includeKinds, excludeKinds)
+		}
+	}
+
 	{ // Apply file:has.contributor() post-search filter
 		if includeContributors, excludeContributors, ok := isContributorSearch(b); ok {
 			includeRe := contributorsAsRegexp(includeContributors, b.IsCaseSensitive())
@@ -548,6 +555,10 @@ func computeFileMatchLimit(b query.Basic, defaultLimit int) int {
 		// This is the int equivalent of count:all.
 		return query.CountAllLimit
 	}
+	if _, _, ok := isDriftSearch(b); ok {
+		// This is the int equivalent of count:all.
+		return query.CountAllLimit
+	}
 	if v, _ := b.ToParseTree().StringValue(query.FieldSelect); v != "" {
 		sp, _ := filter.SelectPathFromString(v) // Invariant: select already validated
 		if isSelectOwnersSearch(sp) {
@@ -566,6 +577,13 @@ func isOwnershipSearch(b query.Basic) (include, exclude []string, ok bool) {
 	return nil, nil, false
 }
 
+func isDriftSearch(b query.Basic) (include, exclude []string, ok bool) {
+	if includeKinds, excludeKinds := b.FileHasDrift(); len(includeKinds) > 0 || len(excludeKinds) > 0 {
+		return includeKinds, excludeKinds, true
+	}
+	return nil, nil, false
+}
+
 func isSelectOwnersSearch(sp filter.SelectPath) bool {
 	// If the filter is for file.owners, this is a select:file.owners search, and we should apply special limits.
 	return sp.Root() == filter.File && len(sp) == 2 && sp[1] == "owners"
@@ -761,6 +779,7 @@ func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOpti
 		UseIndex:            b.Index(),
 		HasKVPs:             b.RepoHasKVPs(),
 		HasTopics:           b.RepoHasTopics(),
+		DependsOn:           b.RepoDependsOn(),
 	}
 }
 
@@ -1052,6 +1071,12 @@ func isGlobal(op search.RepoOptions) bool {
 		return false
 	}
 
+	// Zoekt does not know about precise package references, so we depend on
+	// the database to handle this filter.
+	if len(op.DependsOn) > 0 {
+		return false
+	}
+
 	// If a search context is specified, we do not know ahead of time whether
 	// the repos in the context are indexed and we need to go through the repo
 	// resolution process.
@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/grafana/regexp"
@@ -37,6 +38,7 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.description":       func() Predicate { return &RepoHasDescriptionPredicate{} },
 		"has.meta":              func() Predicate { return &RepoHasMetaPredicate{} },
 		"has.topic":             func() Predicate { return &RepoHasTopicPredicate{} },
+		"depends.on":            func() Predicate { return &RepoDependsOnPredicate{} },
 
 		// Deprecated predicates
 		"has.tag":  func() Predicate { return &RepoHasTagPredicate{} },
@@ -49,6 +51,7 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"has.content":      func() Predicate { return &FileContainsContentPredicate{} },
 		"has.owner":        func() Predicate { return &FileHasOwnerPredicate{} },
 		"has.contributor":  func() Predicate { return &FileHasContributorPredicate{} },
+		"has.drift":        func() Predicate { return &FileHasDriftPredicate{} },
 	},
 }
 
@@ -483,6 +486,40 @@ func (p *RepoHasTopicPredicate) Unmarshal(params string, negated bool) (err erro
 func (p *RepoHasTopicPredicate) Field() string { return FieldRepo }
 func (p *RepoHasTopicPredicate) Name() string  { return "has.topic" }
 
+// RepoDependsOnPredicate represents the `repo:depends.on(name@version)` predicate, which
+// matches repositories whose precise code intelligence data shows a direct or transitive
+// dependency on the given package.
+type RepoDependsOnPredicate struct {
+	Package string
+	Version string
+	Negated bool
+}
+
+func (p *RepoDependsOnPredicate) Unmarshal(params string, negated bool) error {
+	params = strings.TrimSpace(params)
+
+	// Package names may themselves begin with an @ (e.g. scoped npm packages), so the
+	// version separator is the last @ that is not the first character.
+	name, version := params, ""
+	if i := strings.LastIndex(params, "@"); i > 0 {
+		name, version = params[:i], params[i+1:]
+		if version == "" {
+			return errors.New("version must be non-empty when @ is given")
+		}
+	}
+	if name == "" {
+		return errors.New("package name must be non-empty")
+	}
+
+	p.Package = name
+	p.Version = version
+	p.Negated = negated
+	return nil
+}
+
+func (p *RepoDependsOnPredicate) Field() string { return FieldRepo }
+func (p *RepoDependsOnPredicate) Name() string  { return "depends.on" }
+
 // RepoContainsPredicate represents the `repo:contains(file:a content:b)` predicate.
 // DEPRECATED: this syntax is deprecated in favor of `repo:contains.file`.
 type RepoContainsPredicate struct {
@@ -614,3 +651,28 @@ func (f *FileHasContributorPredicate) Unmarshal(params string, negated bool) err
 
 func (f FileHasContributorPredicate) Field() string { return FieldFile }
 func (f FileHasContributorPredicate) Name() string  { return "has.contributor" }
+
+/* file:has.drift(kind) */
+
+// fileHasDriftKinds are the kinds of ownership drift findings, as defined in
+// internal/own/types.
+var fileHasDriftKinds = []string{"deactivated-owner", "empty-team", "stale-owner"}
+
+type FileHasDriftPredicate struct {
+	// Kind is the kind of ownership drift findings, or empty for any kind.
+	Kind    string
+	Negated bool
+}
+
+func (f *FileHasDriftPredicate) Unmarshal(params string, negated bool) error {
+	params = strings.TrimSpace(params)
+	if params != "" && !slices.Contains(fileHasDriftKinds, params) {
+		return errors.Errorf("the file:has.drift() predicate argument must be empty or one of %s", strings.Join(fileHasDriftKinds, ", "))
+	}
+	f.Kind = params
+	f.Negated = negated
+	return nil
+}
+
+func (f FileHasDriftPredicate) Field() string { return FieldFile }
+func (f FileHasDriftPredicate) Name() string  { return "has.drift" }
@@ -147,6 +147,28 @@ func TestRepoHasTopicPredicate(t *testing.T) {
 	})
 }
 
+func TestRepoDependsOnPredicate(t *testing.T) {
+	t.Run("errors on empty", func(t *testing.T) {
+		var p RepoDependsOnPredicate
+		require.Error(t, p.Unmarshal("", false))
+		require.Error(t, p.Unmarshal("leftpad@", false))
+	})
+
+	t.Run("package without version", func(t *testing.T) {
+		var p RepoDependsOnPredicate
+		err := p.Unmarshal("leftpad", true)
+		require.NoError(t, err)
+		require.Equal(t, RepoDependsOnPredicate{Package: "leftpad", Negated: true}, p)
+	})
+
+	t.Run("scoped package with version", func(t *testing.T) {
+		var p RepoDependsOnPredicate
+		err := p.Unmarshal("@types/node@20.1.0", false)
+		require.NoError(t, err)
+		require.Equal(t, RepoDependsOnPredicate{Package: "@types/node", Version: "20.1.0"}, p)
+	})
+}
+
 func TestRepoHasKVPMetaPredicate(t *testing.T) {
 	t.Run("Unmarshal", func(t *testing.T) {
 		type test struct {
@@ -366,3 +388,42 @@ func TestFileHasContributorPredicate(t *testing.T) {
 		}
 	})
 }
+
+func TestFileHasDriftPredicate(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			negated  bool
+			expected *FileHasDriftPredicate
+			error    string
+		}
+
+		valid := []test{
+			{`empty`, ``, false, &FileHasDriftPredicate{Kind: ""}, ""},
+			{`kind`, `stale-owner`, false, &FileHasDriftPredicate{Kind: "stale-owner"}, ""},
+			{`negated kind`, ` empty-team `, true, &FileHasDriftPredicate{Kind: "empty-team", Negated: true}, ""},
+			{`unknown kind`, `unknown`, false, &FileHasDriftPredicate{}, "the file:has.drift() predicate argument must be empty or one of deactivated-owner, empty-team, stale-owner"},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &FileHasDriftPredicate{}
+				err := p.Unmarshal(tc.params, tc.negated)
+				if err != nil {
+					if tc.error == "" {
+						t.Fatalf("unexpected error: %s", err)
+					} else if tc.error != err.Error() {
+						t.Fatalf("expected error %s, got %s", tc.error, err.Error())
+					}
+				} else if tc.error != "" {
+					t.Fatalf("expected error %s", tc.error)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+	})
+}
@@ -486,6 +486,13 @@ func (p Parameters) RepoHasTopics() (res []RepoHasTopicPredicate) {
 	return res
 }
 
+func (p Parameters) RepoDependsOn() (res []RepoDependsOnPredicate) {
+	VisitTypedPredicate(toNodes(p), func(pred *RepoDependsOnPredicate) {
+		res = append(res, *pred)
+	})
+	return res
+}
+
 func (p Parameters) FileHasOwner() (include, exclude []string) {
 	VisitTypedPredicate(toNodes(p), func(pred *FileHasOwnerPredicate) {
 		if pred.Negated {
@@ -497,6 +504,19 @@ func (p Parameters) FileHasOwner() (include, exclude []string) {
 	return include, exclude
 }
 
+// FileHasDrift returns the kinds of ownership drift findings of the
+// file:has.drift() predicates, where an empty kind stands for any kind.
+func (p Parameters) FileHasDrift() (include, exclude []string) {
+	VisitTypedPredicate(toNodes(p), func(pred *FileHasDriftPredicate) {
+		if pred.Negated {
+			exclude = append(exclude, pred.Kind)
+		} else {
+			include = append(include, pred.Kind)
+		}
+	})
+	return include, exclude
+}
+
 func (p Parameters) FileHasContributor() (include []string, exclude []string) {
 	VisitTypedPredicate(toNodes(p), func(pred *FileHasContributorPredicate) {
 		if pred.Negated {
@@ -248,6 +248,15 @@ func (r *Resolver) doQueryDB(ctx context.Context, tr trace.Trace, op search.Repo
 		})
 	}
 
+	dependsOnFilters := make([]database.RepoDependsOnFilter, 0, len(op.DependsOn))
+	for _, filter := range op.DependsOn {
+		dependsOnFilters = append(dependsOnFilters, database.RepoDependsOnFilter{
+			Package: filter.Package,
+			Version: filter.Version,
+			Negated: filter.Negated,
+		})
+	}
+
 	options := database.ReposListOptions{
 		IncludePatterns:       includePatterns,
 		ExcludePattern:        query.UnionRegExps(excludePatterns),
@@ -255,6 +264,7 @@ func (r *Resolver) doQueryDB(ctx context.Context, tr trace.Trace, op search.Repo
 		CaseSensitivePatterns: op.CaseSensitiveRepoFilters,
 		KVPFilters:            kvpFilters,
 		TopicFilters:          topicFilters,
+		DependsOnFilters:      dependsOnFilters,
 		Cursors:               op.Cursors,
 		// List N+1 repos so we can see if there are repos omitted due to our repo limit.
 		LimitOffset:  &database.LimitOffset{Limit: limit + 1},
@@ -478,6 +478,7 @@ type RepoOptions struct {
 	HasFileContent []query.RepoHasFileContentArgs
 	HasKVPs        []query.RepoKVPFilter
 	HasTopics      []query.RepoHasTopicPredicate
+	DependsOn      []query.RepoDependsOnPredicate
 
 	// ForkSet indicates whether `fork:` was set explicitly in the query,
 	// or whether the values were set from defaults.
@@ -573,6 +574,21 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 			add(trace.Scoped(fmt.Sprintf("hasTopics[%d]", i), nondefault...)...)
 		}
 	}
+	if len(op.DependsOn) > 0 {
+		for i, arg := range op.DependsOn {
+			nondefault := []attribute.KeyValue{}
+			if arg.Package != "" {
+				nondefault = append(nondefault, attribute.String("package", arg.Package))
+			}
+			if arg.Version != "" {
+				nondefault = append(nondefault, attribute.String("version", arg.Version))
+			}
+			if arg.Negated {
+				nondefault = append(nondefault, attribute.Bool("negated", arg.Negated))
+			}
+			add(trace.Scoped(fmt.Sprintf("dependsOn[%d]", i), nondefault...)...)
+		}
+	}
 	if op.ForkSet {
 		add(attribute.Bool("forkSet", op.ForkSet))
 	}
@@ -659,6 +675,19 @@ func (op *RepoOptions) String() string {
 			}
 		}
 	}
+	if len(op.DependsOn) > 0 {
+		for i, arg := range op.DependsOn {
+			if arg.Package != "" {
+				fmt.Fprintf(&b, "DependsOn[%d].package: %s\n", i, arg.Package)
+			}
+			if arg.Version != "" {
+				fmt.Fprintf(&b, "DependsOn[%d].version: %s\n", i, arg.Version)
+			}
+			if arg.Negated {
+				fmt.Fprintf(&b, "DependsOn[%d].negated: %t\n", i, arg.Negated)
+			}
+		}
+	}
 
 	if op.CaseSensitiveRepoFilters {
 		fmt.Fprintf(&b, "CaseSensitiveRepoFilters: %t\n", op.CaseSensitiveRepoFilters)
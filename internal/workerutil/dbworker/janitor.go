@@ -0,0 +1,162 @@
+package dbworker
+
+import (
+	"context"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DeadLetterJanitor periodically deletes records that have been in the failed state for
+// longer than a retention period, and reports the number of failed records that remain.
+//
+// Failed records are kept around so that they can be inspected and requeued, but queues
+// that fail a lot otherwise grow without bound.
+type DeadLetterJanitor[T workerutil.Record] struct {
+	store    store.Store[T]
+	options  DeadLetterJanitorOptions
+	clock    glock.Clock
+	ctx      context.Context // root context passed to the database
+	cancel   func()          // cancels the root context
+	finished chan struct{}   // signals that Start has finished
+	logger   log.Logger
+}
+
+type DeadLetterJanitorOptions struct {
+	Name     string
+	Interval time.Duration
+
+	// Retention is the duration for which failed records are kept.
+	Retention time.Duration
+
+	// FailureMessage, if set, only purges failed records whose failure message contains
+	// the given text, ignoring case.
+	FailureMessage string
+
+	// BatchSize, if positive, is the maximum number of records deleted per iteration.
+	BatchSize int
+
+	Metrics DeadLetterJanitorMetrics
+}
+
+type DeadLetterJanitorMetrics struct {
+	FailedRecords prometheus.Gauge
+	RecordPurges  prometheus.Counter
+	Errors        prometheus.Counter
+}
+
+// NewDeadLetterJanitorMetrics returns a metrics object for a dead-letter janitor that
+// follows standard naming convention. The base metric name should be the same metric
+// name provided to a `worker` ex. my_job_queue. Do not provide prefix "src" or
+// postfix "_record...".
+func NewDeadLetterJanitorMetrics(observationCtx *observation.Context, metricNameRoot string) DeadLetterJanitorMetrics {
+	failedRecords := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "src_" + metricNameRoot + "_failed_records",
+		Help: "The number of records in the failed state.",
+	})
+	observationCtx.Registerer.MustRegister(failedRecords)
+
+	purges := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_" + metricNameRoot + "_failed_record_purges_total",
+		Help: "The number of failed records deleted after the retention period.",
+	})
+	observationCtx.Registerer.MustRegister(purges)
+
+	purgeErrors := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "src_" + metricNameRoot + "_failed_record_purge_errors_total",
+		Help: "The number of errors that occur during failed " +
+			"record purges.",
+	})
+	observationCtx.Registerer.MustRegister(purgeErrors)
+
+	return DeadLetterJanitorMetrics{
+		FailedRecords: failedRecords,
+		RecordPurges:  purges,
+		Errors:        purgeErrors,
+	}
+}
+
+func NewDeadLetterJanitor[T workerutil.Record](logger log.Logger, store store.Store[T], options DeadLetterJanitorOptions) *DeadLetterJanitor[T] {
+	return newDeadLetterJanitor(logger, store, options, glock.NewRealClock())
+}
+
+func newDeadLetterJanitor[T workerutil.Record](logger log.Logger, store store.Store[T], options DeadLetterJanitorOptions, clock glock.Clock) *DeadLetterJanitor[T] {
+	if options.Name == "" {
+		panic("no name supplied to github.com/sourcegraph/sourcegraph/internal/dbworker/newDeadLetterJanitor")
+	}
+	if options.Retention <= 0 {
+		panic("no retention supplied to github.com/sourcegraph/sourcegraph/internal/dbworker/newDeadLetterJanitor")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &DeadLetterJanitor[T]{
+		store:    store,
+		options:  options,
+		clock:    clock,
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+		logger:   logger,
+	}
+}
+
+// Start begins periodically purging expired failed records from the underlying store.
+func (j *DeadLetterJanitor[T]) Start() {
+	defer close(j.finished)
+
+	for {
+		if err := j.purge(); err != nil {
+			if j.ctx.Err() != nil && errors.Is(err, j.ctx.Err()) {
+				// If the error is due to the loop being shut down, just break
+				return
+			}
+
+			j.options.Metrics.Errors.Inc()
+			j.logger.Error("Failed to purge failed records", log.String("name", j.options.Name), log.Error(err))
+		}
+
+		select {
+		case <-j.clock.After(j.options.Interval):
+		case <-j.ctx.Done():
+			return
+		}
+	}
+}
+
+func (j *DeadLetterJanitor[T]) purge() error {
+	numDeleted, err := j.store.DeleteFailed(j.ctx, store.FailedRecordsOptions{
+		FailureMessage: j.options.FailureMessage,
+		FailedBefore:   j.clock.Now().Add(-j.options.Retention),
+		Limit:          j.options.BatchSize,
+	})
+	if err != nil {
+		return err
+	}
+	if numDeleted > 0 {
+		j.logger.Info("Deleted expired failed records", log.String("name", j.options.Name), log.Int("count", numDeleted))
+	}
+	j.options.Metrics.RecordPurges.Add(float64(numDeleted))
+
+	numFailed, err := j.store.CountFailed(j.ctx, store.FailedRecordsOptions{})
+	if err != nil {
+		return err
+	}
+	j.options.Metrics.FailedRecords.Set(float64(numFailed))
+
+	return nil
+}
+
+// Stop will cause the janitor loop to exit after the current iteration.
+func (j *DeadLetterJanitor[T]) Stop() {
+	j.cancel()
+	<-j.finished
+}
@@ -0,0 +1,63 @@
+package dbworker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	storemocks "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store/mocks"
+)
+
+func TestDeadLetterJanitor(t *testing.T) {
+	logger := logtest.Scoped(t)
+	s := storemocks.NewMockStore[*TestRecord]()
+	s.DeleteFailedFunc.SetDefaultReturn(3, nil)
+	s.CountFailedFunc.SetDefaultReturn(5, nil)
+	clock := glock.NewMockClock()
+	options := DeadLetterJanitorOptions{
+		Name:           "test",
+		Interval:       time.Second,
+		Retention:      time.Hour,
+		FailureMessage: "timeout",
+		BatchSize:      100,
+		Metrics: DeadLetterJanitorMetrics{
+			FailedRecords: prometheus.NewGauge(prometheus.GaugeOpts{}),
+			RecordPurges:  prometheus.NewCounter(prometheus.CounterOpts{}),
+			Errors:        prometheus.NewCounter(prometheus.CounterOpts{}),
+		},
+	}
+
+	janitor := newDeadLetterJanitor(logger, store.Store[*TestRecord](s), options, clock)
+	go func() { janitor.Start() }()
+	clock.BlockingAdvance(time.Second)
+	janitor.Stop()
+
+	history := s.DeleteFailedFunc.History()
+	if callCount := len(history); callCount < 1 {
+		t.Fatalf("unexpected delete failed call count. want>=%d have=%d", 1, callCount)
+	}
+	expectedOptions := store.FailedRecordsOptions{
+		FailureMessage: "timeout",
+		FailedBefore:   history[0].Arg1.FailedBefore,
+		Limit:          100,
+	}
+	if history[0].Arg1 != expectedOptions {
+		t.Errorf("unexpected options. want=%+v have=%+v", expectedOptions, history[0].Arg1)
+	}
+	if age := clock.Now().Sub(history[0].Arg1.FailedBefore); age < time.Hour {
+		t.Errorf("unexpected failed before. want age>=%s have=%s", time.Hour, age)
+	}
+
+	if value := testutil.ToFloat64(options.Metrics.FailedRecords); value != 5 {
+		t.Errorf("unexpected failed records. want=%d have=%v", 5, value)
+	}
+	if value := testutil.ToFloat64(options.Metrics.RecordPurges); value < 3 {
+		t.Errorf("unexpected record purges. want>=%d have=%v", 3, value)
+	}
+}
@@ -0,0 +1,45 @@
+package recurring
+
+import (
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type operations struct {
+	registerJob        *observation.Operation
+	dueJobs            *observation.Operation
+	enqueueRuns        *observation.Operation
+	listRuns           *observation.Operation
+	deleteFinishedRuns *observation.Operation
+}
+
+var m = new(metrics.SingletonREDMetrics)
+
+func newOperations(observationCtx *observation.Context) *operations {
+	m := m.Get(func() *metrics.REDMetrics {
+		return metrics.NewREDMetrics(
+			observationCtx.Registerer,
+			"workerutil_recurring_store",
+			metrics.WithLabels("op"),
+			metrics.WithCountHelp("Total number of method invocations."),
+		)
+	})
+
+	op := func(name string) *observation.Operation {
+		return observationCtx.Operation(observation.Op{
+			Name:              fmt.Sprintf("workerutil.recurring.store.%s", name),
+			MetricLabelValues: []string{name},
+			Metrics:           m,
+		})
+	}
+
+	return &operations{
+		registerJob:        op("RegisterJob"),
+		dueJobs:            op("DueJobs"),
+		enqueueRuns:        op("EnqueueRuns"),
+		listRuns:           op("ListRuns"),
+		deleteFinishedRuns: op("DeleteFinishedRuns"),
+	}
+}
@@ -0,0 +1,99 @@
+// Package recurring runs jobs on cron schedules on top of dbworker.
+//
+// Each schedule time of a job is enqueued as a run in the recurring_job_runs table, which is
+// processed by a regular dbworker worker and kept as the history of the job. Runs are enqueued
+// by a scheduler that only runs in the instance holding a Redis lock, and each schedule time is
+// enqueued at most once even if the lock is lost halfway through.
+package recurring
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+)
+
+// Job is a job that runs on a cron schedule.
+type Job struct {
+	// Kind uniquely identifies the job across all schedulers.
+	Kind string
+
+	// Schedule is a cron expression such as "*/15 * * * *" or "@daily". Schedules are
+	// evaluated in UTC.
+	Schedule string
+
+	// CatchUp decides which of the schedule times that passed while no scheduler was running
+	// are enqueued. Defaults to CatchUpLatest.
+	CatchUp CatchUpPolicy
+
+	// MaxCatchUp is the maximum number of missed schedule times enqueued at once by the
+	// CatchUpAll policy. Older schedule times are dropped. Defaults to 100.
+	MaxCatchUp int
+
+	// Handler runs the job.
+	Handler Handler
+}
+
+// CatchUpPolicy decides which missed schedule times of a job are enqueued, for example after
+// all schedulers were down for a while.
+type CatchUpPolicy string
+
+const (
+	// CatchUpLatest enqueues a single run for the most recent missed schedule time.
+	CatchUpLatest CatchUpPolicy = "latest"
+
+	// CatchUpAll enqueues a run for each missed schedule time, up to the job's MaxCatchUp.
+	CatchUpAll CatchUpPolicy = "all"
+
+	// CatchUpSkip drops missed schedule times, and the job next runs at its next schedule time.
+	CatchUpSkip CatchUpPolicy = "skip"
+)
+
+const defaultMaxCatchUp = 100
+
+// Handler runs a job.
+type Handler interface {
+	// Handle runs the job for the given run. A run that returns an error is retried.
+	Handle(ctx context.Context, logger log.Logger, run *Run) error
+}
+
+// HandlerFunc is a function that implements Handler.
+type HandlerFunc func(ctx context.Context, logger log.Logger, run *Run) error
+
+func (f HandlerFunc) Handle(ctx context.Context, logger log.Logger, run *Run) error {
+	return f(ctx, logger, run)
+}
+
+// Run is a single run of a recurring job.
+type Run struct {
+	ID              int
+	State           string
+	FailureMessage  *string
+	QueuedAt        time.Time
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
+	ProcessAfter    *time.Time
+	NumResets       int
+	NumFailures     int
+	LastHeartbeatAt time.Time
+	ExecutionLogs   []executor.ExecutionLogEntry
+	WorkerHostname  string
+	Cancel          bool
+
+	// Kind is the kind of the job this run belongs to.
+	Kind string
+	// ScheduledAt is the schedule time this run was enqueued for. It may be well in the past
+	// if the run was enqueued to catch up on missed schedule times.
+	ScheduledAt time.Time
+}
+
+func (r *Run) RecordID() int {
+	return r.ID
+}
+
+func (r *Run) RecordUID() string {
+	return strconv.Itoa(r.ID)
+}
@@ -0,0 +1,152 @@
+package recurring
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Options configure the routines that schedule and run a set of recurring jobs.
+type Options struct {
+	// Name identifies the set of jobs in logs and metrics, and names the lock of its scheduler.
+	// All processes running the same jobs must use the same name. It must be a valid Prometheus
+	// metric name fragment, such as "batches_recurring_jobs".
+	Name string
+
+	// Jobs are the jobs to schedule and run. Each kind may only be run by a single set of jobs.
+	Jobs []Job
+
+	// Interval is the interval at which due jobs are enqueued. Defaults to 30 seconds.
+	Interval time.Duration
+
+	// NumHandlers is the maximum number of runs processed concurrently. Defaults to 1.
+	NumHandlers int
+
+	// HistoryRetention is the duration for which finished runs are kept. Defaults to 30 days.
+	HistoryRetention time.Duration
+}
+
+// NewRoutines returns the background routines that enqueue the runs of the given jobs on their
+// schedules, process the runs, and clean up the history of the jobs.
+func NewRoutines(observationCtx *observation.Context, db database.DB, options Options) ([]goroutine.BackgroundRoutine, error) {
+	return newRoutines(observationCtx, db, redispool.Store, options, glock.NewRealClock())
+}
+
+func newRoutines(observationCtx *observation.Context, db database.DB, kv redispool.KeyValue, options Options, clock glock.Clock) ([]goroutine.BackgroundRoutine, error) {
+	if options.Name == "" {
+		return nil, errors.New("no name supplied to recurring.NewRoutines")
+	}
+	if options.Interval <= 0 {
+		options.Interval = 30 * time.Second
+	}
+	if options.NumHandlers <= 0 {
+		options.NumHandlers = 1
+	}
+	if options.HistoryRetention <= 0 {
+		options.HistoryRetention = 30 * 24 * time.Hour
+	}
+
+	jobs := make(map[string]scheduledJob, len(options.Jobs))
+	kinds := make([]string, 0, len(options.Jobs))
+	for _, job := range options.Jobs {
+		scheduledJob, err := newScheduledJob(job, clock.Now())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := jobs[job.Kind]; ok {
+			return nil, errors.Newf("duplicate job %q", job.Kind)
+		}
+		jobs[job.Kind] = scheduledJob
+		kinds = append(kinds, job.Kind)
+	}
+	sort.Strings(kinds)
+
+	observationCtx = observation.NewContext(observationCtx.Logger.Scoped("recurring"))
+	ctx := actor.WithInternalActor(context.Background())
+
+	runsEnqueued := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_" + options.Name + "_runs_enqueued_total",
+		Help: "The number of runs of recurring jobs enqueued by the scheduler.",
+	}, []string{"kind"})
+	observationCtx.Registerer.MustRegister(runsEnqueued)
+
+	scheduler := &scheduler{
+		store:            NewStore(observationCtx, db),
+		kv:               kv,
+		name:             options.Name,
+		jobs:             jobs,
+		kinds:            kinds,
+		interval:         options.Interval,
+		historyRetention: options.HistoryRetention,
+		clock:            clock,
+		logger:           observationCtx.Logger.Scoped("scheduler"),
+		runsEnqueued:     runsEnqueued,
+	}
+
+	workerStore := newWorkerStore(observationCtx, db, options.Name+"_store")
+	handler := &handler{jobs: jobs, kinds: kinds}
+
+	return []goroutine.BackgroundRoutine{
+		goroutine.NewPeriodicGoroutine(
+			ctx,
+			scheduler,
+			goroutine.WithName(options.Name+"_scheduler"),
+			goroutine.WithDescription("enqueues the runs of recurring jobs on their schedules"),
+			goroutine.WithInterval(options.Interval),
+		),
+		dbworker.NewWorker[*Run](ctx, workerStore, handler, workerutil.WorkerOptions{
+			Name:              options.Name + "_worker",
+			Interval:          time.Second,
+			NumHandlers:       options.NumHandlers,
+			HeartbeatInterval: 10 * time.Second,
+			Metrics:           workerutil.NewMetrics(observationCtx, options.Name+"_worker"),
+		}),
+		dbworker.NewResetter(observationCtx.Logger, workerStore, dbworker.ResetterOptions{
+			Name:     options.Name + "_resetter",
+			Interval: time.Minute,
+			Metrics:  dbworker.NewResetterMetrics(observationCtx, options.Name),
+		}),
+	}, nil
+}
+
+// handler runs the runs of a set of jobs with the handlers of their jobs.
+type handler struct {
+	jobs  map[string]scheduledJob
+	kinds []string
+}
+
+var (
+	_ workerutil.Handler[*Run]  = &handler{}
+	_ workerutil.WithPreDequeue = &handler{}
+)
+
+func (h *handler) PreDequeue(_ context.Context, _ log.Logger) (bool, any, error) {
+	// Only dequeue the runs of jobs this handler knows about, as other processes may run other
+	// sets of jobs.
+	return true, []*sqlf.Query{sqlf.Sprintf("r.kind = ANY(%s)", pq.Array(h.kinds))}, nil
+}
+
+func (h *handler) Handle(ctx context.Context, logger log.Logger, run *Run) error {
+	job, ok := h.jobs[run.Kind]
+	if !ok {
+		return errors.Newf("unknown recurring job %q", run.Kind)
+	}
+
+	logger = logger.With(log.String("kind", run.Kind), log.Time("scheduledAt", run.ScheduledAt))
+	return job.Handler.Handle(ctx, logger, run)
+}
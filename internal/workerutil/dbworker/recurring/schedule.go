@@ -0,0 +1,79 @@
+package recurring
+
+import (
+	"time"
+
+	"github.com/hashicorp/cronexpr"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// scheduledJob is a validated job with its parsed schedule.
+type scheduledJob struct {
+	Job
+	schedule *cronexpr.Expression
+}
+
+func newScheduledJob(job Job, now time.Time) (scheduledJob, error) {
+	if job.Kind == "" {
+		return scheduledJob{}, errors.New("missing kind")
+	}
+	if job.Handler == nil {
+		return scheduledJob{}, errors.Newf("job %q: missing handler", job.Kind)
+	}
+
+	schedule, err := cronexpr.Parse(job.Schedule)
+	if err != nil {
+		return scheduledJob{}, errors.Wrapf(err, "job %q: invalid schedule", job.Kind)
+	}
+	if schedule.Next(now.UTC()).IsZero() {
+		return scheduledJob{}, errors.Newf("job %q: schedule %q has no upcoming times", job.Kind, job.Schedule)
+	}
+
+	switch job.CatchUp {
+	case "":
+		job.CatchUp = CatchUpLatest
+	case CatchUpLatest, CatchUpAll, CatchUpSkip:
+	default:
+		return scheduledJob{}, errors.Newf("job %q: unknown catch-up policy %q", job.Kind, job.CatchUp)
+	}
+	if job.MaxCatchUp <= 0 {
+		job.MaxCatchUp = defaultMaxCatchUp
+	}
+
+	return scheduledJob{Job: job, schedule: schedule}, nil
+}
+
+// next returns the first schedule time of the job after the given time, or the zero time if the
+// schedule has no more times.
+func (j scheduledJob) next(t time.Time) time.Time {
+	return j.schedule.Next(t.UTC())
+}
+
+// dueTimes returns the schedule times of the job to enqueue at the given time, given the first
+// schedule time that has not been enqueued yet. It also returns the first schedule time after
+// now, which is the next time the job is due.
+//
+// Schedule times that passed more than the given tolerance ago are considered missed, and are
+// enqueued according to the catch-up policy of the job.
+func (j scheduledJob) dueTimes(next, now time.Time, tolerance time.Duration) (due []time.Time, following time.Time) {
+	limit := 1
+	if j.CatchUp == CatchUpAll {
+		limit = j.MaxCatchUp
+	}
+
+	t := next.UTC()
+	for !t.IsZero() && !t.After(now) {
+		due = append(due, t)
+		if len(due) > limit {
+			due = due[1:]
+		}
+		t = j.next(t)
+	}
+
+	if j.CatchUp == CatchUpSkip && len(due) > 0 && now.Sub(due[len(due)-1]) > tolerance {
+		due = nil
+	}
+
+	return due, t
+}
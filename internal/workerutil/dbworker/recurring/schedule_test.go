@@ -0,0 +1,146 @@
+package recurring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var noopHandler = HandlerFunc(func(context.Context, log.Logger, *Run) error { return nil })
+
+func TestNewScheduledJob(t *testing.T) {
+	now := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	job, err := newScheduledJob(Job{Kind: "test", Schedule: "@hourly", Handler: noopHandler}, now)
+	require.NoError(t, err)
+	assert.Equal(t, CatchUpLatest, job.CatchUp)
+	assert.Equal(t, defaultMaxCatchUp, job.MaxCatchUp)
+	assert.Equal(t, now.Add(time.Hour), job.next(now))
+
+	tests := []struct {
+		name        string
+		job         Job
+		expectedErr string
+	}{
+		{
+			name:        "No kind",
+			job:         Job{Schedule: "@hourly", Handler: noopHandler},
+			expectedErr: "missing kind",
+		},
+		{
+			name:        "No handler",
+			job:         Job{Kind: "test", Schedule: "@hourly"},
+			expectedErr: `job "test": missing handler`,
+		},
+		{
+			name:        "Invalid schedule",
+			job:         Job{Kind: "test", Schedule: "every hour", Handler: noopHandler},
+			expectedErr: `job "test": invalid schedule`,
+		},
+		{
+			name:        "No upcoming times",
+			job:         Job{Kind: "test", Schedule: "0 0 0 1 1 * 2020", Handler: noopHandler},
+			expectedErr: `job "test": schedule "0 0 0 1 1 * 2020" has no upcoming times`,
+		},
+		{
+			name:        "Unknown catch-up policy",
+			job:         Job{Kind: "test", Schedule: "@hourly", CatchUp: "some", Handler: noopHandler},
+			expectedErr: `job "test": unknown catch-up policy "some"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := newScheduledJob(test.job, now)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expectedErr)
+		})
+	}
+}
+
+func TestDueTimes(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2023, 12, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name         string
+		catchUp      CatchUpPolicy
+		maxCatchUp   int
+		next         time.Time
+		now          time.Time
+		expectedDue  []time.Time
+		expectedNext time.Time
+	}{
+		{
+			name:         "Not due",
+			catchUp:      CatchUpLatest,
+			next:         at(11, 0),
+			now:          at(10, 30),
+			expectedDue:  nil,
+			expectedNext: at(11, 0),
+		},
+		{
+			name:         "Due",
+			catchUp:      CatchUpSkip,
+			next:         at(10, 0),
+			now:          at(10, 0).Add(10 * time.Second),
+			expectedDue:  []time.Time{at(10, 0)},
+			expectedNext: at(11, 0),
+		},
+		{
+			name:         "Missed, latest",
+			catchUp:      CatchUpLatest,
+			next:         at(7, 0),
+			now:          at(10, 30),
+			expectedDue:  []time.Time{at(10, 0)},
+			expectedNext: at(11, 0),
+		},
+		{
+			name:         "Missed, all",
+			catchUp:      CatchUpAll,
+			next:         at(7, 0),
+			now:          at(10, 30),
+			expectedDue:  []time.Time{at(7, 0), at(8, 0), at(9, 0), at(10, 0)},
+			expectedNext: at(11, 0),
+		},
+		{
+			name:         "Missed, all beyond the maximum",
+			catchUp:      CatchUpAll,
+			maxCatchUp:   2,
+			next:         at(7, 0),
+			now:          at(10, 30),
+			expectedDue:  []time.Time{at(9, 0), at(10, 0)},
+			expectedNext: at(11, 0),
+		},
+		{
+			name:         "Missed, skip",
+			catchUp:      CatchUpSkip,
+			next:         at(7, 0),
+			now:          at(10, 30),
+			expectedDue:  nil,
+			expectedNext: at(11, 0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job, err := newScheduledJob(Job{
+				Kind:       "test",
+				Schedule:   "@hourly",
+				CatchUp:    test.catchUp,
+				MaxCatchUp: test.maxCatchUp,
+				Handler:    noopHandler,
+			}, test.now)
+			require.NoError(t, err)
+
+			due, next := job.dueTimes(test.next, test.now, time.Minute)
+			assert.Equal(t, test.expectedDue, due)
+			assert.Equal(t, test.expectedNext, next)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/redislock"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// schedulerLockTimeout is the duration after which the lock of a scheduler that stopped
+// without releasing it expires. A scheduler iteration must finish well within this duration.
+const schedulerLockTimeout = time.Minute
+
+// scheduler enqueues the runs of due jobs. Only the scheduler holding the Redis lock of its
+// name enqueues runs, the others skip their iteration.
+type scheduler struct {
+	store            Store
+	kv               redispool.KeyValue
+	name             string
+	jobs             map[string]scheduledJob
+	kinds            []string
+	interval         time.Duration
+	historyRetention time.Duration
+	clock            glock.Clock
+	logger           log.Logger
+	runsEnqueued     *prometheus.CounterVec
+}
+
+var _ goroutine.Handler = &scheduler{}
+
+func (s *scheduler) Handle(ctx context.Context) error {
+	acquired, release, err := redislock.TryAcquire(s.kv, "recurring-jobs-scheduler:"+s.name, schedulerLockTimeout)
+	if err != nil {
+		return errors.Wrap(err, "acquiring scheduler lock")
+	}
+	if !acquired {
+		// Another instance is enqueueing the runs of these jobs.
+		return nil
+	}
+	defer release()
+
+	now := s.clock.Now().UTC()
+
+	for _, kind := range s.kinds {
+		job := s.jobs[kind]
+		if err := s.store.RegisterJob(ctx, kind, job.Schedule, job.next(now)); err != nil {
+			return errors.Wrapf(err, "registering job %q", kind)
+		}
+	}
+
+	if err := s.enqueueDueRuns(ctx, now); err != nil {
+		return err
+	}
+
+	if _, err := s.store.DeleteFinishedRuns(ctx, s.kinds, now.Add(-s.historyRetention)); err != nil {
+		return errors.Wrap(err, "deleting finished runs")
+	}
+
+	return nil
+}
+
+func (s *scheduler) enqueueDueRuns(ctx context.Context, now time.Time) error {
+	return s.store.WithTransaction(ctx, func(tx Store) error {
+		dueJobs, err := tx.DueJobs(ctx, s.kinds, now)
+		if err != nil {
+			return errors.Wrap(err, "listing due jobs")
+		}
+
+		for _, dueJob := range dueJobs {
+			job := s.jobs[dueJob.Kind]
+
+			// A schedule time counts as missed once the next iteration could have enqueued it.
+			due, next := job.dueTimes(dueJob.NextScheduledAt, now, 2*s.interval)
+			if next.IsZero() {
+				return errors.Newf("job %q: schedule %q has no upcoming times", dueJob.Kind, job.Schedule)
+			}
+
+			count, err := tx.EnqueueRuns(ctx, dueJob.Kind, due, next)
+			if err != nil {
+				return errors.Wrapf(err, "enqueueing runs of job %q", dueJob.Kind)
+			}
+			s.runsEnqueued.WithLabelValues(dueJob.Kind).Add(float64(count))
+
+			if len(due) == 0 {
+				s.logger.Info("Skipped missed schedule times", log.String("kind", dueJob.Kind), log.Time("nextScheduledAt", next))
+			}
+		}
+
+		return nil
+	})
+}
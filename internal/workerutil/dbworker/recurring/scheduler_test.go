@@ -0,0 +1,125 @@
+package recurring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derision-test/glock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/redispool"
+)
+
+func TestScheduler(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	kv := redispool.NewMockKeyValue()
+	kv.SetNxFunc.SetDefaultReturn(true, nil)
+
+	start := time.Date(2023, 12, 1, 10, 30, 0, 0, time.UTC)
+	clock := glock.NewMockClockAt(start)
+
+	jobs := map[string]scheduledJob{}
+	for _, job := range []Job{
+		{Kind: "hourly", Schedule: "@hourly", Handler: noopHandler},
+		{Kind: "all", Schedule: "@hourly", CatchUp: CatchUpAll, Handler: noopHandler},
+		{Kind: "skip", Schedule: "@hourly", CatchUp: CatchUpSkip, Handler: noopHandler},
+	} {
+		scheduledJob, err := newScheduledJob(job, start)
+		require.NoError(t, err)
+		jobs[job.Kind] = scheduledJob
+	}
+
+	store := NewStore(observation.TestContextTB(t), db)
+	s := &scheduler{
+		store:            store,
+		kv:               kv,
+		name:             "test",
+		jobs:             jobs,
+		kinds:            []string{"all", "hourly", "skip"},
+		interval:         30 * time.Second,
+		historyRetention: 24 * time.Hour,
+		clock:            clock,
+		logger:           logger,
+		runsEnqueued:     prometheus.NewCounterVec(prometheus.CounterOpts{}, []string{"kind"}),
+	}
+
+	countRuns := func(kind string) int {
+		runs, err := store.ListRuns(ctx, ListRunsOptions{Kind: kind})
+		require.NoError(t, err)
+		return len(runs)
+	}
+
+	// Registering the jobs does not enqueue anything until the next schedule time.
+	require.NoError(t, s.Handle(ctx))
+	for _, kind := range s.kinds {
+		assert.Equal(t, 0, countRuns(kind), kind)
+	}
+
+	// Each job runs on time.
+	clock.SetCurrent(start.Add(30*time.Minute + 10*time.Second))
+	require.NoError(t, s.Handle(ctx))
+	require.NoError(t, s.Handle(ctx))
+	for _, kind := range s.kinds {
+		assert.Equal(t, 1, countRuns(kind), kind)
+	}
+
+	// The schedulers were down for three hours.
+	clock.SetCurrent(start.Add(3*time.Hour + 45*time.Minute))
+	require.NoError(t, s.Handle(ctx))
+	assert.Equal(t, 2, countRuns("hourly"))
+	assert.Equal(t, 4, countRuns("all"))
+	assert.Equal(t, 1, countRuns("skip"))
+
+	runs, err := store.ListRuns(ctx, ListRunsOptions{Kind: "hourly"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 12, 1, 14, 0, 0, 0, time.UTC), runs[0].ScheduledAt.UTC())
+	assert.Equal(t, "queued", runs[0].State)
+
+	// Another instance holds the lock.
+	kv.SetNxFunc.SetDefaultReturn(false, nil)
+	kv.GetFunc.SetDefaultReturn(redispool.NewValue([]byte("9223372036854775807,other"), nil))
+	clock.SetCurrent(start.Add(4*time.Hour + 31*time.Minute))
+	require.NoError(t, s.Handle(ctx))
+	assert.Equal(t, 2, countRuns("hourly"))
+}
+
+func TestStoreDeleteFinishedRuns(t *testing.T) {
+	logger := logtest.Scoped(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+	store := NewStore(observation.TestContextTB(t), db)
+
+	now := time.Now()
+	require.NoError(t, store.RegisterJob(ctx, "test", "@hourly", now))
+	require.NoError(t, store.RegisterJob(ctx, "other", "@hourly", now))
+
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO recurring_job_runs (kind, scheduled_at, state, finished_at)
+		VALUES
+			('test', NOW() - '3 day'::interval, 'completed', NOW() - '3 day'::interval),
+			('test', NOW() - '2 day'::interval, 'failed', NOW() - '2 day'::interval),
+			('test', NOW() - '1 hour'::interval, 'completed', NOW() - '1 hour'::interval),
+			('test', NOW(), 'queued', NULL),
+			('other', NOW() - '3 day'::interval, 'completed', NOW() - '3 day'::interval)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting runs: %s", err)
+	}
+
+	count, err := store.DeleteFinishedRuns(ctx, []string{"test"}, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	runs, err := store.ListRuns(ctx, ListRunsOptions{})
+	require.NoError(t, err)
+	assert.Len(t, runs, 3)
+}
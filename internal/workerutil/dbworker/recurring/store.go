@@ -0,0 +1,306 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// Store is the store of the recurring job schedule and of the runs of recurring jobs.
+type Store interface {
+	WithTransaction(ctx context.Context, f func(tx Store) error) error
+
+	// RegisterJob adds a job to the schedule, or updates the schedule of an existing job if it
+	// changed. The given next schedule time is only used for new jobs and jobs whose schedule
+	// changed.
+	RegisterJob(ctx context.Context, kind, schedule string, next time.Time) error
+
+	// DueJobs returns the jobs of the given kinds that are due at the given time, and locks them
+	// until the end of the transaction.
+	DueJobs(ctx context.Context, kinds []string, now time.Time) ([]ScheduledJob, error)
+
+	// EnqueueRuns enqueues a run of the given job for each of the given schedule times that has
+	// not been enqueued before, and sets the next time the job is due. It returns the number of
+	// enqueued runs.
+	EnqueueRuns(ctx context.Context, kind string, scheduledAt []time.Time, next time.Time) (int, error)
+
+	// ListRuns returns the runs matching the given options, most recently scheduled first.
+	ListRuns(ctx context.Context, opts ListRunsOptions) ([]*Run, error)
+
+	// DeleteFinishedRuns deletes the runs of the given kinds that finished before the given time,
+	// and returns the number of deleted runs.
+	DeleteFinishedRuns(ctx context.Context, kinds []string, before time.Time) (int, error)
+}
+
+// ScheduledJob is the schedule of a job as recorded in the database.
+type ScheduledJob struct {
+	Kind            string
+	Schedule        string
+	NextScheduledAt time.Time
+}
+
+// ListRunsOptions filters the runs returned by Store.ListRuns.
+type ListRunsOptions struct {
+	// Kind, when set, only matches runs of the given job.
+	Kind string
+	// State, when set, only matches runs in the given state.
+	State string
+
+	Limit int
+}
+
+type store struct {
+	db         *basestore.Store
+	operations *operations
+}
+
+// NewStore creates a new Store.
+func NewStore(observationCtx *observation.Context, db database.DB) Store {
+	return &store{
+		db:         basestore.NewWithHandle(db.Handle()),
+		operations: newOperations(observationCtx),
+	}
+}
+
+func (s *store) WithTransaction(ctx context.Context, f func(s Store) error) error {
+	return basestore.InTransaction[*store](ctx, s, func(s *store) error { return f(s) })
+}
+
+func (s *store) Transact(ctx context.Context) (*store, error) {
+	tx, err := s.db.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &store{
+		db:         tx,
+		operations: s.operations,
+	}, nil
+}
+
+func (s *store) Done(err error) error {
+	return s.db.Done(err)
+}
+
+func (s *store) RegisterJob(ctx context.Context, kind, schedule string, next time.Time) (err error) {
+	ctx, _, endObservation := s.operations.registerJob.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("kind", kind),
+		attribute.String("schedule", schedule),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.db.Exec(ctx, sqlf.Sprintf(registerJobQuery, kind, schedule, next))
+}
+
+const registerJobQuery = `
+INSERT INTO recurring_jobs (kind, schedule, next_scheduled_at)
+VALUES (%s, %s, %s)
+ON CONFLICT (kind) DO UPDATE SET
+	schedule = EXCLUDED.schedule,
+	next_scheduled_at = EXCLUDED.next_scheduled_at,
+	updated_at = NOW()
+WHERE recurring_jobs.schedule != EXCLUDED.schedule
+`
+
+func (s *store) DueJobs(ctx context.Context, kinds []string, now time.Time) (_ []ScheduledJob, err error) {
+	ctx, _, endObservation := s.operations.dueJobs.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.StringSlice("kinds", kinds),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanScheduledJobs(s.db.Query(ctx, sqlf.Sprintf(dueJobsQuery, pq.Array(kinds), now)))
+}
+
+const dueJobsQuery = `
+SELECT kind, schedule, next_scheduled_at
+FROM recurring_jobs
+WHERE kind = ANY(%s) AND next_scheduled_at <= %s
+ORDER BY kind
+FOR UPDATE SKIP LOCKED
+`
+
+var scanScheduledJobs = basestore.NewSliceScanner(func(s dbutil.Scanner) (job ScheduledJob, _ error) {
+	err := s.Scan(&job.Kind, &job.Schedule, &job.NextScheduledAt)
+	return job, err
+})
+
+func (s *store) EnqueueRuns(ctx context.Context, kind string, scheduledAt []time.Time, next time.Time) (_ int, err error) {
+	ctx, trace, endObservation := s.operations.enqueueRuns.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("kind", kind),
+		attribute.Int("numScheduledAt", len(scheduledAt)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	var count int
+	if len(scheduledAt) > 0 {
+		values := make([]*sqlf.Query, 0, len(scheduledAt))
+		for _, t := range scheduledAt {
+			values = append(values, sqlf.Sprintf("(%s, %s)", kind, t))
+		}
+
+		count, _, err = basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(enqueueRunsQuery, sqlf.Join(values, ", "))))
+		if err != nil {
+			return 0, err
+		}
+	}
+	trace.AddEvent("EnqueueRuns", attribute.Int("numEnqueued", count))
+
+	if err := s.db.Exec(ctx, sqlf.Sprintf(updateNextScheduledAtQuery, next, kind)); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+const enqueueRunsQuery = `
+WITH inserted AS (
+	INSERT INTO recurring_job_runs (kind, scheduled_at)
+	VALUES %s
+	ON CONFLICT (kind, scheduled_at) DO NOTHING
+	RETURNING id
+)
+SELECT COUNT(*) FROM inserted
+`
+
+const updateNextScheduledAtQuery = `
+UPDATE recurring_jobs
+SET next_scheduled_at = %s, updated_at = NOW()
+WHERE kind = %s
+`
+
+func (s *store) ListRuns(ctx context.Context, opts ListRunsOptions) (_ []*Run, err error) {
+	ctx, _, endObservation := s.operations.listRuns.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.String("kind", opts.Kind),
+		attribute.String("state", opts.State),
+		attribute.Int("limit", opts.Limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.Kind != "" {
+		conds = append(conds, sqlf.Sprintf("r.kind = %s", opts.Kind))
+	}
+	if opts.State != "" {
+		conds = append(conds, sqlf.Sprintf("r.state = %s", opts.State))
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	return scanRuns(s.db.Query(ctx, sqlf.Sprintf(
+		listRunsQuery,
+		sqlf.Join(runColumns, ", "),
+		sqlf.Join(conds, " AND "),
+		limit,
+	)))
+}
+
+const listRunsQuery = `
+SELECT %s
+FROM recurring_job_runs r
+WHERE %s
+ORDER BY r.scheduled_at DESC, r.id DESC
+LIMIT %s
+`
+
+func (s *store) DeleteFinishedRuns(ctx context.Context, kinds []string, before time.Time) (_ int, err error) {
+	ctx, trace, endObservation := s.operations.deleteFinishedRuns.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.StringSlice("kinds", kinds),
+		attribute.Stringer("before", before),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err := basestore.ScanFirstInt(s.db.Query(ctx, sqlf.Sprintf(deleteFinishedRunsQuery, pq.Array(kinds), before)))
+	trace.AddEvent("DeleteFinishedRuns", attribute.Int("numDeleted", count))
+	return count, err
+}
+
+const deleteFinishedRunsQuery = `
+WITH deleted AS (
+	DELETE FROM recurring_job_runs
+	WHERE
+		kind = ANY(%s) AND
+		state IN ('completed', 'failed', 'canceled') AND
+		finished_at < %s
+	RETURNING id
+)
+SELECT COUNT(*) FROM deleted
+`
+
+// workerStoreOptions are the options of the dbworker store of the runs of recurring jobs.
+var workerStoreOptions = dbworkerstore.Options[*Run]{
+	TableName:         "recurring_job_runs",
+	ViewName:          "recurring_job_runs r",
+	ColumnExpressions: runColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(scanRun),
+	OrderByExpression: sqlf.Sprintf("r.scheduled_at, r.id"),
+	StalledMaxAge:     time.Minute,
+	MaxNumResets:      3,
+	RetryAfter:        time.Minute,
+	MaxNumRetries:     3,
+}
+
+// newWorkerStore creates a dbworker store that wraps the recurring_job_runs table.
+func newWorkerStore(observationCtx *observation.Context, db database.DB, name string) dbworkerstore.Store[*Run] {
+	options := workerStoreOptions
+	options.Name = name
+	return dbworkerstore.New(observationCtx, db.Handle(), options)
+}
+
+var runColumns = []*sqlf.Query{
+	sqlf.Sprintf("r.id"),
+	sqlf.Sprintf("r.state"),
+	sqlf.Sprintf("r.failure_message"),
+	sqlf.Sprintf("r.queued_at"),
+	sqlf.Sprintf("r.started_at"),
+	sqlf.Sprintf("r.finished_at"),
+	sqlf.Sprintf("r.process_after"),
+	sqlf.Sprintf("r.num_resets"),
+	sqlf.Sprintf("r.num_failures"),
+	sqlf.Sprintf("r.last_heartbeat_at"),
+	sqlf.Sprintf("r.execution_logs"),
+	sqlf.Sprintf("r.worker_hostname"),
+	sqlf.Sprintf("r.cancel"),
+	sqlf.Sprintf("r.kind"),
+	sqlf.Sprintf("r.scheduled_at"),
+}
+
+func scanRun(s dbutil.Scanner) (*Run, error) {
+	var run Run
+	var executionLogs []executor.ExecutionLogEntry
+	if err := s.Scan(
+		&run.ID,
+		&run.State,
+		&run.FailureMessage,
+		&run.QueuedAt,
+		&run.StartedAt,
+		&run.FinishedAt,
+		&run.ProcessAfter,
+		&run.NumResets,
+		&run.NumFailures,
+		&dbutil.NullTime{Time: &run.LastHeartbeatAt},
+		pq.Array(&executionLogs),
+		&run.WorkerHostname,
+		&run.Cancel,
+		&run.Kind,
+		&run.ScheduledAt,
+	); err != nil {
+		return nil, err
+	}
+
+	run.ExecutionLogs = append(run.ExecutionLogs, executionLogs...)
+	return &run, nil
+}
+
+var scanRuns = basestore.NewSliceScanner(scanRun)
@@ -0,0 +1,212 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// FailedRecord describes a record that has been moved to the failed state, either because it
+// failed more often than the store retries records or because it was reset too many times.
+type FailedRecord struct {
+	ID             int
+	FailureMessage string
+	NumFailures    int
+	NumResets      int
+	QueuedAt       *time.Time
+	FinishedAt     *time.Time
+}
+
+// FailedRecordsOptions selects the failed records of a store.
+type FailedRecordsOptions struct {
+	// FailureMessage, if set, only selects records whose failure message contains the given
+	// text, ignoring case.
+	FailureMessage string
+
+	// FailedBefore, if set, only selects records that failed before the given time.
+	FailedBefore time.Time
+
+	// Limit, if positive, is the maximum number of records that are listed, requeued, or deleted.
+	Limit int
+}
+
+// DeadLetterStore is the part of a Store that manages its failed records. It does not depend on
+// the type of the records, so that the failed records of different stores can be managed alike.
+type DeadLetterStore interface {
+	CountFailed(ctx context.Context, opts FailedRecordsOptions) (int, error)
+	ListFailed(ctx context.Context, opts FailedRecordsOptions) ([]FailedRecord, error)
+	RequeueFailed(ctx context.Context, opts FailedRecordsOptions) (int, error)
+	DeleteFailed(ctx context.Context, opts FailedRecordsOptions) (int, error)
+}
+
+func (o *FailedRecordsOptions) ToSQLConds(formatQuery func(query string, args ...any) *sqlf.Query) []*sqlf.Query {
+	conds := []*sqlf.Query{formatQuery("{state} = 'failed'")}
+	if o.FailureMessage != "" {
+		conds = append(conds, formatQuery("strpos(lower({failure_message}), lower(%s)) > 0", o.FailureMessage))
+	}
+	if !o.FailedBefore.IsZero() {
+		conds = append(conds, formatQuery("{finished_at} < %s", o.FailedBefore))
+	}
+	return conds
+}
+
+func (o *FailedRecordsOptions) limitClause() *sqlf.Query {
+	if o.Limit <= 0 {
+		return sqlf.Sprintf("")
+	}
+	return sqlf.Sprintf("LIMIT %s", o.Limit)
+}
+
+func (o *FailedRecordsOptions) attrs() []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("failureMessage", o.FailureMessage),
+		attribute.Stringer("failedBefore", o.FailedBefore),
+		attribute.Int("limit", o.Limit),
+	}
+}
+
+// CountFailed returns the number of failed records matching the given options.
+func (s *store[T]) CountFailed(ctx context.Context, opts FailedRecordsOptions) (_ int, err error) {
+	ctx, _, endObservation := s.operations.countFailed.With(ctx, &err, observation.Args{Attrs: opts.attrs()})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, s.formatQuery(
+		countFailedQuery,
+		quote(s.options.TableName),
+		sqlf.Join(opts.ToSQLConds(s.formatQuery), "AND"),
+	)))
+	return count, err
+}
+
+const countFailedQuery = `
+SELECT COUNT(*)
+FROM %s
+WHERE %s
+`
+
+// ListFailed returns the failed records matching the given options, most recently failed first.
+func (s *store[T]) ListFailed(ctx context.Context, opts FailedRecordsOptions) (_ []FailedRecord, err error) {
+	ctx, _, endObservation := s.operations.listFailed.With(ctx, &err, observation.Args{Attrs: opts.attrs()})
+	defer endObservation(1, observation.Args{})
+
+	return scanFailedRecords(s.Query(ctx, s.formatQuery(
+		listFailedQuery,
+		quote(s.options.TableName),
+		sqlf.Join(opts.ToSQLConds(s.formatQuery), "AND"),
+		opts.limitClause(),
+	)))
+}
+
+const listFailedQuery = `
+SELECT
+	{id},
+	{failure_message},
+	{num_failures},
+	{num_resets},
+	{queued_at},
+	{finished_at}
+FROM %s
+WHERE %s
+ORDER BY {finished_at} DESC NULLS LAST, {id} DESC
+%s
+`
+
+var scanFailedRecords = basestore.NewSliceScanner(func(s dbutil.Scanner) (record FailedRecord, _ error) {
+	var failureMessage sql.NullString
+	err := s.Scan(
+		&record.ID,
+		&failureMessage,
+		&record.NumFailures,
+		&record.NumResets,
+		&record.QueuedAt,
+		&record.FinishedAt,
+	)
+	record.FailureMessage = failureMessage.String
+	return record, err
+})
+
+// RequeueFailed moves the failed records matching the given options back to the queued state and
+// resets their failure and reset counters, so that they are retried as if they were new. The
+// execution logs of previous attempts are kept. This method returns the number of requeued records.
+func (s *store[T]) RequeueFailed(ctx context.Context, opts FailedRecordsOptions) (_ int, err error) {
+	ctx, trace, endObservation := s.operations.requeueFailed.With(ctx, &err, observation.Args{Attrs: opts.attrs()})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, s.formatQuery(
+		requeueFailedQuery,
+		quote(s.options.TableName),
+		sqlf.Join(opts.ToSQLConds(s.formatQuery), "AND"),
+		opts.limitClause(),
+		quote(s.options.TableName),
+	)))
+	trace.AddEvent("RequeueFailed", attribute.Int("numRequeued", count))
+
+	return count, err
+}
+
+const requeueFailedQuery = `
+WITH candidates AS (
+	SELECT {id} FROM %s
+	WHERE %s
+	ORDER BY {id}
+	%s
+	FOR UPDATE SKIP LOCKED
+),
+requeued AS (
+	UPDATE %s
+	SET
+		{state} = 'queued',
+		{queued_at} = clock_timestamp(),
+		{started_at} = null,
+		{finished_at} = null,
+		{process_after} = null,
+		{failure_message} = null,
+		{num_failures} = 0,
+		{num_resets} = 0,
+		{cancel} = false
+	WHERE {id} IN (SELECT {id} FROM candidates)
+	RETURNING {id}
+)
+SELECT COUNT(*) FROM requeued
+`
+
+// DeleteFailed deletes the failed records matching the given options. This method returns the
+// number of deleted records.
+func (s *store[T]) DeleteFailed(ctx context.Context, opts FailedRecordsOptions) (_ int, err error) {
+	ctx, trace, endObservation := s.operations.deleteFailed.With(ctx, &err, observation.Args{Attrs: opts.attrs()})
+	defer endObservation(1, observation.Args{})
+
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, s.formatQuery(
+		deleteFailedQuery,
+		quote(s.options.TableName),
+		sqlf.Join(opts.ToSQLConds(s.formatQuery), "AND"),
+		opts.limitClause(),
+		quote(s.options.TableName),
+	)))
+	trace.AddEvent("DeleteFailed", attribute.Int("numDeleted", count))
+
+	return count, err
+}
+
+const deleteFailedQuery = `
+WITH candidates AS (
+	SELECT {id} FROM %s
+	WHERE %s
+	ORDER BY {id}
+	%s
+	FOR UPDATE SKIP LOCKED
+),
+deleted AS (
+	DELETE FROM %s
+	WHERE {id} IN (SELECT {id} FROM candidates)
+	RETURNING {id}
+)
+SELECT COUNT(*) FROM deleted
+`
@@ -26,6 +26,12 @@ type MockStore[T workerutil.Record] struct {
 	// AddExecutionLogEntryFunc is an instance of a mock function object
 	// controlling the behavior of the method AddExecutionLogEntry.
 	AddExecutionLogEntryFunc *StoreAddExecutionLogEntryFunc[T]
+	// CountFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method CountFailed.
+	CountFailedFunc *StoreCountFailedFunc[T]
+	// DeleteFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method DeleteFailed.
+	DeleteFailedFunc *StoreDeleteFailedFunc[T]
 	// DequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Dequeue.
 	DequeueFunc *StoreDequeueFunc[T]
@@ -35,6 +41,12 @@ type MockStore[T workerutil.Record] struct {
 	// HeartbeatFunc is an instance of a mock function object controlling
 	// the behavior of the method Heartbeat.
 	HeartbeatFunc *StoreHeartbeatFunc[T]
+	// ListDequeueCandidatesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListDequeueCandidates.
+	ListDequeueCandidatesFunc *StoreListDequeueCandidatesFunc[T]
+	// ListFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method ListFailed.
+	ListFailedFunc *StoreListFailedFunc[T]
 	// MarkCompleteFunc is an instance of a mock function object controlling
 	// the behavior of the method MarkComplete.
 	MarkCompleteFunc *StoreMarkCompleteFunc[T]
@@ -47,12 +59,18 @@ type MockStore[T workerutil.Record] struct {
 	// MaxDurationInQueueFunc is an instance of a mock function object
 	// controlling the behavior of the method MaxDurationInQueue.
 	MaxDurationInQueueFunc *StoreMaxDurationInQueueFunc[T]
+	// QueueStatisticsFunc is an instance of a mock function object controlling
+	// the behavior of the method QueueStatistics.
+	QueueStatisticsFunc *StoreQueueStatisticsFunc[T]
 	// QueuedCountFunc is an instance of a mock function object controlling
 	// the behavior of the method QueuedCount.
 	QueuedCountFunc *StoreQueuedCountFunc[T]
 	// RequeueFunc is an instance of a mock function object controlling the
 	// behavior of the method Requeue.
 	RequeueFunc *StoreRequeueFunc[T]
+	// RequeueFailedFunc is an instance of a mock function object controlling the
+	// behavior of the method RequeueFailed.
+	RequeueFailedFunc *StoreRequeueFailedFunc[T]
 	// ResetStalledFunc is an instance of a mock function object controlling
 	// the behavior of the method ResetStalled.
 	ResetStalledFunc *StoreResetStalledFunc[T]
@@ -73,6 +91,16 @@ func NewMockStore[T workerutil.Record]() *MockStore[T] {
 				return
 			},
 		},
+		CountFailedFunc: &StoreCountFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		DeleteFailedFunc: &StoreDeleteFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
 		DequeueFunc: &StoreDequeueFunc[T]{
 			defaultHook: func(context.Context, string, []*sqlf.Query) (r0 T, r1 bool, r2 error) {
 				return
@@ -88,6 +116,16 @@ func NewMockStore[T workerutil.Record]() *MockStore[T] {
 				return
 			},
 		},
+		ListDequeueCandidatesFunc: &StoreListDequeueCandidatesFunc[T]{
+			defaultHook: func(context.Context, int) (r0 []store.DequeueCandidate, r1 error) {
+				return
+			},
+		},
+		ListFailedFunc: &StoreListFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) (r0 []store.FailedRecord, r1 error) {
+				return
+			},
+		},
 		MarkCompleteFunc: &StoreMarkCompleteFunc[T]{
 			defaultHook: func(context.Context, int, store.MarkFinalOptions) (r0 bool, r1 error) {
 				return
@@ -108,6 +146,11 @@ func NewMockStore[T workerutil.Record]() *MockStore[T] {
 				return
 			},
 		},
+		QueueStatisticsFunc: &StoreQueueStatisticsFunc[T]{
+			defaultHook: func(context.Context, time.Duration) (r0 store.QueueStatistics, r1 error) {
+				return
+			},
+		},
 		QueuedCountFunc: &StoreQueuedCountFunc[T]{
 			defaultHook: func(context.Context, bool) (r0 int, r1 error) {
 				return
@@ -118,6 +161,11 @@ func NewMockStore[T workerutil.Record]() *MockStore[T] {
 				return
 			},
 		},
+		RequeueFailedFunc: &StoreRequeueFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) (r0 int, r1 error) {
+				return
+			},
+		},
 		ResetStalledFunc: &StoreResetStalledFunc[T]{
 			defaultHook: func(context.Context) (r0 map[int]time.Duration, r1 map[int]time.Duration, r2 error) {
 				return
@@ -145,6 +193,16 @@ func NewStrictMockStore[T workerutil.Record]() *MockStore[T] {
 				panic("unexpected invocation of MockStore.AddExecutionLogEntry")
 			},
 		},
+		CountFailedFunc: &StoreCountFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockStore.CountFailed")
+			},
+		},
+		DeleteFailedFunc: &StoreDeleteFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockStore.DeleteFailed")
+			},
+		},
 		DequeueFunc: &StoreDequeueFunc[T]{
 			defaultHook: func(context.Context, string, []*sqlf.Query) (T, bool, error) {
 				panic("unexpected invocation of MockStore.Dequeue")
@@ -160,6 +218,16 @@ func NewStrictMockStore[T workerutil.Record]() *MockStore[T] {
 				panic("unexpected invocation of MockStore.Heartbeat")
 			},
 		},
+		ListDequeueCandidatesFunc: &StoreListDequeueCandidatesFunc[T]{
+			defaultHook: func(context.Context, int) ([]store.DequeueCandidate, error) {
+				panic("unexpected invocation of MockStore.ListDequeueCandidates")
+			},
+		},
+		ListFailedFunc: &StoreListFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error) {
+				panic("unexpected invocation of MockStore.ListFailed")
+			},
+		},
 		MarkCompleteFunc: &StoreMarkCompleteFunc[T]{
 			defaultHook: func(context.Context, int, store.MarkFinalOptions) (bool, error) {
 				panic("unexpected invocation of MockStore.MarkComplete")
@@ -180,6 +248,11 @@ func NewStrictMockStore[T workerutil.Record]() *MockStore[T] {
 				panic("unexpected invocation of MockStore.MaxDurationInQueue")
 			},
 		},
+		QueueStatisticsFunc: &StoreQueueStatisticsFunc[T]{
+			defaultHook: func(context.Context, time.Duration) (store.QueueStatistics, error) {
+				panic("unexpected invocation of MockStore.QueueStatistics")
+			},
+		},
 		QueuedCountFunc: &StoreQueuedCountFunc[T]{
 			defaultHook: func(context.Context, bool) (int, error) {
 				panic("unexpected invocation of MockStore.QueuedCount")
@@ -190,6 +263,11 @@ func NewStrictMockStore[T workerutil.Record]() *MockStore[T] {
 				panic("unexpected invocation of MockStore.Requeue")
 			},
 		},
+		RequeueFailedFunc: &StoreRequeueFailedFunc[T]{
+			defaultHook: func(context.Context, store.FailedRecordsOptions) (int, error) {
+				panic("unexpected invocation of MockStore.RequeueFailed")
+			},
+		},
 		ResetStalledFunc: &StoreResetStalledFunc[T]{
 			defaultHook: func(context.Context) (map[int]time.Duration, map[int]time.Duration, error) {
 				panic("unexpected invocation of MockStore.ResetStalled")
@@ -215,6 +293,12 @@ func NewMockStoreFrom[T workerutil.Record](i store.Store[T]) *MockStore[T] {
 		AddExecutionLogEntryFunc: &StoreAddExecutionLogEntryFunc[T]{
 			defaultHook: i.AddExecutionLogEntry,
 		},
+		CountFailedFunc: &StoreCountFailedFunc[T]{
+			defaultHook: i.CountFailed,
+		},
+		DeleteFailedFunc: &StoreDeleteFailedFunc[T]{
+			defaultHook: i.DeleteFailed,
+		},
 		DequeueFunc: &StoreDequeueFunc[T]{
 			defaultHook: i.Dequeue,
 		},
@@ -224,6 +308,12 @@ func NewMockStoreFrom[T workerutil.Record](i store.Store[T]) *MockStore[T] {
 		HeartbeatFunc: &StoreHeartbeatFunc[T]{
 			defaultHook: i.Heartbeat,
 		},
+		ListDequeueCandidatesFunc: &StoreListDequeueCandidatesFunc[T]{
+			defaultHook: i.ListDequeueCandidates,
+		},
+		ListFailedFunc: &StoreListFailedFunc[T]{
+			defaultHook: i.ListFailed,
+		},
 		MarkCompleteFunc: &StoreMarkCompleteFunc[T]{
 			defaultHook: i.MarkComplete,
 		},
@@ -236,12 +326,18 @@ func NewMockStoreFrom[T workerutil.Record](i store.Store[T]) *MockStore[T] {
 		MaxDurationInQueueFunc: &StoreMaxDurationInQueueFunc[T]{
 			defaultHook: i.MaxDurationInQueue,
 		},
+		QueueStatisticsFunc: &StoreQueueStatisticsFunc[T]{
+			defaultHook: i.QueueStatistics,
+		},
 		QueuedCountFunc: &StoreQueuedCountFunc[T]{
 			defaultHook: i.QueuedCount,
 		},
 		RequeueFunc: &StoreRequeueFunc[T]{
 			defaultHook: i.Requeue,
 		},
+		RequeueFailedFunc: &StoreRequeueFailedFunc[T]{
+			defaultHook: i.RequeueFailed,
+		},
 		ResetStalledFunc: &StoreResetStalledFunc[T]{
 			defaultHook: i.ResetStalled,
 		},
@@ -368,6 +464,216 @@ func (c StoreAddExecutionLogEntryFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreCountFailedFunc describes the behavior when the CountFailed method of
+// the parent MockStore instance is invoked.
+type StoreCountFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store.FailedRecordsOptions) (int, error)
+	history     []StoreCountFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// CountFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockStore[T]) CountFailed(v0 context.Context, v1 store.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.CountFailedFunc.nextHook()(v0, v1)
+	m.CountFailedFunc.appendCall(StoreCountFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountFailed method of
+// the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreCountFailedFunc[T]) SetDefaultHook(hook func(context.Context, store.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountFailed method of the parent MockStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreCountFailedFunc[T]) PushHook(hook func(context.Context, store.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreCountFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreCountFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreCountFailedFunc[T]) nextHook() func(context.Context, store.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreCountFailedFunc[T]) appendCall(r0 StoreCountFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreCountFailedFuncCall objects describing
+// the invocations of this function.
+func (f *StoreCountFailedFunc[T]) History() []StoreCountFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreCountFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreCountFailedFuncCall is an object that describes an invocation of
+// method CountFailed on an instance of MockStore.
+type StoreCountFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreCountFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreCountFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreDeleteFailedFunc describes the behavior when the DeleteFailed method
+// of the parent MockStore instance is invoked.
+type StoreDeleteFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store.FailedRecordsOptions) (int, error)
+	history     []StoreDeleteFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// DeleteFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockStore[T]) DeleteFailed(v0 context.Context, v1 store.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.DeleteFailedFunc.nextHook()(v0, v1)
+	m.DeleteFailedFunc.appendCall(StoreDeleteFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DeleteFailed method
+// of the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreDeleteFailedFunc[T]) SetDefaultHook(hook func(context.Context, store.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteFailed method of the parent MockStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreDeleteFailedFunc[T]) PushHook(hook func(context.Context, store.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreDeleteFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreDeleteFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreDeleteFailedFunc[T]) nextHook() func(context.Context, store.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreDeleteFailedFunc[T]) appendCall(r0 StoreDeleteFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreDeleteFailedFuncCall objects describing
+// the invocations of this function.
+func (f *StoreDeleteFailedFunc[T]) History() []StoreDeleteFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreDeleteFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreDeleteFailedFuncCall is an object that describes an invocation of
+// method DeleteFailed on an instance of MockStore.
+type StoreDeleteFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreDeleteFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreDeleteFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreDequeueFunc describes the behavior when the Dequeue method of the
 // parent MockStore instance is invoked.
 type StoreDequeueFunc[T workerutil.Record] struct {
@@ -692,6 +998,217 @@ func (c StoreHeartbeatFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// StoreListDequeueCandidatesFunc describes the behavior when the
+// ListDequeueCandidates method of the parent MockStore instance is invoked.
+type StoreListDequeueCandidatesFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, int) ([]store.DequeueCandidate, error)
+	hooks       []func(context.Context, int) ([]store.DequeueCandidate, error)
+	history     []StoreListDequeueCandidatesFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// ListDequeueCandidates delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore[T]) ListDequeueCandidates(v0 context.Context, v1 int) ([]store.DequeueCandidate, error) {
+	r0, r1 := m.ListDequeueCandidatesFunc.nextHook()(v0, v1)
+	m.ListDequeueCandidatesFunc.appendCall(StoreListDequeueCandidatesFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListDequeueCandidates
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreListDequeueCandidatesFunc[T]) SetDefaultHook(hook func(context.Context, int) ([]store.DequeueCandidate, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListDequeueCandidates method of the parent MockStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *StoreListDequeueCandidatesFunc[T]) PushHook(hook func(context.Context, int) ([]store.DequeueCandidate, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreListDequeueCandidatesFunc[T]) SetDefaultReturn(r0 []store.DequeueCandidate, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]store.DequeueCandidate, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreListDequeueCandidatesFunc[T]) PushReturn(r0 []store.DequeueCandidate, r1 error) {
+	f.PushHook(func(context.Context, int) ([]store.DequeueCandidate, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreListDequeueCandidatesFunc[T]) nextHook() func(context.Context, int) ([]store.DequeueCandidate, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreListDequeueCandidatesFunc[T]) appendCall(r0 StoreListDequeueCandidatesFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreListDequeueCandidatesFuncCall objects
+// describing the invocations of this function.
+func (f *StoreListDequeueCandidatesFunc[T]) History() []StoreListDequeueCandidatesFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreListDequeueCandidatesFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreListDequeueCandidatesFuncCall is an object that describes an
+// invocation of method ListDequeueCandidates on an instance of MockStore.
+type StoreListDequeueCandidatesFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []store.DequeueCandidate
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreListDequeueCandidatesFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreListDequeueCandidatesFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// StoreListFailedFunc describes the behavior when the ListFailed method of
+// the parent MockStore instance is invoked.
+type StoreListFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error)
+	hooks       []func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error)
+	history     []StoreListFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// ListFailed delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockStore[T]) ListFailed(v0 context.Context, v1 store.FailedRecordsOptions) ([]store.FailedRecord, error) {
+	r0, r1 := m.ListFailedFunc.nextHook()(v0, v1)
+	m.ListFailedFunc.appendCall(StoreListFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListFailed method of
+// the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreListFailedFunc[T]) SetDefaultHook(hook func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListFailed method of the parent MockStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *StoreListFailedFunc[T]) PushHook(hook func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreListFailedFunc[T]) SetDefaultReturn(r0 []store.FailedRecord, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreListFailedFunc[T]) PushReturn(r0 []store.FailedRecord, r1 error) {
+	f.PushHook(func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreListFailedFunc[T]) nextHook() func(context.Context, store.FailedRecordsOptions) ([]store.FailedRecord, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreListFailedFunc[T]) appendCall(r0 StoreListFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreListFailedFuncCall objects describing
+// the invocations of this function.
+func (f *StoreListFailedFunc[T]) History() []StoreListFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreListFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreListFailedFuncCall is an object that describes an invocation of
+// method ListFailed on an instance of MockStore.
+type StoreListFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []store.FailedRecord
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreListFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreListFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreMarkCompleteFunc describes the behavior when the MarkComplete method
 // of the parent MockStore instance is invoked.
 type StoreMarkCompleteFunc[T workerutil.Record] struct {
@@ -1133,6 +1650,112 @@ func (c StoreMaxDurationInQueueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// StoreQueueStatisticsFunc describes the behavior when the QueueStatistics
+// method of the parent MockStore instance is invoked.
+type StoreQueueStatisticsFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, time.Duration) (store.QueueStatistics, error)
+	hooks       []func(context.Context, time.Duration) (store.QueueStatistics, error)
+	history     []StoreQueueStatisticsFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// QueueStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockStore[T]) QueueStatistics(v0 context.Context, v1 time.Duration) (store.QueueStatistics, error) {
+	r0, r1 := m.QueueStatisticsFunc.nextHook()(v0, v1)
+	m.QueueStatisticsFunc.appendCall(StoreQueueStatisticsFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueueStatistics
+// method of the parent MockStore instance is invoked and the hook queue is
+// empty.
+func (f *StoreQueueStatisticsFunc[T]) SetDefaultHook(hook func(context.Context, time.Duration) (store.QueueStatistics, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueueStatistics method of the parent MockStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreQueueStatisticsFunc[T]) PushHook(hook func(context.Context, time.Duration) (store.QueueStatistics, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreQueueStatisticsFunc[T]) SetDefaultReturn(r0 store.QueueStatistics, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration) (store.QueueStatistics, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreQueueStatisticsFunc[T]) PushReturn(r0 store.QueueStatistics, r1 error) {
+	f.PushHook(func(context.Context, time.Duration) (store.QueueStatistics, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreQueueStatisticsFunc[T]) nextHook() func(context.Context, time.Duration) (store.QueueStatistics, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreQueueStatisticsFunc[T]) appendCall(r0 StoreQueueStatisticsFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreQueueStatisticsFuncCall objects
+// describing the invocations of this function.
+func (f *StoreQueueStatisticsFunc[T]) History() []StoreQueueStatisticsFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreQueueStatisticsFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreQueueStatisticsFuncCall is an object that describes an invocation of
+// method QueueStatistics on an instance of MockStore.
+type StoreQueueStatisticsFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 time.Duration
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 store.QueueStatistics
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreQueueStatisticsFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreQueueStatisticsFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreQueuedCountFunc describes the behavior when the QueuedCount method
 // of the parent MockStore instance is invoked.
 type StoreQueuedCountFunc[T workerutil.Record] struct {
@@ -1347,6 +1970,111 @@ func (c StoreRequeueFuncCall[T]) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// StoreRequeueFailedFunc describes the behavior when the RequeueFailed
+// method of the parent MockStore instance is invoked.
+type StoreRequeueFailedFunc[T workerutil.Record] struct {
+	defaultHook func(context.Context, store.FailedRecordsOptions) (int, error)
+	hooks       []func(context.Context, store.FailedRecordsOptions) (int, error)
+	history     []StoreRequeueFailedFuncCall[T]
+	mutex       sync.Mutex
+}
+
+// RequeueFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockStore[T]) RequeueFailed(v0 context.Context, v1 store.FailedRecordsOptions) (int, error) {
+	r0, r1 := m.RequeueFailedFunc.nextHook()(v0, v1)
+	m.RequeueFailedFunc.appendCall(StoreRequeueFailedFuncCall[T]{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RequeueFailed method
+// of the parent MockStore instance is invoked and the hook queue is empty.
+func (f *StoreRequeueFailedFunc[T]) SetDefaultHook(hook func(context.Context, store.FailedRecordsOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RequeueFailed method of the parent MockStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *StoreRequeueFailedFunc[T]) PushHook(hook func(context.Context, store.FailedRecordsOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *StoreRequeueFailedFunc[T]) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *StoreRequeueFailedFunc[T]) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store.FailedRecordsOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *StoreRequeueFailedFunc[T]) nextHook() func(context.Context, store.FailedRecordsOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *StoreRequeueFailedFunc[T]) appendCall(r0 StoreRequeueFailedFuncCall[T]) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of StoreRequeueFailedFuncCall objects
+// describing the invocations of this function.
+func (f *StoreRequeueFailedFunc[T]) History() []StoreRequeueFailedFuncCall[T] {
+	f.mutex.Lock()
+	history := make([]StoreRequeueFailedFuncCall[T], len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// StoreRequeueFailedFuncCall is an object that describes an invocation of
+// method RequeueFailed on an instance of MockStore.
+type StoreRequeueFailedFuncCall[T workerutil.Record] struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 store.FailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c StoreRequeueFailedFuncCall[T]) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c StoreRequeueFailedFuncCall[T]) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // StoreResetStalledFunc describes the behavior when the ResetStalled method
 // of the parent MockStore instance is invoked.
 type StoreResetStalledFunc[T workerutil.Record] struct {
@@ -21,6 +21,12 @@ type operations struct {
 	resetStalled            *observation.Operation
 	updateExecutionLogEntry *observation.Operation
 	canceledJobs            *observation.Operation
+	listDequeueCandidates   *observation.Operation
+	countFailed             *observation.Operation
+	listFailed              *observation.Operation
+	requeueFailed           *observation.Operation
+	deleteFailed            *observation.Operation
+	queueStatistics         *observation.Operation
 }
 
 // as newOperations changes based on the store name passed in, and a dbworker store
@@ -70,5 +76,11 @@ func newOperations(observationCtx *observation.Context, storeName string) *opera
 		resetStalled:            op("ResetStalled"),
 		updateExecutionLogEntry: op("UpdateExecutionLogEntry"),
 		canceledJobs:            op("CanceledJobs"),
+		listDequeueCandidates:   op("ListDequeueCandidates"),
+		countFailed:             op("CountFailed"),
+		listFailed:              op("ListFailed"),
+		requeueFailed:           op("RequeueFailed"),
+		deleteFailed:            op("DeleteFailed"),
+		queueStatistics:         op("QueueStatistics"),
 	}
 }
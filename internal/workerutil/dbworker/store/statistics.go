@@ -0,0 +1,104 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// QueueStatistics describes the current load of a store and the records it processed recently.
+type QueueStatistics struct {
+	// Queued is the number of queued and errored records.
+	Queued int
+
+	// Processing is the number of records currently being processed.
+	Processing int
+
+	// Arrivals is the number of records queued within the window.
+	Arrivals int
+
+	// Processed is the number of processing attempts that finished within the window,
+	// successfully or not.
+	Processed int
+
+	// MeanProcessingDuration is the mean duration of the processing attempts that finished
+	// within the window. It is zero if no attempt finished.
+	MeanProcessingDuration time.Duration
+
+	// P95ProcessingDuration is the 95th percentile of the duration of the processing attempts
+	// that finished within the window. It is zero if no attempt finished.
+	P95ProcessingDuration time.Duration
+}
+
+// QueueStatistics returns the current queue and processing counts of the store, along with the
+// number of records that arrived and the durations of the processing attempts that finished
+// within the given window before now.
+//
+// Arrivals are counted by the queued_at column, so the same caveats as for MaxDurationInQueue
+// apply to records that are requeued outside of this package.
+func (s *store[T]) QueueStatistics(ctx context.Context, window time.Duration) (_ QueueStatistics, err error) {
+	ctx, _, endObservation := s.operations.queueStatistics.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Stringer("window", window),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	since := s.now().Add(-window)
+
+	stats, _, err := scanQueueStatistics(s.Query(ctx, s.formatQuery(
+		queueStatisticsQuery,
+		// counts
+		since,
+		quote(s.options.TableName),
+		// durations
+		quote(s.options.TableName),
+		since,
+	)))
+	return stats, err
+}
+
+const queueStatisticsQuery = `
+WITH
+counts AS (
+	SELECT
+		COUNT(*) FILTER (WHERE {state} IN ('queued', 'errored')) AS queued,
+		COUNT(*) FILTER (WHERE {state} = 'processing') AS processing,
+		COUNT(*) FILTER (WHERE {queued_at} >= %s) AS arrivals
+	FROM %s
+),
+durations AS (
+	SELECT EXTRACT(EPOCH FROM {finished_at} - {started_at}) AS seconds
+	FROM %s
+	WHERE
+		{state} IN ('completed', 'errored', 'failed') AND
+		{started_at} IS NOT NULL AND
+		{finished_at} >= %s
+)
+SELECT
+	counts.queued,
+	counts.processing,
+	counts.arrivals,
+	(SELECT COUNT(*) FROM durations),
+	(SELECT COALESCE(AVG(seconds), 0) FROM durations),
+	(SELECT COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY seconds), 0) FROM durations)
+FROM counts
+`
+
+var scanQueueStatistics = basestore.NewFirstScanner(func(s dbutil.Scanner) (stats QueueStatistics, _ error) {
+	var meanSeconds, p95Seconds float64
+	err := s.Scan(
+		&stats.Queued,
+		&stats.Processing,
+		&stats.Arrivals,
+		&stats.Processed,
+		&meanSeconds,
+		&p95Seconds,
+	)
+	stats.MeanProcessingDuration = time.Duration(meanSeconds * float64(time.Second))
+	stats.P95ProcessingDuration = time.Duration(p95Seconds * float64(time.Second))
+	return stats, err
+})
@@ -68,6 +68,19 @@ func (o *MarkFinalOptions) ToSQLConds(formatQuery func(query string, args ...any
 	return conds
 }
 
+// DequeueCandidate describes a queued record and the reasons for its position in the dequeue order.
+type DequeueCandidate struct {
+	ID int
+	// Position is the 1-based position of the record in the dequeue order.
+	Position int
+	// Priority is the value of the configured priority expression for the record, or 0.
+	Priority int
+	// Namespace is the value of the configured fair share column for the record, if any.
+	Namespace string
+	// NamespaceProcessing is the number of records of the same namespace being processed.
+	NamespaceProcessing int
+}
+
 // ErrExecutionLogEntryNotUpdated is returned by AddExecutionLogEntry and UpdateExecutionLogEntry, when
 // the log entry was not updated.
 var ErrExecutionLogEntryNotUpdated = errors.New("execution log entry not updated")
@@ -88,6 +101,10 @@ type Store[T workerutil.Record] interface {
 	// MaxDurationInQueue returns the maximum age of queued records in this store. Returns 0 if there are no queued records.
 	MaxDurationInQueue(ctx context.Context) (time.Duration, error)
 
+	// QueueStatistics returns the queued and processing counts of this store, along with the number of
+	// records queued and the durations of processing attempts finished within the given window.
+	QueueStatistics(ctx context.Context, window time.Duration) (QueueStatistics, error)
+
 	// Dequeue selects the first queued record matching the given conditions and updates the state to processing. If there
 	// is such a record, it is returned. If there is no such unclaimed record, a nil record and a nil cancel function
 	// will be returned along with a false-valued flag. This method must not be called from within a transaction.
@@ -133,6 +150,25 @@ type Store[T workerutil.Record] interface {
 	// identifiers the age of the record's last heartbeat timestamp for each record reset to queued and failed states,
 	// respectively.
 	ResetStalled(ctx context.Context) (resetLastHeartbeatsByIDs, failedLastHeartbeatsByIDs map[int]time.Duration, err error)
+
+	// ListDequeueCandidates returns the first limit queued records in the order in which Dequeue
+	// would pick them, without locking them.
+	ListDequeueCandidates(ctx context.Context, limit int) ([]DequeueCandidate, error)
+
+	// CountFailed returns the number of failed records matching the given options.
+	CountFailed(ctx context.Context, opts FailedRecordsOptions) (int, error)
+
+	// ListFailed returns the failed records matching the given options, most recently failed first.
+	ListFailed(ctx context.Context, opts FailedRecordsOptions) ([]FailedRecord, error)
+
+	// RequeueFailed moves the failed records matching the given options back to the queued state and
+	// resets their failure and reset counters, so that they are retried as if they were new. This
+	// method returns the number of requeued records.
+	RequeueFailed(ctx context.Context, opts FailedRecordsOptions) (int, error)
+
+	// DeleteFailed deletes the failed records matching the given options. This method returns the
+	// number of deleted records.
+	DeleteFailed(ctx context.Context, opts FailedRecordsOptions) (int, error)
 }
 
 type store[T workerutil.Record] struct {
@@ -205,6 +241,21 @@ type Options[T workerutil.Record] struct {
 	// supplied.
 	OrderByExpression *sqlf.Query
 
+	// PriorityExpression is an optional integer SQL expression. When supplied, candidate records with
+	// a higher priority are dequeued before records with a lower priority, regardless of the order
+	// given by `OrderByExpression` and of fair sharing. This expression may use the alias provided in
+	// `ViewName`, if one was supplied.
+	PriorityExpression *sqlf.Query
+
+	// FairShareColumn is the optional name of a column of the table containing work records that
+	// identifies the namespace of a record, such as a user or a repository. When supplied, records of
+	// equal priority belonging to namespaces with fewer records in the processing state are dequeued
+	// first, so that a namespace with many queued records cannot starve the other namespaces. Records
+	// of the same namespace are still ordered by `OrderByExpression`.
+	//
+	// It's recommended to put an index on this column and the state column.
+	FairShareColumn string
+
 	// ColumnExpressions are the target columns provided to the query when selecting a job record. These
 	// expressions may use the alias provided in `ViewName`, if one was supplied.
 	ColumnExpressions []*sqlf.Query
@@ -471,7 +522,6 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 	}
 
 	now := s.now()
-	retryAfter := int(s.options.RetryAfter / time.Second)
 
 	var (
 		processingExpr     = sqlf.Sprintf("%s", "processing")
@@ -494,14 +544,7 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 
 	records, err := s.options.Scan(s.Query(ctx, s.formatQuery(
 		dequeueQuery,
-		s.options.OrderByExpression,
-		quote(s.options.ViewName),
-		now,
-		retryAfter,
-		now,
-		retryAfter,
-		makeConditionSuffix(conditions),
-		s.options.OrderByExpression,
+		s.potentialCandidatesQuery(now, conditions, dequeueCandidateLimit),
 		quote(s.options.TableName),
 		quote(s.options.TableName),
 		quote(s.options.TableName),
@@ -524,26 +567,7 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 }
 
 const dequeueQuery = `
-WITH potential_candidates AS (
-	SELECT
-		{id} AS candidate_id,
-		ROW_NUMBER() OVER (ORDER BY %s) AS order
-	FROM %s
-	WHERE
-		(
-			(
-				{state} = 'queued' AND
-				({process_after} IS NULL OR {process_after} <= %s)
-			) OR (
-				%s > 0 AND
-				{state} = 'errored' AND
-				%s - {finished_at} > (%s * '1 second'::interval)
-			)
-		)
-		%s
-	ORDER BY %s
-	LIMIT 50
-),
+WITH %s,
 candidate AS (
 	SELECT
 		{id} FROM %s
@@ -571,6 +595,172 @@ WHERE
 	{id} IN (SELECT {id} FROM candidate)
 `
 
+// dequeueCandidateLimit is the number of candidate records Dequeue tries to lock, in order, until
+// it finds one that is not locked by a concurrent dequeue.
+const dequeueCandidateLimit = 50
+
+// fairShareCandidateWindow is the number of candidate records, in priority and then configured
+// order, among which Dequeue shares executors between namespaces. Bounding the window keeps the
+// candidate scan in index order, so the cost of a dequeue doesn't grow with the number of queued
+// records.
+const fairShareCandidateWindow = 1000
+
+// potentialCandidatesQuery returns the `potential_candidates` CTE of the dequeue query, which ranks
+// the first `limit` dequeueable records in the order they are dequeued. Records are ordered by
+// priority, then by the number of records of the same namespace being processed, and finally by the
+// configured order.
+//
+// The CTE yields the columns candidate_id, priority, namespace, num_processing and order.
+func (s *store[T]) potentialCandidatesQuery(now time.Time, conditions []*sqlf.Query, limit int) *sqlf.Query {
+	retryAfter := int(s.options.RetryAfter / time.Second)
+	where := s.formatQuery(
+		dequeueableConditionsQuery,
+		now,
+		retryAfter,
+		now,
+		retryAfter,
+		makeConditionSuffix(conditions),
+	)
+
+	priority := sqlf.Sprintf("0")
+	orderBy := s.options.OrderByExpression
+	if s.options.PriorityExpression != nil {
+		priority = s.options.PriorityExpression
+		orderBy = sqlf.Sprintf("%s DESC, %s", priority, s.options.OrderByExpression)
+	}
+
+	if s.options.FairShareColumn == "" {
+		return s.formatQuery(
+			potentialCandidatesQuery,
+			priority,
+			orderBy,
+			quote(s.options.ViewName),
+			where,
+			orderBy,
+			limit,
+		)
+	}
+
+	// The last token of the view name is the alias under which the candidate record is known
+	// in the dequeue query (or the name of the view or table itself).
+	fields := strings.Fields(s.options.ViewName)
+	alias := fields[len(fields)-1]
+
+	return s.formatQuery(
+		fairSharePotentialCandidatesQuery,
+		quote(s.options.FairShareColumn),
+		quote(s.options.TableName),
+		quote(s.options.FairShareColumn),
+		priority,
+		quote(alias),
+		quote(s.options.FairShareColumn),
+		orderBy,
+		quote(s.options.ViewName),
+		where,
+		orderBy,
+		fairShareCandidateWindow,
+		limit,
+	)
+}
+
+const dequeueableConditionsQuery = `
+(
+	(
+		{state} = 'queued' AND
+		({process_after} IS NULL OR {process_after} <= %s)
+	) OR (
+		%s > 0 AND
+		{state} = 'errored' AND
+		%s - {finished_at} > (%s * '1 second'::interval)
+	)
+)
+%s
+`
+
+const potentialCandidatesQuery = `
+potential_candidates AS (
+	SELECT
+		{id} AS candidate_id,
+		%s AS priority,
+		NULL::text AS namespace,
+		0 AS num_processing,
+		ROW_NUMBER() OVER (ORDER BY %s) AS order
+	FROM %s
+	WHERE %s
+	ORDER BY %s
+	LIMIT %s
+)
+`
+
+// fairSharePotentialCandidatesQuery counts the records being processed per namespace once, rather
+// than once per candidate, and only sorts the candidate window by that count. The window itself is
+// read in priority and configured order, which indexes on the queue can serve.
+const fairSharePotentialCandidatesQuery = `
+fair_share AS (
+	SELECT %s AS namespace, COUNT(*) AS num_processing
+	FROM %s
+	WHERE {state} = 'processing'
+	GROUP BY %s
+),
+ordered_candidates AS (
+	SELECT
+		{id} AS candidate_id,
+		%s AS priority,
+		%s.%s AS namespace,
+		ROW_NUMBER() OVER (ORDER BY %s) AS order
+	FROM %s
+	WHERE %s
+	ORDER BY %s
+	LIMIT %s
+),
+potential_candidates AS (
+	SELECT
+		oc.candidate_id,
+		oc.priority,
+		oc.namespace::text AS namespace,
+		COALESCE(fs.num_processing, 0) AS num_processing,
+		ROW_NUMBER() OVER (ORDER BY oc.priority DESC, COALESCE(fs.num_processing, 0), oc.order) AS order
+	FROM ordered_candidates oc
+	LEFT JOIN fair_share fs ON fs.namespace = oc.namespace
+	ORDER BY oc.priority DESC, COALESCE(fs.num_processing, 0), oc.order
+	LIMIT %s
+)
+`
+
+// ListDequeueCandidates returns the first queued records in the order in which Dequeue would pick
+// them, without locking them.
+func (s *store[T]) ListDequeueCandidates(ctx context.Context, limit int) (_ []DequeueCandidate, err error) {
+	ctx, _, endObservation := s.operations.listDequeueCandidates.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("limit", limit),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanDequeueCandidates(s.Query(ctx, s.formatQuery(
+		listDequeueCandidatesQuery,
+		s.potentialCandidatesQuery(s.now(), nil, limit),
+	)))
+}
+
+const listDequeueCandidatesQuery = `
+WITH %s
+SELECT candidate_id, priority, namespace, num_processing, "order"
+FROM potential_candidates
+ORDER BY "order"
+`
+
+var scanDequeueCandidates = basestore.NewSliceScanner(func(s dbutil.Scanner) (candidate DequeueCandidate, _ error) {
+	var namespace sql.NullString
+	err := s.Scan(
+		&candidate.ID,
+		&candidate.Priority,
+		&namespace,
+		&candidate.NamespaceProcessing,
+		&candidate.Position,
+	)
+	candidate.Namespace = namespace.String
+	return candidate, err
+})
+
 // makeDequeueSelectExpressions constructs the ordered set of SQL expressions that are returned
 // from the dequeue query. This method returns a copy of the configured column expressions slice
 // where expressions referencing one of the column updated by dequeue are replaced by the updated
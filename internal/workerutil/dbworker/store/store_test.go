@@ -178,6 +178,54 @@ func TestStoreMaxDurationInQueueEmpty(t *testing.T) {
 	}
 }
 
+func TestStoreQueueStatistics(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, started_at, finished_at)
+		VALUES
+			(1, 'queued',     NOW() - '10 minutes'::interval, NULL,                           NULL),                           -- queued, arrived
+			(2, 'errored',    NOW() - '3 hours'::interval,    NOW() - '40 minutes'::interval, NOW() - '30 minutes'::interval), -- queued, processed in 10m
+			(3, 'processing', NOW() - '20 minutes'::interval, NOW() - '5 minutes'::interval,  NULL),                           -- processing, arrived
+			(4, 'completed',  NOW() - '50 minutes'::interval, NOW() - '30 minutes'::interval, NOW() - '28 minutes'::interval), -- arrived, processed in 2m
+			(5, 'completed',  NOW() - '5 hours'::interval,    NOW() - '4 hours'::interval,    NOW() - '3 hours'::interval),    -- outside of the window
+			(6, 'failed',     NOW() - '2 hours'::interval,    NOW() - '20 minutes'::interval, NOW() - '14 minutes'::interval)  -- processed in 6m
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	stats, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).QueueStatistics(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error getting queue statistics: %s", err)
+	}
+
+	stats.MeanProcessingDuration = stats.MeanProcessingDuration.Round(time.Second)
+	stats.P95ProcessingDuration = stats.P95ProcessingDuration.Round(time.Second)
+	expected := QueueStatistics{
+		Queued:                 2,
+		Processing:             1,
+		Arrivals:               3,
+		Processed:              3,
+		MeanProcessingDuration: 6 * time.Minute,
+		P95ProcessingDuration:  9*time.Minute + 36*time.Second,
+	}
+	if diff := cmp.Diff(expected, stats); diff != "" {
+		t.Errorf("unexpected statistics (-want +got):\n%s", diff)
+	}
+}
+
+func TestStoreQueueStatisticsEmpty(t *testing.T) {
+	db := setupStoreTest(t)
+
+	stats, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).QueueStatistics(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error getting queue statistics: %s", err)
+	}
+	if diff := cmp.Diff(QueueStatistics{}, stats); diff != "" {
+		t.Errorf("unexpected statistics (-want +got):\n%s", diff)
+	}
+}
+
 func TestStoreDequeueState(t *testing.T) {
 	db := setupStoreTest(t)
 
@@ -216,6 +264,101 @@ func TestStoreDequeueOrder(t *testing.T) {
 	assertDequeueRecordResult(t, 2, record, ok, err)
 }
 
+func TestStoreDequeuePriority(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at)
+		VALUES
+			(1, 'queued', NOW() - '2 minute'::interval),
+			(2, 'queued', NOW() - '5 minute'::interval),
+			(3, 'queued', NOW() - '3 minute'::interval),
+			(4, 'queued', NOW() - '1 minute'::interval),
+			(5, 'queued', NOW() - '4 minute'::interval)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.PriorityExpression = sqlf.Sprintf("(workerutil_test.id IN (1, 4))::int")
+
+	record, ok, err := testStore(db, options).Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 1, record, ok, err)
+}
+
+func TestStoreDequeueFairShare(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `ALTER TABLE workerutil_test ADD COLUMN namespace text`); err != nil {
+		t.Fatalf("unexpected error altering test table: %s", err)
+	}
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, namespace, created_at)
+		VALUES
+			(1, 'processing', 'alice', NOW() - '6 minute'::interval),
+			(2, 'queued',     'alice', NOW() - '5 minute'::interval),
+			(3, 'queued',     'alice', NOW() - '4 minute'::interval),
+			(4, 'queued',     'bob',   NOW() - '3 minute'::interval),
+			(5, 'queued',     'bob',   NOW() - '2 minute'::interval)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.FairShareColumn = "namespace"
+	store := testStore(db, options)
+
+	// Bob has no records being processed yet, so his oldest record goes first.
+	record, ok, err := store.Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 4, record, ok, err)
+
+	// Both namespaces have one record being processed, so the oldest record goes next.
+	record, ok, err = store.Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 2, record, ok, err)
+
+	// Alice has two records being processed now.
+	record, ok, err = store.Dequeue(context.Background(), "test", nil)
+	assertDequeueRecordResult(t, 5, record, ok, err)
+}
+
+func TestStoreListDequeueCandidates(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `ALTER TABLE workerutil_test ADD COLUMN namespace text`); err != nil {
+		t.Fatalf("unexpected error altering test table: %s", err)
+	}
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, namespace, created_at)
+		VALUES
+			(1, 'processing', 'alice', NOW() - '6 minute'::interval),
+			(2, 'queued',     'alice', NOW() - '5 minute'::interval),
+			(3, 'queued',     'alice', NOW() - '4 minute'::interval),
+			(4, 'queued',     'bob',   NOW() - '3 minute'::interval),
+			(5, 'queued',     'bob',   NOW() - '2 minute'::interval),
+			(6, 'failed',     'bob',   NOW() - '1 minute'::interval)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.PriorityExpression = sqlf.Sprintf("(workerutil_test.id = 3)::int")
+	options.FairShareColumn = "namespace"
+
+	candidates, err := testStore(db, options).ListDequeueCandidates(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("unexpected error listing candidates: %s", err)
+	}
+
+	expected := []DequeueCandidate{
+		{ID: 3, Position: 1, Priority: 1, Namespace: "alice", NamespaceProcessing: 1},
+		{ID: 4, Position: 2, Priority: 0, Namespace: "bob", NamespaceProcessing: 0},
+		{ID: 5, Position: 3, Priority: 0, Namespace: "bob", NamespaceProcessing: 0},
+	}
+	if diff := cmp.Diff(expected, candidates); diff != "" {
+		t.Errorf("unexpected candidates (-want +got):\n%s", diff)
+	}
+}
+
 func TestStoreDequeueConditions(t *testing.T) {
 	db := setupStoreTest(t)
 
@@ -463,6 +606,188 @@ func TestStoreRequeue(t *testing.T) {
 	}
 }
 
+func TestStoreFailedRecords(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, failure_message, num_failures, finished_at)
+		VALUES
+			(1, 'failed', 'Connection timeout', 3, NOW() - '1 hour'::interval),
+			(2, 'failed', 'invalid input', 3, NOW() - '2 hour'::interval),
+			(3, 'errored', 'connection timeout', 1, NOW() - '3 hour'::interval),
+			(4, 'failed', 'connection TIMEOUT', 3, NOW() - '4 hour'::interval),
+			(5, 'queued', NULL, 0, NULL),
+			(6, 'failed', NULL, 0, NOW() - '6 hour'::interval)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	store := testStore(db, defaultTestStoreOptions(nil, testScanRecord))
+
+	testCases := []struct {
+		opts        FailedRecordsOptions
+		expectedIDs []int
+	}{
+		{opts: FailedRecordsOptions{}, expectedIDs: []int{1, 2, 4, 6}},
+		{opts: FailedRecordsOptions{FailureMessage: "timeout"}, expectedIDs: []int{1, 4}},
+		{opts: FailedRecordsOptions{FailedBefore: time.Now().Add(-90 * time.Minute)}, expectedIDs: []int{2, 4, 6}},
+		{opts: FailedRecordsOptions{FailureMessage: "timeout", FailedBefore: time.Now().Add(-90 * time.Minute)}, expectedIDs: []int{4}},
+		{opts: FailedRecordsOptions{Limit: 2}, expectedIDs: []int{1, 2}},
+	}
+
+	for _, testCase := range testCases {
+		count, err := store.CountFailed(context.Background(), FailedRecordsOptions{FailureMessage: testCase.opts.FailureMessage, FailedBefore: testCase.opts.FailedBefore})
+		if err != nil {
+			t.Fatalf("unexpected error counting failed records: %s", err)
+		}
+		if testCase.opts.Limit == 0 && count != len(testCase.expectedIDs) {
+			t.Errorf("unexpected count. want=%d have=%d", len(testCase.expectedIDs), count)
+		}
+
+		records, err := store.ListFailed(context.Background(), testCase.opts)
+		if err != nil {
+			t.Fatalf("unexpected error listing failed records: %s", err)
+		}
+
+		var ids []int
+		for _, record := range records {
+			ids = append(ids, record.ID)
+		}
+		if diff := cmp.Diff(testCase.expectedIDs, ids); diff != "" {
+			t.Errorf("unexpected records (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestStoreListFailedWithoutQueuedAt(t *testing.T) {
+	db := setupStoreTest(t)
+
+	// Some queues have a nullable queued_at column, which older records don't set.
+	if _, err := db.ExecContext(context.Background(), `ALTER TABLE workerutil_test ALTER COLUMN created_at DROP NOT NULL`); err != nil {
+		t.Fatalf("unexpected error altering test table: %s", err)
+	}
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, failure_message, num_failures, created_at, finished_at)
+		VALUES
+			(1, 'failed', 'connection timeout', 3, NULL, NOW() - '1 hour'::interval),
+			(2, 'failed', 'invalid input', 3, NOW() - '3 hour'::interval, NOW() - '2 hour'::interval)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	records, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).ListFailed(context.Background(), FailedRecordsOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error listing failed records: %s", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("unexpected number of records. want=%d have=%d", 2, len(records))
+	}
+	if records[0].ID != 1 || records[0].QueuedAt != nil {
+		t.Errorf("expected record 1 without queued at. have=%+v", records[0])
+	}
+	if records[1].ID != 2 || records[1].QueuedAt == nil {
+		t.Errorf("expected record 2 with queued at. have=%+v", records[1])
+	}
+}
+
+func TestStoreRequeueFailed(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, failure_message, num_failures, num_resets, started_at, finished_at, execution_logs)
+		VALUES
+			(1, 'failed', 'connection timeout', 3, 1, NOW() - '2 hour'::interval, NOW() - '1 hour'::interval, ARRAY['{"key": "step.0"}']::json[]),
+			(2, 'failed', 'invalid input', 3, 0, NOW() - '2 hour'::interval, NOW() - '1 hour'::interval, NULL),
+			(3, 'errored', 'connection timeout', 1, 0, NOW() - '2 hour'::interval, NOW() - '1 hour'::interval, NULL)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	count, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).RequeueFailed(context.Background(), FailedRecordsOptions{FailureMessage: "timeout"})
+	if err != nil {
+		t.Fatalf("unexpected error requeueing failed records: %s", err)
+	}
+	if count != 1 {
+		t.Errorf("unexpected count. want=%d have=%d", 1, count)
+	}
+
+	rows, err := db.QueryContext(context.Background(), `
+		SELECT id, state, failure_message, num_failures, num_resets, started_at, finished_at, array_length(execution_logs, 1)
+		FROM workerutil_test
+		ORDER BY id
+	`)
+	if err != nil {
+		t.Fatalf("unexpected error querying records: %s", err)
+	}
+	defer func() { _ = basestore.CloseRows(rows, nil) }()
+
+	var states []string
+	for rows.Next() {
+		var id, numFailures, numResets int
+		var state string
+		var failureMessage *string
+		var startedAt, finishedAt *time.Time
+		var numLogs *int
+
+		if err := rows.Scan(&id, &state, &failureMessage, &numFailures, &numResets, &startedAt, &finishedAt, &numLogs); err != nil {
+			t.Fatalf("unexpected error scanning record: %s", err)
+		}
+		states = append(states, state)
+
+		if id != 1 {
+			continue
+		}
+		if failureMessage != nil || numFailures != 0 || numResets != 0 {
+			t.Errorf("unexpected failure state. failure_message=%v num_failures=%d num_resets=%d", failureMessage, numFailures, numResets)
+		}
+		if startedAt != nil || finishedAt != nil {
+			t.Errorf("unexpected timestamps. started_at=%v finished_at=%v", startedAt, finishedAt)
+		}
+		if numLogs == nil || *numLogs != 1 {
+			t.Errorf("expected execution logs to be kept")
+		}
+	}
+
+	if diff := cmp.Diff([]string{"queued", "failed", "errored"}, states); diff != "" {
+		t.Errorf("unexpected states (-want +got):\n%s", diff)
+	}
+}
+
+func TestStoreDeleteFailed(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, finished_at)
+		VALUES
+			(1, 'failed', NOW() - '1 hour'::interval),
+			(2, 'failed', NOW() - '3 day'::interval),
+			(3, 'completed', NOW() - '3 day'::interval),
+			(4, 'failed', NOW() - '4 day'::interval),
+			(5, 'failed', NOW() - '5 day'::interval)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	count, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).DeleteFailed(context.Background(), FailedRecordsOptions{
+		FailedBefore: time.Now().Add(-24 * time.Hour),
+		Limit:        2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error deleting failed records: %s", err)
+	}
+	if count != 2 {
+		t.Errorf("unexpected count. want=%d have=%d", 2, count)
+	}
+
+	ids, err := basestore.ScanInts(db.QueryContext(context.Background(), `SELECT id FROM workerutil_test ORDER BY id`))
+	if err != nil {
+		t.Fatalf("unexpected error querying records: %s", err)
+	}
+	if diff := cmp.Diff([]int{1, 3, 5}, ids); diff != "" {
+		t.Errorf("unexpected remaining records (-want +got):\n%s", diff)
+	}
+}
+
 func TestStoreAddExecutionLogEntry(t *testing.T) {
 	db := setupStoreTest(t)
 
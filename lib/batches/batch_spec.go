@@ -37,6 +37,8 @@ type BatchSpec struct {
 	TransformChanges  *TransformChanges        `json:"transformChanges,omitempty" yaml:"transformChanges,omitempty"`
 	ImportChangesets  []ImportChangeset        `json:"importChangesets,omitempty" yaml:"importChangesets"`
 	ChangesetTemplate *ChangesetTemplate       `json:"changesetTemplate,omitempty" yaml:"changesetTemplate"`
+	AutoMerge         *AutoMergePolicy         `json:"autoMerge,omitempty" yaml:"autoMerge,omitempty"`
+	Rerun             *RerunPolicy             `json:"rerun,omitempty" yaml:"rerun,omitempty"`
 }
 
 type ChangesetTemplate struct {
@@ -46,6 +48,71 @@ type ChangesetTemplate struct {
 	Fork      *bool                        `json:"fork,omitempty" yaml:"fork"`
 	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Labels    *overridable.StringList      `json:"labels,omitempty" yaml:"labels"`
+	Reviewers *overridable.StringList      `json:"reviewers,omitempty" yaml:"reviewers"`
+	Assignees *overridable.StringList      `json:"assignees,omitempty" yaml:"assignees"`
+	Milestone *overridable.String          `json:"milestone,omitempty" yaml:"milestone"`
+}
+
+// AutoMergePolicy describes when the changesets of a batch change are merged automatically.
+type AutoMergePolicy struct {
+	Method  string            `json:"method,omitempty" yaml:"method"`
+	Checks  string            `json:"checks,omitempty" yaml:"checks"`
+	Review  string            `json:"review,omitempty" yaml:"review"`
+	Windows []AutoMergeWindow `json:"windows,omitempty" yaml:"windows"`
+}
+
+const (
+	AutoMergeMethodMerge  = "merge"
+	AutoMergeMethodSquash = "squash"
+
+	AutoMergeChecksPassed       = "passed"
+	AutoMergeChecksPassedOrNone = "passed-or-none"
+	AutoMergeChecksAny          = "any"
+
+	AutoMergeReviewApproved    = "approved"
+	AutoMergeReviewNotRejected = "not-rejected"
+	AutoMergeReviewAny         = "any"
+)
+
+// MergeMethod returns the merge method of the policy, defaulting to a merge commit.
+func (p *AutoMergePolicy) MergeMethod() string {
+	if p.Method == "" {
+		return AutoMergeMethodMerge
+	}
+	return p.Method
+}
+
+// RequiredChecks returns the required check state of the policy, defaulting to passed checks.
+func (p *AutoMergePolicy) RequiredChecks() string {
+	if p.Checks == "" {
+		return AutoMergeChecksPassed
+	}
+	return p.Checks
+}
+
+// RequiredReview returns the required review state of the policy, defaulting to an approval.
+func (p *AutoMergePolicy) RequiredReview() string {
+	if p.Review == "" {
+		return AutoMergeReviewApproved
+	}
+	return p.Review
+}
+
+// AutoMergeWindow is a window within which changesets are merged, in the same format as the
+// rollout windows of the site configuration.
+type AutoMergeWindow struct {
+	Rate  any      `json:"rate" yaml:"rate"`
+	Start string   `json:"start,omitempty" yaml:"start"`
+	End   string   `json:"end,omitempty" yaml:"end"`
+	Days  []string `json:"days,omitempty" yaml:"days"`
+}
+
+// RerunPolicy describes when the workspaces of a batch change are resolved again, so that
+// repositories that started matching the batch spec after it was applied get the change too.
+type RerunPolicy struct {
+	// Schedule is a cron expression, evaluated in UTC.
+	Schedule string `json:"schedule,omitempty" yaml:"schedule"`
 }
 
 type GitCommitAuthor struct {
@@ -74,6 +141,8 @@ type OnQueryOrRepository struct {
 	Repository                string   `json:"repository,omitempty" yaml:"repository"`
 	Branch                    string   `json:"branch,omitempty" yaml:"branch"`
 	Branches                  []string `json:"branches,omitempty" yaml:"branches"`
+	Tier                      int      `json:"tier,omitempty" yaml:"tier"`
+	DependsOn                 []string `json:"dependsOn,omitempty" yaml:"dependsOn"`
 }
 
 var ErrConflictingBranches = NewValidationError(errors.New("both branch and branches specified"))
@@ -96,8 +165,96 @@ type Step struct {
 	Outputs   Outputs           `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 	Mount     []Mount           `json:"mount,omitempty" yaml:"mount,omitempty"`
 	If        any               `json:"if,omitempty" yaml:"if,omitempty"`
+
+	// Built-in steps are executed without a container. Only one of them can
+	// be set, and never together with Run and Container.
+	Replace    *ReplaceStep      `json:"replace,omitempty" yaml:"replace,omitempty"`
+	WriteFiles map[string]string `json:"writeFiles,omitempty" yaml:"writeFiles,omitempty"`
+	ApplyPatch string            `json:"applyPatch,omitempty" yaml:"applyPatch,omitempty"`
+}
+
+// StepKind is the kind of a step: either a container step or one of the
+// built-in steps.
+type StepKind string
+
+const (
+	StepKindContainer  StepKind = "container"
+	StepKindReplace    StepKind = "replace"
+	StepKindWriteFiles StepKind = "writeFiles"
+	StepKindApplyPatch StepKind = "applyPatch"
+)
+
+// Kind returns the kind of the step. Steps that set a built-in step are
+// reported as that built-in step, even if they're invalid because they also
+// set another one.
+func (s *Step) Kind() StepKind {
+	switch {
+	case s.Replace != nil:
+		return StepKindReplace
+	case s.WriteFiles != nil:
+		return StepKindWriteFiles
+	case s.ApplyPatch != "":
+		return StepKindApplyPatch
+	default:
+		return StepKindContainer
+	}
+}
+
+// IsBuiltin returns whether the step is a built-in step that is executed
+// without a container.
+func (s *Step) IsBuiltin() bool {
+	return s.Kind() != StepKindContainer
 }
 
+// validateKind returns an error if the step sets more than one kind of step,
+// or sets fields of container steps on a built-in step.
+func (s *Step) validateKind() error {
+	var kinds []string
+	if s.Run != "" || s.Container != "" {
+		kinds = append(kinds, "run")
+	}
+	if s.Replace != nil {
+		kinds = append(kinds, "replace")
+	}
+	if s.WriteFiles != nil {
+		kinds = append(kinds, "writeFiles")
+	}
+	if s.ApplyPatch != "" {
+		kinds = append(kinds, "applyPatch")
+	}
+	if len(kinds) > 1 {
+		return errors.Newf("only one of %s can be set", strings.Join(kinds, ", "))
+	}
+
+	if !s.IsBuiltin() {
+		return nil
+	}
+	if !s.Env.Equal(env.Environment{}) {
+		return errors.Newf("env can't be set on %s steps", s.Kind())
+	}
+	if len(s.Files) > 0 {
+		return errors.Newf("files can't be set on %s steps, use writeFiles instead", s.Kind())
+	}
+	if len(s.Mount) > 0 {
+		return errors.Newf("mount can't be set on %s steps", s.Kind())
+	}
+	return nil
+}
+
+// ReplaceStep replaces all matches of a pattern in the files of a workspace.
+type ReplaceStep struct {
+	Pattern     string   `json:"pattern" yaml:"pattern"`
+	Replacement string   `json:"replacement" yaml:"replacement"`
+	PatternType string   `json:"patternType,omitempty" yaml:"patternType,omitempty"`
+	Matcher     string   `json:"matcher,omitempty" yaml:"matcher,omitempty"`
+	Files       []string `json:"files,omitempty" yaml:"files,omitempty"`
+}
+
+const (
+	ReplacePatternTypeRegexp     = "regexp"
+	ReplacePatternTypeStructural = "structural"
+)
+
 func (s *Step) IfCondition() string {
 	switch v := s.If.(type) {
 	case bool:
@@ -167,6 +324,9 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 	}
 
 	for i, step := range spec.Steps {
+		if err := step.validateKind(); err != nil {
+			errs = errors.Append(errs, NewValidationError(errors.Wrapf(err, "step %d", i+1)))
+		}
 		for _, mount := range step.Mount {
 			if strings.Contains(mount.Path, invalidMountCharacters) {
 				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d mount path contains invalid characters", i+1)))
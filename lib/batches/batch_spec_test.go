@@ -211,6 +211,136 @@ changesetTemplate:
 		_, err := ParseBatchSpec([]byte(spec))
 		assert.Equal(t, "step 1 mount mountpoint contains invalid characters", err.Error())
 	})
+
+	t.Run("auto-merge policy", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: /tmp/sample.sh
+    container: alpine:3
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+autoMerge:
+  method: squash
+  review: not-rejected
+  windows:
+    - rate: 10/hour
+      days: [saturday, sunday]
+    - rate: 0
+`
+		batchSpec, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if batchSpec.AutoMerge == nil {
+			t.Fatal("no auto-merge policy parsed")
+		}
+		assert.Equal(t, AutoMergeMethodSquash, batchSpec.AutoMerge.MergeMethod())
+		assert.Equal(t, AutoMergeChecksPassed, batchSpec.AutoMerge.RequiredChecks())
+		assert.Equal(t, AutoMergeReviewNotRejected, batchSpec.AutoMerge.RequiredReview())
+		assert.Equal(t, []AutoMergeWindow{
+			{Rate: "10/hour", Days: []string{"saturday", "sunday"}},
+			{Rate: float64(0)},
+		}, batchSpec.AutoMerge.Windows)
+	})
+
+	t.Run("invalid auto-merge method", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+autoMerge:
+  method: rebase
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+		assert.Contains(t, err.Error(), "autoMerge.method")
+	})
+
+	t.Run("rerun policy", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+on:
+  - repositoriesMatchingQuery: file:README.md
+rerun:
+  schedule: "0 6 * * 1"
+`
+		batchSpec, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, &RerunPolicy{Schedule: "0 6 * * 1"}, batchSpec.Rerun)
+	})
+
+	t.Run("rerun policy without schedule", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+rerun: {}
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+		assert.Contains(t, err.Error(), "schedule")
+	})
+
+	t.Run("changeset metadata", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: /tmp/sample.sh
+    container: alpine:3
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+  labels: [dependencies]
+  reviewers:
+    - "*": [alice]
+    - github.com/sourcegraph/*: [bob, carol]
+  milestone: "1.0"
+`
+		batchSpec, err := ParseBatchSpec([]byte(spec))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tmpl := batchSpec.ChangesetTemplate
+		assert.Equal(t, []string{"dependencies"}, tmpl.Labels.Value("github.com/sourcegraph/src-cli"))
+		assert.Equal(t, []string{"alice"}, tmpl.Reviewers.Value("github.com/other/repo"))
+		assert.Equal(t, []string{"bob", "carol"}, tmpl.Reviewers.Value("github.com/sourcegraph/src-cli"))
+		assert.Nil(t, tmpl.Assignees)
+		assert.Equal(t, "1.0", tmpl.Milestone.Value("github.com/sourcegraph/src-cli"))
+	})
+
+	t.Run("invalid changeset labels", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+changesetTemplate:
+  title: Test
+  body: Test
+  branch: test
+  commit:
+    message: Test
+  labels: dependencies
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+		assert.Contains(t, err.Error(), "changesetTemplate.labels")
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
@@ -416,3 +546,105 @@ func TestBatchSpec_RequiredEnvVars(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBatchSpec_BuiltinSteps(t *testing.T) {
+	const header = `
+name: hello-world
+on:
+  - repositoriesMatchingQuery: file:README.md
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+steps:
+`
+
+	t.Run("valid", func(t *testing.T) {
+		spec, err := ParseBatchSpec([]byte(header + `
+  - replace:
+      pattern: Hello (\w+)
+      replacement: Goodbye $1
+      files: ["**/README.md"]
+  - writeFiles:
+      CODEOWNERS: "* @sourcegraph/batch-changes"
+  - applyPatch: |
+      diff --git a/README.md b/README.md
+  - run: echo Hello World
+    container: alpine:3
+`))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var kinds []StepKind
+		for _, step := range spec.Steps {
+			kinds = append(kinds, step.Kind())
+		}
+		want := []StepKind{StepKindReplace, StepKindWriteFiles, StepKindApplyPatch, StepKindContainer}
+		if diff := cmp.Diff(want, kinds); diff != "" {
+			t.Fatalf("wrong step kinds (-want +have):\n%s", diff)
+		}
+	})
+
+	for name, tc := range map[string]struct {
+		steps   string
+		wantErr string
+	}{
+		"two built-in steps": {
+			steps: `
+  - writeFiles:
+      README.md: Hello
+    applyPatch: diff
+`,
+			wantErr: "step 1: only one of writeFiles, applyPatch can be set",
+		},
+		"built-in step with run": {
+			steps: `
+  - run: echo Hello World
+    container: alpine:3
+    writeFiles:
+      README.md: Hello
+`,
+			wantErr: "step 1: only one of run, writeFiles can be set",
+		},
+		"built-in step with env": {
+			steps: `
+  - writeFiles:
+      README.md: Hello
+    env:
+      FOO: bar
+`,
+			wantErr: "step 1: env can't be set on writeFiles steps",
+		},
+		"built-in step with files": {
+			steps: `
+  - applyPatch: diff
+    files:
+      README.md: Hello
+`,
+			wantErr: "step 1: files can't be set on applyPatch steps, use writeFiles instead",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseBatchSpec([]byte(header + tc.steps))
+			if err == nil {
+				t.Fatal("no error returned")
+			}
+			if have := err.Error(); have != tc.wantErr {
+				t.Fatalf("wrong error. want=%q, have=%q", tc.wantErr, have)
+			}
+		})
+	}
+
+	t.Run("no kind", func(t *testing.T) {
+		if _, err := ParseBatchSpec([]byte(header + `
+  - outputs:
+      foo:
+        value: bar
+`)); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+}
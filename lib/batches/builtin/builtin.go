@@ -0,0 +1,324 @@
+// Package builtin executes the built-in steps of batch specs, which run
+// natively instead of in a container. They change the files of the workspace
+// just like container steps do, so the diff, outputs and cache keys of a step
+// are computed the same way no matter what kind of step it is.
+package builtin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Run executes the built-in step in the workspace at path in the repository
+// checkout at repoDir. The step's templates are rendered with stepContext, and
+// a summary of the changes is written to stdout, which becomes the step's
+// stdout.
+func Run(ctx context.Context, step batches.Step, repoDir, path string, stepContext *template.StepContext, stdout io.Writer) error {
+	dir := filepath.Join(repoDir, path)
+
+	switch step.Kind() {
+	case batches.StepKindReplace:
+		return runReplace(ctx, step.Replace, dir, stepContext, stdout)
+	case batches.StepKindWriteFiles:
+		return runWriteFiles(step.WriteFiles, dir, stepContext, stdout)
+	case batches.StepKindApplyPatch:
+		return runApplyPatch(ctx, step.ApplyPatch, repoDir, stepContext, stdout)
+	default:
+		return errors.Newf("step of kind %q is not a built-in step", step.Kind())
+	}
+}
+
+func renderString(name, tmpl string, stepContext *template.StepContext) (string, error) {
+	var out bytes.Buffer
+	if err := template.RenderStepTemplate(name, tmpl, &out, stepContext); err != nil {
+		return "", errors.Wrapf(err, "rendering %s", name)
+	}
+	return out.String(), nil
+}
+
+// replacer replaces all matches in the content of a file, and returns the new
+// content and the number of replaced matches.
+type replacer func(ctx context.Context, content []byte) ([]byte, int, error)
+
+func runReplace(ctx context.Context, step *batches.ReplaceStep, dir string, stepContext *template.StepContext, stdout io.Writer) error {
+	pattern, err := renderString("replace.pattern", step.Pattern, stepContext)
+	if err != nil {
+		return err
+	}
+	replacement, err := renderString("replace.replacement", step.Replacement, stepContext)
+	if err != nil {
+		return err
+	}
+
+	var globs []glob.Glob
+	for _, f := range step.Files {
+		rendered, err := renderString("replace.files", f, stepContext)
+		if err != nil {
+			return err
+		}
+		g, err := glob.Compile(rendered, '/')
+		if err != nil {
+			return errors.Wrapf(err, "compiling glob %q", rendered)
+		}
+		globs = append(globs, g)
+	}
+
+	var replace replacer
+	switch step.PatternType {
+	case "", batches.ReplacePatternTypeRegexp:
+		replace, err = regexpReplacer(pattern, replacement)
+	case batches.ReplacePatternTypeStructural:
+		replace, err = combyReplacer(pattern, replacement, step.Matcher)
+	default:
+		err = errors.Newf("unknown pattern type %q", step.PatternType)
+	}
+	if err != nil {
+		return err
+	}
+
+	return walkFiles(dir, globs, func(name, path string) error {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		// Like search, we skip binary files.
+		if bytes.IndexByte(content, 0) != -1 {
+			return nil
+		}
+
+		replaced, count, err := replace(ctx, content)
+		if err != nil {
+			return errors.Wrapf(err, "replacing in %s", name)
+		}
+		if count == 0 || bytes.Equal(content, replaced) {
+			return nil
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, replaced, info.Mode().Perm()); err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "%s: %d replacements\n", name, count)
+		return err
+	})
+}
+
+// walkFiles calls fn with the slash-separated name relative to dir and the
+// path of every regular file in dir that matches one of the globs, or of every
+// file if there are no globs. The .git directory is skipped.
+func walkFiles(dir string, globs []glob.Glob, fn func(name, path string) error) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if !matchesAny(globs, name) {
+			return nil
+		}
+		return fn(name, path)
+	})
+}
+
+func matchesAny(globs []glob.Glob, name string) bool {
+	if len(globs) == 0 {
+		return true
+	}
+	for _, g := range globs {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// regexpReplacer replaces matches of a Go regular expression, expanding $1 and
+// ${name} in the replacement like the compute replace command does.
+func regexpReplacer(pattern, replacement string) (replacer, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "compiling pattern")
+	}
+
+	return func(_ context.Context, content []byte) ([]byte, int, error) {
+		count := len(re.FindAllIndex(content, -1))
+		if count == 0 {
+			return content, 0, nil
+		}
+		return re.ReplaceAll(content, []byte(replacement)), count, nil
+	}, nil
+}
+
+// combyPath is the comby binary used by structural replacements.
+const combyPath = "comby"
+
+// combyReplacer replaces matches of a structural pattern with comby, with the
+// .generic matcher by default like the compute replace command does.
+func combyReplacer(pattern, replacement, matcher string) (replacer, error) {
+	if _, err := exec.LookPath(combyPath); err != nil {
+		return nil, errors.Wrap(err, "structural replacements require comby to be installed")
+	}
+	if matcher == "" {
+		matcher = ".generic"
+	}
+
+	return func(ctx context.Context, content []byte) ([]byte, int, error) {
+		cmd := exec.CommandContext(ctx, combyPath, pattern, replacement, "-stdin", "-json-lines", "-match-newline-at-toplevel", "-matcher", matcher)
+		cmd.Stdin = bytes.NewReader(content)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "running comby: %s", strings.TrimSpace(stderr.String()))
+		}
+
+		// comby prints nothing if the pattern doesn't match, and otherwise one
+		// line with the rewritten content since there is just one input.
+		scanner := bufio.NewScanner(bytes.NewReader(out))
+		scanner.Buffer(make([]byte, 0, 64*1024), len(out)+1)
+		for scanner.Scan() {
+			var replacement struct {
+				RewrittenSource string            `json:"rewritten_source"`
+				Substitutions   []json.RawMessage `json:"in_place_substitutions"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &replacement); err != nil {
+				return nil, 0, errors.Wrap(err, "parsing comby output")
+			}
+			return []byte(replacement.RewrittenSource), len(replacement.Substitutions), nil
+		}
+		return content, 0, scanner.Err()
+	}, nil
+}
+
+func runWriteFiles(files map[string]string, dir string, stepContext *template.StepContext, stdout io.Writer) error {
+	rendered := make(map[string]string, len(files))
+	for name, content := range files {
+		renderedName, err := renderString("writeFiles", name, stepContext)
+		if err != nil {
+			return err
+		}
+		renderedContent, err := renderString(renderedName, content, stepContext)
+		if err != nil {
+			return err
+		}
+		rendered[renderedName] = renderedContent
+	}
+
+	// Write the files in a stable order, so that the stdout of the step is
+	// stable too.
+	names := make([]string, 0, len(rendered))
+	for name := range rendered {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !isLocal(name) {
+			return errors.Newf("file path %q must be relative to the workspace and can't be outside of it", name)
+		}
+		// 🚨 SECURITY: Built-in steps run directly on the machine of the user, so a
+		// symlink in the repository must not redirect writes outside of the workspace.
+		if through, err := containsSymlink(dir, name); err != nil {
+			return err
+		} else if through {
+			return errors.Newf("file path %q can't be written through a symlink", name)
+		}
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(rendered[name]), 0o644); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(stdout, "%s: written\n", name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isLocal returns whether the slash-separated path names a file inside the
+// directory it is relative to.
+func isLocal(name string) bool {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(clean) || clean == "." || clean == ".." {
+		return false
+	}
+	return !strings.HasPrefix(clean, ".."+string(filepath.Separator))
+}
+
+// containsSymlink returns whether the file at the slash-separated path relative
+// to dir, or any of its parent directories below dir, is a symlink.
+func containsSymlink(dir, name string) (bool, error) {
+	path := dir
+	for _, segment := range strings.Split(filepath.Clean(filepath.FromSlash(name)), string(filepath.Separator)) {
+		path = filepath.Join(path, segment)
+		info, err := os.Lstat(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// Nothing exists at this path yet, so neither can anything below it.
+				return false, nil
+			}
+			return false, err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// runApplyPatch applies the patch with git apply in the root of the
+// repository, since the paths in diffs are relative to it.
+func runApplyPatch(ctx context.Context, patch string, repoDir string, stepContext *template.StepContext, stdout io.Writer) error {
+	rendered, err := renderString("applyPatch", patch, stepContext)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "apply", "--verbose", "--whitespace=nowarn", "-")
+	cmd.Dir = repoDir
+	cmd.Stdin = strings.NewReader(rendered)
+	// git apply reports the applied files on stderr.
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "applying patch: %s", strings.TrimSpace(out.String()))
+	}
+	_, err = stdout.Write(out.Bytes())
+	return err
+}
@@ -0,0 +1,237 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+)
+
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+func TestRun_Replace(t *testing.T) {
+	ctx := context.Background()
+	stepContext := &template.StepContext{Repository: template.Repository{Name: "github.com/sourcegraph/src-cli"}}
+
+	t.Run("regexp", func(t *testing.T) {
+		repoDir := t.TempDir()
+		writeTestFiles(t, repoDir, map[string]string{
+			"cmd/main.go":      `fmt.Sprintf("%s", name) + fmt.Sprintf("%s", other)`,
+			"cmd/main_test.go": `fmt.Sprintf("%s", name)`,
+			"README.md":        `fmt.Sprintf("%s", name)`,
+			"internal/lib.go":  `fmt.Sprintf("%s", name)`,
+			"internal/bin.go":  "fmt.Sprintf(\"%s\", name)\x00",
+		})
+
+		step := batches.Step{Replace: &batches.ReplaceStep{
+			Pattern:     `fmt\.Sprintf\("%s", (\w+)\)`,
+			Replacement: `$1 /* ${{ repository.name }} */`,
+			Files:       []string{"cmd/*.go", "internal/**"},
+		}}
+
+		var stdout bytes.Buffer
+		if err := Run(ctx, step, repoDir, "", stepContext, &stdout); err != nil {
+			t.Fatal(err)
+		}
+
+		for name, want := range map[string]string{
+			"cmd/main.go":      `name /* github.com/sourcegraph/src-cli */ + other /* github.com/sourcegraph/src-cli */`,
+			"cmd/main_test.go": `name /* github.com/sourcegraph/src-cli */`,
+			"README.md":        `fmt.Sprintf("%s", name)`,
+			"internal/lib.go":  `name /* github.com/sourcegraph/src-cli */`,
+			"internal/bin.go":  "fmt.Sprintf(\"%s\", name)\x00",
+		} {
+			if have := readTestFile(t, filepath.Join(repoDir, name)); have != want {
+				t.Errorf("wrong content of %s. want=%q, have=%q", name, want, have)
+			}
+		}
+
+		wantStdout := "cmd/main.go: 2 replacements\ncmd/main_test.go: 1 replacements\ninternal/lib.go: 1 replacements\n"
+		if diff := cmp.Diff(wantStdout, stdout.String()); diff != "" {
+			t.Fatalf("wrong stdout (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("workspace path", func(t *testing.T) {
+		repoDir := t.TempDir()
+		writeTestFiles(t, repoDir, map[string]string{
+			"a/file.txt": "hello",
+			"b/file.txt": "hello",
+		})
+
+		step := batches.Step{Replace: &batches.ReplaceStep{Pattern: "hello", Replacement: "world"}}
+		if err := Run(ctx, step, repoDir, "a", stepContext, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+
+		if have := readTestFile(t, filepath.Join(repoDir, "a/file.txt")); have != "world" {
+			t.Errorf("file in workspace not replaced: %q", have)
+		}
+		if have := readTestFile(t, filepath.Join(repoDir, "b/file.txt")); have != "hello" {
+			t.Errorf("file outside of workspace replaced: %q", have)
+		}
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		step := batches.Step{Replace: &batches.ReplaceStep{Pattern: "(", Replacement: ""}}
+		if err := Run(ctx, step, t.TempDir(), "", stepContext, &bytes.Buffer{}); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+
+	t.Run("structural", func(t *testing.T) {
+		if _, err := exec.LookPath(combyPath); err != nil {
+			t.Skip("comby is not installed")
+		}
+
+		repoDir := t.TempDir()
+		writeTestFiles(t, repoDir, map[string]string{"main.go": `fmt.Sprintf("%s", strings.Join(names, ","))`})
+
+		step := batches.Step{Replace: &batches.ReplaceStep{
+			Pattern:     `fmt.Sprintf("%s", :[arg])`,
+			Replacement: `:[arg]`,
+			PatternType: batches.ReplacePatternTypeStructural,
+		}}
+		if err := Run(ctx, step, repoDir, "", stepContext, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+		if have, want := readTestFile(t, filepath.Join(repoDir, "main.go")), `strings.Join(names, ",")`; have != want {
+			t.Fatalf("wrong content. want=%q, have=%q", want, have)
+		}
+	})
+}
+
+func TestRun_WriteFiles(t *testing.T) {
+	ctx := context.Background()
+	stepContext := &template.StepContext{Repository: template.Repository{Name: "github.com/sourcegraph/src-cli"}}
+
+	repoDir := t.TempDir()
+	step := batches.Step{WriteFiles: map[string]string{
+		"README.md":                  "# ${{ repository.name }}\n",
+		"${{ repository.name }}.txt": "hello",
+		"nested/dir/CODEOWNERS":      "* @sourcegraph/batch-changes\n",
+	}}
+
+	var stdout bytes.Buffer
+	if err := Run(ctx, step, repoDir, "sub", stepContext, &stdout); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]string{
+		"sub/README.md":                          "# github.com/sourcegraph/src-cli\n",
+		"sub/github.com/sourcegraph/src-cli.txt": "hello",
+		"sub/nested/dir/CODEOWNERS":              "* @sourcegraph/batch-changes\n",
+	} {
+		if have := readTestFile(t, filepath.Join(repoDir, name)); have != want {
+			t.Errorf("wrong content of %s. want=%q, have=%q", name, want, have)
+		}
+	}
+
+	wantStdout := "README.md: written\ngithub.com/sourcegraph/src-cli.txt: written\nnested/dir/CODEOWNERS: written\n"
+	if diff := cmp.Diff(wantStdout, stdout.String()); diff != "" {
+		t.Fatalf("wrong stdout (-want +have):\n%s", diff)
+	}
+
+	for _, name := range []string{"../escape.txt", "/etc/passwd", "a/../../escape.txt"} {
+		step := batches.Step{WriteFiles: map[string]string{name: "hello"}}
+		if err := Run(ctx, step, repoDir, "sub", stepContext, &bytes.Buffer{}); err == nil {
+			t.Errorf("no error returned for path %q", name)
+		}
+	}
+}
+
+func TestRun_WriteFiles_Symlink(t *testing.T) {
+	ctx := context.Background()
+	stepContext := &template.StepContext{}
+
+	outside := t.TempDir()
+	writeTestFiles(t, outside, map[string]string{"secret": "secret"})
+
+	repoDir := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(repoDir, "docs")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(outside, "secret"), filepath.Join(repoDir, "README.md")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"docs/x", "docs/nested/x", "docs/secret", "README.md"} {
+		step := batches.Step{WriteFiles: map[string]string{name: "hello"}}
+		if err := Run(ctx, step, repoDir, "", stepContext, &bytes.Buffer{}); err == nil {
+			t.Errorf("no error returned for path %q", name)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(outside, "x")); !os.IsNotExist(err) {
+		t.Errorf("file written outside of the workspace")
+	}
+	if _, err := os.Stat(filepath.Join(outside, "nested")); !os.IsNotExist(err) {
+		t.Errorf("directory created outside of the workspace")
+	}
+	if have := readTestFile(t, filepath.Join(outside, "secret")); have != "secret" {
+		t.Errorf("file outside of the workspace overwritten: %q", have)
+	}
+}
+
+func TestRun_ApplyPatch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	ctx := context.Background()
+	repoDir := t.TempDir()
+	writeTestFiles(t, repoDir, map[string]string{"sub/README.md": "# Hello\n"})
+
+	step := batches.Step{ApplyPatch: `diff --git a/sub/README.md b/sub/README.md
+--- a/sub/README.md
++++ b/sub/README.md
+@@ -1 +1 @@
+-# Hello
++# ${{ repository.name }}
+`}
+	stepContext := &template.StepContext{Repository: template.Repository{Name: "github.com/sourcegraph/src-cli"}}
+
+	// Paths in the patch are relative to the repository, not the workspace.
+	var stdout bytes.Buffer
+	if err := Run(ctx, step, repoDir, "sub", stepContext, &stdout); err != nil {
+		t.Fatal(err)
+	}
+	if have, want := readTestFile(t, filepath.Join(repoDir, "sub/README.md")), "# github.com/sourcegraph/src-cli\n"; have != want {
+		t.Fatalf("wrong content. want=%q, have=%q", want, have)
+	}
+	if stdout.Len() == 0 {
+		t.Fatal("no stdout written")
+	}
+
+	// The patch doesn't apply anymore.
+	if err := Run(ctx, step, repoDir, "sub", stepContext, &bytes.Buffer{}); err == nil {
+		t.Fatal("no error returned")
+	}
+}
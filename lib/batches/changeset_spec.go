@@ -81,6 +81,13 @@ type ChangesetSpec struct {
 	Commits []GitCommitDescription `json:"commits,omitempty"`
 
 	Published PublishedValue `json:"published,omitempty"`
+
+	// Labels, Reviewers, Assignees and Milestone are applied to the changeset
+	// on code hosts that support them.
+	Labels    []string `json:"labels,omitempty"`
+	Reviewers []string `json:"reviewers,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Milestone string   `json:"milestone,omitempty"`
 }
 
 // MarshalJSON overwrites the default behavior of the json lib while unmarshalling
@@ -104,6 +111,10 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
 		Published      *PublishedValue        `json:"published,omitempty"`
 		Fork           *bool                  `json:"fork,omitempty"`
+		Labels         []string               `json:"labels,omitempty"`
+		Reviewers      []string               `json:"reviewers,omitempty"`
+		Assignees      []string               `json:"assignees,omitempty"`
+		Milestone      string                 `json:"milestone,omitempty"`
 	}{
 		BaseRepository: c.BaseRepository,
 		ExternalID:     c.ExternalID,
@@ -115,6 +126,10 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Body:           c.Body,
 		Commits:        c.Commits,
 		Fork:           c.Fork,
+		Labels:         c.Labels,
+		Reviewers:      c.Reviewers,
+		Assignees:      c.Assignees,
+		Milestone:      c.Milestone,
 	}
 	if !c.Published.Nil() {
 		v.Published = &c.Published
@@ -8,6 +8,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 	"github.com/sourcegraph/sourcegraph/lib/batches/git"
+	"github.com/sourcegraph/sourcegraph/lib/batches/overridable"
 	"github.com/sourcegraph/sourcegraph/lib/batches/template"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -102,12 +103,32 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 		return nil, err
 	}
 
-	newSpec := func(branch string, diff []byte) *ChangesetSpec {
+	newSpec := func(branch string, diff []byte) (*ChangesetSpec, error) {
 		var published any = nil
 		if input.Template.Published != nil {
 			published = input.Template.Published.ValueWithSuffix(input.Repository.Name, branch)
 		}
 
+		labels, err := renderChangesetTemplateList("labels", input.Template.Labels, input.Repository.Name, branch, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		reviewers, err := renderChangesetTemplateList("reviewers", input.Template.Reviewers, input.Repository.Name, branch, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		assignees, err := renderChangesetTemplateList("assignees", input.Template.Assignees, input.Repository.Name, branch, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		var milestone string
+		if input.Template.Milestone != nil {
+			milestone, err = template.RenderChangesetTemplateField("milestone", input.Template.Milestone.ValueWithSuffix(input.Repository.Name, branch), tmplCtx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		fork := input.Template.Fork
 
 		version := 1
@@ -135,7 +156,11 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 				},
 			},
 			Published: PublishedValue{Val: published},
-		}
+			Labels:    labels,
+			Reviewers: reviewers,
+			Assignees: assignees,
+			Milestone: milestone,
+		}, nil
 	}
 
 	var specs []*ChangesetSpec
@@ -154,17 +179,44 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 		}
 
 		for branch, diff := range diffsByBranch {
-			spec := newSpec(branch, diff)
+			spec, err := newSpec(branch, diff)
+			if err != nil {
+				return specs, err
+			}
 			specs = append(specs, spec)
 		}
 	} else {
-		spec := newSpec(defaultBranch, input.Result.Diff)
+		spec, err := newSpec(defaultBranch, input.Result.Diff)
+		if err != nil {
+			return specs, err
+		}
 		specs = append(specs, spec)
 	}
 
 	return specs, nil
 }
 
+// renderChangesetTemplateList renders every entry of the list that applies to
+// the given repository and branch. Entries that render to an empty string are
+// dropped, so that templates can conditionally add entries.
+func renderChangesetTemplateList(name string, l *overridable.StringList, repo, branch string, tmplCtx *template.ChangesetTemplateContext) ([]string, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	var rendered []string
+	for _, entry := range l.ValueWithSuffix(repo, branch) {
+		v, err := template.RenderChangesetTemplateField(name, entry, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+		if v != "" {
+			rendered = append(rendered, v)
+		}
+	}
+	return rendered, nil
+}
+
 type RepoFetcher func(context.Context, []string) (map[string]string, error)
 
 func BuildImportChangesetSpecs(ctx context.Context, importChangesets []ImportChangeset, repoFetcher RepoFetcher) (specs []*ChangesetSpec, errs error) {
@@ -146,6 +146,25 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "metadata",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.Labels = parseStringListFieldString(t, `["batch-change", "${{ batch_change.name }}"]`)
+				input.Template.Reviewers = parseStringListFieldString(t, `[{"*": ["alice"]}, {"github.com/sourcegraph/*": ["bob", "${{ outputs.reviewer }}"]}]`)
+				input.Template.Assignees = parseStringListFieldString(t, `[{"github.com/sourcegraph/*@another-branch-name": ["carol"]}]`)
+				input.Template.Milestone = parseStringFieldString(t, `"${{ repository.branch }}"`)
+				input.Result.Outputs = map[string]any{"reviewer": ""}
+			}),
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Labels = []string{"batch-change", "the name"}
+					s.Reviewers = []string{"bob"}
+					s.Milestone = "my-cool-base-ref"
+				}),
+			},
+			wantErr: "",
+		},
 		{
 			name:   "publish with fallback author",
 			input:  defaultInput,
@@ -350,3 +369,23 @@ func parsePublishedFieldString(t *testing.T, input string) *overridable.BoolOrSt
 	}
 	return &result
 }
+
+func parseStringListFieldString(t *testing.T, input string) *overridable.StringList {
+	t.Helper()
+
+	var result overridable.StringList
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("failed to parse %q as overridable.StringList: %s", input, err)
+	}
+	return &result
+}
+
+func parseStringFieldString(t *testing.T, input string) *overridable.String {
+	t.Helper()
+
+	var result overridable.String
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("failed to parse %q as overridable.String: %s", input, err)
+	}
+	return &result
+}
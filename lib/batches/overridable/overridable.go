@@ -4,6 +4,7 @@ package overridable
 
 import (
 	"encoding/json"
+	"reflect"
 	"strings"
 
 	"github.com/gobwas/glob"
@@ -62,7 +63,8 @@ func newRule(pattern string, value any) (*rule, error) {
 }
 
 func (a rule) Equal(b rule) bool {
-	return a.pattern == b.pattern && a.value == b.value
+	// Values may be lists, which can't be compared with ==.
+	return a.pattern == b.pattern && reflect.DeepEqual(a.value, b.value)
 }
 
 type rules []*rule
@@ -0,0 +1,100 @@
+package overridable
+
+import (
+	"encoding/json"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// String represents a string value that can be modified on a per-repo basis.
+type String struct {
+	rules rules
+}
+
+// FromString creates a String representing a static, scalar value.
+func FromString(s string) String {
+	return String{
+		rules: rules{simpleRule(s)},
+	}
+}
+
+// Value returns the string value for the given repository, or the empty
+// string if no rule matches.
+func (s *String) Value(name string) string {
+	v := s.rules.Match(name)
+	if v == nil {
+		return ""
+	}
+	return v.(string)
+}
+
+// ValueWithSuffix returns the string value for the given repository and
+// branch name, or the empty string if no rule matches.
+func (s *String) ValueWithSuffix(name, suffix string) string {
+	v := s.rules.MatchWithSuffix(name, suffix)
+	if v == nil {
+		return ""
+	}
+	return v.(string)
+}
+
+// MarshalJSON encodes the String overridable to a json representation.
+func (s String) MarshalJSON() ([]byte, error) {
+	if len(s.rules) == 0 {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(s.rules)
+}
+
+// UnmarshalJSON unmarshalls a JSON value into a String.
+func (s *String) UnmarshalJSON(data []byte) error {
+	var all string
+	if err := json.Unmarshal(data, &all); err == nil {
+		*s = String{rules: rules{simpleRule(all)}}
+		return nil
+	}
+
+	var c complex
+	if err := json.Unmarshal(data, &c); err != nil {
+		return err
+	}
+
+	return s.hydrateFromComplex(c)
+}
+
+// UnmarshalYAML unmarshalls a YAML value into a String.
+func (s *String) UnmarshalYAML(unmarshal func(any) error) error {
+	var all string
+	if err := unmarshal(&all); err == nil {
+		*s = String{rules: rules{simpleRule(all)}}
+		return nil
+	}
+
+	var c complex
+	if err := unmarshal(&c); err != nil {
+		return err
+	}
+
+	return s.hydrateFromComplex(c)
+}
+
+// hydrateFromComplex builds the rules out of a complex value, ensuring that
+// every rule value is a string.
+func (s *String) hydrateFromComplex(c complex) error {
+	if err := s.rules.hydrateFromComplex(c); err != nil {
+		return err
+	}
+
+	for i, r := range s.rules {
+		if _, ok := r.value.(string); !ok {
+			return errors.Errorf("unexpected value in the array at entry %d: %v (must be a string)", i, r.value)
+		}
+	}
+
+	return nil
+}
+
+// Equal tests two Strings for equality, used in cmp.
+func (s String) Equal(other String) bool {
+	return s.rules.Equal(other.rules)
+}
@@ -0,0 +1,110 @@
+package overridable
+
+import (
+	"encoding/json"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// StringList represents a list of strings that can be modified on a per-repo
+// basis.
+type StringList struct {
+	rules rules
+}
+
+// FromStringList creates a StringList representing a static list.
+func FromStringList(l []string) StringList {
+	return StringList{
+		rules: rules{simpleRule(l)},
+	}
+}
+
+// Value returns the list for the given repository, or nil if no rule matches.
+func (l *StringList) Value(name string) []string {
+	v := l.rules.Match(name)
+	if v == nil {
+		return nil
+	}
+	return v.([]string)
+}
+
+// ValueWithSuffix returns the list for the given repository and branch name,
+// or nil if no rule matches.
+func (l *StringList) ValueWithSuffix(name, suffix string) []string {
+	v := l.rules.MatchWithSuffix(name, suffix)
+	if v == nil {
+		return nil
+	}
+	return v.([]string)
+}
+
+// MarshalJSON encodes the StringList overridable to a json representation.
+func (l StringList) MarshalJSON() ([]byte, error) {
+	if len(l.rules) == 0 {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(l.rules)
+}
+
+// UnmarshalJSON unmarshalls a JSON value into a StringList.
+func (l *StringList) UnmarshalJSON(data []byte) error {
+	var all []string
+	if err := json.Unmarshal(data, &all); err == nil {
+		*l = StringList{rules: rules{simpleRule(all)}}
+		return nil
+	}
+
+	var c complex
+	if err := json.Unmarshal(data, &c); err != nil {
+		return err
+	}
+
+	return l.hydrateFromComplex(c)
+}
+
+// UnmarshalYAML unmarshalls a YAML value into a StringList.
+func (l *StringList) UnmarshalYAML(unmarshal func(any) error) error {
+	var all []string
+	if err := unmarshal(&all); err == nil {
+		*l = StringList{rules: rules{simpleRule(all)}}
+		return nil
+	}
+
+	var c complex
+	if err := unmarshal(&c); err != nil {
+		return err
+	}
+
+	return l.hydrateFromComplex(c)
+}
+
+// hydrateFromComplex builds the rules out of a complex value, ensuring that
+// every rule value is a list of strings.
+func (l *StringList) hydrateFromComplex(c complex) error {
+	if err := l.rules.hydrateFromComplex(c); err != nil {
+		return err
+	}
+
+	for i, r := range l.rules {
+		raw, ok := r.value.([]any)
+		if !ok {
+			return errors.Errorf("unexpected value in the array at entry %d: %v (must be a list of strings)", i, r.value)
+		}
+		values := make([]string, 0, len(raw))
+		for _, v := range raw {
+			s, ok := v.(string)
+			if !ok {
+				return errors.Errorf("unexpected value in the list at entry %d: %v (must be a string)", i, v)
+			}
+			values = append(values, s)
+		}
+		r.value = values
+	}
+
+	return nil
+}
+
+// Equal tests two StringLists for equality, used in cmp.
+func (l StringList) Equal(other StringList) bool {
+	return l.rules.Equal(other.rules)
+}
@@ -0,0 +1,154 @@
+package overridable
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v2"
+)
+
+func TestStringListValue(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     StringList
+		name   string
+		suffix string
+		want   []string
+	}{
+		"wildcard": {
+			in:   FromStringList([]string{"a", "b"}),
+			name: "foo",
+			want: []string{"a", "b"},
+		},
+		"list exhausted": {
+			in: StringList{
+				rules: rules{{pattern: "bar*", value: []string{"a"}}},
+			},
+			name: "foo",
+			want: nil,
+		},
+		"multiple matches": {
+			in: StringList{
+				rules: rules{
+					{pattern: allPattern, value: []string{"a"}},
+					{pattern: "bar*", value: []string{"b"}},
+				},
+			},
+			name: "bar",
+			want: []string{"b"},
+		},
+		"suffix": {
+			in: StringList{
+				rules: rules{
+					{pattern: allPattern, value: []string{"a"}},
+					{pattern: "bar*@main", value: []string{"b"}},
+				},
+			},
+			name:   "bar",
+			suffix: "other",
+			want:   []string{"a"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := initStringList(&tc.in); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.in.ValueWithSuffix(tc.name, tc.suffix)); diff != "" {
+				t.Errorf("unexpected value: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringListMarshalJSON(t *testing.T) {
+	l := StringList{
+		rules{
+			{pattern: allPattern, value: []string{"a"}},
+			{pattern: "bar*", value: []string{"b", "c"}},
+		},
+	}
+	data, err := json.Marshal(&l)
+	if err != nil {
+		t.Errorf("unexpected non-nil error: %v", err)
+	}
+	if have, want := string(data), `[{"*":["a"]},{"bar*":["b","c"]}]`; have != want {
+		t.Errorf("unexpected JSON: have=%q want=%q", have, want)
+	}
+}
+
+func TestStringListUnmarshal(t *testing.T) {
+	want := StringList{
+		rules: rules{
+			{pattern: allPattern, value: []string{"a"}},
+			{pattern: "github.com/sourcegraph/*", value: []string{"b", "c"}},
+		},
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			in   string
+			want StringList
+		}{
+			"list": {
+				in:   `["a","b"]`,
+				want: StringList{rules: rules{{pattern: allPattern, value: []string{"a", "b"}}}},
+			},
+			"multiple rule list": {
+				in:   `[{"*":["a"]},{"github.com/sourcegraph/*":["b","c"]}]`,
+				want: want,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var have StringList
+				if err := json.Unmarshal([]byte(tc.in), &have); err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+				if diff := cmp.Diff(&have, &tc.want); diff != "" {
+					t.Errorf("unexpected StringList: %s", diff)
+				}
+			})
+		}
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		var have StringList
+		if err := yaml.Unmarshal([]byte("- \"*\": [a]\n- github.com/sourcegraph/*: [b, c]"), &have); err != nil {
+			t.Errorf("unexpected non-nil error: %v", err)
+		}
+		if diff := cmp.Diff(&have, &want); diff != "" {
+			t.Errorf("unexpected StringList: %s", diff)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, in := range map[string]string{
+			"string":             `"foo"`,
+			"non-string element": `[1]`,
+			"non-list value":     `[{"*":"foo"}]`,
+			"non-string value":   `[{"*":[true]}]`,
+			"too many fields":    `[{"foo":["a"],"bar":["b"]}]`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				var have StringList
+				if err := json.Unmarshal([]byte(in), &have); err == nil {
+					t.Error("unexpected nil error")
+				}
+			})
+		}
+	})
+}
+
+// initStringList ensures all rules are compiled.
+func initStringList(l *StringList) (err error) {
+	for i, rule := range l.rules {
+		if rule.compiled == nil {
+			l.rules[i], err = newRule(rule.pattern, rule.value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
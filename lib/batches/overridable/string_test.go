@@ -0,0 +1,71 @@
+package overridable
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v2"
+)
+
+func TestStringValue(t *testing.T) {
+	s := FromString("v1")
+	if have, want := s.Value("foo"), "v1"; have != want {
+		t.Errorf("unexpected value: have=%q want=%q", have, want)
+	}
+
+	var overridden String
+	if err := yaml.Unmarshal([]byte("- \"*\": v1\n- github.com/sourcegraph/*: v2"), &overridden); err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{
+		"github.com/foo/bar":         "v1",
+		"github.com/sourcegraph/foo": "v2",
+	} {
+		if have := overridden.Value(name); have != want {
+			t.Errorf("unexpected value for %q: have=%q want=%q", name, have, want)
+		}
+	}
+
+	var empty String
+	if have := empty.Value("foo"); have != "" {
+		t.Errorf("unexpected value: have=%q want=%q", have, "")
+	}
+}
+
+func TestStringJSON(t *testing.T) {
+	want := String{
+		rules: rules{
+			{pattern: allPattern, value: "v1"},
+			{pattern: "github.com/sourcegraph/*", value: "v2"},
+		},
+	}
+
+	var have String
+	if err := json.Unmarshal([]byte(`[{"*":"v1"},{"github.com/sourcegraph/*":"v2"}]`), &have); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&have, &want); diff != "" {
+		t.Errorf("unexpected String: %s", diff)
+	}
+
+	data, err := json.Marshal(&have)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(data), `[{"*":"v1"},{"github.com/sourcegraph/*":"v2"}]`; have != want {
+		t.Errorf("unexpected JSON: have=%q want=%q", have, want)
+	}
+
+	for name, in := range map[string]string{
+		"bool":             `true`,
+		"non-string value": `[{"*":["v1"]}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var have String
+			if err := json.Unmarshal([]byte(in), &have); err == nil {
+				t.Error("unexpected nil error")
+			}
+		})
+	}
+}
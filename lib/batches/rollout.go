@@ -0,0 +1,83 @@
+package batches
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// HasRollout returns whether the batch spec orders the publication of its
+// changesets, by placing entries of the on field in rollout tiers or by
+// declaring dependencies between repositories.
+func (s *BatchSpec) HasRollout() bool {
+	for _, on := range s.On {
+		if on.Tier > 0 || len(on.DependsOn) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// RolloutTiers computes the rollout tier of every repository the batch spec
+// runs on. repos holds, for every entry of on, the names of the repositories
+// the entry matched.
+//
+// A repository is placed in the tier of the first entry that matched it, and
+// in a higher tier than every repository the entry depends on. Changesets in
+// a tier are only published once the changesets in all lower tiers are merged.
+func RolloutTiers(on []OnQueryOrRepository, repos [][]string) (map[string]int, error) {
+	if len(on) != len(repos) {
+		return nil, errors.Newf("got repositories for %d entries, but batch spec has %d entries", len(repos), len(on))
+	}
+
+	entries := make(map[string]int)
+	var names []string
+	for i, rs := range repos {
+		for _, name := range rs {
+			if _, ok := entries[name]; !ok {
+				entries[name] = i
+				names = append(names, name)
+			}
+		}
+	}
+
+	// Tiers are never negative, so -1 marks the repositories whose tier is
+	// being computed, to detect cycles.
+	const visiting = -1
+	tiers := make(map[string]int, len(entries))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		if tier, ok := tiers[name]; ok {
+			if tier == visiting {
+				return NewValidationError(errors.Newf("dependsOn contains a cycle: %s", strings.Join(append(path, name), " -> ")))
+			}
+			return nil
+		}
+
+		tiers[name] = visiting
+		entry := on[entries[name]]
+		tier := entry.Tier
+		for _, dep := range entry.DependsOn {
+			if _, ok := entries[dep]; !ok {
+				return NewValidationError(errors.Newf("repository %q in dependsOn of %q is not part of the batch change", dep, entry.String()))
+			}
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+			if tiers[dep] >= tier {
+				tier = tiers[dep] + 1
+			}
+		}
+		tiers[name] = tier
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return tiers, nil
+}
@@ -0,0 +1,124 @@
+package batches
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseBatchSpec_Rollout(t *testing.T) {
+	const spec = `
+name: hello-world
+on:
+  - repository: github.com/sourcegraph/lib
+  - repositoriesMatchingQuery: file:go.mod
+    tier: 1
+  - repository: github.com/sourcegraph/app
+    dependsOn:
+      - github.com/sourcegraph/lib
+`
+
+	have, err := ParseBatchSpec([]byte(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !have.HasRollout() {
+		t.Fatal("batch spec has no rollout")
+	}
+	if have.On[1].Tier != 1 {
+		t.Fatalf("wrong tier: %d", have.On[1].Tier)
+	}
+	if diff := cmp.Diff([]string{"github.com/sourcegraph/lib"}, have.On[2].DependsOn); diff != "" {
+		t.Fatalf("wrong dependsOn (-want +have):\n%s", diff)
+	}
+
+	t.Run("negative tier", func(t *testing.T) {
+		const spec = `
+name: hello-world
+on:
+  - repository: github.com/sourcegraph/lib
+    tier: -1
+`
+		if _, err := ParseBatchSpec([]byte(spec)); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+}
+
+func TestRolloutTiers(t *testing.T) {
+	const (
+		lib   = "github.com/sourcegraph/lib"
+		app   = "github.com/sourcegraph/app"
+		cli   = "github.com/sourcegraph/cli"
+		other = "github.com/sourcegraph/other"
+	)
+
+	for name, tc := range map[string]struct {
+		on      []OnQueryOrRepository
+		repos   [][]string
+		want    map[string]int
+		wantErr string
+	}{
+		"no rollout": {
+			on:    []OnQueryOrRepository{{RepositoriesMatchingQuery: "file:go.mod"}},
+			repos: [][]string{{lib, app}},
+			want:  map[string]int{lib: 0, app: 0},
+		},
+		"tiers": {
+			on: []OnQueryOrRepository{
+				{Repository: lib},
+				{RepositoriesMatchingQuery: "file:go.mod", Tier: 2},
+			},
+			// The first entry that matches a repository wins.
+			repos: [][]string{{lib}, {lib, app, cli}},
+			want:  map[string]int{lib: 0, app: 2, cli: 2},
+		},
+		"dependencies": {
+			on: []OnQueryOrRepository{
+				{Repository: app, DependsOn: []string{cli}},
+				{Repository: cli, DependsOn: []string{lib}},
+				{Repository: lib},
+				{Repository: other, Tier: 1, DependsOn: []string{lib}},
+			},
+			repos: [][]string{{app}, {cli}, {lib}, {other}},
+			want:  map[string]int{lib: 0, cli: 1, app: 2, other: 1},
+		},
+		"dependency in a higher tier": {
+			on: []OnQueryOrRepository{
+				{Repository: lib, Tier: 3},
+				{Repository: app, Tier: 1, DependsOn: []string{lib}},
+			},
+			repos: [][]string{{lib}, {app}},
+			want:  map[string]int{lib: 3, app: 4},
+		},
+		"unknown dependency": {
+			on:      []OnQueryOrRepository{{Repository: app, DependsOn: []string{lib}}},
+			repos:   [][]string{{app}},
+			wantErr: `repository "github.com/sourcegraph/lib" in dependsOn of "repository:github.com/sourcegraph/app" is not part of the batch change`,
+		},
+		"cycle": {
+			on: []OnQueryOrRepository{
+				{Repository: app, DependsOn: []string{lib}},
+				{Repository: lib, DependsOn: []string{app}},
+			},
+			repos:   [][]string{{app}, {lib}},
+			wantErr: "dependsOn contains a cycle: github.com/sourcegraph/app -> github.com/sourcegraph/lib -> github.com/sourcegraph/app",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, err := RolloutTiers(tc.on, tc.repos)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("wrong error. want=%q, have=%v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf("wrong tiers (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 
 package schema
 
-// BatchSpecJSON is the content of the file "schema/batch_spec.schema.json".
+// BatchSpecJSON is the content of the file "../schema/batch_spec.schema.json".
 const BatchSpecJSON = `{
   "$id": "batch_spec.schema.json#",
   "$schema": "http://json-schema.org/draft-07/schema#",
@@ -34,6 +34,20 @@ const BatchSpecJSON = `{
             "additionalProperties": false,
             "required": ["repositoriesMatchingQuery"],
             "properties": {
+              "tier": {
+                "type": "integer",
+                "description": "The rollout tier of the changesets in the repositories matched by this entry. Changesets are only published once all changesets of the batch change in lower tiers are merged. Defaults to 0.",
+                "minimum": 0,
+                "default": 0
+              },
+              "dependsOn": {
+                "type": "array",
+                "description": "The repositories whose changesets must be merged before the changesets in the repositories matched by this entry are published. The repositories must be part of the batch change. Changesets are placed in a higher tier than the changesets they depend on.",
+                "items": {
+                  "type": "string"
+                },
+                "examples": [["github.com/foo/library"]]
+              },
               "repositoriesMatchingQuery": {
                 "type": "string",
                 "description": "A Sourcegraph search query that matches a set of repositories (and branches). If the query matches files, symbols, or some other object inside a repository, the object's repository is included.",
@@ -48,6 +62,20 @@ const BatchSpecJSON = `{
             "additionalProperties": false,
             "required": ["repository"],
             "properties": {
+              "tier": {
+                "type": "integer",
+                "description": "The rollout tier of the changesets in the repositories matched by this entry. Changesets are only published once all changesets of the batch change in lower tiers are merged. Defaults to 0.",
+                "minimum": 0,
+                "default": 0
+              },
+              "dependsOn": {
+                "type": "array",
+                "description": "The repositories whose changesets must be merged before the changesets in the repositories matched by this entry are published. The repositories must be part of the batch change. Changesets are placed in a higher tier than the changesets they depend on.",
+                "items": {
+                  "type": "string"
+                },
+                "examples": [["github.com/foo/library"]]
+              },
               "repository": {
                 "type": "string",
                 "description": "The name of the repository (as it is known to Sourcegraph).",
@@ -121,9 +149,22 @@ const BatchSpecJSON = `{
       "items": {
         "title": "Step",
         "type": "object",
-        "description": "A command to run (as part of a sequence) in a repository branch to produce the required changes.",
+        "description": "A command to run (as part of a sequence) in a repository branch to produce the required changes. A step either runs a shell command in a container, or is a built-in step (replace, writeFiles or applyPatch) that is executed without a container.",
         "additionalProperties": false,
-        "required": ["run", "container"],
+        "anyOf": [
+          {
+            "required": ["run", "container"]
+          },
+          {
+            "required": ["replace"]
+          },
+          {
+            "required": ["writeFiles"]
+          },
+          {
+            "required": ["applyPatch"]
+          }
+        ],
         "properties": {
           "run": {
             "type": "string",
@@ -134,6 +175,57 @@ const BatchSpecJSON = `{
             "description": "The Docker image used to launch the Docker container in which the shell command is run.",
             "examples": ["alpine:3"]
           },
+          "replace": {
+            "title": "ReplaceStep",
+            "type": "object",
+            "description": "A built-in step that replaces all matches of a pattern in the files of the workspace, with the semantics of the compute replace command.",
+            "additionalProperties": false,
+            "required": ["pattern", "replacement"],
+            "properties": {
+              "pattern": {
+                "type": "string",
+                "description": "The pattern to match. Supports templating.",
+                "minLength": 1,
+                "examples": ["fmt\\.Sprintf\\(\"%s\", (\\w+)\\)", "fmt.Sprintf(\"%s\", :[arg])"]
+              },
+              "replacement": {
+                "type": "string",
+                "description": "The replacement for every match. Regular expression replacements can reference capture groups with $1 or ${name}, structural replacements can reference holes with :[hole]. Supports templating.",
+                "examples": ["$1", ":[arg]"]
+              },
+              "patternType": {
+                "type": "string",
+                "description": "How the pattern is interpreted: as a Go regular expression, or as a structural search pattern. Structural replacements require comby to be installed.",
+                "enum": ["regexp", "structural"],
+                "default": "regexp"
+              },
+              "matcher": {
+                "type": "string",
+                "description": "The comby matcher (language) used by structural replacements. If not set, the .generic matcher is used, like in the compute replace command.",
+                "examples": [".go", ".generic"]
+              },
+              "files": {
+                "type": ["array", "null"],
+                "description": "Glob patterns of the files to replace in, relative to the workspace. If not set, all files in the workspace are used. Supports templating.",
+                "items": {
+                  "type": "string"
+                },
+                "examples": [["**/*.go"], ["cmd/**", "internal/**/*_test.go"]]
+              }
+            }
+          },
+          "writeFiles": {
+            "type": ["object", "null"],
+            "description": "A built-in step that writes files into the workspace. The keys are file paths relative to the workspace, the values are the file contents. Both support templating.",
+            "additionalProperties": {
+              "type": "string"
+            }
+          },
+          "applyPatch": {
+            "type": "string",
+            "description": "A built-in step that applies a patch in the unified diff format produced by git diff to the repository. Supports templating.",
+            "minLength": 1
+          },
           "outputs": {
             "type": ["object", "null"],
             "description": "Output variables of this step that can be referenced in the changesetTemplate or other steps via outputs.<name-of-output>",
@@ -399,6 +491,199 @@ const BatchSpecJSON = `{
               }
             }
           ]
+        },
+        "labels": {
+          "description": "The labels to add to the changeset. Each label is a template. Labels that don't exist on the code host are created where the code host allows it.",
+          "oneOf": [
+            {
+              "type": "array",
+              "description": "A list of labels to apply to the changesets in every repository.",
+              "items": {
+                "type": "string"
+              }
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value is the list of labels applied to the changesets in matching repositories.",
+                "additionalProperties": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        },
+        "reviewers": {
+          "description": "The usernames of the users to request a review of the changeset from. Each username is a template, and usernames that render to an empty string are skipped.",
+          "oneOf": [
+            {
+              "type": "array",
+              "description": "A list of reviewers to apply to the changesets in every repository.",
+              "items": {
+                "type": "string"
+              }
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value is the list of reviewers applied to the changesets in matching repositories.",
+                "additionalProperties": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        },
+        "assignees": {
+          "description": "The usernames of the users to assign the changeset to. Each username is a template, and usernames that render to an empty string are skipped.",
+          "oneOf": [
+            {
+              "type": "array",
+              "description": "A list of assignees to apply to the changesets in every repository.",
+              "items": {
+                "type": "string"
+              }
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value is the list of assignees applied to the changesets in matching repositories.",
+                "additionalProperties": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        },
+        "milestone": {
+          "description": "The title of the milestone to add the changeset to. The title is a template, and the milestone must already exist on the code host.",
+          "oneOf": [
+            {
+              "type": "string",
+              "description": "The milestone of the changesets in every repository."
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value is the milestone of the changesets in matching repositories.",
+                "additionalProperties": {
+                  "type": "string"
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        }
+      }
+    },
+    "autoMerge": {
+      "title": "AutoMergePolicy",
+      "type": "object",
+      "description": "A policy to merge the changesets of the batch change automatically once their checks and reviews pass.",
+      "additionalProperties": false,
+      "properties": {
+        "method": {
+          "type": "string",
+          "description": "How changesets are merged. Squash merges are only supported on code hosts that support squash merges.",
+          "enum": ["merge", "squash"],
+          "default": "merge"
+        },
+        "checks": {
+          "type": "string",
+          "description": "The state the checks of a changeset must be in to be merged: ` + "`" + `passed` + "`" + ` requires all checks to have passed, ` + "`" + `passed-or-none` + "`" + ` also allows changesets without any checks, and ` + "`" + `any` + "`" + ` ignores checks.",
+          "enum": ["passed", "passed-or-none", "any"],
+          "default": "passed"
+        },
+        "review": {
+          "type": "string",
+          "description": "The state the reviews of a changeset must be in to be merged: ` + "`" + `approved` + "`" + ` requires the changeset to be approved, ` + "`" + `not-rejected` + "`" + ` allows any changeset without requested changes, and ` + "`" + `any` + "`" + ` ignores reviews.",
+          "enum": ["approved", "not-rejected", "any"],
+          "default": "approved"
+        },
+        "windows": {
+          "type": "array",
+          "description": "The windows within which changesets are merged, in the same format as the ` + "`" + `batchChanges.rolloutWindows` + "`" + ` site configuration. The rate of a window limits how many changesets of the batch change are merged. If omitted, changesets are merged at any time.",
+          "items": {
+            "title": "AutoMergeWindow",
+            "type": "object",
+            "required": ["rate"],
+            "additionalProperties": false,
+            "properties": {
+              "rate": {
+                "description": "The rate changesets will be merged at.",
+                "oneOf": [
+                  {
+                    "type": "number",
+                    "minimum": 0,
+                    "maximum": 0
+                  },
+                  {
+                    "type": "string",
+                    "pattern": "^(unlimited|[0-9]+\\/(sec|secs|second|seconds|min|mins|minute|minutes|hr|hrs|hour|hours))$"
+                  }
+                ]
+              },
+              "start": {
+                "description": "Window start time in UTC. If omitted, no time window is applied to the day(s) that match this rule.",
+                "type": "string",
+                "pattern": "^[0-9]?[0-9]:[0-9]{2}$"
+              },
+              "end": {
+                "description": "Window end time in UTC. If omitted, no time window is applied to the day(s) that match this rule.",
+                "type": "string",
+                "pattern": "^[0-9]?[0-9]:[0-9]{2}$"
+              },
+              "days": {
+                "description": "Day(s) the window applies to. If omitted, this rule applies to all days of the week.",
+                "type": "array",
+                "items": {
+                  "type": "string",
+                  "pattern": "^([mM]on(day)?|[tT]ue(s|sday)?|[wW]ed(nesday)?|[tT]hu(r|rs|rsday)?|[fF]ri(day)?|[sS]at(urday)?|[sS]un(day)?)$"
+                }
+              }
+            },
+            "dependencies": {
+              "start": ["end"]
+            }
+          }
+        }
+      }
+    },
+    "rerun": {
+      "title": "RerunPolicy",
+      "type": "object",
+      "description": "A schedule on which the workspaces of the batch change are resolved again. Steps are executed server-side for new and changed workspaces only, and the result is applied to the batch change automatically. Requires server-side execution.",
+      "additionalProperties": false,
+      "required": ["schedule"],
+      "properties": {
+        "schedule": {
+          "type": "string",
+          "description": "A cron expression such as ` + "`" + `0 6 * * 1` + "`" + ` or ` + "`" + `@daily` + "`" + `, evaluated in UTC.",
+          "minLength": 1
         }
       }
     }
@@ -111,7 +111,23 @@ const ChangesetSpecJSON = `{
         "published": {
           "oneOf": [{ "type": "boolean" }, { "type": "string", "pattern": "^draft$" }, { "type": "null" }],
           "description": "Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host."
-        }
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset on the code host.",
+          "items": { "type": "string" }
+        },
+        "reviewers": {
+          "type": "array",
+          "description": "The usernames of the users to request a review of the changeset from on the code host.",
+          "items": { "type": "string" }
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The usernames of the users to assign the changeset to on the code host.",
+          "items": { "type": "string" }
+        },
+        "milestone": { "type": "string", "description": "The title of the milestone to add the changeset to on the code host." }
       },
       "required": ["baseRepository", "baseRef", "baseRev", "headRepository", "headRef", "title", "body", "commits"],
       "additionalProperties": false
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS insight_series_alert_events;
+DROP TABLE IF EXISTS insight_series_alerts;
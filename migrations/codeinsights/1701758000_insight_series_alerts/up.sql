@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS insight_series_alerts (
+    id SERIAL PRIMARY KEY,
+    series_id INTEGER NOT NULL REFERENCES insight_series(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL DEFAULT 0,
+    direction TEXT,
+    notify_email BOOLEAN NOT NULL DEFAULT FALSE,
+    slack_webhook_url TEXT,
+    webhook_url TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE insight_series_alerts IS 'Alert rules of code insights series, evaluated after each snapshot of the series is recorded.';
+COMMENT ON COLUMN insight_series_alerts.user_id IS 'The user who created the alert. Alerts are evaluated with the repository permissions of this user, and emails are sent to them.';
+COMMENT ON COLUMN insight_series_alerts.kind IS 'threshold, percentage_change or new_capture_value.';
+COMMENT ON COLUMN insight_series_alerts.threshold IS 'The value a threshold alert fires at, or the percentage a percentage_change alert fires at.';
+COMMENT ON COLUMN insight_series_alerts.direction IS 'above or below. Only used by threshold alerts.';
+
+CREATE INDEX IF NOT EXISTS insight_series_alerts_series_id_idx ON insight_series_alerts (series_id);
+
+CREATE TABLE IF NOT EXISTS insight_series_alert_events (
+    id SERIAL PRIMARY KEY,
+    alert_id INTEGER NOT NULL REFERENCES insight_series_alerts(id) ON DELETE CASCADE,
+    recording_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    capture TEXT,
+    value DOUBLE PRECISION NOT NULL,
+    previous_value DOUBLE PRECISION,
+    message TEXT NOT NULL,
+    delivery_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE insight_series_alert_events IS 'The history of fired code insights alerts.';
+COMMENT ON COLUMN insight_series_alert_events.recording_time IS 'The time of the snapshot the alert fired on.';
+COMMENT ON COLUMN insight_series_alert_events.delivery_error IS 'The errors of the notifications that could not be delivered, if any.';
+
+CREATE INDEX IF NOT EXISTS insight_series_alert_events_alert_id_recording_time_idx ON insight_series_alert_events (alert_id, recording_time);
@@ -174,6 +174,67 @@ COMMENT ON COLUMN insight_series.just_in_time IS 'Specifies if the series should
 
 COMMENT ON COLUMN insight_series.repository_criteria IS 'The search criteria used to determine the repositories that are included in this series.';
 
+CREATE TABLE insight_series_alert_events (
+    id integer NOT NULL,
+    alert_id integer NOT NULL,
+    recording_time timestamp with time zone NOT NULL,
+    capture text,
+    value double precision NOT NULL,
+    previous_value double precision,
+    message text NOT NULL,
+    delivery_error text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE insight_series_alert_events IS 'The history of fired code insights alerts.';
+
+COMMENT ON COLUMN insight_series_alert_events.recording_time IS 'The time of the snapshot the alert fired on.';
+
+COMMENT ON COLUMN insight_series_alert_events.delivery_error IS 'The errors of the notifications that could not be delivered, if any.';
+
+CREATE SEQUENCE insight_series_alert_events_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE insight_series_alert_events_id_seq OWNED BY insight_series_alert_events.id;
+
+CREATE TABLE insight_series_alerts (
+    id integer NOT NULL,
+    series_id integer NOT NULL,
+    user_id integer NOT NULL,
+    kind text NOT NULL,
+    threshold double precision DEFAULT 0 NOT NULL,
+    direction text,
+    notify_email boolean DEFAULT false NOT NULL,
+    slack_webhook_url text,
+    webhook_url text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE insight_series_alerts IS 'Alert rules of code insights series, evaluated after each snapshot of the series is recorded.';
+
+COMMENT ON COLUMN insight_series_alerts.user_id IS 'The user who created the alert. Alerts are evaluated with the repository permissions of this user, and emails are sent to them.';
+
+COMMENT ON COLUMN insight_series_alerts.kind IS 'threshold, percentage_change or new_capture_value.';
+
+COMMENT ON COLUMN insight_series_alerts.threshold IS 'The value a threshold alert fires at, or the percentage a percentage_change alert fires at.';
+
+COMMENT ON COLUMN insight_series_alerts.direction IS 'above or below. Only used by threshold alerts.';
+
+CREATE SEQUENCE insight_series_alerts_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE insight_series_alerts_id_seq OWNED BY insight_series_alerts.id;
+
 CREATE TABLE insight_series_backfill (
     id integer NOT NULL,
     series_id integer NOT NULL,
@@ -541,6 +602,10 @@ ALTER TABLE ONLY dashboard_insight_view ALTER COLUMN id SET DEFAULT nextval('das
 
 ALTER TABLE ONLY insight_series ALTER COLUMN id SET DEFAULT nextval('insight_series_id_seq'::regclass);
 
+ALTER TABLE ONLY insight_series_alert_events ALTER COLUMN id SET DEFAULT nextval('insight_series_alert_events_id_seq'::regclass);
+
+ALTER TABLE ONLY insight_series_alerts ALTER COLUMN id SET DEFAULT nextval('insight_series_alerts_id_seq'::regclass);
+
 ALTER TABLE ONLY insight_series_backfill ALTER COLUMN id SET DEFAULT nextval('insight_series_backfill_id_seq'::regclass);
 
 ALTER TABLE ONLY insight_series_incomplete_points ALTER COLUMN id SET DEFAULT nextval('insight_series_incomplete_points_id_seq'::regclass);
@@ -573,6 +638,12 @@ ALTER TABLE ONLY dashboard_insight_view
 ALTER TABLE ONLY dashboard
     ADD CONSTRAINT dashboard_pk PRIMARY KEY (id);
 
+ALTER TABLE ONLY insight_series_alert_events
+    ADD CONSTRAINT insight_series_alert_events_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY insight_series_alerts
+    ADD CONSTRAINT insight_series_alerts_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY insight_series_backfill
     ADD CONSTRAINT insight_series_backfill_pk PRIMARY KEY (id);
 
@@ -627,6 +698,10 @@ CREATE INDEX dashboard_insight_view_dashboard_id_fk_idx ON dashboard_insight_vie
 
 CREATE INDEX dashboard_insight_view_insight_view_id_fk_idx ON dashboard_insight_view USING btree (insight_view_id);
 
+CREATE INDEX insight_series_alert_events_alert_id_recording_time_idx ON insight_series_alert_events USING btree (alert_id, recording_time);
+
+CREATE INDEX insight_series_alerts_series_id_idx ON insight_series_alerts USING btree (series_id);
+
 CREATE INDEX insight_series_deleted_at_idx ON insight_series USING btree (deleted_at);
 
 CREATE UNIQUE INDEX insight_series_incomplete_points_unique_idx ON insight_series_incomplete_points USING btree (series_id, reason, "time", repo_id);
@@ -686,6 +761,12 @@ ALTER TABLE ONLY dashboard_insight_view
 ALTER TABLE ONLY dashboard_insight_view
     ADD CONSTRAINT dashboard_insight_view_insight_view_id_fk FOREIGN KEY (insight_view_id) REFERENCES insight_view(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY insight_series_alert_events
+    ADD CONSTRAINT insight_series_alert_events_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES insight_series_alerts(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY insight_series_alerts
+    ADD CONSTRAINT insight_series_alerts_series_id_fkey FOREIGN KEY (series_id) REFERENCES insight_series(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY insight_series_backfill
     ADD CONSTRAINT insight_series_backfill_series_id_fk FOREIGN KEY (series_id) REFERENCES insight_series(id) ON DELETE CASCADE;
 
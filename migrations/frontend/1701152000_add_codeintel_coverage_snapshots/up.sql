@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS codeintel_coverage_snapshots (
+    id bigserial PRIMARY KEY,
+    repository_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    commit text NOT NULL,
+    path text NOT NULL,
+    total_files integer NOT NULL,
+    indexed_files integer NOT NULL,
+    indexers text[] NOT NULL DEFAULT '{}',
+    staleness_commits integer,
+    captured_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE codeintel_coverage_snapshots IS 'Periodic snapshots of the fraction of files in each directory at the tip of the default branch that are covered by precise code intelligence data.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.commit IS 'The tip of the default branch at the time of the snapshot.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.path IS 'The directory relative to the repository root, or the empty string for the repository root.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.indexers IS 'The indexers of the precise indexes covering files in this directory.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.staleness_commits IS 'The largest number of commits between the tip of the default branch and a precise index covering files in this directory. Null if no file is covered.';
+
+CREATE INDEX IF NOT EXISTS codeintel_coverage_snapshots_repository_id_path_captured_at ON codeintel_coverage_snapshots(repository_id, path, captured_at DESC);
+CREATE INDEX IF NOT EXISTS codeintel_coverage_snapshots_captured_at ON codeintel_coverage_snapshots(captured_at);
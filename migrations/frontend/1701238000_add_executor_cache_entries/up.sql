@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS executor_cache_entries (
+    id serial PRIMARY KEY,
+    queue text NOT NULL,
+    key text NOT NULL,
+    size_bytes bigint NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_accessed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE executor_cache_entries IS 'Tracks the job caches saved by executors to the executor cache upload store.';
+COMMENT ON COLUMN executor_cache_entries.key IS 'The hex-encoded SHA-256 hash of the cache key declared by the job.';
+COMMENT ON COLUMN executor_cache_entries.size_bytes IS 'The size of the cache archive in the upload store.';
+COMMENT ON COLUMN executor_cache_entries.last_accessed_at IS 'The last time the cache was restored or saved. Entries accessed least recently are evicted first.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS executor_cache_entries_queue_key ON executor_cache_entries(queue, key);
+CREATE INDEX IF NOT EXISTS executor_cache_entries_last_accessed_at ON executor_cache_entries(last_accessed_at);
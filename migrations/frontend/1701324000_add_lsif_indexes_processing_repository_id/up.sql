@@ -0,0 +1,2 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS lsif_indexes_processing_repository_id
+ON lsif_indexes USING btree (repository_id) WHERE state = 'processing';
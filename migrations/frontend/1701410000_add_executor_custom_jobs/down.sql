@@ -0,0 +1 @@
+DROP TABLE IF EXISTS executor_custom_jobs;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS executor_custom_jobs (
+    id serial PRIMARY KEY,
+    state text NOT NULL DEFAULT 'queued',
+    failure_message text,
+    queued_at timestamp with time zone NOT NULL DEFAULT now(),
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer NOT NULL DEFAULT 0,
+    num_failures integer NOT NULL DEFAULT 0,
+    last_heartbeat_at timestamp with time zone,
+    execution_logs json[],
+    worker_hostname text NOT NULL DEFAULT '',
+    cancel boolean NOT NULL DEFAULT false,
+    name text NOT NULL,
+    creator_id integer REFERENCES users(id) ON DELETE SET NULL DEFERRABLE,
+    repository_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    commit text NOT NULL,
+    steps jsonb NOT NULL,
+    files jsonb NOT NULL DEFAULT '{}'::jsonb,
+    secrets text[] NOT NULL DEFAULT '{}'::text[],
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE executor_custom_jobs IS 'General-purpose jobs enqueued by site admins or services, run by executors of the custom queue.';
+COMMENT ON COLUMN executor_custom_jobs.name IS 'A human-readable name of the job, identifying it in logs and the UI.';
+COMMENT ON COLUMN executor_custom_jobs.creator_id IS 'The user who enqueued the job. Null if the job was enqueued by a service.';
+COMMENT ON COLUMN executor_custom_jobs.repository_id IS 'The repository checked out into the workspace of the job before the steps run.';
+COMMENT ON COLUMN executor_custom_jobs.commit IS 'The commit of the repository checked out into the workspace.';
+COMMENT ON COLUMN executor_custom_jobs.steps IS 'The container steps of the job, in the order they run.';
+COMMENT ON COLUMN executor_custom_jobs.files IS 'A map from workspace-relative paths to the contents of files written into the workspace before the steps run.';
+COMMENT ON COLUMN executor_custom_jobs.secrets IS 'The keys of the executor secrets of the custom scope that are passed to the steps as environment variables.';
+
+CREATE INDEX IF NOT EXISTS executor_custom_jobs_state ON executor_custom_jobs(state);
+CREATE INDEX IF NOT EXISTS executor_custom_jobs_repository_id ON executor_custom_jobs(repository_id);
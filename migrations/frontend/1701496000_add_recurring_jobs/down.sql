@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS recurring_job_runs;
+DROP TABLE IF EXISTS recurring_jobs;
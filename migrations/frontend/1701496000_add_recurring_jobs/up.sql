@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS recurring_jobs (
+    kind text PRIMARY KEY,
+    schedule text NOT NULL,
+    next_scheduled_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE recurring_jobs IS 'The jobs registered with the recurring job scheduler, and the next time each job is due.';
+COMMENT ON COLUMN recurring_jobs.kind IS 'The unique name of the job, used to find the handler of its runs.';
+COMMENT ON COLUMN recurring_jobs.schedule IS 'The cron expression of the job. Changing the schedule of a job discards the schedule times it has missed.';
+COMMENT ON COLUMN recurring_jobs.next_scheduled_at IS 'The next time the job is due. Runs are enqueued for all schedule times up to now, subject to the catch-up policy of the job.';
+
+CREATE TABLE IF NOT EXISTS recurring_job_runs (
+    id serial PRIMARY KEY,
+    state text NOT NULL DEFAULT 'queued',
+    failure_message text,
+    queued_at timestamp with time zone NOT NULL DEFAULT now(),
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer NOT NULL DEFAULT 0,
+    num_failures integer NOT NULL DEFAULT 0,
+    last_heartbeat_at timestamp with time zone,
+    execution_logs json[],
+    worker_hostname text NOT NULL DEFAULT '',
+    cancel boolean NOT NULL DEFAULT false,
+    kind text NOT NULL REFERENCES recurring_jobs(kind) ON DELETE CASCADE,
+    scheduled_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE recurring_job_runs IS 'The runs of recurring jobs. Finished runs are kept as the history of a job.';
+COMMENT ON COLUMN recurring_job_runs.kind IS 'The job this run belongs to.';
+COMMENT ON COLUMN recurring_job_runs.scheduled_at IS 'The schedule time this run was enqueued for. Each schedule time of a job is enqueued at most once.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS recurring_job_runs_kind_scheduled_at ON recurring_job_runs(kind, scheduled_at);
+CREATE INDEX IF NOT EXISTS recurring_job_runs_state ON recurring_job_runs(state);
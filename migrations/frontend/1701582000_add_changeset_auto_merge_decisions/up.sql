@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS changeset_auto_merge_decisions (
+    changeset_id bigint NOT NULL PRIMARY KEY REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE,
+    batch_change_id bigint NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    batch_spec_id bigint NOT NULL REFERENCES batch_specs(id) ON DELETE CASCADE DEFERRABLE,
+    outcome text NOT NULL,
+    reason text NOT NULL,
+    changeset_job_id bigint REFERENCES changeset_jobs(id) ON DELETE SET NULL DEFERRABLE,
+    evaluated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE changeset_auto_merge_decisions IS 'The latest decision of the auto-merge policy of a batch change on each of its changesets.';
+COMMENT ON COLUMN changeset_auto_merge_decisions.batch_spec_id IS 'The batch spec declaring the policy the decision was made with.';
+COMMENT ON COLUMN changeset_auto_merge_decisions.outcome IS 'ENQUEUED if a merge job was enqueued, BLOCKED if the changeset does not meet the requirements of the policy, or DEFERRED if the merge window is closed or its rate limit was reached.';
+COMMENT ON COLUMN changeset_auto_merge_decisions.reason IS 'Why the changeset was or was not merged.';
+COMMENT ON COLUMN changeset_auto_merge_decisions.changeset_job_id IS 'The merge job enqueued by the decision, if any.';
+
+CREATE INDEX IF NOT EXISTS changeset_auto_merge_decisions_enqueued ON changeset_auto_merge_decisions (batch_change_id, evaluated_at) WHERE outcome = 'ENQUEUED';
@@ -0,0 +1,5 @@
+ALTER TABLE changeset_specs
+    ADD COLUMN IF NOT EXISTS labels text[] NOT NULL DEFAULT '{}'::text[],
+    ADD COLUMN IF NOT EXISTS reviewers text[] NOT NULL DEFAULT '{}'::text[],
+    ADD COLUMN IF NOT EXISTS assignees text[] NOT NULL DEFAULT '{}'::text[],
+    ADD COLUMN IF NOT EXISTS milestone text;
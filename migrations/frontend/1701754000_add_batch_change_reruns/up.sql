@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS batch_change_reruns (
+    id serial PRIMARY KEY,
+    batch_change_id bigint NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    batch_spec_id bigint REFERENCES batch_specs(id) ON DELETE SET NULL DEFERRABLE,
+    scheduled_at timestamp with time zone NOT NULL,
+    state text NOT NULL DEFAULT 'PROCESSING',
+    failure_message text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE batch_change_reruns IS 'The scheduled reruns of batch changes with a rerun policy, which resolve the workspaces of the batch change again and apply the result.';
+COMMENT ON COLUMN batch_change_reruns.batch_spec_id IS 'The batch spec created for the rerun.';
+COMMENT ON COLUMN batch_change_reruns.scheduled_at IS 'The schedule time the rerun was started for.';
+COMMENT ON COLUMN batch_change_reruns.state IS 'PROCESSING while the workspaces are resolved and executed, APPLIED once the batch spec was applied, or FAILED if the rerun was given up on.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS batch_change_reruns_batch_change_id_scheduled_at ON batch_change_reruns (batch_change_id, scheduled_at);
+CREATE UNIQUE INDEX IF NOT EXISTS batch_change_reruns_processing ON batch_change_reruns (batch_change_id) WHERE state = 'PROCESSING';
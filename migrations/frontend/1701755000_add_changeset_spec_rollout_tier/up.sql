@@ -0,0 +1 @@
+ALTER TABLE changeset_specs ADD COLUMN IF NOT EXISTS rollout_tier integer NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS batch_change_impact_stats;
+DROP TABLE IF EXISTS changeset_impact_metrics;
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS changeset_impact_metrics (
+    changeset_id bigint PRIMARY KEY REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE,
+    code_host text NOT NULL,
+    team text,
+    opened_at timestamp with time zone NOT NULL,
+    merged_at timestamp with time zone,
+    first_review_at timestamp with time zone,
+    check_runs integer NOT NULL DEFAULT 0,
+    failed_check_runs integer NOT NULL DEFAULT 0,
+    computed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE changeset_impact_metrics IS 'Impact metrics of single changesets, computed periodically from their changeset events.';
+COMMENT ON COLUMN changeset_impact_metrics.code_host IS 'The type of the code host of the changeset.';
+COMMENT ON COLUMN changeset_impact_metrics.team IS 'The team owning most of the files changed by the changeset, from CODEOWNERS or assigned teams.';
+
+CREATE TABLE IF NOT EXISTS batch_change_impact_stats (
+    batch_change_id bigint NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    dimension text NOT NULL,
+    value text NOT NULL DEFAULT '',
+    changesets integer NOT NULL,
+    merged integer NOT NULL,
+    reviewed integer NOT NULL,
+    time_to_merge_p50_seconds bigint,
+    time_to_merge_p90_seconds bigint,
+    time_to_merge_buckets integer[] NOT NULL,
+    review_latency_p50_seconds bigint,
+    review_latency_p90_seconds bigint,
+    check_runs integer NOT NULL,
+    failed_check_runs integer NOT NULL,
+    computed_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (batch_change_id, dimension, value)
+);
+
+COMMENT ON TABLE batch_change_impact_stats IS 'Aggregated impact analytics of batch changes, computed periodically from the changeset events of their changesets.';
+COMMENT ON COLUMN batch_change_impact_stats.dimension IS 'What the stats are broken down by: all, code_host or team.';
+COMMENT ON COLUMN batch_change_impact_stats.value IS 'The code host type or team the stats are for. Empty for the all dimension and for changesets without an owning team.';
+COMMENT ON COLUMN batch_change_impact_stats.changesets IS 'The number of published changesets.';
+COMMENT ON COLUMN batch_change_impact_stats.time_to_merge_buckets IS 'The number of merged changesets per time to merge: under an hour, a day, a week, 30 days and longer.';
+COMMENT ON COLUMN batch_change_impact_stats.check_runs IS 'The number of finished check runs, commit statuses, pipelines and builds.';
@@ -0,0 +1,4 @@
+DELETE FROM own_signal_configurations
+WHERE name = 'recent-reviewers';
+
+DROP TABLE IF EXISTS own_aggregate_recent_review;
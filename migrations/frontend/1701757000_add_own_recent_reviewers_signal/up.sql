@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS own_aggregate_recent_review (
+    id SERIAL PRIMARY KEY,
+    reviewer_handle TEXT NOT NULL,
+    reviewed_file_path_id INTEGER NOT NULL REFERENCES repo_paths(id),
+    reviews_count INTEGER NOT NULL DEFAULT 0,
+    last_reviewed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+COMMENT ON TABLE own_aggregate_recent_review IS 'One entry contains the number of reviewed changes of a single file or directory by a given reviewer.';
+COMMENT ON COLUMN own_aggregate_recent_review.reviewer_handle IS 'The code host handle of the reviewer.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS own_aggregate_recent_review_reviewer ON own_aggregate_recent_review USING btree (reviewed_file_path_id, reviewer_handle);
+
+INSERT INTO own_signal_configurations (name, enabled, description)
+VALUES (
+        'recent-reviewers',
+        FALSE,
+        'Indexes reviewers of recently merged and open changesets in each file using code host review events.'
+    ) ON CONFLICT DO NOTHING;
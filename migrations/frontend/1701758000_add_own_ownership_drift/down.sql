@@ -0,0 +1,4 @@
+DELETE FROM own_signal_configurations
+WHERE name = 'ownership-drift';
+
+DROP TABLE IF EXISTS own_drift_findings;
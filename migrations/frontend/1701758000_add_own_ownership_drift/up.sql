@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS own_drift_findings (
+    id SERIAL PRIMARY KEY,
+    file_path_id INTEGER NOT NULL REFERENCES repo_paths(id),
+    kind TEXT NOT NULL,
+    owner TEXT NOT NULL,
+    source TEXT NOT NULL,
+    computed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE own_drift_findings IS 'One entry flags a declared owner of a single file as orphaned or drifting away from the file.';
+COMMENT ON COLUMN own_drift_findings.kind IS 'One of deactivated-owner, empty-team or stale-owner.';
+COMMENT ON COLUMN own_drift_findings.owner IS 'The owner as declared, that is a CODEOWNERS handle or email, or the name of an assigned user or team.';
+COMMENT ON COLUMN own_drift_findings.source IS 'Where the owner is declared, either codeowners or assigned.';
+
+CREATE UNIQUE INDEX IF NOT EXISTS own_drift_findings_file_kind_owner ON own_drift_findings USING btree (file_path_id, kind, owner);
+
+INSERT INTO own_signal_configurations (name, enabled, description)
+VALUES (
+        'ownership-drift',
+        FALSE,
+        'Flags files whose declared owners are deactivated users, empty teams, or have not contributed to the file recently while others have.'
+    ) ON CONFLICT DO NOTHING;
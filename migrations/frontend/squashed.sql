@@ -960,6 +960,64 @@ CREATE SEQUENCE assigned_teams_id_seq
 
 ALTER SEQUENCE assigned_teams_id_seq OWNED BY assigned_teams.id;
 
+CREATE TABLE batch_change_impact_stats (
+    batch_change_id bigint NOT NULL,
+    dimension text NOT NULL,
+    value text DEFAULT ''::text NOT NULL,
+    changesets integer NOT NULL,
+    merged integer NOT NULL,
+    reviewed integer NOT NULL,
+    time_to_merge_p50_seconds bigint,
+    time_to_merge_p90_seconds bigint,
+    time_to_merge_buckets integer[] NOT NULL,
+    review_latency_p50_seconds bigint,
+    review_latency_p90_seconds bigint,
+    check_runs integer NOT NULL,
+    failed_check_runs integer NOT NULL,
+    computed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE batch_change_impact_stats IS 'Aggregated impact analytics of batch changes, computed periodically from the changeset events of their changesets.';
+
+COMMENT ON COLUMN batch_change_impact_stats.dimension IS 'What the stats are broken down by: all, code_host or team.';
+
+COMMENT ON COLUMN batch_change_impact_stats.value IS 'The code host type or team the stats are for. Empty for the all dimension and for changesets without an owning team.';
+
+COMMENT ON COLUMN batch_change_impact_stats.changesets IS 'The number of published changesets.';
+
+COMMENT ON COLUMN batch_change_impact_stats.time_to_merge_buckets IS 'The number of merged changesets per time to merge: under an hour, a day, a week, 30 days and longer.';
+
+COMMENT ON COLUMN batch_change_impact_stats.check_runs IS 'The number of finished check runs, commit statuses, pipelines and builds.';
+
+CREATE TABLE batch_change_reruns (
+    id integer NOT NULL,
+    batch_change_id bigint NOT NULL,
+    batch_spec_id bigint,
+    scheduled_at timestamp with time zone NOT NULL,
+    state text DEFAULT 'PROCESSING'::text NOT NULL,
+    failure_message text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE batch_change_reruns IS 'The scheduled reruns of batch changes with a rerun policy, which resolve the workspaces of the batch change again and apply the result.';
+
+COMMENT ON COLUMN batch_change_reruns.batch_spec_id IS 'The batch spec created for the rerun.';
+
+COMMENT ON COLUMN batch_change_reruns.scheduled_at IS 'The schedule time the rerun was started for.';
+
+COMMENT ON COLUMN batch_change_reruns.state IS 'PROCESSING while the workspaces are resolved and executed, APPLIED once the batch spec was applied, or FAILED if the rerun was given up on.';
+
+CREATE SEQUENCE batch_change_reruns_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE batch_change_reruns_id_seq OWNED BY batch_change_reruns.id;
+
 CREATE TABLE batch_changes (
     id bigint NOT NULL,
     name text NOT NULL,
@@ -1229,6 +1287,11 @@ CREATE TABLE changeset_specs (
     commit_author_name text,
     commit_author_email text,
     type text NOT NULL,
+    labels text[] DEFAULT '{}'::text[] NOT NULL,
+    reviewers text[] DEFAULT '{}'::text[] NOT NULL,
+    assignees text[] DEFAULT '{}'::text[] NOT NULL,
+    milestone text,
+    rollout_tier integer DEFAULT 0 NOT NULL,
     CONSTRAINT changeset_specs_published_valid_values CHECK (((published = 'true'::text) OR (published = 'false'::text) OR (published = '"draft"'::text) OR (published IS NULL)))
 );
 
@@ -1286,6 +1349,108 @@ CREATE TABLE changesets (
 
 COMMENT ON COLUMN changesets.external_title IS 'Normalized property generated on save using Changeset.Title()';
 
+CREATE TABLE codeintel_coverage_snapshots (
+    id bigint NOT NULL,
+    repository_id integer NOT NULL,
+    commit text NOT NULL,
+    path text NOT NULL,
+    total_files integer NOT NULL,
+    indexed_files integer NOT NULL,
+    indexers text[] DEFAULT '{}'::text[] NOT NULL,
+    staleness_commits integer,
+    captured_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE codeintel_coverage_snapshots IS 'Periodic snapshots of the fraction of files in each directory at the tip of the default branch that are covered by precise code intelligence data.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.commit IS 'The tip of the default branch at the time of the snapshot.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.path IS 'The directory relative to the repository root, or the empty string for the repository root.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.indexers IS 'The indexers of the precise indexes covering files in this directory.';
+COMMENT ON COLUMN codeintel_coverage_snapshots.staleness_commits IS 'The largest number of commits between the tip of the default branch and a precise index covering files in this directory. Null if no file is covered.';
+
+CREATE SEQUENCE codeintel_coverage_snapshots_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE codeintel_coverage_snapshots_id_seq OWNED BY codeintel_coverage_snapshots.id;
+
+CREATE TABLE executor_cache_entries (
+    id integer NOT NULL,
+    queue text NOT NULL,
+    key text NOT NULL,
+    size_bytes bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    last_accessed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE executor_cache_entries IS 'Tracks the job caches saved by executors to the executor cache upload store.';
+COMMENT ON COLUMN executor_cache_entries.key IS 'The hex-encoded SHA-256 hash of the cache key declared by the job.';
+COMMENT ON COLUMN executor_cache_entries.size_bytes IS 'The size of the cache archive in the upload store.';
+COMMENT ON COLUMN executor_cache_entries.last_accessed_at IS 'The last time the cache was restored or saved. Entries accessed least recently are evicted first.';
+
+CREATE SEQUENCE executor_cache_entries_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE executor_cache_entries_id_seq OWNED BY executor_cache_entries.id;
+
+CREATE TABLE executor_custom_jobs (
+    id integer NOT NULL,
+    state text DEFAULT 'queued'::text NOT NULL,
+    failure_message text,
+    queued_at timestamp with time zone DEFAULT now() NOT NULL,
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer DEFAULT 0 NOT NULL,
+    num_failures integer DEFAULT 0 NOT NULL,
+    last_heartbeat_at timestamp with time zone,
+    execution_logs json[],
+    worker_hostname text DEFAULT ''::text NOT NULL,
+    cancel boolean DEFAULT false NOT NULL,
+    name text NOT NULL,
+    creator_id integer,
+    repository_id integer NOT NULL,
+    commit text NOT NULL,
+    steps jsonb NOT NULL,
+    files jsonb DEFAULT '{}'::jsonb NOT NULL,
+    secrets text[] DEFAULT '{}'::text[] NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE executor_custom_jobs IS 'General-purpose jobs enqueued by site admins or services, run by executors of the custom queue.';
+
+COMMENT ON COLUMN executor_custom_jobs.name IS 'A human-readable name of the job, identifying it in logs and the UI.';
+
+COMMENT ON COLUMN executor_custom_jobs.creator_id IS 'The user who enqueued the job. Null if the job was enqueued by a service.';
+
+COMMENT ON COLUMN executor_custom_jobs.repository_id IS 'The repository checked out into the workspace of the job before the steps run.';
+
+COMMENT ON COLUMN executor_custom_jobs.commit IS 'The commit of the repository checked out into the workspace.';
+
+COMMENT ON COLUMN executor_custom_jobs.steps IS 'The container steps of the job, in the order they run.';
+
+COMMENT ON COLUMN executor_custom_jobs.files IS 'A map from workspace-relative paths to the contents of files written into the workspace before the steps run.';
+
+COMMENT ON COLUMN executor_custom_jobs.secrets IS 'The keys of the executor secrets of the custom scope that are passed to the steps as environment variables.';
+
+CREATE SEQUENCE executor_custom_jobs_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE executor_custom_jobs_id_seq OWNED BY executor_custom_jobs.id;
+
 CREATE TABLE repo (
     id integer NOT NULL,
     name citext NOT NULL,
@@ -1344,6 +1509,26 @@ CREATE SEQUENCE cached_available_indexers_id_seq
 
 ALTER SEQUENCE cached_available_indexers_id_seq OWNED BY cached_available_indexers.id;
 
+CREATE TABLE changeset_auto_merge_decisions (
+    changeset_id bigint NOT NULL,
+    batch_change_id bigint NOT NULL,
+    batch_spec_id bigint NOT NULL,
+    outcome text NOT NULL,
+    reason text NOT NULL,
+    changeset_job_id bigint,
+    evaluated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE changeset_auto_merge_decisions IS 'The latest decision of the auto-merge policy of a batch change on each of its changesets.';
+
+COMMENT ON COLUMN changeset_auto_merge_decisions.batch_spec_id IS 'The batch spec declaring the policy the decision was made with.';
+
+COMMENT ON COLUMN changeset_auto_merge_decisions.outcome IS 'ENQUEUED if a merge job was enqueued, BLOCKED if the changeset does not meet the requirements of the policy, or DEFERRED if the merge window is closed or its rate limit was reached.';
+
+COMMENT ON COLUMN changeset_auto_merge_decisions.reason IS 'Why the changeset was or was not merged.';
+
+COMMENT ON COLUMN changeset_auto_merge_decisions.changeset_job_id IS 'The merge job enqueued by the decision, if any.';
+
 CREATE TABLE changeset_events (
     id bigint NOT NULL,
     changeset_id bigint NOT NULL,
@@ -1366,6 +1551,24 @@ CREATE SEQUENCE changeset_events_id_seq
 
 ALTER SEQUENCE changeset_events_id_seq OWNED BY changeset_events.id;
 
+CREATE TABLE changeset_impact_metrics (
+    changeset_id bigint NOT NULL,
+    code_host text NOT NULL,
+    team text,
+    opened_at timestamp with time zone NOT NULL,
+    merged_at timestamp with time zone,
+    first_review_at timestamp with time zone,
+    check_runs integer DEFAULT 0 NOT NULL,
+    failed_check_runs integer DEFAULT 0 NOT NULL,
+    computed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE changeset_impact_metrics IS 'Impact metrics of single changesets, computed periodically from their changeset events.';
+
+COMMENT ON COLUMN changeset_impact_metrics.code_host IS 'The type of the code host of the changeset.';
+
+COMMENT ON COLUMN changeset_impact_metrics.team IS 'The team owning most of the files changed by the changeset, from CODEOWNERS or assigned teams.';
+
 CREATE TABLE changeset_jobs (
     id bigint NOT NULL,
     bulk_group text NOT NULL,
@@ -3876,6 +4079,28 @@ CREATE SEQUENCE own_aggregate_recent_contribution_id_seq
 
 ALTER SEQUENCE own_aggregate_recent_contribution_id_seq OWNED BY own_aggregate_recent_contribution.id;
 
+CREATE TABLE own_aggregate_recent_review (
+    id integer NOT NULL,
+    reviewer_handle text NOT NULL,
+    reviewed_file_path_id integer NOT NULL,
+    reviews_count integer DEFAULT 0 NOT NULL,
+    last_reviewed_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE own_aggregate_recent_review IS 'One entry contains the number of reviewed changes of a single file or directory by a given reviewer.';
+
+COMMENT ON COLUMN own_aggregate_recent_review.reviewer_handle IS 'The code host handle of the reviewer.';
+
+CREATE SEQUENCE own_aggregate_recent_review_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE own_aggregate_recent_review_id_seq OWNED BY own_aggregate_recent_review.id;
+
 CREATE TABLE own_aggregate_recent_view (
     id integer NOT NULL,
     viewer_id integer NOT NULL,
@@ -3913,6 +4138,33 @@ CREATE TABLE own_background_jobs (
     job_type integer NOT NULL
 );
 
+CREATE TABLE own_drift_findings (
+    id integer NOT NULL,
+    file_path_id integer NOT NULL,
+    kind text NOT NULL,
+    owner text NOT NULL,
+    source text NOT NULL,
+    computed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE own_drift_findings IS 'One entry flags a declared owner of a single file as orphaned or drifting away from the file.';
+
+COMMENT ON COLUMN own_drift_findings.kind IS 'One of deactivated-owner, empty-team or stale-owner.';
+
+COMMENT ON COLUMN own_drift_findings.owner IS 'The owner as declared, that is a CODEOWNERS handle or email, or the name of an assigned user or team.';
+
+COMMENT ON COLUMN own_drift_findings.source IS 'Where the owner is declared, either codeowners or assigned.';
+
+CREATE SEQUENCE own_drift_findings_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE own_drift_findings_id_seq OWNED BY own_drift_findings.id;
+
 CREATE TABLE own_signal_configurations (
     id integer NOT NULL,
     name text NOT NULL,
@@ -4257,6 +4509,56 @@ CREATE VIEW reconciler_changesets AS
              LEFT JOIN orgs namespace_org ON ((batch_changes.namespace_org_id = namespace_org.id)))
           WHERE ((c.batch_change_ids ? (batch_changes.id)::text) AND (namespace_user.deleted_at IS NULL) AND (namespace_org.deleted_at IS NULL)))));
 
+CREATE TABLE recurring_job_runs (
+    id integer NOT NULL,
+    state text DEFAULT 'queued'::text NOT NULL,
+    failure_message text,
+    queued_at timestamp with time zone DEFAULT now() NOT NULL,
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    process_after timestamp with time zone,
+    num_resets integer DEFAULT 0 NOT NULL,
+    num_failures integer DEFAULT 0 NOT NULL,
+    last_heartbeat_at timestamp with time zone,
+    execution_logs json[],
+    worker_hostname text DEFAULT ''::text NOT NULL,
+    cancel boolean DEFAULT false NOT NULL,
+    kind text NOT NULL,
+    scheduled_at timestamp with time zone NOT NULL
+);
+
+COMMENT ON TABLE recurring_job_runs IS 'The runs of recurring jobs. Finished runs are kept as the history of a job.';
+
+COMMENT ON COLUMN recurring_job_runs.kind IS 'The job this run belongs to.';
+
+COMMENT ON COLUMN recurring_job_runs.scheduled_at IS 'The schedule time this run was enqueued for. Each schedule time of a job is enqueued at most once.';
+
+CREATE SEQUENCE recurring_job_runs_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER SEQUENCE recurring_job_runs_id_seq OWNED BY recurring_job_runs.id;
+
+CREATE TABLE recurring_jobs (
+    kind text NOT NULL,
+    schedule text NOT NULL,
+    next_scheduled_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+COMMENT ON TABLE recurring_jobs IS 'The jobs registered with the recurring job scheduler, and the next time each job is due.';
+
+COMMENT ON COLUMN recurring_jobs.kind IS 'The unique name of the job, used to find the handler of its runs.';
+
+COMMENT ON COLUMN recurring_jobs.schedule IS 'The cron expression of the job. Changing the schedule of a job discards the schedule times it has missed.';
+
+COMMENT ON COLUMN recurring_jobs.next_scheduled_at IS 'The next time the job is due. Runs are enqueued for all schedule times up to now, subject to the catch-up policy of the job.';
+
 CREATE TABLE redis_key_value (
     namespace text NOT NULL,
     key text NOT NULL,
@@ -5034,6 +5336,8 @@ ALTER TABLE ONLY assigned_owners ALTER COLUMN id SET DEFAULT nextval('assigned_o
 
 ALTER TABLE ONLY assigned_teams ALTER COLUMN id SET DEFAULT nextval('assigned_teams_id_seq'::regclass);
 
+ALTER TABLE ONLY batch_change_reruns ALTER COLUMN id SET DEFAULT nextval('batch_change_reruns_id_seq'::regclass);
+
 ALTER TABLE ONLY batch_changes ALTER COLUMN id SET DEFAULT nextval('batch_changes_id_seq'::regclass);
 
 ALTER TABLE ONLY batch_changes_site_credentials ALTER COLUMN id SET DEFAULT nextval('batch_changes_site_credentials_id_seq'::regclass);
@@ -5082,6 +5386,8 @@ ALTER TABLE ONLY codeintel_autoindex_queue ALTER COLUMN id SET DEFAULT nextval('
 
 ALTER TABLE ONLY codeintel_autoindexing_exceptions ALTER COLUMN id SET DEFAULT nextval('codeintel_autoindexing_exceptions_id_seq'::regclass);
 
+ALTER TABLE ONLY codeintel_coverage_snapshots ALTER COLUMN id SET DEFAULT nextval('codeintel_coverage_snapshots_id_seq'::regclass);
+
 ALTER TABLE ONLY codeintel_initial_path_ranks ALTER COLUMN id SET DEFAULT nextval('codeintel_initial_path_ranks_id_seq'::regclass);
 
 ALTER TABLE ONLY codeintel_initial_path_ranks_processed ALTER COLUMN id SET DEFAULT nextval('codeintel_initial_path_ranks_processed_id_seq'::regclass);
@@ -5130,6 +5436,10 @@ ALTER TABLE ONLY event_logs_scrape_state ALTER COLUMN id SET DEFAULT nextval('ev
 
 ALTER TABLE ONLY event_logs_scrape_state_own ALTER COLUMN id SET DEFAULT nextval('event_logs_scrape_state_own_id_seq'::regclass);
 
+ALTER TABLE ONLY executor_cache_entries ALTER COLUMN id SET DEFAULT nextval('executor_cache_entries_id_seq'::regclass);
+
+ALTER TABLE ONLY executor_custom_jobs ALTER COLUMN id SET DEFAULT nextval('executor_custom_jobs_id_seq'::regclass);
+
 ALTER TABLE ONLY executor_heartbeats ALTER COLUMN id SET DEFAULT nextval('executor_heartbeats_id_seq'::regclass);
 
 ALTER TABLE ONLY executor_job_tokens ALTER COLUMN id SET DEFAULT nextval('executor_job_tokens_id_seq'::regclass);
@@ -5208,10 +5518,14 @@ ALTER TABLE ONLY outbound_webhooks ALTER COLUMN id SET DEFAULT nextval('outbound
 
 ALTER TABLE ONLY own_aggregate_recent_contribution ALTER COLUMN id SET DEFAULT nextval('own_aggregate_recent_contribution_id_seq'::regclass);
 
+ALTER TABLE ONLY own_aggregate_recent_review ALTER COLUMN id SET DEFAULT nextval('own_aggregate_recent_review_id_seq'::regclass);
+
 ALTER TABLE ONLY own_aggregate_recent_view ALTER COLUMN id SET DEFAULT nextval('own_aggregate_recent_view_id_seq'::regclass);
 
 ALTER TABLE ONLY own_background_jobs ALTER COLUMN id SET DEFAULT nextval('own_background_jobs_id_seq'::regclass);
 
+ALTER TABLE ONLY own_drift_findings ALTER COLUMN id SET DEFAULT nextval('own_drift_findings_id_seq'::regclass);
+
 ALTER TABLE ONLY own_signal_configurations ALTER COLUMN id SET DEFAULT nextval('own_signal_configurations_id_seq'::regclass);
 
 ALTER TABLE ONLY own_signal_recent_contribution ALTER COLUMN id SET DEFAULT nextval('own_signal_recent_contribution_id_seq'::regclass);
@@ -5226,6 +5540,8 @@ ALTER TABLE ONLY permissions ALTER COLUMN id SET DEFAULT nextval('permissions_id
 
 ALTER TABLE ONLY phabricator_repos ALTER COLUMN id SET DEFAULT nextval('phabricator_repos_id_seq'::regclass);
 
+ALTER TABLE ONLY recurring_job_runs ALTER COLUMN id SET DEFAULT nextval('recurring_job_runs_id_seq'::regclass);
+
 ALTER TABLE ONLY registry_extension_releases ALTER COLUMN id SET DEFAULT nextval('registry_extension_releases_id_seq'::regclass);
 
 ALTER TABLE ONLY registry_extensions ALTER COLUMN id SET DEFAULT nextval('registry_extensions_id_seq'::regclass);
@@ -5299,6 +5615,12 @@ ALTER TABLE ONLY assigned_owners
 ALTER TABLE ONLY assigned_teams
     ADD CONSTRAINT assigned_teams_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY batch_change_impact_stats
+    ADD CONSTRAINT batch_change_impact_stats_pkey PRIMARY KEY (batch_change_id, dimension, value);
+
+ALTER TABLE ONLY batch_change_reruns
+    ADD CONSTRAINT batch_change_reruns_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY batch_changes
     ADD CONSTRAINT batch_changes_pkey PRIMARY KEY (id);
 
@@ -5335,12 +5657,18 @@ ALTER TABLE ONLY batch_specs
 ALTER TABLE ONLY cached_available_indexers
     ADD CONSTRAINT cached_available_indexers_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY changeset_auto_merge_decisions
+    ADD CONSTRAINT changeset_auto_merge_decisions_pkey PRIMARY KEY (changeset_id);
+
 ALTER TABLE ONLY changeset_events
     ADD CONSTRAINT changeset_events_changeset_id_kind_key_unique UNIQUE (changeset_id, kind, key);
 
 ALTER TABLE ONLY changeset_events
     ADD CONSTRAINT changeset_events_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY changeset_impact_metrics
+    ADD CONSTRAINT changeset_impact_metrics_pkey PRIMARY KEY (changeset_id);
+
 ALTER TABLE ONLY changeset_jobs
     ADD CONSTRAINT changeset_jobs_pkey PRIMARY KEY (id);
 
@@ -5398,6 +5726,9 @@ ALTER TABLE ONLY codeintel_autoindexing_exceptions
 ALTER TABLE ONLY codeintel_commit_dates
     ADD CONSTRAINT codeintel_commit_dates_pkey PRIMARY KEY (repository_id, commit_bytea);
 
+ALTER TABLE ONLY codeintel_coverage_snapshots
+    ADD CONSTRAINT codeintel_coverage_snapshots_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY codeintel_initial_path_ranks
     ADD CONSTRAINT codeintel_initial_path_ranks_pkey PRIMARY KEY (id);
 
@@ -5476,6 +5807,12 @@ ALTER TABLE ONLY event_logs_scrape_state_own
 ALTER TABLE ONLY event_logs_scrape_state
     ADD CONSTRAINT event_logs_scrape_state_pk PRIMARY KEY (id);
 
+ALTER TABLE ONLY executor_cache_entries
+    ADD CONSTRAINT executor_cache_entries_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY executor_custom_jobs
+    ADD CONSTRAINT executor_custom_jobs_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY executor_heartbeats
     ADD CONSTRAINT executor_heartbeats_hostname_key UNIQUE (hostname);
 
@@ -5653,12 +5990,18 @@ ALTER TABLE ONLY outbound_webhooks
 ALTER TABLE ONLY own_aggregate_recent_contribution
     ADD CONSTRAINT own_aggregate_recent_contribution_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY own_aggregate_recent_review
+    ADD CONSTRAINT own_aggregate_recent_review_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY own_aggregate_recent_view
     ADD CONSTRAINT own_aggregate_recent_view_pkey PRIMARY KEY (id);
 
 ALTER TABLE ONLY own_background_jobs
     ADD CONSTRAINT own_background_jobs_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY own_drift_findings
+    ADD CONSTRAINT own_drift_findings_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY own_signal_configurations
     ADD CONSTRAINT own_signal_configurations_pkey PRIMARY KEY (id);
 
@@ -5692,6 +6035,12 @@ ALTER TABLE ONLY product_licenses
 ALTER TABLE ONLY product_subscriptions
     ADD CONSTRAINT product_subscriptions_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY recurring_job_runs
+    ADD CONSTRAINT recurring_job_runs_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY recurring_jobs
+    ADD CONSTRAINT recurring_jobs_pkey PRIMARY KEY (kind);
+
 ALTER TABLE ONLY redis_key_value
     ADD CONSTRAINT redis_key_value_pkey PRIMARY KEY (namespace, key) INCLUDE (value);
 
@@ -5851,6 +6200,10 @@ CREATE UNIQUE INDEX assigned_owners_file_path_owner ON assigned_owners USING btr
 
 CREATE UNIQUE INDEX assigned_teams_file_path_owner ON assigned_teams USING btree (file_path_id, owner_team_id);
 
+CREATE UNIQUE INDEX batch_change_reruns_batch_change_id_scheduled_at ON batch_change_reruns USING btree (batch_change_id, scheduled_at);
+
+CREATE UNIQUE INDEX batch_change_reruns_processing ON batch_change_reruns USING btree (batch_change_id) WHERE (state = 'PROCESSING'::text);
+
 CREATE INDEX batch_changes_namespace_org_id ON batch_changes USING btree (namespace_org_id);
 
 CREATE INDEX batch_changes_namespace_user_id ON batch_changes USING btree (namespace_user_id);
@@ -5887,6 +6240,8 @@ CREATE INDEX cached_available_indexers_num_events ON cached_available_indexers U
 
 CREATE UNIQUE INDEX cached_available_indexers_repository_id ON cached_available_indexers USING btree (repository_id);
 
+CREATE INDEX changeset_auto_merge_decisions_enqueued ON changeset_auto_merge_decisions USING btree (batch_change_id, evaluated_at) WHERE (outcome = 'ENQUEUED'::text);
+
 CREATE INDEX changeset_jobs_bulk_group_idx ON changeset_jobs USING btree (bulk_group);
 
 CREATE INDEX changeset_jobs_state_idx ON changeset_jobs USING btree (state);
@@ -5935,6 +6290,10 @@ CREATE INDEX cm_webhooks_monitor ON cm_webhooks USING btree (monitor);
 
 CREATE UNIQUE INDEX codeintel_autoindex_queue_repository_id_commit ON codeintel_autoindex_queue USING btree (repository_id, rev);
 
+CREATE INDEX codeintel_coverage_snapshots_captured_at ON codeintel_coverage_snapshots USING btree (captured_at);
+
+CREATE INDEX codeintel_coverage_snapshots_repository_id_path_captured_at ON codeintel_coverage_snapshots USING btree (repository_id, path, captured_at DESC);
+
 CREATE INDEX codeintel_initial_path_ranks_exported_upload_id ON codeintel_initial_path_ranks USING btree (exported_upload_id);
 
 CREATE INDEX codeintel_initial_path_ranks_graph_key_id ON codeintel_initial_path_ranks USING btree (graph_key, id);
@@ -6011,6 +6370,14 @@ CREATE INDEX event_logs_user_id_name ON event_logs USING btree (user_id, name);
 
 CREATE INDEX event_logs_user_id_timestamp ON event_logs USING btree (user_id, "timestamp");
 
+CREATE INDEX executor_cache_entries_last_accessed_at ON executor_cache_entries USING btree (last_accessed_at);
+
+CREATE UNIQUE INDEX executor_cache_entries_queue_key ON executor_cache_entries USING btree (queue, key);
+
+CREATE INDEX executor_custom_jobs_repository_id ON executor_custom_jobs USING btree (repository_id);
+
+CREATE INDEX executor_custom_jobs_state ON executor_custom_jobs USING btree (state);
+
 CREATE UNIQUE INDEX executor_secrets_unique_key_global ON executor_secrets USING btree (key, scope) WHERE ((namespace_user_id IS NULL) AND (namespace_org_id IS NULL));
 
 CREATE UNIQUE INDEX executor_secrets_unique_key_namespace_org ON executor_secrets USING btree (key, namespace_org_id, scope) WHERE (namespace_org_id IS NOT NULL);
@@ -6115,6 +6482,8 @@ CREATE INDEX lsif_indexes_commit_last_checked_at ON lsif_indexes USING btree (co
 
 CREATE INDEX lsif_indexes_dequeue_order_idx ON lsif_indexes USING btree (((enqueuer_user_id > 0)) DESC, queued_at DESC, id) WHERE ((state = 'queued'::text) OR (state = 'errored'::text));
 
+CREATE INDEX lsif_indexes_processing_repository_id ON lsif_indexes USING btree (repository_id) WHERE (state = 'processing'::text);
+
 CREATE INDEX lsif_indexes_queued_at_id ON lsif_indexes USING btree (queued_at DESC, id);
 
 CREATE INDEX lsif_indexes_repository_id_commit ON lsif_indexes USING btree (repository_id, commit);
@@ -6195,12 +6564,16 @@ CREATE INDEX outbound_webhooks_logs_status_code_idx ON outbound_webhook_logs USI
 
 CREATE UNIQUE INDEX own_aggregate_recent_contribution_file_author ON own_aggregate_recent_contribution USING btree (changed_file_path_id, commit_author_id);
 
+CREATE UNIQUE INDEX own_aggregate_recent_review_reviewer ON own_aggregate_recent_review USING btree (reviewed_file_path_id, reviewer_handle);
+
 CREATE UNIQUE INDEX own_aggregate_recent_view_viewer ON own_aggregate_recent_view USING btree (viewed_file_path_id, viewer_id);
 
 CREATE INDEX own_background_jobs_repo_id_idx ON own_background_jobs USING btree (repo_id);
 
 CREATE INDEX own_background_jobs_state_idx ON own_background_jobs USING btree (state);
 
+CREATE UNIQUE INDEX own_drift_findings_file_kind_owner ON own_drift_findings USING btree (file_path_id, kind, owner);
+
 CREATE UNIQUE INDEX own_signal_configurations_name_uidx ON own_signal_configurations USING btree (name);
 
 CREATE UNIQUE INDEX package_repo_filters_unique_matcher_per_scheme ON package_repo_filters USING btree (scheme, matcher);
@@ -6227,6 +6600,10 @@ CREATE INDEX process_after_insights_query_runner_jobs_idx ON insights_query_runn
 
 CREATE UNIQUE INDEX product_licenses_license_check_token_idx ON product_licenses USING btree (license_check_token);
 
+CREATE UNIQUE INDEX recurring_job_runs_kind_scheduled_at ON recurring_job_runs USING btree (kind, scheduled_at);
+
+CREATE INDEX recurring_job_runs_state ON recurring_job_runs USING btree (state);
+
 CREATE INDEX registry_extension_releases_registry_extension_id ON registry_extension_releases USING btree (registry_extension_id, release_tag, created_at DESC) WHERE (deleted_at IS NULL);
 
 CREATE INDEX registry_extension_releases_registry_extension_id_created_at ON registry_extension_releases USING btree (registry_extension_id, created_at) WHERE (deleted_at IS NULL);
@@ -6441,6 +6818,15 @@ ALTER TABLE ONLY assigned_teams
 ALTER TABLE ONLY assigned_teams
     ADD CONSTRAINT assigned_teams_who_assigned_team_id_fkey FOREIGN KEY (who_assigned_team_id) REFERENCES users(id) ON DELETE SET NULL DEFERRABLE;
 
+ALTER TABLE ONLY batch_change_impact_stats
+    ADD CONSTRAINT batch_change_impact_stats_batch_change_id_fkey FOREIGN KEY (batch_change_id) REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE;
+
+ALTER TABLE ONLY batch_change_reruns
+    ADD CONSTRAINT batch_change_reruns_batch_change_id_fkey FOREIGN KEY (batch_change_id) REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE;
+
+ALTER TABLE ONLY batch_change_reruns
+    ADD CONSTRAINT batch_change_reruns_batch_spec_id_fkey FOREIGN KEY (batch_spec_id) REFERENCES batch_specs(id) ON DELETE SET NULL DEFERRABLE;
+
 ALTER TABLE ONLY batch_changes
     ADD CONSTRAINT batch_changes_batch_spec_id_fkey FOREIGN KEY (batch_spec_id) REFERENCES batch_specs(id) DEFERRABLE;
 
@@ -6486,9 +6872,24 @@ ALTER TABLE ONLY batch_specs
 ALTER TABLE ONLY batch_specs
     ADD CONSTRAINT batch_specs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL DEFERRABLE;
 
+ALTER TABLE ONLY changeset_auto_merge_decisions
+    ADD CONSTRAINT changeset_auto_merge_decisions_batch_change_id_fkey FOREIGN KEY (batch_change_id) REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE;
+
+ALTER TABLE ONLY changeset_auto_merge_decisions
+    ADD CONSTRAINT changeset_auto_merge_decisions_batch_spec_id_fkey FOREIGN KEY (batch_spec_id) REFERENCES batch_specs(id) ON DELETE CASCADE DEFERRABLE;
+
+ALTER TABLE ONLY changeset_auto_merge_decisions
+    ADD CONSTRAINT changeset_auto_merge_decisions_changeset_id_fkey FOREIGN KEY (changeset_id) REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE;
+
+ALTER TABLE ONLY changeset_auto_merge_decisions
+    ADD CONSTRAINT changeset_auto_merge_decisions_changeset_job_id_fkey FOREIGN KEY (changeset_job_id) REFERENCES changeset_jobs(id) ON DELETE SET NULL DEFERRABLE;
+
 ALTER TABLE ONLY changeset_events
     ADD CONSTRAINT changeset_events_changeset_id_fkey FOREIGN KEY (changeset_id) REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE;
 
+ALTER TABLE ONLY changeset_impact_metrics
+    ADD CONSTRAINT changeset_impact_metrics_changeset_id_fkey FOREIGN KEY (changeset_id) REFERENCES changesets(id) ON DELETE CASCADE DEFERRABLE;
+
 ALTER TABLE ONLY changeset_jobs
     ADD CONSTRAINT changeset_jobs_batch_change_id_fkey FOREIGN KEY (batch_change_id) REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE;
 
@@ -6600,6 +7001,9 @@ ALTER TABLE ONLY cm_webhooks
 ALTER TABLE ONLY codeintel_autoindexing_exceptions
     ADD CONSTRAINT codeintel_autoindexing_exceptions_repository_id_fkey FOREIGN KEY (repository_id) REFERENCES repo(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY codeintel_coverage_snapshots
+    ADD CONSTRAINT codeintel_coverage_snapshots_repository_id_fkey FOREIGN KEY (repository_id) REFERENCES repo(id) ON DELETE CASCADE;
+
 ALTER TABLE ONLY codeintel_initial_path_ranks
     ADD CONSTRAINT codeintel_initial_path_ranks_exported_upload_id_fkey FOREIGN KEY (exported_upload_id) REFERENCES codeintel_ranking_exports(id) ON DELETE CASCADE;
 
@@ -6645,6 +7049,12 @@ ALTER TABLE ONLY discussion_threads_target_repo
 ALTER TABLE ONLY discussion_threads_target_repo
     ADD CONSTRAINT discussion_threads_target_repo_thread_id_fkey FOREIGN KEY (thread_id) REFERENCES discussion_threads(id) ON DELETE CASCADE;
 
+ALTER TABLE ONLY executor_custom_jobs
+    ADD CONSTRAINT executor_custom_jobs_creator_id_fkey FOREIGN KEY (creator_id) REFERENCES users(id) ON DELETE SET NULL DEFERRABLE;
+
+ALTER TABLE ONLY executor_custom_jobs
+    ADD CONSTRAINT executor_custom_jobs_repository_id_fkey FOREIGN KEY (repository_id) REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE;
+
 ALTER TABLE ONLY executor_secret_access_logs
     ADD CONSTRAINT executor_secret_access_logs_executor_secret_id_fkey FOREIGN KEY (executor_secret_id) REFERENCES executor_secrets(id) ON DELETE CASCADE;
 
@@ -6840,12 +7250,18 @@ ALTER TABLE ONLY own_aggregate_recent_contribution
 ALTER TABLE ONLY own_aggregate_recent_contribution
     ADD CONSTRAINT own_aggregate_recent_contribution_commit_author_id_fkey FOREIGN KEY (commit_author_id) REFERENCES commit_authors(id);
 
+ALTER TABLE ONLY own_aggregate_recent_review
+    ADD CONSTRAINT own_aggregate_recent_review_reviewed_file_path_id_fkey FOREIGN KEY (reviewed_file_path_id) REFERENCES repo_paths(id);
+
 ALTER TABLE ONLY own_aggregate_recent_view
     ADD CONSTRAINT own_aggregate_recent_view_viewed_file_path_id_fkey FOREIGN KEY (viewed_file_path_id) REFERENCES repo_paths(id);
 
 ALTER TABLE ONLY own_aggregate_recent_view
     ADD CONSTRAINT own_aggregate_recent_view_viewer_id_fkey FOREIGN KEY (viewer_id) REFERENCES users(id) ON DELETE CASCADE DEFERRABLE;
 
+ALTER TABLE ONLY own_drift_findings
+    ADD CONSTRAINT own_drift_findings_file_path_id_fkey FOREIGN KEY (file_path_id) REFERENCES repo_paths(id);
+
 ALTER TABLE ONLY own_signal_recent_contribution
     ADD CONSTRAINT own_signal_recent_contribution_changed_file_path_id_fkey FOREIGN KEY (changed_file_path_id) REFERENCES repo_paths(id);
 
@@ -6873,6 +7289,9 @@ ALTER TABLE ONLY product_licenses
 ALTER TABLE ONLY product_subscriptions
     ADD CONSTRAINT product_subscriptions_user_id_fkey FOREIGN KEY (user_id) REFERENCES users(id);
 
+ALTER TABLE ONLY recurring_job_runs
+    ADD CONSTRAINT recurring_job_runs_kind_fkey FOREIGN KEY (kind) REFERENCES recurring_jobs(kind) ON DELETE CASCADE;
+
 ALTER TABLE ONLY registry_extension_releases
     ADD CONSTRAINT registry_extension_releases_creator_user_id_fkey FOREIGN KEY (creator_user_id) REFERENCES users(id);
 
@@ -211,6 +211,29 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"azureDevOps", "bitbucketcloud", "builtin", "gerrit", "github", "gitlab", "http-header", "openidconnect", "saml"})
 }
 
+// AutoMergePolicy description: A policy to merge the changesets of the batch change automatically once their checks and reviews pass.
+type AutoMergePolicy struct {
+	// Checks description: The state the checks of a changeset must be in to be merged: `passed` requires all checks to have passed, `passed-or-none` also allows changesets without any checks, and `any` ignores checks.
+	Checks string `json:"checks,omitempty"`
+	// Method description: How changesets are merged. Squash merges are only supported on code hosts that support squash merges.
+	Method string `json:"method,omitempty"`
+	// Review description: The state the reviews of a changeset must be in to be merged: `approved` requires the changeset to be approved, `not-rejected` allows any changeset without requested changes, and `any` ignores reviews.
+	Review string `json:"review,omitempty"`
+	// Windows description: The windows within which changesets are merged, in the same format as the `batchChanges.rolloutWindows` site configuration. The rate of a window limits how many changesets of the batch change are merged. If omitted, changesets are merged at any time.
+	Windows []*AutoMergeWindow `json:"windows,omitempty"`
+}
+
+type AutoMergeWindow struct {
+	// Days description: Day(s) the window applies to. If omitted, this rule applies to all days of the week.
+	Days []string `json:"days,omitempty"`
+	// End description: Window end time in UTC. If omitted, no time window is applied to the day(s) that match this rule.
+	End string `json:"end,omitempty"`
+	// Rate description: The rate changesets will be merged at.
+	Rate any `json:"rate"`
+	// Start description: Window start time in UTC. If omitted, no time window is applied to the day(s) that match this rule.
+	Start string `json:"start,omitempty"`
+}
+
 // AzureDevOpsAuthProvider description: Azure auth provider for dev.azure.com
 type AzureDevOpsAuthProvider struct {
 	// AllowOrgs description: Restricts new logins and signups (if allowSignup is true) to members of these Azure DevOps organizations only. Existing sessions won't be invalidated. Leave empty or unset for no org restrictions.
@@ -266,6 +289,8 @@ type BatchChangeRolloutWindow struct {
 
 // BatchSpec description: A batch specification, which describes the batch change and what kinds of changes to make (or what existing changesets to track).
 type BatchSpec struct {
+	// AutoMerge description: A policy to merge the changesets of the batch change automatically once their checks and reviews pass.
+	AutoMerge *AutoMergePolicy `json:"autoMerge,omitempty"`
 	// ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 	ChangesetTemplate *ChangesetTemplate `json:"changesetTemplate,omitempty"`
 	// Description description: The description of the batch change.
@@ -276,6 +301,8 @@ type BatchSpec struct {
 	Name string `json:"name"`
 	// On description: The set of repositories (and branches) to run the batch change on, specified as a list of search queries (that match repositories) and/or specific repositories.
 	On []any `json:"on,omitempty"`
+	// Rerun description: A schedule on which the workspaces of the batch change are resolved again. Steps are executed server-side for new and changed workspaces only, and the result is applied to the batch change automatically. Requires server-side execution.
+	Rerun *RerunPolicy `json:"rerun,omitempty"`
 	// Steps description: The sequence of commands to run (for each repository branch matched in the `on` property) to produce the workspace changes that will be included in the batch change.
 	Steps []*Step `json:"steps,omitempty"`
 	// TransformChanges description: Optional transformations to apply to the changes produced in each repository.
@@ -494,6 +521,8 @@ type BranchChangesetSpec struct {
 	BaseRepository string `json:"baseRepository"`
 	// BaseRev description: The base revision this changeset is based on. It is the latest commit in baseRef at the time when the changeset spec was created.
 	BaseRev string `json:"baseRev"`
+	// Assignees description: The usernames of the users to assign the changeset to on the code host.
+	Assignees []string `json:"assignees,omitempty"`
 	// Body description: The body (description) of the changeset on the code host.
 	Body string `json:"body"`
 	// Commits description: The Git commits with the proposed changes. These commits are pushed to the head ref.
@@ -504,8 +533,14 @@ type BranchChangesetSpec struct {
 	HeadRef string `json:"headRef"`
 	// HeadRepository description: The GraphQL ID of the repository that contains the branch with this changeset's changes. Fork repositories and cross-repository changesets are not yet supported. Therefore, headRepository must be equal to baseRepository.
 	HeadRepository string `json:"headRepository"`
+	// Labels description: The labels to add to the changeset on the code host.
+	Labels []string `json:"labels,omitempty"`
+	// Milestone description: The title of the milestone to add the changeset to on the code host.
+	Milestone string `json:"milestone,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The usernames of the users to request a review of the changeset from on the code host.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset on the code host.
 	Title string `json:"title"`
 	// Version description: A field for versioning the payload.
@@ -549,6 +584,8 @@ type CapabilitiesResult struct {
 
 // ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 type ChangesetTemplate struct {
+	// Assignees description: The usernames of the users to assign the changeset to. Each username is a template, and usernames that render to an empty string are skipped.
+	Assignees any `json:"assignees,omitempty"`
 	// Body description: The body (description) of the changeset.
 	Body string `json:"body,omitempty"`
 	// Branch description: The name of the Git branch to create or update on each repository with the changes.
@@ -557,8 +594,14 @@ type ChangesetTemplate struct {
 	Commit ExpandedGitCommitDescription `json:"commit"`
 	// Fork description: Whether to publish the changeset to a fork of the target repository. If omitted, the changeset will be published to a branch directly on the target repository, unless the global `batches.enforceFork` setting is enabled. If set, this property will override any global setting.
 	Fork bool `json:"fork,omitempty"`
+	// Labels description: The labels to add to the changeset. Each label is a template. Labels that don't exist on the code host are created where the code host allows it.
+	Labels any `json:"labels,omitempty"`
+	// Milestone description: The title of the milestone to add the changeset to. The title is a template, and the milestone must already exist on the code host.
+	Milestone any `json:"milestone,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.
 	Published any `json:"published,omitempty"`
+	// Reviewers description: The usernames of the users to request a review of the changeset from. Each username is a template, and usernames that render to an empty string are skipped.
+	Reviewers any `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset.
 	Title string `json:"title"`
 }
@@ -642,6 +685,14 @@ type Completions struct {
 	Provider string `json:"provider,omitempty"`
 }
 
+// Custom description: The configuration for the custom queue.
+type Custom struct {
+	// Limit description: The maximum number of dequeues allowed within the expiration window.
+	Limit int `json:"limit"`
+	// Weight description: The relative weight of this queue. Higher weights mean a higher chance of being picked at random.
+	Weight int `json:"weight"`
+}
+
 // CustomGitFetchMapping description: Mapping from Git clone URl domain/path to git fetch command. The `domainPath` field contains the Git clone URL domain/path part. The `fetch` field contains the custom git fetch command.
 type CustomGitFetchMapping struct {
 	// DomainPath description: Git clone URL domain/path
@@ -662,6 +713,8 @@ type DequeueCacheConfig struct {
 	Batches *Batches `json:"batches,omitempty"`
 	// Codeintel description: The configuration for the codeintel queue.
 	Codeintel *Codeintel `json:"codeintel,omitempty"`
+	// Custom description: The configuration for the custom queue.
+	Custom *Custom `json:"custom,omitempty"`
 }
 
 // Dotcom description: Configuration options for Sourcegraph.com only.
@@ -1747,8 +1800,12 @@ type ObservabilityTracing struct {
 
 // OnQuery description: A Sourcegraph search query that matches a set of repositories (and branches). Each matched repository branch is added to the list of repositories that the batch change will be run on.
 type OnQuery struct {
+	// DependsOn description: The repositories whose changesets must be merged before the changesets in the repositories matched by this entry are published. The repositories must be part of the batch change. Changesets are placed in a higher tier than the changesets they depend on.
+	DependsOn []string `json:"dependsOn,omitempty"`
 	// RepositoriesMatchingQuery description: A Sourcegraph search query that matches a set of repositories (and branches). If the query matches files, symbols, or some other object inside a repository, the object's repository is included.
 	RepositoriesMatchingQuery string `json:"repositoriesMatchingQuery"`
+	// Tier description: The rollout tier of the changesets in the repositories matched by this entry. Changesets are only published once all changesets of the batch change in lower tiers are merged. Defaults to 0.
+	Tier int `json:"tier,omitempty"`
 }
 
 // OnRepository description: A specific repository (and branch) that is added to the list of repositories that the batch change will be run on.
@@ -1757,8 +1814,12 @@ type OnRepository struct {
 	Branch string `json:"branch,omitempty"`
 	// Branches description: The repository branches to propose changes to. If unset, the repository's default branch is used. If this field is defined, branch cannot be.
 	Branches []string `json:"branches,omitempty"`
+	// DependsOn description: The repositories whose changesets must be merged before the changesets in the repositories matched by this entry are published. The repositories must be part of the batch change. Changesets are placed in a higher tier than the changesets they depend on.
+	DependsOn []string `json:"dependsOn,omitempty"`
 	// Repository description: The name of the repository (as it is known to Sourcegraph).
 	Repository string `json:"repository"`
+	// Tier description: The rollout tier of the changesets in the repositories matched by this entry. Changesets are only published once all changesets of the batch change in lower tiers are merged. Defaults to 0.
+	Tier int `json:"tier,omitempty"`
 }
 type OnboardingStep struct {
 	Action              any      `json:"action"`
@@ -2097,6 +2158,20 @@ type Repos struct {
 	Path string `json:"path"`
 }
 
+// ReplaceStep description: A built-in step that replaces all matches of a pattern in the files of the workspace, with the semantics of the compute replace command.
+type ReplaceStep struct {
+	// Files description: Glob patterns of the files to replace in, relative to the workspace. If not set, all files in the workspace are used. Supports templating.
+	Files []string `json:"files,omitempty"`
+	// Matcher description: The comby matcher (language) used by structural replacements. If not set, the .generic matcher is used, like in the compute replace command.
+	Matcher string `json:"matcher,omitempty"`
+	// Pattern description: The pattern to match. Supports templating.
+	Pattern string `json:"pattern"`
+	// PatternType description: How the pattern is interpreted: as a Go regular expression, or as a structural search pattern. Structural replacements require comby to be installed.
+	PatternType string `json:"patternType,omitempty"`
+	// Replacement description: The replacement for every match. Regular expression replacements can reference capture groups with $1 or ${name}, structural replacements can reference holes with :[hole]. Supports templating.
+	Replacement string `json:"replacement"`
+}
+
 // Repository description: The repository to get the latest version of.
 type Repository struct {
 	// Name description: The repository name.
@@ -2125,6 +2200,12 @@ type ResponseMessage struct {
 	Result any            `json:"result,omitempty"`
 }
 
+// RerunPolicy description: A schedule on which the workspaces of the batch change are resolved again. Steps are executed server-side for new and changed workspaces only, and the result is applied to the batch change automatically. Requires server-side execution.
+type RerunPolicy struct {
+	// Schedule description: A cron expression such as `0 6 * * 1` or `@daily`, evaluated in UTC.
+	Schedule string `json:"schedule"`
+}
+
 // RestartStep description: Restart step
 type RestartStep struct {
 	Type  any    `json:"type"`
@@ -2835,6 +2916,8 @@ type SiteConfiguration struct {
 	OwnBackgroundRepoIndexRateLimit int `json:"own.background.repoIndexRateLimit,omitempty"`
 	// OwnBestEffortTeamMatching description: The Own service will attempt to match a Team by the last part of its handle if it contains a slash and no match is found for its full handle.
 	OwnBestEffortTeamMatching *bool `json:"own.bestEffortTeamMatching,omitempty"`
+	// OwnDriftStaleOwnerMonths description: The number of months after which a declared owner who has not contributed to a file, while others have, is flagged by the ownership drift analysis.
+	OwnDriftStaleOwnerMonths int `json:"own.drift.staleOwnerMonths,omitempty"`
 	// ParentSourcegraph description: URL to fetch unreachable repository details from. Defaults to "https://sourcegraph.com"
 	ParentSourcegraph *ParentSourcegraph `json:"parentSourcegraph,omitempty"`
 	// PermissionsSyncJobCleanupInterval description: Time interval (in seconds) of how often cleanup worker should remove old jobs from permissions sync jobs table.
@@ -3068,10 +3151,12 @@ type SrcCliVersionCache struct {
 	Interval string `json:"interval,omitempty"`
 }
 
-// Step description: A command to run (as part of a sequence) in a repository branch to produce the required changes.
+// Step description: A command to run (as part of a sequence) in a repository branch to produce the required changes. A step either runs a shell command in a container, or is a built-in step (replace, writeFiles or applyPatch) that is executed without a container.
 type Step struct {
+	// ApplyPatch description: A built-in step that applies a patch in the unified diff format produced by git diff to the repository. Supports templating.
+	ApplyPatch string `json:"applyPatch,omitempty"`
 	// Container description: The Docker image used to launch the Docker container in which the shell command is run.
-	Container string `json:"container"`
+	Container string `json:"container,omitempty"`
 	// Env description: Environment variables to set in the step environment.
 	Env any `json:"env,omitempty"`
 	// Files description: Files that should be mounted into or be created inside the Docker container.
@@ -3082,8 +3167,12 @@ type Step struct {
 	Mount []*Mount `json:"mount,omitempty"`
 	// Outputs description: Output variables of this step that can be referenced in the changesetTemplate or other steps via outputs.<name-of-output>
 	Outputs map[string]OutputVariable `json:"outputs,omitempty"`
+	// Replace description: A built-in step that replaces all matches of a pattern in the files of the workspace, with the semantics of the compute replace command.
+	Replace *ReplaceStep `json:"replace,omitempty"`
 	// Run description: The shell command to run in the container. It can also be a multi-line shell script. The working directory is the root directory of the repository checkout.
-	Run string `json:"run"`
+	Run string `json:"run,omitempty"`
+	// WriteFiles description: A built-in step that writes files into the workspace. The keys are file paths relative to the workspace, the values are the file contents. Both support templating.
+	WriteFiles map[string]string `json:"writeFiles,omitempty"`
 }
 type SubRepoPermissions struct {
 	// Enabled description: Enables sub-repo permission checking